@@ -473,6 +473,7 @@ func (ak *AppKeepers) InitKeepers(
 		appCodec,
 		runtime.NewKVStoreService(keys[monitortypes.StoreKey]),
 		&btclightclientKeeper,
+		appparams.AccGov.String(),
 	)
 
 	// add msgServiceRouter so that the epoching module can forward unwrapped messages to the staking module
@@ -488,8 +489,8 @@ func (ak *AppKeepers) InitKeepers(
 	)
 	ak.BtcCheckpointKeeper = btcCheckpointKeeper
 	ak.BTCLightClientKeeper = *btclightclientKeeper.SetHooks(
-		btclightclienttypes.NewMultiBTCLightClientHooks(ak.BtcCheckpointKeeper.Hooks()),
-	)
+		btclightclienttypes.NewMultiBTCLightClientHooks(ak.BtcCheckpointKeeper.Hooks(), ak.MonitorKeeper.Hooks()),
+	).SetBtcCheckpointKeeper(&ak.BtcCheckpointKeeper)
 
 	// set up BTC staking keeper
 	ak.BTCStakingKeeper = btcstakingkeeper.NewKeeper(
@@ -501,6 +502,7 @@ func (ak *AppKeepers) InitKeepers(
 		btcNetParams,
 		appparams.AccGov.String(),
 	)
+	ak.BTCLightClientKeeper.SetBtcStakingKeeper(&ak.BTCStakingKeeper)
 
 	// set up finality keeper
 	ak.FinalityKeeper = finalitykeeper.NewKeeper(
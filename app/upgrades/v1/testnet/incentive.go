@@ -1,5 +1,7 @@
 package testnet
 
 const IncentiveParamStr = `{
-  "btc_staking_portion": "0.3"
+  "btc_staking_portion": "0.3",
+  "btc_timestamping_portion": "0.05",
+  "btc_header_relaying_portion": "0.01"
 }`
@@ -61,3 +61,19 @@ func (c *QueryClient) BTCCheckpointsInfo(pagination *sdkquerytypes.PageRequest)
 	})
 	return resp, err
 }
+
+// BTCCheckpointSubmissionsByAddress queries btccheckpoint module for the checkpoint submissions
+// made or reported by an address, together with their statistics
+func (c *QueryClient) BTCCheckpointSubmissionsByAddress(address string, pagination *sdkquerytypes.PageRequest) (*btcctypes.QuerySubmissionsByAddressResponse, error) {
+	var resp *btcctypes.QuerySubmissionsByAddressResponse
+	err := c.QueryBTCCheckpoint(func(ctx context.Context, queryClient btcctypes.QueryClient) error {
+		var err error
+		req := &btcctypes.QuerySubmissionsByAddressRequest{
+			Address:    address,
+			Pagination: pagination,
+		}
+		resp, err = queryClient.SubmissionsByAddress(ctx, req)
+		return err
+	})
+	return resp, err
+}
@@ -76,3 +76,79 @@ func (c *QueryClient) BTCMainChain(pagination *sdkquerytypes.PageRequest) (*btcl
 
 	return resp, err
 }
+
+// BTCRecentReorgs queries the btclightclient module for the reorgs kept in the reorg log
+func (c *QueryClient) BTCRecentReorgs(pagination *sdkquerytypes.PageRequest) (*btclctypes.QueryRecentReorgsResponse, error) {
+	var resp *btclctypes.QueryRecentReorgsResponse
+	err := c.QueryBTCLightclient(func(ctx context.Context, queryClient btclctypes.QueryClient) error {
+		var err error
+		req := &btclctypes.QueryRecentReorgsRequest{
+			Pagination: pagination,
+		}
+		resp, err = queryClient.RecentReorgs(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// VerifyBTCTxInclusion queries the btclightclient module for the inclusion of a tx in a BTC block
+func (c *QueryClient) VerifyBTCTxInclusion(req *btclctypes.QueryVerifyTxInclusionRequest) (*btclctypes.QueryVerifyTxInclusionResponse, error) {
+	var resp *btclctypes.QueryVerifyTxInclusionResponse
+	err := c.QueryBTCLightclient(func(ctx context.Context, queryClient btclctypes.QueryClient) error {
+		var err error
+		resp, err = queryClient.VerifyTxInclusion(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCHeadersByHeightRange queries the btclightclient module for the main chain headers in the given height range
+func (c *QueryClient) BTCHeadersByHeightRange(startHeight, endHeight uint32) (*btclctypes.QueryHeadersByHeightRangeResponse, error) {
+	var resp *btclctypes.QueryHeadersByHeightRangeResponse
+	err := c.QueryBTCLightclient(func(ctx context.Context, queryClient btclctypes.QueryClient) error {
+		var err error
+		req := &btclctypes.QueryHeadersByHeightRangeRequest{
+			StartHeight: startHeight,
+			EndHeight:   endHeight,
+		}
+		resp, err = queryClient.HeadersByHeightRange(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCBlockLocator queries the btclightclient module for the fork point of the given block locator
+// and the main chain headers following it
+func (c *QueryClient) BTCBlockLocator(locator []*chainhash.Hash, limit uint32) (*btclctypes.QueryBlockLocatorResponse, error) {
+	var resp *btclctypes.QueryBlockLocatorResponse
+	err := c.QueryBTCLightclient(func(ctx context.Context, queryClient btclctypes.QueryClient) error {
+		var err error
+		req := &btclctypes.QueryBlockLocatorRequest{
+			Locator: make([]string, len(locator)),
+			Limit:   limit,
+		}
+		for i, hash := range locator {
+			req.Locator[i] = hash.String()
+		}
+		resp, err = queryClient.BlockLocator(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCHeaderAccumulator queries the btclightclient module for the accumulator committing to the pruned headers
+func (c *QueryClient) BTCHeaderAccumulator() (*btclctypes.QueryHeaderAccumulatorResponse, error) {
+	var resp *btclctypes.QueryHeaderAccumulatorResponse
+	err := c.QueryBTCLightclient(func(ctx context.Context, queryClient btclctypes.QueryClient) error {
+		var err error
+		req := &btclctypes.QueryHeaderAccumulatorRequest{}
+		resp, err = queryClient.HeaderAccumulator(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
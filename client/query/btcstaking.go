@@ -107,6 +107,83 @@ func (c *QueryClient) BTCDelegations(status btcstakingtypes.BTCDelegationStatus,
 	return resp, err
 }
 
+// BTCDelegationsByStakerBTCPK queries the BTCStaking module for all delegations of a staker BTC PK under a given status
+func (c *QueryClient) BTCDelegationsByStakerBTCPK(stakerBtcPkHex string, status btcstakingtypes.BTCDelegationStatus, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryBTCDelegationsByStakerBTCPKResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationsByStakerBTCPKResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationsByStakerBTCPKRequest{
+			StakerBtcPkHex: stakerBtcPkHex,
+			Status:         status,
+			Pagination:     pagination,
+		}
+		resp, err = queryClient.BTCDelegationsByStakerBTCPK(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// BTCDelegationsByStakerAddr queries the BTCStaking module for all delegations of a staker address under a given status
+func (c *QueryClient) BTCDelegationsByStakerAddr(stakerAddr string, status btcstakingtypes.BTCDelegationStatus, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryBTCDelegationsByStakerAddrResponse, error) {
+	var resp *btcstakingtypes.QueryBTCDelegationsByStakerAddrResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryBTCDelegationsByStakerAddrRequest{
+			StakerAddr: stakerAddr,
+			Status:     status,
+			Pagination: pagination,
+		}
+		resp, err = queryClient.BTCDelegationsByStakerAddr(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// StakeCapacity queries the BTCStaking module for the satoshis staked in all active BTC delegations under the total stake cap
+func (c *QueryClient) StakeCapacity() (*btcstakingtypes.QueryStakeCapacityResponse, error) {
+	var resp *btcstakingtypes.QueryStakeCapacityResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryStakeCapacityRequest{}
+		resp, err = queryClient.StakeCapacity(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityProvidersStakeCapacity queries the BTCStaking module for the stake capacity of all finality providers
+func (c *QueryClient) FinalityProvidersStakeCapacity(pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryFinalityProvidersStakeCapacityResponse, error) {
+	var resp *btcstakingtypes.QueryFinalityProvidersStakeCapacityResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryFinalityProvidersStakeCapacityRequest{
+			Pagination: pagination,
+		}
+		resp, err = queryClient.FinalityProvidersStakeCapacity(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityProviderStakeCapacity queries the BTCStaking module for the stake capacity of a given finality provider
+func (c *QueryClient) FinalityProviderStakeCapacity(fpBtcPkHex string) (*btcstakingtypes.QueryFinalityProviderStakeCapacityResponse, error) {
+	var resp *btcstakingtypes.QueryFinalityProviderStakeCapacityResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryFinalityProviderStakeCapacityRequest{
+			FpBtcPkHex: fpBtcPkHex,
+		}
+		resp, err = queryClient.FinalityProviderStakeCapacity(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
 // BTCDelegation queries the BTCStaking module to retrieve delegation by corresponding staking tx hash
 func (c *QueryClient) BTCDelegation(stakingTxHashHex string) (*btcstakingtypes.QueryBTCDelegationResponse, error) {
 	var resp *btcstakingtypes.QueryBTCDelegationResponse
@@ -121,3 +198,18 @@ func (c *QueryClient) BTCDelegation(stakingTxHashHex string) (*btcstakingtypes.Q
 
 	return resp, err
 }
+
+// ValidateBTCDelegation queries the BTCStaking module to run the checks of the given MsgCreateBTCDelegation without submitting it
+func (c *QueryClient) ValidateBTCDelegation(msg *btcstakingtypes.MsgCreateBTCDelegation) (*btcstakingtypes.QueryValidateBTCDelegationResponse, error) {
+	var resp *btcstakingtypes.QueryValidateBTCDelegationResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryValidateBTCDelegationRequest{
+			Msg: msg,
+		}
+		resp, err = queryClient.ValidateBTCDelegation(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
@@ -153,3 +153,20 @@ func (c *QueryClient) ListEvidences(startHeight uint64, pagination *sdkquerytype
 
 	return resp, err
 }
+
+// SlashingTxs queries the Finality module to get the fully signed slashing txs
+// of the BTC delegations restaked to a slashed finality provider
+func (c *QueryClient) SlashingTxs(fpBtcPkHex string, pagination *sdkquerytypes.PageRequest) (*finalitytypes.QuerySlashingTxsResponse, error) {
+	var resp *finalitytypes.QuerySlashingTxsResponse
+	err := c.QueryFinality(func(ctx context.Context, queryClient finalitytypes.QueryClient) error {
+		var err error
+		req := &finalitytypes.QuerySlashingTxsRequest{
+			FpBtcPkHex: fpBtcPkHex,
+			Pagination: pagination,
+		}
+		resp, err = queryClient.SlashingTxs(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
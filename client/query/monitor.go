@@ -48,3 +48,34 @@ func (c *QueryClient) ReportedCheckpointBTCHeight(hashStr string) (*monitortypes
 
 	return resp, err
 }
+
+// CheckpointLatency queries the latency metrics of the checkpoint of a given epoch
+func (c *QueryClient) CheckpointLatency(epochNum uint64) (*monitortypes.QueryCheckpointLatencyResponse, error) {
+	var resp *monitortypes.QueryCheckpointLatencyResponse
+	err := c.QueryMonitor(func(ctx context.Context, queryClient monitortypes.QueryClient) error {
+		var err error
+		req := &monitortypes.QueryCheckpointLatencyRequest{
+			EpochNum: epochNum,
+		}
+		resp, err = queryClient.CheckpointLatency(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CheckpointLatencies queries the latency metrics of the checkpoints of the epochs in a given inclusive range
+func (c *QueryClient) CheckpointLatencies(startEpoch, endEpoch uint64) (*monitortypes.QueryCheckpointLatenciesResponse, error) {
+	var resp *monitortypes.QueryCheckpointLatenciesResponse
+	err := c.QueryMonitor(func(ctx context.Context, queryClient monitortypes.QueryClient) error {
+		var err error
+		req := &monitortypes.QueryCheckpointLatenciesRequest{
+			StartEpoch: startEpoch,
+			EndEpoch:   endEpoch,
+		}
+		resp, err = queryClient.CheckpointLatencies(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
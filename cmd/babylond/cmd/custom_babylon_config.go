@@ -12,7 +12,8 @@ import (
 )
 
 type BtcConfig struct {
-	Network string `mapstructure:"network"`
+	Network         string `mapstructure:"network"`
+	SignetChallenge string `mapstructure:"signet-challenge"`
 }
 
 func defaultBabylonBtcConfig() BtcConfig {
@@ -50,7 +51,11 @@ func DefaultBabylonTemplate() string {
 [btc-config]
 
 # Configures which bitcoin network should be used for checkpointing
-# valid values are: [mainnet, testnet, simnet, signet, regtest]
+# valid values are: [mainnet, testnet, testnet4, simnet, signet, regtest]
 network = "{{ .BtcConfig.Network }}"
+
+# Hex encoded challenge script of a custom signet, only used if network is signet
+# If empty, the default signet is used
+signet-challenge = "{{ .BtcConfig.SignetChallenge }}"
 `
 }
@@ -10,6 +10,7 @@ import (
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
@@ -75,6 +76,7 @@ func NewHelper(
 
 	ckptKeeper := ftypes.NewMockCheckpointingKeeper(ctrl)
 	ckptKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(timestampedEpoch).AnyTimes()
+	ckptKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).Return(timestampedEpoch).AnyTimes()
 
 	return NewHelperWithStoreAndIncentive(t, db, stateStore, btclcKeeper, btccKeeper, ckptKeeper, iKeeper)
 }
@@ -200,11 +202,12 @@ func CreateFinalityProvider(r *rand.Rand, t *testing.T) *types.FinalityProvider
 	require.NoError(t, err)
 
 	return &types.FinalityProvider{
-		Description: fp.Description,
-		Commission:  fp.Commission,
-		Addr:        fp.Addr,
-		BtcPk:       fp.BtcPk,
-		Pop:         fp.Pop,
+		Description:    fp.Description,
+		Commission:     fp.Commission,
+		Addr:           fp.Addr,
+		BtcPk:          fp.BtcPk,
+		Pop:            fp.Pop,
+		CommissionInfo: fp.CommissionInfo,
 	}
 }
 
@@ -214,11 +217,12 @@ func (h *Helper) CreateFinalityProvider(r *rand.Rand) (*btcec.PrivateKey, *btcec
 	fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, fpSK)
 	h.NoError(err)
 	msgNewFp := types.MsgCreateFinalityProvider{
-		Addr:        fp.Addr,
-		Description: fp.Description,
-		Commission:  fp.Commission,
-		BtcPk:       fp.BtcPk,
-		Pop:         fp.Pop,
+		Addr:            fp.Addr,
+		Description:     fp.Description,
+		Commission:      fp.Commission,
+		BtcPk:           fp.BtcPk,
+		Pop:             fp.Pop,
+		CommissionRates: fp.CommissionInfo.Rates(),
 	}
 
 	_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, &msgNewFp)
@@ -419,6 +423,183 @@ func (h *Helper) CreateDelegationWithBtcBlockHeight(
 	}, nil
 }
 
+// genSuccessorDelegation generates a MsgExtendBTCDelegation to the given
+// finality provider whose staking tx spends the staking output of the given
+// BTC delegation
+func (h *Helper) genSuccessorDelegation(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	prevDel *types.BTCDelegation,
+	stakingValue int64,
+	stakingTime uint16,
+	usePreApproval bool,
+	stakingTransactionInclusionHeight uint32,
+) (*types.MsgExtendBTCDelegation, *btclctypes.BTCHeaderInfo, *types.InclusionProof) {
+	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+	covPKs, err := bbn.NewBTCPKsFromBIP340PKs(bsParams.CovenantPks)
+	h.NoError(err)
+	unbondingTime := uint16(bsParams.UnbondingTimeBlocks)
+	unbondingValue := stakingValue - 1000
+
+	// the new staking tx spends the staking output of the previous BTC delegation
+	prevStakingTxHash := prevDel.MustGetStakingTxHash()
+	testStakingInfo := datagen.GenBTCStakingSlashingInfoWithOutPoint(
+		r,
+		h.t,
+		h.Net,
+		wire.NewOutPoint(&prevStakingTxHash, prevDel.StakingOutputIdx),
+		delSK,
+		[]*btcec.PublicKey{fpPK},
+		covPKs,
+		bsParams.CovenantQuorum,
+		stakingTime,
+		stakingValue,
+		bsParams.SlashingPkScript,
+		bsParams.SlashingRate,
+		unbondingTime,
+	)
+	stakingTxHash := testStakingInfo.StakingTx.TxHash()
+
+	prevBlock, _ := datagen.GenRandomBtcdBlock(r, 0, nil)
+	btcHeaderWithProof := datagen.CreateBlockWithTransaction(r, &prevBlock.Header, testStakingInfo.StakingTx)
+	btcHeader := btcHeaderWithProof.HeaderBytes
+	btcHeaderInfo := &btclctypes.BTCHeaderInfo{Header: &btcHeader, Height: stakingTransactionInclusionHeight}
+	serializedStakingTx, err := bbn.SerializeBTCTx(testStakingInfo.StakingTx)
+	h.NoError(err)
+	txInclusionProof := types.NewInclusionProof(&btcctypes.TransactionKey{Index: 1, Hash: btcHeader.Hash()}, btcHeaderWithProof.SpvProof.MerkleNodes)
+
+	slashingSpendInfo, err := testStakingInfo.StakingInfo.SlashingPathSpendInfo()
+	h.NoError(err)
+	delegatorSig, err := testStakingInfo.SlashingTx.Sign(
+		testStakingInfo.StakingTx,
+		0,
+		slashingSpendInfo.GetPkScriptPath(),
+		delSK,
+	)
+	h.NoError(err)
+
+	testUnbondingInfo := datagen.GenBTCUnbondingSlashingInfo(
+		r,
+		h.t,
+		h.Net,
+		delSK,
+		[]*btcec.PublicKey{fpPK},
+		covPKs,
+		bsParams.CovenantQuorum,
+		wire.NewOutPoint(&stakingTxHash, 0),
+		unbondingTime,
+		unbondingValue,
+		bsParams.SlashingPkScript,
+		bsParams.SlashingRate,
+		unbondingTime,
+	)
+	delSlashingTxSig, err := testUnbondingInfo.GenDelSlashingTxSig(delSK)
+	h.NoError(err)
+	serializedUnbondingTx, err := bbn.SerializeBTCTx(testUnbondingInfo.UnbondingTx)
+	h.NoError(err)
+
+	msgExtendBTCDel := &types.MsgExtendBTCDelegation{
+		StakerAddr:                    prevDel.StakerAddr,
+		PrevStakingTxHash:             prevStakingTxHash.String(),
+		StakingTime:                   uint32(stakingTime),
+		StakingValue:                  stakingValue,
+		StakingTx:                     serializedStakingTx,
+		SlashingTx:                    testStakingInfo.SlashingTx,
+		DelegatorSlashingSig:          delegatorSig,
+		UnbondingTx:                   serializedUnbondingTx,
+		UnbondingTime:                 uint32(unbondingTime),
+		UnbondingValue:                unbondingValue,
+		UnbondingSlashingTx:           testUnbondingInfo.SlashingTx,
+		DelegatorUnbondingSlashingSig: delSlashingTxSig,
+	}
+	if !usePreApproval {
+		msgExtendBTCDel.StakingTxInclusionProof = txInclusionProof
+	}
+
+	// mock for testing k-deep stuff
+	h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeader.Hash())).Return(btcHeaderInfo, nil).AnyTimes()
+
+	return msgExtendBTCDel, btcHeaderInfo, txInclusionProof
+}
+
+// ExtendDelegation sends a MsgExtendBTCDelegation whose staking tx spends the
+// staking output of the given BTC delegation
+func (h *Helper) ExtendDelegation(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	prevDel *types.BTCDelegation,
+	stakingValue int64,
+	stakingTime uint16,
+	usePreApproval bool,
+	stakingTransactionInclusionHeight uint32,
+	lightClientTipHeight uint32,
+) (string, *types.MsgExtendBTCDelegation, *btclctypes.BTCHeaderInfo, *types.InclusionProof, error) {
+	msg, btcHeaderInfo, txInclusionProof := h.genSuccessorDelegation(
+		r, delSK, fpPK, prevDel, stakingValue, stakingTime, usePreApproval, stakingTransactionInclusionHeight,
+	)
+
+	// the tip is used for checking the status of the previous BTC delegation
+	// and for validating the new staking tx
+	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: lightClientTipHeight}).Times(2)
+
+	if _, err := h.MsgServer.ExtendBTCDelegation(h.Ctx, msg); err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	stakingTx, err := bbn.NewBTCTxFromBytes(msg.StakingTx)
+	h.NoError(err)
+	return stakingTx.TxHash().String(), msg, btcHeaderInfo, txInclusionProof, nil
+}
+
+// RedelegateDelegation sends a MsgRedelegateBTCDelegation to the given
+// finality provider whose staking tx spends the staking output of the given
+// BTC delegation
+func (h *Helper) RedelegateDelegation(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	prevDel *types.BTCDelegation,
+	stakingValue int64,
+	stakingTime uint16,
+	usePreApproval bool,
+	stakingTransactionInclusionHeight uint32,
+	lightClientTipHeight uint32,
+) (string, *types.MsgRedelegateBTCDelegation, *btclctypes.BTCHeaderInfo, *types.InclusionProof, error) {
+	extMsg, btcHeaderInfo, txInclusionProof := h.genSuccessorDelegation(
+		r, delSK, fpPK, prevDel, stakingValue, stakingTime, usePreApproval, stakingTransactionInclusionHeight,
+	)
+	msg := &types.MsgRedelegateBTCDelegation{
+		StakerAddr:                    extMsg.StakerAddr,
+		PrevStakingTxHash:             extMsg.PrevStakingTxHash,
+		FpBtcPkList:                   []bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)},
+		StakingTime:                   extMsg.StakingTime,
+		StakingValue:                  extMsg.StakingValue,
+		StakingTx:                     extMsg.StakingTx,
+		StakingTxInclusionProof:       extMsg.StakingTxInclusionProof,
+		SlashingTx:                    extMsg.SlashingTx,
+		DelegatorSlashingSig:          extMsg.DelegatorSlashingSig,
+		UnbondingTime:                 extMsg.UnbondingTime,
+		UnbondingTx:                   extMsg.UnbondingTx,
+		UnbondingValue:                extMsg.UnbondingValue,
+		UnbondingSlashingTx:           extMsg.UnbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: extMsg.DelegatorUnbondingSlashingSig,
+	}
+
+	// the tip is used for checking the status of the previous BTC delegation
+	// and for validating the new staking tx
+	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: lightClientTipHeight}).Times(2)
+
+	if _, err := h.MsgServer.RedelegateBTCDelegation(h.Ctx, msg); err != nil {
+		return "", nil, nil, nil, err
+	}
+
+	stakingTx, err := bbn.NewBTCTxFromBytes(msg.StakingTx)
+	h.NoError(err)
+	return stakingTx.TxHash().String(), msg, btcHeaderInfo, txInclusionProof, nil
+}
+
 func (h *Helper) GenerateCovenantSignaturesMessages(
 	r *rand.Rand,
 	covenantSKs []*btcec.PrivateKey,
@@ -478,6 +659,11 @@ func (h *Helper) GenerateCovenantSignaturesMessages(
 	covUnbondingSigs, err := datagen.GenCovenantUnbondingSigs(covenantSKs, stakingTx, del.StakingOutputIdx, unbondingPathInfo.GetPkScriptPath(), unbondingTx)
 	h.NoError(err)
 
+	// a successor additionally needs signatures on its staking tx, which
+	// spends the staking output of the previous BTC delegation through the
+	// unbonding path
+	covTransitionSigs := h.GenerateCovenantTransitionSigs(covenantSKs, del)
+
 	msgs := make([]*types.MsgAddCovenantSigs, len(bsParams.CovenantPks))
 
 	for i := 0; i < len(bsParams.CovenantPks); i++ {
@@ -489,11 +675,44 @@ func (h *Helper) GenerateCovenantSignaturesMessages(
 			UnbondingTxSig:          bbn.NewBIP340SignatureFromBTCSig(covUnbondingSigs[i]),
 			SlashingUnbondingTxSigs: covenantUnbondingSlashingTxSigs[i].AdaptorSigs,
 		}
+		if covTransitionSigs != nil {
+			msgAddCovenantSig.TransitionTxSig = bbn.NewBIP340SignatureFromBTCSig(covTransitionSigs[i])
+		}
 		msgs[i] = msgAddCovenantSig
 	}
 	return msgs
 }
 
+// GenerateCovenantTransitionSigs generates the signatures of all covenant
+// members on the staking tx of the given successor BTC delegation. It returns
+// nil if the BTC delegation is not a successor
+func (h *Helper) GenerateCovenantTransitionSigs(
+	covenantSKs []*btcec.PrivateKey,
+	del *types.BTCDelegation,
+) []*schnorr.Signature {
+	if !del.IsSuccessor() {
+		return nil
+	}
+
+	prevDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, del.PrevStakingTxHash)
+	h.NoError(err)
+	prevParams := h.BTCStakingKeeper.GetParamsByVersion(h.Ctx, prevDel.ParamsVersion)
+	prevStakingInfo, err := prevDel.GetStakingInfo(prevParams, h.Net)
+	h.NoError(err)
+	prevUnbondingPathInfo, err := prevStakingInfo.UnbondingPathSpendInfo()
+	h.NoError(err)
+
+	covTransitionSigs, err := datagen.GenCovenantUnbondingSigs(
+		covenantSKs,
+		prevDel.MustGetStakingTx(),
+		prevDel.StakingOutputIdx,
+		prevUnbondingPathInfo.GetPkScriptPath(),
+		del.MustGetStakingTx(),
+	)
+	h.NoError(err)
+	return covTransitionSigs
+}
+
 func (h *Helper) CreateCovenantSigs(
 	r *rand.Rand,
 	covenantSKs []*btcec.PrivateKey,
@@ -528,6 +747,11 @@ func (h *Helper) CreateCovenantSigs(
 	require.Len(h.t, actualDelWithCovenantSigs.BtcUndelegation.CovenantUnbondingSigList, len(covenantMsgs))
 	require.Len(h.t, actualDelWithCovenantSigs.BtcUndelegation.CovenantSlashingSigs, len(covenantMsgs))
 	require.Len(h.t, actualDelWithCovenantSigs.BtcUndelegation.CovenantSlashingSigs[0].AdaptorSigs, 1)
+	if actualDelWithCovenantSigs.IsSuccessor() {
+		require.Len(h.t, actualDelWithCovenantSigs.CovenantTransitionSigs, len(covenantMsgs))
+	} else {
+		require.Empty(h.t, actualDelWithCovenantSigs.CovenantTransitionSigs)
+	}
 
 	// ensure the BTC delegation is verified (if using pre-approval flow) or active
 	status := actualDelWithCovenantSigs.GetStatus(btcTipHeight, bsParams.CovenantQuorum)
@@ -605,11 +829,12 @@ func (h *Helper) CommitPubRandList(
 
 func (h *Helper) AddFinalityProvider(fp *types.FinalityProvider) {
 	err := h.BTCStakingKeeper.AddFinalityProvider(h.Ctx, &types.MsgCreateFinalityProvider{
-		Addr:        fp.Addr,
-		Description: fp.Description,
-		Commission:  fp.Commission,
-		BtcPk:       fp.BtcPk,
-		Pop:         fp.Pop,
+		Addr:            fp.Addr,
+		Description:     fp.Description,
+		Commission:      fp.Commission,
+		BtcPk:           fp.BtcPk,
+		Pop:             fp.Pop,
+		CommissionRates: fp.CommissionInfo.Rates(),
 	})
 	h.NoError(err)
 }
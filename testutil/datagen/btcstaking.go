@@ -3,6 +3,7 @@ package datagen
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,6 +53,15 @@ func GenRandomCommission(r *rand.Rand) sdkmath.LegacyDec {
 	return sdkmath.LegacyNewDecWithPrec(int64(RandomInt(r, 49)+1), 2) // [1/100, 50/100]
 }
 
+// GenCommissionRates generates commission rates that allow any commission
+// rate and any change of it
+func GenCommissionRates() bstypes.CommissionRates {
+	return bstypes.CommissionRates{
+		MaxRate:       sdkmath.LegacyOneDec(),
+		MaxChangeRate: sdkmath.LegacyOneDec(),
+	}
+}
+
 func GenRandomDescription(r *rand.Rand) *stakingtypes.Description {
 	return &stakingtypes.Description{Moniker: GenRandomHexStr(r, 10)}
 }
@@ -74,11 +84,12 @@ func GenRandomFinalityProviderWithBTCBabylonSKs(
 		return nil, err
 	}
 	return &bstypes.FinalityProvider{
-		Description: description,
-		Commission:  &commission,
-		BtcPk:       bip340PK,
-		Addr:        fpAddr.String(),
-		Pop:         pop,
+		Description:    description,
+		Commission:     &commission,
+		BtcPk:          bip340PK,
+		Addr:           fpAddr.String(),
+		Pop:            pop,
+		CommissionInfo: bstypes.NewCommissionInfo(GenCommissionRates(), time.Time{}),
 	}, nil
 }
 
@@ -92,11 +103,12 @@ func GenRandomCreateFinalityProviderMsgWithBTCBabylonSKs(
 		return nil, err
 	}
 	return &bstypes.MsgCreateFinalityProvider{
-		Addr:        fp.Addr,
-		Description: fp.Description,
-		Commission:  fp.Commission,
-		BtcPk:       fp.BtcPk,
-		Pop:         fp.Pop,
+		Addr:            fp.Addr,
+		Description:     fp.Description,
+		Commission:      fp.Commission,
+		BtcPk:           fp.BtcPk,
+		Pop:             fp.Pop,
+		CommissionRates: fp.CommissionInfo.Rates(),
 	}, nil
 }
 
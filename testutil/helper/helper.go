@@ -315,11 +315,12 @@ func (h *Helper) AddDelegation(del *btcstakingtypes.BTCDelegation) {
 
 func (h *Helper) AddFinalityProvider(fp *btcstakingtypes.FinalityProvider) {
 	err := h.App.BTCStakingKeeper.AddFinalityProvider(h.Ctx, &btcstakingtypes.MsgCreateFinalityProvider{
-		Addr:        fp.Addr,
-		Description: fp.Description,
-		Commission:  fp.Commission,
-		BtcPk:       fp.BtcPk,
-		Pop:         fp.Pop,
+		Addr:            fp.Addr,
+		Description:     fp.Description,
+		Commission:      fp.Commission,
+		BtcPk:           fp.BtcPk,
+		Pop:             fp.Pop,
+		CommissionRates: fp.CommissionInfo.Rates(),
 	})
 	h.NoError(err)
 }
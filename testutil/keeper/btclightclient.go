@@ -30,6 +30,9 @@ type MockIncentiveKeeper struct{}
 
 func (mik MockIncentiveKeeper) IndexRefundableMsg(ctx context.Context, msg sdk.Msg) {}
 
+func (mik MockIncentiveKeeper) RewardBTCHeaderRelaying(ctx context.Context, reporter sdk.AccAddress, numHeaders uint32) {
+}
+
 func BTCLightClientKeeper(t testing.TB) (*btclightclientk.Keeper, sdk.Context) {
 	k, ctx, _ := BTCLightClientKeeperWithCustomParams(t, btclightclientt.DefaultParams())
 	return k, ctx
@@ -48,10 +51,29 @@ func BTCLightClientKeeperWithCustomParams(
 	t testing.TB,
 	p btclightclientt.Params,
 ) (*btclightclientk.Keeper, sdk.Context, corestore.KVStoreService) {
-	storeKey := storetypes.NewKVStoreKey(btclightclientt.StoreKey)
+	return BTCLightClientKeeperWithIncentiveKeeper(t, p, &MockIncentiveKeeper{})
+}
 
+func BTCLightClientKeeperWithIncentiveKeeper(
+	t testing.TB,
+	p btclightclientt.Params,
+	iKeeper btclightclientt.IncentiveKeeper,
+) (*btclightclientk.Keeper, sdk.Context, corestore.KVStoreService) {
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+
+	return BTCLightClientKeeperWithStore(t, db, stateStore, p, iKeeper)
+}
+
+func BTCLightClientKeeperWithStore(
+	t testing.TB,
+	db dbm.DB,
+	stateStore store.CommitMultiStore,
+	p btclightclientt.Params,
+	iKeeper btclightclientt.IncentiveKeeper,
+) (*btclightclientk.Keeper, sdk.Context, corestore.KVStoreService) {
+	storeKey := storetypes.NewKVStoreKey(btclightclientt.StoreKey)
+
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
@@ -64,7 +86,7 @@ func BTCLightClientKeeperWithCustomParams(
 		cdc,
 		stServ,
 		testCfg,
-		&MockIncentiveKeeper{},
+		iKeeper,
 		appparams.AccGov.String(),
 	)
 
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/hex"
 	"math/big"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -15,11 +16,12 @@ type BtcConfig struct {
 }
 
 const (
-	BtcMainnet SupportedBtcNetwork = "mainnet"
-	BtcTestnet SupportedBtcNetwork = "testnet"
-	BtcSimnet  SupportedBtcNetwork = "simnet"
-	BtcRegtest SupportedBtcNetwork = "regtest"
-	BtcSignet  SupportedBtcNetwork = "signet"
+	BtcMainnet  SupportedBtcNetwork = "mainnet"
+	BtcTestnet  SupportedBtcNetwork = "testnet"
+	BtcTestnet4 SupportedBtcNetwork = "testnet4"
+	BtcSimnet   SupportedBtcNetwork = "simnet"
+	BtcRegtest  SupportedBtcNetwork = "regtest"
+	BtcSignet   SupportedBtcNetwork = "signet"
 )
 
 func getParams(opts servertypes.AppOptions) *chaincfg.Params {
@@ -44,11 +46,34 @@ func getParams(opts servertypes.AppOptions) *chaincfg.Params {
 		return &chaincfg.SimNetParams
 	case string(BtcRegtest):
 		return &chaincfg.RegressionNetParams
+	case string(BtcTestnet4):
+		return &TestNet4Params
 	case string(BtcSignet):
-		return &chaincfg.SigNetParams
+		return getSignetParams(opts)
 	default:
-		panic("Bitcoin network should be one of [mainet, testnet, simnet, regtest, signet]")
+		panic("Bitcoin network should be one of [mainet, testnet, testnet4, simnet, regtest, signet]")
+	}
+}
+
+// getSignetParams returns the params of the default signet, or the ones of a
+// custom signet if its challenge is provided in options
+func getSignetParams(opts servertypes.AppOptions) *chaincfg.Params {
+	challenge, err := cast.ToStringE(opts.Get("btc-config.signet-challenge"))
+	if err != nil {
+		panic("Bitcoin signet challenge config should be valid string")
+	}
+
+	if challenge == "" {
+		return &chaincfg.SigNetParams
+	}
+
+	challengeBytes, err := hex.DecodeString(challenge)
+	if err != nil {
+		panic("Bitcoin signet challenge config should be valid hex string")
 	}
+
+	params := chaincfg.CustomSignetParams(challengeBytes, nil)
+	return &params
 }
 
 func ParseBtcOptionsFromConfig(opts servertypes.AppOptions) BtcConfig {
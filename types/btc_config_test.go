@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	simsutils "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/types"
+)
+
+func TestParseBtcOptionsFromConfig(t *testing.T) {
+	parse := func(opts simsutils.AppOptionsMap) *chaincfg.Params {
+		cfg := types.ParseBtcOptionsFromConfig(opts)
+		return cfg.NetParams()
+	}
+
+	params := parse(simsutils.AppOptionsMap{"btc-config.network": string(types.BtcTestnet4)})
+	require.Equal(t, types.TestNet4Params.GenesisHash, params.GenesisHash)
+	require.True(t, types.EnforceBIP94(params))
+
+	params = parse(simsutils.AppOptionsMap{"btc-config.network": string(types.BtcSignet)})
+	require.Equal(t, chaincfg.SigNetParams.Net, params.Net)
+
+	// custom signets share the genesis of the default signet but not the
+	// network magic, which is derived from the challenge
+	params = parse(simsutils.AppOptionsMap{
+		"btc-config.network":          string(types.BtcSignet),
+		"btc-config.signet-challenge": "51",
+	})
+	require.Equal(t, chaincfg.SigNetParams.GenesisHash, params.GenesisHash)
+	require.NotEqual(t, chaincfg.SigNetParams.Net, params.Net)
+	require.Equal(t, chaincfg.CustomSignetParams([]byte{0x51}, nil).Net, params.Net)
+
+	require.Panics(t, func() {
+		parse(simsutils.AppOptionsMap{
+			"btc-config.network":          string(types.BtcSignet),
+			"btc-config.signet-challenge": "not hex",
+		})
+	})
+	require.Panics(t, func() {
+		parse(simsutils.AppOptionsMap{"btc-config.network": "testnet5"})
+	})
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// Bitcoin testnet4 (BIP94) is not supported by the btcd version in use, so its
+// parameters are defined here, following Bitcoin Core.
+
+// TestNet4Net is the magic of the messages of the test network version 4
+const TestNet4Net wire.BitcoinNet = 0x283f161c
+
+// MaxTimewarp is the maximum number of seconds by which the timestamp of the
+// first block of a difficulty adjustment period can precede the timestamp of
+// the previous block on networks enforcing BIP94
+const MaxTimewarp = 600 * time.Second
+
+// testNet4GenesisCoinbaseTx is the coinbase transaction of the genesis block
+// of the test network version 4
+var testNet4GenesisCoinbaseTx = wire.MsgTx{
+	Version: 1,
+	TxIn: []*wire.TxIn{
+		{
+			PreviousOutPoint: wire.OutPoint{
+				Hash:  chainhash.Hash{},
+				Index: 0xffffffff,
+			},
+			SignatureScript: append(
+				// 486604799, 4, OP_PUSHDATA1 76
+				[]byte{0x04, 0xff, 0xff, 0x00, 0x1d, 0x01, 0x04, 0x4c, 0x4c},
+				"03/May/2024 000000000000000000001ebd58c244970b3aa9d783bb001011fbe8ea8e98e00e"...,
+			),
+			Sequence: 0xffffffff,
+		},
+	},
+	TxOut: []*wire.TxOut{
+		{
+			Value: 0x12a05f200,
+			// 33 zero bytes pubkey, OP_CHECKSIG
+			PkScript: append(append([]byte{0x21}, make([]byte, 33)...), 0xac),
+		},
+	},
+	LockTime: 0,
+}
+
+// testNet4GenesisMerkleRoot is the merkle root of the genesis block of the
+// test network version 4
+var testNet4GenesisMerkleRoot = testNet4GenesisCoinbaseTx.TxHash()
+
+// testNet4GenesisBlock defines the genesis block of the test network version 4
+var testNet4GenesisBlock = wire.MsgBlock{
+	Header: wire.BlockHeader{
+		Version:    1,
+		PrevBlock:  chainhash.Hash{},
+		MerkleRoot: testNet4GenesisMerkleRoot,
+		Timestamp:  time.Unix(1714777860, 0), // 2024-05-03 23:11:00 +0000 UTC
+		Bits:       0x1d00ffff,
+		Nonce:      393743547,
+	},
+	Transactions: []*wire.MsgTx{&testNet4GenesisCoinbaseTx},
+}
+
+// testNet4GenesisHash is the hash of the genesis block of the test network
+// version 4
+var testNet4GenesisHash = testNet4GenesisBlock.BlockHash()
+
+// TestNet4Params defines the network parameters of the test network version 4.
+// They only differ from the ones of testnet3 in the genesis block, the network
+// identity, and the activation heights of the soft forks. The BIP94 rules are
+// enforced by the BTC light client based on the network, see EnforceBIP94.
+var TestNet4Params = newTestNet4Params()
+
+func newTestNet4Params() chaincfg.Params {
+	params := chaincfg.TestNet3Params
+
+	params.Name = "testnet4"
+	params.Net = TestNet4Net
+	params.DefaultPort = "48333"
+	params.DNSSeeds = []chaincfg.DNSSeed{
+		{Host: "seed.testnet4.bitcoin.sprovoost.nl", HasFiltering: true},
+		{Host: "seed.testnet4.wiz.biz", HasFiltering: true},
+	}
+
+	params.GenesisBlock = &testNet4GenesisBlock
+	params.GenesisHash = &testNet4GenesisHash
+
+	params.BIP0034Height = 1
+	params.BIP0065Height = 1
+	params.BIP0066Height = 1
+	params.Checkpoints = nil
+
+	return params
+}
+
+// EnforceBIP94 returns true if headers of the given network must follow the
+// BIP94 rules i.e.
+// - the difficulty of a new period is computed from the difficulty of the first
+// block of the previous period, instead of the last one which may have been
+// mined using the 20 minutes minimum difficulty exception, and
+// - the first block of a period cannot be more than MaxTimewarp earlier than
+// the previous block.
+func EnforceBIP94(params *chaincfg.Params) bool {
+	return params.Net == TestNet4Net
+}
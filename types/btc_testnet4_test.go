@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestNet4Params(t *testing.T) {
+	params := &types.TestNet4Params
+
+	// genesis block of testnet4, from Bitcoin Core
+	require.Equal(t, "00000000da84f2bafbbc53dee25a72ae507ff4914b867c565be350b0da8bf043", params.GenesisHash.String())
+	require.Equal(t, "00000000da84f2bafbbc53dee25a72ae507ff4914b867c565be350b0da8bf043", params.GenesisBlock.BlockHash().String())
+	require.Equal(t, "7aa0a7ae1e223414cb807e40cd57e667b718e42aaf9306db9102fe28912b7b4e", params.GenesisBlock.Header.MerkleRoot.String())
+	require.NoError(t, types.ValidateBTCHeader(&params.GenesisBlock.Header, params.PowLimit))
+
+	// the 20 minutes minimum difficulty exception is kept from testnet3
+	require.True(t, params.ReduceMinDifficulty)
+	require.Equal(t, chaincfg.TestNet3Params.MinDiffReductionTime, params.MinDiffReductionTime)
+	require.Equal(t, chaincfg.TestNet3Params.PowLimitBits, params.PowLimitBits)
+
+	// testnet3 must not be altered
+	require.Equal(t, "testnet3", chaincfg.TestNet3Params.Name)
+	require.Equal(t, int32(21111), chaincfg.TestNet3Params.BIP0034Height)
+
+	require.True(t, types.EnforceBIP94(params))
+	require.False(t, types.EnforceBIP94(&chaincfg.TestNet3Params))
+	require.False(t, types.EnforceBIP94(&chaincfg.SigNetParams))
+	require.False(t, types.EnforceBIP94(&chaincfg.MainNetParams))
+}
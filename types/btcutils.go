@@ -11,6 +11,7 @@ import (
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 )
 
@@ -99,3 +100,68 @@ func GetOutputIdxInBTCTx(tx *wire.MsgTx, output *wire.TxOut) (uint32, error) {
 
 	return 0, fmt.Errorf("output not found")
 }
+
+// Concatenates and double hashes two provided inputs
+func hashConcat(a []byte, b []byte) chainhash.Hash {
+	c := []byte{}
+	c = append(c, a...)
+	c = append(c, b...)
+	return chainhash.DoubleHashH(c)
+}
+
+// VerifyInclusionProof checks the validity of a merkle proof of the inclusion
+// of the given transaction at the given index of a block with the given merkle
+// root
+// proof logic copied from:
+// https://github.com/summa-tx/bitcoin-spv/blob/fb2a61e7a941d421ae833789d97ed10d2ad79cfe/golang/btcspv/bitcoin_spv.go#L498
+// main reason for not bringing library in, is that we already use btcd
+// bitcoin primitives and this library defines their own which could lead
+// to some mixups
+func VerifyInclusionProof(tx *btcutil.Tx, merkleRoot *chainhash.Hash, intermediateNodes []byte, index uint32) bool {
+	txHash := tx.Hash()
+
+	// Shortcut the empty-block case
+	if txHash.IsEqual(merkleRoot) && index == 0 && len(intermediateNodes) == 0 {
+		return true
+	}
+
+	proof := []byte{}
+	proof = append(proof, txHash[:]...)
+	proof = append(proof, intermediateNodes...)
+	proof = append(proof, merkleRoot[:]...)
+
+	var current chainhash.Hash
+
+	idx := index
+
+	proofLength := len(proof)
+
+	if proofLength%32 != 0 {
+		return false
+	}
+
+	if proofLength == 64 {
+		return false
+	}
+
+	root := proof[proofLength-32:]
+
+	cur := proof[:32:32]
+	copy(current[:], cur)
+
+	numSteps := (proofLength / 32) - 1
+
+	for i := 1; i < numSteps; i++ {
+		start := i * 32
+		end := i*32 + 32
+		next := proof[start:end:end]
+		if idx%2 == 1 {
+			current = hashConcat(next, current[:])
+		} else {
+			current = hashConcat(current[:], next)
+		}
+		idx >>= 1
+	}
+
+	return bytes.Equal(current[:], root)
+}
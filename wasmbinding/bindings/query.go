@@ -7,6 +7,7 @@ type BabylonQuery struct {
 	BtcBaseHeader            *struct{}          `json:"btc_base_header,omitempty"`
 	BtcHeaderByHash          *BtcHeaderByHash   `json:"btc_header_by_hash,omitempty"`
 	BtcHeaderByHeight        *BtcHeaderByHeight `json:"btc_header_by_height,omitempty"`
+	BtcTxInclusion           *BtcTxInclusion    `json:"btc_tx_inclusion,omitempty"`
 }
 
 type BtcHeaderByHash struct {
@@ -17,6 +18,18 @@ type BtcHeaderByHeight struct {
 	Height uint32 `json:"height"`
 }
 
+type BtcTxInclusion struct {
+	// Tx is the hex encoded raw BTC transaction
+	Tx string `json:"tx"`
+	// BlockHash is the hex encoded hash of the block including the tx
+	BlockHash string `json:"block_hash"`
+	// TxIndex is the index of the tx in the block
+	TxIndex uint32 `json:"tx_index"`
+	// MerkleProof is the hex encoded list of concatenated intermediate merkle
+	// tree nodes
+	MerkleProof string `json:"merkle_proof"`
+}
+
 type CurrentEpochResponse struct {
 	Epoch uint64 `json:"epoch"`
 }
@@ -55,3 +68,9 @@ type BtcBaseHeaderResponse struct {
 type BtcHeaderQueryResponse struct {
 	HeaderInfo *BtcBlockHeaderInfo `json:"header_info,omitempty"`
 }
+
+type BtcTxInclusionResponse struct {
+	Included    bool   `json:"included"`
+	BlockHeight uint32 `json:"block_height"`
+	Depth       uint32 `json:"depth"`
+}
@@ -1,6 +1,7 @@
 package wasmbinding
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"math/rand"
@@ -22,6 +23,8 @@ import (
 
 	"github.com/babylonlabs-io/babylon/app"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/wasmbinding"
 	"github.com/babylonlabs-io/babylon/wasmbinding/bindings"
 )
 
@@ -199,6 +202,50 @@ func TestQueryNonExistingHeader(t *testing.T) {
 	require.Nil(t, resp1.HeaderInfo)
 }
 
+func TestQueryBtcTxInclusion(t *testing.T) {
+	babylonApp, ctx := setupAppWithContext(t)
+
+	// insert a block including a random tx on top of the tip, and confirm it
+	// with another header
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	lcKeeper := babylonApp.BTCLightClientKeeper
+	tip := lcKeeper.GetTipInfo(ctx)
+	blockWithProof := datagen.CreateBlockWithTransaction(r, tip.Header.ToBlockHeader(), datagen.GenRandomTx(r))
+	confirmingHeader := datagen.GenRandomValidChainStartingFrom(r, blockWithProof.HeaderBytes.ToBlockHeader(), nil, 1)
+	headers := append([]bbn.BTCHeaderBytes{blockWithProof.HeaderBytes}, datagen.HeaderToHeaderBytes(confirmingHeader)...)
+	err := lcKeeper.InsertHeadersWithHookAndEvents(ctx, headers)
+	require.NoError(t, err)
+
+	proof := blockWithProof.SpvProof
+	query := bindings.BabylonQuery{
+		BtcTxInclusion: &bindings.BtcTxInclusion{
+			Tx:          hex.EncodeToString(proof.BtcTransaction),
+			BlockHash:   blockWithProof.HeaderBytes.Hash().MarshalHex(),
+			TxIndex:     proof.BtcTransactionIndex,
+			MerkleProof: hex.EncodeToString(proof.MerkleNodes),
+		},
+	}
+	queryBz, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	// the test contract does not know this query variant, so the querier is
+	// called directly
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(
+		&babylonApp.EpochingKeeper,
+		&babylonApp.CheckpointingKeeper,
+		&babylonApp.BTCLightClientKeeper,
+	))
+	resBz, err := querier(ctx, queryBz)
+	require.NoError(t, err)
+
+	resp := bindings.BtcTxInclusionResponse{}
+	err = json.Unmarshal(resBz, &resp)
+	require.NoError(t, err)
+	require.True(t, resp.Included)
+	require.Equal(t, tip.Height+1, resp.BlockHeight)
+	require.Equal(t, uint32(1), resp.Depth)
+}
+
 func setupAppWithContext(t *testing.T) (*app.BabylonApp, sdk.Context) {
 	return setupAppWithContextAndCustomHeight(t, 1)
 }
@@ -1,6 +1,7 @@
 package wasmbinding
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 
@@ -12,6 +13,7 @@ import (
 	lcKeeper "github.com/babylonlabs-io/babylon/x/btclightclient/keeper"
 	checkpointingkeeper "github.com/babylonlabs-io/babylon/x/checkpointing/keeper"
 	epochingkeeper "github.com/babylonlabs-io/babylon/x/epoching/keeper"
+	"github.com/btcsuite/btcd/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -149,6 +151,44 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 				return nil, errorsmod.Wrap(err, "failed marshaling")
 			}
 
+			return bz, nil
+		case contractQuery.BtcTxInclusion != nil:
+			txBytes, err := hex.DecodeString(contractQuery.BtcTxInclusion.Tx)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to decode tx")
+			}
+
+			tx, err := btcutil.NewTxFromBytes(txBytes)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to parse tx")
+			}
+
+			headerHash, err := bbn.NewBTCHeaderHashBytesFromHex(contractQuery.BtcTxInclusion.BlockHash)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to parse header hash")
+			}
+
+			proof, err := hex.DecodeString(contractQuery.BtcTxInclusion.MerkleProof)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to decode merkle proof")
+			}
+
+			info, err := qp.lcKeeper.GetTxInclusionInfo(ctx, tx, &headerHash, proof, contractQuery.BtcTxInclusion.TxIndex)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed to verify tx inclusion")
+			}
+
+			res := bindings.BtcTxInclusionResponse{
+				Included:    info.Included,
+				BlockHeight: info.BlockHeight,
+				Depth:       info.Depth,
+			}
+			bz, err := json.Marshal(res)
+
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "failed marshaling")
+			}
+
 			return bz, nil
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown babylon query variant"}
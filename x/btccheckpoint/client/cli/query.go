@@ -28,6 +28,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdBtcCheckpointHeightAndHash())
 	cmd.AddCommand(CmdEpochSubmissions())
+	cmd.AddCommand(CmdSubmissionsByAddress())
 	return cmd
 }
 
@@ -91,3 +92,33 @@ func CmdEpochSubmissions() *cobra.Command {
 
 	return cmd
 }
+
+func CmdSubmissionsByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-submissions <address>",
+		Short: "all checkpoint submissions made or reported by given address, with their statistics",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := types.QuerySubmissionsByAddressRequest{Address: args[0], Pagination: pageReq}
+			res, err := queryClient.SubmissionsByAddress(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "address-submissions")
+
+	return cmd
+}
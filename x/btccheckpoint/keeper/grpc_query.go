@@ -28,6 +28,7 @@ func (k Keeper) getCheckpointInfo(ctx context.Context, epochNum uint64, epochDat
 	}
 
 	bestSubmissionData := k.GetSubmissionData(ctx, bestSubmission.SubmissionKey)
+	confirmationDepth, finalizationTimeout := k.getEpochParams(ctx, epochData)
 
 	return &types.BTCCheckpointInfo{
 		EpochNumber:                        epochNum,
@@ -35,6 +36,8 @@ func (k Keeper) getCheckpointInfo(ctx context.Context, epochNum uint64, epochDat
 		BestSubmissionBtcBlockHash:         &bestSubmission.YoungestBlockHash,
 		BestSubmissionTransactions:         bestSubmissionData.TxsInfo,
 		BestSubmissionVigilanteAddressList: []*types.CheckpointAddresses{bestSubmissionData.VigilanteAddresses},
+		BtcConfirmationDepth:               confirmationDepth,
+		CheckpointFinalizationTimeout:      finalizationTimeout,
 	}, nil
 }
 
@@ -121,3 +124,52 @@ func (k Keeper) EpochSubmissions(c context.Context, req *types.QueryEpochSubmiss
 		Keys: submKeysResp,
 	}, nil
 }
+
+func (k Keeper) SubmissionsByAddress(c context.Context, req *types.QuerySubmissionsByAddressRequest) (*types.QuerySubmissionsByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	indexStore := k.addressSubmissionIndexStore(ctx, addr)
+
+	records := []*types.SubmissionRecordResponse{}
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key, _ []byte) error {
+		record, err := k.getSubmissionRecordFromIndexKey(ctx, key)
+		if err != nil {
+			return err
+		}
+		recordResp, err := record.ToResponse()
+		if err != nil {
+			return err
+		}
+		records = append(records, recordResp)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySubmissionsByAddressResponse{
+		Submissions: records,
+		Stats:       k.GetSubmissionStats(ctx, addr),
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) getSubmissionRecordFromIndexKey(ctx context.Context, skBytes []byte) (*types.SubmissionRecord, error) {
+	var sk types.SubmissionKey
+	if err := k.cdc.Unmarshal(skBytes, &sk); err != nil {
+		return nil, fmt.Errorf("failed to decode submission key %+v: %w", skBytes, err)
+	}
+	record := k.GetSubmissionRecord(ctx, sk)
+	if record == nil {
+		return nil, fmt.Errorf("record of indexed submission %+v is not found", sk)
+	}
+	return record, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 
 	dg "github.com/babylonlabs-io/babylon/testutil/datagen"
@@ -69,3 +70,81 @@ func TestBtcCheckpointInfo(t *testing.T) {
 	require.Equal(t, btcInfo.BestSubmissionVigilanteAddressList[0].Reporter, rawSubmission.Reporter.String())
 	require.Equal(t, btcInfo.BestSubmissionVigilanteAddressList[0].Submitter, sdk.AccAddress(btcRaw.SubmitterAddress).String())
 }
+
+func TestSubmissionsByAddress(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+	wDeep := types.DefaultParams().CheckpointFinalizationTimeout
+
+	// three submissions of epoch 1 reported by the same reporter
+	reporter := dg.GenRandomAccount().GetAddress()
+	msgs := make([]*types.MsgInsertBTCSpvProof, 3)
+	for i := range msgs {
+		msgs[i] = dg.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+		msgs[i].Submitter = reporter.String()
+		tk.BTCLightClient.SetDepth(b1Hash(msgs[i]), uint32(1))
+		tk.BTCLightClient.SetDepth(b2Hash(msgs[i]), uint32(0))
+		_, err := tk.insertProofMsg(msgs[i])
+		require.NoError(t, err)
+	}
+
+	req := &types.QuerySubmissionsByAddressRequest{Address: reporter.String()}
+	resp, err := tk.BTCCheckpoint.SubmissionsByAddress(tk.Ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Submissions, 3)
+	require.Equal(t, &types.SubmissionStats{Total: 3, Pending: 3}, resp.Stats)
+	for _, record := range resp.Submissions {
+		require.Equal(t, types.SubmissionPending, record.Status)
+		require.Equal(t, uint64(1), record.EpochNum)
+		require.Equal(t, reporter.String(), record.VigilanteAddresses.Reporter)
+	}
+
+	// the first submission is reorged out of btc main chain, and the epoch is
+	// finalized with the third submission being the deepest one
+	tk.BTCLightClient.DeleteHeader(b1Hash(msgs[0]))
+	tk.BTCLightClient.SetDepth(b1Hash(msgs[1]), wDeep)
+	tk.BTCLightClient.SetDepth(b2Hash(msgs[1]), wDeep+1)
+	tk.BTCLightClient.SetDepth(b1Hash(msgs[2]), wDeep+2)
+	tk.BTCLightClient.SetDepth(b2Hash(msgs[2]), wDeep+3)
+	tk.onTipChange()
+
+	resp, err = tk.BTCCheckpoint.SubmissionsByAddress(tk.Ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Submissions, 3)
+	require.Equal(t, &types.SubmissionStats{Total: 3, Best: 1, NotBest: 1, Forgotten: 1}, resp.Stats)
+
+	expectedStatuses := map[string]types.SubmissionStatus{
+		b1Hash(msgs[0]).MarshalHex(): types.SubmissionForgotten,
+		b1Hash(msgs[1]).MarshalHex(): types.SubmissionNotBest,
+		b1Hash(msgs[2]).MarshalHex(): types.SubmissionBest,
+	}
+	var bestRecord *types.SubmissionRecordResponse
+	for _, record := range resp.Submissions {
+		expectedStatus, ok := expectedStatuses[record.SubmissionKey.FirstTxBlockHash]
+		require.True(t, ok)
+		require.Equal(t, expectedStatus, record.Status)
+		if record.Status == types.SubmissionBest {
+			bestRecord = record
+		}
+	}
+	require.NotNil(t, bestRecord)
+
+	// the submitter of the best submission can find its submission
+	submitterResp, err := tk.BTCCheckpoint.SubmissionsByAddress(tk.Ctx, &types.QuerySubmissionsByAddressRequest{
+		Address: bestRecord.VigilanteAddresses.Submitter,
+	})
+	require.NoError(t, err)
+	require.Len(t, submitterResp.Submissions, 1)
+	require.Equal(t, bestRecord, submitterResp.Submissions[0])
+	require.Equal(t, &types.SubmissionStats{Total: 1, Best: 1}, submitterResp.Stats)
+	submitterAddr, err := sdk.AccAddressFromBech32(bestRecord.VigilanteAddresses.Submitter)
+	require.NoError(t, err)
+	require.Equal(t, submitterResp.Stats, tk.BTCCheckpoint.GetSubmissionStats(tk.Ctx, submitterAddr))
+
+	// pagination only limits the returned submissions but not the statistics
+	req.Pagination = &query.PageRequest{Limit: 2}
+	resp, err = tk.BTCCheckpoint.SubmissionsByAddress(tk.Ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.Submissions, 2)
+	require.Equal(t, uint64(3), resp.Stats.Total)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"fmt"
@@ -209,6 +210,43 @@ func (k Keeper) setLastFinalizedEpochNumber(ctx context.Context, epoch uint64) {
 	}
 }
 
+// LowestReferencedHeaderHeight returns the height of the lowest BTC header
+// referenced by the best submission of the last finalized epoch or by any
+// submission of the epochs which are not finalized yet. It returns false if no
+// such header is known to the BTC light client.
+func (k Keeper) LowestReferencedHeaderHeight(ctx context.Context) (uint32, bool) {
+	var startingEpoch []byte
+	if lastFinalizedEpoch := k.getLastFinalizedEpochNumber(ctx); lastFinalizedEpoch > 0 {
+		startingEpoch = sdk.Uint64ToBigEndian(lastFinalizedEpoch)
+	}
+
+	var lowest uint32
+	found := false
+
+	it := k.epochDataStore(ctx).Iterator(startingEpoch, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var ed types.EpochData
+		k.cdc.MustUnmarshal(it.Value(), &ed)
+		for _, sk := range ed.Keys {
+			for _, tk := range sk.Key {
+				height, err := k.GetBlockHeight(ctx, tk.Hash)
+				if err != nil {
+					// the header is no longer known to the light client, the
+					// submission will be removed upon the next check
+					continue
+				}
+				if !found || height < lowest {
+					lowest = height
+					found = true
+				}
+			}
+		}
+	}
+
+	return lowest, found
+}
+
 func (k Keeper) getEpochChanges(
 	ctx context.Context,
 	parentEpochBestSubmission *types.SubmissionBtcInfo,
@@ -249,6 +287,7 @@ func (k Keeper) getEpochChanges(
 			// we do not have info of best submission in this epoch. Set current submission
 			// as best
 			currentEpochBestSubmission = submissionInfo
+			bestSubmissionIdx = i
 			continue
 		}
 
@@ -318,6 +357,8 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 	defer it.Close()
 
 	var parentEpochInfo *epochInfo
+	// epochs which lost submissions, which can only happen due to a BTC reorg
+	var touchedEpochs []uint64
 
 	for ; it.Valid(); it.Next() {
 		var currentEpoch types.EpochData
@@ -360,6 +401,7 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 
 			k.clearEpochData(ctx, it.Key(), store, &currentEpoch)
 			k.checkpointingKeeper.SetCheckpointForgotten(ctx, epoch)
+			touchedEpochs = append(touchedEpochs, epoch)
 			// set parent epoch with empty best submission, so child epoch will also
 			// get clearead
 			parentEpochInfo = &epochInfo{}
@@ -380,6 +422,7 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 			// epoch lost all submissions clear it and inform checkpointing about it
 			k.clearEpochData(ctx, it.Key(), store, &currentEpoch)
 			k.checkpointingKeeper.SetCheckpointForgotten(ctx, epoch)
+			touchedEpochs = append(touchedEpochs, epoch)
 			// set parent epoch with empty best submission, so child epoch will also
 			// get clearead
 			parentEpochInfo = &epochInfo{}
@@ -387,7 +430,7 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 		}
 
 		// there is at least one submission in the epoch, check its current btc status
-		bestSubmissionStatus := k.checkSubmissionStatus(ctx, epochChanges.EpochBestSubmission)
+		bestSubmissionStatus := k.checkSubmissionStatus(ctx, &currentEpoch, epochChanges.EpochBestSubmission)
 
 		if bestSubmissionStatus > currentEpoch.Status && currentEpoch.Status == types.Submitted {
 			// epoch just got confirmed by best submission
@@ -400,6 +443,17 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 			currentEpoch.Status = types.Finalized
 			k.checkpointingKeeper.SetCheckpointFinalized(ctx, epoch)
 			k.setLastFinalizedEpochNumber(ctx, epoch)
+			// trigger incentive module to distribute rewards to submitters/reporters
+			k.rewardBTCTimestamping(ctx, epoch, epochChanges)
+		}
+
+		if len(epochChanges.SubmissionsToDelete) > 0 {
+			touchedEpochs = append(touchedEpochs, epoch)
+		}
+
+		// submissions no longer valid are forgotten
+		for _, sk := range epochChanges.SubmissionsToDelete {
+			k.setSubmissionStatus(ctx, *sk, types.SubmissionForgotten)
 		}
 
 		if currentEpoch.Status == types.Finalized {
@@ -407,8 +461,11 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 			for i, sk := range currentEpoch.Keys {
 				if i != epochChanges.BestSubmissionIdx {
 					k.deleteSubmission(ctx, *sk)
+					// the rest of submissions are valid but not chosen as the best one
+					k.setSubmissionStatus(ctx, *sk, types.SubmissionNotBest)
 				}
 			}
+			k.setSubmissionStatus(ctx, epochChanges.EpochBestSubmission.SubmissionKey, types.SubmissionBest)
 			// leave only best submission key
 			currentEpoch.Keys = []*types.SubmissionKey{&epochChanges.EpochBestSubmission.SubmissionKey}
 		} else {
@@ -423,6 +480,49 @@ func (k Keeper) checkCheckpoints(ctx context.Context) {
 		// save epoch with all applied changes
 		store.Set(it.Key(), k.cdc.MustMarshal(&currentEpoch))
 	}
+
+	// record in the light client reorg log which epochs were touched by the reorg
+	k.btcLightClientKeeper.AnnotateLatestReorg(ctx, types.ModuleName, touchedEpochs)
+}
+
+// rewardBTCTimestamping rewards the submitters/reporters of the best submission
+// and the other valid submissions of the given just finalized epoch
+func (k Keeper) rewardBTCTimestamping(ctx context.Context, epoch uint64, epochChanges *epochChangesSummary) {
+	rewardDistInfo, err := k.getRewardDistInfo(ctx, epochChanges)
+	if err != nil {
+		// submissions kept in the epoch must have valid submission data
+		panic(err)
+	}
+	k.incentiveKeeper.RewardBTCTimestamping(ctx, epoch, rewardDistInfo)
+}
+
+// getRewardDistInfo builds the reward distribution info of an epoch from the
+// address pairs of its best submission and other valid submissions
+func (k Keeper) getRewardDistInfo(ctx context.Context, epochChanges *epochChangesSummary) (*types.RewardDistInfo, error) {
+	var (
+		best   *types.CheckpointAddressPair
+		others []*types.CheckpointAddressPair
+	)
+	bestKeyBytes := k.cdc.MustMarshal(&epochChanges.EpochBestSubmission.SubmissionKey)
+	for _, sk := range epochChanges.SubmissionsToKeep {
+		sd := k.GetSubmissionData(ctx, *sk)
+		if sd == nil {
+			return nil, fmt.Errorf("submission data of a valid submission is not found")
+		}
+		addrPair, err := types.NewCheckpointAddressPair(sd.VigilanteAddresses)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(k.cdc.MustMarshal(sk), bestKeyBytes) {
+			best = addrPair
+		} else {
+			others = append(others, addrPair)
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("best submission is not among valid submissions")
+	}
+	return types.NewRewardDistInfo(best, others...), nil
 }
 
 func (k *Keeper) epochDataStore(ctx context.Context) prefix.Store {
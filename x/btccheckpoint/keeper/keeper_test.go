@@ -124,3 +124,55 @@ func FuzzGetSubmissionBtcInfo(f *testing.F) {
 		require.Equal(t, info.OldestBlockDepth, expectedOldestDepth)
 	})
 }
+
+func TestLowestReferencedHeaderHeight(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+	wDeep := types.DefaultParams().CheckpointFinalizationTimeout
+
+	_, found := tk.BTCCheckpoint.LowestReferencedHeaderHeight(tk.SdkCtx)
+	require.False(t, found)
+
+	msg1 := datagen.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+	tk.BTCLightClient.SetDepth(b1Hash(msg1), 2)
+	tk.BTCLightClient.SetDepth(b2Hash(msg1), 1)
+	tk.BTCLightClient.SetHeight(b1Hash(msg1), 100)
+	tk.BTCLightClient.SetHeight(b2Hash(msg1), 101)
+	_, err := tk.insertProofMsg(msg1)
+	require.NoError(t, err)
+
+	msg2 := datagen.GenerateMessageWithRandomSubmitterForEpoch(r, 2)
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), 0)
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), 0)
+	tk.BTCLightClient.SetHeight(b1Hash(msg2), 103)
+	tk.BTCLightClient.SetHeight(b2Hash(msg2), 103)
+	_, err = tk.insertProofMsg(msg2)
+	require.NoError(t, err)
+
+	// all submissions are referenced while no epoch is finalized
+	height, found := tk.BTCCheckpoint.LowestReferencedHeaderHeight(tk.SdkCtx)
+	require.True(t, found)
+	require.Equal(t, uint32(100), height)
+
+	// the best submission of the last finalized epoch is still referenced
+	tk.BTCLightClient.SetDepth(b1Hash(msg1), wDeep+1)
+	tk.BTCLightClient.SetDepth(b2Hash(msg1), wDeep)
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), 1)
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), 1)
+	tk.onTipChange()
+	require.Equal(t, types.Finalized, tk.GetEpochData(1).Status)
+	height, found = tk.BTCCheckpoint.LowestReferencedHeaderHeight(tk.SdkCtx)
+	require.True(t, found)
+	require.Equal(t, uint32(100), height)
+
+	// submissions of epochs before the last finalized one are not referenced
+	tk.BTCLightClient.SetDepth(b1Hash(msg1), wDeep+3)
+	tk.BTCLightClient.SetDepth(b2Hash(msg1), wDeep+2)
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), wDeep)
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), wDeep)
+	tk.onTipChange()
+	require.Equal(t, types.Finalized, tk.GetEpochData(2).Status)
+	height, found = tk.BTCCheckpoint.LowestReferencedHeaderHeight(tk.SdkCtx)
+	require.True(t, found)
+	require.Equal(t, uint32(103), height)
+}
@@ -459,6 +459,52 @@ func TestLeaveOnlyBestSubmissionWhenEpochFinalized(t *testing.T) {
 	require.Equal(t, finalSubKey.Key[1].Hash, b2Hash(msg3))
 }
 
+func TestKeepBestSubmissionDataWhenFirstSubmissionForgotten(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	tk := InitTestKeepers(t)
+	defaultParams := btcctypes.DefaultParams()
+	wDeep := defaultParams.CheckpointFinalizationTimeout
+
+	msg1 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+	tk.BTCLightClient.SetDepth(b1Hash(msg1), uint32(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg1), uint32(0))
+	_, err := tk.insertProofMsg(msg1)
+	require.NoError(t, err, "failed to insert submission")
+
+	msg2 := dg.GenerateMessageWithRandomSubmitterForEpoch(r, 1)
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), uint32(1))
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), uint32(0))
+	_, err = tk.insertProofMsg(msg2)
+	require.NoError(t, err, "failed to insert submission")
+
+	ed := tk.GetEpochData(uint64(1))
+	require.NotNil(t, ed)
+	require.Len(t, ed.Keys, 2)
+	forgottenKey, bestKey := *ed.Keys[0], *ed.Keys[1]
+
+	// the first submission of the epoch is no longer on the main chain, while
+	// the second one gets finalized
+	tk.BTCLightClient.DeleteHeader(b1Hash(msg1))
+	tk.BTCLightClient.SetDepth(b1Hash(msg2), wDeep)
+	tk.BTCLightClient.SetDepth(b2Hash(msg2), wDeep+1)
+
+	tk.onTipChange()
+
+	ed = tk.GetEpochData(uint64(1))
+	require.NotNil(t, ed)
+	require.Equal(t, btcctypes.Finalized, ed.Status)
+	require.Len(t, ed.Keys, 1)
+	require.Equal(t, bestKey, *ed.Keys[0])
+
+	// only the data of the forgotten submission is deleted
+	require.Nil(t, tk.getSubmissionData(forgottenKey))
+	require.NotNil(t, tk.getSubmissionData(bestKey))
+
+	// the finalized epoch is checked again upon the next tip change, which
+	// requires the data of its best submission
+	require.NotPanics(t, tk.onTipChange)
+}
+
 func TestTxIdxShouldBreakTies(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().Unix()))
 	tk := InitTestKeepers(t)
@@ -574,6 +620,73 @@ func TestStateTransitionOfValidSubmission(t *testing.T) {
 	}
 }
 
+func TestStateTransitionUsesParamsSnapshot(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	epoch := uint64(1)
+	defaultParams := btcctypes.DefaultParams()
+	kDeep := defaultParams.BtcConfirmationDepth
+	raw, _ := dg.RandomRawCheckpointDataForEpoch(r, epoch)
+
+	blck1 := dg.CreateBlock(r, 1, 7, 7, raw.FirstPart)
+	blck2 := dg.CreateBlock(r, 2, 14, 3, raw.SecondPart)
+
+	tk := InitTestKeepers(t)
+
+	msg := dg.GenerateMessageWithRandomSubmitter([]*dg.BlockCreationResult{blck1, blck2})
+	tk.BTCLightClient.SetDepth(blck1.HeaderBytes.Hash(), uint32(1))
+	tk.BTCLightClient.SetDepth(blck2.HeaderBytes.Hash(), uint32(1))
+	_, err := tk.insertProofMsg(msg)
+	require.NoError(t, err)
+
+	// the params in effect upon the first submission are recorded
+	ed := tk.GetEpochData(epoch)
+	require.Equal(t, kDeep, ed.BtcConfirmationDepth)
+	require.Equal(t, defaultParams.CheckpointFinalizationTimeout, ed.CheckpointFinalizationTimeout)
+
+	// increase the confirmation depth after the epoch is submitted
+	newParams := defaultParams
+	newParams.BtcConfirmationDepth = kDeep + 5
+	err = tk.BTCCheckpoint.SetParams(tk.SdkCtx, newParams)
+	require.NoError(t, err)
+
+	// the epoch is confirmed according to the recorded confirmation depth
+	tk.BTCLightClient.SetDepth(blck1.HeaderBytes.Hash(), kDeep)
+	tk.BTCLightClient.SetDepth(blck2.HeaderBytes.Hash(), kDeep)
+	tk.onTipChange()
+
+	ed = tk.GetEpochData(epoch)
+	require.Equal(t, btcctypes.Confirmed, ed.Status)
+	require.Equal(t, kDeep, ed.BtcConfirmationDepth)
+
+	// the recorded params are exposed in the checkpoint info
+	resp, err := tk.BTCCheckpoint.BtcCheckpointInfo(tk.SdkCtx, &btcctypes.QueryBtcCheckpointInfoRequest{EpochNum: epoch})
+	require.NoError(t, err)
+	require.Equal(t, kDeep, resp.Info.BtcConfirmationDepth)
+	require.Equal(t, defaultParams.CheckpointFinalizationTimeout, resp.Info.CheckpointFinalizationTimeout)
+
+	// the recorded params are cleared once the epoch loses all its submissions
+	tk.BTCLightClient.DeleteHeader(blck1.HeaderBytes.Hash())
+	tk.onTipChange()
+
+	ed = tk.GetEpochData(epoch)
+	require.Empty(t, ed.Keys)
+	require.False(t, ed.HasParamsSnapshot())
+
+	// the params in effect upon the next first submission are recorded
+	raw2, _ := dg.RandomRawCheckpointDataForEpoch(r, epoch)
+	blck3 := dg.CreateBlock(r, 3, 7, 7, raw2.FirstPart)
+	blck4 := dg.CreateBlock(r, 4, 14, 3, raw2.SecondPart)
+	msg2 := dg.GenerateMessageWithRandomSubmitter([]*dg.BlockCreationResult{blck3, blck4})
+	tk.BTCLightClient.SetDepth(blck3.HeaderBytes.Hash(), uint32(1))
+	tk.BTCLightClient.SetDepth(blck4.HeaderBytes.Hash(), uint32(1))
+	_, err = tk.insertProofMsg(msg2)
+	require.NoError(t, err)
+
+	ed = tk.GetEpochData(epoch)
+	require.Len(t, ed.Keys, 1)
+	require.Equal(t, newParams.BtcConfirmationDepth, ed.BtcConfirmationDepth)
+}
+
 func FuzzConfirmAndDinalizeManyEpochs(f *testing.F) {
 	dg.AddRandomSeedsToFuzzer(f, 20)
 
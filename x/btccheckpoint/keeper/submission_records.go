@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+)
+
+// indexSubmission saves a pending record of the given submission, indexes the
+// submission under its submitter and reporter addresses, and accounts it into
+// the statistics of these addresses
+func (k Keeper) indexSubmission(ctx context.Context, sk types.SubmissionKey, sd *types.SubmissionData) {
+	record := types.NewSubmissionRecord(sk, sd)
+	k.setSubmissionRecord(ctx, record)
+
+	skBytes := k.cdc.MustMarshal(&sk)
+	for _, addr := range recordAddresses(record) {
+		k.addressSubmissionIndexStore(ctx, addr).Set(skBytes, []byte{})
+
+		stats := k.GetSubmissionStats(ctx, addr)
+		stats.AddRecord(record)
+		k.setSubmissionStats(ctx, addr, stats)
+	}
+}
+
+// setSubmissionStatus sets the outcome of a pending submission, and updates
+// the statistics of its submitter and reporter addresses accordingly.
+// Submissions without records or whose outcome is already decided are left
+// untouched
+func (k Keeper) setSubmissionStatus(ctx context.Context, sk types.SubmissionKey, status types.SubmissionStatus) {
+	record := k.GetSubmissionRecord(ctx, sk)
+	if record == nil || record.IsFinal() {
+		return
+	}
+	prevStatus := record.Status
+	record.Status = status
+	k.setSubmissionRecord(ctx, record)
+
+	for _, addr := range recordAddresses(record) {
+		stats := k.GetSubmissionStats(ctx, addr)
+		stats.UpdateStatus(prevStatus, status)
+		k.setSubmissionStats(ctx, addr, stats)
+	}
+}
+
+// recordAddresses returns the distinct addresses that made or reported the
+// submission of the given record
+func recordAddresses(record *types.SubmissionRecord) []sdk.AccAddress {
+	submitter := sdk.AccAddress(record.VigilanteAddresses.Submitter)
+	reporter := sdk.AccAddress(record.VigilanteAddresses.Reporter)
+	if submitter.Equals(reporter) {
+		return []sdk.AccAddress{submitter}
+	}
+	return []sdk.AccAddress{submitter, reporter}
+}
+
+// GetSubmissionStats returns the statistics of the submissions made or
+// reported by the given address
+func (k Keeper) GetSubmissionStats(ctx context.Context, addr sdk.AccAddress) *types.SubmissionStats {
+	store := k.submissionStatsStore(ctx)
+	statsBytes := store.Get(address.MustLengthPrefix(addr))
+	if statsBytes == nil {
+		return &types.SubmissionStats{}
+	}
+
+	var stats types.SubmissionStats
+	k.cdc.MustUnmarshal(statsBytes, &stats)
+	return &stats
+}
+
+func (k Keeper) setSubmissionStats(ctx context.Context, addr sdk.AccAddress, stats *types.SubmissionStats) {
+	store := k.submissionStatsStore(ctx)
+	store.Set(address.MustLengthPrefix(addr), k.cdc.MustMarshal(stats))
+}
+
+// GetSubmissionRecord returns the record of the given submission, or nil if
+// there is no record
+func (k Keeper) GetSubmissionRecord(ctx context.Context, sk types.SubmissionKey) *types.SubmissionRecord {
+	store := k.submissionRecordStore(ctx)
+	recordBytes := store.Get(k.cdc.MustMarshal(&sk))
+	if recordBytes == nil {
+		return nil
+	}
+
+	var record types.SubmissionRecord
+	k.cdc.MustUnmarshal(recordBytes, &record)
+	return &record
+}
+
+func (k Keeper) setSubmissionRecord(ctx context.Context, record *types.SubmissionRecord) {
+	store := k.submissionRecordStore(ctx)
+	store.Set(k.cdc.MustMarshal(record.SubmissionKey), k.cdc.MustMarshal(record))
+}
+
+// submissionRecordStore returns the KVStore of the submission records
+// prefix: SubmissionRecordPrefix
+// key: submission key
+// value: submission record
+func (k Keeper) submissionRecordStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.SubmissionRecordPrefix)
+}
+
+// addressSubmissionIndexStore returns the KVStore of the keys of submissions
+// made or reported by the given address
+// prefix: AddressSubmissionIndexPrefix || length-prefixed address
+// key: submission key
+// value: empty
+func (k Keeper) addressSubmissionIndexStore(ctx context.Context, addr sdk.AccAddress) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	indexStore := prefix.NewStore(storeAdapter, types.AddressSubmissionIndexPrefix)
+	return prefix.NewStore(indexStore, address.MustLengthPrefix(addr))
+}
+
+// submissionStatsStore returns the KVStore of the statistics of submissions
+// made or reported by each address
+// prefix: AddressSubmissionStatsPrefix
+// key: length-prefixed address
+// value: submission statistics
+func (k Keeper) submissionStatsStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.AddressSubmissionStatsPrefix)
+}
@@ -77,9 +77,16 @@ func (k Keeper) addEpochSubmission(
 		k.checkpointingKeeper.SetCheckpointSubmitted(ctx, epochNum)
 	}
 
+	if !ed.HasParamsSnapshot() {
+		// it is the first accepted submission of the epoch, record the params in
+		// effect so that later params changes do not affect the epoch
+		ed.SetParamsSnapshot(k.GetParams(ctx))
+	}
+
 	ed.AppendKey(sk)
 	k.saveEpochData(ctx, epochNum, ed)
 	k.saveSubmission(ctx, sk, sd)
+	k.indexSubmission(ctx, sk, &sd)
 	return nil
 }
 
@@ -119,12 +126,25 @@ func (k Keeper) GetSubmissionData(ctx context.Context, sk types.SubmissionKey) *
 	return &sd
 }
 
-func (k Keeper) checkSubmissionStatus(ctx context.Context, info *types.SubmissionBtcInfo) types.BtcStatus {
+// getEpochParams returns the btc confirmation depth and checkpoint finalization
+// timeout used for the status transitions of the given epoch, i.e., the ones
+// in effect when the first submission of the epoch was accepted. For epochs
+// without the recorded params, the current params are returned
+func (k Keeper) getEpochParams(ctx context.Context, ed *types.EpochData) (uint32, uint32) {
+	if ed.HasParamsSnapshot() {
+		return ed.BtcConfirmationDepth, ed.CheckpointFinalizationTimeout
+	}
+	params := k.GetParams(ctx)
+	return params.BtcConfirmationDepth, params.CheckpointFinalizationTimeout
+}
+
+func (k Keeper) checkSubmissionStatus(ctx context.Context, ed *types.EpochData, info *types.SubmissionBtcInfo) types.BtcStatus {
+	confirmationDepth, finalizationTimeout := k.getEpochParams(ctx, ed)
 	subDepth := info.SubmissionDepth()
 	switch {
-	case subDepth >= k.GetParams(ctx).CheckpointFinalizationTimeout:
+	case subDepth >= finalizationTimeout:
 		return types.Finalized
-	case subDepth >= k.GetParams(ctx).BtcConfirmationDepth:
+	case subDepth >= confirmationDepth:
 		return types.Confirmed
 	default:
 		return types.Submitted
@@ -253,7 +273,9 @@ func (k Keeper) clearEpochData(
 	currentEpoch *types.EpochData) {
 	for _, sk := range currentEpoch.Keys {
 		k.deleteSubmission(ctx, *sk)
+		k.setSubmissionStatus(ctx, *sk, types.SubmissionForgotten)
 	}
 	currentEpoch.Keys = []*types.SubmissionKey{}
+	currentEpoch.ClearParamsSnapshot()
 	epochDataStore.Set(epoch, k.cdc.MustMarshal(currentEpoch))
 }
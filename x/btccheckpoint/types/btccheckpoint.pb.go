@@ -59,6 +59,46 @@ func (BtcStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e096cac78d49b0a6, []int{0}
 }
 
+// SubmissionStatus is an enum describing the outcome of a checkpoint submission
+type SubmissionStatus int32
+
+const (
+	// PENDING the epoch of the submission is not finalized yet, and the
+	// submission is still on btc main chain
+	SubmissionPending SubmissionStatus = 0
+	// BEST the submission was chosen as the best submission of its epoch when
+	// the epoch got finalized
+	SubmissionBest SubmissionStatus = 1
+	// NOT_BEST the submission was still on btc main chain when its epoch got
+	// finalized, but another submission was chosen as the best one
+	SubmissionNotBest SubmissionStatus = 2
+	// FORGOTTEN the submission was forgotten as it is no longer on btc main
+	// chain or its parent epoch lost its submissions, due to btc reorgs
+	SubmissionForgotten SubmissionStatus = 3
+)
+
+var SubmissionStatus_name = map[int32]string{
+	0: "SUBMISSION_STATUS_PENDING",
+	1: "SUBMISSION_STATUS_BEST",
+	2: "SUBMISSION_STATUS_NOT_BEST",
+	3: "SUBMISSION_STATUS_FORGOTTEN",
+}
+
+var SubmissionStatus_value = map[string]int32{
+	"SUBMISSION_STATUS_PENDING":   0,
+	"SUBMISSION_STATUS_BEST":      1,
+	"SUBMISSION_STATUS_NOT_BEST":  2,
+	"SUBMISSION_STATUS_FORGOTTEN": 3,
+}
+
+func (x SubmissionStatus) String() string {
+	return proto.EnumName(SubmissionStatus_name, int32(x))
+}
+
+func (SubmissionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e096cac78d49b0a6, []int{1}
+}
+
 // Consider we have a Merkle tree with following structure:
 //
 //	          ROOT
@@ -399,6 +439,14 @@ type EpochData struct {
 	Keys []*SubmissionKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	// status is the current btc status of the epoch
 	Status BtcStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btccheckpoint.v1.BtcStatus" json:"status,omitempty"`
+	// btc_confirmation_depth is the btc confirmation depth in effect when the
+	// first submission of the epoch was accepted. It is used for the status
+	// transitions of the epoch
+	BtcConfirmationDepth uint32 `protobuf:"varint,3,opt,name=btc_confirmation_depth,json=btcConfirmationDepth,proto3" json:"btc_confirmation_depth,omitempty"`
+	// checkpoint_finalization_timeout is the checkpoint finalization timeout in
+	// effect when the first submission of the epoch was accepted. It is used for
+	// the status transitions of the epoch
+	CheckpointFinalizationTimeout uint32 `protobuf:"varint,4,opt,name=checkpoint_finalization_timeout,json=checkpointFinalizationTimeout,proto3" json:"checkpoint_finalization_timeout,omitempty"`
 }
 
 func (m *EpochData) Reset()         { *m = EpochData{} }
@@ -448,6 +496,20 @@ func (m *EpochData) GetStatus() BtcStatus {
 	return Submitted
 }
 
+func (m *EpochData) GetBtcConfirmationDepth() uint32 {
+	if m != nil {
+		return m.BtcConfirmationDepth
+	}
+	return 0
+}
+
+func (m *EpochData) GetCheckpointFinalizationTimeout() uint32 {
+	if m != nil {
+		return m.CheckpointFinalizationTimeout
+	}
+	return 0
+}
+
 // CheckpointAddresses contains the addresses of the submitter and reporter of a
 // given checkpoint
 type CheckpointAddresses struct {
@@ -521,6 +583,11 @@ type BTCCheckpointInfo struct {
 	BestSubmissionTransactions []*TransactionInfo `protobuf:"bytes,4,rep,name=best_submission_transactions,json=bestSubmissionTransactions,proto3" json:"best_submission_transactions,omitempty"`
 	// list of vigilantes' addresses of the best submission
 	BestSubmissionVigilanteAddressList []*CheckpointAddresses `protobuf:"bytes,5,rep,name=best_submission_vigilante_address_list,json=bestSubmissionVigilanteAddressList,proto3" json:"best_submission_vigilante_address_list,omitempty"`
+	// btc confirmation depth used for the status transitions of the epoch
+	BtcConfirmationDepth uint32 `protobuf:"varint,6,opt,name=btc_confirmation_depth,json=btcConfirmationDepth,proto3" json:"btc_confirmation_depth,omitempty"`
+	// checkpoint finalization timeout used for the status transitions of the
+	// epoch
+	CheckpointFinalizationTimeout uint32 `protobuf:"varint,7,opt,name=checkpoint_finalization_timeout,json=checkpointFinalizationTimeout,proto3" json:"checkpoint_finalization_timeout,omitempty"`
 }
 
 func (m *BTCCheckpointInfo) Reset()         { *m = BTCCheckpointInfo{} }
@@ -584,8 +651,206 @@ func (m *BTCCheckpointInfo) GetBestSubmissionVigilanteAddressList() []*Checkpoin
 	return nil
 }
 
+func (m *BTCCheckpointInfo) GetBtcConfirmationDepth() uint32 {
+	if m != nil {
+		return m.BtcConfirmationDepth
+	}
+	return 0
+}
+
+func (m *BTCCheckpointInfo) GetCheckpointFinalizationTimeout() uint32 {
+	if m != nil {
+		return m.CheckpointFinalizationTimeout
+	}
+	return 0
+}
+
+// SubmissionRecord is the record of a checkpoint submission, kept after the
+// submission itself is deleted so that vigilantes can track how their
+// submissions fared. Records are never pruned
+type SubmissionRecord struct {
+	// submission_key is the key of the submission
+	SubmissionKey *SubmissionKey `protobuf:"bytes,1,opt,name=submission_key,json=submissionKey,proto3" json:"submission_key,omitempty"`
+	// epoch is the epoch number of the submitted checkpoint
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// vigilante_addresses are the addresses of the submitter and reporter
+	VigilanteAddresses *CheckpointAddresses `protobuf:"bytes,3,opt,name=vigilante_addresses,json=vigilanteAddresses,proto3" json:"vigilante_addresses,omitempty"`
+	// status is the outcome of the submission
+	Status SubmissionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=babylon.btccheckpoint.v1.SubmissionStatus" json:"status,omitempty"`
+	// btc_fee_sat is the BTC fee implied by the transactions of the submission,
+	// i.e., the sum of fees of the transactions whose inputs all spend outputs of
+	// other transactions in the submission. Fees of other transactions cannot be
+	// derived as values of their inputs are unknown
+	BtcFeeSat uint64 `protobuf:"varint,5,opt,name=btc_fee_sat,json=btcFeeSat,proto3" json:"btc_fee_sat,omitempty"`
+}
+
+func (m *SubmissionRecord) Reset()         { *m = SubmissionRecord{} }
+func (m *SubmissionRecord) String() string { return proto.CompactTextString(m) }
+func (*SubmissionRecord) ProtoMessage()    {}
+func (*SubmissionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e096cac78d49b0a6, []int{8}
+}
+func (m *SubmissionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmissionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionRecord.Merge(m, src)
+}
+func (m *SubmissionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionRecord proto.InternalMessageInfo
+
+func (m *SubmissionRecord) GetSubmissionKey() *SubmissionKey {
+	if m != nil {
+		return m.SubmissionKey
+	}
+	return nil
+}
+
+func (m *SubmissionRecord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *SubmissionRecord) GetVigilanteAddresses() *CheckpointAddresses {
+	if m != nil {
+		return m.VigilanteAddresses
+	}
+	return nil
+}
+
+func (m *SubmissionRecord) GetStatus() SubmissionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SubmissionPending
+}
+
+func (m *SubmissionRecord) GetBtcFeeSat() uint64 {
+	if m != nil {
+		return m.BtcFeeSat
+	}
+	return 0
+}
+
+// SubmissionStats is the statistics of checkpoint submissions made or reported
+// by an address. It is kept up to date as submissions are recorded and their
+// outcomes are decided
+type SubmissionStats struct {
+	// total is the total number of submissions
+	Total uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// pending is the number of submissions whose epochs are not finalized yet
+	Pending uint64 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// best is the number of submissions chosen as the best submission of their
+	// epochs
+	Best uint64 `protobuf:"varint,3,opt,name=best,proto3" json:"best,omitempty"`
+	// not_best is the number of submissions that were not chosen as the best
+	// submission of their finalized epochs
+	NotBest uint64 `protobuf:"varint,4,opt,name=not_best,json=notBest,proto3" json:"not_best,omitempty"`
+	// forgotten is the number of submissions forgotten due to btc reorgs
+	Forgotten uint64 `protobuf:"varint,5,opt,name=forgotten,proto3" json:"forgotten,omitempty"`
+	// total_btc_fee_sat is the sum of BTC fees implied by the transactions of
+	// all submissions
+	TotalBtcFeeSat uint64 `protobuf:"varint,6,opt,name=total_btc_fee_sat,json=totalBtcFeeSat,proto3" json:"total_btc_fee_sat,omitempty"`
+}
+
+func (m *SubmissionStats) Reset()         { *m = SubmissionStats{} }
+func (m *SubmissionStats) String() string { return proto.CompactTextString(m) }
+func (*SubmissionStats) ProtoMessage()    {}
+func (*SubmissionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e096cac78d49b0a6, []int{9}
+}
+func (m *SubmissionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmissionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionStats.Merge(m, src)
+}
+func (m *SubmissionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionStats proto.InternalMessageInfo
+
+func (m *SubmissionStats) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *SubmissionStats) GetPending() uint64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *SubmissionStats) GetBest() uint64 {
+	if m != nil {
+		return m.Best
+	}
+	return 0
+}
+
+func (m *SubmissionStats) GetNotBest() uint64 {
+	if m != nil {
+		return m.NotBest
+	}
+	return 0
+}
+
+func (m *SubmissionStats) GetForgotten() uint64 {
+	if m != nil {
+		return m.Forgotten
+	}
+	return 0
+}
+
+func (m *SubmissionStats) GetTotalBtcFeeSat() uint64 {
+	if m != nil {
+		return m.TotalBtcFeeSat
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("babylon.btccheckpoint.v1.BtcStatus", BtcStatus_name, BtcStatus_value)
+	proto.RegisterEnum("babylon.btccheckpoint.v1.SubmissionStatus", SubmissionStatus_name, SubmissionStatus_value)
 	proto.RegisterType((*BTCSpvProof)(nil), "babylon.btccheckpoint.v1.BTCSpvProof")
 	proto.RegisterType((*TransactionKey)(nil), "babylon.btccheckpoint.v1.TransactionKey")
 	proto.RegisterType((*SubmissionKey)(nil), "babylon.btccheckpoint.v1.SubmissionKey")
@@ -594,6 +859,8 @@ func init() {
 	proto.RegisterType((*EpochData)(nil), "babylon.btccheckpoint.v1.EpochData")
 	proto.RegisterType((*CheckpointAddresses)(nil), "babylon.btccheckpoint.v1.CheckpointAddresses")
 	proto.RegisterType((*BTCCheckpointInfo)(nil), "babylon.btccheckpoint.v1.BTCCheckpointInfo")
+	proto.RegisterType((*SubmissionRecord)(nil), "babylon.btccheckpoint.v1.SubmissionRecord")
+	proto.RegisterType((*SubmissionStats)(nil), "babylon.btccheckpoint.v1.SubmissionStats")
 }
 
 func init() {
@@ -601,58 +868,78 @@ func init() {
 }
 
 var fileDescriptor_e096cac78d49b0a6 = []byte{
-	// 814 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6b, 0xe3, 0x46,
-	0x14, 0xf7, 0xd8, 0xce, 0x76, 0x3d, 0x76, 0xbc, 0xe9, 0x38, 0x5b, 0x84, 0x09, 0x5a, 0xaf, 0x0a,
-	0x5d, 0x6f, 0xe9, 0xda, 0x6c, 0x0a, 0x2d, 0x64, 0xa1, 0x10, 0xf9, 0x03, 0x9b, 0xdd, 0x75, 0xb6,
-	0xb2, 0xd2, 0x43, 0x0e, 0x15, 0x92, 0x3c, 0xb6, 0x06, 0xdb, 0x1a, 0xa3, 0x19, 0x1b, 0x3b, 0xb7,
-	0x16, 0x0a, 0xa5, 0xa7, 0xd2, 0x7b, 0x4f, 0xfd, 0x67, 0x7a, 0xe8, 0x21, 0xc7, 0x92, 0x43, 0x28,
-	0xc9, 0xbf, 0xd0, 0x6b, 0xa1, 0xcc, 0x48, 0xf1, 0x57, 0xe2, 0xb6, 0x86, 0xde, 0xf4, 0xde, 0xfb,
-	0xbd, 0xaf, 0xdf, 0x7b, 0x4f, 0x03, 0x3f, 0x71, 0x6c, 0x67, 0x36, 0xa0, 0x7e, 0xd9, 0xe1, 0xae,
-	0xeb, 0x61, 0xb7, 0x3f, 0xa2, 0xc4, 0xe7, 0xe5, 0xc9, 0xcb, 0x55, 0x45, 0x69, 0x14, 0x50, 0x4e,
-	0x91, 0x12, 0xa1, 0x4b, 0xab, 0xc6, 0xc9, 0xcb, 0xfc, 0x7e, 0x8f, 0xf6, 0xa8, 0x04, 0x95, 0xc5,
-	0x57, 0x88, 0xd7, 0xfe, 0x02, 0x30, 0xad, 0x9b, 0x95, 0xf6, 0x68, 0xf2, 0x2e, 0xa0, 0xb4, 0x8b,
-	0x9e, 0xc1, 0x47, 0x0e, 0x77, 0x2d, 0x1e, 0xd8, 0x3e, 0xb3, 0x5d, 0x4e, 0xa8, 0xaf, 0x80, 0x02,
-	0x28, 0x66, 0x8c, 0xac, 0xc3, 0x5d, 0x73, 0xa1, 0x45, 0x87, 0xf0, 0xf1, 0x1a, 0xd0, 0x22, 0x7e,
-	0x07, 0x4f, 0x95, 0x78, 0x01, 0x14, 0x77, 0x8d, 0xdc, 0x2a, 0xbc, 0x29, 0x4c, 0xe8, 0x29, 0xcc,
-	0x0c, 0x71, 0xd0, 0x1f, 0x60, 0xcb, 0xa7, 0x1d, 0xcc, 0x94, 0x84, 0x8c, 0x9c, 0x0e, 0x75, 0x2d,
-	0xa1, 0x42, 0x3e, 0x7c, 0xec, 0x52, 0xbf, 0x4b, 0x82, 0x21, 0xf1, 0x7b, 0x96, 0xc8, 0xe0, 0x61,
-	0xbb, 0x83, 0x03, 0x25, 0x29, 0xb0, 0xfa, 0xd1, 0xe5, 0xd5, 0x93, 0xcf, 0x7a, 0x84, 0x7b, 0x63,
-	0xa7, 0xe4, 0xd2, 0x61, 0x39, 0xea, 0x76, 0x60, 0x3b, 0xec, 0x05, 0xa1, 0xb7, 0x62, 0x99, 0xcf,
-	0x46, 0x98, 0x95, 0x74, 0xb3, 0xd2, 0x90, 0xce, 0xfa, 0x8c, 0x63, 0x66, 0xe4, 0x16, 0x81, 0x75,
-	0xee, 0x86, 0x16, 0xed, 0x1c, 0x66, 0x97, 0xca, 0x7c, 0x8d, 0x67, 0x68, 0x1f, 0xee, 0x84, 0x8d,
-	0x00, 0xd9, 0x48, 0x28, 0x20, 0x03, 0x26, 0x3d, 0x9b, 0x79, 0xb2, 0xbb, 0x8c, 0xfe, 0xc5, 0xe5,
-	0xd5, 0x93, 0xa3, 0xad, 0xcb, 0x68, 0xd8, 0xcc, 0x0b, 0x4b, 0x91, 0xb1, 0xb4, 0xd7, 0x70, 0xb7,
-	0x3d, 0x76, 0x86, 0x84, 0xb1, 0x28, 0xf5, 0x11, 0x4c, 0xf4, 0xf1, 0x4c, 0x01, 0x85, 0x44, 0x31,
-	0x7d, 0x58, 0x2c, 0x6d, 0x1a, 0x65, 0x69, 0xb5, 0x62, 0x43, 0x38, 0x69, 0xdf, 0x01, 0xf8, 0x68,
-	0x85, 0xf0, 0x2e, 0x5d, 0xc4, 0x03, 0x5b, 0xc7, 0x43, 0x05, 0x98, 0x5e, 0x5e, 0x82, 0x78, 0x38,
-	0xaa, 0x25, 0x95, 0x20, 0x6a, 0x24, 0x76, 0x26, 0x1a, 0x63, 0x28, 0x68, 0xbf, 0x01, 0x98, 0x5d,
-	0x74, 0x55, 0xb5, 0xb9, 0x8d, 0xbe, 0x86, 0xb9, 0x09, 0xe9, 0x91, 0x81, 0xed, 0x73, 0x6c, 0xd9,
-	0x9d, 0x4e, 0x80, 0x19, 0xc3, 0x2c, 0x2a, 0xeb, 0xc5, 0xe6, 0xb2, 0x2a, 0x73, 0xe9, 0xf8, 0xd6,
-	0xc9, 0x40, 0xf3, 0x48, 0x73, 0x1d, 0xaa, 0xc2, 0x87, 0x7c, 0xca, 0x2c, 0xe2, 0x77, 0xa9, 0x12,
-	0x97, 0xdc, 0x3d, 0xff, 0x4f, 0xbd, 0x0a, 0x8e, 0x8c, 0xf7, 0xf8, 0x94, 0x49, 0xb2, 0xf6, 0xe1,
-	0x0e, 0x1e, 0x51, 0xd7, 0x93, 0xed, 0x24, 0x8d, 0x50, 0x10, 0xb4, 0xa6, 0x6a, 0xe2, 0x4b, 0x76,
-	0xf2, 0x0a, 0x26, 0xfb, 0x78, 0xc6, 0xa2, 0x09, 0x3d, 0xdb, 0x9c, 0x65, 0x65, 0xae, 0x86, 0x74,
-	0x42, 0xaf, 0xe0, 0x03, 0xc6, 0x6d, 0x3e, 0x66, 0x92, 0xcc, 0xec, 0xe1, 0x87, 0x9b, 0xdd, 0x75,
-	0xee, 0xb6, 0x25, 0xd4, 0x88, 0x5c, 0xb4, 0x13, 0x98, 0xbb, 0x87, 0x0e, 0x74, 0x00, 0x53, 0x4c,
-	0xa4, 0xe2, 0x1c, 0x07, 0xd1, 0xa1, 0x2e, 0x14, 0x28, 0x0f, 0x1f, 0x06, 0x78, 0x44, 0x03, 0x61,
-	0x0c, 0x07, 0x38, 0x97, 0xb5, 0x3f, 0x13, 0xf0, 0x7d, 0xdd, 0xac, 0x2c, 0x82, 0x4a, 0x12, 0x9e,
-	0xc2, 0x8c, 0xec, 0xdb, 0xf2, 0xc7, 0x43, 0x27, 0x0a, 0x99, 0x34, 0xd2, 0x52, 0xd7, 0x92, 0x2a,
-	0x54, 0x87, 0x05, 0x07, 0x33, 0x6e, 0xb1, 0x79, 0x8b, 0xf2, 0x4c, 0x9d, 0x01, 0x75, 0xfb, 0x96,
-	0x87, 0x49, 0xcf, 0xe3, 0xd1, 0x3f, 0xe0, 0x40, 0xe0, 0x16, 0x4c, 0xe8, 0xdc, 0xd5, 0x05, 0xa8,
-	0x21, 0x31, 0xe8, 0x5b, 0x00, 0xd5, 0x7f, 0x08, 0x24, 0x8e, 0x2d, 0xf1, 0xbf, 0x1c, 0x5b, 0x7e,
-	0x43, 0x19, 0x36, 0xf3, 0x50, 0x1f, 0x1e, 0xac, 0xd7, 0xb0, 0xb4, 0xe2, 0x4c, 0x49, 0x6e, 0xbb,
-	0x4e, 0x6b, 0xc9, 0x96, 0xcc, 0x0c, 0x7d, 0x03, 0xe0, 0x47, 0xeb, 0xd9, 0xee, 0x1c, 0x86, 0x35,
-	0x20, 0x8c, 0x2b, 0x3b, 0x32, 0xef, 0x96, 0xb7, 0xa1, 0xad, 0xe6, 0xfe, 0x6a, 0xed, 0x52, 0xde,
-	0x10, 0xc6, 0x3f, 0xfe, 0x09, 0xc0, 0xd4, 0x7c, 0xbb, 0xd0, 0x73, 0xf8, 0x41, 0xed, 0xdd, 0x49,
-	0xa5, 0x61, 0xb5, 0xcd, 0x63, 0xf3, 0xb4, 0x6d, 0xb5, 0x4f, 0xf5, 0xb7, 0x4d, 0xd3, 0xac, 0x55,
-	0xf7, 0x62, 0xf9, 0xdd, 0x1f, 0x7e, 0x2e, 0xa4, 0xda, 0xd1, 0x2e, 0x75, 0xee, 0x40, 0x2b, 0x27,
-	0xad, 0x7a, 0xd3, 0x78, 0x5b, 0xab, 0xee, 0x81, 0x10, 0x5a, 0x09, 0xff, 0xae, 0xf7, 0x40, 0xeb,
-	0xcd, 0xd6, 0xf1, 0x9b, 0xe6, 0x59, 0xad, 0xba, 0x17, 0x0f, 0xa1, 0x75, 0xe2, 0xdb, 0x03, 0x72,
-	0x8e, 0x3b, 0xf9, 0xe4, 0xf7, 0xbf, 0xa8, 0x31, 0xfd, 0xcb, 0x5f, 0xaf, 0x55, 0x70, 0x71, 0xad,
-	0x82, 0x3f, 0xae, 0x55, 0xf0, 0xe3, 0x8d, 0x1a, 0xbb, 0xb8, 0x51, 0x63, 0xbf, 0xdf, 0xa8, 0xb1,
-	0xb3, 0xcf, 0xff, 0x7d, 0xee, 0xd3, 0xb5, 0x87, 0x51, 0xee, 0x81, 0xf3, 0x40, 0x3e, 0x6f, 0x9f,
-	0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x84, 0x86, 0x4f, 0x3e, 0x07, 0x00, 0x00,
+	// 1134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x6f, 0x1a, 0x57,
+	0x14, 0x66, 0x80, 0x38, 0xe1, 0x60, 0x08, 0xb9, 0x7e, 0x94, 0x50, 0x17, 0x13, 0x2a, 0x35, 0xb6,
+	0xd5, 0x60, 0xc5, 0x7d, 0xca, 0x91, 0x2a, 0x79, 0x78, 0xc4, 0x28, 0x09, 0xb8, 0x33, 0x93, 0x2e,
+	0xb2, 0xe8, 0x68, 0x66, 0xb8, 0xc0, 0x08, 0x98, 0x8b, 0xe6, 0x5e, 0x5b, 0x26, 0xbb, 0xb6, 0xaa,
+	0x54, 0x79, 0x55, 0x75, 0xef, 0x55, 0x7f, 0x45, 0xa5, 0xfe, 0x80, 0x2e, 0xba, 0xc8, 0xb2, 0xca,
+	0x22, 0xaa, 0xec, 0x1f, 0x50, 0xa9, 0xfb, 0x4a, 0xd5, 0xbd, 0x33, 0x30, 0x03, 0x0e, 0xa9, 0xad,
+	0x66, 0x37, 0xf7, 0x9c, 0xef, 0xbc, 0xcf, 0xf9, 0x00, 0x3e, 0x34, 0x0d, 0x73, 0xd4, 0x27, 0xce,
+	0xb6, 0xc9, 0x2c, 0xab, 0x8b, 0xad, 0xde, 0x90, 0xd8, 0x0e, 0xdb, 0x3e, 0xba, 0x3f, 0x2d, 0x28,
+	0x0d, 0x5d, 0xc2, 0x08, 0xca, 0xfa, 0xe8, 0xd2, 0xb4, 0xf2, 0xe8, 0x7e, 0x6e, 0xb9, 0x43, 0x3a,
+	0x44, 0x80, 0xb6, 0xf9, 0x97, 0x87, 0x2f, 0xfe, 0x23, 0x41, 0x52, 0xd6, 0xca, 0xea, 0xf0, 0xe8,
+	0xc0, 0x25, 0xa4, 0x8d, 0xee, 0xc2, 0x4d, 0x93, 0x59, 0x3a, 0x73, 0x0d, 0x87, 0x1a, 0x16, 0xb3,
+	0x89, 0x93, 0x95, 0x0a, 0xd2, 0xc6, 0xa2, 0x92, 0x36, 0x99, 0xa5, 0x05, 0x52, 0xb4, 0x03, 0x2b,
+	0x33, 0x40, 0xdd, 0x76, 0x5a, 0xf8, 0x38, 0x1b, 0x2d, 0x48, 0x1b, 0x29, 0x65, 0x69, 0x1a, 0x5e,
+	0xe7, 0x2a, 0x74, 0x07, 0x16, 0x07, 0xd8, 0xed, 0xf5, 0xb1, 0xee, 0x90, 0x16, 0xa6, 0xd9, 0x98,
+	0xf0, 0x9c, 0xf4, 0x64, 0x0d, 0x2e, 0x42, 0x0e, 0xac, 0x58, 0xc4, 0x69, 0xdb, 0xee, 0xc0, 0x76,
+	0x3a, 0x3a, 0x8f, 0xd0, 0xc5, 0x46, 0x0b, 0xbb, 0xd9, 0x38, 0xc7, 0xca, 0xbb, 0x2f, 0x5f, 0xad,
+	0x7f, 0xda, 0xb1, 0x59, 0xf7, 0xd0, 0x2c, 0x59, 0x64, 0xb0, 0xed, 0x57, 0xdb, 0x37, 0x4c, 0x7a,
+	0xcf, 0x26, 0xe3, 0xe7, 0x36, 0x1b, 0x0d, 0x31, 0x2d, 0xc9, 0x5a, 0x79, 0x5f, 0x18, 0xcb, 0x23,
+	0x86, 0xa9, 0xb2, 0x14, 0x38, 0x96, 0x99, 0xe5, 0x69, 0x8a, 0xcf, 0x21, 0x1d, 0x4a, 0xf3, 0x11,
+	0x1e, 0xa1, 0x65, 0xb8, 0xe6, 0x15, 0x22, 0x89, 0x42, 0xbc, 0x07, 0x52, 0x20, 0xde, 0x35, 0x68,
+	0x57, 0x54, 0xb7, 0x28, 0x7f, 0xf1, 0xf2, 0xd5, 0xfa, 0xee, 0x95, 0xd3, 0xd8, 0x37, 0x68, 0xd7,
+	0x4b, 0x45, 0xf8, 0x2a, 0x3e, 0x82, 0x94, 0x7a, 0x68, 0x0e, 0x6c, 0x4a, 0xfd, 0xd0, 0xbb, 0x10,
+	0xeb, 0xe1, 0x51, 0x56, 0x2a, 0xc4, 0x36, 0x92, 0x3b, 0x1b, 0xa5, 0x79, 0xa3, 0x2c, 0x4d, 0x67,
+	0xac, 0x70, 0xa3, 0xe2, 0xf7, 0x12, 0xdc, 0x9c, 0x6a, 0x78, 0x9b, 0x04, 0xfe, 0xa4, 0x2b, 0xfb,
+	0x43, 0x05, 0x48, 0x86, 0x97, 0x20, 0xea, 0x8d, 0x2a, 0x24, 0xe2, 0x8d, 0x1a, 0xf2, 0x9d, 0xf1,
+	0xc7, 0xe8, 0x3d, 0x8a, 0xbf, 0x4b, 0x90, 0x0e, 0xaa, 0xaa, 0x18, 0xcc, 0x40, 0x5f, 0xc3, 0xd2,
+	0x91, 0xdd, 0xb1, 0xfb, 0x86, 0xc3, 0xb0, 0x6e, 0xb4, 0x5a, 0x2e, 0xa6, 0x14, 0x53, 0x3f, 0xad,
+	0x7b, 0xf3, 0xd3, 0x2a, 0x4f, 0x5e, 0x7b, 0x63, 0x23, 0x05, 0x4d, 0x3c, 0x4d, 0x64, 0xa8, 0x02,
+	0x37, 0xd8, 0x31, 0xd5, 0x6d, 0xa7, 0x4d, 0xb2, 0x51, 0xd1, 0xbb, 0xcd, 0x4b, 0xd5, 0xca, 0x7b,
+	0xa4, 0x5c, 0x67, 0xc7, 0x54, 0x34, 0x6b, 0x19, 0xae, 0xe1, 0x21, 0xb1, 0xba, 0xa2, 0x9c, 0xb8,
+	0xe2, 0x3d, 0x8a, 0xdf, 0x45, 0x21, 0x51, 0xe5, 0x5f, 0xa2, 0x92, 0x07, 0x10, 0xef, 0xe1, 0x11,
+	0xf5, 0x27, 0x74, 0x77, 0x7e, 0x94, 0xa9, 0xb9, 0x2a, 0xc2, 0x08, 0x3d, 0x80, 0x05, 0xca, 0x0c,
+	0x76, 0x48, 0x45, 0x33, 0xd3, 0x3b, 0xef, 0xcf, 0x37, 0x97, 0x99, 0xa5, 0x0a, 0xa8, 0xe2, 0x9b,
+	0xa0, 0x8f, 0x61, 0x95, 0x1f, 0x83, 0xbf, 0xc2, 0x86, 0xb8, 0xb7, 0x16, 0x1e, 0x32, 0x2f, 0xdd,
+	0x94, 0xb2, 0x6c, 0x32, 0xab, 0x1c, 0x52, 0x56, 0xb8, 0x0e, 0xd5, 0x60, 0x3d, 0x70, 0xac, 0xb7,
+	0x6d, 0xc7, 0xe8, 0xdb, 0xcf, 0x3d, 0x63, 0x66, 0x0f, 0x30, 0x39, 0x64, 0xe2, 0xae, 0x52, 0xca,
+	0x7b, 0x01, 0xac, 0x16, 0x42, 0x69, 0x1e, 0xa8, 0xd8, 0x84, 0xa5, 0xd7, 0x0c, 0x03, 0xad, 0x41,
+	0x82, 0xf2, 0x42, 0x19, 0xc3, 0xae, 0x4f, 0x13, 0x81, 0x00, 0xe5, 0xe0, 0x86, 0x8b, 0x87, 0xc4,
+	0xe5, 0x4a, 0x6f, 0x7d, 0x26, 0xef, 0xe2, 0xdf, 0x71, 0xb8, 0x25, 0x6b, 0xe5, 0xc0, 0xa9, 0x18,
+	0xc1, 0x1d, 0x58, 0x14, 0x5d, 0xd7, 0x9d, 0xc3, 0x81, 0xe9, 0xbb, 0x8c, 0x2b, 0x49, 0x21, 0x6b,
+	0x08, 0x11, 0xaa, 0x41, 0xc1, 0xc4, 0x94, 0xe9, 0x74, 0xd2, 0x60, 0x41, 0x12, 0x66, 0x9f, 0x58,
+	0x3d, 0xbd, 0x8b, 0xed, 0x4e, 0x97, 0xf9, 0x0c, 0xb4, 0xc6, 0x71, 0xc1, 0x1c, 0x64, 0x66, 0xc9,
+	0x1c, 0xb4, 0x2f, 0x30, 0xe8, 0x5b, 0x09, 0xf2, 0x6f, 0x70, 0xc4, 0x4f, 0x3d, 0xf6, 0x56, 0x4e,
+	0x3d, 0x37, 0x27, 0x0d, 0x83, 0x76, 0x51, 0x0f, 0xd6, 0x66, 0x73, 0x08, 0x1d, 0x18, 0xcd, 0xc6,
+	0xaf, 0xba, 0xcc, 0x33, 0xc1, 0x42, 0x6a, 0x8a, 0xbe, 0x91, 0xe0, 0x83, 0xd9, 0x68, 0x17, 0xce,
+	0x52, 0xef, 0xdb, 0x94, 0x65, 0xaf, 0x89, 0xb8, 0x57, 0xbc, 0xcc, 0xe2, 0x74, 0xec, 0xaf, 0x66,
+	0xee, 0xf4, 0xb1, 0x4d, 0xd9, 0x1b, 0xb6, 0x78, 0xe1, 0xff, 0x6d, 0xf1, 0xf5, 0xcb, 0x6c, 0xf1,
+	0xaf, 0x51, 0xc8, 0x04, 0x09, 0x2a, 0xd8, 0x22, 0x6e, 0x0b, 0x35, 0x20, 0x1d, 0x6a, 0x48, 0x40,
+	0x97, 0x97, 0x3e, 0xee, 0x14, 0x9d, 0xe2, 0xf0, 0x09, 0x8d, 0x44, 0x43, 0x34, 0x32, 0x8f, 0x02,
+	0x63, 0x6f, 0x8b, 0x02, 0xe5, 0x09, 0xb7, 0xc4, 0x05, 0xb7, 0x6c, 0x5d, 0x26, 0xfb, 0x19, 0x8a,
+	0xc9, 0x43, 0x92, 0x0f, 0xa7, 0x8d, 0xb1, 0x4e, 0x0d, 0xbe, 0x04, 0x3c, 0xff, 0x84, 0xc9, 0xac,
+	0x1a, 0xc6, 0xaa, 0xc1, 0x8a, 0xbf, 0x48, 0x70, 0x73, 0xda, 0x98, 0xf2, 0x6a, 0x19, 0x61, 0x46,
+	0xdf, 0x3f, 0x55, 0xef, 0x81, 0xb2, 0x70, 0x7d, 0x88, 0x9d, 0x96, 0xed, 0x74, 0xfc, 0x2e, 0x8c,
+	0x9f, 0x08, 0x41, 0x9c, 0xaf, 0x89, 0xcf, 0xb1, 0xe2, 0x1b, 0xdd, 0x86, 0x1b, 0x0e, 0x61, 0xba,
+	0x90, 0xc7, 0x3d, 0xb8, 0x43, 0x98, 0xcc, 0x55, 0x6b, 0x90, 0x68, 0x13, 0xb7, 0x43, 0x18, 0xc3,
+	0xce, 0x38, 0xa1, 0x89, 0x00, 0x6d, 0xc2, 0x2d, 0x11, 0x4f, 0x0f, 0xa7, 0xbd, 0x20, 0x50, 0x69,
+	0xa1, 0x90, 0xc7, 0xb9, 0x6f, 0xfd, 0x24, 0x41, 0x62, 0x42, 0xaa, 0x68, 0x13, 0x56, 0xab, 0x07,
+	0xcd, 0xf2, 0xbe, 0xae, 0x6a, 0x7b, 0xda, 0x53, 0x55, 0x57, 0x9f, 0xca, 0x4f, 0xea, 0x9a, 0x56,
+	0xad, 0x64, 0x22, 0xb9, 0xd4, 0xc9, 0x69, 0x21, 0xa1, 0xfa, 0x24, 0xd6, 0xba, 0x00, 0x2d, 0x37,
+	0x1b, 0xb5, 0xba, 0xf2, 0xa4, 0x5a, 0xc9, 0x48, 0x1e, 0xd4, 0x5f, 0xd7, 0xd7, 0x40, 0x6b, 0xf5,
+	0xc6, 0xde, 0xe3, 0xfa, 0xb3, 0x6a, 0x25, 0x13, 0xf5, 0xa0, 0xfe, 0x4e, 0xe2, 0x56, 0x2e, 0xfe,
+	0xc3, 0xcf, 0xf9, 0xc8, 0xd6, 0x5f, 0x52, 0x78, 0x1f, 0xd5, 0x31, 0xd1, 0xdf, 0x16, 0xe9, 0xa8,
+	0x6a, 0xbd, 0xd9, 0x18, 0xbb, 0x3a, 0xa8, 0x36, 0x2a, 0xf5, 0xc6, 0xc3, 0x4c, 0x24, 0xb7, 0x72,
+	0x72, 0x5a, 0xb8, 0x15, 0x18, 0x1d, 0xf8, 0x7d, 0x2d, 0xc1, 0xea, 0x45, 0x2b, 0xb9, 0xaa, 0x6a,
+	0x19, 0x29, 0x87, 0x4e, 0x4e, 0x0b, 0xa1, 0x9f, 0x64, 0xd1, 0xd8, 0x4f, 0x20, 0x77, 0x11, 0xdf,
+	0x68, 0x6a, 0x9e, 0x4d, 0x74, 0x36, 0x4c, 0xc3, 0x9f, 0xc7, 0xe7, 0xf0, 0xee, 0x45, 0xb3, 0x5a,
+	0x53, 0x79, 0xd8, 0xd4, 0xb4, 0x6a, 0x23, 0x13, 0xcb, 0xbd, 0x73, 0x72, 0x5a, 0x58, 0x0a, 0xec,
+	0x6a, 0xe3, 0x59, 0x79, 0x15, 0xcb, 0x5f, 0xfe, 0x76, 0x96, 0x97, 0x5e, 0x9c, 0xe5, 0xa5, 0x3f,
+	0xcf, 0xf2, 0xd2, 0x8f, 0xe7, 0xf9, 0xc8, 0x8b, 0xf3, 0x7c, 0xe4, 0x8f, 0xf3, 0x7c, 0xe4, 0xd9,
+	0x67, 0xff, 0x4d, 0xb1, 0xc7, 0x33, 0xff, 0x80, 0x05, 0xe5, 0x9a, 0x0b, 0xe2, 0x7f, 0xec, 0x47,
+	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x85, 0xae, 0x9a, 0xe0, 0x27, 0x0b, 0x00, 0x00,
 }
 
 func (m *BTCSpvProof) Marshal() (dAtA []byte, err error) {
@@ -909,6 +1196,16 @@ func (m *EpochData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CheckpointFinalizationTimeout != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.CheckpointFinalizationTimeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BtcConfirmationDepth != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.BtcConfirmationDepth))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Status != 0 {
 		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Status))
 		i--
@@ -988,6 +1285,16 @@ func (m *BTCCheckpointInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CheckpointFinalizationTimeout != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.CheckpointFinalizationTimeout))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BtcConfirmationDepth != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.BtcConfirmationDepth))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.BestSubmissionVigilanteAddressList) > 0 {
 		for iNdEx := len(m.BestSubmissionVigilanteAddressList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1041,6 +1348,121 @@ func (m *BTCCheckpointInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubmissionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcFeeSat != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.BtcFeeSat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VigilanteAddresses != nil {
+		{
+			size, err := m.VigilanteAddresses.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubmissionKey != nil {
+		{
+			size, err := m.SubmissionKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtccheckpoint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalBtcFeeSat != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.TotalBtcFeeSat))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Forgotten != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Forgotten))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NotBest != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.NotBest))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Best != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Best))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Pending != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Pending))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintBtccheckpoint(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBtccheckpoint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBtccheckpoint(v)
 	base := offset
@@ -1165,6 +1587,12 @@ func (m *EpochData) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovBtccheckpoint(uint64(m.Status))
 	}
+	if m.BtcConfirmationDepth != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.BtcConfirmationDepth))
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.CheckpointFinalizationTimeout))
+	}
 	return n
 }
 
@@ -1213,20 +1641,79 @@ func (m *BTCCheckpointInfo) Size() (n int) {
 			n += 1 + l + sovBtccheckpoint(uint64(l))
 		}
 	}
+	if m.BtcConfirmationDepth != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.BtcConfirmationDepth))
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.CheckpointFinalizationTimeout))
+	}
 	return n
 }
 
-func sovBtccheckpoint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBtccheckpoint(x uint64) (n int) {
-	return sovBtccheckpoint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *SubmissionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmissionKey != nil {
+		l = m.SubmissionKey.Size()
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Epoch))
+	}
+	if m.VigilanteAddresses != nil {
+		l = m.VigilanteAddresses.Size()
+		n += 1 + l + sovBtccheckpoint(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Status))
+	}
+	if m.BtcFeeSat != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.BtcFeeSat))
+	}
+	return n
 }
-func (m *BTCSpvProof) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func (m *SubmissionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Total))
+	}
+	if m.Pending != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Pending))
+	}
+	if m.Best != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Best))
+	}
+	if m.NotBest != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.NotBest))
+	}
+	if m.Forgotten != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.Forgotten))
+	}
+	if m.TotalBtcFeeSat != 0 {
+		n += 1 + sovBtccheckpoint(uint64(m.TotalBtcFeeSat))
+	}
+	return n
+}
+
+func sovBtccheckpoint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtccheckpoint(x uint64) (n int) {
+	return sovBtccheckpoint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BTCSpvProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1957,6 +2444,44 @@ func (m *EpochData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcConfirmationDepth", wireType)
+			}
+			m.BtcConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcConfirmationDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointFinalizationTimeout", wireType)
+			}
+			m.CheckpointFinalizationTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointFinalizationTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
@@ -2266,6 +2791,387 @@ func (m *BTCCheckpointInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcConfirmationDepth", wireType)
+			}
+			m.BtcConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcConfirmationDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointFinalizationTimeout", wireType)
+			}
+			m.CheckpointFinalizationTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointFinalizationTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtccheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmissionKey == nil {
+				m.SubmissionKey = &SubmissionKey{}
+			}
+			if err := m.SubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VigilanteAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VigilanteAddresses == nil {
+				m.VigilanteAddresses = &CheckpointAddresses{}
+			}
+			if err := m.VigilanteAddresses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SubmissionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcFeeSat", wireType)
+			}
+			m.BtcFeeSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcFeeSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtccheckpoint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtccheckpoint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			m.Pending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Best", wireType)
+			}
+			m.Best = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Best |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBest", wireType)
+			}
+			m.NotBest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotBest |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forgotten", wireType)
+			}
+			m.Forgotten = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Forgotten |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBtcFeeSat", wireType)
+			}
+			m.TotalBtcFeeSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtccheckpoint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBtcFeeSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtccheckpoint(dAtA[iNdEx:])
@@ -1,7 +1,6 @@
 package types
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"math/big"
@@ -28,14 +27,6 @@ type ParsedProof struct {
 	OpReturnData     []byte
 }
 
-// Concatenates and double hashes two provided inputs
-func hashConcat(a []byte, b []byte) chainhash.Hash {
-	c := []byte{}
-	c = append(c, a...)
-	c = append(c, b...)
-	return chainhash.DoubleHashH(c)
-}
-
 func min(a, b uint) uint {
 	if a < b {
 		return a
@@ -135,69 +126,6 @@ func CreateProofForIdx(transactions [][]byte, idx uint) ([]*chainhash.Hash, erro
 	return branch, nil
 }
 
-// Verify checks the validity of a merkle proof
-// proof logic copied from:
-// https://github.com/summa-tx/bitcoin-spv/blob/fb2a61e7a941d421ae833789d97ed10d2ad79cfe/golang/btcspv/bitcoin_spv.go#L498
-// main reason for not bringing library in, is that we already use btcd
-// bitcoin primitives and this library defines their own which could lead
-// to some mixups
-func verify(tx *btcutil.Tx, merkleRoot *chainhash.Hash, intermediateNodes []byte, index uint32) bool {
-	txHash := tx.Hash()
-
-	// Shortcut the empty-block case
-	if txHash.IsEqual(merkleRoot) && index == 0 && len(intermediateNodes) == 0 {
-		return true
-	}
-
-	proof := []byte{}
-	proof = append(proof, txHash[:]...)
-	proof = append(proof, intermediateNodes...)
-	proof = append(proof, merkleRoot[:]...)
-
-	var current chainhash.Hash
-
-	idx := index
-
-	proofLength := len(proof)
-
-	if proofLength%32 != 0 {
-		return false
-	}
-
-	if proofLength == 64 {
-		return false
-	}
-
-	root := proof[proofLength-32:]
-
-	cur := proof[:32:32]
-	copy(current[:], cur)
-
-	numSteps := (proofLength / 32) - 1
-
-	for i := 1; i < numSteps; i++ {
-		start := i * 32
-		end := i*32 + 32
-		next := proof[start:end:end]
-		if idx%2 == 1 {
-			current = hashConcat(next, current[:])
-		} else {
-			current = hashConcat(current[:], next)
-		}
-		idx >>= 1
-	}
-
-	return bytes.Equal(current[:], root)
-}
-
-func VerifyInclusionProof(
-	tx *btcutil.Tx,
-	merkleRoot *chainhash.Hash,
-	intermediateNodes []byte,
-	index uint32) bool {
-	return verify(tx, merkleRoot, intermediateNodes, index)
-}
-
 // ExtractStandardOpReturnData extract OP_RETURN data from transaction OP_RETURN
 // output.
 // If OP_RETURN output is not standard it will be ignored. If there is more than
@@ -281,7 +209,7 @@ func ParseProof(
 		return nil, e
 	}
 
-	validProof := verify(tx, &header.MerkleRoot, merkleProof, transactionIndex)
+	validProof := types.VerifyInclusionProof(tx, &header.MerkleRoot, merkleProof, transactionIndex)
 
 	if !validProof {
 		return nil, fmt.Errorf("header failed validation due to failed proof")
@@ -16,6 +16,10 @@ type BTCLightClientKeeper interface {
 
 	// MainChainDepth returns the depth of the header in the main chain or error if the header does not exist
 	MainChainDepth(ctx context.Context, headerBytes *bbn.BTCHeaderHashBytes) (uint32, error)
+
+	// AnnotateLatestReorg records in the reorg log of the light client that the
+	// given epochs were affected by the latest reorg
+	AnnotateLatestReorg(ctx context.Context, module string, epochs []uint64)
 }
 
 type CheckpointingKeeper interface {
@@ -41,5 +45,6 @@ type CheckpointingKeeper interface {
 }
 
 type IncentiveKeeper interface {
+	RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *RewardDistInfo)
 	IndexRefundableMsg(ctx context.Context, msg sdk.Msg)
 }
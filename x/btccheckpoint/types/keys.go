@@ -34,6 +34,15 @@ var (
 	LastFinalizedEpochKey    = append([]byte{5}, []byte(LatestFinalizedEpochKey)...)
 	BtcLightClientUpdatedKey = append([]byte{6}, []byte(btcLightClientUpdated)...)
 	ParamsKey                = []byte{7}
+	// SubmissionRecordPrefix is the prefix of records of submissions indexed by
+	// submission keys
+	SubmissionRecordPrefix = []byte{8}
+	// AddressSubmissionIndexPrefix is the prefix of the index from submitter and
+	// reporter addresses to keys of their submissions
+	AddressSubmissionIndexPrefix = []byte{9}
+	// AddressSubmissionStatsPrefix is the prefix of the statistics of
+	// submissions made or reported by each address
+	AddressSubmissionStatsPrefix = []byte{10}
 )
 
 func KeyPrefix(p string) []byte {
@@ -11,6 +11,7 @@ import (
 
 type MockBTCLightClientKeeper struct {
 	headers map[string]uint32
+	heights map[string]uint32
 }
 
 type MockCheckpointingKeeper struct {
@@ -23,6 +24,7 @@ type MockIncentiveKeeper struct {
 func NewMockBTCLightClientKeeper() *MockBTCLightClientKeeper {
 	lc := MockBTCLightClientKeeper{
 		headers: make(map[string]uint32),
+		heights: make(map[string]uint32),
 	}
 	return &lc
 }
@@ -50,12 +52,21 @@ func (mc *MockBTCLightClientKeeper) SetDepth(header *bbn.BTCHeaderHashBytes, dd
 	mc.headers[header.String()] = dd
 }
 
+func (mc *MockBTCLightClientKeeper) SetHeight(header *bbn.BTCHeaderHashBytes, height uint32) {
+	mc.heights[header.String()] = height
+}
+
 func (mc *MockBTCLightClientKeeper) DeleteHeader(header *bbn.BTCHeaderHashBytes) {
 	delete(mc.headers, header.String())
+	delete(mc.heights, header.String())
 }
 
 func (mb MockBTCLightClientKeeper) BlockHeight(ctx context.Context, header *bbn.BTCHeaderHashBytes) (uint32, error) {
-	// todo not used
+	if header != nil {
+		if height, ok := mb.heights[header.String()]; ok {
+			return height, nil
+		}
+	}
 	return uint32(10), nil
 }
 
@@ -68,6 +79,9 @@ func (ck MockBTCLightClientKeeper) MainChainDepth(ctx context.Context, headerByt
 	}
 }
 
+func (ck MockBTCLightClientKeeper) AnnotateLatestReorg(ctx context.Context, module string, epochs []uint64) {
+}
+
 func (ck MockCheckpointingKeeper) VerifyCheckpoint(ctx context.Context, checkpoint txformat.RawBtcCheckpoint) error {
 	if ck.returnError {
 		return errors.New("bad checkpoints")
@@ -58,5 +58,7 @@ func (b BTCCheckpointInfo) ToResponse() *BTCCheckpointInfoResponse {
 		BestSubmissionBtcBlockHash:         b.BestSubmissionBtcBlockHash.MarshalHex(),
 		BestSubmissionTransactions:         bestSubTxs,
 		BestSubmissionVigilanteAddressList: bestSubVigAddrs,
+		BtcConfirmationDepth:               b.BtcConfirmationDepth,
+		CheckpointFinalizationTimeout:      b.CheckpointFinalizationTimeout,
 	}
 }
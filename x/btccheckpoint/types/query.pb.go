@@ -418,6 +418,13 @@ type BTCCheckpointInfoResponse struct {
 	BestSubmissionTransactions []*TransactionInfoResponse `protobuf:"bytes,4,rep,name=best_submission_transactions,json=bestSubmissionTransactions,proto3" json:"best_submission_transactions,omitempty"`
 	// list of vigilantes' addresses of the best submission
 	BestSubmissionVigilanteAddressList []*CheckpointAddressesResponse `protobuf:"bytes,5,rep,name=best_submission_vigilante_address_list,json=bestSubmissionVigilanteAddressList,proto3" json:"best_submission_vigilante_address_list,omitempty"`
+	// btc confirmation depth used for the status transitions of the epoch, i.e.,
+	// the one in effect when the first submission of the epoch was accepted
+	BtcConfirmationDepth uint32 `protobuf:"varint,6,opt,name=btc_confirmation_depth,json=btcConfirmationDepth,proto3" json:"btc_confirmation_depth,omitempty"`
+	// checkpoint finalization timeout used for the status transitions of the
+	// epoch, i.e., the one in effect when the first submission of the epoch was
+	// accepted
+	CheckpointFinalizationTimeout uint32 `protobuf:"varint,7,opt,name=checkpoint_finalization_timeout,json=checkpointFinalizationTimeout,proto3" json:"checkpoint_finalization_timeout,omitempty"`
 }
 
 func (m *BTCCheckpointInfoResponse) Reset()         { *m = BTCCheckpointInfoResponse{} }
@@ -488,6 +495,20 @@ func (m *BTCCheckpointInfoResponse) GetBestSubmissionVigilanteAddressList() []*C
 	return nil
 }
 
+func (m *BTCCheckpointInfoResponse) GetBtcConfirmationDepth() uint32 {
+	if m != nil {
+		return m.BtcConfirmationDepth
+	}
+	return 0
+}
+
+func (m *BTCCheckpointInfoResponse) GetCheckpointFinalizationTimeout() uint32 {
+	if m != nil {
+		return m.CheckpointFinalizationTimeout
+	}
+	return 0
+}
+
 // TransactionInfoResponse is the info of a tx on Bitcoin,
 // including
 // - the position of the tx on BTC blockchain
@@ -700,6 +721,211 @@ func (m *SubmissionKeyResponse) GetSecondTxIndex() uint32 {
 	return 0
 }
 
+// QuerySubmissionsByAddressRequest defines a request to get all checkpoint
+// submissions made or reported by a given address
+type QuerySubmissionsByAddressRequest struct {
+	// address is the bech32 address of the submitter or reporter
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines whether to have the pagination in the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubmissionsByAddressRequest) Reset()         { *m = QuerySubmissionsByAddressRequest{} }
+func (m *QuerySubmissionsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubmissionsByAddressRequest) ProtoMessage()    {}
+func (*QuerySubmissionsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{12}
+}
+func (m *QuerySubmissionsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubmissionsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubmissionsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubmissionsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubmissionsByAddressRequest.Merge(m, src)
+}
+func (m *QuerySubmissionsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubmissionsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubmissionsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubmissionsByAddressRequest proto.InternalMessageInfo
+
+func (m *QuerySubmissionsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QuerySubmissionsByAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// SubmissionRecordResponse is the record of a checkpoint submission
+type SubmissionRecordResponse struct {
+	// submission_key is the key of the submission
+	SubmissionKey *SubmissionKeyResponse `protobuf:"bytes,1,opt,name=submission_key,json=submissionKey,proto3" json:"submission_key,omitempty"`
+	// epoch_num is the epoch number of the submitted checkpoint
+	EpochNum uint64 `protobuf:"varint,2,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// vigilante_addresses are the addresses of the submitter and reporter
+	VigilanteAddresses *CheckpointAddressesResponse `protobuf:"bytes,3,opt,name=vigilante_addresses,json=vigilanteAddresses,proto3" json:"vigilante_addresses,omitempty"`
+	// status is the outcome of the submission
+	Status SubmissionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=babylon.btccheckpoint.v1.SubmissionStatus" json:"status,omitempty"`
+	// btc_fee_sat is the BTC fee implied by the transactions of the submission
+	BtcFeeSat uint64 `protobuf:"varint,5,opt,name=btc_fee_sat,json=btcFeeSat,proto3" json:"btc_fee_sat,omitempty"`
+}
+
+func (m *SubmissionRecordResponse) Reset()         { *m = SubmissionRecordResponse{} }
+func (m *SubmissionRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmissionRecordResponse) ProtoMessage()    {}
+func (*SubmissionRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{13}
+}
+func (m *SubmissionRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubmissionRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubmissionRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubmissionRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionRecordResponse.Merge(m, src)
+}
+func (m *SubmissionRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubmissionRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmissionRecordResponse proto.InternalMessageInfo
+
+func (m *SubmissionRecordResponse) GetSubmissionKey() *SubmissionKeyResponse {
+	if m != nil {
+		return m.SubmissionKey
+	}
+	return nil
+}
+
+func (m *SubmissionRecordResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *SubmissionRecordResponse) GetVigilanteAddresses() *CheckpointAddressesResponse {
+	if m != nil {
+		return m.VigilanteAddresses
+	}
+	return nil
+}
+
+func (m *SubmissionRecordResponse) GetStatus() SubmissionStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SubmissionPending
+}
+
+func (m *SubmissionRecordResponse) GetBtcFeeSat() uint64 {
+	if m != nil {
+		return m.BtcFeeSat
+	}
+	return 0
+}
+
+// QuerySubmissionsByAddressResponse defines a response to get all checkpoint
+// submissions made or reported by a given address
+type QuerySubmissionsByAddressResponse struct {
+	// submissions is the list of records of submissions made or reported by the
+	// address
+	Submissions []*SubmissionRecordResponse `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	// stats is the statistics over all submissions made or reported by the
+	// address
+	Stats *SubmissionStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	// pagination defines the pagination in the response
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySubmissionsByAddressResponse) Reset()         { *m = QuerySubmissionsByAddressResponse{} }
+func (m *QuerySubmissionsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubmissionsByAddressResponse) ProtoMessage()    {}
+func (*QuerySubmissionsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b9a2f46ada7d854, []int{14}
+}
+func (m *QuerySubmissionsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubmissionsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubmissionsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubmissionsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubmissionsByAddressResponse.Merge(m, src)
+}
+func (m *QuerySubmissionsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubmissionsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubmissionsByAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySubmissionsByAddressResponse proto.InternalMessageInfo
+
+func (m *QuerySubmissionsByAddressResponse) GetSubmissions() []*SubmissionRecordResponse {
+	if m != nil {
+		return m.Submissions
+	}
+	return nil
+}
+
+func (m *QuerySubmissionsByAddressResponse) GetStats() *SubmissionStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QuerySubmissionsByAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btccheckpoint.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btccheckpoint.v1.QueryParamsResponse")
@@ -713,6 +939,9 @@ func init() {
 	proto.RegisterType((*TransactionInfoResponse)(nil), "babylon.btccheckpoint.v1.TransactionInfoResponse")
 	proto.RegisterType((*CheckpointAddressesResponse)(nil), "babylon.btccheckpoint.v1.CheckpointAddressesResponse")
 	proto.RegisterType((*SubmissionKeyResponse)(nil), "babylon.btccheckpoint.v1.SubmissionKeyResponse")
+	proto.RegisterType((*QuerySubmissionsByAddressRequest)(nil), "babylon.btccheckpoint.v1.QuerySubmissionsByAddressRequest")
+	proto.RegisterType((*SubmissionRecordResponse)(nil), "babylon.btccheckpoint.v1.SubmissionRecordResponse")
+	proto.RegisterType((*QuerySubmissionsByAddressResponse)(nil), "babylon.btccheckpoint.v1.QuerySubmissionsByAddressResponse")
 }
 
 func init() {
@@ -720,67 +949,84 @@ func init() {
 }
 
 var fileDescriptor_6b9a2f46ada7d854 = []byte{
-	// 948 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x26, 0x4e, 0x14, 0xbf, 0x34, 0xd0, 0x4e, 0x8d, 0x70, 0x9c, 0xe0, 0xba, 0xab, 0x36,
-	0x8d, 0x10, 0xde, 0x95, 0x1b, 0x68, 0xa8, 0x40, 0x48, 0x38, 0xa2, 0xa5, 0x02, 0xa1, 0x74, 0x1b,
-	0x38, 0x70, 0x59, 0xcd, 0xae, 0x27, 0xeb, 0x51, 0xec, 0x9d, 0xed, 0xce, 0x38, 0x8a, 0x55, 0x71,
-	0xe1, 0x86, 0x38, 0x80, 0xc4, 0xd7, 0xe0, 0x08, 0x37, 0x84, 0xc4, 0x01, 0xa9, 0x12, 0x97, 0x0a,
-	0x2e, 0x9c, 0x10, 0x4a, 0xf8, 0x20, 0x68, 0x67, 0xc6, 0xbb, 0x6b, 0x3b, 0x5b, 0x27, 0xb9, 0x79,
-	0x77, 0x7e, 0xff, 0xe6, 0xbd, 0x97, 0x7d, 0x81, 0x5b, 0x1e, 0xf6, 0x86, 0x3d, 0x16, 0xda, 0x9e,
-	0xf0, 0xfd, 0x2e, 0xf1, 0x0f, 0x23, 0x46, 0x43, 0x61, 0x1f, 0xb5, 0xec, 0xa7, 0x03, 0x12, 0x0f,
-	0xad, 0x28, 0x66, 0x82, 0xa1, 0xaa, 0x46, 0x59, 0x63, 0x28, 0xeb, 0xa8, 0x55, 0xab, 0x04, 0x2c,
-	0x60, 0x12, 0x64, 0x27, 0xbf, 0x14, 0xbe, 0xb6, 0xe6, 0x33, 0xde, 0x67, 0xdc, 0x55, 0x07, 0xea,
-	0x41, 0x1f, 0x6d, 0x04, 0x8c, 0x05, 0x3d, 0x62, 0xe3, 0x88, 0xda, 0x38, 0x0c, 0x99, 0xc0, 0x82,
-	0xb2, 0x70, 0x74, 0xfa, 0xa6, 0xc2, 0xda, 0x1e, 0xe6, 0x44, 0x25, 0xb0, 0x8f, 0x5a, 0x1e, 0x11,
-	0xb8, 0x65, 0x47, 0x38, 0xa0, 0xa1, 0x04, 0x6b, 0xec, 0xed, 0xc2, 0xe8, 0x11, 0x8e, 0x71, 0x5f,
-	0x4b, 0x9a, 0x15, 0x40, 0x8f, 0x13, 0xa1, 0x3d, 0xf9, 0xd2, 0x21, 0x4f, 0x07, 0x84, 0x0b, 0xf3,
-	0x73, 0xb8, 0x3e, 0xf6, 0x96, 0x47, 0x2c, 0xe4, 0x04, 0x7d, 0x00, 0x4b, 0x8a, 0x5c, 0x35, 0x1a,
-	0xc6, 0xd6, 0xca, 0xdd, 0x86, 0x55, 0x74, 0x73, 0x4b, 0x31, 0xdb, 0xa5, 0xe7, 0xff, 0xdc, 0x98,
-	0x73, 0x34, 0xcb, 0x7c, 0x1f, 0xde, 0x90, 0xb2, 0x6d, 0xe1, 0xef, 0xa6, 0xe8, 0x47, 0xe1, 0x01,
-	0xd3, 0xbe, 0x68, 0x1d, 0xca, 0x24, 0x62, 0x7e, 0xd7, 0x0d, 0x07, 0x7d, 0xe9, 0x51, 0x72, 0x96,
-	0xe5, 0x8b, 0xcf, 0x06, 0x7d, 0x93, 0x42, 0xbd, 0x88, 0xad, 0xf3, 0x3d, 0x84, 0x12, 0x0d, 0x0f,
-	0x98, 0x4e, 0xb7, 0x5d, 0x9c, 0xae, 0xbd, 0xbf, 0x7b, 0xb6, 0x84, 0x23, 0x05, 0xcc, 0xee, 0x59,
-	0x56, 0x3c, 0x9f, 0xf4, 0x01, 0x40, 0x56, 0x72, 0x6d, 0xb8, 0x69, 0xe9, 0x5e, 0x26, 0xfd, 0xb1,
-	0xd4, 0x84, 0xe8, 0xfe, 0x58, 0x7b, 0x38, 0x20, 0x9a, 0xeb, 0xe4, 0x98, 0xe6, 0x2f, 0x06, 0xdc,
-	0x28, 0xb4, 0xd2, 0xd7, 0xda, 0x83, 0x72, 0x92, 0xca, 0xed, 0x51, 0x2e, 0xaa, 0x46, 0x63, 0xe1,
-	0xb2, 0x77, 0x5b, 0x4e, 0x54, 0x3e, 0xa5, 0x5c, 0xa0, 0x87, 0x63, 0xe9, 0xe7, 0x65, 0xfa, 0x3b,
-	0x33, 0xd3, 0x6b, 0x99, 0x7c, 0xfc, 0xf7, 0x60, 0x43, 0xa6, 0xff, 0x28, 0x69, 0xd2, 0x93, 0x81,
-	0xd7, 0xa7, 0x9c, 0x27, 0x03, 0x7b, 0xae, 0x86, 0x76, 0xf4, 0x38, 0x4c, 0x93, 0xf5, 0xc5, 0x77,
-	0xa1, 0x74, 0x48, 0x86, 0x5c, 0xdf, 0xd9, 0x2e, 0xbe, 0x73, 0x46, 0xfe, 0x84, 0x0c, 0xb3, 0x5e,
-	0x26, 0x64, 0xf3, 0x8f, 0x05, 0x58, 0x2b, 0xac, 0x09, 0xba, 0x09, 0x57, 0xd2, 0x80, 0x1e, 0x89,
-	0x75, 0xc6, 0x95, 0x51, 0x46, 0x8f, 0xc4, 0xe8, 0x01, 0x34, 0x3c, 0xc2, 0x85, 0xcb, 0x53, 0x13,
-	0xd7, 0x13, 0xbe, 0xeb, 0xf5, 0x98, 0x7f, 0xe8, 0x76, 0x09, 0x0d, 0xba, 0x42, 0x96, 0x70, 0xd5,
-	0xd9, 0x48, 0x70, 0x59, 0x96, 0xb6, 0xf0, 0xdb, 0x09, 0xe8, 0x63, 0x89, 0x41, 0x6d, 0xa8, 0xbf,
-	0x44, 0x07, 0xf3, 0x6e, 0x75, 0xa1, 0x61, 0x6c, 0x95, 0x9d, 0x5a, 0x81, 0x0a, 0xe6, 0x5d, 0xc4,
-	0x61, 0x63, 0x52, 0x43, 0xc4, 0x38, 0xe4, 0xd8, 0x97, 0xdf, 0x89, 0x6a, 0x49, 0x56, 0xaa, 0x55,
-	0x5c, 0xa9, 0xfd, 0x0c, 0x3d, 0x36, 0x1b, 0x13, 0xa6, 0x39, 0x18, 0x47, 0xdf, 0x18, 0xb0, 0x39,
-	0xe9, 0x7a, 0x44, 0x03, 0xda, 0xc3, 0xa1, 0x20, 0x2e, 0xee, 0x74, 0x62, 0xc2, 0xb9, 0x9a, 0xce,
-	0x45, 0xe9, 0xff, 0x4e, 0xb1, 0x7f, 0xd6, 0x86, 0x0f, 0x15, 0x8f, 0xa4, 0xed, 0x76, 0xcc, 0xf1,
-	0x0c, 0x5f, 0x8c, 0x2c, 0x34, 0x32, 0x99, 0x5c, 0xf3, 0x19, 0xbc, 0x5e, 0x70, 0x05, 0x54, 0x81,
-	0x45, 0x1a, 0x76, 0xc8, 0xb1, 0xec, 0xe1, 0xaa, 0xa3, 0x1e, 0x10, 0x82, 0x92, 0xac, 0xed, 0xbc,
-	0xac, 0xad, 0xfc, 0x8d, 0x1a, 0xb0, 0x92, 0xab, 0x9a, 0x2e, 0x7b, 0xfe, 0x55, 0xa2, 0x15, 0xc5,
-	0x8c, 0x1d, 0x54, 0x4b, 0xf2, 0x4c, 0x3d, 0x98, 0xdf, 0x1a, 0xb0, 0xfe, 0x92, 0x0b, 0xa0, 0x7b,
-	0x50, 0x96, 0x25, 0x12, 0x42, 0x4f, 0x52, 0xb9, 0x5d, 0xfd, 0xf3, 0xa7, 0x66, 0x45, 0xff, 0x61,
-	0x69, 0xc2, 0x13, 0x11, 0xd3, 0x30, 0x70, 0x32, 0x28, 0x7a, 0x1b, 0x96, 0x63, 0x12, 0xb1, 0x38,
-	0xa1, 0xcd, 0xcf, 0xa0, 0xa5, 0x48, 0xf3, 0x77, 0x03, 0x5e, 0x3b, 0x73, 0xf0, 0x51, 0x13, 0xae,
-	0x1f, 0xd0, 0x98, 0x0b, 0x57, 0x1c, 0xe7, 0xc7, 0x4b, 0x26, 0x72, 0xae, 0xca, 0xa3, 0xfd, 0xe3,
-	0x6c, 0xa8, 0x6e, 0xc1, 0x2b, 0x29, 0x5c, 0x55, 0x50, 0x8d, 0xf3, 0x15, 0x8d, 0x7c, 0x24, 0x0b,
-	0x69, 0x43, 0x85, 0x13, 0x9f, 0x85, 0x9d, 0x09, 0x55, 0x55, 0xbd, 0x6b, 0xea, 0x2c, 0x2f, 0xbb,
-	0x09, 0xaf, 0x66, 0x04, 0xa5, 0x5b, 0x92, 0xba, 0xab, 0x23, 0xac, 0x14, 0xbe, 0xfb, 0xdb, 0x22,
-	0x2c, 0xca, 0xef, 0x00, 0xfa, 0xce, 0x80, 0x25, 0xb5, 0x38, 0xd0, 0x5b, 0xc5, 0x23, 0x34, 0xbd,
-	0xaf, 0x6a, 0xcd, 0x73, 0xa2, 0x55, 0x7d, 0xcc, 0xad, 0xaf, 0xff, 0xfa, 0xef, 0x87, 0x79, 0x13,
-	0x35, 0xec, 0x19, 0x4b, 0x12, 0xfd, 0x6c, 0xc0, 0xb5, 0xa9, 0x7d, 0x83, 0x76, 0x66, 0xd8, 0x15,
-	0xed, 0xb7, 0xda, 0xbb, 0x17, 0x27, 0xea, 0xc8, 0x4d, 0x19, 0xf9, 0x0e, 0xba, 0x5d, 0x1c, 0xf9,
-	0x59, 0xfa, 0x21, 0xfb, 0x0a, 0xfd, 0x68, 0x00, 0x9a, 0xde, 0x28, 0xe8, 0x42, 0xfe, 0xf9, 0x7d,
-	0x57, 0xbb, 0x7f, 0x09, 0xa6, 0x8e, 0x7e, 0x53, 0x46, 0x5f, 0x47, 0x6b, 0x85, 0xd1, 0xd1, 0xaf,
-	0x06, 0x5c, 0x9d, 0xdc, 0x02, 0xe8, 0xde, 0x0c, 0xcb, 0x82, 0x9d, 0x53, 0xdb, 0xb9, 0x30, 0x4f,
-	0x07, 0xbd, 0x2f, 0x83, 0x6e, 0xa3, 0xd6, 0xb9, 0x6a, 0x6c, 0x67, 0x5f, 0x43, 0xde, 0x7e, 0xfc,
-	0xfc, 0xa4, 0x6e, 0xbc, 0x38, 0xa9, 0x1b, 0xff, 0x9e, 0xd4, 0x8d, 0xef, 0x4f, 0xeb, 0x73, 0x2f,
-	0x4e, 0xeb, 0x73, 0x7f, 0x9f, 0xd6, 0xe7, 0xbe, 0xdc, 0x09, 0xa8, 0xe8, 0x0e, 0x3c, 0xcb, 0x67,
-	0xfd, 0x91, 0x6c, 0x0f, 0x7b, 0xbc, 0x49, 0x59, 0xea, 0x72, 0x3c, 0xe1, 0x23, 0x86, 0x11, 0xe1,
-	0xde, 0x92, 0xfc, 0x07, 0x6d, 0xfb, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x16, 0xcd, 0xfc,
-	0x84, 0x0a, 0x00, 0x00,
+	// 1227 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0xce, 0x0f, 0xbf, 0x34, 0xa1, 0x9d, 0x18, 0x70, 0x9c, 0xd4, 0x71, 0x56, 0x6d,
+	0x1a, 0xaa, 0xc6, 0x2b, 0x27, 0xa5, 0x21, 0x04, 0x81, 0xea, 0x40, 0x4a, 0x05, 0x42, 0xe9, 0x26,
+	0xf4, 0xc0, 0x65, 0xb5, 0xbb, 0x1e, 0xdb, 0xab, 0xd8, 0x3b, 0xdb, 0x9d, 0x71, 0x14, 0x13, 0x71,
+	0x81, 0x13, 0xe2, 0x00, 0x12, 0x12, 0x47, 0xfe, 0x02, 0x8e, 0x70, 0x43, 0xdc, 0x90, 0xca, 0xad,
+	0x2a, 0x17, 0x24, 0x24, 0x84, 0x12, 0xfe, 0x10, 0xb4, 0x33, 0x63, 0xef, 0xda, 0xce, 0xc6, 0x8e,
+	0x6f, 0xde, 0x9d, 0xef, 0x7b, 0xef, 0x7b, 0x3f, 0x77, 0x0c, 0xb7, 0x2c, 0xd3, 0x6a, 0xd5, 0x89,
+	0xab, 0x59, 0xcc, 0xb6, 0x6b, 0xd8, 0x3e, 0xf2, 0x88, 0xe3, 0x32, 0xed, 0xb8, 0xa8, 0x3d, 0x6b,
+	0x62, 0xbf, 0x55, 0xf0, 0x7c, 0xc2, 0x08, 0xca, 0x48, 0x54, 0xa1, 0x0b, 0x55, 0x38, 0x2e, 0x66,
+	0xd3, 0x55, 0x52, 0x25, 0x1c, 0xa4, 0x05, 0xbf, 0x04, 0x3e, 0xbb, 0x60, 0x13, 0xda, 0x20, 0xd4,
+	0x10, 0x07, 0xe2, 0x41, 0x1e, 0x2d, 0x55, 0x09, 0xa9, 0xd6, 0xb1, 0x66, 0x7a, 0x8e, 0x66, 0xba,
+	0x2e, 0x61, 0x26, 0x73, 0x88, 0xdb, 0x3e, 0xbd, 0x2b, 0xb0, 0x9a, 0x65, 0x52, 0x2c, 0x14, 0x68,
+	0xc7, 0x45, 0x0b, 0x33, 0xb3, 0xa8, 0x79, 0x66, 0xd5, 0x71, 0x39, 0x58, 0x62, 0x6f, 0xc7, 0x4a,
+	0xf7, 0x4c, 0xdf, 0x6c, 0xb4, 0x4d, 0xde, 0x8b, 0x85, 0x75, 0x07, 0xc3, 0xd1, 0x6a, 0x1a, 0xd0,
+	0x93, 0xc0, 0xed, 0x3e, 0x37, 0xa1, 0xe3, 0x67, 0x4d, 0x4c, 0x99, 0xfa, 0x29, 0xcc, 0x77, 0xbd,
+	0xa5, 0x1e, 0x71, 0x29, 0x46, 0xef, 0xc2, 0xa4, 0x70, 0x95, 0x51, 0xf2, 0xca, 0xda, 0xcc, 0x46,
+	0xbe, 0x10, 0x97, 0xa7, 0x82, 0x60, 0x96, 0x92, 0xcf, 0xff, 0x59, 0x1e, 0xd3, 0x25, 0x4b, 0x7d,
+	0x07, 0x6e, 0x72, 0xb3, 0x25, 0x66, 0xef, 0x76, 0xd0, 0x8f, 0xdd, 0x0a, 0x91, 0x7e, 0xd1, 0x22,
+	0xa4, 0xb0, 0x47, 0xec, 0x9a, 0xe1, 0x36, 0x1b, 0xdc, 0x47, 0x52, 0x9f, 0xe6, 0x2f, 0x3e, 0x69,
+	0x36, 0x54, 0x07, 0x72, 0x71, 0x6c, 0xa9, 0xef, 0x11, 0x24, 0x1d, 0xb7, 0x42, 0xa4, 0xba, 0xcd,
+	0x78, 0x75, 0xa5, 0xc3, 0xdd, 0x8b, 0x4d, 0xe8, 0xdc, 0x80, 0x5a, 0xbb, 0xc8, 0x15, 0x8d, 0x2a,
+	0xdd, 0x03, 0x08, 0x0b, 0x24, 0x1d, 0xae, 0x16, 0x64, 0xe5, 0x83, 0x6a, 0x16, 0x44, 0x3f, 0xc9,
+	0x6a, 0x16, 0xf6, 0xcd, 0x2a, 0x96, 0x5c, 0x3d, 0xc2, 0x54, 0x7f, 0x55, 0x60, 0x39, 0xd6, 0x95,
+	0x0c, 0x6b, 0x1f, 0x52, 0x81, 0x2a, 0xa3, 0xee, 0x50, 0x96, 0x51, 0xf2, 0xe3, 0xa3, 0xc6, 0x36,
+	0x1d, 0x58, 0xf9, 0xd8, 0xa1, 0x0c, 0x3d, 0xea, 0x52, 0x9f, 0xe0, 0xea, 0xef, 0x0c, 0x54, 0x2f,
+	0xcd, 0x44, 0xe5, 0xef, 0xc0, 0x12, 0x57, 0xff, 0x41, 0x50, 0xa4, 0x83, 0xa6, 0xd5, 0x70, 0x28,
+	0x0d, 0xda, 0x7b, 0xa8, 0x82, 0x96, 0x65, 0x3b, 0xf4, 0x93, 0x65, 0xe0, 0xbb, 0x90, 0x3c, 0xc2,
+	0x2d, 0x2a, 0x63, 0xd6, 0xe2, 0x63, 0x0e, 0xc9, 0x1f, 0xe1, 0x56, 0x58, 0xcb, 0x80, 0xac, 0xfe,
+	0x91, 0x84, 0x85, 0xd8, 0x9c, 0xa0, 0x15, 0xb8, 0xd6, 0x11, 0x68, 0x61, 0x5f, 0x6a, 0x9c, 0x69,
+	0x6b, 0xb4, 0xb0, 0x8f, 0xf6, 0x20, 0x6f, 0x61, 0xca, 0x0c, 0xda, 0x71, 0x62, 0x58, 0xcc, 0x36,
+	0xac, 0x3a, 0xb1, 0x8f, 0x8c, 0x1a, 0x76, 0xaa, 0x35, 0xc6, 0x53, 0x38, 0xab, 0x2f, 0x05, 0xb8,
+	0x50, 0x4b, 0x89, 0xd9, 0xa5, 0x00, 0xf4, 0x21, 0xc7, 0xa0, 0x12, 0xe4, 0x2e, 0xb1, 0x63, 0xd2,
+	0x5a, 0x66, 0x3c, 0xaf, 0xac, 0xa5, 0xf4, 0x6c, 0x8c, 0x15, 0x93, 0xd6, 0x10, 0x85, 0xa5, 0x5e,
+	0x1b, 0xcc, 0x37, 0x5d, 0x6a, 0xda, 0x7c, 0xab, 0x64, 0x92, 0x3c, 0x53, 0xc5, 0xf8, 0x4c, 0x1d,
+	0x86, 0xe8, 0xae, 0xde, 0xe8, 0x71, 0x1a, 0x81, 0x51, 0xf4, 0xb5, 0x02, 0xab, 0xbd, 0x5e, 0x8f,
+	0x9d, 0xaa, 0x53, 0x37, 0x5d, 0x86, 0x0d, 0xb3, 0x5c, 0xf6, 0x31, 0xa5, 0xa2, 0x3b, 0x27, 0xb8,
+	0xff, 0x37, 0xe3, 0xfd, 0x87, 0x65, 0x78, 0x28, 0x78, 0xb8, 0x53, 0x6e, 0x5d, 0xed, 0xd6, 0xf0,
+	0xb4, 0xed, 0x42, 0x22, 0x79, 0xe7, 0xde, 0x87, 0xd7, 0x82, 0xa4, 0xd9, 0xc4, 0xad, 0x38, 0x7e,
+	0x83, 0x37, 0xa1, 0x51, 0xc6, 0x1e, 0xab, 0x65, 0x26, 0x79, 0x09, 0xd2, 0x16, 0xb3, 0x77, 0x23,
+	0x87, 0xef, 0x07, 0x67, 0x68, 0x0f, 0x96, 0x43, 0x19, 0x46, 0xc5, 0x71, 0xcd, 0xba, 0xf3, 0xb9,
+	0x20, 0x33, 0xa7, 0x81, 0x49, 0x93, 0x65, 0xa6, 0x38, 0xfd, 0x66, 0x08, 0xdb, 0x8b, 0xa0, 0x0e,
+	0x05, 0x48, 0x3d, 0x85, 0xd7, 0x63, 0x12, 0x88, 0xd2, 0x30, 0xe1, 0xb8, 0x65, 0x7c, 0xc2, 0x3b,
+	0x68, 0x56, 0x17, 0x0f, 0x08, 0x41, 0x92, 0x57, 0x36, 0xc1, 0x2b, 0xcb, 0x7f, 0xa3, 0x3c, 0xcc,
+	0x44, 0x6a, 0x26, 0x8b, 0x1e, 0x7d, 0x15, 0xd8, 0xf2, 0x7c, 0x42, 0x2a, 0x99, 0x24, 0x3f, 0x13,
+	0x0f, 0xea, 0x37, 0x0a, 0x2c, 0x5e, 0x92, 0x3e, 0xf4, 0x00, 0x52, 0xbc, 0x40, 0x8c, 0xc9, 0x3e,
+	0x4e, 0x95, 0x32, 0x2f, 0x7f, 0x5e, 0x4f, 0xcb, 0xb1, 0x96, 0x84, 0x03, 0xe6, 0x3b, 0x6e, 0x55,
+	0x0f, 0xa1, 0xe8, 0x3e, 0x4c, 0xfb, 0xd8, 0x23, 0x7e, 0x40, 0x4b, 0x0c, 0xa0, 0x75, 0x90, 0xea,
+	0xef, 0x0a, 0xbc, 0x7a, 0xe1, 0xd8, 0xa1, 0x75, 0x98, 0xaf, 0x38, 0x3e, 0x65, 0x06, 0x3b, 0x89,
+	0x36, 0x37, 0x57, 0xa4, 0x5f, 0xe7, 0x47, 0x87, 0x27, 0x61, 0x4b, 0xdf, 0x82, 0xb9, 0x0e, 0x5c,
+	0x64, 0x50, 0x0c, 0xd3, 0x35, 0x89, 0x7c, 0xcc, 0x13, 0xa9, 0x41, 0x9a, 0x62, 0x9b, 0xb8, 0xe5,
+	0x1e, 0xab, 0x22, 0x7b, 0x37, 0xc4, 0x59, 0xd4, 0xec, 0x2a, 0xbc, 0x12, 0x12, 0x84, 0xdd, 0x24,
+	0xb7, 0x3b, 0xdb, 0xc6, 0x72, 0xc3, 0xea, 0x8f, 0x0a, 0xe4, 0xf9, 0x16, 0x8a, 0x2c, 0xa0, 0x52,
+	0x4b, 0x46, 0xdd, 0x5e, 0x63, 0x1b, 0x30, 0x25, 0xdb, 0x7c, 0x60, 0x62, 0xdb, 0xc0, 0x9e, 0x2f,
+	0x44, 0x62, 0xe4, 0x2f, 0xc4, 0xdf, 0x09, 0xc8, 0x84, 0xda, 0x74, 0x6c, 0x13, 0xbf, 0xdc, 0xc9,
+	0xf5, 0x53, 0x98, 0x8b, 0x0c, 0xe5, 0x11, 0x6e, 0xc9, 0x4f, 0xd1, 0x95, 0x77, 0xe5, 0x2c, 0x8d,
+	0xbe, 0xee, 0xde, 0xdb, 0x89, 0xee, 0xbd, 0x8d, 0x2a, 0x30, 0xdf, 0x37, 0xfe, 0x98, 0xf2, 0x52,
+	0x8c, 0x3c, 0xfb, 0xe8, 0xb8, 0x67, 0xda, 0x31, 0x45, 0x25, 0x98, 0xa4, 0xcc, 0x64, 0x4d, 0xca,
+	0x2b, 0x37, 0xb7, 0x71, 0x77, 0x98, 0xa0, 0x0e, 0x38, 0x43, 0x97, 0x4c, 0x94, 0x83, 0x99, 0x60,
+	0x5f, 0x54, 0x30, 0x36, 0xa8, 0x19, 0xec, 0xa7, 0x20, 0x94, 0x94, 0xc5, 0xec, 0x3d, 0x8c, 0x0f,
+	0x4c, 0xa6, 0x7e, 0x95, 0x80, 0x95, 0x4b, 0xca, 0x2f, 0xd3, 0x7c, 0x08, 0x33, 0x61, 0x7e, 0xda,
+	0xdf, 0xa3, 0x8d, 0x61, 0xe4, 0x74, 0xd7, 0x4b, 0x8f, 0x9a, 0x41, 0xef, 0xc1, 0x44, 0xa0, 0x92,
+	0xca, 0xe6, 0x78, 0x63, 0xd8, 0xf0, 0xa8, 0x2e, 0x78, 0x3d, 0x9f, 0xf1, 0xf1, 0x91, 0x3f, 0xe3,
+	0x1b, 0x3f, 0x4c, 0xc1, 0x04, 0xcf, 0x02, 0xfa, 0x56, 0x81, 0x49, 0x71, 0x77, 0x43, 0xf7, 0xe2,
+	0xf5, 0xf4, 0x5f, 0x19, 0xb3, 0xeb, 0x43, 0xa2, 0x85, 0x77, 0x75, 0xed, 0xcb, 0x3f, 0xff, 0xfb,
+	0x3e, 0xa1, 0xa2, 0xbc, 0x36, 0xe0, 0x56, 0x8b, 0x7e, 0x51, 0xe0, 0x46, 0xdf, 0x95, 0x0f, 0x6d,
+	0x0d, 0x70, 0x17, 0x77, 0xc5, 0xcc, 0xbe, 0x75, 0x75, 0xa2, 0x94, 0xbc, 0xce, 0x25, 0xdf, 0x41,
+	0xb7, 0xe3, 0x25, 0x9f, 0x76, 0x86, 0xe6, 0x0b, 0xf4, 0x93, 0x02, 0xa8, 0xff, 0x52, 0x87, 0xae,
+	0xe4, 0x3f, 0x7a, 0xe5, 0xcc, 0x6e, 0x8f, 0xc0, 0x94, 0xd2, 0x57, 0xb8, 0xf4, 0x45, 0xb4, 0x10,
+	0x2b, 0x1d, 0xfd, 0xa6, 0xc0, 0xf5, 0xde, 0x8b, 0x18, 0x7a, 0x30, 0xc0, 0x65, 0xcc, 0xb5, 0x2f,
+	0xbb, 0x75, 0x65, 0x9e, 0x14, 0xba, 0xcd, 0x85, 0x6e, 0xa2, 0xe2, 0x50, 0x39, 0xd6, 0xa2, 0xd3,
+	0xf4, 0x52, 0x81, 0xf4, 0x45, 0x43, 0x8c, 0xde, 0x1e, 0x20, 0xe6, 0x92, 0xc5, 0x9f, 0xdd, 0x19,
+	0x89, 0x2b, 0x83, 0x79, 0xc8, 0x83, 0xd9, 0x41, 0xdb, 0xf1, 0xc1, 0x74, 0xb6, 0xa7, 0x76, 0x2a,
+	0x7f, 0x76, 0x05, 0x55, 0x7a, 0xf2, 0xfc, 0x2c, 0xa7, 0xbc, 0x38, 0xcb, 0x29, 0xff, 0x9e, 0xe5,
+	0x94, 0xef, 0xce, 0x73, 0x63, 0x2f, 0xce, 0x73, 0x63, 0x7f, 0x9d, 0xe7, 0xc6, 0x3e, 0xdb, 0xaa,
+	0x3a, 0xac, 0xd6, 0xb4, 0x0a, 0x36, 0x69, 0xb4, 0xcd, 0xd7, 0x4d, 0x8b, 0xae, 0x3b, 0xa4, 0xe3,
+	0xed, 0xa4, 0xc7, 0x1f, 0x6b, 0x79, 0x98, 0x5a, 0x93, 0xfc, 0x8f, 0xdf, 0xe6, 0xff, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0xa0, 0x42, 0x1a, 0xba, 0x0a, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -803,6 +1049,9 @@ type QueryClient interface {
 	BtcCheckpointsInfo(ctx context.Context, in *QueryBtcCheckpointsInfoRequest, opts ...grpc.CallOption) (*QueryBtcCheckpointsInfoResponse, error)
 	// EpochSubmissions returns all submissions for a given epoch
 	EpochSubmissions(ctx context.Context, in *QueryEpochSubmissionsRequest, opts ...grpc.CallOption) (*QueryEpochSubmissionsResponse, error)
+	// SubmissionsByAddress returns all checkpoint submissions made or reported
+	// by a given address, together with their statistics
+	SubmissionsByAddress(ctx context.Context, in *QuerySubmissionsByAddressRequest, opts ...grpc.CallOption) (*QuerySubmissionsByAddressResponse, error)
 }
 
 type queryClient struct {
@@ -849,6 +1098,15 @@ func (c *queryClient) EpochSubmissions(ctx context.Context, in *QueryEpochSubmis
 	return out, nil
 }
 
+func (c *queryClient) SubmissionsByAddress(ctx context.Context, in *QuerySubmissionsByAddressRequest, opts ...grpc.CallOption) (*QuerySubmissionsByAddressResponse, error) {
+	out := new(QuerySubmissionsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btccheckpoint.v1.Query/SubmissionsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -859,6 +1117,9 @@ type QueryServer interface {
 	BtcCheckpointsInfo(context.Context, *QueryBtcCheckpointsInfoRequest) (*QueryBtcCheckpointsInfoResponse, error)
 	// EpochSubmissions returns all submissions for a given epoch
 	EpochSubmissions(context.Context, *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error)
+	// SubmissionsByAddress returns all checkpoint submissions made or reported
+	// by a given address, together with their statistics
+	SubmissionsByAddress(context.Context, *QuerySubmissionsByAddressRequest) (*QuerySubmissionsByAddressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -877,6 +1138,9 @@ func (*UnimplementedQueryServer) BtcCheckpointsInfo(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) EpochSubmissions(ctx context.Context, req *QueryEpochSubmissionsRequest) (*QueryEpochSubmissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EpochSubmissions not implemented")
 }
+func (*UnimplementedQueryServer) SubmissionsByAddress(ctx context.Context, req *QuerySubmissionsByAddressRequest) (*QuerySubmissionsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmissionsByAddress not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -954,6 +1218,24 @@ func _Query_EpochSubmissions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SubmissionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubmissionsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubmissionsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btccheckpoint.v1.Query/SubmissionsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubmissionsByAddress(ctx, req.(*QuerySubmissionsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btccheckpoint.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -974,6 +1256,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EpochSubmissions",
 			Handler:    _Query_EpochSubmissions_Handler,
 		},
+		{
+			MethodName: "SubmissionsByAddress",
+			Handler:    _Query_SubmissionsByAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btccheckpoint/v1/query.proto",
@@ -1267,6 +1553,16 @@ func (m *BTCCheckpointInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.CheckpointFinalizationTimeout != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckpointFinalizationTimeout))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BtcConfirmationDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcConfirmationDepth))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.BestSubmissionVigilanteAddressList) > 0 {
 		for iNdEx := len(m.BestSubmissionVigilanteAddressList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1448,90 +1744,255 @@ func (m *SubmissionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySubmissionsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySubmissionsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBtcCheckpointInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySubmissionsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBtcCheckpointInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Info != nil {
-		l = m.Info.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *SubmissionRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBtcCheckpointsInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *SubmissionRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBtcCheckpointsInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SubmissionRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.InfoList) > 0 {
-		for _, e := range m.InfoList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BtcFeeSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcFeeSat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VigilanteAddresses != nil {
+		{
+			size, err := m.VigilanteAddresses.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SubmissionKey != nil {
+		{
+			size, err := m.SubmissionKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubmissionsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubmissionsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubmissionsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Stats != nil {
+		{
+			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submissions) > 0 {
+		for iNdEx := len(m.Submissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Submissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBtcCheckpointInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryBtcCheckpointInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBtcCheckpointsInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBtcCheckpointsInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InfoList) > 0 {
+		for _, e := range m.InfoList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -1591,6 +2052,12 @@ func (m *BTCCheckpointInfoResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.BtcConfirmationDepth != 0 {
+		n += 1 + sovQuery(uint64(m.BtcConfirmationDepth))
+	}
+	if m.CheckpointFinalizationTimeout != 0 {
+		n += 1 + sovQuery(uint64(m.CheckpointFinalizationTimeout))
+	}
 	return n
 }
 
@@ -1658,6 +2125,72 @@ func (m *SubmissionKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySubmissionsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmissionRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmissionKey != nil {
+		l = m.SubmissionKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.VigilanteAddresses != nil {
+		l = m.VigilanteAddresses.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.BtcFeeSat != 0 {
+		n += 1 + sovQuery(uint64(m.BtcFeeSat))
+	}
+	return n
+}
+
+func (m *QuerySubmissionsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Submissions) > 0 {
+		for _, e := range m.Submissions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2478,6 +3011,44 @@ func (m *BTCCheckpointInfoResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcConfirmationDepth", wireType)
+			}
+			m.BtcConfirmationDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcConfirmationDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointFinalizationTimeout", wireType)
+			}
+			m.CheckpointFinalizationTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointFinalizationTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2930,6 +3501,459 @@ func (m *SubmissionKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySubmissionsByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubmissionsByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubmissionsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmissionKey == nil {
+				m.SubmissionKey = &SubmissionKeyResponse{}
+			}
+			if err := m.SubmissionKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VigilanteAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VigilanteAddresses == nil {
+				m.VigilanteAddresses = &CheckpointAddressesResponse{}
+			}
+			if err := m.VigilanteAddresses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= SubmissionStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcFeeSat", wireType)
+			}
+			m.BtcFeeSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcFeeSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubmissionsByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubmissionsByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubmissionsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submissions = append(m.Submissions, &SubmissionRecordResponse{})
+			if err := m.Submissions[len(m.Submissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &SubmissionStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"github.com/btcsuite/btcd/wire"
+)
+
+// NewSubmissionRecord creates a pending record of the given submission
+func NewSubmissionRecord(sk SubmissionKey, sd *SubmissionData) *SubmissionRecord {
+	return &SubmissionRecord{
+		SubmissionKey:      &sk,
+		Epoch:              sd.Epoch,
+		VigilanteAddresses: sd.VigilanteAddresses,
+		Status:             SubmissionPending,
+		BtcFeeSat:          GetImpliedBTCFee(sd.TxsInfo),
+	}
+}
+
+// GetImpliedBTCFee returns the sum of fees of the given transactions whose
+// inputs all spend outputs of other given transactions. In a checkpoint
+// submission, this is typically the fee of the second transaction which spends
+// the change output of the first one. Fees of other transactions cannot be
+// derived as values of their inputs are unknown. Transactions that cannot be
+// parsed are ignored.
+func GetImpliedBTCFee(txsInfo []*TransactionInfo) uint64 {
+	txs := make([]*wire.MsgTx, 0, len(txsInfo))
+	outputValues := make(map[wire.OutPoint]int64)
+	for _, txInfo := range txsInfo {
+		tx, err := ParseTransaction(txInfo.Transaction)
+		if err != nil {
+			continue
+		}
+		txs = append(txs, tx.MsgTx())
+		for i, txOut := range tx.MsgTx().TxOut {
+			outputValues[*wire.NewOutPoint(tx.Hash(), uint32(i))] = txOut.Value
+		}
+	}
+
+	var fee uint64
+	for _, tx := range txs {
+		var inputValue int64
+		spendsKnownOutputs := true
+		for _, txIn := range tx.TxIn {
+			value, ok := outputValues[txIn.PreviousOutPoint]
+			if !ok {
+				spendsKnownOutputs = false
+				break
+			}
+			inputValue += value
+		}
+		if !spendsKnownOutputs {
+			continue
+		}
+
+		var outputValue int64
+		for _, txOut := range tx.TxOut {
+			outputValue += txOut.Value
+		}
+		if inputValue > outputValue {
+			fee += uint64(inputValue - outputValue)
+		}
+	}
+	return fee
+}
+
+// IsFinal returns whether the outcome of the submission is already decided
+func (r *SubmissionRecord) IsFinal() bool {
+	return r.Status != SubmissionPending
+}
+
+// ToResponse parses a SubmissionRecord into a query response submission record struct.
+func (r *SubmissionRecord) ToResponse() (*SubmissionRecordResponse, error) {
+	skr, err := NewSubmissionKeyResponse(*r.SubmissionKey)
+	if err != nil {
+		return nil, err
+	}
+	return &SubmissionRecordResponse{
+		SubmissionKey:      skr,
+		EpochNum:           r.Epoch,
+		VigilanteAddresses: r.VigilanteAddresses.ToResponse(),
+		Status:             r.Status,
+		BtcFeeSat:          r.BtcFeeSat,
+	}, nil
+}
+
+// AddRecord accounts the given submission record into the statistics
+func (s *SubmissionStats) AddRecord(r *SubmissionRecord) {
+	s.Total++
+	*s.statusCount(r.Status)++
+	s.TotalBtcFeeSat += r.BtcFeeSat
+}
+
+// UpdateStatus moves a submission accounted into the statistics from its
+// previous status to the given one
+func (s *SubmissionStats) UpdateStatus(prevStatus SubmissionStatus, status SubmissionStatus) {
+	*s.statusCount(prevStatus)--
+	*s.statusCount(status)++
+}
+
+// statusCount returns the counter of submissions with the given status
+func (s *SubmissionStats) statusCount(status SubmissionStatus) *uint64 {
+	switch status {
+	case SubmissionBest:
+		return &s.Best
+	case SubmissionNotBest:
+		return &s.NotBest
+	case SubmissionForgotten:
+		return &s.Forgotten
+	default:
+		return &s.Pending
+	}
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+)
+
+func FuzzGetImpliedBTCFee(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// the first tx spends an unknown output
+		tx1 := wire.NewMsgTx(2)
+		prevHash, err := chainhash.NewHash(datagen.GenRandomByteArray(r, chainhash.HashSize))
+		require.NoError(t, err)
+		tx1.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0), nil, nil))
+		changeValue := int64(datagen.RandomInt(r, 100000)) + 10000
+		tx1.AddTxOut(wire.NewTxOut(changeValue, datagen.GenRandomByteArray(r, 22)))
+
+		// the second tx spends the change output of the first tx
+		tx2 := wire.NewMsgTx(2)
+		tx1Hash := tx1.TxHash()
+		tx2.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&tx1Hash, 0), nil, nil))
+		fee := int64(datagen.RandomInt(r, 10000)) + 1
+		tx2.AddTxOut(wire.NewTxOut(changeValue-fee, datagen.GenRandomByteArray(r, 22)))
+
+		txsInfo := make([]*btcctypes.TransactionInfo, 2)
+		for i, tx := range []*wire.MsgTx{tx1, tx2} {
+			txBytes, err := bbn.SerializeBTCTx(tx)
+			require.NoError(t, err)
+			txsInfo[i] = &btcctypes.TransactionInfo{Transaction: txBytes}
+		}
+
+		// only the fee of the second tx can be derived
+		require.Equal(t, uint64(fee), btcctypes.GetImpliedBTCFee(txsInfo))
+		// the fee of the second tx cannot be derived without the first tx
+		require.Zero(t, btcctypes.GetImpliedBTCFee(txsInfo[1:]))
+	})
+}
+
+func TestSubmissionStats(t *testing.T) {
+	stats := &btcctypes.SubmissionStats{}
+	stats.AddRecord(&btcctypes.SubmissionRecord{Status: btcctypes.SubmissionPending, BtcFeeSat: 10})
+	stats.AddRecord(&btcctypes.SubmissionRecord{Status: btcctypes.SubmissionPending, BtcFeeSat: 20})
+	stats.AddRecord(&btcctypes.SubmissionRecord{Status: btcctypes.SubmissionPending})
+	require.Equal(t, &btcctypes.SubmissionStats{Total: 3, Pending: 3, TotalBtcFeeSat: 30}, stats)
+
+	stats.UpdateStatus(btcctypes.SubmissionPending, btcctypes.SubmissionBest)
+	stats.UpdateStatus(btcctypes.SubmissionPending, btcctypes.SubmissionNotBest)
+	stats.UpdateStatus(btcctypes.SubmissionPending, btcctypes.SubmissionForgotten)
+	require.Equal(t, &btcctypes.SubmissionStats{Total: 3, Best: 1, NotBest: 1, Forgotten: 1, TotalBtcFeeSat: 30}, stats)
+}
@@ -123,6 +123,26 @@ func NewEmptyEpochData() EpochData {
 	}
 }
 
+// HasParamsSnapshot returns whether the params in effect when the first
+// submission of the epoch was accepted are recorded in the epoch data
+func (s *EpochData) HasParamsSnapshot() bool {
+	return s.BtcConfirmationDepth > 0 && s.CheckpointFinalizationTimeout > 0
+}
+
+// SetParamsSnapshot records the given params used for the status transitions
+// of the epoch
+func (s *EpochData) SetParamsSnapshot(p Params) {
+	s.BtcConfirmationDepth = p.BtcConfirmationDepth
+	s.CheckpointFinalizationTimeout = p.CheckpointFinalizationTimeout
+}
+
+// ClearParamsSnapshot removes the recorded params, so that the params in effect
+// when the epoch gets a new first submission are recorded again
+func (s *EpochData) ClearParamsSnapshot() {
+	s.BtcConfirmationDepth = 0
+	s.CheckpointFinalizationTimeout = 0
+}
+
 func (s *EpochData) AppendKey(k SubmissionKey) {
 	key := &k
 	s.Keys = append(s.Keys, key)
@@ -228,7 +248,7 @@ func (ti *TransactionInfo) VerifyInclusion(btcHeader *types.BTCHeaderBytes, powL
 		return err
 	}
 
-	if !verify(tx, &header.MerkleRoot, ti.Proof, ti.Key.Index) {
+	if !types.VerifyInclusionProof(tx, &header.MerkleRoot, ti.Proof, ti.Key.Index) {
 		return fmt.Errorf("header failed validation due to failed proof")
 	}
 
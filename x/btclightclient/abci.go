@@ -0,0 +1,13 @@
+package btclightclient
+
+import (
+	"context"
+
+	"github.com/babylonlabs-io/babylon/x/btclightclient/keeper"
+)
+
+// EndBlocker prunes headers deeper than the pruning depth, if pruning is
+// enabled
+func EndBlocker(ctx context.Context, k keeper.Keeper) {
+	k.PruneHeaders(ctx)
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -27,6 +28,11 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdTip())
 	cmd.AddCommand(CmdBaseHeader())
 	cmd.AddCommand(CmdHeaderDepth())
+	cmd.AddCommand(CmdRecentReorgs())
+	cmd.AddCommand(CmdVerifyTxInclusion())
+	cmd.AddCommand(CmdHeadersByHeightRange())
+	cmd.AddCommand(CmdBlockLocator())
+	cmd.AddCommand(CmdHeaderAccumulator())
 
 	return cmd
 }
@@ -198,3 +204,160 @@ func CmdHeaderDepth() *cobra.Command {
 
 	return cmd
 }
+
+func CmdRecentReorgs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recent-reorgs",
+		Short: "retrieve the recent reorgs of the bitcoin blockchain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryRecentReorgsRequest(pageReq)
+			res, err := queryClient.RecentReorgs(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "recent-reorgs")
+
+	return cmd
+}
+
+func CmdVerifyTxInclusion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-tx-inclusion [tx-hex] [block-hash-hex] [tx-index] [merkle-proof-hex]",
+		Short: "verify that a tx is included in the block with the given hash and retrieve the block depth",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			txIndex, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			params, err := types.NewQueryVerifyTxInclusionRequest(args[0], args[1], uint32(txIndex), args[3])
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.VerifyTxInclusion(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdHeadersByHeightRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "headers-by-height-range [start-height] [end-height]",
+		Short: "retrieve the main chain headers with heights in the given inclusive range",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryHeadersByHeightRangeRequest(uint32(startHeight), uint32(endHeight))
+			res, err := queryClient.HeadersByHeightRange(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdBlockLocator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-locator [hex-hash]...",
+		Short: "retrieve the fork point of the block locator with the main chain and the headers following it",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			limit, err := cmd.Flags().GetUint32(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			params, err := types.NewQueryBlockLocatorRequest(args, limit)
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.BlockLocator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32(flags.FlagLimit, 0, "maximum number of headers to return after the fork point")
+
+	return cmd
+}
+
+func CmdHeaderAccumulator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "header-accumulator",
+		Short: "retrieve the accumulator committing to the pruned headers of the bitcoin blockchain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := types.NewQueryHeaderAccumulatorRequest()
+			res, err := queryClient.HeaderAccumulator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
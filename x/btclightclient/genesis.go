@@ -19,12 +19,22 @@ func InitGenesis(ctx context.Context, k keeper.Keeper, gs types.GenesisState) {
 	}
 
 	k.InsertHeaderInfos(ctx, gs.BtcHeaders)
+
+	if gs.HeaderAccumulator != nil {
+		k.SetHeaderAccumulator(ctx, gs.HeaderAccumulator)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx context.Context, k keeper.Keeper) *types.GenesisState {
-	return &types.GenesisState{
+	gs := &types.GenesisState{
 		Params:     k.GetParams(ctx),
 		BtcHeaders: k.GetMainChainFrom(ctx, 0),
 	}
+
+	if acc := k.GetHeaderAccumulator(ctx); acc.NumLeaves > 0 {
+		gs.HeaderAccumulator = acc
+	}
+
+	return gs
 }
@@ -21,6 +21,7 @@ import (
 func TestGenesis(t *testing.T) {
 	baseHeaderInfo := types.SimnetGenesisBlock()
 	genesisState := types.GenesisState{
+		Params:     types.DefaultParams(),
 		BtcHeaders: []*types.BTCHeaderInfo{&baseHeaderInfo},
 	}
 
@@ -2,8 +2,14 @@ package keeper
 
 import (
 	"context"
+
+	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
 )
 
 func (k *Keeper) HeadersState(ctx context.Context) headersState {
 	return k.headersState(ctx)
 }
+
+func (k *Keeper) RecordReorg(ctx context.Context, oldTip, newTip, forkPoint *types.BTCHeaderInfo) *types.BTCReorgRecord {
+	return k.recordReorg(ctx, oldTip, newTip, forkPoint)
+}
@@ -6,6 +6,8 @@ import (
 
 	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
@@ -215,3 +217,155 @@ func (k Keeper) HeaderDepth(ctx context.Context, req *types.QueryHeaderDepthRequ
 
 	return &types.QueryHeaderDepthResponse{Depth: depth}, nil
 }
+
+func (k Keeper) RecentReorgs(ctx context.Context, req *types.QueryRecentReorgsRequest) (*types.QueryRecentReorgsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// the reorg records are keyed by an increasing sequence number, so the
+	// store is iterated in reverse to return the most recent reorgs first
+	pagination := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pagination = *req.Pagination
+	}
+	pagination.Reverse = !pagination.Reverse
+
+	var reorgs []*types.BTCReorgRecordResponse
+	store := k.reorgStore(sdkCtx)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var record types.BTCReorgRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		reorgs = append(reorgs, record.ToResponse())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecentReorgsResponse{Reorgs: reorgs, Pagination: pageRes}, nil
+}
+
+func (k Keeper) VerifyTxInclusion(ctx context.Context, req *types.QueryVerifyTxInclusionRequest) (*types.QueryVerifyTxInclusionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tx, err := btcutil.NewTxFromBytes(req.Tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "provided tx is not a valid BTC transaction")
+	}
+
+	headerHash, err := bbn.NewBTCHeaderHashBytesFromHex(req.BlockHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "provided hash is not a valid hex string")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if req.AccumulatorProof != nil {
+		// the block was pruned, so its header is proven against the accumulator
+		if req.Header == nil || !req.Header.Hash().Eq(&headerHash) {
+			return nil, status.Error(codes.InvalidArgument, "provided header does not match the block hash")
+		}
+		info, err := k.GetPrunedTxInclusionInfo(sdkCtx, tx, req.Header, req.AccumulatorProof, req.MerkleProof, req.TxIndex)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return info.ToResponse(), nil
+	}
+
+	info, err := k.GetTxInclusionInfo(sdkCtx, tx, &headerHash, req.MerkleProof, req.TxIndex)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return info.ToResponse(), nil
+}
+
+func (k Keeper) HeadersByHeightRange(ctx context.Context, req *types.QueryHeadersByHeightRangeRequest) (*types.QueryHeadersByHeightRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.StartHeight > req.EndHeight {
+		return nil, status.Error(codes.InvalidArgument, "start height is larger than end height")
+	}
+
+	if uint64(req.EndHeight)-uint64(req.StartHeight)+1 > uint64(MaxHeadersPerRequest) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("height range is larger than the maximum limit of %d", MaxHeadersPerRequest))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	headers := make([]*types.BTCHeaderInfo, 0)
+	k.headersState(sdkCtx).IterateForwardHeaders(req.StartHeight, func(header *types.BTCHeaderInfo) bool {
+		if header.Height > req.EndHeight {
+			return true
+		}
+		headers = append(headers, header)
+		return false
+	})
+
+	return &types.QueryHeadersByHeightRangeResponse{Headers: types.ParseBTCHeadersToResponse(headers)}, nil
+}
+
+func (k Keeper) BlockLocator(ctx context.Context, req *types.QueryBlockLocatorRequest) (*types.QueryBlockLocatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Locator) > wire.MaxBlockLocatorsPerMsg {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("locator is larger than the maximum size of %d", wire.MaxBlockLocatorsPerMsg))
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	if limit > MaxHeadersPerRequest {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("limit is larger than the maximum limit of %d", MaxHeadersPerRequest))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	state := k.headersState(sdkCtx)
+
+	// the fork point is the first header of the locator we know about, as the
+	// light client only maintains headers of the main chain
+	var forkPoint *types.BTCHeaderInfo
+	for _, hashHex := range req.Locator {
+		hash, err := bbn.NewBTCHeaderHashBytesFromHex(hashHex)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "provided hash is not a valid hex string")
+		}
+		header, err := state.GetHeaderByHash(&hash)
+		if err == nil {
+			forkPoint = header
+			break
+		}
+	}
+	if forkPoint == nil {
+		forkPoint = state.BaseHeader()
+	}
+
+	headers := k.GetMainChainFromWithLimit(sdkCtx, forkPoint.Height+1, limit)
+
+	return &types.QueryBlockLocatorResponse{
+		ForkPoint: forkPoint.ToResponse(),
+		Headers:   types.ParseBTCHeadersToResponse(headers),
+	}, nil
+}
+
+func (k Keeper) HeaderAccumulator(ctx context.Context, req *types.QueryHeaderAccumulatorRequest) (*types.QueryHeaderAccumulatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryHeaderAccumulatorResponse{Accumulator: *k.GetHeaderAccumulator(sdkCtx)}, nil
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
+	"github.com/babylonlabs-io/babylon/x/btclightclient/keeper"
 	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
 )
 
@@ -429,3 +431,191 @@ func constructRequestWithLimit(r *rand.Rand, limit uint64) *query.PageRequest {
 func constructRequestWithKey(r *rand.Rand, key []byte) *query.PageRequest {
 	return constructRequestWithKeyAndLimit(r, key, 0)
 }
+
+func FuzzVerifyTxInclusionQuery(f *testing.F) {
+	/*
+		Checks:
+		1. If the request is nil, (nil, error) is returned
+		2. A valid proof of a tx included in a main chain block is reported as
+		   included, together with the height and the depth of the block
+		3. An invalid proof is reported as not included
+		4. A block unknown to the light client leads to an error
+
+		Data generation:
+		- Generate a random chain of headers and insert into storage
+		- Extend it with a block including a random tx, and then with a random
+		  number of headers
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+
+		// Test nil input
+		resp, err := blcKeeper.VerifyTxInclusion(ctx, nil)
+		require.Nil(t, resp)
+		require.Error(t, err)
+
+		_, chain := datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			0,
+			uint32(datagen.RandomInt(r, 50))+10,
+		)
+		tip := chain.GetTipInfo()
+
+		// insert a block including a random tx on top of the tip
+		blockWithProof := datagen.CreateBlockWithTransaction(r, tip.Header.ToBlockHeader(), datagen.GenRandomTx(r))
+		err = blcKeeper.InsertHeadersWithHookAndEvents(ctx, []bbn.BTCHeaderBytes{blockWithProof.HeaderBytes})
+		require.NoError(t, err)
+
+		// build a random number of headers on top of the block
+		depth := uint32(datagen.RandomInt(r, 10)) + 1
+		extension := datagen.GenRandomValidChainStartingFrom(r, blockWithProof.HeaderBytes.ToBlockHeader(), nil, depth)
+		err = blcKeeper.InsertHeadersWithHookAndEvents(ctx, keepertest.NewBTCHeaderBytesList(extension))
+		require.NoError(t, err)
+
+		proof := blockWithProof.SpvProof
+		req := &types.QueryVerifyTxInclusionRequest{
+			Tx:          proof.BtcTransaction,
+			BlockHash:   blockWithProof.HeaderBytes.Hash().MarshalHex(),
+			TxIndex:     proof.BtcTransactionIndex,
+			MerkleProof: proof.MerkleNodes,
+		}
+		resp, err = blcKeeper.VerifyTxInclusion(ctx, req)
+		require.NoError(t, err)
+		require.True(t, resp.Included)
+		require.Equal(t, tip.Height+1, resp.BlockHeight)
+		require.Equal(t, depth, resp.Depth)
+
+		// proof with wrong index is not valid
+		req.TxIndex = proof.BtcTransactionIndex + 1
+		resp, err = blcKeeper.VerifyTxInclusion(ctx, req)
+		require.NoError(t, err)
+		require.False(t, resp.Included)
+
+		// unknown block leads to error
+		req.BlockHash = datagen.GenRandomBtcdHash(r).String()
+		_, err = blcKeeper.VerifyTxInclusion(ctx, req)
+		require.Error(t, err)
+	})
+}
+
+func FuzzHeadersByHeightRangeQuery(f *testing.F) {
+	/*
+		Checks:
+		1. If the request is nil or the range is invalid, (nil, error) is returned
+		2. The query returns the main chain headers in the range, excluding
+		   the ones above the tip
+
+		Data generation:
+		- Generate a random chain of headers and insert into storage
+		- Generate a random range within the chain, possibly exceeding the tip
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+
+		resp, err := blcKeeper.HeadersByHeightRange(ctx, nil)
+		require.Nil(t, resp)
+		require.Error(t, err)
+
+		baseHeader, chain := datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			uint32(datagen.RandomInt(r, 100)),
+			uint32(datagen.RandomInt(r, 50))+10,
+		)
+		tip := chain.GetTipInfo()
+
+		// invalid ranges
+		_, err = blcKeeper.HeadersByHeightRange(ctx, types.NewQueryHeadersByHeightRangeRequest(tip.Height, baseHeader.Height))
+		require.Error(t, err)
+		_, err = blcKeeper.HeadersByHeightRange(ctx, types.NewQueryHeadersByHeightRangeRequest(0, keeper.MaxHeadersPerRequest))
+		require.Error(t, err)
+
+		startHeight := baseHeader.Height + uint32(datagen.RandomInt(r, chain.ChainLength()))
+		endHeight := startHeight + uint32(datagen.RandomInt(r, chain.ChainLength()))
+		resp, err = blcKeeper.HeadersByHeightRange(ctx, types.NewQueryHeadersByHeightRangeRequest(startHeight, endHeight))
+		require.NoError(t, err)
+
+		expectedEndHeight := endHeight
+		if expectedEndHeight > tip.Height {
+			expectedEndHeight = tip.Height
+		}
+		require.Len(t, resp.Headers, int(expectedEndHeight-startHeight+1))
+		for i, header := range resp.Headers {
+			require.Equal(t, startHeight+uint32(i), header.Height)
+			require.True(t, header.Eq(blcKeeper.GetHeaderByHeight(ctx, header.Height)))
+		}
+	})
+}
+
+func FuzzBlockLocatorQuery(f *testing.F) {
+	/*
+		Checks:
+		1. If the request is nil, (nil, error) is returned
+		2. The fork point is the first header of the locator in the main chain
+		3. The headers following the fork point are returned up to the limit
+		4. If no header of the locator is known, the base header is the fork point
+
+		Data generation:
+		- Generate a random chain of headers and insert into storage
+		- Generate a locator starting with unknown hashes followed by a random
+		  main chain header
+	*/
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+
+		resp, err := blcKeeper.BlockLocator(ctx, nil)
+		require.Nil(t, resp)
+		require.Error(t, err)
+
+		baseHeader, chain := datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			0,
+			uint32(datagen.RandomInt(r, 50))+10,
+		)
+		tip := chain.GetTipInfo()
+
+		// the caller is on a fork, so the most recent hashes of its locator are
+		// unknown to the light client
+		var locator []string
+		numUnknown := int(datagen.RandomInt(r, 10))
+		for i := 0; i < numUnknown; i++ {
+			locator = append(locator, datagen.GenRandomBtcdHash(r).String())
+		}
+		forkPoint := chain.GetRandomHeaderInfo(r)
+		locator = append(locator, forkPoint.Hash.MarshalHex(), baseHeader.Hash.MarshalHex())
+
+		limit := uint32(datagen.RandomInt(r, chain.ChainLength())) + 1
+		resp, err = blcKeeper.BlockLocator(ctx, &types.QueryBlockLocatorRequest{Locator: locator, Limit: limit})
+		require.NoError(t, err)
+		require.True(t, resp.ForkPoint.Eq(forkPoint))
+
+		expectedLen := tip.Height - forkPoint.Height
+		if expectedLen > limit {
+			expectedLen = limit
+		}
+		require.Len(t, resp.Headers, int(expectedLen))
+		for i, header := range resp.Headers {
+			require.Equal(t, forkPoint.Height+1+uint32(i), header.Height)
+			require.True(t, header.Eq(blcKeeper.GetHeaderByHeight(ctx, header.Height)))
+		}
+
+		// locator with unknown hashes only falls back to the base header
+		resp, err = blcKeeper.BlockLocator(ctx, &types.QueryBlockLocatorRequest{Locator: locator[:numUnknown]})
+		require.NoError(t, err)
+		require.True(t, resp.ForkPoint.Eq(baseHeader))
+	})
+}
@@ -23,6 +23,8 @@ type (
 		storeService corestoretypes.KVStoreService
 		hooks        types.BTCLightClientHooks
 		iKeeper      types.IncentiveKeeper
+		btccKeeper   types.BtcCheckpointKeeper
+		bsKeeper     types.BtcStakingKeeper
 		btcConfig    bbn.BtcConfig
 		bl           *types.BtcLightClient
 		authority    string
@@ -78,6 +80,30 @@ func (k *Keeper) SetHooks(bh types.BTCLightClientHooks) *Keeper {
 	return k
 }
 
+// SetBtcCheckpointKeeper sets the BTC checkpoint keeper, which is consulted
+// before pruning headers. It is set after construction as the BTC checkpoint
+// keeper depends on the BTC light client keeper.
+func (k *Keeper) SetBtcCheckpointKeeper(ck types.BtcCheckpointKeeper) *Keeper {
+	if k.btccKeeper != nil {
+		panic("cannot set btccheckpoint keeper twice")
+	}
+	k.btccKeeper = ck
+
+	return k
+}
+
+// SetBtcStakingKeeper sets the BTC staking keeper, which is consulted before
+// pruning headers. It is set after construction as the BTC staking keeper
+// depends on the BTC light client keeper.
+func (k *Keeper) SetBtcStakingKeeper(bk types.BtcStakingKeeper) *Keeper {
+	if k.bsKeeper != nil {
+		panic("cannot set btcstaking keeper twice")
+	}
+	k.bsKeeper = bk
+
+	return k
+}
+
 func (k Keeper) insertHandler() func(ctx context.Context, s headersState, result *types.InsertResult) error {
 	return func(ctx context.Context, s headersState, result *types.InsertResult) error {
 		// if we receive rollback, should return error
@@ -97,8 +123,13 @@ func (k Keeper) triggerEventAndHandleHooksHandler() func(ctx context.Context, s
 	return func(ctx context.Context, s headersState, result *types.InsertResult) error {
 		// if we have rollback, first delete all headers up to the rollback point
 		if result.RollbackInfo != nil {
+			oldTip := s.GetTip()
 			// roll back to the height
 			s.rollBackHeadersUpTo(result.RollbackInfo.HeaderToRollbackTo.Height)
+			// persist the reorg before triggering hooks, so that hook consumers
+			// can annotate it. The new tip is the last header of the fork.
+			newTip := result.HeadersToInsert[len(result.HeadersToInsert)-1]
+			k.recordReorg(ctx, oldTip, newTip, result.RollbackInfo.HeaderToRollbackTo)
 			// trigger rollback event
 			k.triggerRollBack(ctx, result.RollbackInfo.HeaderToRollbackTo)
 		}
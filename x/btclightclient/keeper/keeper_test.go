@@ -196,6 +196,8 @@ func FuzzKeeperInsertValidChainExtension(f *testing.F) {
 		require.Len(t, mockHooks.AfterBTCRollForwardStore, len(chainToInsert))
 		require.Len(t, mockHooks.AfterBTCRollBackStore, 0)
 		require.Equal(t, numEvents, len(chainToInsert)*2)
+		// chain extension is not a reorg
+		require.Nil(t, blcKeeper.GetLatestReorg(ctx))
 
 		for i, header := range chainToInsert {
 			headerHash := header.BlockHash()
@@ -281,6 +283,15 @@ func FuzzKeeperInsertValidBetterChain(f *testing.F) {
 			require.True(t, allFieldsEqual(headerInfoByHash, headerInfoByHeight))
 		}
 
+		// check the reorg is recorded in the reorg log
+		reorg := blcKeeper.GetLatestReorg(ctx)
+		require.NotNil(t, reorg)
+		require.Equal(t, uint64(0), reorg.Id)
+		require.True(t, reorg.OldTip.Eq(oldTip))
+		require.True(t, reorg.NewTip.Eq(newTip))
+		require.True(t, reorg.ForkPoint.Eq(forkHeaderParent))
+		require.Equal(t, uint32(len(removedBranch)), reorg.Depth)
+
 		// check events and hooks
 		rollBackType, _ := sdk.TypedEventToEvent(&types.EventBTCRollBack{})
 		rollForwadType, _ := sdk.TypedEventToEvent(&types.EventBTCRollForward{})
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the btclightclient module state from consensus version
+// 1 to 2. Version 2 introduces the PermissionlessMinWork parameter, which is
+// set to zero if it is absent in the stored parameters.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if !params.PermissionlessMinWork.IsNil() {
+		return nil
+	}
+	params.PermissionlessMinWork = sdkmath.ZeroUint()
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
+	"github.com/babylonlabs-io/babylon/x/btclightclient/keeper"
+	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx, storeService := keepertest.BTCLightClientKeeperWithCustomParams(t, types.DefaultParams())
+
+	// store the parameters of consensus version 1, which do not have the
+	// permissionless min work
+	paramsBytes := protowire.AppendTag(nil, 2, protowire.VarintType)
+	paramsBytes = protowire.AppendVarint(paramsBytes, uint64(types.MinPruningDepth))
+	err := storeService.OpenKVStore(ctx).Set(types.ParamsKey, paramsBytes)
+	require.NoError(t, err)
+	require.True(t, k.GetParams(ctx).PermissionlessMinWork.IsNil())
+
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	require.Equal(t, types.MinPruningDepth, params.PruningDepth)
+	require.False(t, params.PermissionlessRelaying)
+	require.True(t, sdkmath.ZeroUint().Equal(params.PermissionlessMinWork))
+	require.NoError(t, params.Validate())
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -17,18 +19,7 @@ type msgServer struct {
 func (m msgServer) canInsertHeaders(sdkCtx sdk.Context, reporterAddress sdk.AccAddress) bool {
 	params := m.k.GetParams(sdkCtx)
 
-	if params.AllowAllReporters() {
-		return true
-	}
-
-	var allowInsertHeaders bool = false
-	for _, addr := range params.InsertHeadersAllowList {
-		if sdk.MustAccAddressFromBech32(addr).Equals(reporterAddress) {
-			allowInsertHeaders = true
-		}
-	}
-
-	return allowInsertHeaders
+	return params.AllowAllReporters() || params.IsAllowListed(reporterAddress)
 }
 
 func (m msgServer) InsertHeaders(ctx context.Context, msg *types.MsgInsertHeaders) (*types.MsgInsertHeadersResponse, error) {
@@ -47,6 +38,18 @@ func (m msgServer) InsertHeaders(ctx context.Context, msg *types.MsgInsertHeader
 		return nil, types.ErrUnauthorizedReporter.Wrapf("reporter %s is not authorized to insert headers", reporterAddress)
 	}
 
+	// In permissionless relaying mode, reporters outside the allow list must
+	// relay headers with sufficient work, so that spamming the BTC light
+	// client requires the corresponding Bitcoin mining work
+	params := m.k.GetParams(sdkCtx)
+	if params.RequiresSufficientWork(reporterAddress) {
+		if err := m.checkSufficientWork(sdkCtx, msg.Headers, params.PermissionlessMinWork); err != nil {
+			return nil, err
+		}
+	}
+
+	prevTip := m.k.GetTipInfo(sdkCtx)
+
 	err := m.k.InsertHeadersWithHookAndEvents(sdkCtx, msg.Headers)
 	if err != nil {
 		return nil, err
@@ -58,9 +61,40 @@ func (m msgServer) InsertHeaders(ctx context.Context, msg *types.MsgInsertHeader
 	// Thus, we can safely consider this message as refundable
 	m.k.iKeeper.IndexRefundableMsg(sdkCtx, msg)
 
+	// The reporter is the first to relay the main chain headers above the
+	// previous tip, so it is rewarded for each of them
+	tip := m.k.GetTipInfo(sdkCtx)
+	if tip.Height > prevTip.Height {
+		m.k.iKeeper.RewardBTCHeaderRelaying(sdkCtx, reporterAddress, tip.Height-prevTip.Height)
+	}
+
 	return &types.MsgInsertHeadersResponse{}, nil
 }
 
+// checkSufficientWork checks that the given headers extend a known header, and
+// that they would increase the total work of the main chain by at least the
+// given minimum work
+func (m msgServer) checkSufficientWork(sdkCtx sdk.Context, headers []bbn.BTCHeaderBytes, minWork sdkmath.Uint) error {
+	parent, err := m.k.GetHeaderByHash(sdkCtx, headers[0].ParentHash())
+	if err != nil {
+		return types.ErrHeaderParentDoesNotExist.Wrapf("the inserted headers do not extend a known header: %v", err)
+	}
+
+	newTipWork := *parent.Work
+	for i := range headers {
+		newTipWork = types.CumulativeWork(types.CalcWork(&headers[i]), newTipWork)
+	}
+	tipWork := *m.k.GetTipInfo(sdkCtx).Work
+	if newTipWork.LT(tipWork.Add(minWork)) {
+		return types.ErrInsufficientWork.Wrapf(
+			"the inserted headers would increase the main chain work from %s to %s, while at least %s more is required",
+			tipWork, newTipWork, minWork,
+		)
+	}
+
+	return nil
+}
+
 func (ms msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if ms.k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.k.authority, req.Authority)
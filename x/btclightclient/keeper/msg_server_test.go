@@ -223,3 +223,125 @@ func TestAllowUpdatesOnlyFromReportesInTheList(t *testing.T) {
 	_, err = srv.InsertHeaders(sdkCtx, msg1)
 	require.NoError(t, err)
 }
+
+// relayingIncentiveKeeper records the headers relayed by each reporter
+type relayingIncentiveKeeper struct {
+	keepertest.MockIncentiveKeeper
+	relayedHeaders map[string]uint32
+}
+
+func (ik *relayingIncentiveKeeper) RewardBTCHeaderRelaying(_ context.Context, reporter sdk.AccAddress, numHeaders uint32) {
+	ik.relayedHeaders[reporter.String()] += numHeaders
+}
+
+// Property: the reporter of a message is rewarded for each new main chain
+// header above the previous tip
+func FuzzMsgServerRewardNewHeaders(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+	senderPrivKey := secp256k1.GenPrivKey()
+	address, err := sdk.AccAddressFromHexUnsafe(senderPrivKey.PubKey().Address().String())
+	require.NoError(f, err)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		iKeeper := &relayingIncentiveKeeper{relayedHeaders: map[string]uint32{}}
+		blcKeeper, ctx, _ := keepertest.BTCLightClientKeeperWithIncentiveKeeper(t, types.DefaultParams(), iKeeper)
+		srv := keeper.NewMsgServerImpl(*blcKeeper)
+
+		chainLength := uint32(datagen.RandomInt(r, 50)) + 10
+		_, chain := datagen.GenRandBtcChainInsertingInKeeper(
+			t,
+			r,
+			blcKeeper,
+			ctx,
+			uint32(datagen.RandomInt(r, 50))+10,
+			chainLength,
+		)
+		initTip := chain.GetTipInfo()
+
+		// extending the tip rewards each new header
+		chainExtensionLength := uint32(r.Int31n(20) + 1)
+		chainExtension := datagen.GenRandomValidChainStartingFrom(
+			r,
+			initTip.Header.ToBlockHeader(),
+			nil,
+			chainExtensionLength,
+		)
+		msg := &types.MsgInsertHeaders{Signer: address.String(), Headers: keepertest.NewBTCHeaderBytesList(chainExtension)}
+		_, err := srv.InsertHeaders(ctx, msg)
+		require.NoError(t, err)
+		require.Equal(t, chainExtensionLength, iKeeper.relayedHeaders[address.String()])
+
+		// a reorg only rewards the new headers above the previous tip
+		tip := blcKeeper.GetTipInfo(ctx)
+		reorgDepth := uint32(r.Int31n(int32(chainLength-1)) + 1)
+		forkHeader := blcKeeper.GetHeaderByHeight(ctx, tip.Height-reorgDepth)
+		require.NotNil(t, forkHeader)
+		forkChainLength := reorgDepth + uint32(r.Int31n(10)+1)
+		forkChain := datagen.GenRandomValidChainStartingFrom(
+			r,
+			forkHeader.Header.ToBlockHeader(),
+			nil,
+			forkChainLength,
+		)
+		msg = &types.MsgInsertHeaders{Signer: address.String(), Headers: keepertest.NewBTCHeaderBytesList(forkChain)}
+		_, err = srv.InsertHeaders(ctx, msg)
+		require.NoError(t, err)
+		require.Equal(t, chainExtensionLength+forkChainLength-reorgDepth, iKeeper.relayedHeaders[address.String()])
+	})
+}
+
+func TestPermissionlessRelayingRequiresSufficientWork(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	allowedSender := secp256k1.GenPrivKey()
+	allowedAddress, err := sdk.AccAddressFromHexUnsafe(allowedSender.PubKey().Address().String())
+	require.NoError(t, err)
+	sender := secp256k1.GenPrivKey()
+	address, err := sdk.AccAddressFromHexUnsafe(sender.PubKey().Address().String())
+	require.NoError(t, err)
+
+	params := types.NewParams([]string{allowedAddress.String()})
+	params.PermissionlessRelaying = true
+	srv, blcKeeper, sdkCtx := setupMsgServerWithCustomParams(t, params)
+	ctx := sdk.UnwrapSDKContext(sdkCtx)
+
+	_, chain := datagen.GenRandBtcChainInsertingInKeeper(t, r, blcKeeper, ctx, 0, 10)
+	initTip := chain.GetTipInfo()
+
+	// the reporter outside the allow list can relay headers
+	chainExtension := datagen.GenRandomValidChainStartingFrom(r, initTip.Header.ToBlockHeader(), nil, 10)
+	msg := &types.MsgInsertHeaders{Signer: address.String(), Headers: keepertest.NewBTCHeaderBytesList(chainExtension)}
+	_, err = srv.InsertHeaders(sdkCtx, msg)
+	require.NoError(t, err)
+
+	// require more work than a single header carries
+	tip := blcKeeper.GetTipInfo(ctx)
+	newChainExt := datagen.GenRandomValidChainStartingFrom(r, tip.Header.ToBlockHeader(), nil, 1)
+	params.PermissionlessMinWork = chainWork(newChainExt).AddUint64(1)
+	require.NoError(t, blcKeeper.SetParams(ctx, params))
+
+	// the reporter outside the allow list cannot relay headers with
+	// insufficient work
+	msg = &types.MsgInsertHeaders{Signer: address.String(), Headers: keepertest.NewBTCHeaderBytesList(newChainExt)}
+	_, err = srv.InsertHeaders(sdkCtx, msg)
+	require.ErrorIs(t, err, types.ErrInsufficientWork)
+	require.Equal(t, tip, blcKeeper.GetTipInfo(ctx))
+
+	// nor headers not extending a known header
+	orphanChain := datagen.GenRandomValidChainStartingFrom(r, tip.Header.ToBlockHeader(), nil, 3)[1:]
+	msg = &types.MsgInsertHeaders{Signer: address.String(), Headers: keepertest.NewBTCHeaderBytesList(orphanChain)}
+	_, err = srv.InsertHeaders(sdkCtx, msg)
+	require.ErrorIs(t, err, types.ErrHeaderParentDoesNotExist)
+
+	// the reporter in the allow list is not subject to the work requirement
+	msg = &types.MsgInsertHeaders{Signer: allowedAddress.String(), Headers: keepertest.NewBTCHeaderBytesList(newChainExt)}
+	_, err = srv.InsertHeaders(sdkCtx, msg)
+	require.NoError(t, err)
+
+	// the reporter outside the allow list can relay headers with sufficient work
+	tip = blcKeeper.GetTipInfo(ctx)
+	newChainExt = datagen.GenRandomValidChainStartingFrom(r, tip.Header.ToBlockHeader(), nil, 2)
+	msg = &types.MsgInsertHeaders{Signer: address.String(), Headers: keepertest.NewBTCHeaderBytesList(newChainExt)}
+	_, err = srv.InsertHeaders(sdkCtx, msg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PruneHeaders prunes the oldest difficulty adjustment period of the main
+// chain, if all of its headers are deeper than the pruning depth. Pruned
+// headers are removed from the store and committed into the header
+// accumulator. As the base header is a difficulty adjustment block, pruning
+// a whole period at a time keeps it so. Headers still referenced by BTC
+// checkpoint submissions or by inclusion proofs that the BTC staking module
+// can still accept are never pruned.
+func (k Keeper) PruneHeaders(ctx context.Context) {
+	params := k.GetParams(ctx)
+	if !params.PruningEnabled() {
+		return
+	}
+
+	s := k.headersState(ctx)
+	base := s.BaseHeader()
+	if base == nil {
+		return
+	}
+	tip := s.GetTip()
+
+	blocksPerRetarget := uint32(types.BlocksPerRetarget(k.btcConfig.NetParams()))
+	newBaseHeight := base.Height + blocksPerRetarget
+	// the depth of the last pruned header must be at least the pruning depth
+	if uint64(tip.Height)+1 < uint64(newBaseHeight)+uint64(params.PruningDepth) {
+		return
+	}
+	// the submissions of the last finalized epoch and of the unfinalized epochs,
+	// as well as the inclusion proofs of the staking and unbonding txs that
+	// are still accepted, must remain verifiable against the stored headers
+	if k.btccKeeper != nil {
+		if lowest, found := k.btccKeeper.LowestReferencedHeaderHeight(ctx); found && lowest < newBaseHeight {
+			return
+		}
+	}
+	if k.bsKeeper != nil {
+		if lowest, found := k.bsKeeper.LowestReferencedHeaderHeight(ctx); found && lowest < newBaseHeight {
+			return
+		}
+	}
+
+	acc := k.GetHeaderAccumulator(ctx)
+	headersToPrune := make([]*types.BTCHeaderInfo, 0, blocksPerRetarget)
+	s.IterateForwardHeaders(base.Height, func(header *types.BTCHeaderInfo) bool {
+		if header.Height >= newBaseHeight {
+			return true
+		}
+		headersToPrune = append(headersToPrune, header)
+		return false
+	})
+
+	for _, header := range headersToPrune {
+		if header.Height != acc.NextHeight() {
+			// headers are committed in height order without gaps
+			panic("pruned header is not the next header of the accumulator")
+		}
+		acc.Append(header.Hash)
+		s.deleteHeader(header)
+	}
+	k.SetHeaderAccumulator(ctx, acc)
+
+	k.Logger(sdk.UnwrapSDKContext(ctx)).Info(
+		"pruned BTC headers",
+		"from_height", base.Height,
+		"to_height", newBaseHeight-1,
+	)
+}
+
+// GetHeaderAccumulator returns the accumulator of the pruned headers. If no
+// header has been pruned yet, an empty accumulator starting at the base
+// header is returned.
+func (k Keeper) GetHeaderAccumulator(ctx context.Context) *types.HeaderAccumulator {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.HeaderAccumulatorKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		var startHeight uint32
+		if base := k.headersState(ctx).BaseHeader(); base != nil {
+			startHeight = base.Height
+		}
+		return types.NewHeaderAccumulator(startHeight)
+	}
+
+	var acc types.HeaderAccumulator
+	k.cdc.MustUnmarshal(bz, &acc)
+	return &acc
+}
+
+// SetHeaderAccumulator sets the accumulator of the pruned headers
+func (k Keeper) SetHeaderAccumulator(ctx context.Context, acc *types.HeaderAccumulator) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.HeaderAccumulatorKey, k.cdc.MustMarshal(acc)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/stretchr/testify/require"
+)
+
+// referencedHeaderKeeper mocks the BTC checkpoint keeper with a fixed lowest
+// referenced header
+type referencedHeaderKeeper struct {
+	height uint32
+	found  bool
+}
+
+func (k *referencedHeaderKeeper) LowestReferencedHeaderHeight(_ context.Context) (uint32, bool) {
+	return k.height, k.found
+}
+
+func FuzzPruneHeaders(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 3)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+		btccKeeper := &referencedHeaderKeeper{}
+		blcKeeper.SetBtcCheckpointKeeper(btccKeeper)
+
+		params := types.DefaultParams()
+		params.PruningDepth = types.MinPruningDepth
+		require.NoError(t, blcKeeper.SetParams(ctx, params))
+
+		// simnet genesis as base header, followed by a block including a random
+		// tx and enough headers to prune exactly one retarget period
+		retargetPeriod := uint32(types.BlocksPerRetarget(&chaincfg.SimNetParams))
+		genesisHeader := chaincfg.SimNetParams.GenesisBlock.Header
+		genesisHeaderBytes := bbn.NewBTCHeaderBytesFromBlockHeader(&genesisHeader)
+		genesisHash := bbn.NewBTCHeaderHashBytesFromChainhash(chaincfg.SimNetParams.GenesisHash)
+		genesisWork := sdkmath.NewUint(0)
+		blcKeeper.SetBaseBTCHeader(ctx, *types.NewBTCHeaderInfo(&genesisHeaderBytes, &genesisHash, 0, &genesisWork))
+
+		blockWithProof := datagen.CreateBlockWithTransaction(r, &genesisHeader, datagen.GenRandomTx(r))
+		headers := []*wire.BlockHeader{blockWithProof.HeaderBytes.ToBlockHeader()}
+		lastRetargetHeader := &genesisHeader
+		chainLength := 2*retargetPeriod + uint32(datagen.RandomInt(r, 100))
+		for height := uint32(2); height <= chainLength; height++ {
+			var rt *datagen.RetargetInfo
+			if height%retargetPeriod == 0 {
+				rt = &datagen.RetargetInfo{LastRetargetHeader: lastRetargetHeader, Params: &chaincfg.SimNetParams}
+			}
+			header := datagen.GenRandomBtcdValidHeader(r, headers[len(headers)-1], nil, rt)
+			if rt != nil {
+				lastRetargetHeader = header
+			}
+			headers = append(headers, header)
+		}
+		err := blcKeeper.InsertHeadersWithHookAndEvents(ctx, keepertest.NewBTCHeaderBytesList(headers))
+		require.NoError(t, err)
+		tip := blcKeeper.GetTipInfo(ctx)
+
+		// record the hashes of the headers to be pruned
+		var prunedHashes []*bbn.BTCHeaderHashBytes
+		for _, header := range blcKeeper.GetMainChainFromWithLimit(ctx, 0, retargetPeriod) {
+			prunedHashes = append(prunedHashes, header.Hash)
+		}
+
+		// disabled pruning does not prune anything
+		params.PruningDepth = 0
+		require.NoError(t, blcKeeper.SetParams(ctx, params))
+		blcKeeper.PruneHeaders(ctx)
+		require.Equal(t, uint32(0), blcKeeper.GetBaseBTCHeader(ctx).Height)
+
+		// headers referenced by checkpoint submissions are not pruned
+		params.PruningDepth = types.MinPruningDepth
+		require.NoError(t, blcKeeper.SetParams(ctx, params))
+		btccKeeper.height = uint32(datagen.RandomInt(r, int(retargetPeriod)))
+		btccKeeper.found = true
+		blcKeeper.PruneHeaders(ctx)
+		require.Equal(t, uint32(0), blcKeeper.GetBaseBTCHeader(ctx).Height)
+
+		// once the lowest referenced header is above the retarget period,
+		// enabled pruning prunes the first retarget period only
+		btccKeeper.height = retargetPeriod + uint32(datagen.RandomInt(r, int(chainLength-retargetPeriod)))
+		blcKeeper.PruneHeaders(ctx)
+		blcKeeper.PruneHeaders(ctx)
+		require.Equal(t, retargetPeriod, blcKeeper.GetBaseBTCHeader(ctx).Height)
+		require.True(t, blcKeeper.GetTipInfo(ctx).Eq(tip))
+		for _, hash := range prunedHashes {
+			_, err := blcKeeper.GetHeaderByHash(ctx, hash)
+			require.Error(t, err)
+		}
+
+		acc := blcKeeper.GetHeaderAccumulator(ctx)
+		require.Equal(t, uint32(0), acc.StartHeight)
+		require.Equal(t, uint64(retargetPeriod), acc.NumLeaves)
+
+		// the tx in the pruned block can still be verified with an accumulator
+		// proof
+		accProof, err := types.BuildHeaderAccumulatorProof(acc.StartHeight, prunedHashes, 1)
+		require.NoError(t, err)
+		proof := blockWithProof.SpvProof
+		req := &types.QueryVerifyTxInclusionRequest{
+			Tx:          proof.BtcTransaction,
+			BlockHash:   blockWithProof.HeaderBytes.Hash().MarshalHex(),
+			TxIndex:     proof.BtcTransactionIndex,
+			MerkleProof: proof.MerkleNodes,
+		}
+		_, err = blcKeeper.VerifyTxInclusion(ctx, req)
+		require.Error(t, err)
+
+		req.Header = &blockWithProof.HeaderBytes
+		req.AccumulatorProof = accProof
+		resp, err := blcKeeper.VerifyTxInclusion(ctx, req)
+		require.NoError(t, err)
+		require.True(t, resp.Included)
+		require.Equal(t, uint32(1), resp.BlockHeight)
+		require.Equal(t, tip.Height-1, resp.Depth)
+
+		// accumulator proof of another height is not valid
+		req.AccumulatorProof, err = types.BuildHeaderAccumulatorProof(acc.StartHeight, prunedHashes, 2)
+		require.NoError(t, err)
+		_, err = blcKeeper.VerifyTxInclusion(ctx, req)
+		require.Error(t, err)
+	})
+}
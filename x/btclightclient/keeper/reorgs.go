@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordReorg appends a reorg to the reorg log. If the log already holds
+// MaxReorgRecords records, the oldest one is evicted.
+func (k Keeper) recordReorg(
+	ctx context.Context,
+	oldTip, newTip, forkPoint *types.BTCHeaderInfo,
+) *types.BTCReorgRecord {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	id := k.getNextReorgID(ctx)
+	record := &types.BTCReorgRecord{
+		Id:            id,
+		Timestamp:     sdkCtx.HeaderInfo().Time,
+		BabylonHeight: uint64(sdkCtx.HeaderInfo().Height),
+		OldTip:        oldTip,
+		NewTip:        newTip,
+		ForkPoint:     forkPoint,
+		Depth:         oldTip.Height - forkPoint.Height,
+	}
+
+	store := k.reorgStore(ctx)
+	store.Set(types.ReorgRecordKey(id), k.cdc.MustMarshal(record))
+	if id >= types.MaxReorgRecords {
+		store.Delete(types.ReorgRecordKey(id - types.MaxReorgRecords))
+	}
+	k.setNextReorgID(ctx, id+1)
+
+	return record
+}
+
+// GetLatestReorg returns the most recent reorg record, or nil if the light
+// client has never been reorganized
+func (k Keeper) GetLatestReorg(ctx context.Context) *types.BTCReorgRecord {
+	nextID := k.getNextReorgID(ctx)
+	if nextID == 0 {
+		return nil
+	}
+
+	bz := k.reorgStore(ctx).Get(types.ReorgRecordKey(nextID - 1))
+	if bz == nil {
+		return nil
+	}
+	var record types.BTCReorgRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record
+}
+
+// AnnotateLatestReorg records that the given module found the given epochs to
+// be affected by the most recent reorg. The annotation is only applied if the
+// reorg happened in the current block, so that modules reacting to light
+// client updates cannot attribute their changes to an older reorg.
+func (k Keeper) AnnotateLatestReorg(ctx context.Context, module string, epochs []uint64) {
+	if len(epochs) == 0 {
+		return
+	}
+
+	record := k.GetLatestReorg(ctx)
+	if record == nil {
+		return
+	}
+	if record.BabylonHeight != uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height) {
+		return
+	}
+
+	record.Annotate(module, epochs)
+	k.reorgStore(ctx).Set(types.ReorgRecordKey(record.Id), k.cdc.MustMarshal(record))
+}
+
+func (k Keeper) getNextReorgID(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextReorgIDKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextReorgID(ctx context.Context, id uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.NextReorgIDKey, sdk.Uint64ToBigEndian(id)); err != nil {
+		panic(err)
+	}
+}
+
+// reorgStore returns the KVStore of the reorg log
+// prefix: ReorgRecordPrefix
+// key: reorg ID
+// value: BTCReorgRecord
+func (k Keeper) reorgStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.ReorgRecordPrefix)
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
+	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzReorgLog(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		blcKeeper, ctx := keepertest.BTCLightClientKeeper(t)
+
+		// no reorg at the beginning
+		require.Nil(t, blcKeeper.GetLatestReorg(ctx))
+
+		// record random number of reorgs, possibly more than the log can hold
+		numReorgs := datagen.RandomInt(r, int(types.MaxReorgRecords)*2) + 1
+		for i := uint64(0); i < numReorgs; i++ {
+			forkPoint := datagen.GenRandomBTCHeaderInfo(r)
+			oldTip := datagen.GenRandomBTCHeaderInfoWithParent(r, forkPoint)
+			newTip := datagen.GenRandomBTCHeaderInfoWithParent(r, forkPoint)
+			record := blcKeeper.RecordReorg(ctx, oldTip, newTip, forkPoint)
+			require.Equal(t, i, record.Id)
+			require.Equal(t, uint32(1), record.Depth)
+		}
+
+		latest := blcKeeper.GetLatestReorg(ctx)
+		require.NotNil(t, latest)
+		require.Equal(t, numReorgs-1, latest.Id)
+
+		// only the most recent MaxReorgRecords reorgs are kept
+		expectedLen := numReorgs
+		if expectedLen > types.MaxReorgRecords {
+			expectedLen = types.MaxReorgRecords
+		}
+		resp, err := blcKeeper.RecentReorgs(ctx, &types.QueryRecentReorgsRequest{
+			Pagination: &query.PageRequest{Limit: types.MaxReorgRecords},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Reorgs, int(expectedLen))
+		for i, reorg := range resp.Reorgs {
+			require.Equal(t, numReorgs-1-uint64(i), reorg.Id)
+		}
+
+		// the most recent reorg comes first across pages
+		resp, err = blcKeeper.RecentReorgs(ctx, &types.QueryRecentReorgsRequest{
+			Pagination: &query.PageRequest{Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Reorgs, 1)
+		require.Equal(t, numReorgs-1, resp.Reorgs[0].Id)
+		if expectedLen > 1 {
+			resp, err = blcKeeper.RecentReorgs(ctx, &types.QueryRecentReorgsRequest{
+				Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+			})
+			require.NoError(t, err)
+			require.Len(t, resp.Reorgs, 1)
+			require.Equal(t, numReorgs-2, resp.Reorgs[0].Id)
+		}
+
+		// the oldest reorg comes first in reverse order
+		resp, err = blcKeeper.RecentReorgs(ctx, &types.QueryRecentReorgsRequest{
+			Pagination: &query.PageRequest{Limit: types.MaxReorgRecords, Reverse: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Reorgs, int(expectedLen))
+		for i, reorg := range resp.Reorgs {
+			require.Equal(t, numReorgs-expectedLen+uint64(i), reorg.Id)
+		}
+
+		// annotations are merged without duplicates
+		blcKeeper.AnnotateLatestReorg(ctx, "mod1", []uint64{1, 2})
+		blcKeeper.AnnotateLatestReorg(ctx, "mod1", []uint64{2, 3})
+		blcKeeper.AnnotateLatestReorg(ctx, "mod2", []uint64{5})
+		latest = blcKeeper.GetLatestReorg(ctx)
+		require.Len(t, latest.Annotations, 2)
+		require.Equal(t, "mod1", latest.Annotations[0].Module)
+		require.Equal(t, []uint64{1, 2, 3}, latest.Annotations[0].Epochs)
+		require.Equal(t, "mod2", latest.Annotations[1].Module)
+		require.Equal(t, []uint64{5}, latest.Annotations[1].Epochs)
+
+		// reorgs of previous blocks are not annotated
+		ctx = ctx.WithHeaderInfo(header.Info{Height: ctx.HeaderInfo().Height + 1})
+		blcKeeper.AnnotateLatestReorg(ctx, "mod3", []uint64{7})
+		latest = blcKeeper.GetLatestReorg(ctx)
+		require.Len(t, latest.Annotations, 2)
+	})
+}
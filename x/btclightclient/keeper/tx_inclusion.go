@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// GetTxInclusionInfo verifies the merkle proof of the given tx against the
+// block with the given hash, and returns the height of the block and its
+// depth in the main chain. It returns an error if the block is not part of
+// the main chain maintained by the light client.
+func (k Keeper) GetTxInclusionInfo(
+	ctx context.Context,
+	tx *btcutil.Tx,
+	headerHash *bbn.BTCHeaderHashBytes,
+	proof []byte,
+	index uint32,
+) (*types.TxInclusionInfo, error) {
+	if tx == nil || headerHash == nil {
+		return nil, types.ErrEmptyMessage
+	}
+
+	headerInfo, err := k.GetHeaderByHash(ctx, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	depth, err := k.MainChainDepth(ctx, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// the header was already validated by the light client, so only the merkle
+	// proof needs to be checked
+	btcHeader := headerInfo.Header.ToBlockHeader()
+
+	return &types.TxInclusionInfo{
+		Included:    bbn.VerifyInclusionProof(tx, &btcHeader.MerkleRoot, proof, index),
+		BlockHeight: headerInfo.Height,
+		Depth:       depth,
+	}, nil
+}
+
+// GetPrunedTxInclusionInfo verifies the merkle proof of the given tx against
+// the given header pruned from the store, whose inclusion in the main chain is
+// proven by the accumulator proof. It returns the height of the header and its
+// depth in the main chain.
+func (k Keeper) GetPrunedTxInclusionInfo(
+	ctx context.Context,
+	tx *btcutil.Tx,
+	header *bbn.BTCHeaderBytes,
+	accProof *types.HeaderAccumulatorProof,
+	proof []byte,
+	index uint32,
+) (*types.TxInclusionInfo, error) {
+	if tx == nil || header == nil || accProof == nil {
+		return nil, types.ErrEmptyMessage
+	}
+
+	if err := k.GetHeaderAccumulator(ctx).VerifyProof(header.Hash(), accProof); err != nil {
+		return nil, types.ErrInvalidAccumulatorProof.Wrap(err.Error())
+	}
+
+	tip := k.GetTipInfo(ctx)
+	btcHeader := header.ToBlockHeader()
+
+	return &types.TxInclusionInfo{
+		Included:    bbn.VerifyInclusionProof(tx, &btcHeader.MerkleRoot, proof, index),
+		BlockHeight: accProof.Height,
+		Depth:       tip.Height - accProof.Height,
+	}, nil
+}
@@ -0,0 +1,175 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"math/bits"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+)
+
+// The header accumulator is a Merkle Mountain Range (MMR) i.e a list of perfect
+// binary merkle trees of decreasing size. Appending a leaf adds a new tree of
+// size one and merges trees of equal size, exactly like incrementing a binary
+// counter. Thus, the accumulator with n leaves has one tree, or peak, per bit
+// set in n.
+//
+// Leaves and internal nodes are domain separated to prevent second preimage
+// attacks:
+// - leaf = sha256(0x00 || header hash)
+// - node = sha256(0x01 || left || right)
+
+const (
+	accumulatorLeafPrefix byte = 0x00
+	accumulatorNodePrefix byte = 0x01
+)
+
+// NewHeaderAccumulator returns an empty accumulator whose first leaf will be
+// the header at the given height
+func NewHeaderAccumulator(startHeight uint32) *HeaderAccumulator {
+	return &HeaderAccumulator{StartHeight: startHeight}
+}
+
+// AccumulatorLeaf returns the accumulator leaf of the header with the given hash
+func AccumulatorLeaf(headerHash *bbn.BTCHeaderHashBytes) []byte {
+	h := sha256.New()
+	h.Write([]byte{accumulatorLeafPrefix})
+	h.Write(headerHash.MustMarshal())
+	return h.Sum(nil)
+}
+
+func accumulatorNode(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{accumulatorNodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// NextHeight returns the height of the next header to be committed into the
+// accumulator
+func (a *HeaderAccumulator) NextHeight() uint32 {
+	return a.StartHeight + uint32(a.NumLeaves)
+}
+
+// Append commits the header with the given hash into the accumulator. The
+// header must be at the height returned by NextHeight.
+func (a *HeaderAccumulator) Append(headerHash *bbn.BTCHeaderHashBytes) {
+	node := AccumulatorLeaf(headerHash)
+	// every trailing set bit of the number of leaves is a peak of the same
+	// size as the node being appended, which must be merged with it
+	for n := a.NumLeaves; n&1 == 1; n >>= 1 {
+		last := len(a.Peaks) - 1
+		node = accumulatorNode(a.Peaks[last], node)
+		a.Peaks = a.Peaks[:last]
+	}
+	a.Peaks = append(a.Peaks, node)
+	a.NumLeaves++
+}
+
+// peakOf returns the index of the peak whose tree contains the leaf with the
+// given index, together with the height of the tree and the position of the
+// leaf within the tree
+func peakOf(numLeaves uint64, leafIdx uint64) (peakIdx int, treeHeight int, pos uint64) {
+	var start uint64
+	for b := 63; b >= 0; b-- {
+		size := uint64(1) << b
+		if numLeaves&size == 0 {
+			continue
+		}
+		if leafIdx < start+size {
+			return peakIdx, b, leafIdx - start
+		}
+		start += size
+		peakIdx++
+	}
+	// unreachable as long as leafIdx < numLeaves
+	panic("leaf index is out of the accumulator range")
+}
+
+// VerifyProof checks that the header with the given hash is committed into
+// the accumulator at the height of the proof
+func (a *HeaderAccumulator) VerifyProof(headerHash *bbn.BTCHeaderHashBytes, proof *HeaderAccumulatorProof) error {
+	if headerHash == nil || proof == nil {
+		return fmt.Errorf("empty header hash or accumulator proof")
+	}
+	if proof.Height < a.StartHeight || proof.Height >= a.NextHeight() {
+		return fmt.Errorf("height %d is not committed into the accumulator", proof.Height)
+	}
+
+	peakIdx, treeHeight, pos := peakOf(a.NumLeaves, uint64(proof.Height-a.StartHeight))
+	if len(proof.Siblings) != treeHeight {
+		return fmt.Errorf("invalid number of siblings: expected %d, got %d", treeHeight, len(proof.Siblings))
+	}
+
+	node := AccumulatorLeaf(headerHash)
+	for _, sibling := range proof.Siblings {
+		if pos&1 == 0 {
+			node = accumulatorNode(node, sibling)
+		} else {
+			node = accumulatorNode(sibling, node)
+		}
+		pos >>= 1
+	}
+
+	if !bytes.Equal(node, a.Peaks[peakIdx]) {
+		return fmt.Errorf("accumulator proof does not match the accumulator")
+	}
+	return nil
+}
+
+// Validate performs stateless validation of the accumulator
+func (a *HeaderAccumulator) Validate() error {
+	if len(a.Peaks) != bits.OnesCount64(a.NumLeaves) {
+		return fmt.Errorf("accumulator with %d leaves must have %d peaks, got %d",
+			a.NumLeaves, bits.OnesCount64(a.NumLeaves), len(a.Peaks))
+	}
+	for _, peak := range a.Peaks {
+		if len(peak) != sha256.Size {
+			return fmt.Errorf("invalid accumulator peak length %d", len(peak))
+		}
+	}
+	return nil
+}
+
+// BuildHeaderAccumulatorProof builds the proof of the header at the given
+// height, for the accumulator committing to the given header hashes in height
+// order starting from startHeight. The hashes must cover all the headers
+// committed into the accumulator the proof is verified against.
+func BuildHeaderAccumulatorProof(
+	startHeight uint32,
+	headerHashes []*bbn.BTCHeaderHashBytes,
+	height uint32,
+) (*HeaderAccumulatorProof, error) {
+	numLeaves := uint64(len(headerHashes))
+	if height < startHeight || uint64(height-startHeight) >= numLeaves {
+		return nil, fmt.Errorf("height %d is not covered by the header hashes", height)
+	}
+
+	leafIdx := uint64(height - startHeight)
+	_, treeHeight, pos := peakOf(numLeaves, leafIdx)
+
+	// leaves of the perfect tree containing the header
+	treeStart := leafIdx - pos
+	level := make([][]byte, 0, 1<<treeHeight)
+	for _, hash := range headerHashes[treeStart : treeStart+(uint64(1)<<treeHeight)] {
+		level = append(level, AccumulatorLeaf(hash))
+	}
+
+	siblings := make([][]byte, 0, treeHeight)
+	for len(level) > 1 {
+		siblings = append(siblings, level[pos^1])
+		next := make([][]byte, 0, len(level)/2)
+		for i := 0; i < len(level); i += 2 {
+			next = append(next, accumulatorNode(level[i], level[i+1]))
+		}
+		level = next
+		pos >>= 1
+	}
+
+	return &HeaderAccumulatorProof{
+		Height:   height,
+		Siblings: siblings,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzHeaderAccumulator(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		startHeight := uint32(datagen.RandomInt(r, 1000))
+		numLeaves := datagen.RandomInt(r, 500) + 1
+
+		randomHash := func() *bbn.BTCHeaderHashBytes {
+			chHash := datagen.GenRandomBtcdHash(r)
+			hash := bbn.NewBTCHeaderHashBytesFromChainhash(&chHash)
+			return &hash
+		}
+
+		acc := types.NewHeaderAccumulator(startHeight)
+		hashes := make([]*bbn.BTCHeaderHashBytes, 0, numLeaves)
+		for i := uint64(0); i < numLeaves; i++ {
+			hash := randomHash()
+			hashes = append(hashes, hash)
+			acc.Append(hash)
+			require.NoError(t, acc.Validate())
+		}
+		require.Equal(t, startHeight+uint32(numLeaves), acc.NextHeight())
+
+		// proof of a random header is valid
+		height := startHeight + uint32(datagen.RandomInt(r, int(numLeaves)))
+		proof, err := types.BuildHeaderAccumulatorProof(startHeight, hashes, height)
+		require.NoError(t, err)
+		hash := hashes[height-startHeight]
+		require.NoError(t, acc.VerifyProof(hash, proof))
+
+		// proof of another header is not valid
+		require.Error(t, acc.VerifyProof(randomHash(), proof))
+
+		// proof at heights outside of the accumulator is not valid
+		proof.Height = acc.NextHeight()
+		require.Error(t, acc.VerifyProof(hash, proof))
+	})
+}
@@ -192,17 +192,49 @@ func (l *lightHeaderCtx) RelativeAncestorCtx(
 	)
 }
 
+// bip94RetargetHeaderCtx is the context of the last header of a difficulty
+// adjustment period on networks enforcing BIP94. It exposes the difficulty of
+// the first header of the period instead of its own, as BIP94 computes the
+// difficulty of the next period from it.
+type bip94RetargetHeaderCtx struct {
+	blockchain.HeaderCtx
+	firstHeaderBits uint32
+}
+
+var _ blockchain.HeaderCtx = (*bip94RetargetHeaderCtx)(nil)
+
+func newBIP94RetargetHeaderCtx(
+	lastHeaderCtx blockchain.HeaderCtx,
+	blocksPerRetarget int32) (*bip94RetargetHeaderCtx, error) {
+	firstHeaderCtx := lastHeaderCtx.RelativeAncestorCtx(blocksPerRetarget - 1)
+	if firstHeaderCtx == nil {
+		return nil, fmt.Errorf("unable to obtain the first header of the difficulty adjustment period")
+	}
+
+	return &bip94RetargetHeaderCtx{
+		HeaderCtx:       lastHeaderCtx,
+		firstHeaderBits: firstHeaderCtx.Bits(),
+	}, nil
+}
+
+func (b *bip94RetargetHeaderCtx) Bits() uint32 {
+	return b.firstHeaderBits
+}
+
 type BtcLightClient struct {
 	params *chaincfg.Params
 	ctx    *lightChainCtx
+	// enforceBIP94 is true for networks following the BIP94 rules i.e. testnet4
+	enforceBIP94 bool
 }
 
 func NewBtcLightClient(
 	params *chaincfg.Params,
 	ctx *lightChainCtx) *BtcLightClient {
 	return &BtcLightClient{
-		params: params,
-		ctx:    ctx,
+		params:       params,
+		ctx:          ctx,
+		enforceBIP94: bbn.EnforceBIP94(params),
 	}
 }
 
@@ -407,10 +439,26 @@ func (l *BtcLightClient) checkHeader(
 	parentHeaderInfo *localHeaderInfo,
 	blockHeader *wire.BlockHeader,
 ) error {
-	parentHeaderCtx := newLightHeaderCtx(
+	var parentHeaderCtx blockchain.HeaderCtx = newLightHeaderCtx(
 		parentHeaderInfo.height, parentHeaderInfo.header, s,
 	)
 
+	isRetarget := (parentHeaderInfo.height+1)%uint32(l.ctx.BlocksPerRetarget()) == 0
+	if l.enforceBIP94 && isRetarget {
+		// timewarp attack mitigation
+		minTimestamp := parentHeaderInfo.header.Timestamp.Add(-bbn.MaxTimewarp)
+		if blockHeader.Timestamp.Before(minTimestamp) {
+			return fmt.Errorf("block timestamp of %v is before the minimum %v allowed for the first block of a difficulty adjustment period",
+				blockHeader.Timestamp, minTimestamp)
+		}
+
+		bip94HeaderCtx, err := newBIP94RetargetHeaderCtx(parentHeaderCtx, l.ctx.BlocksPerRetarget())
+		if err != nil {
+			return err
+		}
+		parentHeaderCtx = bip94HeaderCtx
+	}
+
 	var emptyFlags blockchain.BehaviorFlags
 	err := blockchain.CheckBlockHeaderContext(
 		blockHeader, parentHeaderCtx, emptyFlags, l.ctx, true,
@@ -0,0 +1,185 @@
+package types_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btclightclient/types"
+)
+
+// headersStore is an in-memory BtcChainReadStore containing a single chain
+type headersStore struct {
+	headers []*types.BTCHeaderInfo
+}
+
+var _ types.BtcChainReadStore = (*headersStore)(nil)
+
+func newHeadersStore(base *types.BTCHeaderInfo) *headersStore {
+	return &headersStore{headers: []*types.BTCHeaderInfo{base}}
+}
+
+func (s *headersStore) GetHeaderByHash(hash *bbn.BTCHeaderHashBytes) (*types.BTCHeaderInfo, error) {
+	for _, header := range s.headers {
+		if header.Hash.Eq(hash) {
+			return header, nil
+		}
+	}
+	return nil, types.ErrHeaderDoesNotExist
+}
+
+func (s *headersStore) GetHeaderByHeight(height uint32) (*types.BTCHeaderInfo, error) {
+	base := s.headers[0].Height
+	if height < base || height-base >= uint32(len(s.headers)) {
+		return nil, types.ErrHeaderDoesNotExist
+	}
+	return s.headers[height-base], nil
+}
+
+func (s *headersStore) GetTip() *types.BTCHeaderInfo {
+	return s.headers[len(s.headers)-1]
+}
+
+func newHeaderInfo(header *wire.BlockHeader, height uint32) *types.BTCHeaderInfo {
+	headerBytes := bbn.NewBTCHeaderBytesFromBlockHeader(header)
+	blockHash := header.BlockHash()
+	headerHash := bbn.NewBTCHeaderHashBytesFromChainhash(&blockHash)
+	work := types.CalcWork(&headerBytes)
+	return types.NewBTCHeaderInfo(&headerBytes, &headerHash, height, &work)
+}
+
+func headersFromHex(t *testing.T, headersHex ...string) []*wire.BlockHeader {
+	headers := make([]*wire.BlockHeader, len(headersHex))
+	for i, headerHex := range headersHex {
+		headerBytes, err := bbn.NewBTCHeaderBytesFromHex(headerHex)
+		require.NoError(t, err)
+		headers[i] = headerBytes.ToBlockHeader()
+	}
+	return headers
+}
+
+func TestSignetHeaders(t *testing.T) {
+	// signet headers from height 195552, which is a difficulty adjustment block
+	headers := headersFromHex(t,
+		"00000020c8710c5662ab0a4680963697765a390cba4814f95f0556fc5fb3b446b2000000fa9b80e52653455e5d4a4648fbe1f62854a07dbec0633a42ef595431de9be36dccb64366934f011ef3d98200",
+		"0000002031746b63c89e0e9b0a341611a8c45011d176077901c44c963d3debf86e000000dcc825d49792f6cb0585542cc80dade8e44824c7c792e3ceca17805e5a738f3c04b84366934f011e2b9b5c01",
+		"00000020a87076e9e536209ba9e91bf6a6dda9df17284ced7a195d055950b820e9000000ffcb47795f9aca88db55add40ef3a59faa0c10728f6158553d965c388299a3270dbe4366934f011e153a4b01",
+	)
+	store := newHeadersStore(newHeaderInfo(headers[0], 195552))
+
+	customSignetParams := chaincfg.CustomSignetParams([]byte{0x51}, nil)
+
+	testCases := []struct {
+		name        string
+		params      *chaincfg.Params
+		expectValid bool
+	}{
+		{"signet", &chaincfg.SigNetParams, true},
+		// header validation rules do not depend on the signet challenge
+		{"custom signet", &customSignetParams, true},
+		// signet difficulty is below the minimum difficulty of other networks
+		{"mainnet", &chaincfg.MainNetParams, false},
+		{"testnet3", &chaincfg.TestNet3Params, false},
+		{"testnet4", &bbn.TestNet4Params, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lc := types.NewBtcLightClientFromParams(tc.params)
+			res, err := lc.InsertHeaders(store, headers[1:])
+			if !tc.expectValid {
+				require.ErrorIs(t, err, types.ErrInvalidHeader)
+				return
+			}
+			require.NoError(t, err)
+			require.Nil(t, res.RollbackInfo)
+			require.Len(t, res.HeadersToInsert, 2)
+			require.Equal(t, uint32(195554), res.HeadersToInsert[1].Height)
+		})
+	}
+}
+
+func FuzzTestnet4DifficultyAdjustment(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 3)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// keep proof of work cheap to generate, as the BIP94 rules only
+		// depend on the network
+		testnet4Params := bbn.TestNet4Params
+		testnet4Params.PowLimit = chaincfg.SimNetParams.PowLimit
+		testnet4Params.PowLimitBits = chaincfg.SimNetParams.PowLimitBits
+		testnet3Params := chaincfg.TestNet3Params
+		testnet3Params.PowLimit = chaincfg.SimNetParams.PowLimit
+		testnet3Params.PowLimitBits = chaincfg.SimNetParams.PowLimitBits
+		blocksPerRetarget := uint32(types.BlocksPerRetarget(&testnet4Params))
+
+		// base header at the start of a difficulty adjustment period, with a
+		// difficulty above the minimum one
+		base := &wire.BlockHeader{
+			Version:   4,
+			Timestamp: time.Unix(bbn.TestNet4Params.GenesisBlock.Header.Timestamp.Unix()+r.Int63n(1000000), 0),
+			Bits:      0x2000ffff,
+		}
+		store := newHeadersStore(newHeaderInfo(base, blocksPerRetarget*uint32(datagen.RandomInt(r, 100))))
+
+		// headers of the period, the last one being mined with the minimum
+		// difficulty after 20 minutes without blocks
+		headers := datagen.GenRandomValidChainStartingFrom(
+			r, base, &datagen.TimeBetweenBlocksInfo{Time: 10 * time.Minute}, blocksPerRetarget-2,
+		)
+		minDiffHeader := datagen.GenRandomBtcdValidHeader(
+			r, headers[len(headers)-1], &datagen.TimeBetweenBlocksInfo{Time: testnet4Params.MinDiffReductionTime + time.Minute}, nil,
+		)
+		minDiffHeader.Bits = testnet4Params.PowLimitBits
+		datagen.SolveBlock(minDiffHeader)
+		headers = append(headers, minDiffHeader)
+
+		// first header of the next period, whose difficulty is adjusted from
+		// the difficulty of the first header of the period, as per BIP94
+		bip94Header := datagen.GenRandomBtcdValidHeader(
+			r, minDiffHeader, nil, &datagen.RetargetInfo{LastRetargetHeader: base, Params: &testnet4Params},
+		)
+		// testnet3 adjusts the difficulty of the last header of the period,
+		// which is the minimum one, thus stays at the minimum difficulty
+		require.NotEqual(t, testnet4Params.PowLimitBits, bip94Header.Bits)
+
+		withRetargetHeader := func(bits uint32, timestamp time.Time) []*wire.BlockHeader {
+			header := *bip94Header
+			header.Bits = bits
+			header.Timestamp = timestamp
+			datagen.SolveBlock(&header)
+			return append(append([]*wire.BlockHeader{}, headers...), &header)
+		}
+		insert := func(params *chaincfg.Params, chain []*wire.BlockHeader) error {
+			_, err := types.NewBtcLightClientFromParams(params).InsertHeaders(store, chain)
+			return err
+		}
+
+		bip94Chain := withRetargetHeader(bip94Header.Bits, bip94Header.Timestamp)
+		testnet3Chain := withRetargetHeader(testnet4Params.PowLimitBits, bip94Header.Timestamp)
+		require.NoError(t, insert(&testnet4Params, bip94Chain))
+		require.ErrorIs(t, insert(&testnet4Params, testnet3Chain), types.ErrInvalidHeader)
+		require.ErrorIs(t, insert(&testnet3Params, bip94Chain), types.ErrInvalidHeader)
+		require.NoError(t, insert(&testnet3Params, testnet3Chain))
+
+		// the first header of a period cannot be more than MaxTimewarp before
+		// the last header of the previous one
+		maxTimewarpChain := withRetargetHeader(bip94Header.Bits, minDiffHeader.Timestamp.Add(-bbn.MaxTimewarp))
+		timewarpChain := withRetargetHeader(bip94Header.Bits, minDiffHeader.Timestamp.Add(-bbn.MaxTimewarp-time.Second))
+		require.NoError(t, insert(&testnet4Params, maxTimewarpChain))
+		err := insert(&testnet4Params, timewarpChain)
+		require.ErrorIs(t, err, types.ErrInvalidHeader)
+		require.Contains(t, err.Error(), fmt.Sprintf("minimum %v", minDiffHeader.Timestamp.Add(-bbn.MaxTimewarp)))
+		// testnet3 does not enforce it
+		require.NoError(t, insert(&testnet3Params, withRetargetHeader(testnet4Params.PowLimitBits, timewarpChain[len(timewarpChain)-1].Timestamp)))
+	})
+}
@@ -9,15 +9,19 @@ import (
 	github_com_babylonlabs_io_babylon_types "github.com/babylonlabs-io/babylon/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -80,8 +84,300 @@ func (m *BTCHeaderInfo) GetHeight() uint32 {
 	return 0
 }
 
+// BTCReorgAnnotation is the list of epochs that a module marked as touched
+// by a BTC reorg
+type BTCReorgAnnotation struct {
+	// module is the name of the module that produced the annotation
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// epochs are the epochs the module found to be affected by the reorg
+	Epochs []uint64 `protobuf:"varint,2,rep,packed,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *BTCReorgAnnotation) Reset()         { *m = BTCReorgAnnotation{} }
+func (m *BTCReorgAnnotation) String() string { return proto.CompactTextString(m) }
+func (*BTCReorgAnnotation) ProtoMessage()    {}
+func (*BTCReorgAnnotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{1}
+}
+func (m *BTCReorgAnnotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCReorgAnnotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCReorgAnnotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCReorgAnnotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCReorgAnnotation.Merge(m, src)
+}
+func (m *BTCReorgAnnotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCReorgAnnotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCReorgAnnotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCReorgAnnotation proto.InternalMessageInfo
+
+func (m *BTCReorgAnnotation) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *BTCReorgAnnotation) GetEpochs() []uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+// BTCReorgRecord is an entry of the bounded reorg log kept by the light client
+//   - Timestamp and Babylon height of the block in which the reorg happened
+//   - Tip of the main chain before the reorg
+//   - Tip of the main chain after the reorg
+//   - Fork point i.e the last common header of the old and new main chains
+//   - Depth of the reorg i.e the number of headers rolled back from the old tip
+type BTCReorgRecord struct {
+	// id is the sequence number of the reorg
+	Id            uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp     time.Time      `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	BabylonHeight uint64         `protobuf:"varint,3,opt,name=babylon_height,json=babylonHeight,proto3" json:"babylon_height,omitempty"`
+	OldTip        *BTCHeaderInfo `protobuf:"bytes,4,opt,name=old_tip,json=oldTip,proto3" json:"old_tip,omitempty"`
+	NewTip        *BTCHeaderInfo `protobuf:"bytes,5,opt,name=new_tip,json=newTip,proto3" json:"new_tip,omitempty"`
+	ForkPoint     *BTCHeaderInfo `protobuf:"bytes,6,opt,name=fork_point,json=forkPoint,proto3" json:"fork_point,omitempty"`
+	Depth         uint32         `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	// annotations are the epochs other modules marked as touched by the reorg
+	Annotations []*BTCReorgAnnotation `protobuf:"bytes,8,rep,name=annotations,proto3" json:"annotations,omitempty"`
+}
+
+func (m *BTCReorgRecord) Reset()         { *m = BTCReorgRecord{} }
+func (m *BTCReorgRecord) String() string { return proto.CompactTextString(m) }
+func (*BTCReorgRecord) ProtoMessage()    {}
+func (*BTCReorgRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{2}
+}
+func (m *BTCReorgRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCReorgRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCReorgRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCReorgRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCReorgRecord.Merge(m, src)
+}
+func (m *BTCReorgRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCReorgRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCReorgRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCReorgRecord proto.InternalMessageInfo
+
+func (m *BTCReorgRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BTCReorgRecord) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *BTCReorgRecord) GetBabylonHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeight
+	}
+	return 0
+}
+
+func (m *BTCReorgRecord) GetOldTip() *BTCHeaderInfo {
+	if m != nil {
+		return m.OldTip
+	}
+	return nil
+}
+
+func (m *BTCReorgRecord) GetNewTip() *BTCHeaderInfo {
+	if m != nil {
+		return m.NewTip
+	}
+	return nil
+}
+
+func (m *BTCReorgRecord) GetForkPoint() *BTCHeaderInfo {
+	if m != nil {
+		return m.ForkPoint
+	}
+	return nil
+}
+
+func (m *BTCReorgRecord) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *BTCReorgRecord) GetAnnotations() []*BTCReorgAnnotation {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+// HeaderAccumulator is a Merkle Mountain Range committing to the hashes of the
+// headers pruned from the store, in height order
+type HeaderAccumulator struct {
+	// start_height is the height of the first header committed into the
+	// accumulator
+	StartHeight uint32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// num_leaves is the number of headers committed into the accumulator
+	NumLeaves uint64 `protobuf:"varint,2,opt,name=num_leaves,json=numLeaves,proto3" json:"num_leaves,omitempty"`
+	// peaks are the roots of the perfect binary trees of the accumulator,
+	// ordered from the largest to the smallest tree
+	Peaks [][]byte `protobuf:"bytes,3,rep,name=peaks,proto3" json:"peaks,omitempty"`
+}
+
+func (m *HeaderAccumulator) Reset()         { *m = HeaderAccumulator{} }
+func (m *HeaderAccumulator) String() string { return proto.CompactTextString(m) }
+func (*HeaderAccumulator) ProtoMessage()    {}
+func (*HeaderAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{3}
+}
+func (m *HeaderAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeaderAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderAccumulator.Merge(m, src)
+}
+func (m *HeaderAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderAccumulator proto.InternalMessageInfo
+
+func (m *HeaderAccumulator) GetStartHeight() uint32 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *HeaderAccumulator) GetNumLeaves() uint64 {
+	if m != nil {
+		return m.NumLeaves
+	}
+	return 0
+}
+
+func (m *HeaderAccumulator) GetPeaks() [][]byte {
+	if m != nil {
+		return m.Peaks
+	}
+	return nil
+}
+
+// HeaderAccumulatorProof proves that a header is committed into the header
+// accumulator at the given height
+type HeaderAccumulatorProof struct {
+	// height is the height of the header
+	Height uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// siblings are the sibling nodes on the path from the header to the peak of
+	// the tree containing it, ordered from the leaf level
+	Siblings [][]byte `protobuf:"bytes,2,rep,name=siblings,proto3" json:"siblings,omitempty"`
+}
+
+func (m *HeaderAccumulatorProof) Reset()         { *m = HeaderAccumulatorProof{} }
+func (m *HeaderAccumulatorProof) String() string { return proto.CompactTextString(m) }
+func (*HeaderAccumulatorProof) ProtoMessage()    {}
+func (*HeaderAccumulatorProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84bf438d909b681d, []int{4}
+}
+func (m *HeaderAccumulatorProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeaderAccumulatorProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeaderAccumulatorProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeaderAccumulatorProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderAccumulatorProof.Merge(m, src)
+}
+func (m *HeaderAccumulatorProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeaderAccumulatorProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderAccumulatorProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderAccumulatorProof proto.InternalMessageInfo
+
+func (m *HeaderAccumulatorProof) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HeaderAccumulatorProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BTCHeaderInfo)(nil), "babylon.btclightclient.v1.BTCHeaderInfo")
+	proto.RegisterType((*BTCReorgAnnotation)(nil), "babylon.btclightclient.v1.BTCReorgAnnotation")
+	proto.RegisterType((*BTCReorgRecord)(nil), "babylon.btclightclient.v1.BTCReorgRecord")
+	proto.RegisterType((*HeaderAccumulator)(nil), "babylon.btclightclient.v1.HeaderAccumulator")
+	proto.RegisterType((*HeaderAccumulatorProof)(nil), "babylon.btclightclient.v1.HeaderAccumulatorProof")
 }
 
 func init() {
@@ -89,25 +385,46 @@ func init() {
 }
 
 var fileDescriptor_84bf438d909b681d = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0x68, 0x22, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x92, 0x50, 0xf5, 0x7a,
-	0x68, 0xb2, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x55, 0xfa, 0x20, 0x16, 0x44,
-	0x83, 0xd2, 0x7f, 0x46, 0x2e, 0x5e, 0xa7, 0x10, 0x67, 0x8f, 0xd4, 0xc4, 0x94, 0xd4, 0x22, 0xcf,
-	0xbc, 0xb4, 0x7c, 0xa1, 0x20, 0x2e, 0xb6, 0x0c, 0x30, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0xc7,
-	0xc9, 0xea, 0xd6, 0x3d, 0x79, 0xb3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0x7d, 0xa8, 0x0d, 0x39, 0x89, 0x49, 0xc5, 0xba, 0x99, 0xf9, 0x30, 0xae, 0x7e, 0x49, 0x65, 0x41,
-	0x6a, 0xb1, 0x1e, 0xdc, 0x28, 0xa7, 0xca, 0x92, 0xd4, 0xe2, 0x20, 0xa8, 0x49, 0x42, 0x41, 0x5c,
-	0x2c, 0x19, 0x89, 0xc5, 0x19, 0x12, 0x4c, 0x60, 0x13, 0xed, 0x6e, 0xdd, 0x93, 0xb7, 0x22, 0xd9,
-	0x44, 0x8f, 0xc4, 0xe2, 0x0c, 0x88, 0xa9, 0x60, 0xb3, 0x84, 0xc4, 0x40, 0xee, 0x04, 0x79, 0x51,
-	0x82, 0x59, 0x81, 0x51, 0x83, 0x37, 0x08, 0xca, 0x13, 0xd2, 0xe3, 0x62, 0x29, 0xcf, 0x2f, 0xca,
-	0x96, 0x60, 0x01, 0xdb, 0x25, 0x75, 0xeb, 0x9e, 0xbc, 0x58, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71,
-	0x71, 0x4a, 0xb6, 0x5e, 0x66, 0xbe, 0x7e, 0x6e, 0x62, 0x49, 0x86, 0x5e, 0x68, 0x66, 0x5e, 0x49,
-	0x10, 0x58, 0x9d, 0x53, 0xd0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59,
-	0x10, 0x76, 0x63, 0x05, 0x7a, 0xc4, 0x80, 0x1d, 0x9d, 0xc4, 0x06, 0x0e, 0x5c, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x5d, 0xd6, 0x30, 0xf9, 0xbf, 0x01, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xc1, 0x6a, 0xdb, 0x4c,
+	0x10, 0xc7, 0x2d, 0x5b, 0x71, 0xe2, 0xb5, 0x13, 0xf8, 0x44, 0x08, 0xfa, 0x0c, 0xb5, 0x5d, 0x43,
+	0xc1, 0x97, 0xac, 0x48, 0x0a, 0xa5, 0xe4, 0x50, 0x88, 0x52, 0x68, 0x0a, 0x81, 0x86, 0xc5, 0xbd,
+	0xf4, 0x62, 0x56, 0xd2, 0x5a, 0x5a, 0x2c, 0xed, 0x08, 0xed, 0x2a, 0x69, 0xde, 0x22, 0xaf, 0xd4,
+	0x5b, 0x8e, 0x39, 0x96, 0x1c, 0xdc, 0x92, 0x3c, 0x48, 0x8b, 0x56, 0xb2, 0x9b, 0xb8, 0xb4, 0xc5,
+	0x17, 0xe3, 0xff, 0x68, 0xe6, 0xb7, 0xa3, 0xff, 0xcc, 0x0a, 0x61, 0x8f, 0x7a, 0x57, 0x31, 0x08,
+	0xc7, 0x53, 0x7e, 0xcc, 0xc3, 0xa8, 0xf8, 0x65, 0x42, 0x39, 0x17, 0x07, 0x2b, 0x11, 0x9c, 0x66,
+	0xa0, 0xc0, 0xfa, 0xbf, 0xca, 0xc7, 0x2b, 0x4f, 0x2f, 0x0e, 0xba, 0xbb, 0x21, 0x84, 0xa0, 0xb3,
+	0x9c, 0xe2, 0x5f, 0x59, 0xd0, 0xed, 0x87, 0x00, 0x61, 0xcc, 0x1c, 0xad, 0xbc, 0x7c, 0xea, 0x28,
+	0x9e, 0x30, 0xa9, 0x68, 0x92, 0x96, 0x09, 0xc3, 0x1f, 0x06, 0xda, 0x76, 0xc7, 0x27, 0xa7, 0x8c,
+	0x06, 0x2c, 0x7b, 0x2f, 0xa6, 0x60, 0x11, 0xd4, 0x8c, 0xb4, 0xb2, 0x8d, 0x81, 0x31, 0xea, 0xb8,
+	0x47, 0x77, 0xf3, 0xfe, 0xab, 0x90, 0xab, 0x28, 0xf7, 0xb0, 0x0f, 0x89, 0x53, 0xb5, 0x10, 0x53,
+	0x4f, 0xee, 0x73, 0x58, 0x48, 0x47, 0x5d, 0xa5, 0x4c, 0xe2, 0x25, 0xca, 0xbd, 0x52, 0x4c, 0x92,
+	0x8a, 0x64, 0x11, 0x64, 0x46, 0x54, 0x46, 0x76, 0x5d, 0x13, 0xdf, 0xdc, 0xcd, 0xfb, 0x47, 0x6b,
+	0x13, 0x4f, 0xa9, 0x8c, 0x4a, 0xaa, 0x66, 0x59, 0x7b, 0x45, 0x9f, 0x85, 0x07, 0x76, 0x63, 0x60,
+	0x8c, 0xb6, 0x49, 0xa5, 0x2c, 0x8c, 0xcc, 0x4b, 0xc8, 0x66, 0xb6, 0xa9, 0xcf, 0xea, 0xde, 0xcd,
+	0xfb, 0x7b, 0x3e, 0xc8, 0x04, 0xa4, 0x0c, 0x66, 0x98, 0x83, 0x93, 0x50, 0x15, 0xe1, 0x8f, 0x5c,
+	0x28, 0xa2, 0xf3, 0x86, 0x6f, 0x91, 0xe5, 0x8e, 0x4f, 0x08, 0x83, 0x2c, 0x3c, 0x16, 0x02, 0x14,
+	0x55, 0x1c, 0x44, 0x41, 0x4f, 0x20, 0xc8, 0x63, 0xa6, 0x5d, 0x68, 0x91, 0x4a, 0x15, 0x71, 0x96,
+	0x82, 0x1f, 0x49, 0xbb, 0x3e, 0x68, 0x8c, 0x4c, 0x52, 0xa9, 0xe1, 0x97, 0x06, 0xda, 0x59, 0x60,
+	0x08, 0xf3, 0x21, 0x0b, 0xac, 0x1d, 0x54, 0xe7, 0x81, 0x2e, 0x37, 0x49, 0x9d, 0x07, 0x96, 0x8b,
+	0x5a, 0x4b, 0xf7, 0xb5, 0x13, 0xed, 0xc3, 0x2e, 0x2e, 0xe7, 0x83, 0x17, 0xf3, 0xc1, 0xe3, 0x45,
+	0x86, 0xbb, 0x75, 0x33, 0xef, 0xd7, 0xae, 0xbf, 0xf5, 0x0d, 0xf2, 0xab, 0xcc, 0x7a, 0x81, 0x76,
+	0x2a, 0x7b, 0x26, 0x8f, 0x5e, 0xde, 0x24, 0xdb, 0x55, 0xf4, 0xb4, 0xf4, 0xe0, 0x18, 0x6d, 0x42,
+	0x1c, 0x4c, 0x14, 0x4f, 0xb5, 0x0d, 0xed, 0xc3, 0x11, 0xfe, 0xe3, 0xe6, 0xe0, 0x27, 0xe3, 0x27,
+	0x4d, 0x88, 0x83, 0x31, 0x4f, 0x0b, 0x84, 0x60, 0x97, 0x1a, 0xb1, 0xb1, 0x2e, 0x42, 0xb0, 0xcb,
+	0x02, 0xf1, 0x0e, 0xa1, 0x29, 0x64, 0xb3, 0x49, 0x0a, 0x5c, 0x28, 0xbb, 0xb9, 0x26, 0xa5, 0x55,
+	0xd4, 0x9e, 0x17, 0xa5, 0xd6, 0x2e, 0xda, 0x08, 0x58, 0xaa, 0x22, 0x7b, 0x53, 0x4f, 0xba, 0x14,
+	0xd6, 0x07, 0xd4, 0xa6, 0xcb, 0x81, 0x49, 0x7b, 0x6b, 0xd0, 0x18, 0xb5, 0x0f, 0xf7, 0xff, 0xce,
+	0x5f, 0x19, 0x33, 0x79, 0x4c, 0x18, 0xce, 0xd0, 0x7f, 0xe5, 0xf9, 0xc7, 0xbe, 0x9f, 0x27, 0x79,
+	0x4c, 0x15, 0x64, 0xd6, 0x73, 0xd4, 0x91, 0x8a, 0x66, 0x6a, 0xe1, 0xb7, 0xa1, 0x5b, 0x68, 0xeb,
+	0x58, 0xe5, 0xf6, 0x33, 0x84, 0x44, 0x9e, 0x4c, 0x62, 0x46, 0x2f, 0x98, 0xd4, 0x93, 0x35, 0x49,
+	0x4b, 0xe4, 0xc9, 0x99, 0x0e, 0x14, 0xdd, 0xa7, 0x8c, 0xce, 0xa4, 0xdd, 0x18, 0x34, 0x46, 0x1d,
+	0x52, 0x8a, 0xe1, 0x19, 0xda, 0xfb, 0xed, 0xb0, 0xf3, 0x0c, 0x60, 0xfa, 0x68, 0xb1, 0x8d, 0x27,
+	0x8b, 0xdd, 0x45, 0x5b, 0x92, 0x7b, 0x31, 0x17, 0x61, 0xb9, 0x7c, 0x1d, 0xb2, 0xd4, 0x2e, 0xb9,
+	0xb9, 0xef, 0x19, 0xb7, 0xf7, 0x3d, 0xe3, 0xfb, 0x7d, 0xcf, 0xb8, 0x7e, 0xe8, 0xd5, 0x6e, 0x1f,
+	0x7a, 0xb5, 0xaf, 0x0f, 0xbd, 0xda, 0xa7, 0xd7, 0xff, 0xbe, 0x68, 0x9f, 0x57, 0x3f, 0x3f, 0xfa,
+	0xe6, 0x79, 0x4d, 0xbd, 0x94, 0x2f, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xb3, 0x4f, 0x6f,
+	0xa5, 0x04, 0x00, 0x00,
 }
 
 func (m *BTCHeaderInfo) Marshal() (dAtA []byte, err error) {
@@ -137,85 +454,1019 @@ func (m *BTCHeaderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			if _, err := m.Work.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Height != 0 {
-		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Hash != nil {
-		{
-			size := m.Hash.Size()
-			i -= size
-			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Hash != nil {
+		{
+			size := m.Hash.Size()
+			i -= size
+			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Header != nil {
+		{
+			size := m.Header.Size()
+			i -= size
+			if _, err := m.Header.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCReorgAnnotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCReorgAnnotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCReorgAnnotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		dAtA2 := make([]byte, len(m.Epochs)*10)
+		var j1 int
+		for _, num := range m.Epochs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBtclightclient(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCReorgRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCReorgRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCReorgRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for iNdEx := len(m.Annotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Annotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Depth != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ForkPoint != nil {
+		{
+			size, err := m.ForkPoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NewTip != nil {
+		{
+			size, err := m.NewTip.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OldTip != nil {
+		{
+			size, err := m.OldTip.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BabylonHeight != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.BabylonHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintBtclightclient(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeaderAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peaks) > 0 {
+		for iNdEx := len(m.Peaks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Peaks[iNdEx])
+			copy(dAtA[i:], m.Peaks[iNdEx])
+			i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Peaks[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumLeaves != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.NumLeaves))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeaderAccumulatorProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeaderAccumulatorProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeaderAccumulatorProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintBtclightclient(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintBtclightclient(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBtclightclient(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBtclightclient(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BTCHeaderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBtclightclient(uint64(m.Height))
+	}
+	if m.Work != nil {
+		l = m.Work.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCReorgAnnotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if len(m.Epochs) > 0 {
+		l = 0
+		for _, e := range m.Epochs {
+			l += sovBtclightclient(uint64(e))
+		}
+		n += 1 + sovBtclightclient(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BTCReorgRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBtclightclient(uint64(m.Id))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovBtclightclient(uint64(l))
+	if m.BabylonHeight != 0 {
+		n += 1 + sovBtclightclient(uint64(m.BabylonHeight))
+	}
+	if m.OldTip != nil {
+		l = m.OldTip.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.NewTip != nil {
+		l = m.NewTip.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.ForkPoint != nil {
+		l = m.ForkPoint.Size()
+		n += 1 + l + sovBtclightclient(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovBtclightclient(uint64(m.Depth))
+	}
+	if len(m.Annotations) > 0 {
+		for _, e := range m.Annotations {
+			l = e.Size()
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HeaderAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovBtclightclient(uint64(m.StartHeight))
+	}
+	if m.NumLeaves != 0 {
+		n += 1 + sovBtclightclient(uint64(m.NumLeaves))
+	}
+	if len(m.Peaks) > 0 {
+		for _, b := range m.Peaks {
+			l = len(b)
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HeaderAccumulatorProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBtclightclient(uint64(m.Height))
+	}
+	if len(m.Siblings) > 0 {
+		for _, b := range m.Siblings {
+			l = len(b)
+			n += 1 + l + sovBtclightclient(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBtclightclient(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBtclightclient(x uint64) (n int) {
+	return sovBtclightclient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCHeaderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCHeaderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BTCHeaderBytes
+			m.Header = &v
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.Work = &v
+			if err := m.Work.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCReorgAnnotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCReorgAnnotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCReorgAnnotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtclightclient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Epochs = append(m.Epochs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBtclightclient
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBtclightclient
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBtclightclient
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Epochs) == 0 {
+					m.Epochs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBtclightclient
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Epochs = append(m.Epochs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCReorgRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCReorgRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCReorgRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeight", wireType)
+			}
+			m.BabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldTip == nil {
+				m.OldTip = &BTCHeaderInfo{}
+			}
+			if err := m.OldTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewTip == nil {
+				m.NewTip = &BTCHeaderInfo{}
 			}
-			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Header != nil {
-		{
-			size := m.Header.Size()
-			i -= size
-			if _, err := m.Header.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+			if err := m.NewTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			i = encodeVarintBtclightclient(dAtA, i, uint64(size))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkPoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForkPoint == nil {
+				m.ForkPoint = &BTCHeaderInfo{}
+			}
+			if err := m.ForkPoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotations = append(m.Annotations, &BTCReorgAnnotation{})
+			if err := m.Annotations[len(m.Annotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintBtclightclient(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBtclightclient(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *BTCHeaderInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovBtclightclient(uint64(l))
-	}
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovBtclightclient(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovBtclightclient(uint64(m.Height))
-	}
-	if m.Work != nil {
-		l = m.Work.Size()
-		n += 1 + l + sovBtclightclient(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBtclightclient(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBtclightclient(x uint64) (n int) {
-	return sovBtclightclient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
+func (m *HeaderAccumulator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -238,17 +1489,17 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCHeaderInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeaderAccumulator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCHeaderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeaderAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var byteLen int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBtclightclient
@@ -258,30 +1509,33 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBtclightclient
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBtclightclient
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLeaves", wireType)
 			}
-			var v github_com_babylonlabs_io_babylon_types.BTCHeaderBytes
-			m.Header = &v
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NumLeaves = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtclightclient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLeaves |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peaks", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -308,13 +1562,60 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BTCHeaderHashBytes
-			m.Hash = &v
-			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Peaks = append(m.Peaks, make([]byte, postIndex-iNdEx))
+			copy(m.Peaks[len(m.Peaks)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtclightclient(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtclightclient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderAccumulatorProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtclightclient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderAccumulatorProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderAccumulatorProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
@@ -333,9 +1634,9 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -362,11 +1663,8 @@ func (m *BTCHeaderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Uint
-			m.Work = &v
-			if err := m.Work.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Siblings = append(m.Siblings, make([]byte, postIndex-iNdEx))
+			copy(m.Siblings[len(m.Siblings)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
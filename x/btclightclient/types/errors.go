@@ -16,4 +16,6 @@ var (
 	ErrChainWithNotEnoughWork   = errorsmod.Register(ModuleName, 1105, "provided chain has not enough work")
 	ErrUnauthorizedReporter     = errorsmod.Register(ModuleName, 1106, "unauthorized reporter")
 	ErrInvalidMessageFormat     = errorsmod.Register(ModuleName, 1107, "invalid message format")
+	ErrInvalidAccumulatorProof  = errorsmod.Register(ModuleName, 1108, "invalid header accumulator proof")
+	ErrInsufficientWork         = errorsmod.Register(ModuleName, 1109, "inserted headers do not carry sufficient work")
 )
@@ -12,6 +12,21 @@ type BTCLightClientHooks interface {
 	AfterBTCHeaderInserted(ctx context.Context, headerInfo *BTCHeaderInfo) // Must be called after a header is inserted
 }
 
+type BtcCheckpointKeeper interface {
+	// LowestReferencedHeaderHeight returns the height of the lowest header
+	// referenced by the checkpoint submissions that are still tracked, and
+	// false if there is no such header
+	LowestReferencedHeaderHeight(ctx context.Context) (uint32, bool)
+}
+
+type BtcStakingKeeper interface {
+	// LowestReferencedHeaderHeight returns the height of the lowest header
+	// that the inclusion proofs accepted by the BTC staking module can still
+	// reference, and false if there is no such header
+	LowestReferencedHeaderHeight(ctx context.Context) (uint32, bool)
+}
+
 type IncentiveKeeper interface {
 	IndexRefundableMsg(ctx context.Context, msg sdk.Msg)
+	RewardBTCHeaderRelaying(ctx context.Context, reporter sdk.AccAddress, numHeaders uint32)
 }
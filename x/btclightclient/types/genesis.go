@@ -72,6 +72,17 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	// the accumulator must commit to all the headers pruned before the first
+	// header
+	if gs.HeaderAccumulator != nil {
+		if err := gs.HeaderAccumulator.Validate(); err != nil {
+			return fmt.Errorf("invalid header accumulator in genesis: %w", err)
+		}
+		if gs.HeaderAccumulator.NextHeight() != gs.BtcHeaders[0].Height {
+			return fmt.Errorf("header accumulator must end right before the first btc header")
+		}
+	}
 	// TODO: validate headers have proper parent-child relationships and proper proof of work
 
 	return nil
@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params     Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	BtcHeaders []*BTCHeaderInfo `protobuf:"bytes,2,rep,name=btc_headers,json=btcHeaders,proto3" json:"btc_headers,omitempty"`
+	// header_accumulator commits to the headers pruned before btc_headers
+	HeaderAccumulator *HeaderAccumulator `protobuf:"bytes,3,opt,name=header_accumulator,json=headerAccumulator,proto3" json:"header_accumulator,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +78,13 @@ func (m *GenesisState) GetBtcHeaders() []*BTCHeaderInfo {
 	return nil
 }
 
+func (m *GenesisState) GetHeaderAccumulator() *HeaderAccumulator {
+	if m != nil {
+		return m.HeaderAccumulator
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btclightclient.v1.GenesisState")
 }
@@ -85,24 +94,26 @@ func init() {
 }
 
 var fileDescriptor_4f95902e4096217a = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
+	// 294 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
 	0xfa, 0x65, 0x86, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25,
 	0xf9, 0x42, 0x92, 0x50, 0x85, 0x7a, 0xa8, 0x0a, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
 	0xf3, 0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88, 0x06, 0x29, 0x3d, 0xdc, 0x26, 0xa3, 0x19, 0x01, 0x51,
-	0xaf, 0x86, 0x5b, 0x7d, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x21, 0x4a, 0xb3, 0x18, 0xb9, 0x78,
+	0xaf, 0x86, 0x5b, 0x7d, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x21, 0x4a, 0xdf, 0x19, 0xb9, 0x78,
 	0xdc, 0x21, 0x4e, 0x0b, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe7, 0x62, 0x83, 0x28, 0x90, 0x60,
 	0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd4, 0xc3, 0xe9, 0x54, 0xbd, 0x00, 0xb0, 0x42, 0x27, 0x96,
 	0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xda, 0x84, 0x3c, 0xb9, 0xb8, 0x93, 0x4a, 0x92, 0xe3, 0x33,
 	0x52, 0x13, 0x53, 0x52, 0x8b, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x34, 0xf0, 0x98,
 	0xe2, 0x14, 0xe2, 0xec, 0x01, 0x56, 0xec, 0x99, 0x97, 0x96, 0x1f, 0xc4, 0x95, 0x54, 0x92, 0x0c,
-	0xe1, 0x16, 0x3b, 0x05, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xe4, 0x9c, 0xc4, 0xa4,
-	0x62, 0xdd, 0xcc, 0x7c, 0x18, 0x57, 0xbf, 0x02, 0xdd, 0xeb, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0x60, 0x7f, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbc, 0xe4, 0xd2, 0xd7, 0xab, 0x01,
-	0x00, 0x00,
+	0xe1, 0x16, 0x0b, 0x45, 0x73, 0x09, 0x41, 0x8c, 0x89, 0x4f, 0x4c, 0x4e, 0x2e, 0xcd, 0x2d, 0xcd,
+	0x49, 0x2c, 0xc9, 0x2f, 0x92, 0x60, 0x06, 0xbb, 0x4b, 0x07, 0x8f, 0x89, 0x10, 0xfd, 0x8e, 0x08,
+	0x3d, 0x41, 0x82, 0x19, 0xe8, 0x42, 0x4e, 0x41, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
+	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
+	0xc7, 0x10, 0x65, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb5,
+	0x24, 0x27, 0x31, 0xa9, 0x58, 0x37, 0x33, 0x1f, 0xc6, 0xd5, 0xaf, 0x40, 0x0f, 0xd7, 0x92, 0xca,
+	0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xa0, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0a, 0x2f,
+	0x11, 0xc5, 0x08, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +136,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HeaderAccumulator != nil {
+		{
+			size, err := m.HeaderAccumulator.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.BtcHeaders) > 0 {
 		for iNdEx := len(m.BtcHeaders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +200,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.HeaderAccumulator != nil {
+		l = m.HeaderAccumulator.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -282,6 +309,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderAccumulator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeaderAccumulator == nil {
+				m.HeaderAccumulator = &HeaderAccumulator{}
+			}
+			if err := m.HeaderAccumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,11 +23,18 @@ const (
 )
 
 var (
-	HeadersObjectPrefix = []byte{0x01} // reserve this namespace mapping: Height -> BTCHeaderInfo
-	HashToHeightPrefix  = []byte{0x02} // reserve this namespace mapping: Hash -> Height
-	ParamsKey           = []byte{0x03} // key for params
+	HeadersObjectPrefix  = []byte{0x01} // reserve this namespace mapping: Height -> BTCHeaderInfo
+	HashToHeightPrefix   = []byte{0x02} // reserve this namespace mapping: Hash -> Height
+	ParamsKey            = []byte{0x03} // key for params
+	ReorgRecordPrefix    = []byte{0x04} // reserve this namespace mapping: ReorgID -> BTCReorgRecord
+	NextReorgIDKey       = []byte{0x05} // key for the ID of the next reorg record
+	HeaderAccumulatorKey = []byte{0x06} // key for the accumulator of pruned headers
 )
 
+// MaxReorgRecords is the maximum number of reorg records kept in the reorg
+// log. Once the limit is reached, the oldest record is evicted.
+const MaxReorgRecords uint64 = 100
+
 func HeadersObjectKey(height uint32) []byte {
 	return sdk.Uint64ToBigEndian(uint64(height))
 }
@@ -35,3 +42,7 @@ func HeadersObjectKey(height uint32) []byte {
 func HeadersObjectHeightKey(hash *bbn.BTCHeaderHashBytes) []byte {
 	return hash.MustMarshal()
 }
+
+func ReorgRecordKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
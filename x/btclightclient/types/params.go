@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,6 +11,7 @@ import (
 func NewParams(allowedAddresses []string) Params {
 	return Params{
 		InsertHeadersAllowList: allowedAddresses,
+		PermissionlessMinWork:  sdkmath.ZeroUint(),
 	}
 }
 
@@ -44,15 +46,61 @@ func ValidateAddressList(i interface{}) error {
 	return nil
 }
 
+// MinPruningDepth is the minimum pruning depth when pruning is enabled. It
+// guarantees that the headers needed to validate difficulty adjustments and
+// to handle reorgs are always kept.
+const MinPruningDepth uint32 = 2016
+
+func ValidatePruningDepth(depth uint32) error {
+	if depth != 0 && depth < MinPruningDepth {
+		return fmt.Errorf("pruning depth must be either 0 or at least %d, got %d", MinPruningDepth, depth)
+	}
+
+	return nil
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if err := ValidateAddressList(p.InsertHeadersAllowList); err != nil {
 		return err
 	}
 
+	if err := ValidatePruningDepth(p.PruningDepth); err != nil {
+		return err
+	}
+
+	if p.PermissionlessMinWork.IsNil() {
+		return fmt.Errorf("permissionless min work should not be nil")
+	}
+
 	return nil
 }
 
+// PruningEnabled returns true if headers below the pruning depth are pruned
+func (p *Params) PruningEnabled() bool {
+	return p.PruningDepth != 0
+}
+
+// AllowAllReporters returns true if any address can insert headers, either
+// in permissionless relaying mode or due to an empty allow list
 func (p *Params) AllowAllReporters() bool {
-	return len(p.InsertHeadersAllowList) == 0
+	return p.PermissionlessRelaying || len(p.InsertHeadersAllowList) == 0
+}
+
+// IsAllowListed returns true if the given address is in the allow list
+func (p *Params) IsAllowListed(addr sdk.AccAddress) bool {
+	for _, allowedAddr := range p.InsertHeadersAllowList {
+		if sdk.MustAccAddressFromBech32(allowedAddr).Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequiresSufficientWork returns true if the headers inserted by the given
+// address must increase the total work of the main chain by at least
+// PermissionlessMinWork, i.e., if the address is outside the allow list in
+// permissionless relaying mode
+func (p *Params) RequiresSufficientWork(addr sdk.AccAddress) bool {
+	return p.PermissionlessRelaying && !p.IsAllowListed(addr)
 }
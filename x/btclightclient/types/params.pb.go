@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,8 +27,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	// List of addresses which are allowed to insert headers to btc light client
-	// if the list is empty, any address can insert headers
+	// if the list is empty, any address can insert headers. It is ignored in
+	// permissionless relaying mode
 	InsertHeadersAllowList []string `protobuf:"bytes,1,rep,name=insert_headers_allow_list,json=insertHeadersAllowList,proto3" json:"insert_headers_allow_list,omitempty"`
+	// pruning_depth is the depth below which headers of the main chain are
+	// pruned from the store and committed into the header accumulator.
+	// If it is zero, pruning is disabled.
+	PruningDepth uint32 `protobuf:"varint,2,opt,name=pruning_depth,json=pruningDepth,proto3" json:"pruning_depth,omitempty"`
+	// permissionless_relaying enables the permissionless relaying mode, in which
+	// any address can insert headers to btc light client, provided that the
+	// inserted headers carry sufficient work
+	PermissionlessRelaying bool `protobuf:"varint,3,opt,name=permissionless_relaying,json=permissionlessRelaying,proto3" json:"permissionless_relaying,omitempty"`
+	// permissionless_min_work is the minimum work by which headers inserted by
+	// addresses outside insert_headers_allow_list must increase the total work
+	// of the btc light client main chain in permissionless relaying mode
+	PermissionlessMinWork cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=permissionless_min_work,json=permissionlessMinWork,proto3,customtype=cosmossdk.io/math.Uint" json:"permissionless_min_work"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +84,20 @@ func (m *Params) GetInsertHeadersAllowList() []string {
 	return nil
 }
 
+func (m *Params) GetPruningDepth() uint32 {
+	if m != nil {
+		return m.PruningDepth
+	}
+	return 0
+}
+
+func (m *Params) GetPermissionlessRelaying() bool {
+	if m != nil {
+		return m.PermissionlessRelaying
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.btclightclient.v1.Params")
 }
@@ -79,21 +107,29 @@ func init() {
 }
 
 var fileDescriptor_1e4c5f7a17079e1f = []byte{
-	// 213 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0x2a, 0x49, 0xce, 0xc9, 0x4c, 0xcf, 0x00, 0x91, 0xa9, 0x79, 0x25,
-	0xfa, 0x65, 0x86, 0xfa, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x92, 0x50, 0x75, 0x7a, 0xa8, 0xea, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0xaa, 0xf4, 0x41, 0x2c, 0x88, 0x06, 0x25, 0x4f, 0x2e, 0xb6, 0x00, 0xb0, 0x01, 0x42, 0x96,
-	0x5c, 0x92, 0x99, 0x79, 0xc5, 0xa9, 0x45, 0x25, 0xf1, 0x19, 0xa9, 0x89, 0x29, 0xa9, 0x45, 0xc5,
-	0xf1, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0x39, 0x99, 0xc5, 0x25, 0x12, 0x8c, 0x0a, 0xcc, 0x1a,
-	0x9c, 0x41, 0x62, 0x10, 0x05, 0x1e, 0x10, 0x79, 0x47, 0x90, 0xb4, 0x4f, 0x66, 0x71, 0x89, 0x15,
-	0xcb, 0x8b, 0x05, 0xf2, 0x8c, 0x4e, 0x41, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x65, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x75, 0x60,
-	0x4e, 0x62, 0x52, 0xb1, 0x6e, 0x66, 0x3e, 0x8c, 0xab, 0x5f, 0x81, 0xee, 0xb3, 0x92, 0xca, 0x82,
-	0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x2b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x43, 0x29, 0x40,
-	0xa2, 0x00, 0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x3f, 0x4b, 0xc3, 0x40,
+	0x18, 0xc6, 0x73, 0xb6, 0x14, 0x0d, 0xed, 0x12, 0xb4, 0xa6, 0x0e, 0x69, 0x50, 0x90, 0x2c, 0x26,
+	0x14, 0x07, 0xff, 0x6c, 0x16, 0x07, 0x07, 0x05, 0x09, 0xa8, 0xe0, 0x12, 0x2e, 0xed, 0x91, 0x1c,
+	0xbd, 0xdc, 0x1b, 0xee, 0xbd, 0xb6, 0xf6, 0x5b, 0xf8, 0x11, 0xfc, 0x38, 0x1d, 0x3b, 0x8a, 0x43,
+	0x91, 0x76, 0xf1, 0x5b, 0x28, 0x69, 0xe3, 0x50, 0x5d, 0x8e, 0xbb, 0xf7, 0xf7, 0x7b, 0x6e, 0x78,
+	0x5e, 0xf3, 0x38, 0xa6, 0xf1, 0x44, 0x80, 0x0c, 0x62, 0xdd, 0x13, 0x3c, 0x49, 0x8b, 0x93, 0x49,
+	0x1d, 0x8c, 0x3a, 0x41, 0x4e, 0x15, 0xcd, 0xd0, 0xcf, 0x15, 0x68, 0xb0, 0x5a, 0xa5, 0xe7, 0x6f,
+	0x7a, 0xfe, 0xa8, 0x73, 0xb0, 0x9b, 0x40, 0x02, 0x2b, 0x2b, 0x28, 0x6e, 0xeb, 0xc0, 0xe1, 0x37,
+	0x31, 0x6b, 0xf7, 0xab, 0x1f, 0xac, 0x0b, 0xb3, 0xc5, 0x25, 0x32, 0xa5, 0xa3, 0x94, 0xd1, 0x3e,
+	0x53, 0x18, 0x51, 0x21, 0x60, 0x1c, 0x09, 0x8e, 0xda, 0x26, 0x6e, 0xc5, 0xdb, 0x09, 0x9b, 0x6b,
+	0xe1, 0x66, 0xcd, 0xaf, 0x0a, 0x7c, 0xcb, 0x51, 0x5b, 0x47, 0x66, 0x23, 0x57, 0x43, 0xc9, 0x65,
+	0x12, 0xf5, 0x59, 0xae, 0x53, 0x7b, 0xcb, 0x25, 0x5e, 0x23, 0xac, 0x97, 0xc3, 0xeb, 0x62, 0x66,
+	0x9d, 0x99, 0xfb, 0x39, 0x53, 0x19, 0x47, 0xe4, 0x20, 0x05, 0x43, 0x8c, 0x14, 0x13, 0x74, 0xc2,
+	0x65, 0x62, 0x57, 0x5c, 0xe2, 0x6d, 0x87, 0xcd, 0x4d, 0x1c, 0x96, 0xd4, 0x7a, 0xfc, 0x17, 0xcc,
+	0xb8, 0x8c, 0xc6, 0xa0, 0x06, 0x76, 0xd5, 0x25, 0x5e, 0xbd, 0xeb, 0x4c, 0xe7, 0x6d, 0xe3, 0x63,
+	0xde, 0x6e, 0xf6, 0x00, 0x33, 0x40, 0xec, 0x0f, 0x7c, 0x0e, 0x41, 0x46, 0x75, 0xea, 0x3f, 0x70,
+	0xa9, 0xc3, 0xbd, 0xcd, 0xf8, 0x1d, 0x97, 0x4f, 0xa0, 0x06, 0x97, 0xd5, 0xaf, 0xb7, 0x36, 0xe9,
+	0x86, 0xd3, 0x85, 0x43, 0x66, 0x0b, 0x87, 0x7c, 0x2e, 0x1c, 0xf2, 0xba, 0x74, 0x8c, 0xd9, 0xd2,
+	0x31, 0xde, 0x97, 0x8e, 0xf1, 0x7c, 0x9e, 0x70, 0x9d, 0x0e, 0x63, 0xbf, 0x07, 0x59, 0x50, 0xf6,
+	0x2a, 0x68, 0x8c, 0x27, 0x1c, 0x7e, 0x9f, 0xc1, 0xcb, 0xdf, 0x85, 0xe8, 0x49, 0xce, 0x30, 0xae,
+	0xad, 0xca, 0x3d, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0xac, 0x25, 0xf5, 0xe0, 0xb7, 0x01, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -123,6 +159,15 @@ func (this *Params) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.PruningDepth != that1.PruningDepth {
+		return false
+	}
+	if this.PermissionlessRelaying != that1.PermissionlessRelaying {
+		return false
+	}
+	if !this.PermissionlessMinWork.Equal(that1.PermissionlessMinWork) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -145,6 +190,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PermissionlessMinWork.Size()
+		i -= size
+		if _, err := m.PermissionlessMinWork.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PermissionlessRelaying {
+		i--
+		if m.PermissionlessRelaying {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PruningDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PruningDepth))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.InsertHeadersAllowList) > 0 {
 		for iNdEx := len(m.InsertHeadersAllowList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.InsertHeadersAllowList[iNdEx])
@@ -180,6 +250,14 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.PruningDepth != 0 {
+		n += 1 + sovParams(uint64(m.PruningDepth))
+	}
+	if m.PermissionlessRelaying {
+		n += 2
+	}
+	l = m.PermissionlessMinWork.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -250,6 +328,78 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.InsertHeadersAllowList = append(m.InsertHeadersAllowList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningDepth", wireType)
+			}
+			m.PruningDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessRelaying", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PermissionlessRelaying = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionlessMinWork", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PermissionlessMinWork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	"github.com/babylonlabs-io/babylon/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
@@ -40,3 +43,48 @@ func NewQueryTipRequest() *QueryTipRequest {
 func NewQueryBaseHeaderRequest() *QueryBaseHeaderRequest {
 	return &QueryBaseHeaderRequest{}
 }
+
+func NewQueryHeaderAccumulatorRequest() *QueryHeaderAccumulatorRequest {
+	return &QueryHeaderAccumulatorRequest{}
+}
+
+func NewQueryRecentReorgsRequest(req *query.PageRequest) *QueryRecentReorgsRequest {
+	return &QueryRecentReorgsRequest{Pagination: req}
+}
+
+// NewQueryVerifyTxInclusionRequest creates a new instance of
+// QueryVerifyTxInclusionRequest from the hex encoded tx, block hash and
+// merkle proof.
+func NewQueryVerifyTxInclusionRequest(txHex string, blockHash string, txIndex uint32, proofHex string) (*QueryVerifyTxInclusionRequest, error) {
+	txBytes, err := hex.DecodeString(txHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tx hex: %w", err)
+	}
+	if _, err := types.NewBTCHeaderHashBytesFromHex(blockHash); err != nil {
+		return nil, err
+	}
+	proof, err := hex.DecodeString(proofHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid merkle proof hex: %w", err)
+	}
+	return &QueryVerifyTxInclusionRequest{
+		Tx:          txBytes,
+		BlockHash:   blockHash,
+		TxIndex:     txIndex,
+		MerkleProof: proof,
+	}, nil
+}
+
+func NewQueryHeadersByHeightRangeRequest(startHeight, endHeight uint32) *QueryHeadersByHeightRangeRequest {
+	return &QueryHeadersByHeightRangeRequest{StartHeight: startHeight, EndHeight: endHeight}
+}
+
+// NewQueryBlockLocatorRequest creates a new instance of QueryBlockLocatorRequest.
+func NewQueryBlockLocatorRequest(locator []string, limit uint32) (*QueryBlockLocatorRequest, error) {
+	for _, hash := range locator {
+		if _, err := types.NewBTCHeaderHashBytesFromHex(hash); err != nil {
+			return nil, err
+		}
+	}
+	return &QueryBlockLocatorRequest{Locator: locator, Limit: limit}, nil
+}
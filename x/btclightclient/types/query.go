@@ -23,3 +23,17 @@ func ParseBTCHeadersToResponse(infos []*BTCHeaderInfo) (resp []*BTCHeaderInfoRes
 func (m *BTCHeaderInfoResponse) Eq(other *BTCHeaderInfo) bool {
 	return m.HashHex == other.Hash.MarshalHex()
 }
+
+// ToResponse parses a BTCReorgRecord into BTCReorgRecordResponse.
+func (r *BTCReorgRecord) ToResponse() *BTCReorgRecordResponse {
+	return &BTCReorgRecordResponse{
+		Id:            r.Id,
+		Timestamp:     r.Timestamp,
+		BabylonHeight: r.BabylonHeight,
+		OldTip:        r.OldTip.ToResponse(),
+		NewTip:        r.NewTip.ToResponse(),
+		ForkPoint:     r.ForkPoint.ToResponse(),
+		Depth:         r.Depth,
+		Annotations:   r.Annotations,
+	}
+}
@@ -13,19 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -811,1274 +815,3893 @@ func (m *BTCHeaderInfoResponse) GetHeight() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btclightclient.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btclightclient.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryHashesRequest)(nil), "babylon.btclightclient.v1.QueryHashesRequest")
-	proto.RegisterType((*QueryHashesResponse)(nil), "babylon.btclightclient.v1.QueryHashesResponse")
-	proto.RegisterType((*QueryContainsRequest)(nil), "babylon.btclightclient.v1.QueryContainsRequest")
-	proto.RegisterType((*QueryContainsResponse)(nil), "babylon.btclightclient.v1.QueryContainsResponse")
-	proto.RegisterType((*QueryContainsBytesRequest)(nil), "babylon.btclightclient.v1.QueryContainsBytesRequest")
-	proto.RegisterType((*QueryContainsBytesResponse)(nil), "babylon.btclightclient.v1.QueryContainsBytesResponse")
-	proto.RegisterType((*QueryMainChainRequest)(nil), "babylon.btclightclient.v1.QueryMainChainRequest")
-	proto.RegisterType((*QueryMainChainResponse)(nil), "babylon.btclightclient.v1.QueryMainChainResponse")
-	proto.RegisterType((*QueryTipRequest)(nil), "babylon.btclightclient.v1.QueryTipRequest")
-	proto.RegisterType((*QueryTipResponse)(nil), "babylon.btclightclient.v1.QueryTipResponse")
-	proto.RegisterType((*QueryBaseHeaderRequest)(nil), "babylon.btclightclient.v1.QueryBaseHeaderRequest")
-	proto.RegisterType((*QueryBaseHeaderResponse)(nil), "babylon.btclightclient.v1.QueryBaseHeaderResponse")
-	proto.RegisterType((*QueryHeaderDepthRequest)(nil), "babylon.btclightclient.v1.QueryHeaderDepthRequest")
-	proto.RegisterType((*QueryHeaderDepthResponse)(nil), "babylon.btclightclient.v1.QueryHeaderDepthResponse")
-	proto.RegisterType((*BTCHeaderInfoResponse)(nil), "babylon.btclightclient.v1.BTCHeaderInfoResponse")
+// QueryRecentReorgsRequest is the request type for the Query/RecentReorgs RPC
+// method.
+type QueryRecentReorgsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("babylon/btclightclient/v1/query.proto", fileDescriptor_3961270631e52721)
+func (m *QueryRecentReorgsRequest) Reset()         { *m = QueryRecentReorgsRequest{} }
+func (m *QueryRecentReorgsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentReorgsRequest) ProtoMessage()    {}
+func (*QueryRecentReorgsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{17}
 }
-
-var fileDescriptor_3961270631e52721 = []byte{
-	// 924 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x49, 0xea, 0x26, 0x2f, 0x54, 0xc0, 0x90, 0x06, 0x67, 0x05, 0x4e, 0xba, 0x25,
-	0x3f, 0x9a, 0xe2, 0x9d, 0x38, 0x01, 0xa9, 0xe2, 0x00, 0xc2, 0x41, 0x10, 0x90, 0x90, 0xca, 0x2a,
-	0xf4, 0x80, 0x2a, 0x45, 0xb3, 0xce, 0xb0, 0xbb, 0x34, 0xde, 0xd9, 0x7a, 0x37, 0x21, 0x16, 0xe2,
-	0xc2, 0x81, 0x33, 0x82, 0x1b, 0x07, 0x0e, 0x5c, 0xb8, 0x00, 0x27, 0xc4, 0xdf, 0xd0, 0x63, 0x05,
-	0x17, 0xd4, 0x43, 0x84, 0x12, 0xfe, 0x08, 0x8e, 0x68, 0x66, 0xde, 0xda, 0x5e, 0x3b, 0xf5, 0xda,
-	0x22, 0x97, 0x28, 0x33, 0xf3, 0xde, 0xfb, 0x7e, 0xe6, 0xf9, 0xed, 0x77, 0x17, 0x56, 0x3c, 0xee,
-	0xb5, 0x0f, 0x65, 0xc4, 0xbc, 0xb4, 0x71, 0x18, 0xfa, 0x81, 0xfa, 0x2b, 0xa2, 0x94, 0x1d, 0xd7,
-	0xd8, 0xc3, 0x23, 0xd1, 0x6a, 0x3b, 0x71, 0x4b, 0xa6, 0x92, 0x2e, 0x62, 0x98, 0x93, 0x0f, 0x73,
-	0x8e, 0x6b, 0xd6, 0xbc, 0x2f, 0x7d, 0xa9, 0xa3, 0x98, 0xfa, 0xcf, 0x24, 0x58, 0x8b, 0x0d, 0x99,
-	0x34, 0x65, 0xb2, 0x6f, 0x0e, 0xcc, 0x02, 0x8f, 0x5e, 0xf2, 0xa5, 0xf4, 0x0f, 0x05, 0xe3, 0x71,
-	0xc8, 0x78, 0x14, 0xc9, 0x94, 0xa7, 0xa1, 0x8c, 0xb2, 0xd3, 0x0d, 0x13, 0xcb, 0x3c, 0x9e, 0x08,
-	0x83, 0xc0, 0x8e, 0x6b, 0x9e, 0x48, 0x79, 0x8d, 0xc5, 0xdc, 0x0f, 0x23, 0x1d, 0x8c, 0xb1, 0xab,
-	0x4f, 0x87, 0x8f, 0x79, 0x8b, 0x37, 0xb1, 0xa6, 0x3d, 0x0f, 0xf4, 0x23, 0x55, 0xe9, 0xae, 0xde,
-	0x74, 0xc5, 0xc3, 0x23, 0x91, 0xa4, 0xf6, 0x3d, 0x78, 0x21, 0xb7, 0x9b, 0xc4, 0x32, 0x4a, 0x04,
-	0x7d, 0x0b, 0x4a, 0x26, 0xb9, 0x4c, 0x96, 0xc9, 0xfa, 0xdc, 0xd6, 0x0d, 0xe7, 0xa9, 0x77, 0x77,
-	0x4c, 0x6a, 0x7d, 0xfa, 0xd1, 0xe9, 0xd2, 0x84, 0x8b, 0x69, 0xf6, 0x7d, 0x54, 0xdb, 0xe5, 0x49,
-	0x20, 0x32, 0x35, 0xfa, 0x2e, 0x40, 0x97, 0x1f, 0x4b, 0xaf, 0x3a, 0xd8, 0x18, 0x75, 0x59, 0xc7,
-	0xf4, 0x1b, 0x2f, 0xeb, 0xdc, 0xe5, 0xbe, 0xc0, 0x5c, 0xb7, 0x27, 0xd3, 0xfe, 0x9d, 0x20, 0x76,
-	0x56, 0x1e, 0xb1, 0xef, 0x41, 0x29, 0xd0, 0x3b, 0x65, 0xb2, 0x3c, 0xb5, 0xfe, 0x4c, 0xfd, 0xcd,
-	0x27, 0xa7, 0x4b, 0x6f, 0xf8, 0x61, 0x1a, 0x1c, 0x79, 0x4e, 0x43, 0x36, 0x19, 0x5e, 0xe2, 0x90,
-	0x7b, 0x49, 0x35, 0x94, 0xd9, 0x92, 0xa5, 0xed, 0x58, 0x24, 0x4e, 0x7d, 0x6f, 0x67, 0x57, 0xf0,
-	0x03, 0xd1, 0x52, 0x45, 0xeb, 0xed, 0x54, 0x24, 0x2e, 0x56, 0xa3, 0xef, 0xe5, 0xb8, 0x27, 0x35,
-	0xf7, 0x5a, 0x21, 0xb7, 0x81, 0xca, 0x81, 0x7f, 0x06, 0xf3, 0x9a, 0x7b, 0x47, 0x46, 0x29, 0x0f,
-	0xa3, 0x4e, 0x63, 0x5c, 0x98, 0x56, 0x52, 0xba, 0x25, 0xff, 0x1f, 0x5b, 0xd7, 0xb2, 0xb7, 0xe1,
-	0x7a, 0x9f, 0x16, 0x76, 0xc9, 0x82, 0x99, 0x06, 0xee, 0x69, 0xc1, 0x19, 0xb7, 0xb3, 0xb6, 0x19,
-	0x2c, 0xe6, 0x92, 0x4c, 0x41, 0xa4, 0xa4, 0xbd, 0x94, 0xa8, 0x72, 0x07, 0xac, 0x8b, 0x12, 0x46,
-	0x90, 0xda, 0x47, 0xbe, 0x0f, 0x79, 0x18, 0xed, 0x04, 0x3c, 0x8c, 0x2e, 0x7b, 0x4a, 0x7e, 0x21,
-	0xb0, 0xd0, 0xaf, 0x80, 0x5c, 0x1f, 0xc0, 0xd5, 0x40, 0x37, 0xcd, 0x4c, 0xca, 0xdc, 0xd6, 0xe6,
-	0x90, 0x01, 0xef, 0x74, 0xf8, 0xfd, 0xe8, 0x53, 0xd9, 0xf9, 0x59, 0xb3, 0x02, 0x97, 0x37, 0x1c,
-	0xcf, 0xc3, 0xb3, 0x1a, 0x77, 0x2f, 0x8c, 0xb3, 0xc7, 0xf3, 0x3e, 0x3c, 0xd7, 0xdd, 0x42, 0xf6,
-	0x5d, 0x28, 0x19, 0x69, 0x6c, 0xcd, 0xf8, 0xe8, 0x98, 0x6f, 0x97, 0xb1, 0x3f, 0x75, 0x9e, 0x08,
-	0x13, 0x96, 0xe9, 0x36, 0xe0, 0xc5, 0x81, 0x93, 0x4b, 0x97, 0xaf, 0xa2, 0x88, 0x09, 0x79, 0x47,
-	0xc4, 0x69, 0x70, 0xd1, 0xa4, 0xcd, 0xe2, 0xa4, 0x6d, 0x42, 0x79, 0x30, 0x1c, 0xa1, 0xe6, 0xe1,
-	0xca, 0x81, 0xda, 0xd0, 0x09, 0xd7, 0x5c, 0xb3, 0xb0, 0x7f, 0x26, 0x70, 0xfd, 0x42, 0x04, 0xfa,
-	0x32, 0x80, 0x81, 0xd8, 0x0f, 0xc4, 0x09, 0xaa, 0xcc, 0x9a, 0x9d, 0x5d, 0x71, 0x42, 0x17, 0x61,
-	0x46, 0x49, 0xea, 0xc3, 0x49, 0x7d, 0x78, 0x55, 0xad, 0xd5, 0xd1, 0x82, 0xba, 0xbe, 0xba, 0x65,
-	0x79, 0x4a, 0x4b, 0xe1, 0x8a, 0xbe, 0x0d, 0xd3, 0x9f, 0xcb, 0xd6, 0x83, 0xf2, 0xb4, 0x0a, 0xaf,
-	0x57, 0x95, 0x19, 0x3e, 0x39, 0x5d, 0x5a, 0x30, 0x63, 0x90, 0x1c, 0x3c, 0x70, 0x42, 0xc9, 0x9a,
-	0x3c, 0x0d, 0x9c, 0x8f, 0xc3, 0x28, 0xfd, 0xe3, 0xb7, 0xea, 0x1c, 0x0e, 0x88, 0x5a, 0xba, 0x3a,
-	0x75, 0xeb, 0xdf, 0x59, 0xb8, 0xa2, 0x6f, 0x48, 0xbf, 0x25, 0x50, 0x32, 0xb6, 0x4a, 0xab, 0x43,
-	0xda, 0x3b, 0xe8, 0xe7, 0x96, 0x33, 0x6a, 0xb8, 0x69, 0x84, 0x7d, 0xeb, 0xab, 0x3f, 0xff, 0xf9,
-	0x6e, 0xf2, 0x26, 0xbd, 0xc1, 0x8a, 0x5e, 0x23, 0x1a, 0xca, 0xf8, 0x6d, 0x31, 0x54, 0xce, 0xf6,
-	0x8b, 0xa1, 0xf2, 0x36, 0x3e, 0x12, 0x14, 0x3a, 0xf3, 0xf7, 0x04, 0x66, 0x32, 0xeb, 0xa1, 0xac,
-	0x48, 0xa7, 0xcf, 0x76, 0xad, 0xcd, 0xd1, 0x13, 0x10, 0xed, 0xb6, 0x46, 0x5b, 0xa1, 0x37, 0x87,
-	0xa0, 0x65, 0x0e, 0x47, 0x7f, 0x25, 0x70, 0x2d, 0xe7, 0x8b, 0xf4, 0xb5, 0x51, 0x05, 0x7b, 0x7d,
-	0xd7, 0x7a, 0x7d, 0xcc, 0x2c, 0x64, 0xdd, 0xd4, 0xac, 0x1b, 0x74, 0x7d, 0x04, 0x56, 0x83, 0xf7,
-	0x03, 0x81, 0xd9, 0x8e, 0x59, 0xd2, 0xc2, 0xee, 0xf4, 0x3b, 0xb7, 0x55, 0x1b, 0x23, 0x03, 0x21,
-	0x5f, 0xd5, 0x90, 0xab, 0xf4, 0x95, 0x21, 0x90, 0x4d, 0x1e, 0x46, 0x0d, 0x8d, 0xf4, 0x35, 0x81,
-	0xa9, 0xbd, 0x30, 0xa6, 0x1b, 0x45, 0x42, 0x5d, 0x0f, 0xb5, 0x6e, 0x8f, 0x14, 0x8b, 0x38, 0xab,
-	0x1a, 0x67, 0x99, 0x56, 0x86, 0xe0, 0xa4, 0x61, 0x4c, 0x7f, 0x24, 0x00, 0x5d, 0x73, 0xa4, 0x85,
-	0x17, 0x1f, 0xb0, 0x58, 0x6b, 0x6b, 0x9c, 0x14, 0xa4, 0xab, 0x6a, 0xba, 0x35, 0xba, 0x32, 0x84,
-	0x4e, 0xbd, 0x71, 0x8c, 0x93, 0xd1, 0x9f, 0x08, 0xcc, 0xf5, 0xb8, 0x25, 0x2d, 0x94, 0x1c, 0x74,
-	0x62, 0x6b, 0x7b, 0xac, 0x1c, 0xe4, 0x64, 0x9a, 0xf3, 0x16, 0x5d, 0x1b, 0xc2, 0xa9, 0x2d, 0x9a,
-	0x7d, 0xa1, 0x9e, 0xe3, 0x2f, 0xeb, 0xee, 0xa3, 0xb3, 0x0a, 0x79, 0x7c, 0x56, 0x21, 0x7f, 0x9f,
-	0x55, 0xc8, 0x37, 0xe7, 0x95, 0x89, 0xc7, 0xe7, 0x95, 0x89, 0xbf, 0xce, 0x2b, 0x13, 0x9f, 0xdc,
-	0x29, 0xfe, 0x0e, 0x3a, 0xe9, 0xaf, 0xae, 0x3f, 0x8c, 0xbc, 0x92, 0xfe, 0xee, 0xdd, 0xfe, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0xc6, 0x41, 0x02, 0xa9, 0xde, 0x0b, 0x00, 0x00,
+func (m *QueryRecentReorgsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentReorgsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentReorgsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecentReorgsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentReorgsRequest.Merge(m, src)
+}
+func (m *QueryRecentReorgsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentReorgsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentReorgsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryRecentReorgsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Hashes retrieves the hashes maintained by the module.
-	Hashes(ctx context.Context, in *QueryHashesRequest, opts ...grpc.CallOption) (*QueryHashesResponse, error)
-	// Contains checks whether a hash is maintained by the module.
-	Contains(ctx context.Context, in *QueryContainsRequest, opts ...grpc.CallOption) (*QueryContainsResponse, error)
-	// ContainsBytes is a temporary method that
-	// checks whether a hash is maintained by the module.
-	// See discussion at https://github.com/babylonlabs-io/babylon/pull/132
-	// for more details.
-	ContainsBytes(ctx context.Context, in *QueryContainsBytesRequest, opts ...grpc.CallOption) (*QueryContainsBytesResponse, error)
-	// MainChain returns the canonical chain
-	MainChain(ctx context.Context, in *QueryMainChainRequest, opts ...grpc.CallOption) (*QueryMainChainResponse, error)
-	// Tip return best header on canonical chain
-	Tip(ctx context.Context, in *QueryTipRequest, opts ...grpc.CallOption) (*QueryTipResponse, error)
-	// BaseHeader returns the base BTC header of the chain. This header is defined
-	// on genesis.
-	BaseHeader(ctx context.Context, in *QueryBaseHeaderRequest, opts ...grpc.CallOption) (*QueryBaseHeaderResponse, error)
-	// HeaderDepth returns the depth of the header in main chain or error if the
-	// block is not found or it exists on fork
-	HeaderDepth(ctx context.Context, in *QueryHeaderDepthRequest, opts ...grpc.CallOption) (*QueryHeaderDepthResponse, error)
+func (m *QueryRecentReorgsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryRecentReorgsResponse is the response type for the Query/RecentReorgs
+// RPC method.
+type QueryRecentReorgsResponse struct {
+	Reorgs     []*BTCReorgRecordResponse `protobuf:"bytes,1,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+	Pagination *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRecentReorgsResponse) Reset()         { *m = QueryRecentReorgsResponse{} }
+func (m *QueryRecentReorgsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecentReorgsResponse) ProtoMessage()    {}
+func (*QueryRecentReorgsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{18}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecentReorgsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecentReorgsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecentReorgsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRecentReorgsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecentReorgsResponse.Merge(m, src)
+}
+func (m *QueryRecentReorgsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecentReorgsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecentReorgsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Hashes(ctx context.Context, in *QueryHashesRequest, opts ...grpc.CallOption) (*QueryHashesResponse, error) {
-	out := new(QueryHashesResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Hashes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRecentReorgsResponse proto.InternalMessageInfo
+
+func (m *QueryRecentReorgsResponse) GetReorgs() []*BTCReorgRecordResponse {
+	if m != nil {
+		return m.Reorgs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Contains(ctx context.Context, in *QueryContainsRequest, opts ...grpc.CallOption) (*QueryContainsResponse, error) {
-	out := new(QueryContainsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Contains", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRecentReorgsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ContainsBytes(ctx context.Context, in *QueryContainsBytesRequest, opts ...grpc.CallOption) (*QueryContainsBytesResponse, error) {
-	out := new(QueryContainsBytesResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/ContainsBytes", in, out, opts...)
-	if err != nil {
-		return nil, err
+// BTCReorgRecordResponse is the response structure of a BTCReorgRecord
+type BTCReorgRecordResponse struct {
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp     time.Time              `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	BabylonHeight uint64                 `protobuf:"varint,3,opt,name=babylon_height,json=babylonHeight,proto3" json:"babylon_height,omitempty"`
+	OldTip        *BTCHeaderInfoResponse `protobuf:"bytes,4,opt,name=old_tip,json=oldTip,proto3" json:"old_tip,omitempty"`
+	NewTip        *BTCHeaderInfoResponse `protobuf:"bytes,5,opt,name=new_tip,json=newTip,proto3" json:"new_tip,omitempty"`
+	ForkPoint     *BTCHeaderInfoResponse `protobuf:"bytes,6,opt,name=fork_point,json=forkPoint,proto3" json:"fork_point,omitempty"`
+	Depth         uint32                 `protobuf:"varint,7,opt,name=depth,proto3" json:"depth,omitempty"`
+	Annotations   []*BTCReorgAnnotation  `protobuf:"bytes,8,rep,name=annotations,proto3" json:"annotations,omitempty"`
+}
+
+func (m *BTCReorgRecordResponse) Reset()         { *m = BTCReorgRecordResponse{} }
+func (m *BTCReorgRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCReorgRecordResponse) ProtoMessage()    {}
+func (*BTCReorgRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{19}
+}
+func (m *BTCReorgRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCReorgRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCReorgRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *BTCReorgRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCReorgRecordResponse.Merge(m, src)
+}
+func (m *BTCReorgRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCReorgRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCReorgRecordResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) MainChain(ctx context.Context, in *QueryMainChainRequest, opts ...grpc.CallOption) (*QueryMainChainResponse, error) {
-	out := new(QueryMainChainResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/MainChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BTCReorgRecordResponse proto.InternalMessageInfo
+
+func (m *BTCReorgRecordResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Tip(ctx context.Context, in *QueryTipRequest, opts ...grpc.CallOption) (*QueryTipResponse, error) {
-	out := new(QueryTipResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Tip", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCReorgRecordResponse) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *queryClient) BaseHeader(ctx context.Context, in *QueryBaseHeaderRequest, opts ...grpc.CallOption) (*QueryBaseHeaderResponse, error) {
-	out := new(QueryBaseHeaderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/BaseHeader", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCReorgRecordResponse) GetBabylonHeight() uint64 {
+	if m != nil {
+		return m.BabylonHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) HeaderDepth(ctx context.Context, in *QueryHeaderDepthRequest, opts ...grpc.CallOption) (*QueryHeaderDepthResponse, error) {
-	out := new(QueryHeaderDepthResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/HeaderDepth", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCReorgRecordResponse) GetOldTip() *BTCHeaderInfoResponse {
+	if m != nil {
+		return m.OldTip
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Hashes retrieves the hashes maintained by the module.
-	Hashes(context.Context, *QueryHashesRequest) (*QueryHashesResponse, error)
-	// Contains checks whether a hash is maintained by the module.
-	Contains(context.Context, *QueryContainsRequest) (*QueryContainsResponse, error)
-	// ContainsBytes is a temporary method that
-	// checks whether a hash is maintained by the module.
-	// See discussion at https://github.com/babylonlabs-io/babylon/pull/132
-	// for more details.
-	ContainsBytes(context.Context, *QueryContainsBytesRequest) (*QueryContainsBytesResponse, error)
-	// MainChain returns the canonical chain
-	MainChain(context.Context, *QueryMainChainRequest) (*QueryMainChainResponse, error)
-	// Tip return best header on canonical chain
-	Tip(context.Context, *QueryTipRequest) (*QueryTipResponse, error)
-	// BaseHeader returns the base BTC header of the chain. This header is defined
-	// on genesis.
-	BaseHeader(context.Context, *QueryBaseHeaderRequest) (*QueryBaseHeaderResponse, error)
-	// HeaderDepth returns the depth of the header in main chain or error if the
-	// block is not found or it exists on fork
-	HeaderDepth(context.Context, *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error)
+func (m *BTCReorgRecordResponse) GetNewTip() *BTCHeaderInfoResponse {
+	if m != nil {
+		return m.NewTip
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *BTCReorgRecordResponse) GetForkPoint() *BTCHeaderInfoResponse {
+	if m != nil {
+		return m.ForkPoint
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *BTCReorgRecordResponse) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) Hashes(ctx context.Context, req *QueryHashesRequest) (*QueryHashesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Hashes not implemented")
+
+func (m *BTCReorgRecordResponse) GetAnnotations() []*BTCReorgAnnotation {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Contains(ctx context.Context, req *QueryContainsRequest) (*QueryContainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Contains not implemented")
+
+// QueryVerifyTxInclusionRequest is the request type for the
+// Query/VerifyTxInclusion RPC method.
+type QueryVerifyTxInclusionRequest struct {
+	// tx is the raw BTC transaction
+	Tx []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	// block_hash is the hex encoded hash of the BTC block including the tx. The
+	// block must be part of the main chain maintained by the light client.
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// tx_index is the index of the tx in the block
+	TxIndex uint32 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// merkle_proof is the list of concatenated intermediate merkle tree nodes,
+	// without the root node and the leaf node
+	MerkleProof []byte `protobuf:"bytes,4,opt,name=merkle_proof,json=merkleProof,proto3" json:"merkle_proof,omitempty"`
+	// header is the BTC block header. It is only needed if the block was pruned
+	// from the store, together with accumulator_proof.
+	Header *github_com_babylonlabs_io_babylon_types.BTCHeaderBytes `protobuf:"bytes,5,opt,name=header,proto3,customtype=github.com/babylonlabs-io/babylon/types.BTCHeaderBytes" json:"header,omitempty"`
+	// accumulator_proof proves that the pruned header is committed into the
+	// header accumulator
+	AccumulatorProof *HeaderAccumulatorProof `protobuf:"bytes,6,opt,name=accumulator_proof,json=accumulatorProof,proto3" json:"accumulator_proof,omitempty"`
+}
+
+func (m *QueryVerifyTxInclusionRequest) Reset()         { *m = QueryVerifyTxInclusionRequest{} }
+func (m *QueryVerifyTxInclusionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyTxInclusionRequest) ProtoMessage()    {}
+func (*QueryVerifyTxInclusionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{20}
+}
+func (m *QueryVerifyTxInclusionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ContainsBytes(ctx context.Context, req *QueryContainsBytesRequest) (*QueryContainsBytesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContainsBytes not implemented")
+func (m *QueryVerifyTxInclusionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyTxInclusionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) MainChain(ctx context.Context, req *QueryMainChainRequest) (*QueryMainChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MainChain not implemented")
+func (m *QueryVerifyTxInclusionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyTxInclusionRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Tip(ctx context.Context, req *QueryTipRequest) (*QueryTipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tip not implemented")
+func (m *QueryVerifyTxInclusionRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) BaseHeader(ctx context.Context, req *QueryBaseHeaderRequest) (*QueryBaseHeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BaseHeader not implemented")
+func (m *QueryVerifyTxInclusionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyTxInclusionRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) HeaderDepth(ctx context.Context, req *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HeaderDepth not implemented")
+
+var xxx_messageInfo_QueryVerifyTxInclusionRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyTxInclusionRequest) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryVerifyTxInclusionRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryVerifyTxInclusionRequest) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btclightclient.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Hashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHashesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Hashes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btclightclient.v1.Query/Hashes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Hashes(ctx, req.(*QueryHashesRequest))
+func (m *QueryVerifyTxInclusionRequest) GetMerkleProof() []byte {
+	if m != nil {
+		return m.MerkleProof
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Contains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Contains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btclightclient.v1.Query/Contains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Contains(ctx, req.(*QueryContainsRequest))
+func (m *QueryVerifyTxInclusionRequest) GetAccumulatorProof() *HeaderAccumulatorProof {
+	if m != nil {
+		return m.AccumulatorProof
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ContainsBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContainsBytesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContainsBytes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btclightclient.v1.Query/ContainsBytes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContainsBytes(ctx, req.(*QueryContainsBytesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryVerifyTxInclusionResponse is the response type for the
+// Query/VerifyTxInclusion RPC method.
+type QueryVerifyTxInclusionResponse struct {
+	// included is true if the merkle proof of the tx is valid against the block
+	Included bool `protobuf:"varint,1,opt,name=included,proto3" json:"included,omitempty"`
+	// block_height is the height of the block
+	BlockHeight uint32 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// depth is the depth of the block in the main chain
+	Depth uint32 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
 }
 
-func _Query_MainChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMainChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MainChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btclightclient.v1.Query/MainChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MainChain(ctx, req.(*QueryMainChainRequest))
+func (m *QueryVerifyTxInclusionResponse) Reset()         { *m = QueryVerifyTxInclusionResponse{} }
+func (m *QueryVerifyTxInclusionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyTxInclusionResponse) ProtoMessage()    {}
+func (*QueryVerifyTxInclusionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{21}
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyTxInclusionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyTxInclusionResponse.Merge(m, src)
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyTxInclusionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyTxInclusionResponse.DiscardUnknown(m)
 }
 
-func _Query_Tip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTipRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tip(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btclightclient.v1.Query/Tip",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tip(ctx, req.(*QueryTipRequest))
+var xxx_messageInfo_QueryVerifyTxInclusionResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyTxInclusionResponse) GetIncluded() bool {
+	if m != nil {
+		return m.Included
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_BaseHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBaseHeaderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BaseHeader(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btclightclient.v1.Query/BaseHeader",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BaseHeader(ctx, req.(*QueryBaseHeaderRequest))
+func (m *QueryVerifyTxInclusionResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_HeaderDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHeaderDepthRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HeaderDepth(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btclightclient.v1.Query/HeaderDepth",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HeaderDepth(ctx, req.(*QueryHeaderDepthRequest))
+func (m *QueryVerifyTxInclusionResponse) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btclightclient.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Hashes",
-			Handler:    _Query_Hashes_Handler,
-		},
-		{
-			MethodName: "Contains",
-			Handler:    _Query_Contains_Handler,
-		},
-		{
-			MethodName: "ContainsBytes",
-			Handler:    _Query_ContainsBytes_Handler,
-		},
-		{
-			MethodName: "MainChain",
-			Handler:    _Query_MainChain_Handler,
-		},
-		{
-			MethodName: "Tip",
-			Handler:    _Query_Tip_Handler,
-		},
-		{
-			MethodName: "BaseHeader",
-			Handler:    _Query_BaseHeader_Handler,
-		},
-		{
-			MethodName: "HeaderDepth",
-			Handler:    _Query_HeaderDepth_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btclightclient/v1/query.proto",
+// QueryHeadersByHeightRangeRequest is the request type for the
+// Query/HeadersByHeightRange RPC method.
+type QueryHeadersByHeightRangeRequest struct {
+	StartHeight uint32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint32 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHeadersByHeightRangeRequest) Reset()         { *m = QueryHeadersByHeightRangeRequest{} }
+func (m *QueryHeadersByHeightRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadersByHeightRangeRequest) ProtoMessage()    {}
+func (*QueryHeadersByHeightRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{22}
+}
+func (m *QueryHeadersByHeightRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadersByHeightRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadersByHeightRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryHeadersByHeightRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadersByHeightRangeRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryHeadersByHeightRangeRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryHeadersByHeightRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadersByHeightRangeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryHeadersByHeightRangeRequest proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryHeadersByHeightRangeRequest) GetStartHeight() uint32 {
+	if m != nil {
+		return m.StartHeight
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryHashesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHeadersByHeightRangeRequest) GetEndHeight() uint32 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryHashesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryHeadersByHeightRangeResponse is the response type for the
+// Query/HeadersByHeightRange RPC method. Headers above the tip of the main
+// chain are not returned.
+type QueryHeadersByHeightRangeResponse struct {
+	Headers []*BTCHeaderInfoResponse `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
 }
 
-func (m *QueryHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryHeadersByHeightRangeResponse) Reset()         { *m = QueryHeadersByHeightRangeResponse{} }
+func (m *QueryHeadersByHeightRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeadersByHeightRangeResponse) ProtoMessage()    {}
+func (*QueryHeadersByHeightRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{23}
+}
+func (m *QueryHeadersByHeightRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeadersByHeightRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeadersByHeightRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHeadersByHeightRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeadersByHeightRangeResponse.Merge(m, src)
+}
+func (m *QueryHeadersByHeightRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeadersByHeightRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeadersByHeightRangeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryHashesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeadersByHeightRangeResponse proto.InternalMessageInfo
+
+func (m *QueryHeadersByHeightRangeResponse) GetHeaders() []*BTCHeaderInfoResponse {
+	if m != nil {
+		return m.Headers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHashesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBlockLocatorRequest is the request type for the Query/BlockLocator RPC
+// method.
+type QueryBlockLocatorRequest struct {
+	// locator is the list of hex encoded header hashes known by the caller,
+	// ordered from the most recent one, as in Bitcoin's getheaders message
+	Locator []string `protobuf:"bytes,1,rep,name=locator,proto3" json:"locator,omitempty"`
+	// limit is the maximum number of headers to return after the fork point.
+	// If it is zero, the default limit is used.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryBlockLocatorRequest) Reset()         { *m = QueryBlockLocatorRequest{} }
+func (m *QueryBlockLocatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockLocatorRequest) ProtoMessage()    {}
+func (*QueryBlockLocatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{24}
+}
+func (m *QueryBlockLocatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Hashes) > 0 {
-		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Hashes[iNdEx].Size()
-				i -= size
-				if _, err := m.Hashes[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryBlockLocatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockLocatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryContainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBlockLocatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockLocatorRequest.Merge(m, src)
 }
-
-func (m *QueryContainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBlockLocatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockLocatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockLocatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryContainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Hash != nil {
-		{
-			size := m.Hash.Size()
-			i -= size
-			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryBlockLocatorRequest proto.InternalMessageInfo
+
+func (m *QueryBlockLocatorRequest) GetLocator() []string {
+	if m != nil {
+		return m.Locator
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryContainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBlockLocatorRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryContainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBlockLocatorResponse is the response type for the Query/BlockLocator
+// RPC method.
+type QueryBlockLocatorResponse struct {
+	// fork_point is the first header of the locator which is part of the main
+	// chain, or the base header if none of them is
+	ForkPoint *BTCHeaderInfoResponse `protobuf:"bytes,1,opt,name=fork_point,json=forkPoint,proto3" json:"fork_point,omitempty"`
+	// headers are the main chain headers following the fork point
+	Headers []*BTCHeaderInfoResponse `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
 }
 
-func (m *QueryContainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Contains {
-		i--
-		if m.Contains {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryBlockLocatorResponse) Reset()         { *m = QueryBlockLocatorResponse{} }
+func (m *QueryBlockLocatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockLocatorResponse) ProtoMessage()    {}
+func (*QueryBlockLocatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{25}
+}
+func (m *QueryBlockLocatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockLocatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockLocatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryBlockLocatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockLocatorResponse.Merge(m, src)
+}
+func (m *QueryBlockLocatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockLocatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockLocatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryContainsBytesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlockLocatorResponse proto.InternalMessageInfo
+
+func (m *QueryBlockLocatorResponse) GetForkPoint() *BTCHeaderInfoResponse {
+	if m != nil {
+		return m.ForkPoint
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryContainsBytesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBlockLocatorResponse) GetHeaders() []*BTCHeaderInfoResponse {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
 }
 
-func (m *QueryContainsBytesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryHeaderAccumulatorRequest is the request type for the
+// Query/HeaderAccumulator RPC method.
+type QueryHeaderAccumulatorRequest struct {
 }
 
-func (m *QueryContainsBytesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryHeaderAccumulatorRequest) Reset()         { *m = QueryHeaderAccumulatorRequest{} }
+func (m *QueryHeaderAccumulatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderAccumulatorRequest) ProtoMessage()    {}
+func (*QueryHeaderAccumulatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{26}
+}
+func (m *QueryHeaderAccumulatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderAccumulatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderAccumulatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryHeaderAccumulatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderAccumulatorRequest.Merge(m, src)
+}
+func (m *QueryHeaderAccumulatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderAccumulatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderAccumulatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryContainsBytesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryHeaderAccumulatorRequest proto.InternalMessageInfo
+
+// QueryHeaderAccumulatorResponse is the response type for the
+// Query/HeaderAccumulator RPC method.
+type QueryHeaderAccumulatorResponse struct {
+	Accumulator HeaderAccumulator `protobuf:"bytes,1,opt,name=accumulator,proto3" json:"accumulator"`
 }
 
-func (m *QueryContainsBytesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Contains {
-		i--
-		if m.Contains {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryHeaderAccumulatorResponse) Reset()         { *m = QueryHeaderAccumulatorResponse{} }
+func (m *QueryHeaderAccumulatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeaderAccumulatorResponse) ProtoMessage()    {}
+func (*QueryHeaderAccumulatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3961270631e52721, []int{27}
+}
+func (m *QueryHeaderAccumulatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeaderAccumulatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeaderAccumulatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryHeaderAccumulatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeaderAccumulatorResponse.Merge(m, src)
+}
+func (m *QueryHeaderAccumulatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeaderAccumulatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeaderAccumulatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryMainChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeaderAccumulatorResponse proto.InternalMessageInfo
+
+func (m *QueryHeaderAccumulatorResponse) GetAccumulator() HeaderAccumulator {
+	if m != nil {
+		return m.Accumulator
 	}
-	return dAtA[:n], nil
+	return HeaderAccumulator{}
 }
 
-func (m *QueryMainChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btclightclient.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btclightclient.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryHashesRequest)(nil), "babylon.btclightclient.v1.QueryHashesRequest")
+	proto.RegisterType((*QueryHashesResponse)(nil), "babylon.btclightclient.v1.QueryHashesResponse")
+	proto.RegisterType((*QueryContainsRequest)(nil), "babylon.btclightclient.v1.QueryContainsRequest")
+	proto.RegisterType((*QueryContainsResponse)(nil), "babylon.btclightclient.v1.QueryContainsResponse")
+	proto.RegisterType((*QueryContainsBytesRequest)(nil), "babylon.btclightclient.v1.QueryContainsBytesRequest")
+	proto.RegisterType((*QueryContainsBytesResponse)(nil), "babylon.btclightclient.v1.QueryContainsBytesResponse")
+	proto.RegisterType((*QueryMainChainRequest)(nil), "babylon.btclightclient.v1.QueryMainChainRequest")
+	proto.RegisterType((*QueryMainChainResponse)(nil), "babylon.btclightclient.v1.QueryMainChainResponse")
+	proto.RegisterType((*QueryTipRequest)(nil), "babylon.btclightclient.v1.QueryTipRequest")
+	proto.RegisterType((*QueryTipResponse)(nil), "babylon.btclightclient.v1.QueryTipResponse")
+	proto.RegisterType((*QueryBaseHeaderRequest)(nil), "babylon.btclightclient.v1.QueryBaseHeaderRequest")
+	proto.RegisterType((*QueryBaseHeaderResponse)(nil), "babylon.btclightclient.v1.QueryBaseHeaderResponse")
+	proto.RegisterType((*QueryHeaderDepthRequest)(nil), "babylon.btclightclient.v1.QueryHeaderDepthRequest")
+	proto.RegisterType((*QueryHeaderDepthResponse)(nil), "babylon.btclightclient.v1.QueryHeaderDepthResponse")
+	proto.RegisterType((*BTCHeaderInfoResponse)(nil), "babylon.btclightclient.v1.BTCHeaderInfoResponse")
+	proto.RegisterType((*QueryRecentReorgsRequest)(nil), "babylon.btclightclient.v1.QueryRecentReorgsRequest")
+	proto.RegisterType((*QueryRecentReorgsResponse)(nil), "babylon.btclightclient.v1.QueryRecentReorgsResponse")
+	proto.RegisterType((*BTCReorgRecordResponse)(nil), "babylon.btclightclient.v1.BTCReorgRecordResponse")
+	proto.RegisterType((*QueryVerifyTxInclusionRequest)(nil), "babylon.btclightclient.v1.QueryVerifyTxInclusionRequest")
+	proto.RegisterType((*QueryVerifyTxInclusionResponse)(nil), "babylon.btclightclient.v1.QueryVerifyTxInclusionResponse")
+	proto.RegisterType((*QueryHeadersByHeightRangeRequest)(nil), "babylon.btclightclient.v1.QueryHeadersByHeightRangeRequest")
+	proto.RegisterType((*QueryHeadersByHeightRangeResponse)(nil), "babylon.btclightclient.v1.QueryHeadersByHeightRangeResponse")
+	proto.RegisterType((*QueryBlockLocatorRequest)(nil), "babylon.btclightclient.v1.QueryBlockLocatorRequest")
+	proto.RegisterType((*QueryBlockLocatorResponse)(nil), "babylon.btclightclient.v1.QueryBlockLocatorResponse")
+	proto.RegisterType((*QueryHeaderAccumulatorRequest)(nil), "babylon.btclightclient.v1.QueryHeaderAccumulatorRequest")
+	proto.RegisterType((*QueryHeaderAccumulatorResponse)(nil), "babylon.btclightclient.v1.QueryHeaderAccumulatorResponse")
 }
 
-func (m *QueryMainChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("babylon/btclightclient/v1/query.proto", fileDescriptor_3961270631e52721)
 }
 
-func (m *QueryMainChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_3961270631e52721 = []byte{
+	// 1606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcb, 0x73, 0x1b, 0x45,
+	0x13, 0xf7, 0xca, 0x4f, 0xb5, 0x9c, 0x7c, 0xf1, 0x7c, 0x8e, 0x3f, 0x79, 0xeb, 0x8b, 0x6c, 0x6f,
+	0x62, 0x27, 0x71, 0xe2, 0x5d, 0xdb, 0x79, 0x54, 0x08, 0x54, 0x20, 0x0a, 0x15, 0xec, 0x14, 0x54,
+	0xcc, 0x96, 0xc9, 0x81, 0x4a, 0xa1, 0x5a, 0x69, 0xc7, 0xd2, 0x62, 0x69, 0x47, 0xd1, 0xae, 0x1c,
+	0xb9, 0x52, 0xb9, 0x70, 0xe0, 0x9c, 0x82, 0x1b, 0x07, 0x0e, 0x14, 0x14, 0x1c, 0x78, 0x5c, 0x28,
+	0x8a, 0x1b, 0x39, 0xe6, 0x98, 0x82, 0x0b, 0xe4, 0x60, 0x20, 0xe1, 0x0f, 0xa1, 0x66, 0xa6, 0x57,
+	0x5a, 0x59, 0x8f, 0x95, 0x1c, 0x5f, 0x54, 0x9a, 0xd9, 0xee, 0xfe, 0xfd, 0xba, 0xa7, 0x7b, 0xa6,
+	0x1b, 0xe6, 0xb3, 0x56, 0x76, 0xb7, 0xc8, 0x5c, 0x23, 0xeb, 0xe7, 0x8a, 0x4e, 0xbe, 0xc0, 0x7f,
+	0xa9, 0xeb, 0x1b, 0x3b, 0x2b, 0xc6, 0xbd, 0x2a, 0xad, 0xec, 0xea, 0xe5, 0x0a, 0xf3, 0x19, 0x99,
+	0x46, 0x31, 0xbd, 0x59, 0x4c, 0xdf, 0x59, 0x51, 0x27, 0xf3, 0x2c, 0xcf, 0x84, 0x94, 0xc1, 0xff,
+	0x49, 0x05, 0x75, 0x3a, 0xc7, 0xbc, 0x12, 0xf3, 0x32, 0xf2, 0x83, 0x5c, 0xe0, 0xa7, 0xff, 0xe7,
+	0x19, 0xcb, 0x17, 0xa9, 0x61, 0x95, 0x1d, 0xc3, 0x72, 0x5d, 0xe6, 0x5b, 0xbe, 0xc3, 0xdc, 0xe0,
+	0xeb, 0xa2, 0x94, 0x35, 0xb2, 0x96, 0x47, 0x25, 0x05, 0x63, 0x67, 0x25, 0x4b, 0x7d, 0x6b, 0xc5,
+	0x28, 0x5b, 0x79, 0xc7, 0x15, 0xc2, 0x28, 0xbb, 0xd0, 0x99, 0x7c, 0xd9, 0xaa, 0x58, 0xa5, 0xc0,
+	0xa6, 0xde, 0x59, 0x6e, 0x9f, 0x3f, 0x52, 0x7e, 0x06, 0x19, 0x8a, 0x55, 0xb6, 0xba, 0x65, 0xf8,
+	0x4e, 0x89, 0x7a, 0xbe, 0x55, 0x2a, 0x4b, 0x01, 0x6d, 0x12, 0xc8, 0xbb, 0x9c, 0xda, 0x86, 0x40,
+	0x31, 0xe9, 0xbd, 0x2a, 0xf5, 0x7c, 0xed, 0x0e, 0xfc, 0xb7, 0x69, 0xd7, 0x2b, 0x33, 0xd7, 0xa3,
+	0xe4, 0x75, 0x18, 0x91, 0x6c, 0x92, 0xca, 0xac, 0x72, 0x26, 0xb1, 0x3a, 0xa7, 0x77, 0x0c, 0xa6,
+	0x2e, 0x55, 0xd3, 0x43, 0x4f, 0xf6, 0x66, 0x06, 0x4c, 0x54, 0xd3, 0xee, 0x22, 0xda, 0x9a, 0xe5,
+	0x15, 0x68, 0x80, 0x46, 0x6e, 0x02, 0x34, 0x02, 0x82, 0xa6, 0x17, 0x74, 0x8c, 0x34, 0x8f, 0x9e,
+	0x2e, 0x0f, 0x10, 0xa3, 0xa7, 0x6f, 0x58, 0x79, 0x8a, 0xba, 0x66, 0x48, 0x53, 0xfb, 0x49, 0x41,
+	0xda, 0x81, 0x79, 0xa4, 0x7d, 0x07, 0x46, 0x0a, 0x62, 0x27, 0xa9, 0xcc, 0x0e, 0x9e, 0x19, 0x4f,
+	0x5f, 0x7b, 0xb6, 0x37, 0x73, 0x35, 0xef, 0xf8, 0x85, 0x6a, 0x56, 0xcf, 0xb1, 0x92, 0x81, 0x4e,
+	0x14, 0xad, 0xac, 0xb7, 0xe4, 0xb0, 0x60, 0x69, 0xf8, 0xbb, 0x65, 0xea, 0xe9, 0xe9, 0xcd, 0x1b,
+	0x6b, 0xd4, 0xb2, 0x69, 0x85, 0x1b, 0x4d, 0xef, 0xfa, 0xd4, 0x33, 0xd1, 0x1a, 0x79, 0xab, 0x89,
+	0x77, 0x4c, 0xf0, 0x3e, 0x1d, 0xc9, 0x5b, 0x92, 0x6a, 0x22, 0xfe, 0x21, 0x4c, 0x0a, 0xde, 0x37,
+	0x98, 0xeb, 0x5b, 0x8e, 0x5b, 0x0f, 0x8c, 0x09, 0x43, 0x1c, 0x4a, 0x84, 0xe4, 0xe5, 0x69, 0x0b,
+	0x5b, 0xda, 0x05, 0x38, 0xbe, 0x0f, 0x0b, 0xa3, 0xa4, 0xc2, 0x58, 0x0e, 0xf7, 0x04, 0xe0, 0x98,
+	0x59, 0x5f, 0x6b, 0x06, 0x4c, 0x37, 0x29, 0x49, 0x83, 0xc8, 0x92, 0x84, 0x59, 0x22, 0xca, 0x15,
+	0x50, 0xdb, 0x29, 0xf4, 0x00, 0x95, 0x41, 0x7e, 0xef, 0x58, 0x8e, 0x7b, 0xa3, 0x60, 0x39, 0xee,
+	0x61, 0x67, 0xc9, 0x77, 0x0a, 0x4c, 0xed, 0x47, 0x40, 0x5e, 0xb7, 0x60, 0xb4, 0x20, 0x82, 0x26,
+	0x33, 0x25, 0xb1, 0xba, 0xdc, 0x25, 0xc1, 0xeb, 0x11, 0x5e, 0x77, 0xb7, 0x58, 0xfd, 0x58, 0x03,
+	0x03, 0x87, 0x97, 0x1c, 0x13, 0xf0, 0x1f, 0x41, 0x77, 0xd3, 0x29, 0x07, 0xe5, 0x79, 0x17, 0x8e,
+	0x35, 0xb6, 0x90, 0xfb, 0x1a, 0x8c, 0x48, 0x68, 0x0c, 0x4d, 0xff, 0xd4, 0x51, 0x5f, 0x4b, 0x62,
+	0x7c, 0xd2, 0x96, 0x47, 0xa5, 0x58, 0x80, 0x9b, 0x83, 0xff, 0xb5, 0x7c, 0x39, 0x74, 0xf8, 0x25,
+	0x04, 0x91, 0x22, 0x6f, 0xd2, 0xb2, 0x5f, 0x68, 0x97, 0x69, 0x71, 0xcc, 0xb4, 0x65, 0x48, 0xb6,
+	0x8a, 0x23, 0xa9, 0x49, 0x18, 0xb6, 0xf9, 0x86, 0x50, 0x38, 0x62, 0xca, 0x85, 0xf6, 0xad, 0x02,
+	0xc7, 0xdb, 0x52, 0x20, 0x27, 0x00, 0x24, 0x89, 0x4c, 0x81, 0xd6, 0x10, 0x25, 0x2e, 0x77, 0xd6,
+	0x68, 0x8d, 0x4c, 0xc3, 0x18, 0x87, 0x14, 0x1f, 0x63, 0xe2, 0xe3, 0x28, 0x5f, 0xf3, 0x4f, 0x53,
+	0xdc, 0x7d, 0xee, 0x65, 0x72, 0x50, 0x40, 0xe1, 0x8a, 0x5c, 0x87, 0xa1, 0xfb, 0xac, 0xb2, 0x9d,
+	0x1c, 0xe2, 0xe2, 0xe9, 0x25, 0x7e, 0x19, 0x3e, 0xdb, 0x9b, 0x99, 0x92, 0x69, 0xe0, 0xd9, 0xdb,
+	0xba, 0xc3, 0x8c, 0x92, 0xe5, 0x17, 0xf4, 0xf7, 0x1c, 0xd7, 0xff, 0xf5, 0xc7, 0xa5, 0x04, 0x26,
+	0x08, 0x5f, 0x9a, 0x42, 0x55, 0xcb, 0xa2, 0x83, 0x26, 0xcd, 0x51, 0xd7, 0x37, 0x29, 0xab, 0xe4,
+	0x0f, 0xfd, 0xe6, 0xfc, 0x41, 0xc1, 0x02, 0x6f, 0x06, 0xc1, 0xb0, 0xac, 0xc3, 0x48, 0x45, 0xec,
+	0x60, 0x55, 0xac, 0x74, 0x3f, 0x5b, 0xa1, 0x6d, 0xd2, 0x1c, 0xab, 0xd8, 0x8d, 0xc3, 0x95, 0x06,
+	0x0e, 0xaf, 0x2a, 0xfe, 0x1e, 0x84, 0xa9, 0xf6, 0x58, 0xe4, 0x28, 0xc4, 0x1c, 0x5b, 0x04, 0x63,
+	0xc8, 0x8c, 0x39, 0x36, 0x49, 0x43, 0xbc, 0xfe, 0xea, 0x21, 0xa4, 0xaa, 0xcb, 0x77, 0x51, 0x0f,
+	0xde, 0x45, 0x7d, 0x33, 0x90, 0x48, 0x8f, 0xf1, 0x43, 0x7a, 0xf4, 0xe7, 0x8c, 0x62, 0x36, 0xd4,
+	0xc8, 0x3c, 0x1c, 0x45, 0x9f, 0x33, 0xa1, 0x73, 0x1e, 0x32, 0x8f, 0xe0, 0xee, 0x9a, 0x3c, 0xee,
+	0x75, 0x18, 0x65, 0x45, 0x3b, 0xe3, 0x3b, 0x65, 0x71, 0xe2, 0x07, 0x2a, 0x03, 0x56, 0xb4, 0x37,
+	0x9d, 0x32, 0x37, 0xe5, 0xd2, 0xfb, 0xc2, 0xd4, 0xf0, 0x41, 0x4d, 0xb9, 0xf4, 0x3e, 0x37, 0x75,
+	0x1b, 0x60, 0x8b, 0x55, 0xb6, 0x33, 0x65, 0xe6, 0xb8, 0x7e, 0x72, 0xe4, 0x80, 0xd6, 0xe2, 0xdc,
+	0xc6, 0x06, 0x37, 0xd1, 0xa8, 0xab, 0xd1, 0x50, 0x5d, 0x91, 0xdb, 0x90, 0x08, 0x35, 0x41, 0xc9,
+	0x31, 0x91, 0x2b, 0x4b, 0x3d, 0xe4, 0xca, 0xf5, 0xba, 0x96, 0x19, 0xb6, 0xa0, 0x3d, 0x8e, 0xc1,
+	0x09, 0x91, 0x95, 0x77, 0x68, 0xc5, 0xd9, 0xda, 0xdd, 0xac, 0xad, 0xbb, 0xb9, 0x62, 0xd5, 0xe3,
+	0x72, 0x98, 0xff, 0x47, 0x21, 0xe6, 0xd7, 0xf0, 0xe1, 0x89, 0xf9, 0x35, 0x5e, 0xc0, 0xd9, 0x22,
+	0xcb, 0x6d, 0x67, 0xc4, 0x35, 0x21, 0x6b, 0x34, 0x2e, 0x76, 0xf8, 0x33, 0xc8, 0x0b, 0xd8, 0xaf,
+	0x65, 0x1c, 0xd7, 0xa6, 0x35, 0xac, 0xd3, 0x51, 0xbf, 0xb6, 0xce, 0x97, 0x64, 0x0e, 0xc6, 0x4b,
+	0xb4, 0xb2, 0x5d, 0xa4, 0xbc, 0xcf, 0x63, 0x5b, 0xe2, 0xf8, 0xc6, 0xcd, 0x84, 0xdc, 0xdb, 0xe0,
+	0x5b, 0xc4, 0xac, 0x5f, 0x71, 0xc3, 0xe2, 0x3d, 0xbe, 0xfa, 0x6c, 0x6f, 0xe6, 0x72, 0xdf, 0xef,
+	0x71, 0xd0, 0x42, 0x88, 0x05, 0xf9, 0x00, 0x26, 0xac, 0x5c, 0xae, 0x5a, 0xaa, 0x16, 0x2d, 0x9f,
+	0x55, 0x10, 0x5b, 0x9e, 0x50, 0xb7, 0x2a, 0x93, 0xa6, 0xae, 0x37, 0x34, 0x05, 0x43, 0xf3, 0x98,
+	0xb5, 0x6f, 0x47, 0xab, 0x42, 0xaa, 0x53, 0x04, 0x1b, 0x6f, 0xb1, 0xc3, 0x37, 0x6d, 0x6a, 0x07,
+	0x6f, 0x71, 0xb0, 0xe6, 0x41, 0xc1, 0x70, 0xca, 0x9c, 0x8f, 0x89, 0x98, 0x25, 0x64, 0x40, 0x65,
+	0xc6, 0xd7, 0x53, 0x61, 0x30, 0x7c, 0xc5, 0xda, 0x30, 0x1b, 0xba, 0x94, 0xbd, 0xf4, 0xae, 0x94,
+	0x36, 0x2d, 0xb7, 0x7e, 0xff, 0x70, 0xe3, 0x9e, 0x6f, 0x55, 0xfc, 0xc0, 0xb8, 0xbc, 0xa3, 0x13,
+	0x62, 0x0f, 0x8d, 0x9f, 0x00, 0xa0, 0xae, 0xdd, 0x8c, 0x1e, 0xa7, 0xae, 0x2d, 0x3f, 0x6b, 0x0c,
+	0xe6, 0xba, 0xa0, 0x1c, 0xfe, 0x9b, 0xae, 0xdd, 0xc2, 0xab, 0x38, 0xcd, 0x03, 0xf0, 0x36, 0xcb,
+	0xf1, 0x38, 0x07, 0xee, 0x24, 0x61, 0xb4, 0x28, 0x77, 0x04, 0x4e, 0xdc, 0x0c, 0x96, 0x3c, 0x44,
+	0x45, 0xa7, 0xe4, 0x04, 0x0e, 0xc8, 0x85, 0xf6, 0x73, 0x70, 0xe5, 0x36, 0x1b, 0x43, 0xd6, 0xcd,
+	0x25, 0xab, 0xbc, 0x7c, 0xc9, 0x86, 0xc2, 0x10, 0x7b, 0xd9, 0x30, 0xcc, 0x60, 0x59, 0xb6, 0x64,
+	0x61, 0xd0, 0x27, 0xec, 0x60, 0xd6, 0xb5, 0x11, 0x40, 0xff, 0x36, 0x21, 0x11, 0xca, 0x55, 0x74,
+	0xf0, 0x7c, 0x3f, 0x19, 0x8f, 0x93, 0x45, 0xd8, 0xcc, 0xea, 0x37, 0x13, 0x30, 0x2c, 0x80, 0xc9,
+	0x27, 0x0a, 0x8c, 0xc8, 0x09, 0x84, 0x74, 0xbb, 0x81, 0x5a, 0x47, 0x1f, 0x55, 0xef, 0x55, 0x5c,
+	0x7a, 0xa2, 0x9d, 0xfd, 0xe8, 0xb7, 0x7f, 0x3e, 0x8d, 0x9d, 0x24, 0x73, 0x46, 0xd4, 0x08, 0x27,
+	0x48, 0xc9, 0xd1, 0x24, 0x9a, 0x54, 0xd3, 0x84, 0x14, 0x4d, 0xaa, 0x79, 0xe2, 0xe9, 0x89, 0x14,
+	0x0e, 0x31, 0x9f, 0x29, 0x30, 0x16, 0x74, 0xe9, 0xc4, 0x88, 0xc2, 0xd9, 0x37, 0xa1, 0xa8, 0xcb,
+	0xbd, 0x2b, 0x20, 0xb5, 0x73, 0x82, 0xda, 0x3c, 0x39, 0xd9, 0x85, 0x5a, 0x30, 0x0c, 0x90, 0xef,
+	0x15, 0x38, 0xd2, 0x34, 0x42, 0x90, 0x8b, 0xbd, 0x02, 0x86, 0x47, 0x14, 0xf5, 0x52, 0x9f, 0x5a,
+	0xc8, 0x75, 0x59, 0x70, 0x5d, 0x24, 0x67, 0x7a, 0xe0, 0x2a, 0xe9, 0x7d, 0xae, 0x40, 0xbc, 0x3e,
+	0x57, 0x90, 0xc8, 0xe8, 0xec, 0x1f, 0x72, 0xd4, 0x95, 0x3e, 0x34, 0x90, 0xe4, 0x79, 0x41, 0x72,
+	0x81, 0x9c, 0xea, 0x42, 0xb2, 0x64, 0x39, 0x6e, 0x4e, 0x50, 0xfa, 0x58, 0x81, 0x41, 0xde, 0x13,
+	0x2c, 0x46, 0x01, 0x35, 0xc6, 0x0d, 0xf5, 0x5c, 0x4f, 0xb2, 0x48, 0x67, 0x41, 0xd0, 0x99, 0x25,
+	0xa9, 0x2e, 0x74, 0x7c, 0xa7, 0x4c, 0xbe, 0x50, 0x00, 0x1a, 0x73, 0x04, 0x89, 0x74, 0xbc, 0x65,
+	0x1a, 0x51, 0x57, 0xfb, 0x51, 0x41, 0x76, 0x4b, 0x82, 0xdd, 0x69, 0x32, 0xdf, 0x85, 0x1d, 0x6f,
+	0x43, 0xf1, 0x79, 0xfe, 0x5a, 0x81, 0x44, 0x68, 0xb0, 0x20, 0x91, 0x90, 0xad, 0x43, 0x8b, 0x7a,
+	0xa1, 0x2f, 0x1d, 0xe4, 0x69, 0x08, 0x9e, 0x67, 0xc9, 0xe9, 0x2e, 0x3c, 0xc5, 0x53, 0x6b, 0x3c,
+	0xe0, 0x75, 0xfc, 0x90, 0x7c, 0xa9, 0xc0, 0x78, 0xb8, 0x79, 0x27, 0x91, 0xb0, 0x6d, 0xe6, 0x09,
+	0xf5, 0x62, 0x7f, 0x4a, 0x7d, 0xdc, 0x36, 0xd8, 0xff, 0x3f, 0x56, 0x60, 0xa2, 0xa5, 0x17, 0x21,
+	0x57, 0xa2, 0x60, 0x3b, 0x35, 0x80, 0xea, 0x2b, 0x07, 0xd0, 0x44, 0xd6, 0x97, 0x05, 0xeb, 0x65,
+	0xa2, 0x77, 0x61, 0xbd, 0x23, 0xb4, 0x33, 0xa2, 0x69, 0x0c, 0xc8, 0xfe, 0xa1, 0xc0, 0x64, 0xbb,
+	0x8e, 0x83, 0xbc, 0xda, 0xdb, 0x41, 0xb7, 0xed, 0x86, 0xd4, 0xd7, 0x0e, 0xa6, 0x8c, 0xbe, 0xdc,
+	0x14, 0xbe, 0xbc, 0x41, 0xae, 0x75, 0xbb, 0xef, 0xa5, 0x01, 0xe3, 0x41, 0xb8, 0xeb, 0x7a, 0x68,
+	0x3c, 0x68, 0x74, 0x58, 0x0f, 0xc9, 0x57, 0x0a, 0x8c, 0x87, 0xfb, 0x91, 0xe8, 0x2c, 0x6a, 0xd3,
+	0x0a, 0x45, 0x67, 0x51, 0xbb, 0x96, 0x47, 0x5b, 0x14, 0x3e, 0x9c, 0x22, 0x5a, 0x17, 0x1f, 0x82,
+	0x96, 0xea, 0x17, 0x05, 0x26, 0x5a, 0x3a, 0x82, 0xe8, 0x34, 0xea, 0xd4, 0xb0, 0x44, 0xa7, 0x51,
+	0xc7, 0x4e, 0x46, 0xbb, 0x24, 0x68, 0x1b, 0x64, 0x29, 0x32, 0xf4, 0x99, 0x50, 0xab, 0x92, 0x36,
+	0x9f, 0x3c, 0x4f, 0x29, 0x4f, 0x9f, 0xa7, 0x94, 0xbf, 0x9e, 0xa7, 0x94, 0x47, 0x2f, 0x52, 0x03,
+	0x4f, 0x5f, 0xa4, 0x06, 0x7e, 0x7f, 0x91, 0x1a, 0x78, 0xff, 0x4a, 0xf4, 0x48, 0x51, 0xdb, 0x8f,
+	0x21, 0x66, 0x8c, 0xec, 0x88, 0x98, 0x66, 0x2f, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x23, 0x84,
+	0xa8, 0xce, 0x0a, 0x17, 0x00, 0x00,
 }
 
-func (m *QueryMainChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *QueryMainChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Headers) > 0 {
-		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func (m *QueryTipRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Hashes retrieves the hashes maintained by the module.
+	Hashes(ctx context.Context, in *QueryHashesRequest, opts ...grpc.CallOption) (*QueryHashesResponse, error)
+	// Contains checks whether a hash is maintained by the module.
+	Contains(ctx context.Context, in *QueryContainsRequest, opts ...grpc.CallOption) (*QueryContainsResponse, error)
+	// ContainsBytes is a temporary method that
+	// checks whether a hash is maintained by the module.
+	// See discussion at https://github.com/babylonlabs-io/babylon/pull/132
+	// for more details.
+	ContainsBytes(ctx context.Context, in *QueryContainsBytesRequest, opts ...grpc.CallOption) (*QueryContainsBytesResponse, error)
+	// MainChain returns the canonical chain
+	MainChain(ctx context.Context, in *QueryMainChainRequest, opts ...grpc.CallOption) (*QueryMainChainResponse, error)
+	// Tip return best header on canonical chain
+	Tip(ctx context.Context, in *QueryTipRequest, opts ...grpc.CallOption) (*QueryTipResponse, error)
+	// BaseHeader returns the base BTC header of the chain. This header is defined
+	// on genesis.
+	BaseHeader(ctx context.Context, in *QueryBaseHeaderRequest, opts ...grpc.CallOption) (*QueryBaseHeaderResponse, error)
+	// HeaderDepth returns the depth of the header in main chain or error if the
+	// block is not found or it exists on fork
+	HeaderDepth(ctx context.Context, in *QueryHeaderDepthRequest, opts ...grpc.CallOption) (*QueryHeaderDepthResponse, error)
+	// RecentReorgs returns the reorgs kept in the bounded reorg log, ordered
+	// from the most recent to the oldest one, or from the oldest to the most
+	// recent one if pagination.reverse is set
+	RecentReorgs(ctx context.Context, in *QueryRecentReorgsRequest, opts ...grpc.CallOption) (*QueryRecentReorgsResponse, error)
+	// VerifyTxInclusion verifies the inclusion proof of a BTC transaction in the
+	// BTC block with the given hash, and returns the height and the depth of the
+	// block
+	VerifyTxInclusion(ctx context.Context, in *QueryVerifyTxInclusionRequest, opts ...grpc.CallOption) (*QueryVerifyTxInclusionResponse, error)
+	// HeadersByHeightRange returns the main chain headers with heights in the
+	// given inclusive range
+	HeadersByHeightRange(ctx context.Context, in *QueryHeadersByHeightRangeRequest, opts ...grpc.CallOption) (*QueryHeadersByHeightRangeResponse, error)
+	// BlockLocator returns the fork point of a Bitcoin-style block locator with
+	// the main chain, and the main chain headers following it
+	BlockLocator(ctx context.Context, in *QueryBlockLocatorRequest, opts ...grpc.CallOption) (*QueryBlockLocatorResponse, error)
+	// HeaderAccumulator returns the accumulator committing to the headers pruned
+	// from the store
+	HeaderAccumulator(ctx context.Context, in *QueryHeaderAccumulatorRequest, opts ...grpc.CallOption) (*QueryHeaderAccumulatorResponse, error)
 }
 
-func (m *QueryTipRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryTipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Hashes(ctx context.Context, in *QueryHashesRequest, opts ...grpc.CallOption) (*QueryHashesResponse, error) {
+	out := new(QueryHashesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Hashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Contains(ctx context.Context, in *QueryContainsRequest, opts ...grpc.CallOption) (*QueryContainsResponse, error) {
+	out := new(QueryContainsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Contains", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBaseHeaderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ContainsBytes(ctx context.Context, in *QueryContainsBytesRequest, opts ...grpc.CallOption) (*QueryContainsBytesResponse, error) {
+	out := new(QueryContainsBytesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/ContainsBytes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBaseHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) MainChain(ctx context.Context, in *QueryMainChainRequest, opts ...grpc.CallOption) (*QueryMainChainResponse, error) {
+	out := new(QueryMainChainResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/MainChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBaseHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) Tip(ctx context.Context, in *QueryTipRequest, opts ...grpc.CallOption) (*QueryTipResponse, error) {
+	out := new(QueryTipResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/Tip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBaseHeaderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BaseHeader(ctx context.Context, in *QueryBaseHeaderRequest, opts ...grpc.CallOption) (*QueryBaseHeaderResponse, error) {
+	out := new(QueryBaseHeaderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/BaseHeader", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBaseHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HeaderDepth(ctx context.Context, in *QueryHeaderDepthRequest, opts ...grpc.CallOption) (*QueryHeaderDepthResponse, error) {
+	out := new(QueryHeaderDepthResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/HeaderDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBaseHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Header != nil {
-		{
-			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RecentReorgs(ctx context.Context, in *QueryRecentReorgsRequest, opts ...grpc.CallOption) (*QueryRecentReorgsResponse, error) {
+	out := new(QueryRecentReorgsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/RecentReorgs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeaderDepthRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) VerifyTxInclusion(ctx context.Context, in *QueryVerifyTxInclusionRequest, opts ...grpc.CallOption) (*QueryVerifyTxInclusionResponse, error) {
+	out := new(QueryVerifyTxInclusionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/VerifyTxInclusion", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryHeaderDepthRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HeadersByHeightRange(ctx context.Context, in *QueryHeadersByHeightRangeRequest, opts ...grpc.CallOption) (*QueryHeadersByHeightRangeResponse, error) {
+	out := new(QueryHeadersByHeightRangeResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/HeadersByHeightRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryHeaderDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) BlockLocator(ctx context.Context, in *QueryBlockLocatorRequest, opts ...grpc.CallOption) (*QueryBlockLocatorResponse, error) {
+	out := new(QueryBlockLocatorResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/BlockLocator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryHeaderDepthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) HeaderAccumulator(ctx context.Context, in *QueryHeaderAccumulatorRequest, opts ...grpc.CallOption) (*QueryHeaderAccumulatorResponse, error) {
+	out := new(QueryHeaderAccumulatorResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btclightclient.v1.Query/HeaderAccumulator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryHeaderDepthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Hashes retrieves the hashes maintained by the module.
+	Hashes(context.Context, *QueryHashesRequest) (*QueryHashesResponse, error)
+	// Contains checks whether a hash is maintained by the module.
+	Contains(context.Context, *QueryContainsRequest) (*QueryContainsResponse, error)
+	// ContainsBytes is a temporary method that
+	// checks whether a hash is maintained by the module.
+	// See discussion at https://github.com/babylonlabs-io/babylon/pull/132
+	// for more details.
+	ContainsBytes(context.Context, *QueryContainsBytesRequest) (*QueryContainsBytesResponse, error)
+	// MainChain returns the canonical chain
+	MainChain(context.Context, *QueryMainChainRequest) (*QueryMainChainResponse, error)
+	// Tip return best header on canonical chain
+	Tip(context.Context, *QueryTipRequest) (*QueryTipResponse, error)
+	// BaseHeader returns the base BTC header of the chain. This header is defined
+	// on genesis.
+	BaseHeader(context.Context, *QueryBaseHeaderRequest) (*QueryBaseHeaderResponse, error)
+	// HeaderDepth returns the depth of the header in main chain or error if the
+	// block is not found or it exists on fork
+	HeaderDepth(context.Context, *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error)
+	// RecentReorgs returns the reorgs kept in the bounded reorg log, ordered
+	// from the most recent to the oldest one, or from the oldest to the most
+	// recent one if pagination.reverse is set
+	RecentReorgs(context.Context, *QueryRecentReorgsRequest) (*QueryRecentReorgsResponse, error)
+	// VerifyTxInclusion verifies the inclusion proof of a BTC transaction in the
+	// BTC block with the given hash, and returns the height and the depth of the
+	// block
+	VerifyTxInclusion(context.Context, *QueryVerifyTxInclusionRequest) (*QueryVerifyTxInclusionResponse, error)
+	// HeadersByHeightRange returns the main chain headers with heights in the
+	// given inclusive range
+	HeadersByHeightRange(context.Context, *QueryHeadersByHeightRangeRequest) (*QueryHeadersByHeightRangeResponse, error)
+	// BlockLocator returns the fork point of a Bitcoin-style block locator with
+	// the main chain, and the main chain headers following it
+	BlockLocator(context.Context, *QueryBlockLocatorRequest) (*QueryBlockLocatorResponse, error)
+	// HeaderAccumulator returns the accumulator committing to the headers pruned
+	// from the store
+	HeaderAccumulator(context.Context, *QueryHeaderAccumulatorRequest) (*QueryHeaderAccumulatorResponse, error)
 }
 
-func (m *QueryHeaderDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Depth != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *BTCHeaderInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Hashes(ctx context.Context, req *QueryHashesRequest) (*QueryHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hashes not implemented")
+}
+func (*UnimplementedQueryServer) Contains(ctx context.Context, req *QueryContainsRequest) (*QueryContainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Contains not implemented")
+}
+func (*UnimplementedQueryServer) ContainsBytes(ctx context.Context, req *QueryContainsBytesRequest) (*QueryContainsBytesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContainsBytes not implemented")
+}
+func (*UnimplementedQueryServer) MainChain(ctx context.Context, req *QueryMainChainRequest) (*QueryMainChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MainChain not implemented")
+}
+func (*UnimplementedQueryServer) Tip(ctx context.Context, req *QueryTipRequest) (*QueryTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tip not implemented")
+}
+func (*UnimplementedQueryServer) BaseHeader(ctx context.Context, req *QueryBaseHeaderRequest) (*QueryBaseHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseHeader not implemented")
+}
+func (*UnimplementedQueryServer) HeaderDepth(ctx context.Context, req *QueryHeaderDepthRequest) (*QueryHeaderDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaderDepth not implemented")
+}
+func (*UnimplementedQueryServer) RecentReorgs(ctx context.Context, req *QueryRecentReorgsRequest) (*QueryRecentReorgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecentReorgs not implemented")
+}
+func (*UnimplementedQueryServer) VerifyTxInclusion(ctx context.Context, req *QueryVerifyTxInclusionRequest) (*QueryVerifyTxInclusionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTxInclusion not implemented")
+}
+func (*UnimplementedQueryServer) HeadersByHeightRange(ctx context.Context, req *QueryHeadersByHeightRangeRequest) (*QueryHeadersByHeightRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeadersByHeightRange not implemented")
+}
+func (*UnimplementedQueryServer) BlockLocator(ctx context.Context, req *QueryBlockLocatorRequest) (*QueryBlockLocatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockLocator not implemented")
+}
+func (*UnimplementedQueryServer) HeaderAccumulator(ctx context.Context, req *QueryHeaderAccumulatorRequest) (*QueryHeaderAccumulatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeaderAccumulator not implemented")
 }
 
-func (m *BTCHeaderInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *BTCHeaderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Work.Size()
-		i -= size
-		if _, err := m.Work.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if len(m.HashHex) > 0 {
-		i -= len(m.HashHex)
-		copy(dAtA[i:], m.HashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.HashHex)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/Params",
 	}
-	if len(m.HeaderHex) > 0 {
-		i -= len(m.HeaderHex)
-		copy(dAtA[i:], m.HeaderHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.HeaderHex)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Query_Hashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).Hashes(ctx, in)
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/Hashes",
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Hashes(ctx, req.(*QueryHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHashesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Contains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Contains(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/Contains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Contains(ctx, req.(*QueryContainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHashesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ContainsBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContainsBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Hashes) > 0 {
-		for _, e := range m.Hashes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ContainsBytes(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/ContainsBytes",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContainsBytes(ctx, req.(*QueryContainsBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryContainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_MainChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMainChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Hash != nil {
-		l = m.Hash.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).MainChain(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryContainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/MainChain",
 	}
-	var l int
-	_ = l
-	if m.Contains {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MainChain(ctx, req.(*QueryMainChainRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryContainsBytesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Tip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Tip(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryContainsBytesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/Tip",
 	}
-	var l int
-	_ = l
-	if m.Contains {
-		n += 2
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tip(ctx, req.(*QueryTipRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMainChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BaseHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).BaseHeader(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/BaseHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseHeader(ctx, req.(*QueryBaseHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryMainChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_HeaderDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeaderDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).HeaderDepth(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/HeaderDepth",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeaderDepth(ctx, req.(*QueryHeaderDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTipRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RecentReorgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecentReorgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).RecentReorgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/RecentReorgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecentReorgs(ctx, req.(*QueryRecentReorgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryTipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_VerifyTxInclusion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyTxInclusionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyTxInclusion(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/VerifyTxInclusion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyTxInclusion(ctx, req.(*QueryVerifyTxInclusionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBaseHeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_HeadersByHeightRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeadersByHeightRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).HeadersByHeightRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/HeadersByHeightRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeadersByHeightRange(ctx, req.(*QueryHeadersByHeightRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBaseHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BlockLocator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockLocatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).BlockLocator(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/BlockLocator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockLocator(ctx, req.(*QueryBlockLocatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeaderDepthRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_HeaderAccumulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeaderAccumulatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).HeaderAccumulator(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btclightclient.v1.Query/HeaderAccumulator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeaderAccumulator(ctx, req.(*QueryHeaderAccumulatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryHeaderDepthResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btclightclient.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Hashes",
+			Handler:    _Query_Hashes_Handler,
+		},
+		{
+			MethodName: "Contains",
+			Handler:    _Query_Contains_Handler,
+		},
+		{
+			MethodName: "ContainsBytes",
+			Handler:    _Query_ContainsBytes_Handler,
+		},
+		{
+			MethodName: "MainChain",
+			Handler:    _Query_MainChain_Handler,
+		},
+		{
+			MethodName: "Tip",
+			Handler:    _Query_Tip_Handler,
+		},
+		{
+			MethodName: "BaseHeader",
+			Handler:    _Query_BaseHeader_Handler,
+		},
+		{
+			MethodName: "HeaderDepth",
+			Handler:    _Query_HeaderDepth_Handler,
+		},
+		{
+			MethodName: "RecentReorgs",
+			Handler:    _Query_RecentReorgs_Handler,
+		},
+		{
+			MethodName: "VerifyTxInclusion",
+			Handler:    _Query_VerifyTxInclusion_Handler,
+		},
+		{
+			MethodName: "HeadersByHeightRange",
+			Handler:    _Query_HeadersByHeightRange_Handler,
+		},
+		{
+			MethodName: "BlockLocator",
+			Handler:    _Query_BlockLocator_Handler,
+		},
+		{
+			MethodName: "HeaderAccumulator",
+			Handler:    _Query_HeaderAccumulator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btclightclient/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Depth != 0 {
-		n += 1 + sovQuery(uint64(m.Depth))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hashes) > 0 {
+		for iNdEx := len(m.Hashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Hashes[iNdEx].Size()
+				i -= size
+				if _, err := m.Hashes[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Hash != nil {
+		{
+			size := m.Hash.Size()
+			i -= size
+			if _, err := m.Hash.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Contains {
+		i--
+		if m.Contains {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContainsBytesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContainsBytesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContainsBytesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContainsBytesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContainsBytesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContainsBytesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Contains {
+		i--
+		if m.Contains {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMainChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMainChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMainChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMainChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMainChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMainChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		{
+			size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCHeaderInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCHeaderInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCHeaderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Work.Size()
+		i -= size
+		if _, err := m.Work.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.HashHex) > 0 {
+		i -= len(m.HashHex)
+		copy(dAtA[i:], m.HashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HashHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HeaderHex) > 0 {
+		i -= len(m.HeaderHex)
+		copy(dAtA[i:], m.HeaderHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HeaderHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentReorgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentReorgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentReorgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecentReorgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecentReorgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecentReorgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reorgs) > 0 {
+		for iNdEx := len(m.Reorgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reorgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCReorgRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCReorgRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCReorgRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for iNdEx := len(m.Annotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Annotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ForkPoint != nil {
+		{
+			size, err := m.ForkPoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NewTip != nil {
+		{
+			size, err := m.NewTip.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OldTip != nil {
+		{
+			size, err := m.OldTip.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BabylonHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyTxInclusionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyTxInclusionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyTxInclusionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccumulatorProof != nil {
+		{
+			size, err := m.AccumulatorProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Header != nil {
+		{
+			size := m.Header.Size()
+			i -= size
+			if _, err := m.Header.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MerkleProof) > 0 {
+		i -= len(m.MerkleProof)
+		copy(dAtA[i:], m.MerkleProof)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MerkleProof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyTxInclusionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyTxInclusionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyTxInclusionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Included {
+		i--
+		if m.Included {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadersByHeightRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadersByHeightRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadersByHeightRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeadersByHeightRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeadersByHeightRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeadersByHeightRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockLocatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockLocatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockLocatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Locator) > 0 {
+		for iNdEx := len(m.Locator) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Locator[iNdEx])
+			copy(dAtA[i:], m.Locator[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Locator[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockLocatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockLocatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockLocatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ForkPoint != nil {
+		{
+			size, err := m.ForkPoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderAccumulatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderAccumulatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderAccumulatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeaderAccumulatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeaderAccumulatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeaderAccumulatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Accumulator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hashes) > 0 {
+		for _, e := range m.Hashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Hash != nil {
+		l = m.Hash.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Contains {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryContainsBytesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContainsBytesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Contains {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryMainChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMainChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeaderDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeaderDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *BTCHeaderInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Work.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRecentReorgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecentReorgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reorgs) > 0 {
+		for _, e := range m.Reorgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCReorgRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BabylonHeight))
+	}
+	if m.OldTip != nil {
+		l = m.OldTip.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NewTip != nil {
+		l = m.NewTip.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ForkPoint != nil {
+		l = m.ForkPoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	if len(m.Annotations) > 0 {
+		for _, e := range m.Annotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVerifyTxInclusionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	l = len(m.MerkleProof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AccumulatorProof != nil {
+		l = m.AccumulatorProof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVerifyTxInclusionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Included {
+		n += 2
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *QueryHeadersByHeightRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *QueryHeadersByHeightRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockLocatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locator) > 0 {
+		for _, s := range m.Locator {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryBlockLocatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForkPoint != nil {
+		l = m.ForkPoint.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHeaderAccumulatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHeaderAccumulatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Accumulator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHashesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHashesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BTCHeaderHashBytes
+			m.Hashes = append(m.Hashes, v)
+			if err := m.Hashes[len(m.Hashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BTCHeaderHashBytes
+			m.Hash = &v
+			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Contains = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContainsBytesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContainsBytesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContainsBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContainsBytesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContainsBytesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContainsBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Contains = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMainChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMainChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMainChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMainChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &BTCHeaderInfoResponse{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &BTCHeaderInfoResponse{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *BTCHeaderInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.HeaderHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.HashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Work.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseHeaderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2101,10 +4724,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseHeaderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2128,7 +4751,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2151,15 +4774,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseHeaderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2186,7 +4809,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Header == nil {
+				m.Header = &BTCHeaderInfoResponse{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2211,7 +4837,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeaderDepthRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2234,17 +4860,17 @@ func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHashesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeaderDepthRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeaderDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2254,28 +4880,93 @@ func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHeaderDepthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHeaderDepthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHeaderDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2297,7 +4988,7 @@ func (m *QueryHashesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
+func (m *BTCHeaderInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2320,17 +5011,17 @@ func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHashesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCHeaderInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCHeaderInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHex", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2340,32 +5031,29 @@ func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BTCHeaderHashBytes
-			m.Hashes = append(m.Hashes, v)
-			if err := m.Hashes[len(m.Hashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HeaderHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HashHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2375,25 +5063,74 @@ func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.HashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Work.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2418,7 +5155,7 @@ func (m *QueryHashesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecentReorgsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2441,17 +5178,17 @@ func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecentReorgsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecentReorgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2461,24 +5198,25 @@ func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BTCHeaderHashBytes
-			m.Hash = &v
-			if err := m.Hash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2503,7 +5241,7 @@ func (m *QueryContainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecentReorgsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2526,17 +5264,17 @@ func (m *QueryContainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecentReorgsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecentReorgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reorgs", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2546,12 +5284,62 @@ func (m *QueryContainsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Contains = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reorgs = append(m.Reorgs, &BTCReorgRecordResponse{})
+			if err := m.Reorgs[len(m.Reorgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2573,7 +5361,7 @@ func (m *QueryContainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContainsBytesRequest) Unmarshal(dAtA []byte) error {
+func (m *BTCReorgRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2596,17 +5384,36 @@ func (m *QueryContainsBytesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContainsBytesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCReorgRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContainsBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCReorgRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2616,81 +5423,176 @@ func (m *QueryContainsBytesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BabylonHeight", wireType)
+			}
+			m.BabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldTip == nil {
+				m.OldTip = &BTCHeaderInfoResponse{}
+			}
+			if err := m.OldTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryContainsBytesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if m.NewTip == nil {
+				m.NewTip = &BTCHeaderInfoResponse{}
 			}
-			if iNdEx >= l {
+			if err := m.NewTip.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkPoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ForkPoint == nil {
+				m.ForkPoint = &BTCHeaderInfoResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContainsBytesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContainsBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ForkPoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var v int
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2700,12 +5602,26 @@ func (m *QueryContainsBytesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Contains = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Annotations = append(m.Annotations, &BTCReorgAnnotation{})
+			if err := m.Annotations[len(m.Annotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2727,7 +5643,7 @@ func (m *QueryContainsBytesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyTxInclusionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2750,15 +5666,169 @@ func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMainChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyTxInclusionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMainChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyTxInclusionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleProof = append(m.MerkleProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleProof == nil {
+				m.MerkleProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BTCHeaderBytes
+			m.Header = &v
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatorProof", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2785,10 +5855,10 @@ func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.AccumulatorProof == nil {
+				m.AccumulatorProof = &HeaderAccumulatorProof{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AccumulatorProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2813,7 +5883,7 @@ func (m *QueryMainChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyTxInclusionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2836,17 +5906,17 @@ func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMainChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyTxInclusionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMainChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyTxInclusionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Included", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2856,31 +5926,17 @@ func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Headers = append(m.Headers, &BTCHeaderInfoResponse{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Included = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var msglen int
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2890,28 +5946,30 @@ func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2933,7 +5991,7 @@ func (m *QueryMainChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTipRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadersByHeightRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2956,12 +6014,50 @@ func (m *QueryTipRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTipRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadersByHeightRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadersByHeightRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2983,7 +6079,7 @@ func (m *QueryTipRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeadersByHeightRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3006,15 +6102,15 @@ func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeadersByHeightRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeadersByHeightRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3041,10 +6137,8 @@ func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &BTCHeaderInfoResponse{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, &BTCHeaderInfoResponse{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3069,7 +6163,7 @@ func (m *QueryTipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseHeaderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockLocatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3092,12 +6186,63 @@ func (m *QueryBaseHeaderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseHeaderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockLocatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockLocatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locator = append(m.Locator, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3119,7 +6264,7 @@ func (m *QueryBaseHeaderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockLocatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3142,15 +6287,15 @@ func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBaseHeaderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockLocatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBaseHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockLocatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkPoint", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3177,68 +6322,18 @@ func (m *QueryBaseHeaderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &BTCHeaderInfoResponse{}
+			if m.ForkPoint == nil {
+				m.ForkPoint = &BTCHeaderInfoResponse{}
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ForkPoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryHeaderDepthRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeaderDepthRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeaderDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3248,23 +6343,25 @@ func (m *QueryHeaderDepthRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Headers = append(m.Headers, &BTCHeaderInfoResponse{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3287,7 +6384,7 @@ func (m *QueryHeaderDepthRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHeaderDepthResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeaderAccumulatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3310,31 +6407,12 @@ func (m *QueryHeaderDepthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHeaderDepthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeaderAccumulatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHeaderDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeaderAccumulatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
-			}
-			m.Depth = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Depth |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3356,7 +6434,7 @@ func (m *QueryHeaderDepthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCHeaderInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeaderAccumulatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3379,100 +6457,17 @@ func (m *BTCHeaderInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BTCHeaderInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeaderAccumulatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCHeaderInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeaderAccumulatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HeaderHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HashHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HashHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Work", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3482,23 +6477,22 @@ func (m *BTCHeaderInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Work.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,34 @@
+package types
+
+// Annotate records that the given module found the given epochs to be affected
+// by the reorg. Epochs already recorded for the module are not duplicated.
+func (r *BTCReorgRecord) Annotate(module string, epochs []uint64) {
+	var annotation *BTCReorgAnnotation
+	for _, a := range r.Annotations {
+		if a.Module == module {
+			annotation = a
+			break
+		}
+	}
+
+	if annotation == nil {
+		annotation = &BTCReorgAnnotation{Module: module}
+		r.Annotations = append(r.Annotations, annotation)
+	}
+
+	for _, epoch := range epochs {
+		if !annotation.HasEpoch(epoch) {
+			annotation.Epochs = append(annotation.Epochs, epoch)
+		}
+	}
+}
+
+// HasEpoch returns true if the epoch is part of the annotation.
+func (a *BTCReorgAnnotation) HasEpoch(epoch uint64) bool {
+	for _, e := range a.Epochs {
+		if e == epoch {
+			return true
+		}
+	}
+	return false
+}
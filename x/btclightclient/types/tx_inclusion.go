@@ -0,0 +1,21 @@
+package types
+
+// TxInclusionInfo is the result of verifying the inclusion of a BTC
+// transaction in a BTC block maintained by the light client
+type TxInclusionInfo struct {
+	// Included is true if the merkle proof of the tx is valid against the block
+	Included bool
+	// BlockHeight is the height of the block
+	BlockHeight uint32
+	// Depth is the depth of the block in the main chain
+	Depth uint32
+}
+
+// ToResponse converts the TxInclusionInfo into QueryVerifyTxInclusionResponse.
+func (i *TxInclusionInfo) ToResponse() *QueryVerifyTxInclusionResponse {
+	return &QueryVerifyTxInclusionResponse{
+		Included:    i.Included,
+		BlockHeight: i.BlockHeight,
+		Depth:       i.Depth,
+	}
+}
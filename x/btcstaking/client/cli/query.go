@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
@@ -26,7 +28,16 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdFinalityProviders())
 	cmd.AddCommand(CmdBTCDelegations())
 	cmd.AddCommand(CmdFinalityProviderDelegations())
+	cmd.AddCommand(CmdBTCDelegationsByStakerBTCPK())
+	cmd.AddCommand(CmdBTCDelegationsByStakerAddr())
+	cmd.AddCommand(CmdFinalityProviderCommissionHistory())
+	cmd.AddCommand(CmdStakeCapacity())
+	cmd.AddCommand(CmdFinalityProvidersStakeCapacity())
+	cmd.AddCommand(CmdFinalityProviderStakeCapacity())
 	cmd.AddCommand(CmdDelegation())
+	cmd.AddCommand(CmdValidateBTCDelegation())
+	cmd.AddCommand(CmdAllowedStakingTxHash())
+	cmd.AddCommand(CmdAllowedStakingTxHashes())
 
 	return cmd
 }
@@ -188,3 +199,290 @@ func CmdFinalityProviderDelegations() *cobra.Command {
 
 	return cmd
 }
+
+func CmdBTCDelegationsByStakerBTCPK() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-by-staker-btc-pk [staker_btc_pk_hex] [status]",
+		Short: "retrieve all BTC delegations of a given staker BTC PK under the given status (pending, active, unbonding, unbonded, any)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			status, err := types.NewBTCDelegationStatusFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BTCDelegationsByStakerBTCPK(cmd.Context(), &types.QueryBTCDelegationsByStakerBTCPKRequest{
+				StakerBtcPkHex: args[0],
+				Status:         status,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations-by-staker-btc-pk")
+
+	return cmd
+}
+
+func CmdBTCDelegationsByStakerAddr() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "btc-delegations-by-staker-addr [staker_addr] [status]",
+		Short: "retrieve all BTC delegations of a given staker address under the given status (pending, active, unbonding, unbonded, any)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			status, err := types.NewBTCDelegationStatusFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BTCDelegationsByStakerAddr(cmd.Context(), &types.QueryBTCDelegationsByStakerAddrRequest{
+				StakerAddr: args[0],
+				Status:     status,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "btc-delegations-by-staker-addr")
+
+	return cmd
+}
+
+func CmdValidateBTCDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-btc-delegation [msg_create_btc_delegation_json_file]",
+		Short: "run the checks of a MsgCreateBTCDelegation in JSON format against the current state without submitting it, and retrieve every failing rule",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contents, err := os.ReadFile(filepath.Clean(args[0]))
+			if err != nil {
+				return err
+			}
+			var msg types.MsgCreateBTCDelegation
+			if err := clientCtx.Codec.UnmarshalJSON(contents, &msg); err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidateBTCDelegation(cmd.Context(), &types.QueryValidateBTCDelegationRequest{
+				Msg: &msg,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProviderCommissionHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-commission-history [fp_pk_hex]",
+		Short: "retrieve the commission history and the pending commission change of a given finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProviderCommissionHistory(cmd.Context(), &types.QueryFinalityProviderCommissionHistoryRequest{
+				FpBtcPkHex: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "finality-provider-commission-history")
+
+	return cmd
+}
+
+func CmdStakeCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stake-capacity",
+		Short: "retrieve the satoshis staked in all active BTC delegations and the remaining capacity under the total stake cap",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakeCapacity(cmd.Context(), &types.QueryStakeCapacityRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityProvidersStakeCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-providers-stake-capacity",
+		Short: "retrieve the satoshis staked to each finality provider and the remaining capacity under the per finality provider stake cap",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProvidersStakeCapacity(cmd.Context(), &types.QueryFinalityProvidersStakeCapacityRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "finality-providers-stake-capacity")
+
+	return cmd
+}
+
+func CmdFinalityProviderStakeCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-provider-stake-capacity [fp_pk_hex]",
+		Short: "retrieve the satoshis staked to a given finality provider and the remaining capacity under the per finality provider stake cap",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityProviderStakeCapacity(cmd.Context(), &types.QueryFinalityProviderStakeCapacityRequest{
+				FpBtcPkHex: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAllowedStakingTxHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowed-staking-tx-hash [staking_tx_hash_hex]",
+		Short: "check whether a staking tx hash is in the allow list",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllowedStakingTxHash(
+				cmd.Context(),
+				&types.QueryAllowedStakingTxHashRequest{
+					StakingTxHashHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAllowedStakingTxHashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowed-staking-tx-hashes",
+		Short: "retrieve all staking tx hashes in the allow list",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllowedStakingTxHashes(
+				cmd.Context(),
+				&types.QueryAllowedStakingTxHashesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "allowed-staking-tx-hashes")
+
+	return cmd
+}
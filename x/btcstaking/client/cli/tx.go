@@ -24,6 +24,9 @@ const (
 	FlagSecurityContact = "security-contact"
 	FlagDetails         = "details"
 	FlagCommissionRate  = "commission-rate"
+
+	FlagCommissionMaxRate       = "commission-max-rate"
+	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -39,7 +42,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateFinalityProviderCmd(),
 		NewEditFinalityProviderCmd(),
+		NewRetireFinalityProviderCmd(),
 		NewCreateBTCDelegationCmd(),
+		NewExtendBTCDelegationCmd(),
+		NewRedelegateBTCDelegationCmd(),
 		NewAddCovenantSigsCmd(),
 		NewBTCUndelegateCmd(),
 		NewSelectiveSlashingEvidenceCmd(),
@@ -84,6 +90,16 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			maxRateStr, _ := fs.GetString(FlagCommissionMaxRate)
+			maxRate, err := sdkmath.LegacyNewDecFromStr(maxRateStr)
+			if err != nil {
+				return err
+			}
+			maxChangeRateStr, _ := fs.GetString(FlagCommissionMaxChangeRate)
+			maxChangeRate, err := sdkmath.LegacyNewDecFromStr(maxChangeRateStr)
+			if err != nil {
+				return err
+			}
 
 			// get BTC PK
 			btcPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
@@ -103,6 +119,10 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 				Commission:  &rate,
 				BtcPk:       btcPK,
 				Pop:         pop,
+				CommissionRates: &types.CommissionRates{
+					MaxRate:       maxRate,
+					MaxChangeRate: maxChangeRate,
+				},
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -116,6 +136,8 @@ func NewCreateFinalityProviderCmd() *cobra.Command {
 	fs.String(FlagDetails, "", "The finality provider's (optional) details")
 	fs.String(FlagIdentity, "", "The (optional) identity signature (ex. UPort or Keybase)")
 	fs.String(FlagCommissionRate, "0", "The initial commission rate percentage")
+	fs.String(FlagCommissionMaxRate, "1", "The maximum commission rate percentage")
+	fs.String(FlagCommissionMaxChangeRate, "0.01", "The maximum commission rate increase percentage per change")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -188,6 +210,41 @@ func NewEditFinalityProviderCmd() *cobra.Command {
 	return cmd
 }
 
+func NewRetireFinalityProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-finality-provider [btc_pk]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Retire an existing finality provider",
+		Long: strings.TrimSpace(
+			`Retire an existing finality provider. The finality provider stops accepting new BTC delegations ` +
+				`and exits once its remaining voting power expires or is unbonded.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get BTC PK
+			btcPK, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRetireFinalityProvider{
+				Addr:  clientCtx.FromAddress.String(),
+				BtcPk: btcPK,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewCreateBTCDelegationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-btc-delegation [btc_pk] [pop_hex] [staking_tx] [inclusion_proof] [fp_pk] [staking_time] [staking_value] [slashing_tx] [delegator_slashing_sig] [unbonding_tx] [unbonding_slashing_tx] [unbonding_time] [unbonding_value] [delegator_unbonding_slashing_sig]",
@@ -316,6 +373,175 @@ func NewCreateBTCDelegationCmd() *cobra.Command {
 	return cmd
 }
 
+func NewExtendBTCDelegationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-btc-delegation [prev_staking_tx_hash] [staking_tx] [inclusion_proof] [staking_time] [staking_value] [slashing_tx] [delegator_slashing_sig] [unbonding_tx] [unbonding_slashing_tx] [unbonding_time] [unbonding_value] [delegator_unbonding_slashing_sig]",
+		Args:  cobra.ExactArgs(12),
+		Short: "Extend a BTC delegation without unbonding it",
+		Long: strings.TrimSpace(
+			`Extend a BTC delegation identified by a given staking tx hash. The new staking tx must spend the staking output of the extended BTC delegation. The extension restakes to the same finality providers, and takes over the voting power of the extended BTC delegation once it becomes active.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseExtendBTCDelegationArgs(clientCtx.FromAddress.String(), args)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRedelegateBTCDelegationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegate-btc-delegation [prev_staking_tx_hash] [fp_pk] [staking_tx] [inclusion_proof] [staking_time] [staking_value] [slashing_tx] [delegator_slashing_sig] [unbonding_tx] [unbonding_slashing_tx] [unbonding_time] [unbonding_value] [delegator_unbonding_slashing_sig]",
+		Args:  cobra.ExactArgs(13),
+		Short: "Redelegate a BTC delegation to another finality provider without unbonding it",
+		Long: strings.TrimSpace(
+			`Redelegate a BTC delegation identified by a given staking tx hash to another finality provider. The transition staking tx must spend the staking output of the redelegated BTC delegation, and be pre-signed by the covenant committee. The redelegated BTC delegation keeps its voting power until the redelegation becomes active.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// TODO: Support multiple finality providers
+			// get finality provider PK
+			fpPK, err := bbn.NewBIP340PubKeyFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			// the remaining arguments are the same as the ones of extension
+			extArgs := append([]string{args[0]}, args[2:]...)
+			extMsg, err := parseExtendBTCDelegationArgs(clientCtx.FromAddress.String(), extArgs)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRedelegateBTCDelegation{
+				StakerAddr:                    extMsg.StakerAddr,
+				PrevStakingTxHash:             extMsg.PrevStakingTxHash,
+				FpBtcPkList:                   []bbn.BIP340PubKey{*fpPK},
+				StakingTime:                   extMsg.StakingTime,
+				StakingValue:                  extMsg.StakingValue,
+				StakingTx:                     extMsg.StakingTx,
+				StakingTxInclusionProof:       extMsg.StakingTxInclusionProof,
+				SlashingTx:                    extMsg.SlashingTx,
+				DelegatorSlashingSig:          extMsg.DelegatorSlashingSig,
+				UnbondingTx:                   extMsg.UnbondingTx,
+				UnbondingTime:                 extMsg.UnbondingTime,
+				UnbondingValue:                extMsg.UnbondingValue,
+				UnbondingSlashingTx:           extMsg.UnbondingSlashingTx,
+				DelegatorUnbondingSlashingSig: extMsg.DelegatorUnbondingSlashingSig,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseExtendBTCDelegationArgs parses the arguments of extend-btc-delegation
+// into MsgExtendBTCDelegation
+func parseExtendBTCDelegationArgs(stakerAddr string, args []string) (*types.MsgExtendBTCDelegation, error) {
+	// get staking tx bytes
+	stakingTx, err := hex.DecodeString(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var inclusionProof *types.InclusionProof
+	// inclusionProof can be nil if empty argument is provided
+	if len(args[2]) > 0 {
+		inclusionProof, err = types.NewInclusionProofFromHex(args[2])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// get staking time
+	stakingTime, err := parseLockTime(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	stakingValue, err := parseBtcAmount(args[4])
+	if err != nil {
+		return nil, err
+	}
+
+	// get slashing tx
+	slashingTx, err := types.NewBTCSlashingTxFromHex(args[5])
+	if err != nil {
+		return nil, err
+	}
+
+	// get delegator sig on slashing tx
+	delegatorSlashingSig, err := bbn.NewBIP340SignatureFromHex(args[6])
+	if err != nil {
+		return nil, err
+	}
+
+	// get unbonding tx
+	_, unbondingTxBytes, err := bbn.NewBTCTxFromHex(args[7])
+	if err != nil {
+		return nil, err
+	}
+
+	// get unbonding slashing tx
+	unbondingSlashingTx, err := types.NewBTCSlashingTxFromHex(args[8])
+	if err != nil {
+		return nil, err
+	}
+
+	// get unbonding time
+	unbondingTime, err := parseLockTime(args[9])
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingValue, err := parseBtcAmount(args[10])
+	if err != nil {
+		return nil, err
+	}
+
+	// get delegator sig on unbonding slashing tx
+	delegatorUnbondingSlashingSig, err := bbn.NewBIP340SignatureFromHex(args[11])
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExtendBTCDelegation{
+		StakerAddr:                    stakerAddr,
+		PrevStakingTxHash:             args[0],
+		StakingTime:                   uint32(stakingTime),
+		StakingValue:                  int64(stakingValue),
+		StakingTx:                     stakingTx,
+		StakingTxInclusionProof:       inclusionProof,
+		SlashingTx:                    slashingTx,
+		DelegatorSlashingSig:          delegatorSlashingSig,
+		UnbondingTx:                   unbondingTxBytes,
+		UnbondingTime:                 uint32(unbondingTime),
+		UnbondingValue:                int64(unbondingValue),
+		UnbondingSlashingTx:           unbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: delegatorUnbondingSlashingSig,
+	}, nil
+}
+
 func NewAddBTCDelegationInclusionProofCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "add-btc-inclusion-proof [staking_tx_hash] [inclusion_proof]",
@@ -355,11 +581,11 @@ func NewAddBTCDelegationInclusionProofCmd() *cobra.Command {
 
 func NewAddCovenantSigsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "add-covenant-sigs [covenant_pk] [staking_tx_hash] [slashing_tx_sig1],[slashing_tx_sig2],... [unbonding_tx_sig] [slashing_unbonding_tx_sig1],[slashing_unbonding_tx_sig2],...",
-		Args:  cobra.ExactArgs(5),
+		Use:   "add-covenant-sigs [covenant_pk] [staking_tx_hash] [slashing_tx_sig1],[slashing_tx_sig2],... [unbonding_tx_sig] [slashing_unbonding_tx_sig1],[slashing_unbonding_tx_sig2],... [transition_tx_sig]",
+		Args:  cobra.RangeArgs(5, 6),
 		Short: "Add a covenant signature",
 		Long: strings.TrimSpace(
-			`Add a covenant signature.`, // TODO: example
+			`Add a covenant signature. The signature on the transition tx is required iff the BTC delegation extends or redelegates a previous one.`, // TODO: example
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -401,6 +627,15 @@ func NewAddCovenantSigsCmd() *cobra.Command {
 				unbondingSlashingSigs = append(unbondingSlashingSigs, slashingSig.MustMarshal())
 			}
 
+			// get covenant signature for the transition tx, if any
+			var transitionTxSig *bbn.BIP340Signature
+			if len(args) == 6 {
+				transitionTxSig, err = bbn.NewBIP340SignatureFromHex(args[5])
+				if err != nil {
+					return err
+				}
+			}
+
 			msg := types.MsgAddCovenantSigs{
 				Signer:                  clientCtx.FromAddress.String(),
 				Pk:                      covPK,
@@ -408,6 +643,7 @@ func NewAddCovenantSigsCmd() *cobra.Command {
 				SlashingTxSigs:          slashingTxSigs,
 				UnbondingTxSig:          unbondingTxSig,
 				SlashingUnbondingTxSigs: unbondingSlashingSigs,
+				TransitionTxSig:         transitionTxSig,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
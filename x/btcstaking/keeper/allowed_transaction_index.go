@@ -4,6 +4,9 @@ import (
 	"context"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 )
 
 // IndexAllowedStakingTransaction indexes the given allowed staking transaction by its hash.
@@ -14,6 +17,14 @@ func (k Keeper) IndexAllowedStakingTransaction(ctx context.Context, txHash *chai
 	}
 }
 
+// RemoveAllowedStakingTransaction removes the given staking transaction from the allow list.
+func (k Keeper) RemoveAllowedStakingTransaction(ctx context.Context, txHash *chainhash.Hash) {
+	err := k.AllowedStakingTxHashesKeySet.Remove(ctx, txHash[:])
+	if err != nil {
+		panic(err) // encoding issue; this can only be a programming error
+	}
+}
+
 // IsStakingTransactionAllowed checks if the given staking transaction is allowed.
 func (k Keeper) IsStakingTransactionAllowed(ctx context.Context, txHash *chainhash.Hash) bool {
 	has, err := k.AllowedStakingTxHashesKeySet.Has(ctx, txHash[:])
@@ -22,3 +33,10 @@ func (k Keeper) IsStakingTransactionAllowed(ctx context.Context, txHash *chainha
 	}
 	return has
 }
+
+// isAllowListEnabled checks if the allow list is enabled at the given height
+// allow list is enabled if AllowListExpirationHeight is larger than 0,
+// and current block height is less than AllowListExpirationHeight
+func (k Keeper) isAllowListEnabled(ctx sdk.Context, p *types.Params) bool {
+	return p.AllowListExpirationHeight > 0 && uint64(ctx.BlockHeight()) < p.AllowListExpirationHeight
+}
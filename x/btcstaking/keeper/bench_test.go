@@ -37,11 +37,12 @@ func benchBeginBlock(b *testing.B, numFPs int, numDelsUnderFP int) {
 		fp, err := datagen.GenRandomFinalityProvider(r)
 		h.NoError(err)
 		msg := &types.MsgCreateFinalityProvider{
-			Addr:        fp.Addr,
-			Description: fp.Description,
-			Commission:  fp.Commission,
-			BtcPk:       fp.BtcPk,
-			Pop:         fp.Pop,
+			Addr:            fp.Addr,
+			Description:     fp.Description,
+			Commission:      fp.Commission,
+			BtcPk:           fp.BtcPk,
+			Pop:             fp.Pop,
+			CommissionRates: fp.CommissionInfo.Rates(),
 		}
 		_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
 		h.NoError(err)
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/gogoproto/proto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	asig "github.com/babylonlabs-io/babylon/crypto/schnorr-adaptor-signature"
@@ -16,7 +17,8 @@ import (
 
 // AddBTCDelegation adds a BTC delegation post verification to the system, including
 // - indexing the given BTC delegation in the BTC delegator store,
-// - saving it under BTC delegation store, and
+// - saving it under BTC delegation store,
+// - indexing it by its staker's BTC PK and Babylon address, and
 // - emit events about this BTC delegation.
 func (k Keeper) AddBTCDelegation(
 	ctx sdk.Context,
@@ -48,8 +50,11 @@ func (k Keeper) AddBTCDelegation(
 		k.setBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk, btcDelIndex)
 	}
 
-	// save this BTC delegation
+	// save this BTC delegation and index it by its staker
 	k.setBTCDelegation(ctx, btcDel)
+	if err := k.indexBTCDelegationByStaker(ctx, btcDel); err != nil {
+		return err
+	}
 
 	if err := ctx.EventManager().EmitTypedEvents(types.NewBtcDelCreationEvent(
 		btcDel,
@@ -94,8 +99,9 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 	parsedSlashingAdaptorSignatures []asig.AdaptorSignature,
 	unbondingTxSig *bbn.BIP340Signature,
 	parsedUnbondingSlashingAdaptorSignatures []asig.AdaptorSignature,
+	transitionTxSig *bbn.BIP340Signature,
 	params *types.Params,
-) {
+) error {
 	hadQuorum := btcDel.HasCovenantQuorums(params.CovenantQuorum)
 
 	// All is fine add received signatures to the BTC delegation and BtcUndelegation
@@ -104,6 +110,7 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 		parsedSlashingAdaptorSignatures,
 		unbondingTxSig,
 		parsedUnbondingSlashingAdaptorSignatures,
+		transitionTxSig,
 	)
 
 	k.setBTCDelegation(ctx, btcDel)
@@ -122,6 +129,11 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 	// is the first time the quorum is reached
 	if !hadQuorum && btcDel.HasCovenantQuorums(params.CovenantQuorum) {
 		if btcDel.HasInclusionProof() {
+			// NOTE: the stake caps are not checked here, as the signatures of
+			// the covenant members cannot be rejected because of the stake of
+			// other BTC delegations. Instead, the stake of the BTC delegation
+			// is checked against the stake caps and reserved upon its creation
+			// with the inclusion proof
 			quorumReachedEvent := types.NewCovenantQuorumReachedEvent(
 				btcDel,
 				types.BTCDelegationStatus_ACTIVE,
@@ -139,6 +151,16 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 			)
 			btcTip := k.btclcKeeper.GetTipInfo(ctx)
 			k.addPowerDistUpdateEvent(ctx, btcTip.Height, activeEvent)
+			if err := k.addActiveStake(ctx, btcDel); err != nil {
+				return err
+			}
+			if err := k.warnIfExpiringSoon(ctx, btcDel); err != nil {
+				return err
+			}
+
+			// if the BTC delegation is a successor, it takes over the voting
+			// power of the BTC delegation it succeeds
+			k.unbondPrevBTCDelegation(ctx, btcDel, btcTip.Height)
 		} else {
 			quorumReachedEvent := types.NewCovenantQuorumReachedEvent(
 				btcDel,
@@ -150,6 +172,8 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 			}
 		}
 	}
+
+	return nil
 }
 
 // btcUndelegate adds the signature of the unbonding tx signed by the staker
@@ -158,12 +182,17 @@ func (k Keeper) btcUndelegate(
 	ctx sdk.Context,
 	btcDel *types.BTCDelegation,
 	u *types.DelegatorUnbondingInfo,
-) {
+) error {
 	btcDel.BtcUndelegation.DelegatorUnbondingInfo = u
 	k.setBTCDelegation(ctx, btcDel)
 
 	if !btcDel.HasInclusionProof() {
-		return
+		return nil
+	}
+
+	// the unbonded stake no longer counts towards the stake caps
+	if err := k.releaseActiveStake(ctx, btcDel); err != nil {
+		return err
 	}
 
 	// notify subscriber about this unbonded BTC delegation
@@ -176,6 +205,127 @@ func (k Keeper) btcUndelegate(
 	unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(event)
 	btcTip := k.btclcKeeper.GetTipInfo(ctx)
 	k.addPowerDistUpdateEvent(ctx, btcTip.Height, unbondedEvent)
+
+	return nil
+}
+
+// unbondPrevBTCDelegation unbonds the BTC delegation succeeded by the given
+// newly active BTC delegation at the given BTC height, i.e., the same height
+// at which the successor becomes active, so that both state updates are
+// applied in the same voting power update. For an extension, the successor is
+// owned by the same staker and restakes to the same finality providers, so the
+// voting power and the rewards carry over without interruption. For a
+// redelegation, the voting power moves to the new finality providers. It is a
+// no-op if the given BTC delegation is not a successor
+func (k Keeper) unbondPrevBTCDelegation(
+	ctx sdk.Context,
+	btcDel *types.BTCDelegation,
+	btcHeight uint32,
+) {
+	if !btcDel.IsSuccessor() {
+		return
+	}
+
+	prevDel, err := k.GetBTCDelegation(ctx, btcDel.PrevStakingTxHash)
+	if err != nil {
+		panic(fmt.Errorf("failed to get the BTC delegation succeeded by a verified BTC delegation: %w", err))
+	}
+	prevParams := k.GetParamsByVersion(ctx, prevDel.ParamsVersion)
+	if prevParams == nil {
+		panic("params version in BTC delegation is not found")
+	}
+
+	// the previous BTC delegation might have already expired or been reported
+	// as unbonded, in which case its voting power is already removed
+	if prevDel.GetStatus(btcHeight, prevParams.CovenantQuorum) == types.BTCDelegationStatus_ACTIVE {
+		// the staking output of the previous BTC delegation is spent by the
+		// staking tx of the successor
+		prevDel.BtcUndelegation.DelegatorUnbondingInfo = &types.DelegatorUnbondingInfo{
+			SpendStakeTx: btcDel.StakingTx,
+		}
+		k.setBTCDelegation(ctx, prevDel)
+		if err := k.releaseActiveStake(ctx, prevDel); err != nil {
+			panic(fmt.Errorf("failed to release the stake of the BTC delegation succeeded by an active BTC delegation: %w", err))
+		}
+
+		// record event that the previous BTC delegation becomes unbonded at
+		// the same height as the successor becomes active
+		unbondedEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
+			StakingTxHash: btcDel.PrevStakingTxHash,
+			NewState:      types.BTCDelegationStatus_UNBONDED,
+		})
+		k.addPowerDistUpdateEvent(ctx, btcHeight, unbondedEvent)
+	}
+
+	var event proto.Message
+	if prevDel.HasSameFinalityProviders(btcDel.FpBtcPkList) {
+		event = types.NewBTCDelegationExtendedEvent(btcDel.PrevStakingTxHash, btcDel)
+	} else {
+		event = types.NewBTCDelegationRedelegatedEvent(prevDel, btcDel)
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(fmt.Errorf("failed to emit event for the successor BTC delegation: %w", err))
+	}
+}
+
+// ensurePrevFpsNotSlashed returns an error if the given BTC delegation is a
+// successor of a BTC delegation restaked to a slashed finality provider. This
+// ensures the stake of a slashed finality provider cannot escape slashing by
+// moving to a successor
+func (k Keeper) ensurePrevFpsNotSlashed(ctx context.Context, btcDel *types.BTCDelegation) error {
+	if !btcDel.IsSuccessor() {
+		return nil
+	}
+	prevDel, err := k.GetBTCDelegation(ctx, btcDel.PrevStakingTxHash)
+	if err != nil {
+		return err
+	}
+	return k.ensureFpsNotSlashed(ctx, prevDel)
+}
+
+// ensureRedelegationWindowPassed returns an error if the given BTC delegation
+// with inclusion proof is a redelegation whose staking tx is not yet deep
+// enough to be activated. The transition tx moves the stake out of the reach
+// of the slashing txs of the previous finality providers, so the redelegation
+// only becomes active once the unbonding time of the redelegated BTC
+// delegation has passed at the given BTC tip height, as if the stake was
+// unbonded first
+func (k Keeper) ensureRedelegationWindowPassed(ctx context.Context, btcDel *types.BTCDelegation, btcTipHeight uint32) error {
+	if !btcDel.IsSuccessor() {
+		return nil
+	}
+	prevDel, err := k.GetBTCDelegation(ctx, btcDel.PrevStakingTxHash)
+	if err != nil {
+		return err
+	}
+	if prevDel.HasSameFinalityProviders(btcDel.FpBtcPkList) {
+		// an extension stays slashable by the same finality providers
+		return nil
+	}
+
+	if btcTipHeight < btcDel.StartHeight+prevDel.UnbondingTime {
+		return types.ErrInvalidRedelegation.Wrapf(
+			"the staking tx is included at height %d, the redelegation can only become active after %d blocks of unbonding time, current BTC height: %d",
+			btcDel.StartHeight, prevDel.UnbondingTime, btcTipHeight)
+	}
+	return nil
+}
+
+// ensureFpsNotSlashed returns an error if the given BTC delegation is restaked
+// to a slashed finality provider
+func (k Keeper) ensureFpsNotSlashed(ctx context.Context, btcDel *types.BTCDelegation) error {
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+		if err != nil {
+			return err
+		}
+		if fp.IsSlashed() {
+			return types.ErrFpAlreadySlashed.Wrapf(
+				"the BTC delegation %s is restaked to the slashed finality provider %s",
+				btcDel.MustGetStakingTxHash().String(), fpBTCPK.MarshalHex())
+		}
+	}
+	return nil
 }
 
 func (k Keeper) setBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) {
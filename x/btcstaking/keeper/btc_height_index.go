@@ -42,6 +42,18 @@ func (k Keeper) GetCurrentBTCHeight(ctx context.Context) uint32 {
 	return k.GetBTCHeightAtBabylonHeight(ctx, babylonHeight)
 }
 
+// getIndexedBTCHeight returns the BTC height indexed at the current Babylon
+// height. Unlike GetCurrentBTCHeight, it does not fall back to the base BTC
+// header, and returns false if the BTC height is not indexed
+func (k Keeper) getIndexedBTCHeight(ctx context.Context) (uint32, bool) {
+	babylonHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	btcHeightBytes := k.btcHeightStore(ctx).Get(sdk.Uint64ToBigEndian(babylonHeight))
+	if len(btcHeightBytes) == 0 {
+		return 0, false
+	}
+	return uint32(sdk.BigEndianToUint64(btcHeightBytes)), true
+}
+
 // btcHeightStore returns the KVStore of the BTC heights
 // prefix: BTCHeightKey
 // key: Babylon block height
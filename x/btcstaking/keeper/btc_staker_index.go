@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// indexBTCDelegationByStaker indexes the given BTC delegation by its staker's
+// BTC PK and Babylon address, so that the BTC delegations of a staker can be
+// queried without iterating over all BTC delegations
+func (k Keeper) indexBTCDelegationByStaker(ctx context.Context, btcDel *types.BTCDelegation) error {
+	stakingTxHash, err := btcDel.GetStakingTxHash()
+	if err != nil {
+		return err
+	}
+	stakerAddr, err := sdk.AccAddressFromBech32(btcDel.StakerAddr)
+	if err != nil {
+		return err
+	}
+
+	if err := k.StakerBTCPKIndex.Set(ctx, collections.Join(btcDel.BtcPk.MustMarshal(), stakingTxHash[:])); err != nil {
+		return err
+	}
+	return k.StakerAddrIndex.Set(ctx, collections.Join(stakerAddr, stakingTxHash[:]))
+}
+
+// paginateStakerBTCDelegations returns the BTC delegations under the given
+// status whose staking tx hashes are indexed under the given staker key in
+// the given staker index
+func paginateStakerBTCDelegations[K any](
+	ctx context.Context,
+	k Keeper,
+	index collections.KeySet[collections.Pair[K, []byte]],
+	stakerKey K,
+	status types.BTCDelegationStatus,
+	pagination *query.PageRequest,
+) ([]*types.BTCDelegationResponse, *query.PageResponse, error) {
+	covenantQuorum := k.GetParams(ctx).CovenantQuorum
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+
+	// the predicate needs to fetch the BTC delegation to get its status, so
+	// keep the hits for the transform to avoid fetching them twice
+	btcDels := map[string]*types.BTCDelegation{}
+	return query.CollectionFilteredPaginate(
+		ctx,
+		index,
+		pagination,
+		func(key collections.Pair[K, []byte], _ collections.NoValue) (bool, error) {
+			stakingTxHash, err := chainhash.NewHash(key.K2())
+			if err != nil {
+				return false, err
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			if btcDel == nil {
+				return false, types.ErrBTCDelegationNotFound
+			}
+			// hit if the queried status is ANY or matches the BTC delegation status
+			delStatus := btcDel.GetStatus(btcTipHeight, covenantQuorum)
+			if status != types.BTCDelegationStatus_ANY && delStatus != status {
+				return false, nil
+			}
+			btcDels[string(key.K2())] = btcDel
+			return true, nil
+		},
+		func(key collections.Pair[K, []byte], _ collections.NoValue) (*types.BTCDelegationResponse, error) {
+			btcDel := btcDels[string(key.K2())]
+			return types.NewBTCDelegationResponse(btcDel, btcDel.GetStatus(btcTipHeight, covenantQuorum)), nil
+		},
+		query.WithCollectionPaginationPairPrefix[K, []byte](stakerKey),
+	)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// GetPendingCommission returns the requested commission rate change of the
+// finality provider with the given BTC PK that does not take effect yet,
+// or nil if there is none
+func (k Keeper) GetPendingCommission(ctx context.Context, fpBTCPK []byte) (*types.CommissionChange, error) {
+	change, err := k.PendingCommission.Get(ctx, fpBTCPK)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &change, nil
+}
+
+// ScheduleCommissionChange requests a change of the commission rate of the
+// given finality provider, which takes effect after `CommissionChangeDelay`.
+// The change is validated against the commission rates of the finality
+// provider and the last requested commission rate, if any
+func (k Keeper) ScheduleCommissionChange(ctx context.Context, fp *types.FinalityProvider, newRate sdkmath.LegacyDec) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.HeaderInfo().Time
+
+	// the last requested commission rate is the one to be changed
+	curRate := *fp.Commission
+	pending, err := k.GetPendingCommission(ctx, fp.BtcPk.MustMarshal())
+	if err != nil {
+		return err
+	}
+	if pending != nil {
+		curRate = pending.Commission
+	}
+	if newRate.Equal(curRate) {
+		return nil
+	}
+
+	// finality providers registered before the commission rates were
+	// introduced are subject to the default limits
+	if fp.CommissionInfo == nil {
+		fp.CommissionInfo = types.NewCommissionInfo(types.DefaultLegacyCommissionRates(), time.Time{})
+	}
+	if err := fp.CommissionInfo.ValidateNewRate(curRate, newRate, blockTime); err != nil {
+		return err
+	}
+	fp.CommissionInfo.UpdateTime = blockTime
+	k.setFinalityProvider(ctx, fp)
+
+	change := types.NewCommissionChange(newRate, blockTime, types.CommissionChangeDelay)
+	if err := k.PendingCommission.Set(ctx, fp.BtcPk.MustMarshal(), change); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(types.NewEventFinalityProviderCommissionChangeScheduled(fp.BtcPk, &change))
+}
+
+// ApplyPendingCommissionChanges applies the requested commission rate changes
+// whose effective time has been reached, and records them in the commission
+// history. This is called upon `BeginBlock`
+func (k Keeper) ApplyPendingCommissionChanges(ctx context.Context) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).HeaderInfo().Time
+
+	// collect the changes to apply first, as the pending commission store
+	// cannot be modified while iterating over it
+	var fpBTCPKs [][]byte
+	var changes []types.CommissionChange
+	err := k.PendingCommission.Walk(ctx, nil, func(fpBTCPK []byte, change types.CommissionChange) (bool, error) {
+		if !change.EffectiveTime.After(blockTime) {
+			fpBTCPKs = append(fpBTCPKs, fpBTCPK)
+			changes = append(changes, change)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, fpBTCPK := range fpBTCPKs {
+		if err := k.applyCommissionChange(ctx, fpBTCPK, changes[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) applyCommissionChange(ctx context.Context, fpBTCPK []byte, change types.CommissionChange) error {
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	fp.Commission = &change.Commission
+	k.setFinalityProvider(ctx, fp)
+
+	if err := k.PendingCommission.Remove(ctx, fpBTCPK); err != nil {
+		return err
+	}
+	if err := k.recordCommissionChange(ctx, fp.BtcPk, change); err != nil {
+		return err
+	}
+
+	// record commission updated event so that the next `BeginBlock` will
+	// apply the new commission rate to the voting power distribution
+	powerUpdateEvent := types.NewEventPowerDistUpdateWithCommissionUpdatedFP(fp.BtcPk, fp.Commission)
+	k.addPowerDistUpdateEvent(ctx, k.GetCurrentBTCHeight(ctx), powerUpdateEvent)
+
+	return nil
+}
+
+// recordCommissionChange records a commission rate change of the finality
+// provider that took effect in the commission history
+func (k Keeper) recordCommissionChange(ctx context.Context, fpBTCPK *bbn.BIP340PubKey, change types.CommissionChange) error {
+	key := collections.Join(fpBTCPK.MustMarshal(), change.EffectiveTime.UnixNano())
+	return k.CommissionHistory.Set(ctx, key, change)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// getExpiryWarningHeight returns the expiry BTC height up to which the
+// active BTC delegations are warned about their expiry
+func (k Keeper) getExpiryWarningHeight(ctx context.Context) (uint32, error) {
+	warningHeight, err := k.ExpiryWarningHeight.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return warningHeight, err
+}
+
+// EmitExpiryWarnings emits an EventBTCDelegationExpiringSoon for each active
+// BTC delegation whose voting power expires within `expiry_warning_blocks`
+// BTC blocks of the BTC height indexed at the current Babylon height. Each
+// BTC delegation is warned at most once, as the expiry BTC heights that are
+// already covered are skipped.
+// This is called in `BeginBlocker` after `IndexBTCHeight`
+func (k Keeper) EmitExpiryWarnings(ctx context.Context) error {
+	warningBlocks := k.GetParams(ctx).ExpiryWarningBlocks
+	if warningBlocks == 0 {
+		return nil
+	}
+	btcHeight, ok := k.getIndexedBTCHeight(ctx)
+	if !ok {
+		// the BTC height is not indexed, i.e., there is no BTC tip yet
+		return nil
+	}
+
+	warnedHeight, err := k.getExpiryWarningHeight(ctx)
+	if err != nil {
+		return err
+	}
+	warningHeight := btcHeight + warningBlocks
+	if warningHeight <= warnedHeight {
+		return nil
+	}
+
+	// warn the BTC delegations expiring in (warnedHeight, warningHeight]
+	rng := new(collections.Range[collections.Pair[uint32, []byte]]).
+		StartInclusive(collections.PairPrefix[uint32, []byte](warnedHeight + 1)).
+		EndExclusive(collections.PairPrefix[uint32, []byte](warningHeight + 1))
+	iter, err := k.ActiveStakeExpiry.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, key := range keys {
+		stakingTxHash, err := chainhash.NewHash(key.K2())
+		if err != nil {
+			return err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+		}
+		// the stake of BTC delegations waiting for a covenant quorum is
+		// reserved as well, but they are only warned once they become active
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		if params == nil {
+			return types.ErrParamsNotFound.Wrapf("params version: %d", btcDel.ParamsVersion)
+		}
+		if !btcDel.HasCovenantQuorums(params.CovenantQuorum) {
+			continue
+		}
+		types.EmitExpiringSoonDelegationEvent(sdkCtx, btcDel)
+	}
+
+	return k.ExpiryWarningHeight.Set(ctx, warningHeight)
+}
+
+// warnIfExpiringSoon emits an EventBTCDelegationExpiringSoon for the given
+// newly active BTC delegation if its expiry BTC height is already covered by
+// the expiry warnings, as it would otherwise never be warned
+func (k Keeper) warnIfExpiringSoon(ctx context.Context, btcDel *types.BTCDelegation) error {
+	if k.GetParams(ctx).ExpiryWarningBlocks == 0 {
+		return nil
+	}
+	warnedHeight, err := k.getExpiryWarningHeight(ctx)
+	if err != nil {
+		return err
+	}
+	if btcDel.EndHeight-btcDel.UnbondingTime <= warnedHeight {
+		types.EmitExpiringSoonDelegationEvent(sdk.UnwrapSDKContext(ctx), btcDel)
+	}
+	return nil
+}
@@ -6,9 +6,11 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 )
 
@@ -27,21 +29,37 @@ func (k Keeper) AddFinalityProvider(goCtx context.Context, msg *types.MsgCreateF
 		return types.ErrCommissionGTMaxRate
 	}
 
+	// ensure the commission rate is within the commission rates
+	if msg.CommissionRates == nil {
+		return types.ErrInvalidCommissionRates.Wrap("empty commission rates")
+	}
+	if err := msg.CommissionRates.Validate(*msg.Commission); err != nil {
+		return err
+	}
+
 	// ensure finality provider does not already exist
 	if k.HasFinalityProvider(ctx, *msg.BtcPk) {
 		return types.ErrFpRegistered
 	}
 
 	// all good, add this finality provider
+	blockTime := ctx.HeaderInfo().Time
 	fp := types.FinalityProvider{
-		Description: msg.Description,
-		Commission:  msg.Commission,
-		Addr:        msg.Addr,
-		BtcPk:       msg.BtcPk,
-		Pop:         msg.Pop,
+		Description:    msg.Description,
+		Commission:     msg.Commission,
+		Addr:           msg.Addr,
+		BtcPk:          msg.BtcPk,
+		Pop:            msg.Pop,
+		CommissionInfo: types.NewCommissionInfo(*msg.CommissionRates, blockTime),
 	}
 	k.setFinalityProvider(ctx, &fp)
 
+	// the initial commission rate takes effect upon registration
+	initialCommission := types.NewCommissionChange(*msg.Commission, blockTime, 0)
+	if err := k.recordCommissionChange(ctx, fp.BtcPk, initialCommission); err != nil {
+		return err
+	}
+
 	// notify subscriber
 	return ctx.EventManager().EmitTypedEvent(types.NewEventFinalityProviderCreated(&fp))
 }
@@ -105,6 +123,12 @@ func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	fp.SlashedBtcHeight = btcTip.Height
 	k.setFinalityProvider(ctx, fp)
 
+	// the stake of the slashed BTC delegations no longer counts towards the
+	// stake caps
+	if err := k.releaseSlashedStake(ctx, fp.BtcPk); err != nil {
+		return err
+	}
+
 	// record slashed event. The next `BeginBlock` will consume this
 	// event for updating the finality provider set
 	powerUpdateEvent := types.NewEventPowerDistUpdateWithSlashedFP(fp.BtcPk)
@@ -179,6 +203,83 @@ func (k Keeper) UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) erro
 	return nil
 }
 
+// RetireFinalityProvider marks a finality provider with the given PK as retired
+// A retired finality provider does not accept new BTC delegations. It keeps its
+// remaining voting power until its BTC delegations expire or are unbonded, after
+// which it leaves the active set and is released from its duties
+func (k Keeper) RetireFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
+	// ensure finality provider exists
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	// ensure finality provider is not slashed yet
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed
+	}
+
+	// ensure finality provider is not retired yet
+	if fp.IsRetired() {
+		return types.ErrFpAlreadyRetired
+	}
+
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return fmt.Errorf("failed to get current BTC tip")
+	}
+
+	// set finality provider to be retired
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	fp.RetiredBabylonHeight = uint64(sdkCtx.HeaderInfo().Height)
+	k.setFinalityProvider(ctx, fp)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(types.NewEventFinalityProviderRetired(fp)); err != nil {
+		return err
+	}
+
+	// notify the delegators of the finality provider whose BTC delegations
+	// are not unbonded yet, so that they can unbond or redelegate
+	return k.notifyFinalityProviderRetired(sdkCtx, fp.BtcPk, btcTip.Height)
+}
+
+// notifyFinalityProviderRetired emits an event for each BTC delegation to the
+// given retired finality provider that is not unbonded at the given BTC height
+func (k Keeper) notifyFinalityProviderRetired(ctx sdk.Context, fpBTCPK *bbn.BIP340PubKey, btcHeight uint32) error {
+	iter := k.btcDelegatorFpStore(ctx, fpBTCPK).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var btcDelIndex types.BTCDelegatorDelegationIndex
+		k.cdc.MustUnmarshal(iter.Value(), &btcDelIndex)
+
+		for _, stakingTxHashBytes := range btcDelIndex.StakingTxHashList {
+			stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+			if err != nil {
+				// failing to unmarshal hash bytes in DB's BTC delegation index is a programming error
+				panic(err)
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+			if params == nil {
+				panic("params version in BTC delegation is not found")
+			}
+
+			status := btcDel.GetStatus(btcHeight, params.CovenantQuorum)
+			if status == types.BTCDelegationStatus_UNBONDED || status == types.BTCDelegationStatus_EXPIRED {
+				continue
+			}
+
+			ev := types.NewEventBTCDelegationFinalityProviderRetired(stakingTxHash.String(), fpBTCPK, status)
+			if err := ctx.EventManager().EmitTypedEvent(ev); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // finalityProviderStore returns the KVStore of the finality provider set
 // prefix: FinalityProviderKey
 // key: Bitcoin secp256k1 PK
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	bbn "github.com/babylonlabs-io/babylon/types"
@@ -27,6 +28,15 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 
 	for _, btcDel := range gs.BtcDelegations {
 		k.setBTCDelegation(ctx, btcDel)
+		// the staker indexes are not exported, and are rebuilt from the
+		// BTC delegations instead
+		if err := k.indexBTCDelegationByStaker(ctx, btcDel); err != nil {
+			return err
+		}
+		// neither is the active stake counted towards the stake caps
+		if err := k.initActiveStake(ctx, btcDel); err != nil {
+			return err
+		}
 	}
 
 	for _, blocks := range gs.BlockHeightChains {
@@ -48,6 +58,18 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		}
 	}
 
+	for _, entry := range gs.PendingCommissions {
+		if err := k.PendingCommission.Set(ctx, entry.FpBtcPk.MustMarshal(), entry.Change); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range gs.CommissionHistory {
+		if err := k.recordCommissionChange(ctx, entry.FpBtcPk, entry.Change); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -73,13 +95,25 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	pendingCommissions, err := k.pendingCommissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commissionHistory, err := k.commissionHistory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
-		Params:            k.GetAllParams(ctx),
-		FinalityProviders: fps,
-		BtcDelegations:    dels,
-		BlockHeightChains: k.blockHeightChains(ctx),
-		BtcDelegators:     btcDels,
-		Events:            evts,
+		Params:             k.GetAllParams(ctx),
+		FinalityProviders:  fps,
+		BtcDelegations:     dels,
+		BlockHeightChains:  k.blockHeightChains(ctx),
+		BtcDelegators:      btcDels,
+		Events:             evts,
+		PendingCommissions: pendingCommissions,
+		CommissionHistory:  commissionHistory,
 	}, nil
 }
 
@@ -159,6 +193,46 @@ func (k Keeper) btcDelegators(ctx context.Context) ([]*types.BTCDelegator, error
 	return dels, nil
 }
 
+func (k Keeper) pendingCommissions(ctx context.Context) ([]*types.CommissionChangeEntry, error) {
+	entries := make([]*types.CommissionChangeEntry, 0)
+	err := k.PendingCommission.Walk(ctx, nil, func(fpBTCPKBytes []byte, change types.CommissionChange) (bool, error) {
+		fpBTCPK, err := bbn.NewBIP340PubKey(fpBTCPKBytes)
+		if err != nil {
+			return true, err
+		}
+		entries = append(entries, &types.CommissionChangeEntry{
+			FpBtcPk: fpBTCPK,
+			Change:  change,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (k Keeper) commissionHistory(ctx context.Context) ([]*types.CommissionChangeEntry, error) {
+	entries := make([]*types.CommissionChangeEntry, 0)
+	err := k.CommissionHistory.Walk(ctx, nil, func(key collections.Pair[[]byte, int64], change types.CommissionChange) (bool, error) {
+		fpBTCPK, err := bbn.NewBIP340PubKey(key.K1())
+		if err != nil {
+			return true, err
+		}
+		entries = append(entries, &types.CommissionChangeEntry{
+			FpBtcPk: fpBTCPK,
+			Change:  change,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
 // eventIdxs sets an event into the store.
 func (k Keeper) eventIdxs(
 	ctx context.Context,
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	dbm "github.com/cosmos/cosmos-db"
@@ -133,6 +134,11 @@ func TestExportGenesis(t *testing.T) {
 		blkHeight++ // each fp increase blk height to modify data in state.
 	}
 
+	// a pending commission rate change of the first finality provider
+	pendingChange := types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(1, 1), ctx.HeaderInfo().Time, types.CommissionChangeDelay)
+	err := k.PendingCommission.Set(ctx, fps[0].BtcPk.MustMarshal(), pendingChange)
+	h.NoError(err)
+
 	gs, err := k.ExportGenesis(ctx)
 	h.NoError(err)
 	require.Equal(t, k.GetParams(ctx), *gs.Params[0])
@@ -180,5 +186,23 @@ func TestExportGenesis(t *testing.T) {
 		require.Equal(t, evt, evtIdx)
 	}
 
+	// commission changes: the initial commission of each finality provider
+	// is recorded in the history
+	require.Equal(t, []*types.CommissionChangeEntry{{FpBtcPk: fps[0].BtcPk, Change: pendingChange}}, gs.PendingCommissions)
+	require.Len(t, gs.CommissionHistory, numFps)
+	require.NoError(t, gs.Validate())
+
+	// the commission changes are imported back
+	importedK, importedCtx := testutilk.BTCStakingKeeper(t, nil, nil, nil)
+	err = importedK.InitGenesis(importedCtx, types.GenesisState{
+		PendingCommissions: gs.PendingCommissions,
+		CommissionHistory:  gs.CommissionHistory,
+	})
+	h.NoError(err)
+	importedGs, err := importedK.ExportGenesis(importedCtx)
+	h.NoError(err)
+	require.Equal(t, gs.PendingCommissions, importedGs.PendingCommissions)
+	require.Equal(t, gs.CommissionHistory, importedGs.CommissionHistory)
+
 	// TODO: vp dst cache
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -168,6 +169,197 @@ func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.Quer
 	return &types.QueryFinalityProviderDelegationsResponse{BtcDelegatorDelegations: btcDels, Pagination: pageRes}, nil
 }
 
+// BTCDelegationsByStakerBTCPK returns all BTC delegations of the given staker
+// BTC PK under a given status
+func (k Keeper) BTCDelegationsByStakerBTCPK(ctx context.Context, req *types.QueryBTCDelegationsByStakerBTCPKRequest) (*types.QueryBTCDelegationsByStakerBTCPKResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.StakerBtcPkHex) == 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "staker BTC public key cannot be empty")
+	}
+
+	stakerPK, err := bbn.NewBIP340PubKeyFromHex(req.StakerBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staker BTC PK: %v", err)
+	}
+
+	btcDels, pageRes, err := paginateStakerBTCDelegations(ctx, k, k.StakerBTCPKIndex, stakerPK.MustMarshal(), req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBTCDelegationsByStakerBTCPKResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// BTCDelegationsByStakerAddr returns all BTC delegations of the given staker
+// address under a given status
+func (k Keeper) BTCDelegationsByStakerAddr(ctx context.Context, req *types.QueryBTCDelegationsByStakerAddrRequest) (*types.QueryBTCDelegationsByStakerAddrResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakerAddr, err := sdk.AccAddressFromBech32(req.StakerAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staker address: %v", err)
+	}
+
+	btcDels, pageRes, err := paginateStakerBTCDelegations(ctx, k, k.StakerAddrIndex, stakerAddr, req.Status, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBTCDelegationsByStakerAddrResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// ValidateBTCDelegation runs the checks of MsgCreateBTCDelegation on the given
+// message against the current state, and returns every failing rule
+func (k Keeper) ValidateBTCDelegation(ctx context.Context, req *types.QueryValidateBTCDelegationRequest) (*types.QueryValidateBTCDelegationResponse, error) {
+	if req == nil || req.Msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	failures := k.validateBTCDelegation(sdk.UnwrapSDKContext(ctx), req.Msg)
+
+	return &types.QueryValidateBTCDelegationResponse{
+		Valid:    len(failures) == 0,
+		Failures: failures,
+	}, nil
+}
+
+// FinalityProviderCommissionHistory returns the commission rate changes of the
+// given finality provider
+func (k Keeper) FinalityProviderCommissionHistory(ctx context.Context, req *types.QueryFinalityProviderCommissionHistoryRequest) (*types.QueryFinalityProviderCommissionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid finality provider BTC PK: %v", err)
+	}
+	if !k.HasFinalityProvider(ctx, *fpPK) {
+		return nil, types.ErrFpNotFound
+	}
+
+	history, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.CommissionHistory,
+		req.Pagination,
+		func(_ collections.Pair[[]byte, int64], change types.CommissionChange) (types.CommissionChange, error) {
+			return change, nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, int64](fpPK.MustMarshal()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pending, err := k.GetPendingCommission(ctx, fpPK.MustMarshal())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFinalityProviderCommissionHistoryResponse{
+		History:    history,
+		Pending:    pending,
+		Pagination: pageRes,
+	}, nil
+}
+
+// StakeCapacity returns the satoshis staked in all active BTC delegations
+// and the remaining capacity under the total stake cap
+func (k Keeper) StakeCapacity(ctx context.Context, req *types.QueryStakeCapacityRequest) (*types.QueryStakeCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	capacity, err := k.totalStakeCapacity(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStakeCapacityResponse{Capacity: capacity}, nil
+}
+
+// FinalityProvidersStakeCapacity returns a paginated list of the stake
+// capacity of all finality providers
+func (k Keeper) FinalityProvidersStakeCapacity(ctx context.Context, req *types.QueryFinalityProvidersStakeCapacityRequest) (*types.QueryFinalityProvidersStakeCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	store := k.finalityProviderStore(ctx)
+
+	var fpCapacities []types.FinalityProviderStakeCapacity
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var fp types.FinalityProvider
+		if err := fp.Unmarshal(value); err != nil {
+			return err
+		}
+
+		fpCapacity, err := k.fpStakeCapacity(ctx, &fp)
+		if err != nil {
+			return err
+		}
+		fpCapacities = append(fpCapacities, fpCapacity)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	totalCapacity, err := k.totalStakeCapacity(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFinalityProvidersStakeCapacityResponse{
+		FinalityProviders: fpCapacities,
+		TotalCapacity:     totalCapacity,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// FinalityProviderStakeCapacity returns the stake capacity of the finality
+// provider with the specified finality provider BTC PK
+func (k Keeper) FinalityProviderStakeCapacity(ctx context.Context, req *types.QueryFinalityProviderStakeCapacityRequest) (*types.QueryFinalityProviderStakeCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid finality provider BTC PK: %v", err)
+	}
+	fp, err := k.GetFinalityProvider(ctx, *fpPK)
+	if err != nil {
+		return nil, err
+	}
+
+	fpCapacity, err := k.fpStakeCapacity(ctx, fp)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	totalCapacity, err := k.totalStakeCapacity(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFinalityProviderStakeCapacityResponse{
+		FinalityProvider: fpCapacity,
+		TotalCapacity:    totalCapacity,
+	}, nil
+}
+
 // BTCDelegation returns existing btc delegation by staking tx hash
 func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegationRequest) (*types.QueryBTCDelegationResponse, error) {
 	if req == nil {
@@ -195,3 +387,49 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 		BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
 	}, nil
 }
+
+// AllowedStakingTxHash checks whether the given staking tx hash is in the allow list
+func (k Keeper) AllowedStakingTxHash(ctx context.Context, req *types.QueryAllowedStakingTxHashRequest) (*types.QueryAllowedStakingTxHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHashHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid staking tx hash: %v", err)
+	}
+
+	params := k.GetParams(ctx)
+	return &types.QueryAllowedStakingTxHashResponse{
+		Allowed:          k.IsStakingTransactionAllowed(ctx, stakingTxHash),
+		AllowListEnabled: k.isAllowListEnabled(sdk.UnwrapSDKContext(ctx), &params),
+	}, nil
+}
+
+// AllowedStakingTxHashes returns all the staking tx hashes in the allow list
+func (k Keeper) AllowedStakingTxHashes(ctx context.Context, req *types.QueryAllowedStakingTxHashesRequest) (*types.QueryAllowedStakingTxHashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	txHashesHex, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.AllowedStakingTxHashesKeySet,
+		req.Pagination,
+		func(key []byte, _ collections.NoValue) (string, error) {
+			txHash, err := chainhash.NewHash(key)
+			if err != nil {
+				return "", err
+			}
+			return txHash.String(), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllowedStakingTxHashesResponse{
+		StakingTxHashesHex: txHashesHex,
+		Pagination:         pageRes,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"math/rand"
 	"testing"
@@ -376,6 +377,138 @@ func FuzzPendingBTCDelegations(f *testing.F) {
 	})
 }
 
+func FuzzBTCDelegationsByStaker(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		// Generate a finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		startHeight := uint32(datagen.RandomInt(r, 100)) + 1
+		endHeight := uint32(datagen.RandomInt(r, 1000)) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+		stakingTime := endHeight - startHeight
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+
+		// Generate a staker with a random number of BTC delegations, among
+		// which some are pending, as well as BTC delegations of other stakers
+		stakerSK, stakerPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		stakerAddr := datagen.GenRandomAccount().Address
+		numBTCDels := datagen.RandomInt(r, 10) + 1
+		stakerBtcDelsMap := make(map[string]bool)
+		pendingBtcDelsMap := make(map[string]bool)
+		for j := uint64(0); j < 2*numBTCDels; j++ {
+			delSK := stakerSK
+			isStakerDel := j < numBTCDels
+			if !isStakerDel {
+				delSK, _, err = datagen.GenRandomBTCKeyPair(r)
+				require.NoError(t, err)
+			}
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				stakingTime, startHeight, endHeight, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+			if isStakerDel {
+				btcDel.StakerAddr = stakerAddr
+				stakingTxHex := hex.EncodeToString(btcDel.StakingTx)
+				stakerBtcDelsMap[stakingTxHex] = true
+				if datagen.RandomInt(r, 2) == 1 {
+					// remove covenant sig in random BTC delegations to make them inactive
+					btcDel.CovenantSigs = nil
+					pendingBtcDelsMap[stakingTxHex] = true
+				}
+			}
+			err = keeper.AddBTCDelegation(ctx, btcDel)
+			require.NoError(t, err)
+		}
+
+		// Test nil and invalid requests
+		_, err = keeper.BTCDelegationsByStakerBTCPK(ctx, nil)
+		require.Error(t, err)
+		_, err = keeper.BTCDelegationsByStakerAddr(ctx, &types.QueryBTCDelegationsByStakerAddrRequest{StakerAddr: "invalid"})
+		require.Error(t, err)
+
+		stakerPKHex := bbn.NewBIP340PubKeyFromBTCPK(stakerPK).MarshalHex()
+		for _, tc := range []struct {
+			status   types.BTCDelegationStatus
+			expected map[string]bool
+		}{
+			{types.BTCDelegationStatus_ANY, stakerBtcDelsMap},
+			{types.BTCDelegationStatus_PENDING, pendingBtcDelsMap},
+		} {
+			// query BTC delegations of the staker page by page, by both its
+			// BTC PK and its address, and assert consistency
+			limit := datagen.RandomInt(r, int(numBTCDels)) + 1
+			btcPKReq := &types.QueryBTCDelegationsByStakerBTCPKRequest{
+				StakerBtcPkHex: stakerPKHex,
+				Status:         tc.status,
+				Pagination:     constructRequestWithLimit(r, limit),
+			}
+			addrReq := &types.QueryBTCDelegationsByStakerAddrRequest{
+				StakerAddr: stakerAddr,
+				Status:     tc.status,
+				Pagination: constructRequestWithLimit(r, limit),
+			}
+			btcPKDelsFound := make(map[string]bool)
+			addrDelsFound := make(map[string]bool)
+			for {
+				btcPKResp, err := keeper.BTCDelegationsByStakerBTCPK(ctx, btcPKReq)
+				require.NoError(t, err)
+				addrResp, err := keeper.BTCDelegationsByStakerAddr(ctx, addrReq)
+				require.NoError(t, err)
+				require.Equal(t, btcPKResp.BtcDelegations, addrResp.BtcDelegations)
+				for _, btcDel := range btcPKResp.BtcDelegations {
+					require.Equal(t, stakerPKHex, btcDel.BtcPk.MarshalHex())
+					require.Equal(t, stakerAddr, btcDel.StakerAddr)
+					require.True(t, tc.expected[btcDel.StakingTxHex])
+					btcPKDelsFound[btcDel.StakingTxHex] = true
+				}
+				for _, btcDel := range addrResp.BtcDelegations {
+					addrDelsFound[btcDel.StakingTxHex] = true
+				}
+				if btcPKResp.Pagination.NextKey == nil {
+					break
+				}
+				btcPKReq.Pagination = constructRequestWithKeyAndLimit(r, btcPKResp.Pagination.NextKey, limit)
+				addrReq.Pagination = constructRequestWithKeyAndLimit(r, addrResp.Pagination.NextKey, limit)
+			}
+			require.Len(t, btcPKDelsFound, len(tc.expected))
+			require.Len(t, addrDelsFound, len(tc.expected))
+		}
+	})
+}
+
 // Constructors for PageRequest objects
 func constructRequestWithKeyAndLimit(r *rand.Rand, key []byte, limit uint64) *query.PageRequest {
 	// If limit is 0, set one randomly
@@ -397,11 +530,12 @@ func constructRequestWithLimit(r *rand.Rand, limit uint64) *query.PageRequest {
 
 func AddFinalityProvider(t *testing.T, goCtx context.Context, k btcstakingkeeper.Keeper, fp *types.FinalityProvider) {
 	err := k.AddFinalityProvider(goCtx, &types.MsgCreateFinalityProvider{
-		Addr:        fp.Addr,
-		Description: fp.Description,
-		Commission:  fp.Commission,
-		BtcPk:       fp.BtcPk,
-		Pop:         fp.Pop,
+		Addr:            fp.Addr,
+		Description:     fp.Description,
+		Commission:      fp.Commission,
+		BtcPk:           fp.BtcPk,
+		Pop:             fp.Pop,
+		CommissionRates: fp.CommissionInfo.Rates(),
 	})
 	require.NoError(t, err)
 }
@@ -1,12 +1,13 @@
 package keeper
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/btcsuite/btcd/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	btcckpttypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+	bbn "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 )
 
@@ -41,7 +42,7 @@ func (k Keeper) VerifyInclusionProofAndGetHeight(
 	// validated by the btclightclient module
 	btcHeader := stakingTxHeader.Header.ToBlockHeader()
 
-	proofValid := btcckpttypes.VerifyInclusionProof(
+	proofValid := bbn.VerifyInclusionProof(
 		stakingTx,
 		&btcHeader.MerkleRoot,
 		inclusionProof.Proof,
@@ -73,3 +74,29 @@ func (k Keeper) VerifyInclusionProofAndGetHeight(
 		TipHeight:   btcTip.Height,
 	}, nil
 }
+
+// LowestReferencedHeaderHeight returns the height of the lowest BTC header
+// that an inclusion proof accepted by the module can still reference. A
+// staking tx is only accepted while it is at most its staking time deep, and
+// an unbonding tx can only be included after the staking tx it spends, so no
+// accepted inclusion proof references a header deeper than the max staking
+// time of any parameters version. It returns false if the BTC light client
+// has no header.
+func (k Keeper) LowestReferencedHeaderHeight(ctx context.Context) (uint32, bool) {
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if btcTip == nil {
+		return 0, false
+	}
+
+	var maxStakingTime uint32
+	for _, p := range k.GetAllParams(ctx) {
+		if p.MaxStakingTimeBlocks > maxStakingTime {
+			maxStakingTime = p.MaxStakingTimeBlocks
+		}
+	}
+
+	if btcTip.Height < maxStakingTime {
+		return 0, true
+	}
+	return btcTip.Height - maxStakingTime, true
+}
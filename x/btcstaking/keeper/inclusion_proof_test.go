@@ -5,14 +5,23 @@ import (
 	"math/rand"
 	"testing"
 
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	testutil "github.com/babylonlabs-io/babylon/testutil/btcstaking-helper"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	btcstakingkeeper "github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 )
 
@@ -187,3 +196,90 @@ func FuzzVerifyInclusionProofAndGetHeight(f *testing.F) {
 		})
 	})
 }
+
+func FuzzVerifyInclusionProofAfterPruningHeaders(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 3)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// BTC light client and BTC staking keepers sharing the same store
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		btclcKeeper, _, _ := keepertest.BTCLightClientKeeperWithStore(t, db, stateStore, btclctypes.DefaultParams(), &keepertest.MockIncentiveKeeper{})
+		bsKeeper, ctx := keepertest.BTCStakingKeeperWithStore(t, db, stateStore, btclcKeeper, nil, nil)
+		btclcKeeper.SetBtcStakingKeeper(bsKeeper)
+
+		btclcParams := btclctypes.DefaultParams()
+		btclcParams.PruningDepth = btclctypes.MinPruningDepth
+		require.NoError(t, btclcKeeper.SetParams(ctx, btclcParams))
+
+		// simnet genesis as base header, followed by a block including the
+		// staking tx and enough headers to prune one retarget period
+		retargetPeriod := uint32(btclctypes.BlocksPerRetarget(&chaincfg.SimNetParams))
+		genesisHeader := chaincfg.SimNetParams.GenesisBlock.Header
+		genesisHeaderBytes := bbntypes.NewBTCHeaderBytesFromBlockHeader(&genesisHeader)
+		genesisHash := bbntypes.NewBTCHeaderHashBytesFromChainhash(chaincfg.SimNetParams.GenesisHash)
+		genesisWork := sdkmath.NewUint(0)
+		btclcKeeper.SetBaseBTCHeader(ctx, *btclctypes.NewBTCHeaderInfo(&genesisHeaderBytes, &genesisHash, 0, &genesisWork))
+
+		msgTx := datagen.CreateDummyTx()
+		blockWithProof := datagen.CreateBlockWithTransaction(r, &genesisHeader, msgTx)
+		headers := []*wire.BlockHeader{blockWithProof.HeaderBytes.ToBlockHeader()}
+		lastRetargetHeader := &genesisHeader
+		chainLength := 2*retargetPeriod + uint32(datagen.RandomInt(r, 100))
+		for height := uint32(2); height <= chainLength; height++ {
+			var rt *datagen.RetargetInfo
+			if height%retargetPeriod == 0 {
+				rt = &datagen.RetargetInfo{LastRetargetHeader: lastRetargetHeader, Params: &chaincfg.SimNetParams}
+			}
+			header := datagen.GenRandomBtcdValidHeader(r, headers[len(headers)-1], nil, rt)
+			if rt != nil {
+				lastRetargetHeader = header
+			}
+			headers = append(headers, header)
+		}
+		err := btclcKeeper.InsertHeadersWithHookAndEvents(ctx, keepertest.NewBTCHeaderBytesList(headers))
+		require.NoError(t, err)
+
+		// the staking time is long enough for the staking tx to be accepted
+		// at the current BTC tip
+		params := types.DefaultParams()
+		stakingTime := chainLength + params.UnbondingTimeBlocks + 1
+		params.MaxStakingTimeBlocks = stakingTime
+		require.NoError(t, bsKeeper.SetParams(ctx, params))
+
+		proof := &types.ParsedProofOfInclusion{
+			HeaderHash: blockWithProof.HeaderBytes.Hash(),
+			Proof:      blockWithProof.SpvProof.MerkleNodes,
+			Index:      blockWithProof.SpvProof.BtcTransactionIndex,
+		}
+		verify := func() (*btcstakingkeeper.DelegationTimeRangeInfo, error) {
+			return bsKeeper.VerifyInclusionProofAndGetHeight(
+				ctx,
+				btcutil.NewTx(msgTx),
+				6,
+				stakingTime,
+				params.UnbondingTimeBlocks,
+				proof,
+			)
+		}
+
+		// the headers within the max staking time are not pruned, so the
+		// inclusion proof of the staking tx still verifies
+		btclcKeeper.PruneHeaders(ctx)
+		require.Equal(t, uint32(0), btclcKeeper.GetBaseBTCHeader(ctx).Height)
+		timeRange, err := verify()
+		require.NoError(t, err)
+		require.Equal(t, uint32(1), timeRange.StartHeight)
+
+		// once the first retarget period is deeper than the max staking time,
+		// it is pruned and the inclusion proof can no longer be verified
+		params.MaxStakingTimeBlocks = params.MinStakingTimeBlocks
+		require.NoError(t, bsKeeper.OverwriteParamsAtVersion(ctx, 0, params))
+		btclcKeeper.PruneHeaders(ctx)
+		require.Equal(t, retargetPeriod, btclcKeeper.GetBaseBTCHeader(ctx).Height)
+		_, err = verify()
+		require.ErrorContains(t, err, "is not found in BTC light client state")
+	})
+}
@@ -26,6 +26,30 @@ type (
 
 		Schema                       collections.Schema
 		AllowedStakingTxHashesKeySet collections.KeySet[[]byte]
+		// PendingCommission maps the finality provider's BTC PK to its
+		// requested commission rate change that does not take effect yet
+		PendingCommission collections.Map[[]byte, types.CommissionChange]
+		// CommissionHistory maps the finality provider's BTC PK and the
+		// effective time (in Unix nanoseconds) to a commission rate change
+		CommissionHistory collections.Map[collections.Pair[[]byte, int64], types.CommissionChange]
+		// StakerBTCPKIndex indexes the staking tx hashes of BTC delegations
+		// by the staker's BTC PK
+		StakerBTCPKIndex collections.KeySet[collections.Pair[[]byte, []byte]]
+		// StakerAddrIndex indexes the staking tx hashes of BTC delegations
+		// by the staker's Babylon address
+		StakerAddrIndex collections.KeySet[collections.Pair[sdk.AccAddress, []byte]]
+		// TotalStakedSat is the satoshis staked in all active BTC delegations
+		TotalStakedSat collections.Item[uint64]
+		// FpStakedSat maps the finality provider's BTC PK to the satoshis
+		// staked in the active BTC delegations restaked to it
+		FpStakedSat collections.Map[[]byte, uint64]
+		// ActiveStakeExpiry indexes the staking tx hashes of the active BTC
+		// delegations counted in the staked satoshis by the BTC height at
+		// which they expire
+		ActiveStakeExpiry collections.KeySet[collections.Pair[uint32, []byte]]
+		// ExpiryWarningHeight is the expiry BTC height up to which the active
+		// BTC delegations are warned about their expiry
+		ExpiryWarningHeight collections.Item[uint32]
 
 		btcNet *chaincfg.Params
 		// the address capable of executing a MsgUpdateParams or
@@ -62,6 +86,63 @@ func NewKeeper(
 			"allowed_staking_tx_hashes_key_set",
 			collections.BytesKey,
 		),
+		PendingCommission: collections.NewMap(
+			sb,
+			types.PendingCommissionKey,
+			"pending_commission",
+			// key: FpBtcPk
+			collections.BytesKey,
+			codec.CollValue[types.CommissionChange](cdc),
+		),
+		CommissionHistory: collections.NewMap(
+			sb,
+			types.CommissionHistoryKey,
+			"commission_history",
+			// keys: (FpBtcPk, effective time)
+			collections.PairKeyCodec(collections.BytesKey, collections.Int64Key),
+			codec.CollValue[types.CommissionChange](cdc),
+		),
+		StakerBTCPKIndex: collections.NewKeySet(
+			sb,
+			types.StakerBTCPKIndexKey,
+			"staker_btc_pk_index",
+			// keys: (staker BTC PK, staking tx hash)
+			collections.PairKeyCodec(collections.BytesKey, collections.BytesKey),
+		),
+		StakerAddrIndex: collections.NewKeySet(
+			sb,
+			types.StakerAddrIndexKey,
+			"staker_addr_index",
+			// keys: (staker address, staking tx hash)
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.BytesKey),
+		),
+		TotalStakedSat: collections.NewItem(
+			sb,
+			types.TotalStakedSatKey,
+			"total_staked_sat",
+			collections.Uint64Value,
+		),
+		FpStakedSat: collections.NewMap(
+			sb,
+			types.FpStakedSatKey,
+			"fp_staked_sat",
+			// key: FpBtcPk
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
+		ActiveStakeExpiry: collections.NewKeySet(
+			sb,
+			types.ActiveStakeExpiryKey,
+			"active_stake_expiry",
+			// keys: (expiry BTC height, staking tx hash)
+			collections.PairKeyCodec(collections.Uint32Key, collections.BytesKey),
+		),
+		ExpiryWarningHeight: collections.NewItem(
+			sb,
+			types.ExpiryWarningHeightKey,
+			"expiry_warning_height",
+			collections.Uint32Value,
+		),
 		btcNet:    btcNet,
 		authority: authority,
 	}
@@ -88,5 +169,16 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	// index BTC height at the current height
 	k.IndexBTCHeight(ctx)
 
-	return nil
+	// release the stake of the BTC delegations that expire
+	if err := k.ReleaseExpiredStake(ctx); err != nil {
+		return err
+	}
+
+	// warn the BTC delegations that expire soon
+	if err := k.EmitExpiryWarnings(ctx); err != nil {
+		return err
+	}
+
+	// apply the commission rate changes that take effect
+	return k.ApplyPendingCommissionChanges(ctx)
 }
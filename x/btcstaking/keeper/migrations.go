@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the btcstaking module state from consensus version 1
+// to 2. Since version 2, BTC delegations are indexed by their staker's BTC PK
+// and Babylon address upon creation, so the existing BTC delegations are
+// indexed.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	btcDels, err := m.keeper.btcDelegations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, btcDel := range btcDels {
+		if err := m.keeper.indexBTCDelegationByStaker(ctx, btcDel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate2to3 migrates the btcstaking module state from consensus version 2
+// to 3. Since version 3, the stake of the active BTC delegations, and of the
+// ones with inclusion proof waiting for a covenant quorum, is counted towards
+// the stake caps and indexed by their expiry BTC height, so the stake
+// caps state and the active stake expiry index are rebuilt from the existing
+// BTC delegations. This way, the existing BTC delegations are also warned about
+// their expiry. The stake of the ones that already expired is released in the
+// next `BeginBlocker`.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := m.keeper.TotalStakedSat.Remove(ctx); err != nil {
+		return err
+	}
+	if err := m.keeper.FpStakedSat.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := m.keeper.ActiveStakeExpiry.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	btcDels, err := m.keeper.btcDelegations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, btcDel := range btcDels {
+		if err := m.keeper.initActiveStake(ctx, btcDel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Migrate3to4 migrates the btcstaking module state from consensus version 3
+// to 4. Since version 4, the commission rate changes of all finality providers
+// are limited, so the finality providers registered before the commission
+// rates were introduced are assigned the default legacy commission rates.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	fps, err := m.keeper.finalityProviders(ctx)
+	if err != nil {
+		return err
+	}
+	for _, fp := range fps {
+		if fp.CommissionInfo != nil {
+			continue
+		}
+		fp.CommissionInfo = types.NewCommissionInfo(types.DefaultLegacyCommissionRates(), time.Time{})
+		m.keeper.setFinalityProvider(ctx, fp)
+	}
+	return nil
+}
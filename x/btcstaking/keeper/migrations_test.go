@@ -0,0 +1,298 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/txscript"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	btcstakingkeeper "github.com/babylonlabs-io/babylon/x/btcstaking/keeper"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// addStakerBTCDelegations inserts a random number of BTC delegations of the
+// given staker to the given finality provider, among which some are pending,
+// and returns them
+func addStakerBTCDelegations(
+	r *rand.Rand,
+	t *testing.T,
+	keeper *btcstakingkeeper.Keeper,
+	ctx sdk.Context,
+	fp *types.FinalityProvider,
+	stakerSK *btcec.PrivateKey,
+	stakerAddr string,
+	startHeight, endHeight uint32,
+) []*types.BTCDelegation {
+	covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+	slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+	require.NoError(t, err)
+	slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+	require.NoError(t, err)
+	slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+	numBTCDels := int(datagen.RandomInt(r, 10) + 1)
+	btcDels := make([]*types.BTCDelegation, 0, numBTCDels)
+	for i := 0; i < numBTCDels; i++ {
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			[]bbn.BIP340PubKey{*fp.BtcPk},
+			stakerSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingPkScript,
+			endHeight-startHeight, startHeight, endHeight, 10000,
+			slashingRate,
+			uint16(101),
+		)
+		require.NoError(t, err)
+		btcDel.StakerAddr = stakerAddr
+		if datagen.OneInN(r, 2) {
+			// remove covenant sigs in random BTC delegations to keep them pending
+			btcDel.CovenantSigs = nil
+		}
+		err = keeper.AddBTCDelegation(ctx, btcDel)
+		require.NoError(t, err)
+		btcDels = append(btcDels, btcDel)
+	}
+	return btcDels
+}
+
+func FuzzMigrate1to2(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		startHeight := uint32(datagen.RandomInt(r, 100)) + 1
+		endHeight := uint32(datagen.RandomInt(r, 1000)) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+
+		// generate BTC delegations of a staker
+		stakerSK, stakerPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		stakerAddr := datagen.GenRandomAccount().Address
+		btcDels := addStakerBTCDelegations(r, t, keeper, ctx, fp, stakerSK, stakerAddr, startHeight, endHeight)
+
+		// wipe the staker indexes, as in the state before version 2
+		err = keeper.StakerBTCPKIndex.Clear(ctx, nil)
+		require.NoError(t, err)
+		err = keeper.StakerAddrIndex.Clear(ctx, nil)
+		require.NoError(t, err)
+		addrReq := &types.QueryBTCDelegationsByStakerAddrRequest{StakerAddr: stakerAddr, Status: types.BTCDelegationStatus_ANY}
+		resp, err := keeper.BTCDelegationsByStakerAddr(ctx, addrReq)
+		require.NoError(t, err)
+		require.Empty(t, resp.BtcDelegations)
+
+		// the migration indexes all existing BTC delegations
+		err = btcstakingkeeper.NewMigrator(*keeper).Migrate1to2(ctx)
+		require.NoError(t, err)
+		resp, err = keeper.BTCDelegationsByStakerAddr(ctx, addrReq)
+		require.NoError(t, err)
+		require.Len(t, resp.BtcDelegations, len(btcDels))
+		btcPKResp, err := keeper.BTCDelegationsByStakerBTCPK(ctx, &types.QueryBTCDelegationsByStakerBTCPKRequest{
+			StakerBtcPkHex: bbn.NewBIP340PubKeyFromBTCPK(stakerPK).MarshalHex(),
+			Status:         types.BTCDelegationStatus_ANY,
+		})
+		require.NoError(t, err)
+		require.Equal(t, resp.BtcDelegations, btcPKResp.BtcDelegations)
+	})
+}
+
+func FuzzMigrate2to3(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		startHeight := uint32(datagen.RandomInt(r, 100)) + 1
+		endHeight := uint32(datagen.RandomInt(r, 1000)) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+
+		// generate BTC delegations, whose stake is not counted as in the
+		// state before version 3
+		stakerSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDels := addStakerBTCDelegations(r, t, keeper, ctx, fp, stakerSK, datagen.GenRandomAccount().Address, startHeight, endHeight)
+		// the stake of the BTC delegations with inclusion proof is counted,
+		// either as active or as reserved until a covenant quorum
+		expectedStakedSat, expectedActiveSat := uint64(0), uint64(0)
+		covenantQuorum := keeper.GetParams(ctx).CovenantQuorum
+		for _, btcDel := range btcDels {
+			expectedStakedSat += btcDel.TotalSat
+			if btcDel.HasCovenantQuorums(covenantQuorum) {
+				expectedActiveSat += btcDel.TotalSat
+			}
+		}
+		capacity, err := keeper.StakeCapacity(ctx, &types.QueryStakeCapacityRequest{})
+		require.NoError(t, err)
+		require.Zero(t, capacity.Capacity.StakedSat)
+
+		// the migration counts the stake of all active and pending BTC
+		// delegations with inclusion proof
+		err = btcstakingkeeper.NewMigrator(*keeper).Migrate2to3(ctx)
+		require.NoError(t, err)
+		fpCapacity, err := keeper.FinalityProviderStakeCapacity(ctx, &types.QueryFinalityProviderStakeCapacityRequest{
+			FpBtcPkHex: fp.BtcPk.MarshalHex(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, expectedStakedSat, fpCapacity.TotalCapacity.StakedSat)
+		require.Equal(t, expectedStakedSat, fpCapacity.FinalityProvider.Capacity.StakedSat)
+
+		// the migration is idempotent
+		err = btcstakingkeeper.NewMigrator(*keeper).Migrate2to3(ctx)
+		require.NoError(t, err)
+		capacity, err = keeper.StakeCapacity(ctx, &types.QueryStakeCapacityRequest{})
+		require.NoError(t, err)
+		require.Equal(t, expectedStakedSat, capacity.Capacity.StakedSat)
+
+		// the active BTC delegations that existed before the migration are
+		// warned about their expiry, while the pending ones are not
+		stakedParams := keeper.GetParamsWithVersion(ctx)
+		stakedParams.Params.ExpiryWarningBlocks = endHeight
+		err = keeper.OverwriteParamsAtVersion(ctx, stakedParams.Version, stakedParams.Params)
+		require.NoError(t, err)
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight})
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		keeper.IndexBTCHeight(ctx)
+		err = keeper.EmitExpiryWarnings(ctx)
+		require.NoError(t, err)
+		warned := uint64(0)
+		for _, ev := range ctx.EventManager().Events() {
+			if ev.Type == "babylon.btcstaking.v1.EventBTCDelegationExpiringSoon" {
+				typedEv, err := sdk.ParseTypedEvent(abci.Event(ev))
+				require.NoError(t, err)
+				totalSat, err := strconv.ParseUint(typedEv.(*types.EventBTCDelegationExpiringSoon).TotalSat, 10, 64)
+				require.NoError(t, err)
+				warned += totalSat
+			}
+		}
+		require.Equal(t, expectedActiveSat, warned)
+
+		// the stake is released once the BTC delegations expire
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: endHeight}).AnyTimes()
+		keeper.IndexBTCHeight(ctx)
+		err = keeper.ReleaseExpiredStake(ctx)
+		require.NoError(t, err)
+		capacity, err = keeper.StakeCapacity(ctx, &types.QueryStakeCapacityRequest{})
+		require.NoError(t, err)
+		require.Zero(t, capacity.Capacity.StakedSat)
+	})
+}
+
+func FuzzMigrate3to4(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+
+		// generate finality providers, among which some are registered
+		// before the commission rates were introduced
+		numFps := int(datagen.RandomInt(r, 10) + 1)
+		fps := make([]*types.FinalityProvider, 0, numFps)
+		legacy := make(map[string]bool, numFps)
+		for i := 0; i < numFps; i++ {
+			fp, err := datagen.GenRandomFinalityProvider(r)
+			require.NoError(t, err)
+			AddFinalityProvider(t, ctx, *keeper, fp)
+			if datagen.OneInN(r, 2) {
+				fp, err = keeper.GetFinalityProvider(ctx, *fp.BtcPk)
+				require.NoError(t, err)
+				fp.CommissionInfo = nil
+				err = keeper.UpdateFinalityProvider(ctx, fp)
+				require.NoError(t, err)
+				legacy[fp.BtcPk.MarshalHex()] = true
+			}
+			fps = append(fps, fp)
+		}
+
+		// the migration assigns the default commission rates to the legacy
+		// finality providers only
+		err := btcstakingkeeper.NewMigrator(*keeper).Migrate3to4(ctx)
+		require.NoError(t, err)
+		defaultRates := types.DefaultLegacyCommissionRates()
+		for _, fp := range fps {
+			migratedFp, err := keeper.GetFinalityProvider(ctx, *fp.BtcPk)
+			require.NoError(t, err)
+			require.NotNil(t, migratedFp.CommissionInfo)
+			if legacy[fp.BtcPk.MarshalHex()] {
+				require.Equal(t, defaultRates, *migratedFp.CommissionInfo.Rates())
+			} else {
+				require.Equal(t, fp.CommissionInfo, migratedFp.CommissionInfo)
+			}
+		}
+	})
+}
+
+func TestScheduleCommissionChangeOfLegacyFinalityProvider(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	keeper, ctx := testkeeper.BTCStakingKeeper(t, nil, nil, nil)
+	ctx = ctx.WithHeaderInfo(header.Info{Time: time.Now().UTC()})
+
+	// a finality provider registered before the commission rates were
+	// introduced, and not migrated yet
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	AddFinalityProvider(t, ctx, *keeper, fp)
+	fp, err = keeper.GetFinalityProvider(ctx, *fp.BtcPk)
+	require.NoError(t, err)
+	fp.CommissionInfo = nil
+	err = keeper.UpdateFinalityProvider(ctx, fp)
+	require.NoError(t, err)
+
+	// the commission rate cannot change by more than the default max change
+	// rate
+	maxChangeRate := types.DefaultLegacyCommissionRates().MaxChangeRate
+	tooHighChange := fp.Commission.Add(maxChangeRate).Add(sdkmath.LegacyNewDecWithPrec(1, 3))
+	err = keeper.ScheduleCommissionChange(ctx, fp, tooHighChange)
+	require.ErrorIs(t, err, types.ErrCommissionGTMaxChangeRate)
+
+	// a change within the default max change rate is scheduled, and the
+	// finality provider is subject to the update interval afterwards
+	fp, err = keeper.GetFinalityProvider(ctx, *fp.BtcPk)
+	require.NoError(t, err)
+	newRate := fp.Commission.Add(maxChangeRate)
+	err = keeper.ScheduleCommissionChange(ctx, fp, newRate)
+	require.NoError(t, err)
+	fp, err = keeper.GetFinalityProvider(ctx, *fp.BtcPk)
+	require.NoError(t, err)
+	require.NotNil(t, fp.CommissionInfo)
+	err = keeper.ScheduleCommissionChange(ctx, fp, newRate.Add(maxChangeRate))
+	require.ErrorIs(t, err, types.ErrCommissionUpdateTooSoon)
+}
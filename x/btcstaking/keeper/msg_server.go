@@ -1,18 +1,18 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
 	"time"
 
-	btcckpttypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
-
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -63,6 +63,60 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// AddAllowedStakingTxHashes adds the given staking tx hashes to the allow list
+func (ms msgServer) AddAllowedStakingTxHashes(goCtx context.Context, req *types.MsgAddAllowedStakingTxHashes) (*types.MsgAddAllowedStakingTxHashesResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	txHashes, err := types.ParseStakingTxHashesHex(req.StakingTxHashesHex)
+	if err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	added := make([]string, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		if ms.IsStakingTransactionAllowed(ctx, txHash) {
+			continue
+		}
+		ms.IndexAllowedStakingTransaction(ctx, txHash)
+		added = append(added, txHash.String())
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAllowedStakingTxHashesAdded{StakingTxHashes: added}); err != nil {
+		panic(fmt.Errorf("failed to emit EventAllowedStakingTxHashesAdded event: %w", err))
+	}
+
+	return &types.MsgAddAllowedStakingTxHashesResponse{}, nil
+}
+
+// RemoveAllowedStakingTxHashes removes the given staking tx hashes from the allow list
+func (ms msgServer) RemoveAllowedStakingTxHashes(goCtx context.Context, req *types.MsgRemoveAllowedStakingTxHashes) (*types.MsgRemoveAllowedStakingTxHashesResponse, error) {
+	if ms.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+	txHashes, err := types.ParseStakingTxHashesHex(req.StakingTxHashesHex)
+	if err != nil {
+		return nil, govtypes.ErrInvalidProposalMsg.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	removed := make([]string, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		if !ms.IsStakingTransactionAllowed(ctx, txHash) {
+			continue
+		}
+		ms.RemoveAllowedStakingTransaction(ctx, txHash)
+		removed = append(removed, txHash.String())
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAllowedStakingTxHashesRemoved{StakingTxHashes: removed}); err != nil {
+		panic(fmt.Errorf("failed to emit EventAllowedStakingTxHashesRemoved event: %w", err))
+	}
+
+	return &types.MsgRemoveAllowedStakingTxHashesResponse{}, nil
+}
+
 // CreateFinalityProvider creates a finality provider
 func (ms msgServer) CreateFinalityProvider(goCtx context.Context, req *types.MsgCreateFinalityProvider) (*types.MsgCreateFinalityProviderResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyCreateFinalityProvider)
@@ -127,9 +181,13 @@ func (ms msgServer) EditFinalityProvider(goCtx context.Context, req *types.MsgEd
 
 	// all good, update the finality provider and set back
 	fp.Description = req.Description
-	fp.Commission = req.Commission
 	ms.setFinalityProvider(goCtx, fp)
 
+	// the change of the commission rate takes effect after a delay
+	if err := ms.ScheduleCommissionChange(goCtx, fp, *req.Commission); err != nil {
+		return nil, err
+	}
+
 	// notify subscriber
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := ctx.EventManager().EmitTypedEvent(types.NewEventFinalityProviderEdited(fp)); err != nil {
@@ -139,14 +197,36 @@ func (ms msgServer) EditFinalityProvider(goCtx context.Context, req *types.MsgEd
 	return &types.MsgEditFinalityProviderResponse{}, nil
 }
 
-// isAllowListEnabled checks if the allow list is enabled at the given height
-// allow list is enabled if AllowListExpirationHeight is larger than 0,
-// and current block height is less than AllowListExpirationHeight
-func (ms msgServer) isAllowListEnabled(ctx sdk.Context, p *types.Params) bool {
-	return p.AllowListExpirationHeight > 0 && uint64(ctx.BlockHeight()) < p.AllowListExpirationHeight
+// RetireFinalityProvider announces the retirement of an existing finality provider
+func (ms msgServer) RetireFinalityProvider(goCtx context.Context, req *types.MsgRetireFinalityProvider) (*types.MsgRetireFinalityProviderResponse, error) {
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	fp, err := ms.GetFinalityProvider(goCtx, req.BtcPk)
+	if err != nil {
+		return nil, err
+	}
+
+	fpAddr, err := sdk.AccAddressFromBech32(req.Addr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %v", req.Addr, err)
+	}
+
+	// ensure the signer corresponds to the finality provider's Babylon address
+	if !strings.EqualFold(fpAddr.String(), fp.Addr) {
+		return nil, status.Errorf(codes.PermissionDenied, "the signer does not correspond to the finality provider's Babylon address")
+	}
+
+	if err := ms.Keeper.RetireFinalityProvider(goCtx, req.BtcPk); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRetireFinalityProviderResponse{}, nil
 }
 
-func (ms msgServer) getTimeInfoAndParams(
+func (k Keeper) getTimeInfoAndParams(
 	ctx sdk.Context,
 	parsedMsg *types.ParsedCreateDelegationMessage,
 ) (*DelegationTimeRangeInfo, *types.Params, uint32, error) {
@@ -154,9 +234,12 @@ func (ms msgServer) getTimeInfoAndParams(
 		// staking tx is already included on BTC
 		// 1. Validate inclusion proof and retrieve inclusion height
 		// 2. Get params for the validated inclusion height
-		btccParams := ms.btccKeeper.GetParams(ctx)
+		// NOTE: the current BTC confirmation depth is used rather than the one
+		// recorded for any epoch, as the inclusion proof is only verified once,
+		// upon this message, so that param changes cannot apply retroactively
+		btccParams := k.btccKeeper.GetParams(ctx)
 
-		timeInfo, err := ms.VerifyInclusionProofAndGetHeight(
+		timeInfo, err := k.VerifyInclusionProofAndGetHeight(
 			ctx,
 			btcutil.NewTx(parsedMsg.StakingTx.Transaction),
 			btccParams.BtcConfirmationDepth,
@@ -169,7 +252,7 @@ func (ms msgServer) getTimeInfoAndParams(
 			return nil, nil, 0, fmt.Errorf("invalid inclusion proof: %w", err)
 		}
 
-		paramsByHeight, version, err := ms.GetParamsForBtcHeight(ctx, uint64(timeInfo.StartHeight))
+		paramsByHeight, version, err := k.GetParamsForBtcHeight(ctx, uint64(timeInfo.StartHeight))
 		if err != nil {
 			// this error can happen if we receive delegations which is included before
 			// first activation height we support
@@ -180,9 +263,9 @@ func (ms msgServer) getTimeInfoAndParams(
 	}
 	// staking tx is not included on BTC, retrieve params for the current tip height
 	// and return info about the tip
-	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
 
-	paramsByHeight, version, err := ms.GetParamsForBtcHeight(ctx, uint64(btcTip.Height))
+	paramsByHeight, version, err := k.GetParamsForBtcHeight(ctx, uint64(btcTip.Height))
 	if err != nil {
 		return nil, nil, 0, err
 	}
@@ -213,55 +296,84 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		return nil, types.ErrInvalidProofOfPossession.Wrap(err.Error())
 	}
 
-	// 3. Check if it is not duplicated staking tx
+	// 3. Verify the delegation against the current state and the params,
+	// and construct the BTC delegation
+	newBTCDel, params, err := ms.verifyAndBuildBTCDelegation(ctx, parsedMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. if allow list is enabled we need to check whether staking transactions hash
+	// is in the allow list
 	stakingTxHash := parsedMsg.StakingTx.Transaction.TxHash()
-	delegation := ms.getBTCDelegation(ctx, stakingTxHash)
-	if delegation != nil {
-		return nil, types.ErrReusedStakingTx.Wrapf("duplicated tx hash: %s", stakingTxHash.String())
+	if ms.isAllowListEnabled(ctx, params) {
+		if !ms.IsStakingTransactionAllowed(ctx, &stakingTxHash) {
+			return nil, types.ErrInvalidStakingTx.Wrapf("staking tx hash: %s, is not in the allow list", stakingTxHash.String())
+		}
 	}
 
-	// 4. Check finality providers to which message delegate
-	// Ensure all finality providers are known to Babylon, are not slashed
-	for _, fpBTCPK := range parsedMsg.FinalityProviderKeys.PublicKeysBbnFormat {
-		// get this finality provider
-		fp, err := ms.GetFinalityProvider(ctx, fpBTCPK)
-		if err != nil {
+	// 5. if the staking tx is included on BTC, the BTC delegation becomes
+	// active once it receives a covenant quorum, so it must be within the
+	// stake caps already, and its stake is reserved upon creation
+	if parsedMsg.IsIncludedOnBTC() {
+		if err := ms.ensureWithinStakeCaps(ctx, newBTCDel); err != nil {
 			return nil, err
 		}
-		// ensure the finality provider is not slashed
-		if fp.IsSlashed() {
-			return nil, types.ErrFpAlreadySlashed.Wrapf("finality key: %s", fpBTCPK.MarshalHex())
-		}
 	}
 
-	// 5. Get params for the validated inclusion height either tip or inclusion height
-	timeInfo, params, paramsVersion, err := ms.getTimeInfoAndParams(ctx, parsedMsg)
-	if err != nil {
+	// everything is good, if the staking tx is not included on BTC consume additinal
+	// gas
+	if !parsedMsg.IsIncludedOnBTC() {
+		ctx.GasMeter().ConsumeGas(params.DelegationCreationBaseGasFee, "delegation creation fee")
+	}
+
+	// add this BTC delegation, and emit corresponding events
+	if err := ms.AddBTCDelegation(ctx, newBTCDel); err != nil {
+		panic(fmt.Errorf("failed to add BTC delegation that has passed verification: %w", err))
+	}
+	if err := ms.reserveStake(ctx, newBTCDel); err != nil {
 		return nil, err
 	}
 
-	// 6. Validate the staking tx against the params
-	paramsValidationResult, err := types.ValidateParsedMessageAgainstTheParams(parsedMsg, params, ms.btcNet)
+	return &types.MsgCreateBTCDelegationResponse{}, nil
+}
 
-	if err != nil {
-		return nil, err
+// verifyAndBuildBTCDelegation verifies the parsed delegation message against
+// the current state and the params, and constructs the BTC delegation to be
+// inserted, together with the params it is validated against
+func (ms msgServer) verifyAndBuildBTCDelegation(
+	ctx sdk.Context,
+	parsedMsg *types.ParsedCreateDelegationMessage,
+) (*types.BTCDelegation, *types.Params, error) {
+	// 1. Check if it is not duplicated staking tx
+	stakingTxHash := parsedMsg.StakingTx.Transaction.TxHash()
+	delegation := ms.getBTCDelegation(ctx, stakingTxHash)
+	if delegation != nil {
+		return nil, nil, types.ErrReusedStakingTx.Wrapf("duplicated tx hash: %s", stakingTxHash.String())
 	}
 
-	// 7. if allow list is enabled we need to check whether staking transactions hash
-	// is in the allow list
-	if ms.isAllowListEnabled(ctx, params) {
-		if !ms.IsStakingTransactionAllowed(ctx, &stakingTxHash) {
-			return nil, types.ErrInvalidStakingTx.Wrapf("staking tx hash: %s, is not in the allow list", stakingTxHash.String())
+	// 2. Check finality providers to which message delegate
+	// Ensure all finality providers are known to Babylon, are not slashed
+	// and are not retired
+	for _, fpBTCPK := range parsedMsg.FinalityProviderKeys.PublicKeysBbnFormat {
+		if err := ms.ensureFpAcceptsDelegation(ctx, fpBTCPK); err != nil {
+			return nil, nil, err
 		}
 	}
 
-	// everything is good, if the staking tx is not included on BTC consume additinal
-	// gas
-	if !parsedMsg.IsIncludedOnBTC() {
-		ctx.GasMeter().ConsumeGas(params.DelegationCreationBaseGasFee, "delegation creation fee")
+	// 3. Get params for the validated inclusion height either tip or inclusion height
+	timeInfo, params, paramsVersion, err := ms.getTimeInfoAndParams(ctx, parsedMsg)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// 7.all good, construct BTCDelegation and insert BTC delegation
+	// 4. Validate the staking tx against the params
+	paramsValidationResult, err := types.ValidateParsedMessageAgainstTheParams(parsedMsg, params, ms.btcNet)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 5. all good, construct BTCDelegation
 	// NOTE: the BTC delegation does not have voting power yet. It will
 	// have voting power only when it receives a covenant signatures
 	newBTCDel := &types.BTCDelegation{
@@ -291,12 +403,274 @@ func (ms msgServer) CreateBTCDelegation(goCtx context.Context, req *types.MsgCre
 		BtcTipHeight:  timeInfo.TipHeight, // height of the BTC light client tip at the time of the delegation creation
 	}
 
+	return newBTCDel, params, nil
+}
+
+// ensureFpAcceptsDelegation returns an error if the given finality provider
+// is not known to Babylon, is slashed or is retired
+func (k Keeper) ensureFpAcceptsDelegation(ctx context.Context, fpBTCPK bbn.BIP340PubKey) error {
+	fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+	if err != nil {
+		return err
+	}
+	// ensure the finality provider is not slashed
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed.Wrapf("finality key: %s", fpBTCPK.MarshalHex())
+	}
+	// ensure the finality provider does not retire
+	if fp.IsRetired() {
+		return types.ErrFpAlreadyRetired.Wrapf("finality key: %s", fpBTCPK.MarshalHex())
+	}
+	return nil
+}
+
+// successorDelegationMsg is a message registering a new BTC delegation that
+// spends the staking output of an existing BTC delegation as its successor
+type successorDelegationMsg interface {
+	GetStakerAddr() string
+	GetPrevStakingTxHash() string
+	ToCreateBTCDelegationMsg(prevDel *types.BTCDelegation) *types.MsgCreateBTCDelegation
+}
+
+// ExtendBTCDelegation registers a new BTC delegation that spends the staking
+// output of an active BTC delegation as its successor. The extension inherits
+// the staker and the finality providers of the extended BTC delegation, and
+// takes over its voting power once it becomes active
+func (ms msgServer) ExtendBTCDelegation(goCtx context.Context, req *types.MsgExtendBTCDelegation) (*types.MsgExtendBTCDelegationResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyExtendBTCDelegation)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	if err := ms.addSuccessorBTCDelegation(ctx, req, types.ErrInvalidDelExtension, nil); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgExtendBTCDelegationResponse{}, nil
+}
+
+// RedelegateBTCDelegation registers a new BTC delegation to other finality
+// providers that spends the staking output of an active BTC delegation as its
+// successor. The redelegation takes over the voting power of the redelegated
+// BTC delegation once it becomes active, which requires a quorum of the
+// covenant committee to pre-sign the transition tx via MsgAddCovenantSigs, and
+// the transition tx to be deeper than the unbonding time of the redelegated
+// BTC delegation
+func (ms msgServer) RedelegateBTCDelegation(goCtx context.Context, req *types.MsgRedelegateBTCDelegation) (*types.MsgRedelegateBTCDelegationResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyRedelegateBTCDelegation)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// basic stateless checks
+	if err := req.ValidateBasic(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// the voting power has to be moved to other finality providers, otherwise
+	// the staker shall extend the BTC delegation instead
+	checkFps := func(prevDel *types.BTCDelegation) error {
+		if prevDel.HasSameFinalityProviders(req.FpBtcPkList) {
+			return types.ErrInvalidRedelegation.Wrapf(
+				"the BTC delegation %s is already delegated to the given finality providers", req.PrevStakingTxHash)
+		}
+		return nil
+	}
+
+	if err := ms.addSuccessorBTCDelegation(ctx, req, types.ErrInvalidRedelegation, checkFps); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedelegateBTCDelegationResponse{}, nil
+}
+
+// addSuccessorBTCDelegation verifies and inserts a BTC delegation that
+// succeeds an active BTC delegation of the same staker. The given error is
+// used for reporting invalid successors, and checkPrevDel performs additional
+// checks on the BTC delegation to be succeeded, if not nil
+func (ms msgServer) addSuccessorBTCDelegation(
+	ctx sdk.Context,
+	req successorDelegationMsg,
+	errInvalid *errorsmod.Error,
+	checkPrevDel func(prevDel *types.BTCDelegation) error,
+) error {
+	// 1. ensure the previous BTC delegation is active and owned by the staker
+	prevDel, prevParams, err := ms.getBTCDelWithParams(ctx, req.GetPrevStakingTxHash())
+	if err != nil {
+		return err
+	}
+	if prevDel.StakerAddr != req.GetStakerAddr() {
+		return errInvalid.Wrapf(
+			"the BTC delegation %s is not owned by staker %s", req.GetPrevStakingTxHash(), req.GetStakerAddr())
+	}
+	btcTip := ms.btclcKeeper.GetTipInfo(ctx)
+	if prevStatus := prevDel.GetStatus(btcTip.Height, prevParams.CovenantQuorum); prevStatus != types.BTCDelegationStatus_ACTIVE {
+		return errInvalid.Wrapf(
+			"the BTC delegation %s is not active, status: %s", req.GetPrevStakingTxHash(), prevStatus.String())
+	}
+	// the stake of a slashed finality provider cannot escape slashing by
+	// moving to a successor
+	if err := ms.ensureFpsNotSlashed(ctx, prevDel); err != nil {
+		return err
+	}
+	if checkPrevDel != nil {
+		if err := checkPrevDel(prevDel); err != nil {
+			return err
+		}
+	}
+	// the covenant committee controlling the staking output of the previous
+	// BTC delegation co-signs the transition, so it has to be the same as the
+	// one verifying the successor
+	if !ms.GetParams(ctx).HasSameCovenantCommittee(prevParams) {
+		return errInvalid.Wrapf(
+			"the covenant committee has changed since the BTC delegation %s was created", req.GetPrevStakingTxHash())
+	}
+
+	// 2. parse the successor as a new BTC delegation. The proof of possession
+	// is inherited from the previous BTC delegation, which has the same staker
+	// address and BTC PK
+	parsedMsg, err := types.ParseCreateDelegationMessage(req.ToCreateBTCDelegationMsg(prevDel))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	// 3. ensure the new staking tx is a transition tx spending the staking
+	// output of the previous BTC delegation through its unbonding path
+	if err := ms.verifyTransitionTx(parsedMsg.StakingTx.Transaction, prevDel, prevParams); err != nil {
+		return errInvalid.Wrapf("the BTC delegation %s: %v", req.GetPrevStakingTxHash(), err)
+	}
+
+	// 4. verify the successor against the current state and the params, and
+	// construct the BTC delegation
+	// NOTE: the allow list is not checked since the previous BTC delegation
+	// has already passed it
+	newBTCDel, params, err := ms.verifyAndBuildBTCDelegation(ctx, parsedMsg)
+	if err != nil {
+		return err
+	}
+	newBTCDel.PrevStakingTxHash = req.GetPrevStakingTxHash()
+
+	// 5. ensure the successor can become active upon the covenant quorum, i.e.,
+	// the unbonding time of a redelegation has passed, and it is within the
+	// stake caps
+	if parsedMsg.IsIncludedOnBTC() {
+		if err := ms.ensureRedelegationWindowPassed(ctx, newBTCDel, btcTip.Height); err != nil {
+			return err
+		}
+		if err := ms.ensureWithinStakeCaps(ctx, newBTCDel); err != nil {
+			return err
+		}
+	}
+
+	if !parsedMsg.IsIncludedOnBTC() {
+		ctx.GasMeter().ConsumeGas(params.DelegationCreationBaseGasFee, "delegation creation fee")
+	}
+
 	// add this BTC delegation, and emit corresponding events
 	if err := ms.AddBTCDelegation(ctx, newBTCDel); err != nil {
-		panic(fmt.Errorf("failed to add BTC delegation that has passed verification: %w", err))
+		panic(fmt.Errorf("failed to add successor BTC delegation that has passed verification: %w", err))
+	}
+	if err := ms.reserveStake(ctx, newBTCDel); err != nil {
+		return err
 	}
 
-	return &types.MsgCreateBTCDelegationResponse{}, nil
+	return nil
+}
+
+// verifyTransitionTx checks that the given staking tx spends the staking
+// output of the given previous BTC delegation as its only input, so that the
+// covenant committee can co-sign it through the unbonding path. If the staking
+// tx comes with its witness, it must reveal the unbonding path script
+func (ms msgServer) verifyTransitionTx(
+	stakingTx *wire.MsgTx,
+	prevDel *types.BTCDelegation,
+	prevParams *types.Params,
+) error {
+	prevStakingTxHash := prevDel.MustGetStakingTxHash()
+	if len(stakingTx.TxIn) != 1 || !containsInput(stakingTx, &prevStakingTxHash, prevDel.StakingOutputIdx) {
+		return fmt.Errorf("the staking tx must spend the staking output as its only input")
+	}
+
+	witness := stakingTx.TxIn[0].Witness
+	if len(witness) == 0 {
+		return nil
+	}
+	_, unbondingPathScript := ms.mustGetUnbondingPath(prevDel, prevParams)
+	if !revealsScript(witness, unbondingPathScript) {
+		return fmt.Errorf("the staking tx does not spend the staking output through the unbonding path")
+	}
+
+	return nil
+}
+
+// verifyCovenantTransitionSig verifies the signature of the given covenant
+// member on the staking tx of a successor BTC delegation, which spends the
+// staking output of the previous BTC delegation through its unbonding path.
+// The signature must be provided iff the BTC delegation is a successor
+func (ms msgServer) verifyCovenantTransitionSig(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	covPK *bbn.BIP340PubKey,
+	sig *bbn.BIP340Signature,
+) error {
+	if !btcDel.IsSuccessor() {
+		if sig != nil {
+			return types.ErrInvalidCovenantSig.Wrap("the BTC delegation is not a successor, thus has no transition tx")
+		}
+		return nil
+	}
+	if sig == nil {
+		return types.ErrInvalidCovenantSig.Wrap("empty covenant signature on the transition tx")
+	}
+
+	prevDel, prevParams, err := ms.getBTCDelWithParams(ctx, btcDel.PrevStakingTxHash)
+	if err != nil {
+		return err
+	}
+	prevStakingOutput, unbondingPathScript := ms.mustGetUnbondingPath(prevDel, prevParams)
+	if err := btcstaking.VerifyTransactionSigWithOutput(
+		btcDel.MustGetStakingTx(),
+		prevStakingOutput,
+		unbondingPathScript,
+		covPK.MustToBTCPK(),
+		*sig,
+	); err != nil {
+		return types.ErrInvalidCovenantSig.Wrapf("invalid signature on the transition tx: %v", err)
+	}
+
+	return nil
+}
+
+// mustGetUnbondingPath returns the staking output of the given BTC delegation
+// and the script of its unbonding path
+func (ms msgServer) mustGetUnbondingPath(btcDel *types.BTCDelegation, params *types.Params) (*wire.TxOut, []byte) {
+	stakingInfo, err := btcDel.GetStakingInfo(params, ms.btcNet)
+	if err != nil {
+		panic(fmt.Errorf("failed to get staking info from a verified delegation: %w", err))
+	}
+	unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		// our staking info was constructed by using BuildStakingInfo constructor, so if
+		// this fails, it is a programming error
+		panic(err)
+	}
+	return stakingInfo.StakingOutput, unbondingSpendInfo.GetPkScriptPath()
+}
+
+// revealsScript returns whether the given taproot input witness is a script
+// path spend revealing the given script, i.e., the script is the element
+// preceding the control block, which is the last element apart from the annex
+func revealsScript(witness wire.TxWitness, script []byte) bool {
+	if len(witness) >= 2 {
+		lastElement := witness[len(witness)-1]
+		if len(lastElement) > 0 && lastElement[0] == txscript.TaprootAnnexTag {
+			witness = witness[:len(witness)-1]
+		}
+	}
+	return len(witness) >= 2 && bytes.Equal(witness[len(witness)-2], script)
 }
 
 // AddBTCDelegationInclusionProof adds inclusion proof of the given delegation on BTC chain
@@ -329,6 +703,12 @@ func (ms msgServer) AddBTCDelegationInclusionProof(
 		return nil, fmt.Errorf("the delegation %s is already unbonded", req.StakingTxHash)
 	}
 
+	// ensure the stake of a slashed finality provider does not escape slashing
+	// by activating a successor
+	if err := ms.ensurePrevFpsNotSlashed(ctx, btcDel); err != nil {
+		return nil, err
+	}
+
 	// 5. verify inclusion proof
 	parsedInclusionProof, err := types.NewParsedProofOfInclusion(req.StakingTxInclusionProof)
 	if err != nil {
@@ -339,6 +719,8 @@ func (ms msgServer) AddBTCDelegationInclusionProof(
 		return nil, err
 	}
 
+	// the current BTC confirmation depth is used as the inclusion proof is only
+	// verified once, upon this message
 	btccParams := ms.btccKeeper.GetParams(ctx)
 
 	timeInfo, err := ms.VerifyInclusionProofAndGetHeight(
@@ -366,9 +748,19 @@ func (ms msgServer) AddBTCDelegationInclusionProof(
 	// 7. set start height and end height and save it to db
 	btcDel.StartHeight = timeInfo.StartHeight
 	btcDel.EndHeight = timeInfo.EndHeight
+
+	// 8. check the BTC delegation can become active, i.e., the unbonding time
+	// of a redelegation has passed, and it does not exceed the stake caps
+	if err := ms.ensureRedelegationWindowPassed(ctx, btcDel, timeInfo.TipHeight); err != nil {
+		return nil, err
+	}
+	if err := ms.ensureWithinStakeCaps(ctx, btcDel); err != nil {
+		return nil, err
+	}
+
 	ms.setBTCDelegation(ctx, btcDel)
 
-	// 8. emit events
+	// 9. emit events
 	stakingTxHash := btcDel.MustGetStakingTxHash()
 
 	newInclusionProofEvent := types.NewInclusionProofEvent(
@@ -390,6 +782,16 @@ func (ms msgServer) AddBTCDelegationInclusionProof(
 	)
 
 	ms.addPowerDistUpdateEvent(ctx, timeInfo.TipHeight, activeEvent)
+	if err := ms.addActiveStake(ctx, btcDel); err != nil {
+		return nil, err
+	}
+	if err := ms.warnIfExpiringSoon(ctx, btcDel); err != nil {
+		return nil, err
+	}
+
+	// if the BTC delegation is a successor, it takes over the voting power
+	// of the BTC delegation it succeeds
+	ms.unbondPrevBTCDelegation(ctx, btcDel, timeInfo.TipHeight)
 
 	// record event that the BTC delegation will become unbonded at EndHeight-w
 	expiredEvent := types.NewEventPowerDistUpdateWithBTCDel(&types.EventBTCDelegationStateUpdate{
@@ -452,6 +854,12 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		return nil, types.ErrDuplicatedCovenantSig
 	}
 
+	// ensure the covenant committee does not co-sign a transition of the
+	// stake of a slashed finality provider
+	if err := ms.ensurePrevFpsNotSlashed(ctx, btcDel); err != nil {
+		return nil, err
+	}
+
 	// ensure BTC delegation is still pending, i.e., not unbonded
 	btcTipHeight := ms.btclcKeeper.GetTipInfo(ctx).Height
 	status := btcDel.GetStatus(btcTipHeight, params.CovenantQuorum)
@@ -548,17 +956,27 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		return nil, types.ErrInvalidCovenantSig.Wrapf("err: %v", err)
 	}
 
+	/*
+		verify Schnorr signature over the transition tx of a successor
+	*/
+	if err := ms.verifyCovenantTransitionSig(ctx, btcDel, req.Pk, req.TransitionTxSig); err != nil {
+		return nil, err
+	}
+
 	// All is fine add received signatures to the BTC delegation and BtcUndelegation
 	// and emit corresponding events
-	ms.addCovenantSigsToBTCDelegation(
+	if err := ms.addCovenantSigsToBTCDelegation(
 		ctx,
 		btcDel,
 		req.Pk,
 		parsedSlashingAdaptorSignatures,
 		req.UnbondingTxSig,
 		parsedUnbondingSlashingAdaptorSignatures,
+		req.TransitionTxSig,
 		params,
-	)
+	); err != nil {
+		return nil, err
+	}
 
 	// at this point, the covenant signatures are verified and are not duplicated.
 	// Thus, we can safely consider this message as refundable
@@ -623,7 +1041,7 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 
 	btcHeader := stakerSpendigTxHeader.Header.ToBlockHeader()
 
-	proofValid := btcckpttypes.VerifyInclusionProof(
+	proofValid := bbn.VerifyInclusionProof(
 		btcutil.NewTx(stakeSpendingTx),
 		&btcHeader.MerkleRoot,
 		req.StakeSpendingTxInclusionProof.Proof,
@@ -657,6 +1075,23 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 
 		types.EmitEarlyUnbondedEvent(ctx, btcDel.MustGetStakingTxHash().String(), stakerSpendigTxHeader.Height)
 	} else {
+		// the staking output spent by a verified extension is handed over to
+		// the extension once its inclusion proof is submitted, so that the
+		// voting power carries over without interruption. A redelegation only
+		// becomes active after the unbonding time, so the redelegated BTC
+		// delegation can be reported as unbonded in the meantime
+		if successor := ms.getBTCDelegation(ctx, spendStakeTxHash); successor != nil &&
+			successor.PrevStakingTxHash == req.StakingTxHash &&
+			successor.HasSameFinalityProviders(btcDel.FpBtcPkList) {
+			successorParams := ms.GetParamsByVersion(ctx, successor.ParamsVersion)
+			if successorParams != nil && successor.HasCovenantQuorums(successorParams.CovenantQuorum) {
+				return nil, types.ErrInvalidBTCUndelegateReq.Wrapf(
+					"stake spending tx is the staking tx of the verified successor %s, submit its inclusion proof instead",
+					spendStakeTxHash.String(),
+				)
+			}
+		}
+
 		// stakeSpendingTx is not unbonding tx, first we need to verify whether it
 		// actually spends staking output
 		stakingTxHash, err := chainhash.NewHashFromStr(req.StakingTxHash)
@@ -684,7 +1119,9 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 
 	// all good, add the signature to BTC delegation's undelegation
 	// and set back
-	ms.btcUndelegate(ctx, btcDel, delegatorUnbondingInfo)
+	if err := ms.btcUndelegate(ctx, btcDel, delegatorUnbondingInfo); err != nil {
+		return nil, err
+	}
 
 	// At this point, the unbonding signature is verified.
 	// Thus, we can safely consider this message as refundable
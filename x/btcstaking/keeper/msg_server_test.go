@@ -3,16 +3,20 @@ package keeper_test
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math"
 	"math/rand"
 	"testing"
 	"time"
 
+	"cosmossdk.io/core/header"
 	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -90,11 +94,12 @@ func FuzzMsgCreateFinalityProvider(f *testing.F) {
 			fp, err := datagen.GenRandomFinalityProvider(r)
 			require.NoError(t, err)
 			msg := &types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
-				Pop:         fp.Pop,
+				Addr:            fp.Addr,
+				Description:     fp.Description,
+				Commission:      fp.Commission,
+				BtcPk:           fp.BtcPk,
+				Pop:             fp.Pop,
+				CommissionRates: fp.CommissionInfo.Rates(),
 			}
 			_, err = h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
 			require.NoError(t, err)
@@ -110,11 +115,12 @@ func FuzzMsgCreateFinalityProvider(f *testing.F) {
 		// duplicated finality providers should not pass
 		for _, fp2 := range fps {
 			msg := &types.MsgCreateFinalityProvider{
-				Addr:        fp2.Addr,
-				Description: fp2.Description,
-				Commission:  fp2.Commission,
-				BtcPk:       fp2.BtcPk,
-				Pop:         fp2.Pop,
+				Addr:            fp2.Addr,
+				Description:     fp2.Description,
+				Commission:      fp2.Commission,
+				BtcPk:           fp2.BtcPk,
+				Pop:             fp2.Pop,
+				CommissionRates: fp2.CommissionInfo.Rates(),
 			}
 			_, err := h.MsgServer.CreateFinalityProvider(h.Ctx, msg)
 			require.Error(t, err)
@@ -144,9 +150,13 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 
 		// updated commission and description
 		newCommission := datagen.GenRandomCommission(r)
+		for newCommission.Equal(*fp.Commission) {
+			newCommission = datagen.GenRandomCommission(r)
+		}
 		newDescription := datagen.GenRandomDescription(r)
 
-		// scenario 1: editing finality provider should succeed
+		// scenario 1: changing the commission right after the registration
+		// should fail
 		msg := &types.MsgEditFinalityProvider{
 			Addr:        fp.Addr,
 			BtcPk:       *fp.BtcPk,
@@ -154,13 +164,68 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 			Commission:  &newCommission,
 		}
 		_, err := h.MsgServer.EditFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrCommissionUpdateTooSoon)
+
+		// scenario 2: editing finality provider after the update interval
+		// should succeed, and the new commission takes effect after the delay
+		h.Ctx = h.Ctx.WithHeaderInfo(header.Info{Time: h.Ctx.HeaderInfo().Time.Add(types.CommissionUpdateInterval)})
+		_, err = h.MsgServer.EditFinalityProvider(h.Ctx, msg)
 		h.NoError(err)
 		editedFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
 		h.NoError(err)
-		require.Equal(t, newCommission, *editedFp.Commission)
+		require.Equal(t, fp.Commission, editedFp.Commission)
 		require.Equal(t, newDescription, editedFp.Description)
+		pending, err := h.BTCStakingKeeper.GetPendingCommission(h.Ctx, *fp.BtcPk)
+		h.NoError(err)
+		require.NotNil(t, pending)
+		require.Equal(t, newCommission, pending.Commission)
+
+		h.Ctx = h.Ctx.WithHeaderInfo(header.Info{Time: pending.EffectiveTime})
+		err = h.BTCStakingKeeper.ApplyPendingCommissionChanges(h.Ctx)
+		h.NoError(err)
+		editedFp, err = h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
+		h.NoError(err)
+		require.Equal(t, newCommission, *editedFp.Commission)
+		pending, err = h.BTCStakingKeeper.GetPendingCommission(h.Ctx, *fp.BtcPk)
+		h.NoError(err)
+		require.Nil(t, pending)
+
+		// the commission history contains the initial and the new commission
+		historyResp, err := h.BTCStakingKeeper.FinalityProviderCommissionHistory(h.Ctx, &types.QueryFinalityProviderCommissionHistoryRequest{
+			FpBtcPkHex: fp.BtcPk.MarshalHex(),
+		})
+		h.NoError(err)
+		require.Len(t, historyResp.History, 2)
+		require.Equal(t, *fp.Commission, historyResp.History[0].Commission)
+		require.Equal(t, newCommission, historyResp.History[1].Commission)
+		require.Nil(t, historyResp.Pending)
+
+		// scenario 3: increasing the commission by more than the max change
+		// rate or above the max rate should fail
+		editedFp.CommissionInfo.MaxRate = newCommission.Add(sdkmath.LegacyNewDecWithPrec(2, 2))
+		editedFp.CommissionInfo.MaxChangeRate = sdkmath.LegacyNewDecWithPrec(1, 2)
+		err = h.BTCStakingKeeper.UpdateFinalityProvider(h.Ctx, editedFp)
+		h.NoError(err)
+		h.Ctx = h.Ctx.WithHeaderInfo(header.Info{Time: h.Ctx.HeaderInfo().Time.Add(types.CommissionUpdateInterval)})
 
-		// scenario 2: message from an unauthorised signer should fail
+		tooHighChange := newCommission.Add(sdkmath.LegacyNewDecWithPrec(2, 2))
+		msg.Commission = &tooHighChange
+		_, err = h.MsgServer.EditFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrCommissionGTMaxChangeRate)
+
+		if newCommission.GTE(h.BTCStakingKeeper.MinCommissionRate(h.Ctx).Add(sdkmath.LegacyNewDecWithPrec(2, 2))) {
+			tooLowChange := newCommission.Sub(sdkmath.LegacyNewDecWithPrec(2, 2))
+			msg.Commission = &tooLowChange
+			_, err = h.MsgServer.EditFinalityProvider(h.Ctx, msg)
+			require.ErrorIs(t, err, types.ErrCommissionGTMaxChangeRate)
+		}
+
+		aboveMaxRate := newCommission.Add(sdkmath.LegacyNewDecWithPrec(3, 2))
+		msg.Commission = &aboveMaxRate
+		_, err = h.MsgServer.EditFinalityProvider(h.Ctx, msg)
+		require.ErrorIs(t, err, types.ErrCommissionGTMaxRateLimit)
+
+		// scenario 4: message from an unauthorised signer should fail
 		newCommission = datagen.GenRandomCommission(r)
 		newDescription = datagen.GenRandomDescription(r)
 		invalidAddr := datagen.GenRandomAccount().Address
@@ -177,6 +242,121 @@ func FuzzMsgEditFinalityProvider(f *testing.F) {
 	})
 }
 
+func FuzzMsgRetireFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, fp := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation and activate it
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegationWithBtcBlockHeight(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			true,
+			false,
+			10,
+			10,
+		)
+		h.NoError(err)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel, 10)
+		h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof, 30)
+
+		// prepare another BTC delegation to the finality provider without
+		// persisting it, so that it can be submitted after the retirement
+		ctx := h.Ctx
+		h.Ctx, _ = ctx.CacheContext()
+		_, msgCreateBTCDel2, _, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			true,
+			false,
+		)
+		h.NoError(err)
+		h.Ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		// message from an unauthorised signer should fail
+		_, err = h.MsgServer.RetireFinalityProvider(h.Ctx, &types.MsgRetireFinalityProvider{
+			Addr:  datagen.GenRandomAccount().Address,
+			BtcPk: *fp.BtcPk,
+		})
+		require.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		// retiring the finality provider should succeed
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		_, err = h.MsgServer.RetireFinalityProvider(h.Ctx, &types.MsgRetireFinalityProvider{
+			Addr:  fp.Addr,
+			BtcPk: *fp.BtcPk,
+		})
+		h.NoError(err)
+		retiredFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, *fp.BtcPk)
+		h.NoError(err)
+		require.True(t, retiredFp.IsRetired())
+		require.Equal(t, uint64(h.Ctx.HeaderInfo().Height), retiredFp.RetiredBabylonHeight)
+
+		// the delegator of the active BTC delegation is notified
+		delNotified := false
+		for _, ev := range h.Ctx.EventManager().Events() {
+			if ev.Type != "babylon.btcstaking.v1.EventBTCDelegationFinalityProviderRetired" {
+				continue
+			}
+			typedEv, err := sdk.ParseTypedEvent(abci.Event(ev))
+			h.NoError(err)
+			retiredEv := typedEv.(*types.EventBTCDelegationFinalityProviderRetired)
+			require.Equal(t, stakingTxHash, retiredEv.StakingTxHash)
+			require.Equal(t, fp.BtcPk.MarshalHex(), retiredEv.FpBtcPkHex)
+			require.Equal(t, types.BTCDelegationStatus_ACTIVE.String(), retiredEv.State)
+			delNotified = true
+		}
+		require.True(t, delNotified)
+
+		// the finality provider cannot be retired twice
+		_, err = h.MsgServer.RetireFinalityProvider(h.Ctx, &types.MsgRetireFinalityProvider{
+			Addr:  fp.Addr,
+			BtcPk: *fp.BtcPk,
+		})
+		require.ErrorIs(t, err, types.ErrFpAlreadyRetired)
+
+		// the retired finality provider does not accept new BTC delegations
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel2)
+		require.ErrorIs(t, err, types.ErrFpAlreadyRetired)
+
+		// the existing BTC delegation keeps its voting power
+		del, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, del.GetStatus(30, h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+	})
+}
+
 func FuzzCreateBTCDelegation(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -265,6 +445,92 @@ func FuzzCreateBTCDelegation(f *testing.F) {
 	})
 }
 
+func FuzzValidateBTCDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		h.GenAndApplyParams(r)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate a BTC delegation in a discarded branch of the state, so
+		// that the msg can be validated against the state without it
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		ctx := h.Ctx
+		h.Ctx, _ = ctx.CacheContext()
+		_, msgCreateBTCDel, _, _, _, _, err := h.CreateDelegation(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			true,
+			false,
+		)
+		h.NoError(err)
+		h.Ctx = ctx
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+
+		// nil request should fail
+		_, err = h.BTCStakingKeeper.ValidateBTCDelegation(h.Ctx, nil)
+		require.Error(t, err)
+
+		// the msg is valid against the state without the BTC delegation
+		resp, err := h.BTCStakingKeeper.ValidateBTCDelegation(h.Ctx, &types.QueryValidateBTCDelegationRequest{Msg: msgCreateBTCDel})
+		h.NoError(err)
+		require.True(t, resp.Valid)
+		require.Empty(t, resp.Failures)
+
+		// every failing rule is reported
+		invalidMsg := *msgCreateBTCDel
+		invalidMsg.StakerAddr = datagen.GenRandomAccount().Address
+		invalidMsg.UnbondingTime++
+		resp, err = h.BTCStakingKeeper.ValidateBTCDelegation(h.Ctx, &types.QueryValidateBTCDelegationRequest{Msg: &invalidMsg})
+		h.NoError(err)
+		require.False(t, resp.Valid)
+		rules := []string{}
+		for _, failure := range resp.Failures {
+			rules = append(rules, failure.Rule)
+		}
+		require.Equal(t, []string{
+			types.RuleProofOfPossession,
+			types.RuleUnbondingTime,
+			types.RuleStakingTx,
+		}, rules)
+		require.Equal(t, types.ErrInvalidProofOfPossession.Codespace(), resp.Failures[0].Codespace)
+		require.Equal(t, types.ErrInvalidProofOfPossession.ABCICode(), resp.Failures[0].Code)
+
+		// the msg is no longer valid once the BTC delegation is created, and
+		// the state is not changed by the validation
+		_, err = h.MsgServer.CreateBTCDelegation(h.Ctx, msgCreateBTCDel)
+		h.NoError(err)
+		resp, err = h.BTCStakingKeeper.ValidateBTCDelegation(h.Ctx, &types.QueryValidateBTCDelegationRequest{Msg: msgCreateBTCDel})
+		h.NoError(err)
+		require.False(t, resp.Valid)
+		require.Len(t, resp.Failures, 1)
+		require.Equal(t, types.RuleStakingTxNotDuplicated, resp.Failures[0].Rule)
+	})
+}
+
 func FuzzCreateBTCDelegationWithParamsFromBtcHeight(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -819,6 +1085,582 @@ func FuzzBTCUndelegateExpired(f *testing.F) {
 	})
 }
 
+func FuzzStakeCaps(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters, where each finality provider can have a single
+		// BTC delegation and there can be two BTC delegations in total
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		stakingValue := int64(2 * 10e8)
+		stakedParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+		stakedParams.Params.MaxFpStakedSat = uint64(stakingValue)
+		stakedParams.Params.MaxTotalStakedSat = uint64(2 * stakingValue)
+		err := h.BTCStakingKeeper.OverwriteParamsAtVersion(h.Ctx, stakedParams.Version, stakedParams.Params)
+		h.NoError(err)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality providers
+		_, fpPK1, _ := h.CreateFinalityProvider(r)
+		_, fpPK2, _ := h.CreateFinalityProvider(r)
+		_, fpPK3, _ := h.CreateFinalityProvider(r)
+
+		// createVerifiedDelegation creates a BTC delegation to the given
+		// finality provider that receives a covenant quorum
+		createVerifiedDelegation := func(fpPK *btcec.PublicKey) (string, *btclctypes.BTCHeaderInfo, *types.InclusionProof, *testutil.UnbondingTxInfo) {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			h.NoError(err)
+			stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, unbondingInfo, err := h.CreateDelegationWithBtcBlockHeight(
+				r,
+				delSK,
+				fpPK,
+				changeAddress.EncodeAddress(),
+				stakingValue,
+				1000,
+				0,
+				0,
+				true,
+				false,
+				10,
+				10,
+			)
+			h.NoError(err)
+			h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel, 10)
+			return stakingTxHash, btcHeaderInfo, inclusionProof, unbondingInfo
+		}
+		// addInclusionProof submits the inclusion proof of the given BTC
+		// delegation, which might be rejected
+		addInclusionProof := func(stakingTxHash string, btcHeaderInfo *btclctypes.BTCHeaderInfo, inclusionProof *types.InclusionProof) error {
+			h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeaderInfo.Header.Hash())).Return(btcHeaderInfo, nil).AnyTimes()
+			_, err := h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, &types.MsgAddBTCDelegationInclusionProof{
+				StakingTxHash:           stakingTxHash,
+				StakingTxInclusionProof: inclusionProof,
+			})
+			return err
+		}
+		assertStakedSat := func(fpPK *btcec.PublicKey, expectedFpStakedSat, expectedTotalStakedSat uint64) {
+			resp, err := h.BTCStakingKeeper.FinalityProviderStakeCapacity(h.Ctx, &types.QueryFinalityProviderStakeCapacityRequest{
+				FpBtcPkHex: bbn.NewBIP340PubKeyFromBTCPK(fpPK).MarshalHex(),
+			})
+			h.NoError(err)
+			require.Equal(t, types.NewStakeCapacity(expectedFpStakedSat, uint64(stakingValue)), resp.FinalityProvider.Capacity)
+			require.Equal(t, types.NewStakeCapacity(expectedTotalStakedSat, uint64(2*stakingValue)), resp.TotalCapacity)
+		}
+
+		// the first BTC delegation to the 1st finality provider becomes active
+		stakingTxHash1, btcHeaderInfo1, inclusionProof1, unbondingInfo1 := createVerifiedDelegation(fpPK1)
+		h.AddInclusionProof(stakingTxHash1, btcHeaderInfo1, inclusionProof1, 30)
+		assertStakedSat(fpPK1, uint64(stakingValue), uint64(stakingValue))
+
+		// the second BTC delegation to the 1st finality provider exceeds its cap
+		stakingTxHash2, btcHeaderInfo2, inclusionProof2, _ := createVerifiedDelegation(fpPK1)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		err = addInclusionProof(stakingTxHash2, btcHeaderInfo2, inclusionProof2)
+		require.ErrorIs(t, err, types.ErrStakeCapExceeded)
+		assertStakedSat(fpPK1, uint64(stakingValue), uint64(stakingValue))
+
+		// a BTC delegation with an inclusion proof to the 3rd finality
+		// provider is within the caps upon its creation, and its stake is
+		// reserved until it receives a covenant quorum
+		delSK5, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash5, msgCreateBTCDel5, actualDel5, _, _, unbondingInfo5, err := h.CreateDelegationWithBtcBlockHeight(
+			r, delSK5, fpPK3, changeAddress.EncodeAddress(), stakingValue, 1000, 0, 0, false, false, 10, 30,
+		)
+		h.NoError(err)
+		assertStakedSat(fpPK3, uint64(stakingValue), uint64(2*stakingValue))
+
+		// a BTC delegation to the 2nd finality provider exceeds the total cap
+		// including the reserved stake
+		stakingTxHash3, btcHeaderInfo3, inclusionProof3, _ := createVerifiedDelegation(fpPK2)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		err = addInclusionProof(stakingTxHash3, btcHeaderInfo3, inclusionProof3)
+		require.ErrorIs(t, err, types.ErrStakeCapExceeded)
+		assertStakedSat(fpPK2, 0, uint64(2*stakingValue))
+
+		// the BTC delegation with the inclusion proof becomes active upon the
+		// covenant quorum without exceeding the caps
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel5, actualDel5, 30)
+		actualDel5, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash5)
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, actualDel5.GetStatus(30, h.BTCStakingKeeper.GetParams(h.Ctx).CovenantQuorum))
+		assertStakedSat(fpPK3, uint64(stakingValue), uint64(2*stakingValue))
+
+		// a BTC delegation with an inclusion proof exceeding the caps is
+		// rejected upon its creation
+		delSK6, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, _, _, _, _, _, err = h.CreateDelegationWithBtcBlockHeight(
+			r, delSK6, fpPK2, changeAddress.EncodeAddress(), stakingValue, 1000, 0, 0, false, false, 10, 30,
+		)
+		require.ErrorIs(t, err, types.ErrStakeCapExceeded)
+
+		// unbonding the first BTC delegation and the one to the 3rd finality
+		// provider releases their stake
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+		actualDel1, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash1)
+		h.NoError(err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:                        datagen.GenRandomAccount().Address,
+			StakingTxHash:                 stakingTxHash1,
+			StakeSpendingTx:               actualDel1.BtcUndelegation.UnbondingTx,
+			StakeSpendingTxInclusionProof: unbondingInfo1.UnbondingTxInclusionProof,
+		})
+		h.NoError(err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:                        datagen.GenRandomAccount().Address,
+			StakingTxHash:                 stakingTxHash5,
+			StakeSpendingTx:               actualDel5.BtcUndelegation.UnbondingTx,
+			StakeSpendingTxInclusionProof: unbondingInfo5.UnbondingTxInclusionProof,
+		})
+		h.NoError(err)
+		assertStakedSat(fpPK1, 0, 0)
+		assertStakedSat(fpPK3, 0, 0)
+
+		// then the second BTC delegation to the 1st finality provider and the
+		// one to the 2nd finality provider fit
+		err = addInclusionProof(stakingTxHash2, btcHeaderInfo2, inclusionProof2)
+		h.NoError(err)
+		assertStakedSat(fpPK1, uint64(stakingValue), uint64(stakingValue))
+		err = addInclusionProof(stakingTxHash3, btcHeaderInfo3, inclusionProof3)
+		h.NoError(err)
+		assertStakedSat(fpPK2, uint64(stakingValue), uint64(2*stakingValue))
+	})
+}
+
+func FuzzExpiryWarnings(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters, where BTC delegations are warned 10 BTC blocks
+		// before their voting power expires
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		warningBlocks := uint32(10)
+		stakedParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+		stakedParams.Params.ExpiryWarningBlocks = warningBlocks
+		err := h.BTCStakingKeeper.OverwriteParamsAtVersion(h.Ctx, stakedParams.Version, stakedParams.Params)
+		h.NoError(err)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation and activate it
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingValue := int64(2 * 10e8)
+		stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegationWithBtcBlockHeight(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			true,
+			false,
+			10,
+			10,
+		)
+		h.NoError(err)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel, 10)
+		h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof, 30)
+
+		activeDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		expiryHeight := activeDel.EndHeight - activeDel.UnbondingTime
+
+		// the recorded state update events only carry the staking tx hash and
+		// the new state, while the BTC delegation's details are only added to
+		// the emitted events
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, expiryHeight, expiryHeight)
+		require.Len(t, events, 1)
+		require.Equal(t, &types.EventBTCDelegationStateUpdate{
+			StakingTxHash: stakingTxHash,
+			NewState:      types.BTCDelegationStatus_EXPIRED,
+		}, events[0].GetBtcDelStateUpdate())
+
+		// beginBlockAt indexes the given BTC tip height and emits the expiry
+		// warnings, returning the warned BTC delegations
+		beginBlockAt := func(btcHeight uint32) []*types.EventBTCDelegationExpiringSoon {
+			h.Ctx = h.Ctx.WithEventManager(sdk.NewEventManager())
+			h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcHeight})
+			h.BTCStakingKeeper.IndexBTCHeight(h.Ctx)
+			err := h.BTCStakingKeeper.EmitExpiryWarnings(h.Ctx)
+			h.NoError(err)
+
+			warned := []*types.EventBTCDelegationExpiringSoon{}
+			for _, ev := range h.Ctx.EventManager().Events() {
+				if ev.Type != "babylon.btcstaking.v1.EventBTCDelegationExpiringSoon" {
+					continue
+				}
+				typedEv, err := sdk.ParseTypedEvent(abci.Event(ev))
+				h.NoError(err)
+				warned = append(warned, typedEv.(*types.EventBTCDelegationExpiringSoon))
+			}
+			return warned
+		}
+
+		// the BTC delegation is not warned before the warning window
+		require.Empty(t, beginBlockAt(expiryHeight-warningBlocks-1))
+
+		// the BTC delegation is warned once it enters the warning window
+		warned := beginBlockAt(expiryHeight - warningBlocks)
+		require.Len(t, warned, 1)
+		require.Equal(t, stakingTxHash, warned[0].StakingTxHash)
+		require.Equal(t, activeDel.StakerAddr, warned[0].StakerAddr)
+		require.Equal(t, activeDel.FinalityProviderKeys(), warned[0].FinalityProviderBtcPksHex)
+		require.Equal(t, fmt.Sprintf("%d", expiryHeight), warned[0].ExpiryHeight)
+
+		// the BTC delegation is warned only once
+		require.Empty(t, beginBlockAt(expiryHeight-warningBlocks+1))
+	})
+}
+
+func FuzzExtendBTCDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation and activate it
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, prevDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegationWithBtcBlockHeight(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			true,
+			false,
+			10,
+			10,
+		)
+		h.NoError(err)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, prevDel, 10)
+		h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof, 30)
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+
+		// extending a BTC delegation of another staker is rejected
+		_, err = h.MsgServer.ExtendBTCDelegation(h.Ctx, &types.MsgExtendBTCDelegation{
+			StakerAddr:        datagen.GenRandomAccount().Address,
+			PrevStakingTxHash: stakingTxHash,
+			StakingTx:         msgCreateBTCDel.StakingTx,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidDelExtension)
+
+		// an extension whose staking tx does not spend the staking output of
+		// the extended BTC delegation is rejected
+		bogusMsg := &types.MsgExtendBTCDelegation{
+			StakerAddr:                    prevDel.StakerAddr,
+			PrevStakingTxHash:             stakingTxHash,
+			StakingTime:                   msgCreateBTCDel.StakingTime,
+			StakingValue:                  msgCreateBTCDel.StakingValue,
+			StakingTx:                     msgCreateBTCDel.StakingTx,
+			SlashingTx:                    msgCreateBTCDel.SlashingTx,
+			DelegatorSlashingSig:          msgCreateBTCDel.DelegatorSlashingSig,
+			UnbondingTime:                 msgCreateBTCDel.UnbondingTime,
+			UnbondingTx:                   msgCreateBTCDel.UnbondingTx,
+			UnbondingValue:                msgCreateBTCDel.UnbondingValue,
+			UnbondingSlashingTx:           msgCreateBTCDel.UnbondingSlashingTx,
+			DelegatorUnbondingSlashingSig: msgCreateBTCDel.DelegatorUnbondingSlashingSig,
+		}
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		_, err = h.MsgServer.ExtendBTCDelegation(h.Ctx, bogusMsg)
+		require.ErrorIs(t, err, types.ErrInvalidDelExtension)
+
+		// extend the BTC delegation via the pre-approval flow
+		extStakingTxHash, msgExtendBTCDel, extHeaderInfo, extInclusionProof, err := h.ExtendDelegation(
+			r, delSK, fpPK, prevDel, stakingValue, 1000, true, 30, 30,
+		)
+		h.NoError(err)
+		extDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, extStakingTxHash)
+		h.NoError(err)
+		require.True(t, extDel.IsSuccessor())
+		require.Equal(t, stakingTxHash, extDel.PrevStakingTxHash)
+		require.Equal(t, prevDel.StakerAddr, extDel.StakerAddr)
+		require.Equal(t, prevDel.FpBtcPkList, extDel.FpBtcPkList)
+
+		// covenant signatures without a valid signature on the transition tx
+		// spending the staking output of the extended BTC delegation are rejected
+		covMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgExtendBTCDel.ToCreateBTCDelegationMsg(prevDel), extDel)
+		noTransitionSigMsg := *covMsgs[0]
+		noTransitionSigMsg.TransitionTxSig = nil
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &noTransitionSigMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+		wrongTransitionSigMsg := *covMsgs[0]
+		wrongTransitionSigMsg.TransitionTxSig = covMsgs[1].TransitionTxSig
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &wrongTransitionSigMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+		extDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, extStakingTxHash)
+		h.NoError(err)
+		require.Empty(t, extDel.CovenantSigs)
+		require.Empty(t, extDel.CovenantTransitionSigs)
+
+		// the extension is verified by the covenant committee, while the
+		// extended BTC delegation remains active
+		h.CreateCovenantSigs(r, covenantSKs, msgExtendBTCDel.ToCreateBTCDelegationMsg(prevDel), extDel, 30)
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, prevDel.GetStatus(30, bsParams.CovenantQuorum))
+
+		// the spending of the staking output by the verified extension cannot
+		// be reported as unbonding
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:                        datagen.GenRandomAccount().Address,
+			StakingTxHash:                 stakingTxHash,
+			StakeSpendingTx:               msgExtendBTCDel.StakingTx,
+			StakeSpendingTxInclusionProof: extInclusionProof,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidBTCUndelegateReq)
+
+		// activate the extension, which takes over the voting power of the
+		// extended BTC delegation at the same BTC height
+		activationHeight := uint32(50)
+		h.AddInclusionProof(extStakingTxHash, extHeaderInfo, extInclusionProof, activationHeight)
+
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, prevDel.GetStatus(activationHeight, bsParams.CovenantQuorum))
+		require.Equal(t, msgExtendBTCDel.StakingTx, prevDel.BtcUndelegation.DelegatorUnbondingInfo.SpendStakeTx)
+
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, activationHeight, activationHeight)
+		require.Len(t, events, 2)
+		require.Equal(t, extStakingTxHash, events[0].GetBtcDelStateUpdate().StakingTxHash)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, events[0].GetBtcDelStateUpdate().NewState)
+		require.Equal(t, stakingTxHash, events[1].GetBtcDelStateUpdate().StakingTxHash)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, events[1].GetBtcDelStateUpdate().NewState)
+	})
+}
+
+func FuzzRedelegateBTCDelegation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert the finality providers to redelegate from and to
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+		_, newFpPK, _ := h.CreateFinalityProvider(r)
+
+		// generate and insert new BTC delegation and activate it
+		stakingValue := int64(2 * 10e8)
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		stakingTxHash, msgCreateBTCDel, prevDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegationWithBtcBlockHeight(
+			r,
+			delSK,
+			fpPK,
+			changeAddress.EncodeAddress(),
+			stakingValue,
+			1000,
+			0,
+			0,
+			true,
+			false,
+			10,
+			10,
+		)
+		h.NoError(err)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, prevDel, 10)
+		h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof, 30)
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+
+		// redelegating to the same finality provider is rejected
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		_, err = h.MsgServer.RedelegateBTCDelegation(h.Ctx, &types.MsgRedelegateBTCDelegation{
+			StakerAddr:        prevDel.StakerAddr,
+			PrevStakingTxHash: stakingTxHash,
+			FpBtcPkList:       prevDel.FpBtcPkList,
+			StakingTx:         msgCreateBTCDel.StakingTx,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidRedelegation)
+
+		// redelegate the BTC delegation via the pre-approval flow
+		newStakingTxHash, msgRedelegate, newHeaderInfo, newInclusionProof, err := h.RedelegateDelegation(
+			r, delSK, newFpPK, prevDel, stakingValue, 1000, true, 30, 30,
+		)
+		h.NoError(err)
+		newDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, newStakingTxHash)
+		h.NoError(err)
+		require.True(t, newDel.IsSuccessor())
+		require.Equal(t, stakingTxHash, newDel.PrevStakingTxHash)
+		require.Equal(t, []bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(newFpPK)}, newDel.FpBtcPkList)
+
+		// covenant signatures without a valid signature on the transition tx
+		// spending the staking output of the redelegated BTC delegation are
+		// rejected
+		covMsgs := h.GenerateCovenantSignaturesMessages(r, covenantSKs, msgRedelegate.ToCreateBTCDelegationMsg(prevDel), newDel)
+		noTransitionSigMsg := *covMsgs[0]
+		noTransitionSigMsg.TransitionTxSig = nil
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &noTransitionSigMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+		wrongTransitionSigMsg := *covMsgs[0]
+		wrongTransitionSigMsg.TransitionTxSig = covMsgs[1].TransitionTxSig
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		_, err = h.MsgServer.AddCovenantSigs(h.Ctx, &wrongTransitionSigMsg)
+		require.ErrorIs(t, err, types.ErrInvalidCovenantSig)
+
+		// the redelegation cannot be activated before a quorum of the covenant
+		// committee has pre-signed the transition tx
+		for _, msg := range covMsgs[:bsParams.CovenantQuorum-1] {
+			h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+		}
+		_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, &types.MsgAddBTCDelegationInclusionProof{
+			StakingTxHash:           newStakingTxHash,
+			StakingTxInclusionProof: newInclusionProof,
+		})
+		require.Error(t, err)
+
+		// the transition tx is pre-signed by the covenant committee, while the
+		// redelegated BTC delegation remains active
+		for _, msg := range covMsgs[bsParams.CovenantQuorum-1:] {
+			h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+			_, err = h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+			h.NoError(err)
+		}
+		newDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, newStakingTxHash)
+		h.NoError(err)
+		require.Len(t, newDel.CovenantTransitionSigs, len(covMsgs))
+		require.Equal(t, types.BTCDelegationStatus_VERIFIED, newDel.GetStatus(30, bsParams.CovenantQuorum))
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, prevDel.GetStatus(30, bsParams.CovenantQuorum))
+
+		if datagen.OneInN(r, 2) {
+			// the previous finality provider is slashed before the redelegation
+			// becomes active, so the stake cannot escape slashing
+			h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+			err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, bbn.NewBIP340PubKeyFromBTCPK(fpPK).MustMarshal())
+			h.NoError(err)
+
+			_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, &types.MsgAddBTCDelegationInclusionProof{
+				StakingTxHash:           newStakingTxHash,
+				StakingTxInclusionProof: newInclusionProof,
+			})
+			require.ErrorIs(t, err, types.ErrFpAlreadySlashed)
+			return
+		}
+
+		// the redelegation cannot be activated before the unbonding time of the
+		// redelegated BTC delegation has passed since the transition tx, as the
+		// stake is no longer slashable by the previous finality provider
+		activationHeight := newHeaderInfo.Height + prevDel.UnbondingTime
+		h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(newHeaderInfo.Header.Hash())).Return(newHeaderInfo, nil).AnyTimes()
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: activationHeight - 1})
+		_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, &types.MsgAddBTCDelegationInclusionProof{
+			StakingTxHash:           newStakingTxHash,
+			StakingTxInclusionProof: newInclusionProof,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidRedelegation)
+
+		// in the meantime, the spending of the staking output by the
+		// transition tx can be reported as unbonding
+		prevUnbondedEarly := datagen.OneInN(r, 2)
+		if prevUnbondedEarly {
+			h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: activationHeight - 1}).Times(2)
+			_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+				Signer:                        datagen.GenRandomAccount().Address,
+				StakingTxHash:                 stakingTxHash,
+				StakeSpendingTx:               msgRedelegate.StakingTx,
+				StakeSpendingTxInclusionProof: newInclusionProof,
+			})
+			h.NoError(err)
+		}
+
+		// activate the redelegation, which moves the voting power to the new
+		// finality provider
+		h.AddInclusionProof(newStakingTxHash, newHeaderInfo, newInclusionProof, activationHeight)
+
+		prevDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		h.NoError(err)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, prevDel.GetStatus(activationHeight, bsParams.CovenantQuorum))
+
+		events := h.BTCStakingKeeper.GetAllPowerDistUpdateEvents(h.Ctx, activationHeight, activationHeight)
+		if prevUnbondedEarly {
+			// the voting power of the redelegated BTC delegation is already
+			// removed upon the report
+			require.Len(t, events, 1)
+			require.Equal(t, newStakingTxHash, events[0].GetBtcDelStateUpdate().StakingTxHash)
+			require.Equal(t, types.BTCDelegationStatus_ACTIVE, events[0].GetBtcDelStateUpdate().NewState)
+			return
+		}
+		require.Len(t, events, 2)
+		require.Equal(t, newStakingTxHash, events[0].GetBtcDelStateUpdate().StakingTxHash)
+		require.Equal(t, types.BTCDelegationStatus_ACTIVE, events[0].GetBtcDelStateUpdate().NewState)
+		require.Equal(t, stakingTxHash, events[1].GetBtcDelStateUpdate().StakingTxHash)
+		require.Equal(t, types.BTCDelegationStatus_UNBONDED, events[1].GetBtcDelStateUpdate().NewState)
+	})
+}
+
 func FuzzSelectiveSlashing(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -1344,6 +2186,91 @@ type ExpectedProviderData struct {
 	stakingValue   int32
 }
 
+func FuzzMsgServer_AllowedStakingTxHashes(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		numTxHashes := int(datagen.RandomInt(r, 20) + 2)
+		txHashesHex := make([]string, 0, numTxHashes)
+		for i := 0; i < numTxHashes; i++ {
+			txHash := datagen.GenRandomBtcdHash(r)
+			txHashesHex = append(txHashesHex, txHash.String())
+		}
+
+		// only the governance account can update the allow list
+		_, err := h.MsgServer.AddAllowedStakingTxHashes(h.Ctx, &types.MsgAddAllowedStakingTxHashes{
+			Authority:          datagen.GenRandomAccount().Address,
+			StakingTxHashesHex: txHashesHex,
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+		// duplicated staking tx hashes are rejected
+		_, err = h.MsgServer.AddAllowedStakingTxHashes(h.Ctx, &types.MsgAddAllowedStakingTxHashes{
+			Authority:          appparams.AccGov.String(),
+			StakingTxHashesHex: append(txHashesHex, txHashesHex[0]),
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+
+		_, err = h.MsgServer.AddAllowedStakingTxHashes(h.Ctx, &types.MsgAddAllowedStakingTxHashes{
+			Authority:          appparams.AccGov.String(),
+			StakingTxHashesHex: txHashesHex,
+		})
+		require.NoError(t, err)
+		for _, txHashHex := range txHashesHex {
+			res, err := h.BTCStakingKeeper.AllowedStakingTxHash(h.Ctx, &types.QueryAllowedStakingTxHashRequest{
+				StakingTxHashHex: txHashHex,
+			})
+			require.NoError(t, err)
+			require.True(t, res.Allowed)
+		}
+
+		// remove a random subset of the staking tx hashes
+		numRemoved := int(datagen.RandomInt(r, numTxHashes-1) + 1)
+		_, err = h.MsgServer.RemoveAllowedStakingTxHashes(h.Ctx, &types.MsgRemoveAllowedStakingTxHashes{
+			Authority:          appparams.AccGov.String(),
+			StakingTxHashesHex: txHashesHex[:numRemoved],
+		})
+		require.NoError(t, err)
+		for i, txHashHex := range txHashesHex {
+			res, err := h.BTCStakingKeeper.AllowedStakingTxHash(h.Ctx, &types.QueryAllowedStakingTxHashRequest{
+				StakingTxHashHex: txHashHex,
+			})
+			require.NoError(t, err)
+			require.Equal(t, i >= numRemoved, res.Allowed)
+		}
+
+		// page through the allow list
+		remaining := map[string]struct{}{}
+		var nextKey []byte
+		for {
+			res, err := h.BTCStakingKeeper.AllowedStakingTxHashes(h.Ctx, &types.QueryAllowedStakingTxHashesRequest{
+				Pagination: &query.PageRequest{Key: nextKey, Limit: 3},
+			})
+			require.NoError(t, err)
+			for _, txHashHex := range res.StakingTxHashesHex {
+				remaining[txHashHex] = struct{}{}
+			}
+			nextKey = res.Pagination.NextKey
+			if nextKey == nil {
+				break
+			}
+		}
+		require.Len(t, remaining, numTxHashes-numRemoved)
+		for _, txHashHex := range txHashesHex[numRemoved:] {
+			require.Contains(t, remaining, txHashHex)
+		}
+	})
+}
+
 func FuzzDeterminismBtcstakingBeginBlocker(f *testing.F) {
 	// less seeds than usual as this is pretty long running test
 	datagen.AddRandomSeedsToFuzzer(f, 5)
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// BuildSlashingTxsWithWitness uses the given SK of a slashed finality provider
+// to build the fully signed slashing txs of its active BTC delegations and its
+// BTC delegations that are unbonded early, such that the slashing txs can be
+// submitted to Bitcoin. The BTC delegations are paginated by their stakers
+func (k Keeper) BuildSlashingTxsWithWitness(
+	ctx context.Context,
+	fpSK *btcec.PrivateKey,
+	pagination *query.PageRequest,
+) ([]*types.BTCDelegationSlashingTxs, *query.PageResponse, error) {
+	fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpSK.PubKey())
+	fp, err := k.GetFinalityProvider(ctx, *fpBTCPK)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !fp.IsSlashed() {
+		return nil, nil, types.ErrFpNotSlashed.Wrapf("finality provider: %s", fpBTCPK.MarshalHex())
+	}
+
+	btcHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+
+	slashingTxs := []*types.BTCDelegationSlashingTxs{}
+	btcDelStore := k.btcDelegatorFpStore(ctx, fpBTCPK)
+	pageRes, err := query.Paginate(btcDelStore, pagination, func(key, value []byte) error {
+		delBTCPK, err := bbn.NewBIP340PubKey(key)
+		if err != nil {
+			return err
+		}
+
+		for _, btcDel := range k.getBTCDelegatorDelegations(ctx, fpBTCPK, delBTCPK).Dels {
+			params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+			if params == nil {
+				return types.ErrParamsNotFound.Wrapf("params version: %d", btcDel.ParamsVersion)
+			}
+			status := btcDel.GetStatus(btcHeight, params.CovenantQuorum)
+			delSlashingTxs, err := btcDel.BuildSlashingTxsWithWitness(status, params, k.btcNet, fpSK)
+			if err != nil {
+				return err
+			}
+			if delSlashingTxs != nil {
+				slashingTxs = append(slashingTxs, delSlashingTxs)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return slashingTxs, pageRes, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	btctest "github.com/babylonlabs-io/babylon/testutil/bitcoin"
+	testutil "github.com/babylonlabs-io/babylon/testutil/btcstaking-helper"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+func FuzzBuildSlashingTxsWithWitness(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock BTC light client and BTC checkpoint modules
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper)
+
+		// set all parameters
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+
+		changeAddress, err := datagen.GenRandomBTCAddress(r, h.Net)
+		require.NoError(t, err)
+
+		// generate and insert new finality provider
+		fpSK, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// createActiveDelegation creates an active BTC delegation to the
+		// finality provider
+		createActiveDelegation := func() (string, *testutil.UnbondingTxInfo) {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			h.NoError(err)
+			stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, unbondingInfo, err := h.CreateDelegationWithBtcBlockHeight(
+				r,
+				delSK,
+				fpPK,
+				changeAddress.EncodeAddress(),
+				int64(2*10e8),
+				1000,
+				0,
+				0,
+				true,
+				false,
+				10,
+				10,
+			)
+			h.NoError(err)
+			h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel, 10)
+			h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof, 30)
+			return stakingTxHash, unbondingInfo
+		}
+
+		// an active BTC delegation and a BTC delegation unbonded early
+		activeStakingTxHash, _ := createActiveDelegation()
+		unbondedStakingTxHash, unbondingInfo := createActiveDelegation()
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30}).AnyTimes()
+		unbondedDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, unbondedStakingTxHash)
+		h.NoError(err)
+		_, err = h.MsgServer.BTCUndelegate(h.Ctx, &types.MsgBTCUndelegate{
+			Signer:                        datagen.GenRandomAccount().Address,
+			StakingTxHash:                 unbondedStakingTxHash,
+			StakeSpendingTx:               unbondedDel.BtcUndelegation.UnbondingTx,
+			StakeSpendingTxInclusionProof: unbondingInfo.UnbondingTxInclusionProof,
+		})
+		h.NoError(err)
+
+		// the slashing txs cannot be built before the finality provider is slashed
+		_, _, err = h.BTCStakingKeeper.BuildSlashingTxsWithWitness(h.Ctx, fpSK, nil)
+		require.ErrorIs(t, err, types.ErrFpNotSlashed)
+
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, bbn.NewBIP340PubKeyFromBTCPK(fpPK).MustMarshal())
+		h.NoError(err)
+
+		slashingTxs, _, err := h.BTCStakingKeeper.BuildSlashingTxsWithWitness(h.Ctx, fpSK, nil)
+		h.NoError(err)
+		require.Len(t, slashingTxs, 2)
+
+		// assertSlashingTxsExecution asserts the given slashing txs can spend
+		// the staking and unbonding outputs of the given BTC delegation
+		assertSlashingTxsExecution := func(delSlashingTxs *types.BTCDelegationSlashingTxs, expectSlashingTx bool) {
+			btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, delSlashingTxs.StakingTxHash)
+			h.NoError(err)
+			if expectSlashingTx {
+				stakingInfo, err := btcDel.GetStakingInfo(&bsParams, h.Net)
+				h.NoError(err)
+				btctest.AssertSlashingTxExecution(t, stakingInfo.StakingOutput, delSlashingTxs.SlashingTx)
+			} else {
+				require.Nil(t, delSlashingTxs.SlashingTx)
+			}
+			unbondingInfo, err := btcDel.GetUnbondingInfo(&bsParams, h.Net)
+			h.NoError(err)
+			btctest.AssertSlashingTxExecution(t, unbondingInfo.UnbondingOutput, delSlashingTxs.UnbondingSlashingTx)
+		}
+
+		for _, delSlashingTxs := range slashingTxs {
+			switch delSlashingTxs.StakingTxHash {
+			case activeStakingTxHash:
+				require.Equal(t, types.BTCDelegationStatus_ACTIVE, delSlashingTxs.Status)
+				assertSlashingTxsExecution(delSlashingTxs, true)
+			case unbondedStakingTxHash:
+				require.Equal(t, types.BTCDelegationStatus_UNBONDED, delSlashingTxs.Status)
+				assertSlashingTxsExecution(delSlashingTxs, false)
+			default:
+				t.Fatalf("unexpected BTC delegation %s", delSlashingTxs.StakingTxHash)
+			}
+		}
+
+		// the slashing txs cannot be built with another SK
+		otherSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, _, err = h.BTCStakingKeeper.BuildSlashingTxsWithWitness(h.Ctx, otherSK, nil)
+		require.ErrorIs(t, err, types.ErrFpNotFound)
+	})
+}
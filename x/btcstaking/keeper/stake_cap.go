@@ -0,0 +1,335 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// activeStakeExpiryKey returns the key of the given BTC delegation in the
+// active stake expiry index. The BTC delegation expires at the same BTC height
+// as its expired event in the voting power distribution, i.e., EndHeight-w
+func activeStakeExpiryKey(btcDel *types.BTCDelegation) collections.Pair[uint32, []byte] {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	return collections.Join(btcDel.EndHeight-btcDel.UnbondingTime, stakingTxHash[:])
+}
+
+// getTotalStakedSat returns the satoshis staked in all active BTC delegations
+func (k Keeper) getTotalStakedSat(ctx context.Context) (uint64, error) {
+	stakedSat, err := k.TotalStakedSat.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return stakedSat, err
+}
+
+// getFpStakedSat returns the satoshis staked in the active BTC delegations
+// restaked to the given finality provider
+func (k Keeper) getFpStakedSat(ctx context.Context, fpBTCPK []byte) (uint64, error) {
+	stakedSat, err := k.FpStakedSat.Get(ctx, fpBTCPK)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return stakedSat, err
+}
+
+// isStakeActive returns whether the stake of the given BTC delegation is
+// counted towards the stake caps, i.e., it is active or reserved
+func (k Keeper) isStakeActive(ctx context.Context, btcDel *types.BTCDelegation) (bool, error) {
+	return k.ActiveStakeExpiry.Has(ctx, activeStakeExpiryKey(btcDel))
+}
+
+// totalStakeCapacity returns the satoshis staked in all active BTC
+// delegations under the total stake cap
+func (k Keeper) totalStakeCapacity(ctx context.Context) (types.StakeCapacity, error) {
+	stakedSat, err := k.getTotalStakedSat(ctx)
+	if err != nil {
+		return types.StakeCapacity{}, err
+	}
+	return types.NewStakeCapacity(stakedSat, k.GetParams(ctx).MaxTotalStakedSat), nil
+}
+
+// fpStakeCapacity returns the satoshis staked to the given finality provider
+// under the per finality provider stake cap
+func (k Keeper) fpStakeCapacity(ctx context.Context, fp *types.FinalityProvider) (types.FinalityProviderStakeCapacity, error) {
+	stakedSat, err := k.getFpStakedSat(ctx, *fp.BtcPk)
+	if err != nil {
+		return types.FinalityProviderStakeCapacity{}, err
+	}
+	return types.FinalityProviderStakeCapacity{
+		FpBtcPkHex:         fp.BtcPk.MarshalHex(),
+		Capacity:           types.NewStakeCapacity(stakedSat, k.GetParams(ctx).MaxFpStakedSat),
+		AcceptsDelegations: !fp.IsSlashed() && !fp.IsRetired(),
+	}, nil
+}
+
+// ensureWithinStakeCaps returns an error if the given BTC delegation cannot
+// become active without exceeding the total stake cap or the stake cap of any
+// of its finality providers. If the BTC delegation is a successor, the stake
+// of the BTC delegation it succeeds is released upon its activation, thus does
+// not count towards the stake caps
+func (k Keeper) ensureWithinStakeCaps(ctx context.Context, btcDel *types.BTCDelegation) error {
+	var releasedDel *types.BTCDelegation
+	if btcDel.IsSuccessor() {
+		prevDel, err := k.GetBTCDelegation(ctx, btcDel.PrevStakingTxHash)
+		if err != nil {
+			return err
+		}
+		isActive, err := k.isStakeActive(ctx, prevDel)
+		if err != nil {
+			return err
+		}
+		if isActive {
+			releasedDel = prevDel
+		}
+	}
+
+	return k.ensureStakeWithinCaps(ctx, btcDel.FpBtcPkList, btcDel.TotalSat, releasedDel)
+}
+
+// ensureStakeWithinCaps returns an error if the given satoshis restaked to
+// the given finality providers exceed the total stake cap or the stake cap
+// of any of the finality providers, assuming the stake of the given released
+// BTC delegation, if not nil, is released at the same time
+func (k Keeper) ensureStakeWithinCaps(
+	ctx context.Context,
+	fpBTCPKs []bbn.BIP340PubKey,
+	stakingValueSat uint64,
+	releasedDel *types.BTCDelegation,
+) error {
+	params := k.GetParams(ctx)
+
+	if params.MaxTotalStakedSat > 0 {
+		stakedSat, err := k.getTotalStakedSat(ctx)
+		if err != nil {
+			return err
+		}
+		if releasedDel != nil {
+			stakedSat -= releasedDel.TotalSat
+		}
+		if stakedSat+stakingValueSat > params.MaxTotalStakedSat {
+			return types.ErrStakeCapExceeded.Wrapf(
+				"staked: %d sat, BTC delegation: %d sat, total stake cap: %d sat",
+				stakedSat, stakingValueSat, params.MaxTotalStakedSat)
+		}
+	}
+
+	if params.MaxFpStakedSat > 0 {
+		for i := range fpBTCPKs {
+			fpBTCPK := fpBTCPKs[i]
+			stakedSat, err := k.getFpStakedSat(ctx, fpBTCPK)
+			if err != nil {
+				return err
+			}
+			if releasedDel != nil && releasedDel.GetFpIdx(&fpBTCPK) != -1 {
+				stakedSat -= releasedDel.TotalSat
+			}
+			if stakedSat+stakingValueSat > params.MaxFpStakedSat {
+				return types.ErrStakeCapExceeded.Wrapf(
+					"finality provider: %s, staked: %d sat, BTC delegation: %d sat, finality provider stake cap: %d sat",
+					fpBTCPK.MarshalHex(), stakedSat, stakingValueSat, params.MaxFpStakedSat)
+			}
+		}
+	}
+
+	return nil
+}
+
+// addActiveStake counts the stake of the given newly active BTC delegation
+// towards the stake caps until it expires, is unbonded early or is slashed.
+// It is a no-op if the stake is already counted, e.g., it is reserved upon
+// the creation of the BTC delegation
+func (k Keeper) addActiveStake(ctx context.Context, btcDel *types.BTCDelegation) error {
+	key := activeStakeExpiryKey(btcDel)
+	isActive, err := k.ActiveStakeExpiry.Has(ctx, key)
+	if err != nil || isActive {
+		return err
+	}
+	if err := k.ActiveStakeExpiry.Set(ctx, key); err != nil {
+		return err
+	}
+	return k.updateStakedSat(ctx, btcDel, true)
+}
+
+// reserveStake counts the stake of the given newly created BTC delegation
+// towards the stake caps if it comes with an inclusion proof. Such a BTC
+// delegation becomes active once it receives a covenant quorum, and the
+// signatures of the covenant members cannot be rejected because of the stake
+// of other BTC delegations. Reserving its stake upon creation ensures the BTC
+// delegations waiting for a covenant quorum cannot exceed the stake caps
+// altogether. The reserved stake is released in the same way as the active
+// stake
+func (k Keeper) reserveStake(ctx context.Context, btcDel *types.BTCDelegation) error {
+	if !btcDel.HasInclusionProof() {
+		return nil
+	}
+	return k.addActiveStake(ctx, btcDel)
+}
+
+// releaseActiveStake stops counting the stake of the given BTC delegation
+// towards the stake caps. It is a no-op if the stake is not counted, e.g.,
+// the BTC delegation never became active or is already released
+func (k Keeper) releaseActiveStake(ctx context.Context, btcDel *types.BTCDelegation) error {
+	key := activeStakeExpiryKey(btcDel)
+	isActive, err := k.ActiveStakeExpiry.Has(ctx, key)
+	if err != nil || !isActive {
+		return err
+	}
+	if err := k.ActiveStakeExpiry.Remove(ctx, key); err != nil {
+		return err
+	}
+	return k.updateStakedSat(ctx, btcDel, false)
+}
+
+// updateStakedSat adds the stake of the given BTC delegation to, or removes
+// it from, the total staked satoshis and the staked satoshis of each of its
+// finality providers
+func (k Keeper) updateStakedSat(ctx context.Context, btcDel *types.BTCDelegation, add bool) error {
+	update := func(stakedSat uint64) (uint64, error) {
+		if add {
+			return stakedSat + btcDel.TotalSat, nil
+		}
+		if stakedSat < btcDel.TotalSat {
+			return 0, fmt.Errorf("staked satoshis %d is less than the released stake %d", stakedSat, btcDel.TotalSat)
+		}
+		return stakedSat - btcDel.TotalSat, nil
+	}
+
+	totalStakedSat, err := k.getTotalStakedSat(ctx)
+	if err != nil {
+		return err
+	}
+	if totalStakedSat, err = update(totalStakedSat); err != nil {
+		return err
+	}
+	if err := k.TotalStakedSat.Set(ctx, totalStakedSat); err != nil {
+		return err
+	}
+
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		fpStakedSat, err := k.getFpStakedSat(ctx, fpBTCPK)
+		if err != nil {
+			return err
+		}
+		if fpStakedSat, err = update(fpStakedSat); err != nil {
+			return err
+		}
+		// keep the store clean from finality providers without stake
+		if fpStakedSat == 0 {
+			if err := k.FpStakedSat.Remove(ctx, fpBTCPK); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.FpStakedSat.Set(ctx, fpBTCPK, fpStakedSat); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// initActiveStake counts the stake of the given BTC delegation towards the
+// stake caps if it is active or reserved, and not slashed. This is used for
+// rebuilding the stake caps state from the genesis BTC delegations, and the
+// stake of the ones that already expired is released in the next
+// `BeginBlocker`
+func (k Keeper) initActiveStake(ctx context.Context, btcDel *types.BTCDelegation) error {
+	params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+	if params == nil {
+		return types.ErrParamsNotFound.Wrapf("params version: %d", btcDel.ParamsVersion)
+	}
+	if btcDel.IsUnbondedEarly() || !btcDel.HasInclusionProof() {
+		return nil
+	}
+	if err := k.ensureFpsNotSlashed(ctx, btcDel); err != nil {
+		if errors.Is(err, types.ErrFpAlreadySlashed) {
+			return nil
+		}
+		return err
+	}
+	return k.addActiveStake(ctx, btcDel)
+}
+
+// ReleaseExpiredStake releases the stake of the active BTC delegations that
+// expire at or before the BTC height indexed at the current Babylon height.
+// This is called in `BeginBlocker` after `IndexBTCHeight`
+func (k Keeper) ReleaseExpiredStake(ctx context.Context) error {
+	btcHeight, ok := k.getIndexedBTCHeight(ctx)
+	if !ok {
+		// the BTC height is not indexed, i.e., there is no BTC tip yet
+		return nil
+	}
+
+	// collect the expired keys first since the index cannot be modified
+	// while iterating over it
+	rng := collections.NewPrefixUntilPairRange[uint32, []byte](btcHeight)
+	expiredKeys, err := k.ActiveStakeExpiry.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := expiredKeys.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		stakingTxHash, err := chainhash.NewHash(key.K2())
+		if err != nil {
+			return err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+		}
+		if err := k.releaseActiveStake(ctx, btcDel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseSlashedStake releases the stake of all active BTC delegations
+// restaked to the given slashed finality provider. The BTC delegations are
+// slashed together with the finality provider, thus their stake no longer
+// counts towards the stake caps of any of their finality providers
+func (k Keeper) releaseSlashedStake(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) error {
+	// collect the BTC delegations first since the BTC delegator store cannot
+	// be iterated over while releasing the stake
+	btcDels := []*types.BTCDelegation{}
+	store := k.btcDelegatorFpStore(ctx, fpBTCPK)
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		var btcDelIndex types.BTCDelegatorDelegationIndex
+		k.cdc.MustUnmarshal(iter.Value(), &btcDelIndex)
+		for _, stakingTxHashBytes := range btcDelIndex.StakingTxHashList {
+			stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+			if err != nil {
+				iter.Close()
+				return err
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			if btcDel == nil {
+				iter.Close()
+				return types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+			}
+			btcDels = append(btcDels, btcDel)
+		}
+	}
+	iter.Close()
+
+	for _, btcDel := range btcDels {
+		if err := k.releaseActiveStake(ctx, btcDel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
+)
+
+// validateBTCDelegation runs the checks of `CreateBTCDelegation` on the given
+// message against the current state without writing to it, and returns the
+// failure of every failing rule. Rules that depend on the outcome of a failed
+// rule are skipped, e.g., the staking tx cannot be validated against the
+// params if the params cannot be determined
+func (k Keeper) validateBTCDelegation(
+	ctx sdk.Context,
+	req *types.MsgCreateBTCDelegation,
+) []types.BTCDelegationValidationFailure {
+	failures := []types.BTCDelegationValidationFailure{}
+	fail := func(rule string, err error) {
+		failures = append(failures, types.NewBTCDelegationValidationFailure(rule, err))
+	}
+
+	// 1. parse the message into better domain format. None of the other
+	// rules can be checked without it
+	parsedMsg, err := types.ParseCreateDelegationMessage(req)
+	if err != nil {
+		fail(types.RuleParseMessage, sdkerrors.ErrInvalidRequest.Wrap(err.Error()))
+		return failures
+	}
+
+	// 2. verify proof of possession
+	if err := parsedMsg.ParsedPop.Verify(parsedMsg.StakerAddress, parsedMsg.StakerPK.BIP340PubKey, k.btcNet); err != nil {
+		fail(types.RuleProofOfPossession, types.ErrInvalidProofOfPossession.Wrap(err.Error()))
+	}
+
+	// 3. check the staking tx is not duplicated
+	stakingTxHash := parsedMsg.StakingTx.Transaction.TxHash()
+	if k.getBTCDelegation(ctx, stakingTxHash) != nil {
+		fail(types.RuleStakingTxNotDuplicated, types.ErrReusedStakingTx.Wrapf("duplicated tx hash: %s", stakingTxHash.String()))
+	}
+
+	// 4. check each finality provider accepts the delegation
+	for _, fpBTCPK := range parsedMsg.FinalityProviderKeys.PublicKeysBbnFormat {
+		if err := k.ensureFpAcceptsDelegation(ctx, fpBTCPK); err != nil {
+			fail(types.RuleFinalityProvider, err)
+		}
+	}
+
+	// 5. get params for the inclusion height or the tip height. The
+	// remaining rules depend on the params
+	_, params, _, err := k.getTimeInfoAndParams(ctx, parsedMsg)
+	if err != nil {
+		fail(types.RuleParams, err)
+		return failures
+	}
+
+	// 6. validate the delegation against the params
+	if err := types.ValidateUnbondingTimeAgainstTheParams(parsedMsg, params); err != nil {
+		fail(types.RuleUnbondingTime, err)
+	}
+	stakingOutputIdx, err := types.ValidateStakingTxAgainstTheParams(parsedMsg, params, k.btcNet)
+	if err != nil {
+		fail(types.RuleStakingTx, err)
+	} else if err := types.ValidateUnbondingTxAgainstTheParams(parsedMsg, params, k.btcNet, stakingOutputIdx); err != nil {
+		// the unbonding tx can only be validated against a valid staking output
+		fail(types.RuleUnbondingTx, err)
+	}
+
+	// 7. check the allow list if enabled
+	if k.isAllowListEnabled(ctx, params) && !k.IsStakingTransactionAllowed(ctx, &stakingTxHash) {
+		fail(types.RuleAllowList, types.ErrInvalidStakingTx.Wrapf("staking tx hash: %s, is not in the allow list", stakingTxHash.String()))
+	}
+
+	// 8. check the stake caps against the current active stake if the staking
+	// tx is included on BTC, as in CreateBTCDelegation. Otherwise, the caps
+	// are enforced upon MsgAddBTCDelegationInclusionProof
+	if parsedMsg.IsIncludedOnBTC() {
+		if err := k.ensureStakeWithinCaps(ctx, parsedMsg.FinalityProviderKeys.PublicKeysBbnFormat, uint64(parsedMsg.StakingValue), nil); err != nil {
+			fail(types.RuleStakeCap, err)
+		}
+	}
+
+	return failures
+}
@@ -94,6 +94,34 @@ func (d *BTCDelegation) IsUnbondedEarly() bool {
 	return d.BtcUndelegation.DelegatorUnbondingInfo != nil
 }
 
+// IsUnbondedViaUnbondingTx returns whether the BTC delegation is unbonded
+// early via its registered unbonding tx, rather than via another tx spending
+// the staking output
+func (d *BTCDelegation) IsUnbondedViaUnbondingTx() bool {
+	return d.IsUnbondedEarly() && len(d.BtcUndelegation.DelegatorUnbondingInfo.SpendStakeTx) == 0
+}
+
+// IsSuccessor returns whether the BTC delegation succeeds a previous BTC
+// delegation, i.e., it is created via MsgExtendBTCDelegation or
+// MsgRedelegateBTCDelegation
+func (d *BTCDelegation) IsSuccessor() bool {
+	return len(d.PrevStakingTxHash) > 0
+}
+
+// HasSameFinalityProviders returns whether the BTC delegation is restaked to
+// exactly the given finality providers, regardless of their order
+func (d *BTCDelegation) HasSameFinalityProviders(fpBtcPkList []bbn.BIP340PubKey) bool {
+	if len(d.FpBtcPkList) != len(fpBtcPkList) {
+		return false
+	}
+	for i := range fpBtcPkList {
+		if d.GetFpIdx(&fpBtcPkList[i]) == -1 {
+			return false
+		}
+	}
+	return true
+}
+
 func (d *BTCDelegation) FinalityProviderKeys() []string {
 	var fpPks = make([]string, len(d.FpBtcPkList))
 
@@ -231,8 +259,20 @@ func (d *BTCDelegation) ValidateBasic() error {
 // - adaptor signatures on slashing tx
 // - Schnorr signatures on unbonding tx
 // - adaptor signatrues on unbonding slashing tx
+// - Schnorr signatures on the staking tx spending the staking output of the
+// previous BTC delegation, if the BTC delegation is a successor
 func (d *BTCDelegation) HasCovenantQuorums(quorum uint32) bool {
-	return len(d.CovenantSigs) >= int(quorum) && d.BtcUndelegation.HasCovenantQuorums(quorum)
+	return len(d.CovenantSigs) >= int(quorum) &&
+		d.BtcUndelegation.HasCovenantQuorums(quorum) &&
+		d.HasCovenantQuorumOnTransition(quorum)
+}
+
+// HasCovenantQuorumOnTransition returns whether a successor BTC delegation has
+// a quorum number of covenant signatures on its staking tx, which spends the
+// staking output of the previous BTC delegation through its unbonding path.
+// It is always true for a BTC delegation that is not a successor
+func (d *BTCDelegation) HasCovenantQuorumOnTransition(quorum uint32) bool {
+	return !d.IsSuccessor() || len(d.CovenantTransitionSigs) >= int(quorum)
 }
 
 // IsSignedByCovMember checks whether the given covenant PK has signed the delegation
@@ -248,7 +288,8 @@ func (d *BTCDelegation) IsSignedByCovMember(covPk *bbn.BIP340PubKey) bool {
 
 // AddCovenantSigs adds signatures on the slashing tx from the given
 // covenant, where each signature is an adaptor signature encrypted by
-// each finality provider's PK this BTC delegation restakes to
+// each finality provider's PK this BTC delegation restakes to, as well as the
+// signature on the transition tx if the BTC delegation is a successor
 // It is up to the caller to ensure that given adaptor signatures are valid or
 // that they were not added before
 func (d *BTCDelegation) AddCovenantSigs(
@@ -256,6 +297,7 @@ func (d *BTCDelegation) AddCovenantSigs(
 	stakingSlashingSigs []asig.AdaptorSignature,
 	unbondingSig *bbn.BIP340Signature,
 	unbondingSlashingSigs []asig.AdaptorSignature,
+	transitionSig *bbn.BIP340Signature,
 ) {
 	adaptorSigs := make([][]byte, 0, len(stakingSlashingSigs))
 	for _, s := range stakingSlashingSigs {
@@ -266,6 +308,10 @@ func (d *BTCDelegation) AddCovenantSigs(
 	d.CovenantSigs = append(d.CovenantSigs, covSigs)
 	// add unbonding sig and unbonding slashing adaptor sig
 	d.BtcUndelegation.addCovenantSigs(covPk, unbondingSig, unbondingSlashingSigs)
+	// add the sig on the transition tx spending the previous staking output
+	if transitionSig != nil {
+		d.CovenantTransitionSigs = append(d.CovenantTransitionSigs, &SignatureInfo{Pk: covPk, Sig: transitionSig})
+	}
 }
 
 // GetStakingInfo returns the staking info of the BTC delegation
@@ -476,6 +522,53 @@ func (d *BTCDelegation) BuildUnbondingSlashingTxWithWitness(bsParams *Params, bt
 	return slashingMsgTxWithWitness, nil
 }
 
+// BTCDelegationSlashingTxs is the fully signed slashing txs of a BTC
+// delegation restaked to a slashed finality provider, which can be submitted
+// to Bitcoin. A slashing tx is nil if the output it spends is not slashable
+type BTCDelegationSlashingTxs struct {
+	StakingTxHash       string
+	Status              BTCDelegationStatus
+	SlashingTx          *wire.MsgTx
+	UnbondingSlashingTx *wire.MsgTx
+}
+
+// BuildSlashingTxsWithWitness uses the given finality provider's SK to
+// complete the signatures on the slashing txs of the BTC delegation with the
+// given status. An active BTC delegation can be slashed via both the staking
+// tx and the unbonding tx, while a BTC delegation that is unbonded early via
+// its unbonding tx can only be slashed via the unbonding tx. It returns nil
+// for other BTC delegations, as they are not slashable
+func (d *BTCDelegation) BuildSlashingTxsWithWitness(
+	status BTCDelegationStatus,
+	bsParams *Params,
+	btcNet *chaincfg.Params,
+	fpSK *btcec.PrivateKey,
+) (*BTCDelegationSlashingTxs, error) {
+	isActive := status == BTCDelegationStatus_ACTIVE
+	if !isActive && !d.IsUnbondedViaUnbondingTx() {
+		return nil, nil
+	}
+
+	slashingTxs := &BTCDelegationSlashingTxs{
+		StakingTxHash: d.MustGetStakingTxHash().String(),
+		Status:        status,
+	}
+	if isActive {
+		slashingTx, err := d.BuildSlashingTxWithWitness(bsParams, btcNet, fpSK)
+		if err != nil {
+			return nil, err
+		}
+		slashingTxs.SlashingTx = slashingTx
+	}
+	unbondingSlashingTx, err := d.BuildUnbondingSlashingTxWithWitness(bsParams, btcNet, fpSK)
+	if err != nil {
+		return nil, err
+	}
+	slashingTxs.UnbondingSlashingTx = unbondingSlashingTx
+
+	return slashingTxs, nil
+}
+
 func NewBTCDelegatorDelegationIndex() *BTCDelegatorDelegationIndex {
 	return &BTCDelegatorDelegationIndex{
 		StakingTxHashList: [][]byte{},
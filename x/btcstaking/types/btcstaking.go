@@ -17,6 +17,10 @@ func (fp *FinalityProvider) IsJailed() bool {
 	return fp.Jailed
 }
 
+func (fp *FinalityProvider) IsRetired() bool {
+	return fp.RetiredBabylonHeight > 0
+}
+
 // Address returns the bech32 fp address
 func (fp *FinalityProvider) Address() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(fp.Addr)
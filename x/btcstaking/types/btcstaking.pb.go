@@ -12,15 +12,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -111,6 +115,15 @@ type FinalityProvider struct {
 	// highest_voted_height is the highest height for which the
 	// finality provider has voted
 	HighestVotedHeight uint32 `protobuf:"varint,9,opt,name=highest_voted_height,json=highestVotedHeight,proto3" json:"highest_voted_height,omitempty"`
+	// retired_babylon_height indicates the Babylon height when
+	// the finality provider announced its retirement.
+	// if it's 0 then the finality provider is not retired
+	RetiredBabylonHeight uint64 `protobuf:"varint,10,opt,name=retired_babylon_height,json=retiredBabylonHeight,proto3" json:"retired_babylon_height,omitempty"`
+	// commission_info defines the limits on changing the commission rate
+	// of the finality provider.
+	// finality providers registered before the limits were introduced are
+	// assigned the default legacy limits
+	CommissionInfo *CommissionInfo `protobuf:"bytes,11,opt,name=commission_info,json=commissionInfo,proto3" json:"commission_info,omitempty"`
 }
 
 func (m *FinalityProvider) Reset()         { *m = FinalityProvider{} }
@@ -195,6 +208,174 @@ func (m *FinalityProvider) GetHighestVotedHeight() uint32 {
 	return 0
 }
 
+func (m *FinalityProvider) GetRetiredBabylonHeight() uint64 {
+	if m != nil {
+		return m.RetiredBabylonHeight
+	}
+	return 0
+}
+
+func (m *FinalityProvider) GetCommissionInfo() *CommissionInfo {
+	if m != nil {
+		return m.CommissionInfo
+	}
+	return nil
+}
+
+// CommissionRates defines the limits on the commission rate of a finality
+// provider, given upon its registration.
+type CommissionRates struct {
+	// max_rate defines the maximum commission rate which the finality
+	// provider can ever charge, as a fraction.
+	MaxRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=max_rate,json=maxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate"`
+	// max_change_rate defines the maximum daily increase of the finality
+	// provider commission rate, as a fraction.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+}
+
+func (m *CommissionRates) Reset()         { *m = CommissionRates{} }
+func (m *CommissionRates) String() string { return proto.CompactTextString(m) }
+func (*CommissionRates) ProtoMessage()    {}
+func (*CommissionRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{1}
+}
+func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionRates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionRates.Merge(m, src)
+}
+func (m *CommissionRates) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionRates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionRates proto.InternalMessageInfo
+
+// CommissionInfo defines the limits on changing the commission rate of a
+// finality provider.
+type CommissionInfo struct {
+	// max_rate defines the maximum commission rate which the finality
+	// provider can ever charge, as a fraction.
+	MaxRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=max_rate,json=maxRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate"`
+	// max_change_rate defines the maximum daily increase of the finality
+	// provider commission rate, as a fraction.
+	MaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_change_rate"`
+	// update_time is the last time a commission rate change was requested.
+	UpdateTime time.Time `protobuf:"bytes,3,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time"`
+}
+
+func (m *CommissionInfo) Reset()         { *m = CommissionInfo{} }
+func (m *CommissionInfo) String() string { return proto.CompactTextString(m) }
+func (*CommissionInfo) ProtoMessage()    {}
+func (*CommissionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{2}
+}
+func (m *CommissionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionInfo.Merge(m, src)
+}
+func (m *CommissionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionInfo proto.InternalMessageInfo
+
+func (m *CommissionInfo) GetUpdateTime() time.Time {
+	if m != nil {
+		return m.UpdateTime
+	}
+	return time.Time{}
+}
+
+// CommissionChange is a change of the commission rate of a finality provider
+type CommissionChange struct {
+	// commission is the commission rate after the change
+	Commission cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission"`
+	// request_time is the time at which the change was requested
+	RequestTime time.Time `protobuf:"bytes,2,opt,name=request_time,json=requestTime,proto3,stdtime" json:"request_time"`
+	// effective_time is the time from which the change takes effect
+	EffectiveTime time.Time `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *CommissionChange) Reset()         { *m = CommissionChange{} }
+func (m *CommissionChange) String() string { return proto.CompactTextString(m) }
+func (*CommissionChange) ProtoMessage()    {}
+func (*CommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{3}
+}
+func (m *CommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionChange.Merge(m, src)
+}
+func (m *CommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionChange proto.InternalMessageInfo
+
+func (m *CommissionChange) GetRequestTime() time.Time {
+	if m != nil {
+		return m.RequestTime
+	}
+	return time.Time{}
+}
+
+func (m *CommissionChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
 // FinalityProviderWithMeta wraps the FinalityProvider with metadata.
 type FinalityProviderWithMeta struct {
 	// btc_pk is the Bitcoin secp256k1 PK of thisfinality provider
@@ -223,7 +404,7 @@ func (m *FinalityProviderWithMeta) Reset()         { *m = FinalityProviderWithMe
 func (m *FinalityProviderWithMeta) String() string { return proto.CompactTextString(m) }
 func (*FinalityProviderWithMeta) ProtoMessage()    {}
 func (*FinalityProviderWithMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{1}
+	return fileDescriptor_3851ae95ccfaf7db, []int{4}
 }
 func (m *FinalityProviderWithMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,13 +526,22 @@ type BTCDelegation struct {
 	// btc_tip_height is the height of the BTC light client tip at the time of
 	// the delegation creation
 	BtcTipHeight uint32 `protobuf:"varint,17,opt,name=btc_tip_height,json=btcTipHeight,proto3" json:"btc_tip_height,omitempty"`
+	// prev_staking_tx_hash is the staking tx hash of the BTC delegation that
+	// this BTC delegation succeeds. It is empty if this BTC delegation is not
+	// created via MsgExtendBTCDelegation or MsgRedelegateBTCDelegation
+	PrevStakingTxHash string `protobuf:"bytes,18,opt,name=prev_staking_tx_hash,json=prevStakingTxHash,proto3" json:"prev_staking_tx_hash,omitempty"`
+	// covenant_transition_sigs is the list of Schnorr signatures of the covenant
+	// members on the staking tx of this BTC delegation, which spends the staking
+	// output of the previous BTC delegation through its unbonding path. It is
+	// empty if this BTC delegation is not a successor
+	CovenantTransitionSigs []*SignatureInfo `protobuf:"bytes,19,rep,name=covenant_transition_sigs,json=covenantTransitionSigs,proto3" json:"covenant_transition_sigs,omitempty"`
 }
 
 func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
 func (m *BTCDelegation) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegation) ProtoMessage()    {}
 func (*BTCDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{2}
+	return fileDescriptor_3851ae95ccfaf7db, []int{5}
 }
 func (m *BTCDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,6 +661,20 @@ func (m *BTCDelegation) GetBtcTipHeight() uint32 {
 	return 0
 }
 
+func (m *BTCDelegation) GetPrevStakingTxHash() string {
+	if m != nil {
+		return m.PrevStakingTxHash
+	}
+	return ""
+}
+
+func (m *BTCDelegation) GetCovenantTransitionSigs() []*SignatureInfo {
+	if m != nil {
+		return m.CovenantTransitionSigs
+	}
+	return nil
+}
+
 // DelegatorUnbondingInfo contains the information about transaction which spent
 // the staking output. It contains:
 // - spend_stake_tx: the transaction which spent the staking output
@@ -485,7 +689,7 @@ func (m *DelegatorUnbondingInfo) Reset()         { *m = DelegatorUnbondingInfo{}
 func (m *DelegatorUnbondingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorUnbondingInfo) ProtoMessage()    {}
 func (*DelegatorUnbondingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{3}
+	return fileDescriptor_3851ae95ccfaf7db, []int{6}
 }
 func (m *DelegatorUnbondingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -552,7 +756,7 @@ func (m *BTCUndelegation) Reset()         { *m = BTCUndelegation{} }
 func (m *BTCUndelegation) String() string { return proto.CompactTextString(m) }
 func (*BTCUndelegation) ProtoMessage()    {}
 func (*BTCUndelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{4}
+	return fileDescriptor_3851ae95ccfaf7db, []int{7}
 }
 func (m *BTCUndelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +822,7 @@ func (m *BTCDelegatorDelegations) Reset()         { *m = BTCDelegatorDelegations
 func (m *BTCDelegatorDelegations) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegations) ProtoMessage()    {}
 func (*BTCDelegatorDelegations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{5}
+	return fileDescriptor_3851ae95ccfaf7db, []int{8}
 }
 func (m *BTCDelegatorDelegations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,7 +867,7 @@ func (m *BTCDelegatorDelegationIndex) Reset()         { *m = BTCDelegatorDelegat
 func (m *BTCDelegatorDelegationIndex) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegationIndex) ProtoMessage()    {}
 func (*BTCDelegatorDelegationIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{6}
+	return fileDescriptor_3851ae95ccfaf7db, []int{9}
 }
 func (m *BTCDelegatorDelegationIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +913,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{7}
+	return fileDescriptor_3851ae95ccfaf7db, []int{10}
 }
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +955,7 @@ func (m *CovenantAdaptorSignatures) Reset()         { *m = CovenantAdaptorSignat
 func (m *CovenantAdaptorSignatures) String() string { return proto.CompactTextString(m) }
 func (*CovenantAdaptorSignatures) ProtoMessage()    {}
 func (*CovenantAdaptorSignatures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{8}
+	return fileDescriptor_3851ae95ccfaf7db, []int{11}
 }
 func (m *CovenantAdaptorSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -810,7 +1014,7 @@ func (m *SelectiveSlashingEvidence) Reset()         { *m = SelectiveSlashingEvid
 func (m *SelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*SelectiveSlashingEvidence) ProtoMessage()    {}
 func (*SelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{9}
+	return fileDescriptor_3851ae95ccfaf7db, []int{12}
 }
 func (m *SelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +1072,7 @@ func (m *InclusionProof) Reset()         { *m = InclusionProof{} }
 func (m *InclusionProof) String() string { return proto.CompactTextString(m) }
 func (*InclusionProof) ProtoMessage()    {}
 func (*InclusionProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{10}
+	return fileDescriptor_3851ae95ccfaf7db, []int{13}
 }
 func (m *InclusionProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -914,6 +1118,9 @@ func (m *InclusionProof) GetProof() []byte {
 func init() {
 	proto.RegisterEnum("babylon.btcstaking.v1.BTCDelegationStatus", BTCDelegationStatus_name, BTCDelegationStatus_value)
 	proto.RegisterType((*FinalityProvider)(nil), "babylon.btcstaking.v1.FinalityProvider")
+	proto.RegisterType((*CommissionRates)(nil), "babylon.btcstaking.v1.CommissionRates")
+	proto.RegisterType((*CommissionInfo)(nil), "babylon.btcstaking.v1.CommissionInfo")
+	proto.RegisterType((*CommissionChange)(nil), "babylon.btcstaking.v1.CommissionChange")
 	proto.RegisterType((*FinalityProviderWithMeta)(nil), "babylon.btcstaking.v1.FinalityProviderWithMeta")
 	proto.RegisterType((*BTCDelegation)(nil), "babylon.btcstaking.v1.BTCDelegation")
 	proto.RegisterType((*DelegatorUnbondingInfo)(nil), "babylon.btcstaking.v1.DelegatorUnbondingInfo")
@@ -931,95 +1138,111 @@ func init() {
 }
 
 var fileDescriptor_3851ae95ccfaf7db = []byte{
-	// 1398 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xdb, 0x46,
-	0x16, 0x36, 0x25, 0xf9, 0xef, 0x48, 0xb2, 0x95, 0x89, 0xd6, 0xab, 0xc4, 0x58, 0xdb, 0xab, 0xcd,
-	0x06, 0x42, 0x1b, 0x4b, 0xb1, 0x13, 0xa0, 0x69, 0x8b, 0x16, 0xb0, 0x2c, 0xa5, 0x11, 0x9a, 0xd8,
-	0x2a, 0x25, 0xbb, 0x3f, 0x40, 0xc1, 0x50, 0xe4, 0x98, 0x9a, 0x4a, 0xe2, 0xb0, 0x9c, 0x91, 0x2a,
-	0x3f, 0x45, 0x5b, 0xa0, 0x0f, 0xd1, 0x07, 0xc8, 0x65, 0x1f, 0x20, 0x97, 0x41, 0xd0, 0x8b, 0xc2,
-	0x17, 0x46, 0x91, 0xbc, 0x48, 0x31, 0xc3, 0x11, 0x49, 0xbb, 0x76, 0xea, 0xc4, 0xbe, 0xe3, 0x9c,
-	0xbf, 0xef, 0xf0, 0x3b, 0xdf, 0xcc, 0x90, 0x70, 0xbb, 0x63, 0x76, 0x0e, 0xfb, 0xd4, 0xad, 0x74,
-	0xb8, 0xc5, 0xb8, 0xd9, 0x23, 0xae, 0x53, 0x19, 0x6d, 0xc4, 0x56, 0x65, 0xcf, 0xa7, 0x9c, 0xa2,
-	0x7f, 0xa9, 0xb8, 0x72, 0xcc, 0x33, 0xda, 0xb8, 0x99, 0x77, 0xa8, 0x43, 0x65, 0x44, 0x45, 0x3c,
-	0x05, 0xc1, 0x37, 0x6f, 0x58, 0x94, 0x0d, 0x28, 0x33, 0x02, 0x47, 0xb0, 0x50, 0xae, 0x5b, 0xc1,
-	0xaa, 0x12, 0x61, 0x75, 0x30, 0x37, 0x37, 0x2a, 0x27, 0xd0, 0x6e, 0xae, 0x9e, 0xdd, 0x95, 0x47,
-	0x3d, 0x15, 0x70, 0x27, 0x16, 0x60, 0x75, 0xb1, 0xd5, 0xf3, 0x28, 0x71, 0xb9, 0xea, 0x3c, 0x32,
-	0x04, 0xd1, 0xc5, 0x5f, 0x52, 0x90, 0x7b, 0x48, 0x5c, 0xb3, 0x4f, 0xf8, 0x61, 0xd3, 0xa7, 0x23,
-	0x62, 0x63, 0x1f, 0xdd, 0x81, 0x94, 0x69, 0xdb, 0x7e, 0x41, 0x5b, 0xd3, 0x4a, 0xf3, 0xd5, 0xc2,
-	0xcb, 0x67, 0xeb, 0x79, 0xd5, 0xe9, 0x96, 0x6d, 0xfb, 0x98, 0xb1, 0x16, 0xf7, 0x89, 0xeb, 0xe8,
-	0x32, 0x0a, 0xd5, 0x21, 0x6d, 0x63, 0x66, 0xf9, 0xc4, 0xe3, 0x84, 0xba, 0x85, 0xc4, 0x9a, 0x56,
-	0x4a, 0x6f, 0xfe, 0xaf, 0xac, 0x32, 0x22, 0x46, 0xe4, 0xdb, 0x94, 0x6b, 0x51, 0xa8, 0x1e, 0xcf,
-	0x43, 0x4f, 0x00, 0x2c, 0x3a, 0x18, 0x10, 0xc6, 0x44, 0x95, 0xa4, 0x84, 0x5e, 0x3f, 0x3a, 0x5e,
-	0x5d, 0x0e, 0x0a, 0x31, 0xbb, 0x57, 0x26, 0xb4, 0x32, 0x30, 0x79, 0xb7, 0xfc, 0x18, 0x3b, 0xa6,
-	0x75, 0x58, 0xc3, 0xd6, 0xcb, 0x67, 0xeb, 0xa0, 0x70, 0x6a, 0xd8, 0xd2, 0x63, 0x05, 0xd0, 0x2e,
-	0xcc, 0x74, 0xb8, 0x65, 0x78, 0xbd, 0x42, 0x6a, 0x4d, 0x2b, 0x65, 0xaa, 0x0f, 0x8e, 0x8e, 0x57,
-	0xef, 0x3b, 0x84, 0x77, 0x87, 0x9d, 0xb2, 0x45, 0x07, 0x15, 0xc5, 0x52, 0xdf, 0xec, 0xb0, 0x75,
-	0x42, 0x27, 0xcb, 0x0a, 0x3f, 0xf4, 0x30, 0x2b, 0x57, 0x1b, 0xcd, 0x7b, 0xf7, 0xef, 0x36, 0x87,
-	0x9d, 0xcf, 0xf1, 0xa1, 0x3e, 0xdd, 0xe1, 0x56, 0xb3, 0x87, 0x3e, 0x81, 0xa4, 0x47, 0xbd, 0xc2,
-	0xb4, 0x7c, 0xbd, 0xf7, 0xcb, 0x67, 0x0e, 0xbd, 0xdc, 0xf4, 0x29, 0x3d, 0xd8, 0x3d, 0x68, 0x52,
-	0xc6, 0xb0, 0xec, 0xa3, 0xda, 0xde, 0xd6, 0x45, 0x1e, 0xba, 0x0f, 0x4b, 0xac, 0x6f, 0xb2, 0x2e,
-	0xb6, 0x0d, 0x95, 0x6a, 0x74, 0x31, 0x71, 0xba, 0xbc, 0x30, 0xb3, 0xa6, 0x95, 0x52, 0x7a, 0x5e,
-	0x79, 0xab, 0x81, 0xf3, 0x91, 0xf4, 0xa1, 0x3b, 0x80, 0xc2, 0x2c, 0x6e, 0x4d, 0x32, 0x66, 0xd7,
-	0xb4, 0x52, 0x56, 0xcf, 0x4d, 0x32, 0xb8, 0xa5, 0xa2, 0x97, 0x60, 0xe6, 0x3b, 0x93, 0xf4, 0xb1,
-	0x5d, 0x98, 0x5b, 0xd3, 0x4a, 0x73, 0xba, 0x5a, 0xa1, 0xbb, 0x90, 0xef, 0x12, 0xa7, 0x8b, 0x19,
-	0x37, 0x46, 0x94, 0x63, 0x7b, 0x52, 0x67, 0x5e, 0xd6, 0x41, 0xca, 0xb7, 0x2f, 0x5c, 0x41, 0xa5,
-	0xe2, 0xef, 0x09, 0x28, 0x9c, 0x96, 0xc5, 0x97, 0x84, 0x77, 0x9f, 0x60, 0x6e, 0xc6, 0xa8, 0xd5,
-	0xae, 0x86, 0xda, 0x25, 0x98, 0x51, 0x1d, 0x25, 0x24, 0x17, 0x6a, 0x85, 0xfe, 0x0b, 0x99, 0x11,
-	0xe5, 0xc4, 0x75, 0x0c, 0x8f, 0xfe, 0x80, 0x7d, 0x29, 0x8a, 0x94, 0x9e, 0x0e, 0x6c, 0x4d, 0x61,
-	0x7a, 0x03, 0xad, 0xa9, 0xb7, 0xa6, 0x75, 0xfa, 0x1f, 0x69, 0x9d, 0xb9, 0x10, 0xad, 0xb3, 0xe7,
-	0xd2, 0xfa, 0xdb, 0x2c, 0x64, 0xab, 0xed, 0xed, 0x1a, 0xee, 0x63, 0xc7, 0x94, 0xaa, 0xff, 0x10,
-	0xd2, 0x42, 0x3e, 0xd8, 0x37, 0x2e, 0xb4, 0xe3, 0x20, 0x08, 0x16, 0xc6, 0xd8, 0x18, 0x12, 0x57,
-	0xaa, 0xf0, 0xe4, 0x3b, 0x2a, 0xfc, 0x5b, 0x58, 0x38, 0xf0, 0x8c, 0xa0, 0x25, 0xa3, 0x4f, 0x98,
-	0x18, 0x41, 0xf2, 0x52, 0x7d, 0xa5, 0x0f, 0xbc, 0xaa, 0xe8, 0xec, 0x31, 0x61, 0x52, 0x0c, 0xaa,
-	0x0d, 0x83, 0x93, 0x01, 0x56, 0xd3, 0x4a, 0x2b, 0x5b, 0x9b, 0x0c, 0xb0, 0x0a, 0xf1, 0x79, 0x7c,
-	0x67, 0x05, 0x21, 0x3e, 0x57, 0xb3, 0xfc, 0x0f, 0x00, 0x76, 0x4f, 0x4d, 0x6a, 0x1e, 0xbb, 0x6a,
-	0x40, 0x68, 0x19, 0xe6, 0x39, 0xe5, 0x66, 0xdf, 0x60, 0x26, 0x97, 0x9b, 0x28, 0xa5, 0xcf, 0x49,
-	0x43, 0xcb, 0x94, 0xb9, 0x61, 0x07, 0x63, 0xb9, 0x79, 0x32, 0xfa, 0xfc, 0x04, 0x7f, 0x2c, 0x45,
-	0xa5, 0xdc, 0x74, 0xc8, 0xbd, 0x21, 0x37, 0x88, 0x3d, 0x2e, 0x80, 0x12, 0x55, 0xe0, 0xd9, 0x95,
-	0x8e, 0x86, 0x3d, 0x46, 0x9b, 0x90, 0x96, 0x42, 0x53, 0xd5, 0xd2, 0x72, 0x84, 0xd7, 0x8e, 0x8e,
-	0x57, 0x85, 0x40, 0x5a, 0xca, 0xd3, 0x1e, 0xeb, 0xc0, 0xc2, 0x67, 0xf4, 0x14, 0xb2, 0x76, 0x20,
-	0x1d, 0xea, 0x1b, 0x8c, 0x38, 0x85, 0x8c, 0xcc, 0xfa, 0xf8, 0xe8, 0x78, 0xf5, 0x83, 0xb7, 0x23,
-	0xb8, 0x45, 0x1c, 0xd7, 0xe4, 0x43, 0x1f, 0xeb, 0x99, 0xb0, 0x62, 0x8b, 0x38, 0x68, 0x0f, 0xb2,
-	0x16, 0x1d, 0x61, 0xd7, 0x74, 0xb9, 0x00, 0x60, 0x85, 0xec, 0x5a, 0xb2, 0x94, 0xde, 0xbc, 0x7b,
-	0x8e, 0x18, 0xb6, 0x55, 0xec, 0x96, 0x6d, 0x7a, 0x41, 0x85, 0xa0, 0x2a, 0xd3, 0x33, 0x93, 0x32,
-	0x2d, 0xe2, 0x30, 0xf4, 0x7f, 0x58, 0x18, 0xba, 0x1d, 0xea, 0xda, 0xe1, 0xf4, 0x16, 0x24, 0x2d,
-	0xd9, 0xd0, 0x2a, 0xe7, 0xf7, 0x05, 0xe4, 0x84, 0x7c, 0x86, 0xae, 0x1d, 0x6e, 0x90, 0xc2, 0xa2,
-	0x54, 0xe3, 0xed, 0x73, 0x1a, 0xa8, 0xb6, 0xb7, 0xf7, 0x62, 0xd1, 0xfa, 0x62, 0x87, 0x5b, 0x71,
-	0x83, 0x40, 0xf6, 0x4c, 0xdf, 0x1c, 0x30, 0x63, 0x84, 0x7d, 0x79, 0xb3, 0xe4, 0x02, 0xe4, 0xc0,
-	0xba, 0x1f, 0x18, 0xd1, 0x2d, 0x58, 0x10, 0xc8, 0x9c, 0x78, 0x13, 0x69, 0x5c, 0x93, 0x61, 0x99,
-	0x0e, 0xb7, 0xda, 0xc4, 0x53, 0xdb, 0xf7, 0x53, 0x58, 0xaa, 0x4d, 0xd8, 0xda, 0x9b, 0x74, 0xde,
-	0x70, 0x0f, 0xa8, 0xc8, 0x67, 0x9e, 0x10, 0x96, 0xdc, 0x9f, 0x62, 0xa0, 0xf2, 0x68, 0xd4, 0x33,
-	0xd2, 0xda, 0x12, 0xc6, 0xf6, 0xb8, 0xf8, 0x73, 0x0a, 0x16, 0x4f, 0x75, 0x2c, 0x34, 0x1b, 0xa3,
-	0x66, 0x92, 0x97, 0x8e, 0x88, 0xf9, 0x9b, 0x54, 0x12, 0x17, 0x91, 0xca, 0xf7, 0xb0, 0x14, 0x93,
-	0xca, 0x24, 0x5b, 0x68, 0x26, 0x79, 0x79, 0xcd, 0xe4, 0x23, 0xcd, 0xa8, 0xca, 0x42, 0x3b, 0x07,
-	0xb0, 0x14, 0x69, 0x27, 0x86, 0xc8, 0xe4, 0x39, 0xf0, 0x2e, 0x22, 0xca, 0x87, 0x22, 0x8a, 0x60,
-	0x18, 0xb2, 0x60, 0x39, 0xc4, 0x89, 0xa8, 0x63, 0xc4, 0x09, 0x0e, 0x9d, 0x69, 0x09, 0x76, 0xeb,
-	0x1c, 0xb0, 0xb0, 0xba, 0x18, 0x9b, 0x5e, 0x98, 0x14, 0x0a, 0xa7, 0xd9, 0x22, 0x8e, 0x3c, 0x6d,
-	0x1c, 0x28, 0x44, 0xfc, 0x45, 0x28, 0xc4, 0x3d, 0xa0, 0xf2, 0x58, 0x49, 0x6f, 0xae, 0x9f, 0x83,
-	0x70, 0xb6, 0x42, 0xf4, 0x68, 0x1c, 0x27, 0xec, 0xc5, 0x16, 0xfc, 0x3b, 0xba, 0x11, 0xa8, 0x1f,
-	0x5d, 0x0d, 0x0c, 0x3d, 0x80, 0x94, 0x8d, 0xfb, 0xac, 0xa0, 0xbd, 0xf1, 0x8d, 0x4e, 0xdc, 0x27,
-	0xba, 0xcc, 0x28, 0xee, 0xc0, 0xf2, 0xd9, 0x45, 0x1b, 0xae, 0x8d, 0xc7, 0xa8, 0x02, 0xf9, 0xe8,
-	0x20, 0x33, 0xba, 0x26, 0xeb, 0x06, 0xd4, 0x09, 0xa0, 0x8c, 0x7e, 0x2d, 0x3c, 0xd2, 0x1e, 0x99,
-	0xac, 0x2b, 0xd8, 0x28, 0xfe, 0xaa, 0x41, 0xf6, 0x04, 0x73, 0xe8, 0x11, 0x24, 0xae, 0xe0, 0xfe,
-	0x4f, 0x78, 0x3d, 0xf4, 0x04, 0x92, 0x42, 0x96, 0x89, 0xcb, 0xcb, 0x52, 0xd4, 0x29, 0xfe, 0xa8,
-	0xc1, 0x8d, 0x73, 0x15, 0x25, 0xee, 0x4c, 0x8b, 0x8e, 0xae, 0xe4, 0xd3, 0xc5, 0xa2, 0xa3, 0x66,
-	0x4f, 0x6c, 0x5f, 0x33, 0x40, 0x09, 0xa4, 0x9e, 0x90, 0x14, 0xa6, 0xcd, 0x10, 0x99, 0x15, 0x9f,
-	0x6b, 0x70, 0xa3, 0x85, 0xfb, 0xd8, 0xe2, 0x64, 0x84, 0x27, 0x4a, 0xae, 0x8b, 0x4f, 0x2a, 0xd7,
-	0xc2, 0xe8, 0x36, 0x2c, 0x9e, 0x9a, 0x45, 0xf0, 0x11, 0xa0, 0x67, 0x4f, 0x8c, 0x01, 0xb5, 0x61,
-	0x3e, 0xbc, 0x5d, 0x2f, 0x7d, 0xe1, 0xcf, 0xaa, 0x8b, 0x15, 0xad, 0xc3, 0x75, 0x1f, 0x8b, 0x4d,
-	0xe0, 0x63, 0xdb, 0x50, 0xf5, 0x59, 0x2f, 0x38, 0x23, 0xf4, 0x5c, 0xe8, 0x7a, 0x28, 0xc2, 0x5b,
-	0xbd, 0x62, 0x07, 0x16, 0x1a, 0xae, 0xd5, 0x1f, 0x8a, 0x33, 0x53, 0x7e, 0x08, 0xa0, 0x8f, 0x20,
-	0xd9, 0xc3, 0x87, 0xb2, 0xe5, 0xf4, 0x66, 0x29, 0x2e, 0xd1, 0xd8, 0xaf, 0xc6, 0x68, 0xa3, 0xdc,
-	0xf6, 0x4d, 0x97, 0x99, 0x96, 0xd0, 0xa0, 0x68, 0x40, 0x24, 0xa1, 0x3c, 0x4c, 0x7b, 0xa2, 0x48,
-	0xf0, 0x3a, 0x7a, 0xb0, 0x78, 0xef, 0x29, 0x5c, 0x3f, 0x21, 0xe9, 0x16, 0x37, 0xf9, 0x90, 0xa1,
-	0x34, 0xcc, 0x36, 0xeb, 0x3b, 0xb5, 0xc6, 0xce, 0x67, 0xb9, 0x29, 0x94, 0x81, 0xb9, 0xfd, 0xba,
-	0xde, 0x78, 0xd8, 0xa8, 0xd7, 0x72, 0x1a, 0x02, 0x98, 0xd9, 0xda, 0x6e, 0x37, 0xf6, 0xeb, 0xb9,
-	0x84, 0xf0, 0xec, 0xed, 0x54, 0x77, 0x77, 0x6a, 0xf5, 0x5a, 0x2e, 0x29, 0x92, 0xea, 0x5f, 0x35,
-	0x1b, 0x7a, 0xbd, 0x96, 0x4b, 0xa1, 0x59, 0x48, 0x6e, 0xed, 0x7c, 0x9d, 0x9b, 0xae, 0xee, 0x3c,
-	0x7f, 0xb5, 0xa2, 0xbd, 0x78, 0xb5, 0xa2, 0xfd, 0xf9, 0x6a, 0x45, 0xfb, 0xe9, 0xf5, 0xca, 0xd4,
-	0x8b, 0xd7, 0x2b, 0x53, 0x7f, 0xbc, 0x5e, 0x99, 0xfa, 0xe6, 0x02, 0x6c, 0x8e, 0xe3, 0x3f, 0x5e,
-	0x92, 0xda, 0xce, 0x8c, 0xfc, 0x95, 0xba, 0xf7, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf0,
-	0x69, 0x33, 0x31, 0x0e, 0x00, 0x00,
+	// 1664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xdd, 0x72, 0x1b, 0x49,
+	0x15, 0xf6, 0x48, 0xf2, 0xdf, 0xd1, 0x8f, 0x95, 0x8e, 0x31, 0x4a, 0x52, 0xd8, 0x46, 0x64, 0x53,
+	0x2e, 0x88, 0xa5, 0xd8, 0x9b, 0x2a, 0x16, 0x28, 0xa8, 0x8a, 0x2c, 0x65, 0xa3, 0xda, 0xc4, 0xd1,
+	0x8e, 0x94, 0xc0, 0x52, 0x05, 0xb3, 0xad, 0x99, 0xd6, 0xa8, 0x91, 0x34, 0x3d, 0x3b, 0xdd, 0x12,
+	0xf2, 0x1d, 0x6f, 0xc0, 0x72, 0xcb, 0x13, 0xf0, 0x00, 0x7b, 0xc5, 0x13, 0xe4, 0x86, 0xaa, 0xad,
+	0x2d, 0x2e, 0xa8, 0x5c, 0x18, 0x2a, 0x79, 0x0c, 0x6e, 0xa8, 0xee, 0x9e, 0x3f, 0x79, 0xe3, 0x10,
+	0x3b, 0xbe, 0xd9, 0x3b, 0xf5, 0xf9, 0xef, 0x73, 0xbe, 0x73, 0x4e, 0x6b, 0xe0, 0x4e, 0x1f, 0xf7,
+	0x4f, 0xc6, 0xcc, 0xab, 0xf7, 0x85, 0xcd, 0x05, 0x1e, 0x51, 0xcf, 0xad, 0xcf, 0x0e, 0x52, 0xa7,
+	0x9a, 0x1f, 0x30, 0xc1, 0xd0, 0xf7, 0x42, 0xb9, 0x5a, 0x8a, 0x33, 0x3b, 0xb8, 0xb9, 0xe9, 0x32,
+	0x97, 0x29, 0x89, 0xba, 0xfc, 0xa5, 0x85, 0x6f, 0xee, 0xb8, 0x8c, 0xb9, 0x63, 0x52, 0x57, 0xa7,
+	0xfe, 0x74, 0x50, 0x17, 0x74, 0x42, 0xb8, 0xc0, 0x13, 0x3f, 0x14, 0xb8, 0x61, 0x33, 0x3e, 0x61,
+	0xdc, 0xd2, 0x9a, 0xfa, 0x10, 0xb2, 0x6e, 0xeb, 0x53, 0x3d, 0x09, 0xa6, 0x4f, 0x04, 0x3e, 0xa8,
+	0x2f, 0x84, 0x73, 0x73, 0xe7, 0xcd, 0x61, 0xfb, 0x2c, 0xf2, 0x70, 0x37, 0x25, 0x60, 0x0f, 0x89,
+	0x3d, 0xf2, 0x19, 0xf5, 0x44, 0x78, 0xb5, 0x84, 0xa0, 0xa5, 0xab, 0x7f, 0x5d, 0x86, 0xf2, 0x43,
+	0xea, 0xe1, 0x31, 0x15, 0x27, 0x9d, 0x80, 0xcd, 0xa8, 0x43, 0x02, 0x74, 0x17, 0x72, 0xd8, 0x71,
+	0x82, 0x8a, 0xb1, 0x6b, 0xec, 0xad, 0x37, 0x2a, 0xdf, 0x7c, 0xb5, 0xbf, 0x19, 0x46, 0xfa, 0xc0,
+	0x71, 0x02, 0xc2, 0x79, 0x57, 0x04, 0xd4, 0x73, 0x4d, 0x25, 0x85, 0x5a, 0x90, 0x77, 0x08, 0xb7,
+	0x03, 0xea, 0x0b, 0xca, 0xbc, 0x4a, 0x66, 0xd7, 0xd8, 0xcb, 0x1f, 0xfe, 0xa8, 0x16, 0x6a, 0x24,
+	0x29, 0x53, 0xb7, 0xa9, 0x35, 0x13, 0x51, 0x33, 0xad, 0x87, 0x9e, 0x00, 0xd8, 0x6c, 0x32, 0xa1,
+	0x9c, 0x4b, 0x2b, 0x59, 0xe5, 0x7a, 0xff, 0xe5, 0xe9, 0xce, 0x2d, 0x6d, 0x88, 0x3b, 0xa3, 0x1a,
+	0x65, 0xf5, 0x09, 0x16, 0xc3, 0xda, 0x63, 0xe2, 0x62, 0xfb, 0xa4, 0x49, 0xec, 0x6f, 0xbe, 0xda,
+	0x87, 0xd0, 0x4f, 0x93, 0xd8, 0x66, 0xca, 0x00, 0x7a, 0x0a, 0x2b, 0x7d, 0x61, 0x5b, 0xfe, 0xa8,
+	0x92, 0xdb, 0x35, 0xf6, 0x0a, 0x8d, 0x8f, 0x5e, 0x9e, 0xee, 0xdc, 0x77, 0xa9, 0x18, 0x4e, 0xfb,
+	0x35, 0x9b, 0x4d, 0xea, 0x61, 0x96, 0xc6, 0xb8, 0xcf, 0xf7, 0x29, 0x8b, 0x8e, 0x75, 0x71, 0xe2,
+	0x13, 0x5e, 0x6b, 0xb4, 0x3b, 0x1f, 0xde, 0xbf, 0xd7, 0x99, 0xf6, 0x3f, 0x21, 0x27, 0xe6, 0x72,
+	0x5f, 0xd8, 0x9d, 0x11, 0xfa, 0x25, 0x64, 0x7d, 0xe6, 0x57, 0x96, 0xd5, 0xf5, 0x7e, 0x52, 0x7b,
+	0x23, 0x2a, 0x6a, 0x9d, 0x80, 0xb1, 0xc1, 0xd3, 0x41, 0x87, 0x71, 0x4e, 0x54, 0x1c, 0x8d, 0xde,
+	0x91, 0x29, 0xf5, 0xd0, 0x7d, 0xd8, 0xe2, 0x63, 0xcc, 0x87, 0xc4, 0xb1, 0x42, 0x55, 0x6b, 0x48,
+	0xa8, 0x3b, 0x14, 0x95, 0x95, 0x5d, 0x63, 0x2f, 0x67, 0x6e, 0x86, 0xdc, 0x86, 0x66, 0x3e, 0x52,
+	0x3c, 0x74, 0x17, 0x50, 0xac, 0x25, 0xec, 0x48, 0x63, 0x75, 0xd7, 0xd8, 0x2b, 0x9a, 0xe5, 0x48,
+	0x43, 0xd8, 0xa1, 0xf4, 0x16, 0xac, 0xfc, 0x01, 0xd3, 0x31, 0x71, 0x2a, 0x6b, 0xbb, 0xc6, 0xde,
+	0x9a, 0x19, 0x9e, 0xd0, 0x3d, 0xd8, 0x1c, 0x52, 0x77, 0x48, 0xb8, 0xb0, 0x66, 0x4c, 0x10, 0x27,
+	0xb2, 0xb3, 0xae, 0xec, 0xa0, 0x90, 0xf7, 0x5c, 0xb2, 0x42, 0x4b, 0xf7, 0x61, 0x2b, 0x20, 0x82,
+	0x06, 0xdf, 0x8e, 0x16, 0x74, 0xb4, 0x21, 0x77, 0x31, 0xda, 0x63, 0xd8, 0x48, 0x2a, 0x60, 0x51,
+	0x6f, 0xc0, 0x2a, 0x79, 0x95, 0xae, 0x0f, 0xce, 0x49, 0xd7, 0x51, 0x2c, 0xdd, 0xf6, 0x06, 0xcc,
+	0x2c, 0xd9, 0x0b, 0xe7, 0xea, 0xdf, 0x0d, 0xd8, 0x48, 0x44, 0x4c, 0x2c, 0x08, 0x47, 0x8f, 0x61,
+	0x6d, 0x82, 0xe7, 0x56, 0x80, 0x05, 0x09, 0xf1, 0x79, 0xf0, 0xe2, 0x74, 0x67, 0xe9, 0x62, 0x40,
+	0x59, 0x9d, 0xe0, 0xb9, 0x34, 0x87, 0x3e, 0x83, 0x0d, 0x69, 0xcd, 0x1e, 0x62, 0xcf, 0x25, 0xda,
+	0x68, 0xe6, 0xb2, 0x46, 0x8b, 0x13, 0x3c, 0x3f, 0x52, 0x86, 0xa4, 0xe9, 0xea, 0x9f, 0x32, 0x50,
+	0x5a, 0xbc, 0xdf, 0x77, 0x26, 0x76, 0xd9, 0xd2, 0x53, 0xdf, 0xc1, 0x82, 0x58, 0x72, 0x7e, 0xa9,
+	0x66, 0xcc, 0x1f, 0xde, 0xac, 0xe9, 0xe1, 0x56, 0x8b, 0x86, 0x5b, 0xad, 0x17, 0x0d, 0xb7, 0xc6,
+	0x9a, 0x74, 0xf9, 0xe5, 0xbf, 0x77, 0x0c, 0x13, 0xb4, 0xa2, 0x64, 0x55, 0xff, 0x6b, 0x40, 0x39,
+	0x49, 0x81, 0xb6, 0x8f, 0x3e, 0x5d, 0xe8, 0xf3, 0x4b, 0xa7, 0x21, 0xdd, 0xeb, 0x1f, 0x43, 0x21,
+	0x20, 0x5f, 0x4c, 0x25, 0xbe, 0x55, 0xbc, 0x99, 0x0b, 0xc4, 0x9b, 0x0f, 0x35, 0x25, 0x0f, 0x7d,
+	0x02, 0x25, 0x32, 0x18, 0x10, 0x5b, 0xd0, 0xd9, 0x25, 0xae, 0x5e, 0x8c, 0x75, 0xd5, 0xed, 0xff,
+	0x99, 0x81, 0xca, 0xd9, 0xd1, 0xfa, 0x6b, 0x2a, 0x86, 0x4f, 0x88, 0xc0, 0xa9, 0xf1, 0x64, 0x5c,
+	0xcd, 0x78, 0xda, 0x82, 0x95, 0xb0, 0x43, 0x33, 0xaa, 0x43, 0xc3, 0x13, 0xfa, 0x21, 0x14, 0x66,
+	0x4c, 0x50, 0xcf, 0xb5, 0x7c, 0xf6, 0x47, 0x12, 0xa8, 0x0b, 0xe5, 0xcc, 0xbc, 0xa6, 0x75, 0x24,
+	0xe9, 0x2d, 0xa3, 0x29, 0x77, 0xe1, 0xd1, 0xb4, 0xfc, 0x7f, 0x47, 0xd3, 0xca, 0x3b, 0x8d, 0xa6,
+	0xd5, 0xf3, 0x46, 0x53, 0xf5, 0x1f, 0x6b, 0x50, 0x6c, 0xf4, 0x8e, 0x9a, 0x64, 0x4c, 0x5c, 0xac,
+	0x36, 0xc7, 0xcf, 0x20, 0x2f, 0x67, 0x0a, 0x09, 0xac, 0x77, 0xda, 0x5a, 0xa0, 0x85, 0x25, 0x31,
+	0x55, 0x86, 0xcc, 0x95, 0x6e, 0x89, 0xec, 0x25, 0xb7, 0xc4, 0xef, 0xa0, 0x34, 0xf0, 0x2d, 0x1d,
+	0x92, 0x35, 0xa6, 0x5c, 0x96, 0x20, 0xfb, 0x5e, 0x71, 0xe5, 0x07, 0x7e, 0x43, 0x46, 0xf6, 0x98,
+	0x72, 0x05, 0x86, 0x30, 0x0c, 0x8d, 0x6e, 0x5d, 0xad, 0x7c, 0x48, 0x53, 0x2d, 0xa0, 0x45, 0x02,
+	0x91, 0xde, 0x4e, 0x5a, 0x24, 0x10, 0x61, 0x2d, 0x7f, 0x00, 0x40, 0xbc, 0x33, 0x95, 0x5a, 0x27,
+	0x5e, 0xb4, 0x3b, 0x6e, 0xc1, 0xba, 0x60, 0x02, 0x8f, 0x2d, 0x8e, 0x85, 0x5a, 0x44, 0x39, 0x73,
+	0x4d, 0x11, 0xba, 0x58, 0xe9, 0xc6, 0x11, 0xcc, 0xd5, 0x02, 0x2a, 0x98, 0xeb, 0x91, 0xff, 0xb9,
+	0x02, 0x55, 0xc8, 0x66, 0x53, 0xe1, 0x4f, 0x85, 0x45, 0x9d, 0xb9, 0xda, 0x39, 0x12, 0x54, 0x9a,
+	0xf3, 0x54, 0x31, 0xda, 0xce, 0x1c, 0x1d, 0x42, 0x5e, 0x01, 0x2d, 0xb4, 0x96, 0x57, 0x25, 0xbc,
+	0xf6, 0xf2, 0x74, 0x47, 0x02, 0xa4, 0x1b, 0x72, 0x7a, 0x73, 0x13, 0x78, 0xfc, 0x1b, 0x7d, 0x0e,
+	0x45, 0x47, 0x43, 0x87, 0x05, 0x16, 0xa7, 0x6e, 0xa5, 0xa0, 0xb4, 0x7e, 0xf1, 0xf2, 0x74, 0xe7,
+	0xa7, 0x17, 0x4b, 0x70, 0x97, 0xba, 0x1e, 0x16, 0xd3, 0x80, 0x98, 0x85, 0xd8, 0x62, 0x97, 0xba,
+	0xe8, 0x19, 0x14, 0x6d, 0x36, 0x23, 0x1e, 0xf6, 0x84, 0x74, 0xc0, 0x2b, 0xc5, 0xdd, 0xec, 0x5e,
+	0xfe, 0xf0, 0xde, 0xb9, 0x3b, 0x50, 0xcb, 0x3e, 0x70, 0xb0, 0xaf, 0x2d, 0x68, 0xab, 0xdc, 0x2c,
+	0x44, 0x66, 0xba, 0xd4, 0xe5, 0xe8, 0x03, 0x28, 0x4d, 0xbd, 0x3e, 0xf3, 0x9c, 0xb8, 0x7a, 0x25,
+	0x95, 0x96, 0x62, 0x4c, 0x55, 0xf5, 0xfb, 0x14, 0xca, 0x12, 0x3e, 0x53, 0xcf, 0x89, 0x1b, 0xa4,
+	0xb2, 0xa1, 0xd0, 0x78, 0xe7, 0x9c, 0x00, 0x1a, 0xbd, 0xa3, 0x67, 0x29, 0x69, 0x73, 0xa3, 0x2f,
+	0xec, 0x34, 0x41, 0x7a, 0xf6, 0x71, 0x80, 0x27, 0xdc, 0x9a, 0x91, 0x40, 0x4d, 0xed, 0xb2, 0xf6,
+	0xac, 0xa9, 0xcf, 0x35, 0x11, 0xdd, 0x86, 0x92, 0xf4, 0x2c, 0xa8, 0x1f, 0x41, 0xe3, 0x9a, 0x12,
+	0x2b, 0xf4, 0x85, 0xdd, 0xa3, 0x7e, 0x88, 0x8e, 0x3a, 0x6c, 0xfa, 0x01, 0x99, 0x59, 0x09, 0x0a,
+	0xac, 0x21, 0xe6, 0xc3, 0x0a, 0x92, 0x5d, 0x6b, 0x5e, 0x93, 0xbc, 0x6e, 0x04, 0x87, 0x47, 0x98,
+	0x0f, 0xd1, 0xef, 0xa1, 0x12, 0xa7, 0x53, 0x04, 0xd8, 0xe3, 0x54, 0x06, 0xa5, 0x33, 0x7b, 0x5d,
+	0x65, 0xf6, 0xf6, 0x39, 0x17, 0x8b, 0x53, 0xa9, 0x1e, 0x17, 0x5b, 0x91, 0x95, 0x5e, 0x6c, 0x44,
+	0xe6, 0xb5, 0xfa, 0x2b, 0xd8, 0x6a, 0x46, 0xe5, 0x7b, 0x16, 0xa5, 0x52, 0xad, 0xeb, 0xdb, 0x50,
+	0xe2, 0xbe, 0x44, 0xba, 0x1a, 0x18, 0x12, 0x61, 0x6a, 0x56, 0x9b, 0x05, 0x45, 0x95, 0x51, 0x92,
+	0xde, 0xbc, 0xfa, 0x97, 0x1c, 0x6c, 0x9c, 0x49, 0xa1, 0x6c, 0xa2, 0x54, 0xad, 0x22, 0xbd, 0x7c,
+	0x52, 0xa9, 0x6f, 0x61, 0x37, 0xf3, 0x2e, 0xd8, 0xfd, 0x02, 0xb6, 0x52, 0xd8, 0x8d, 0xb4, 0x25,
+	0x88, 0xb3, 0xef, 0x0f, 0xe2, 0xcd, 0x04, 0xc4, 0xa1, 0x65, 0x09, 0xe6, 0x01, 0x6c, 0x25, 0x60,
+	0x4e, 0x79, 0xe4, 0x6a, 0x30, 0x5d, 0x06, 0xd5, 0x9b, 0x31, 0xaa, 0x13, 0x37, 0x1c, 0xd9, 0x70,
+	0x2b, 0xf6, 0x93, 0xa4, 0x8e, 0x53, 0x57, 0x4f, 0xc1, 0xe5, 0x0b, 0x14, 0x3a, 0x86, 0x4b, 0x5c,
+	0xcd, 0x2e, 0x75, 0xd5, 0xf8, 0x73, 0xa1, 0x92, 0xe4, 0x2f, 0xf1, 0xa2, 0x1e, 0xaa, 0x2b, 0xaa,
+	0x47, 0xf6, 0xcf, 0xf1, 0xf0, 0x66, 0x84, 0x98, 0x49, 0x39, 0x16, 0xe8, 0xd5, 0x2e, 0x7c, 0x3f,
+	0x59, 0x51, 0x2c, 0x48, 0x76, 0x15, 0x47, 0x1f, 0x41, 0xce, 0x21, 0x63, 0x5e, 0x31, 0xde, 0x7a,
+	0xa3, 0x85, 0x05, 0x67, 0x2a, 0x8d, 0xea, 0x31, 0xdc, 0x7a, 0xb3, 0xd1, 0xb6, 0xe7, 0x90, 0xb9,
+	0x6c, 0xac, 0x33, 0x3d, 0xa5, 0x53, 0x27, 0x1d, 0x15, 0xcc, 0x6b, 0x3c, 0xdd, 0x54, 0x32, 0x1b,
+	0xd5, 0xbf, 0x19, 0x50, 0x5c, 0xc8, 0x1c, 0x7a, 0x04, 0x99, 0x2b, 0x78, 0x90, 0x64, 0xfc, 0x11,
+	0x7a, 0x02, 0x59, 0x09, 0xcb, 0xcc, 0xfb, 0xc3, 0x52, 0xda, 0xa9, 0xfe, 0xd9, 0x80, 0x1b, 0xe7,
+	0x22, 0x4a, 0x2e, 0x71, 0x9b, 0xcd, 0xae, 0xe4, 0x2d, 0x65, 0xb3, 0x59, 0x67, 0x24, 0xdb, 0x17,
+	0x6b, 0x2f, 0x1a, 0xea, 0x19, 0x95, 0xc2, 0x3c, 0x8e, 0x3d, 0xf3, 0xea, 0x0b, 0x03, 0x6e, 0x74,
+	0xc9, 0x58, 0x3f, 0xf7, 0x22, 0x24, 0xb7, 0xe4, 0x1b, 0xcf, 0xb3, 0x09, 0xba, 0x03, 0x1b, 0x67,
+	0xe7, 0x9b, 0x7a, 0x95, 0x98, 0xc5, 0x85, 0x32, 0xa0, 0x1e, 0xac, 0xc7, 0xeb, 0xfe, 0xbd, 0x5f,
+	0x20, 0xab, 0xe1, 0xa6, 0x47, 0xfb, 0x70, 0x3d, 0x20, 0xb2, 0x09, 0xe4, 0xdf, 0xb7, 0xd0, 0x3e,
+	0x1f, 0xe9, 0x19, 0x61, 0x96, 0x63, 0xd6, 0x43, 0x29, 0xde, 0x1d, 0x55, 0xfb, 0x50, 0x6a, 0x7b,
+	0xf6, 0x78, 0x2a, 0x87, 0xb8, 0x7a, 0x99, 0xa0, 0x9f, 0x43, 0x76, 0x44, 0x4e, 0x54, 0xc8, 0xf9,
+	0xc3, 0xbd, 0x34, 0x44, 0x53, 0xdf, 0x0f, 0x66, 0x07, 0x35, 0x35, 0x49, 0xb1, 0x2d, 0x31, 0x28,
+	0x03, 0x90, 0x4a, 0x68, 0x13, 0x96, 0x7d, 0x69, 0x44, 0x5f, 0xc7, 0xd4, 0x87, 0x1f, 0x7f, 0x0e,
+	0xd7, 0x17, 0x20, 0xdd, 0x15, 0x58, 0x4c, 0x39, 0xca, 0xc3, 0x6a, 0xa7, 0x75, 0xdc, 0x6c, 0x1f,
+	0x7f, 0x5c, 0x5e, 0x42, 0x05, 0x58, 0x7b, 0xde, 0x32, 0xdb, 0x0f, 0xdb, 0xad, 0x66, 0xd9, 0x40,
+	0x00, 0x2b, 0x0f, 0x8e, 0x7a, 0xed, 0xe7, 0xad, 0x72, 0x46, 0x72, 0x9e, 0x1d, 0x37, 0x9e, 0x1e,
+	0x37, 0x5b, 0xcd, 0x72, 0x56, 0x2a, 0xb5, 0x7e, 0xd3, 0x69, 0x9b, 0xad, 0x66, 0x39, 0x87, 0x56,
+	0x21, 0xfb, 0xe0, 0xf8, 0xb3, 0xf2, 0x72, 0xe3, 0xf8, 0xc5, 0xab, 0x6d, 0xe3, 0xeb, 0x57, 0xdb,
+	0xc6, 0x7f, 0x5e, 0x6d, 0x1b, 0x5f, 0xbe, 0xde, 0x5e, 0xfa, 0xfa, 0xf5, 0xf6, 0xd2, 0xbf, 0x5e,
+	0x6f, 0x2f, 0xfd, 0xf6, 0x1d, 0xb2, 0x39, 0x4f, 0x7f, 0x4d, 0x51, 0xa9, 0xed, 0xaf, 0xa8, 0xa7,
+	0xfe, 0x87, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xc7, 0x40, 0xa7, 0x2e, 0x27, 0x12, 0x00, 0x00,
 }
 
 func (m *FinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -1042,6 +1265,23 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CommissionInfo != nil {
+		{
+			size, err := m.CommissionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RetiredBabylonHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.RetiredBabylonHeight))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.HighestVotedHeight != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.HighestVotedHeight))
 		i--
@@ -1125,6 +1365,149 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintBtcstaking(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxRate.Size()
+		i -= size
+		if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintBtcstaking(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RequestTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintBtcstaking(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Commission.Size()
+		i -= size
+		if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FinalityProviderWithMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1215,6 +1598,31 @@ func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CovenantTransitionSigs) > 0 {
+		for iNdEx := len(m.CovenantTransitionSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantTransitionSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.PrevStakingTxHash) > 0 {
+		i -= len(m.PrevStakingTxHash)
+		copy(dAtA[i:], m.PrevStakingTxHash)
+		i = encodeVarintBtcstaking(dAtA, i, uint64(len(m.PrevStakingTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if m.BtcTipHeight != 0 {
 		i = encodeVarintBtcstaking(dAtA, i, uint64(m.BtcTipHeight))
 		i--
@@ -1788,6 +2196,56 @@ func (m *FinalityProvider) Size() (n int) {
 	if m.HighestVotedHeight != 0 {
 		n += 1 + sovBtcstaking(uint64(m.HighestVotedHeight))
 	}
+	if m.RetiredBabylonHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.RetiredBabylonHeight))
+	}
+	if m.CommissionInfo != nil {
+		l = m.CommissionInfo.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	return n
+}
+
+func (m *CommissionRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxRate.Size()
+	n += 1 + l + sovBtcstaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovBtcstaking(uint64(l))
+	return n
+}
+
+func (m *CommissionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxRate.Size()
+	n += 1 + l + sovBtcstaking(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovBtcstaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovBtcstaking(uint64(l))
+	return n
+}
+
+func (m *CommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovBtcstaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RequestTime)
+	n += 1 + l + sovBtcstaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovBtcstaking(uint64(l))
 	return n
 }
 
@@ -1892,6 +2350,16 @@ func (m *BTCDelegation) Size() (n int) {
 	if m.BtcTipHeight != 0 {
 		n += 2 + sovBtcstaking(uint64(m.BtcTipHeight))
 	}
+	l = len(m.PrevStakingTxHash)
+	if l > 0 {
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
+	if len(m.CovenantTransitionSigs) > 0 {
+		for _, e := range m.CovenantTransitionSigs {
+			l = e.Size()
+			n += 2 + l + sovBtcstaking(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2336,17 +2804,491 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredBabylonHeight", wireType)
+			}
+			m.RetiredBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetiredBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommissionInfo == nil {
+				m.CommissionInfo = &CommissionInfo{}
+			}
+			if err := m.CommissionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RequestTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -3050,6 +3992,72 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevStakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevStakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantTransitionSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantTransitionSigs = append(m.CovenantTransitionSigs, &SignatureInfo{})
+			if err := m.CovenantTransitionSigs[len(m.CovenantTransitionSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -10,11 +10,16 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateFinalityProvider{}, "btcstaking/MsgCreateFinalityProvider", nil)
 	cdc.RegisterConcrete(&MsgEditFinalityProvider{}, "btcstaking/MsgEditFinalityProvider", nil)
+	cdc.RegisterConcrete(&MsgRetireFinalityProvider{}, "btcstaking/MsgRetireFinalityProvider", nil)
 	cdc.RegisterConcrete(&MsgCreateBTCDelegation{}, "btcstaking/MsgCreateBTCDelegation", nil)
+	cdc.RegisterConcrete(&MsgExtendBTCDelegation{}, "btcstaking/MsgExtendBTCDelegation", nil)
+	cdc.RegisterConcrete(&MsgRedelegateBTCDelegation{}, "btcstaking/MsgRedelegateBTCDelegation", nil)
 	cdc.RegisterConcrete(&MsgAddCovenantSigs{}, "btcstaking/MsgAddCovenantSigs", nil)
 	cdc.RegisterConcrete(&MsgBTCUndelegate{}, "btcstaking/MsgBTCUndelegate", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "btcstaking/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgAddBTCDelegationInclusionProof{}, "btcstaking/MsgAddBTCDelegationInclusionProof", nil)
+	cdc.RegisterConcrete(&MsgAddAllowedStakingTxHashes{}, "btcstaking/MsgAddAllowedStakingTxHashes", nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowedStakingTxHashes{}, "btcstaking/MsgRemoveAllowedStakingTxHashes", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -23,11 +28,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateFinalityProvider{},
 		&MsgEditFinalityProvider{},
+		&MsgRetireFinalityProvider{},
 		&MsgCreateBTCDelegation{},
+		&MsgExtendBTCDelegation{},
+		&MsgRedelegateBTCDelegation{},
 		&MsgAddCovenantSigs{},
 		&MsgBTCUndelegate{},
 		&MsgUpdateParams{},
 		&MsgAddBTCDelegationInclusionProof{},
+		&MsgAddAllowedStakingTxHashes{},
+		&MsgRemoveAllowedStakingTxHashes{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	// CommissionUpdateInterval is the minimum interval between two requests
+	// of changing the commission rate of a finality provider
+	CommissionUpdateInterval = 24 * time.Hour
+	// CommissionChangeDelay is the delay after which a requested change of the
+	// commission rate of a finality provider takes effect, so that its BTC
+	// delegators have time to react to the change
+	CommissionChangeDelay = 24 * time.Hour
+)
+
+// DefaultLegacyCommissionRates returns the commission rates assigned to the
+// finality providers registered before the commission rates were introduced,
+// so that their commission rate changes are limited as well. The max rate
+// does not restrict their current commission rate, while the commission rate
+// can only change by up to 1% per `CommissionUpdateInterval`
+func DefaultLegacyCommissionRates() CommissionRates {
+	return CommissionRates{
+		MaxRate:       sdkmath.LegacyOneDec(),
+		MaxChangeRate: sdkmath.LegacyNewDecWithPrec(1, 2),
+	}
+}
+
+// NewCommissionInfo returns the commission info of a finality provider
+// with the given commission rates
+func NewCommissionInfo(rates CommissionRates, updateTime time.Time) *CommissionInfo {
+	return &CommissionInfo{
+		MaxRate:       rates.MaxRate,
+		MaxChangeRate: rates.MaxChangeRate,
+		UpdateTime:    updateTime,
+	}
+}
+
+// NewCommissionChange returns a change of the commission rate requested at
+// the given time that takes effect after the given delay
+func NewCommissionChange(commission sdkmath.LegacyDec, requestTime time.Time, delay time.Duration) CommissionChange {
+	return CommissionChange{
+		Commission:    commission,
+		RequestTime:   requestTime,
+		EffectiveTime: requestTime.Add(delay),
+	}
+}
+
+// Validate ensures the commission rate change is well-formed
+func (c *CommissionChange) Validate() error {
+	switch {
+	case c.Commission.IsNil():
+		return fmt.Errorf("commission rate cannot be empty")
+	case c.Commission.IsNegative() || c.Commission.GT(sdkmath.LegacyOneDec()):
+		return fmt.Errorf("commission rate must be between 0 and 1")
+	case c.EffectiveTime.Before(c.RequestTime):
+		return fmt.Errorf("effective time %s is before the request time %s", c.EffectiveTime, c.RequestTime)
+	}
+
+	return nil
+}
+
+// Validate ensures the commission rates are well-formed and the given
+// commission rate is within them
+func (cr *CommissionRates) Validate(commission sdkmath.LegacyDec) error {
+	switch {
+	case cr.MaxRate.IsNil() || cr.MaxChangeRate.IsNil():
+		return ErrInvalidCommissionRates.Wrap("max rate and max change rate cannot be empty")
+	case cr.MaxRate.IsNegative() || cr.MaxRate.GT(sdkmath.LegacyOneDec()):
+		return ErrInvalidCommissionRates.Wrap("max rate must be between 0 and 1")
+	case cr.MaxChangeRate.IsNegative():
+		return ErrInvalidCommissionRates.Wrap("max change rate cannot be negative")
+	case cr.MaxChangeRate.GT(cr.MaxRate):
+		return ErrInvalidCommissionRates.Wrap("max change rate cannot be more than the max rate")
+	case commission.GT(cr.MaxRate):
+		return ErrCommissionGTMaxRateLimit
+	}
+
+	return nil
+}
+
+// ValidateNewRate ensures the change from the current commission rate to the
+// new commission rate at the given time is within the commission info
+func (ci *CommissionInfo) ValidateNewRate(curRate, newRate sdkmath.LegacyDec, blockTime time.Time) error {
+	switch {
+	case blockTime.Sub(ci.UpdateTime) < CommissionUpdateInterval:
+		return ErrCommissionUpdateTooSoon.Wrapf("the last change was requested at %s", ci.UpdateTime)
+	case newRate.GT(ci.MaxRate):
+		return ErrCommissionGTMaxRateLimit
+	case newRate.Sub(curRate).Abs().GT(ci.MaxChangeRate):
+		return ErrCommissionGTMaxChangeRate
+	}
+
+	return nil
+}
+
+// Rates returns the commission rates of the commission info, or nil for a
+// finality provider without commission info
+func (ci *CommissionInfo) Rates() *CommissionRates {
+	if ci == nil {
+		return nil
+	}
+	return &CommissionRates{
+		MaxRate:       ci.MaxRate,
+		MaxChangeRate: ci.MaxChangeRate,
+	}
+}
@@ -31,4 +31,13 @@ var (
 	ErrFpNotJailed               = errorsmod.Register(ModuleName, 1122, "the finality provider is not jailed")
 	ErrDuplicatedCovenantSig     = errorsmod.Register(ModuleName, 1123, "the covenant signature is already submitted")
 	ErrStakingTxIncludedTooEarly = errorsmod.Register(ModuleName, 1124, "the staking transaction is included too early in BTC chain")
+	ErrInvalidDelExtension       = errorsmod.Register(ModuleName, 1125, "invalid BTC delegation extension")
+	ErrInvalidRedelegation       = errorsmod.Register(ModuleName, 1126, "invalid BTC delegation redelegation")
+	ErrFpAlreadyRetired          = errorsmod.Register(ModuleName, 1127, "the finality provider has already been retired")
+	ErrInvalidCommissionRates    = errorsmod.Register(ModuleName, 1128, "the commission rates are not valid")
+	ErrCommissionGTMaxRateLimit  = errorsmod.Register(ModuleName, 1129, "commission cannot be more than the max rate")
+	ErrCommissionGTMaxChangeRate = errorsmod.Register(ModuleName, 1130, "commission cannot be changed more than the max change rate")
+	ErrCommissionUpdateTooSoon   = errorsmod.Register(ModuleName, 1131, "commission cannot be changed more than once in 24h")
+	ErrStakeCapExceeded          = errorsmod.Register(ModuleName, 1132, "the BTC delegation exceeds the stake cap")
+	ErrFpNotSlashed              = errorsmod.Register(ModuleName, 1133, "the finality provider is not slashed")
 )
@@ -4,7 +4,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	bbn "github.com/babylonlabs-io/babylon/types"
@@ -18,6 +20,24 @@ func NewEventPowerDistUpdateWithBTCDel(ev *EventBTCDelegationStateUpdate) *Event
 	}
 }
 
+// NewEventBTCDelegationStateUpdate returns a state update event of the given
+// BTC delegation, enriched with the BTC delegation's details so that
+// subscribers do not need to query them. The enriched event is only emitted,
+// while the state update events recorded in the voting power distribution
+// event store only carry the staking tx hash and the new state
+func NewEventBTCDelegationStateUpdate(
+	btcDel *BTCDelegation,
+	newState BTCDelegationStatus,
+) *EventBTCDelegationStateUpdate {
+	return &EventBTCDelegationStateUpdate{
+		StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+		NewState:      newState,
+		StakerAddr:    btcDel.StakerAddr,
+		FpBtcPkList:   btcDel.FinalityProviderKeys(),
+		TotalSat:      btcDel.TotalSat,
+	}
+}
+
 func NewEventPowerDistUpdateWithSlashedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowerDistUpdate {
 	return &EventPowerDistUpdate{
 		Ev: &EventPowerDistUpdate_SlashedFp{
@@ -48,6 +68,17 @@ func NewEventPowerDistUpdateWithUnjailedFP(fpBTCPK *bbn.BIP340PubKey) *EventPowe
 	}
 }
 
+func NewEventPowerDistUpdateWithCommissionUpdatedFP(fpBTCPK *bbn.BIP340PubKey, commission *sdkmath.LegacyDec) *EventPowerDistUpdate {
+	return &EventPowerDistUpdate{
+		Ev: &EventPowerDistUpdate_CommissionUpdatedFp{
+			CommissionUpdatedFp: &EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider{
+				Pk:         fpBTCPK,
+				Commission: commission,
+			},
+		},
+	}
+}
+
 func NewEventFinalityProviderCreated(fp *FinalityProvider) *EventFinalityProviderCreated {
 	return &EventFinalityProviderCreated{
 		BtcPkHex:        fp.BtcPk.MarshalHex(),
@@ -73,6 +104,36 @@ func NewEventFinalityProviderEdited(fp *FinalityProvider) *EventFinalityProvider
 	}
 }
 
+func NewEventFinalityProviderCommissionChangeScheduled(
+	fpBtcPk *bbn.BIP340PubKey,
+	change *CommissionChange,
+) *EventFinalityProviderCommissionChangeScheduled {
+	return &EventFinalityProviderCommissionChangeScheduled{
+		BtcPkHex:      fpBtcPk.MarshalHex(),
+		Commission:    change.Commission.String(),
+		EffectiveTime: change.EffectiveTime.Format(time.RFC3339),
+	}
+}
+
+func NewEventFinalityProviderRetired(fp *FinalityProvider) *EventFinalityProviderRetired {
+	return &EventFinalityProviderRetired{
+		BtcPkHex:             fp.BtcPk.MarshalHex(),
+		RetiredBabylonHeight: strconv.FormatUint(fp.RetiredBabylonHeight, 10),
+	}
+}
+
+func NewEventBTCDelegationFinalityProviderRetired(
+	stakingTxHash string,
+	fpBtcPk *bbn.BIP340PubKey,
+	state BTCDelegationStatus,
+) *EventBTCDelegationFinalityProviderRetired {
+	return &EventBTCDelegationFinalityProviderRetired{
+		StakingTxHash: stakingTxHash,
+		FpBtcPkHex:    fpBtcPk.MarshalHex(),
+		State:         state.String(),
+	}
+}
+
 func NewInclusionProofEvent(
 	stakingTxHash string,
 	startHeight uint32,
@@ -87,6 +148,32 @@ func NewInclusionProofEvent(
 	}
 }
 
+func NewBTCDelegationExtendedEvent(
+	prevStakingTxHash string,
+	newBtcDel *BTCDelegation,
+) *EventBTCDelegationExtended {
+	return &EventBTCDelegationExtended{
+		PrevStakingTxHash: prevStakingTxHash,
+		NewStakingTxHash:  newBtcDel.MustGetStakingTxHash().String(),
+		StartHeight:       strconv.FormatUint(uint64(newBtcDel.StartHeight), 10),
+		EndHeight:         strconv.FormatUint(uint64(newBtcDel.EndHeight), 10),
+	}
+}
+
+func NewBTCDelegationRedelegatedEvent(
+	prevBtcDel *BTCDelegation,
+	newBtcDel *BTCDelegation,
+) *EventBTCDelegationRedelegated {
+	return &EventBTCDelegationRedelegated{
+		PrevStakingTxHash: prevBtcDel.MustGetStakingTxHash().String(),
+		NewStakingTxHash:  newBtcDel.MustGetStakingTxHash().String(),
+		PrevFpBtcPkList:   prevBtcDel.FinalityProviderKeys(),
+		NewFpBtcPkList:    newBtcDel.FinalityProviderKeys(),
+		StartHeight:       strconv.FormatUint(uint64(newBtcDel.StartHeight), 10),
+		EndHeight:         strconv.FormatUint(uint64(newBtcDel.EndHeight), 10),
+	}
+}
+
 func NewBtcDelCreationEvent(
 	btcDel *BTCDelegation,
 ) *EventBTCDelegationCreated {
@@ -145,6 +232,19 @@ func NewExpiredDelegationEvent(
 	}
 }
 
+func NewExpiringSoonDelegationEvent(
+	btcDel *BTCDelegation,
+) *EventBTCDelegationExpiringSoon {
+	return &EventBTCDelegationExpiringSoon{
+		StakingTxHash:             btcDel.MustGetStakingTxHash().String(),
+		StakerAddr:                btcDel.StakerAddr,
+		FinalityProviderBtcPksHex: btcDel.FinalityProviderKeys(),
+		TotalSat:                  strconv.FormatUint(btcDel.TotalSat, 10),
+		EndHeight:                 strconv.FormatUint(uint64(btcDel.EndHeight), 10),
+		ExpiryHeight:              strconv.FormatUint(uint64(btcDel.EndHeight-btcDel.UnbondingTime), 10),
+	}
+}
+
 func NewFinalityProviderStatusChangeEvent(
 	fpPk *bbn.BIP340PubKey,
 	status FinalityProviderStatus,
@@ -195,6 +295,25 @@ func EmitExpiredDelegationEvent(sdkCtx sdk.Context, stakingTxHash string) {
 	}
 }
 
+// EmitBTCDelegationStateUpdateEvent emits the enriched state update event of
+// the given BTC delegation once the state update is applied to the voting
+// power distribution
+func EmitBTCDelegationStateUpdateEvent(sdkCtx sdk.Context, btcDel *BTCDelegation, newState BTCDelegationStatus) {
+	ev := NewEventBTCDelegationStateUpdate(btcDel, newState)
+	if err := sdkCtx.EventManager().EmitTypedEvent(ev); err != nil {
+		panic(fmt.Errorf("failed to emit event the BTC delegation state update: %w", err))
+	}
+}
+
+// EmitExpiringSoonDelegationEvent emits events for a BTC delegation whose
+// voting power is about to expire
+func EmitExpiringSoonDelegationEvent(sdkCtx sdk.Context, btcDel *BTCDelegation) {
+	ev := NewExpiringSoonDelegationEvent(btcDel)
+	if err := sdkCtx.EventManager().EmitTypedEvent(ev); err != nil {
+		panic(fmt.Errorf("failed to emit event the expiring soon BTC delegation: %w", err))
+	}
+}
+
 func EmitSlashedFPEvent(sdkCtx sdk.Context, fpBTCPK *bbn.BIP340PubKey) {
 	statusChangeEvent := NewFinalityProviderStatusChangeEvent(fpBTCPK, FinalityProviderStatus_FINALITY_PROVIDER_STATUS_SLASHED)
 	if err := sdkCtx.EventManager().EmitTypedEvent(statusChangeEvent); err != nil {
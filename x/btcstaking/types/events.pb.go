@@ -4,11 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonlabs_io_babylon_types "github.com/babylonlabs-io/babylon/types"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
-	_ "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -41,6 +41,9 @@ const (
 	FinalityProviderStatus_FINALITY_PROVIDER_STATUS_JAILED FinalityProviderStatus = 2
 	// FINALITY_PROVIDER_STATUS_SLASHED defines a finality provider that is slashed due to double-sign
 	FinalityProviderStatus_FINALITY_PROVIDER_STATUS_SLASHED FinalityProviderStatus = 3
+	// FINALITY_PROVIDER_STATUS_RETIRED defines a retired finality provider that has
+	// left the active set after its remaining voting power expired or was unbonded
+	FinalityProviderStatus_FINALITY_PROVIDER_STATUS_RETIRED FinalityProviderStatus = 4
 )
 
 var FinalityProviderStatus_name = map[int32]string{
@@ -48,6 +51,7 @@ var FinalityProviderStatus_name = map[int32]string{
 	1: "FINALITY_PROVIDER_STATUS_ACTIVE",
 	2: "FINALITY_PROVIDER_STATUS_JAILED",
 	3: "FINALITY_PROVIDER_STATUS_SLASHED",
+	4: "FINALITY_PROVIDER_STATUS_RETIRED",
 }
 
 var FinalityProviderStatus_value = map[string]int32{
@@ -55,6 +59,7 @@ var FinalityProviderStatus_value = map[string]int32{
 	"FINALITY_PROVIDER_STATUS_ACTIVE":   1,
 	"FINALITY_PROVIDER_STATUS_JAILED":   2,
 	"FINALITY_PROVIDER_STATUS_SLASHED":  3,
+	"FINALITY_PROVIDER_STATUS_RETIRED":  4,
 }
 
 func (x FinalityProviderStatus) String() string {
@@ -274,6 +279,206 @@ func (m *EventFinalityProviderEdited) GetDetails() string {
 	return ""
 }
 
+// EventFinalityProviderCommissionChangeScheduled is the event emitted when a
+// finality provider requests a change of its commission rate, which takes
+// effect after a delay
+type EventFinalityProviderCommissionChangeScheduled struct {
+	// btc_pk_hex is the hex string of Bitcoin secp256k1 PK of this finality provider
+	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// commission is the new commission rate of the finality provider in decimals
+	Commission string `protobuf:"bytes,2,opt,name=commission,proto3" json:"commission,omitempty"`
+	// effective_time is the time from which the new commission rate takes
+	// effect, in RFC 3339 format
+	EffectiveTime string `protobuf:"bytes,3,opt,name=effective_time,json=effectiveTime,proto3" json:"effective_time,omitempty"`
+}
+
+func (m *EventFinalityProviderCommissionChangeScheduled) Reset() {
+	*m = EventFinalityProviderCommissionChangeScheduled{}
+}
+func (m *EventFinalityProviderCommissionChangeScheduled) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventFinalityProviderCommissionChangeScheduled) ProtoMessage() {}
+func (*EventFinalityProviderCommissionChangeScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{2}
+}
+func (m *EventFinalityProviderCommissionChangeScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderCommissionChangeScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderCommissionChangeScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalityProviderCommissionChangeScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderCommissionChangeScheduled.Merge(m, src)
+}
+func (m *EventFinalityProviderCommissionChangeScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderCommissionChangeScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderCommissionChangeScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalityProviderCommissionChangeScheduled proto.InternalMessageInfo
+
+func (m *EventFinalityProviderCommissionChangeScheduled) GetBtcPkHex() string {
+	if m != nil {
+		return m.BtcPkHex
+	}
+	return ""
+}
+
+func (m *EventFinalityProviderCommissionChangeScheduled) GetCommission() string {
+	if m != nil {
+		return m.Commission
+	}
+	return ""
+}
+
+func (m *EventFinalityProviderCommissionChangeScheduled) GetEffectiveTime() string {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return ""
+}
+
+// EventFinalityProviderRetired is the event emitted when a finality provider
+// announces its retirement
+type EventFinalityProviderRetired struct {
+	// btc_pk_hex is the hex string of Bitcoin secp256k1 PK of this finality provider
+	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// retired_babylon_height is the Babylon height at which the finality
+	// provider announced its retirement
+	RetiredBabylonHeight string `protobuf:"bytes,2,opt,name=retired_babylon_height,json=retiredBabylonHeight,proto3" json:"retired_babylon_height,omitempty"`
+}
+
+func (m *EventFinalityProviderRetired) Reset()         { *m = EventFinalityProviderRetired{} }
+func (m *EventFinalityProviderRetired) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityProviderRetired) ProtoMessage()    {}
+func (*EventFinalityProviderRetired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{3}
+}
+func (m *EventFinalityProviderRetired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderRetired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderRetired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalityProviderRetired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderRetired.Merge(m, src)
+}
+func (m *EventFinalityProviderRetired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderRetired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderRetired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalityProviderRetired proto.InternalMessageInfo
+
+func (m *EventFinalityProviderRetired) GetBtcPkHex() string {
+	if m != nil {
+		return m.BtcPkHex
+	}
+	return ""
+}
+
+func (m *EventFinalityProviderRetired) GetRetiredBabylonHeight() string {
+	if m != nil {
+		return m.RetiredBabylonHeight
+	}
+	return ""
+}
+
+// EventBTCDelegationFinalityProviderRetired is the event emitted for each
+// not yet unbonded BTC delegation of a finality provider that announces its
+// retirement, so that the delegator can unbond or redelegate
+type EventBTCDelegationFinalityProviderRetired struct {
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// fp_btc_pk_hex is the hex string of Bitcoin secp256k1 PK of the retired
+	// finality provider
+	FpBtcPkHex string `protobuf:"bytes,2,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// state is the state of the BTC delegation at the time of retirement
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (m *EventBTCDelegationFinalityProviderRetired) Reset() {
+	*m = EventBTCDelegationFinalityProviderRetired{}
+}
+func (m *EventBTCDelegationFinalityProviderRetired) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventBTCDelegationFinalityProviderRetired) ProtoMessage() {}
+func (*EventBTCDelegationFinalityProviderRetired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{4}
+}
+func (m *EventBTCDelegationFinalityProviderRetired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCDelegationFinalityProviderRetired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCDelegationFinalityProviderRetired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCDelegationFinalityProviderRetired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCDelegationFinalityProviderRetired.Merge(m, src)
+}
+func (m *EventBTCDelegationFinalityProviderRetired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCDelegationFinalityProviderRetired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCDelegationFinalityProviderRetired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCDelegationFinalityProviderRetired proto.InternalMessageInfo
+
+func (m *EventBTCDelegationFinalityProviderRetired) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationFinalityProviderRetired) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationFinalityProviderRetired) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
 // EventBTCDelegationStateUpdate is the event emitted when a BTC delegation's state is
 // updated. There are the following possible state transitions:
 // - non-existing -> pending, which happens upon `MsgCreateBTCDelegation`
@@ -285,13 +490,25 @@ type EventBTCDelegationStateUpdate struct {
 	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
 	// new_state is the new state of this BTC delegation
 	NewState BTCDelegationStatus `protobuf:"varint,2,opt,name=new_state,json=newState,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"new_state,omitempty"`
+	// The following fields are only set in the event emitted when the state
+	// update is applied to the voting power distribution, and are left empty
+	// in the events recorded in the voting power distribution event store.
+	// staker_addr is the address of the staker that receives the rewards of
+	// this BTC delegation
+	StakerAddr string `protobuf:"bytes,3,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// fp_btc_pk_list is the list of hex str of Bitcoin secp256k1 PKs of the
+	// finality providers that this BTC delegation restakes to
+	FpBtcPkList []string `protobuf:"bytes,4,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3" json:"fp_btc_pk_list,omitempty"`
+	// total_sat is the total amount of BTC stakes in this BTC delegation
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,5,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
 }
 
 func (m *EventBTCDelegationStateUpdate) Reset()         { *m = EventBTCDelegationStateUpdate{} }
 func (m *EventBTCDelegationStateUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationStateUpdate) ProtoMessage()    {}
 func (*EventBTCDelegationStateUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{2}
+	return fileDescriptor_74118427820fff75, []int{5}
 }
 func (m *EventBTCDelegationStateUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -334,6 +551,27 @@ func (m *EventBTCDelegationStateUpdate) GetNewState() BTCDelegationStatus {
 	return BTCDelegationStatus_PENDING
 }
 
+func (m *EventBTCDelegationStateUpdate) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationStateUpdate) GetFpBtcPkList() []string {
+	if m != nil {
+		return m.FpBtcPkList
+	}
+	return nil
+}
+
+func (m *EventBTCDelegationStateUpdate) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
+}
+
 // EventSelectiveSlashing is the event emitted when an adversarial
 // finality provider selectively slashes a BTC delegation. This will
 // result in slashing of all BTC delegations under this finality provider.
@@ -346,7 +584,7 @@ func (m *EventSelectiveSlashing) Reset()         { *m = EventSelectiveSlashing{}
 func (m *EventSelectiveSlashing) String() string { return proto.CompactTextString(m) }
 func (*EventSelectiveSlashing) ProtoMessage()    {}
 func (*EventSelectiveSlashing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{3}
+	return fileDescriptor_74118427820fff75, []int{6}
 }
 func (m *EventSelectiveSlashing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,6 +630,7 @@ type EventPowerDistUpdate struct {
 	//	*EventPowerDistUpdate_JailedFp
 	//	*EventPowerDistUpdate_UnjailedFp
 	//	*EventPowerDistUpdate_BtcDelStateUpdate
+	//	*EventPowerDistUpdate_CommissionUpdatedFp
 	Ev isEventPowerDistUpdate_Ev `protobuf_oneof:"ev"`
 }
 
@@ -399,7 +638,7 @@ func (m *EventPowerDistUpdate) Reset()         { *m = EventPowerDistUpdate{} }
 func (m *EventPowerDistUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventPowerDistUpdate) ProtoMessage()    {}
 func (*EventPowerDistUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4}
+	return fileDescriptor_74118427820fff75, []int{7}
 }
 func (m *EventPowerDistUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,11 +685,15 @@ type EventPowerDistUpdate_UnjailedFp struct {
 type EventPowerDistUpdate_BtcDelStateUpdate struct {
 	BtcDelStateUpdate *EventBTCDelegationStateUpdate `protobuf:"bytes,4,opt,name=btc_del_state_update,json=btcDelStateUpdate,proto3,oneof" json:"btc_del_state_update,omitempty"`
 }
+type EventPowerDistUpdate_CommissionUpdatedFp struct {
+	CommissionUpdatedFp *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider `protobuf:"bytes,5,opt,name=commission_updated_fp,json=commissionUpdatedFp,proto3,oneof" json:"commission_updated_fp,omitempty"`
+}
 
-func (*EventPowerDistUpdate_SlashedFp) isEventPowerDistUpdate_Ev()         {}
-func (*EventPowerDistUpdate_JailedFp) isEventPowerDistUpdate_Ev()          {}
-func (*EventPowerDistUpdate_UnjailedFp) isEventPowerDistUpdate_Ev()        {}
-func (*EventPowerDistUpdate_BtcDelStateUpdate) isEventPowerDistUpdate_Ev() {}
+func (*EventPowerDistUpdate_SlashedFp) isEventPowerDistUpdate_Ev()           {}
+func (*EventPowerDistUpdate_JailedFp) isEventPowerDistUpdate_Ev()            {}
+func (*EventPowerDistUpdate_UnjailedFp) isEventPowerDistUpdate_Ev()          {}
+func (*EventPowerDistUpdate_BtcDelStateUpdate) isEventPowerDistUpdate_Ev()   {}
+func (*EventPowerDistUpdate_CommissionUpdatedFp) isEventPowerDistUpdate_Ev() {}
 
 func (m *EventPowerDistUpdate) GetEv() isEventPowerDistUpdate_Ev {
 	if m != nil {
@@ -487,6 +730,13 @@ func (m *EventPowerDistUpdate) GetBtcDelStateUpdate() *EventBTCDelegationStateUp
 	return nil
 }
 
+func (m *EventPowerDistUpdate) GetCommissionUpdatedFp() *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider {
+	if x, ok := m.GetEv().(*EventPowerDistUpdate_CommissionUpdatedFp); ok {
+		return x.CommissionUpdatedFp
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventPowerDistUpdate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -494,6 +744,7 @@ func (*EventPowerDistUpdate) XXX_OneofWrappers() []interface{} {
 		(*EventPowerDistUpdate_JailedFp)(nil),
 		(*EventPowerDistUpdate_UnjailedFp)(nil),
 		(*EventPowerDistUpdate_BtcDelStateUpdate)(nil),
+		(*EventPowerDistUpdate_CommissionUpdatedFp)(nil),
 	}
 }
 
@@ -512,7 +763,7 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) String() string {
 }
 func (*EventPowerDistUpdate_EventSlashedFinalityProvider) ProtoMessage() {}
 func (*EventPowerDistUpdate_EventSlashedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4, 0}
+	return fileDescriptor_74118427820fff75, []int{7, 0}
 }
 func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +806,7 @@ func (m *EventPowerDistUpdate_EventJailedFinalityProvider) String() string {
 }
 func (*EventPowerDistUpdate_EventJailedFinalityProvider) ProtoMessage() {}
 func (*EventPowerDistUpdate_EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4, 1}
+	return fileDescriptor_74118427820fff75, []int{7, 1}
 }
 func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -598,7 +849,7 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) String() string {
 }
 func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) ProtoMessage() {}
 func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4, 2}
+	return fileDescriptor_74118427820fff75, []int{7, 2}
 }
 func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,6 +878,50 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_DiscardUnknown(
 
 var xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider proto.InternalMessageInfo
 
+// EventCommissionUpdatedFinalityProvider defines an event that the
+// commission rate of a finality provider takes effect
+type EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider struct {
+	Pk         *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=pk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+	Commission *cosmossdk_io_math.LegacyDec                          `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
+}
+
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Reset() {
+	*m = EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider{}
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) String() string {
+	return proto.CompactTextString(m)
+}
+func (*EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) ProtoMessage() {}
+func (*EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{7, 3}
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider.Merge(m, src)
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider proto.InternalMessageInfo
+
 // A finality provider starts with status INACTIVE once registered.
 // Possible status transitions are when:
 // 1. it has accumulated sufficient delegations and has
@@ -641,6 +936,9 @@ var xxx_messageInfo_EventPowerDistUpdate_EventUnjailedFinalityProvider proto.Int
 // 5. it does not have sufficient delegations or does not
 // have timestamped public randomness:
 // ACTIVE -> INACTIVE.
+// 6. it has announced its retirement and its remaining voting
+// power has expired or been unbonded:
+// ACTIVE -> RETIRED
 // Note that it is impossible for a SLASHED finality provider to
 // transition to other status
 type EventFinalityProviderStatusChange struct {
@@ -655,7 +953,7 @@ func (m *EventFinalityProviderStatusChange) Reset()         { *m = EventFinality
 func (m *EventFinalityProviderStatusChange) String() string { return proto.CompactTextString(m) }
 func (*EventFinalityProviderStatusChange) ProtoMessage()    {}
 func (*EventFinalityProviderStatusChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{5}
+	return fileDescriptor_74118427820fff75, []int{8}
 }
 func (m *EventFinalityProviderStatusChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -728,7 +1026,7 @@ func (m *EventBTCDelegationCreated) Reset()         { *m = EventBTCDelegationCre
 func (m *EventBTCDelegationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationCreated) ProtoMessage()    {}
 func (*EventBTCDelegationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{6}
+	return fileDescriptor_74118427820fff75, []int{9}
 }
 func (m *EventBTCDelegationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +1136,7 @@ func (m *EventCovenantSignatureReceived) Reset()         { *m = EventCovenantSig
 func (m *EventCovenantSignatureReceived) String() string { return proto.CompactTextString(m) }
 func (*EventCovenantSignatureReceived) ProtoMessage()    {}
 func (*EventCovenantSignatureReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{7}
+	return fileDescriptor_74118427820fff75, []int{10}
 }
 func (m *EventCovenantSignatureReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -902,7 +1200,7 @@ func (m *EventCovenantQuorumReached) Reset()         { *m = EventCovenantQuorumR
 func (m *EventCovenantQuorumReached) String() string { return proto.CompactTextString(m) }
 func (*EventCovenantQuorumReached) ProtoMessage()    {}
 func (*EventCovenantQuorumReached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{8}
+	return fileDescriptor_74118427820fff75, []int{11}
 }
 func (m *EventCovenantQuorumReached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -967,7 +1265,7 @@ func (m *EventBTCDelegationInclusionProofReceived) Reset() {
 func (m *EventBTCDelegationInclusionProofReceived) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationInclusionProofReceived) ProtoMessage()    {}
 func (*EventBTCDelegationInclusionProofReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{9}
+	return fileDescriptor_74118427820fff75, []int{12}
 }
 func (m *EventBTCDelegationInclusionProofReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,7 +1338,7 @@ func (m *EventBTCDelgationUnbondedEarly) Reset()         { *m = EventBTCDelgatio
 func (m *EventBTCDelgationUnbondedEarly) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelgationUnbondedEarly) ProtoMessage()    {}
 func (*EventBTCDelgationUnbondedEarly) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{10}
+	return fileDescriptor_74118427820fff75, []int{13}
 }
 func (m *EventBTCDelgationUnbondedEarly) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1104,7 +1402,7 @@ func (m *EventBTCDelegationExpired) Reset()         { *m = EventBTCDelegationExp
 func (m *EventBTCDelegationExpired) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationExpired) ProtoMessage()    {}
 func (*EventBTCDelegationExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{11}
+	return fileDescriptor_74118427820fff75, []int{14}
 }
 func (m *EventBTCDelegationExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,6 +1445,105 @@ func (m *EventBTCDelegationExpired) GetNewState() string {
 	return ""
 }
 
+// EventBTCDelegationExpiringSoon is the event emitted when the voting power
+// of an active BTC delegation is about to expire, i.e., the BTC tip is within
+// `expiry_warning_blocks` BTC blocks of its expiry height, so that the staker
+// can unbond, extend or redelegate in time
+type EventBTCDelegationExpiringSoon struct {
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// staker_addr is the address of the staker that receives the rewards of
+	// this BTC delegation
+	StakerAddr string `protobuf:"bytes,2,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// finality_provider_btc_pks_hex is the list of hex str of Bitcoin secp256k1
+	// PKs of the finality providers that this BTC delegation restakes to
+	FinalityProviderBtcPksHex []string `protobuf:"bytes,3,rep,name=finality_provider_btc_pks_hex,json=finalityProviderBtcPksHex,proto3" json:"finality_provider_btc_pks_hex,omitempty"`
+	// total_sat is the total amount of BTC stakes in this BTC delegation
+	// quantified in satoshi
+	TotalSat string `protobuf:"bytes,4,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// end_height is the end BTC height of the BTC delegation
+	EndHeight string `protobuf:"bytes,5,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// expiry_height is the BTC height at which the voting power of the BTC
+	// delegation expires, i.e., end_height - unbonding_time
+	ExpiryHeight string `protobuf:"bytes,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *EventBTCDelegationExpiringSoon) Reset()         { *m = EventBTCDelegationExpiringSoon{} }
+func (m *EventBTCDelegationExpiringSoon) String() string { return proto.CompactTextString(m) }
+func (*EventBTCDelegationExpiringSoon) ProtoMessage()    {}
+func (*EventBTCDelegationExpiringSoon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{15}
+}
+func (m *EventBTCDelegationExpiringSoon) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCDelegationExpiringSoon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCDelegationExpiringSoon.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCDelegationExpiringSoon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCDelegationExpiringSoon.Merge(m, src)
+}
+func (m *EventBTCDelegationExpiringSoon) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCDelegationExpiringSoon) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCDelegationExpiringSoon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCDelegationExpiringSoon proto.InternalMessageInfo
+
+func (m *EventBTCDelegationExpiringSoon) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationExpiringSoon) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationExpiringSoon) GetFinalityProviderBtcPksHex() []string {
+	if m != nil {
+		return m.FinalityProviderBtcPksHex
+	}
+	return nil
+}
+
+func (m *EventBTCDelegationExpiringSoon) GetTotalSat() string {
+	if m != nil {
+		return m.TotalSat
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationExpiringSoon) GetEndHeight() string {
+	if m != nil {
+		return m.EndHeight
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationExpiringSoon) GetExpiryHeight() string {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return ""
+}
+
 // EventUnexpectedUnbondingTx is the event emitted when an unbonding tx is
 // is different that the one registered in the BTC delegation.
 type EventUnexpectedUnbondingTx struct {
@@ -1165,7 +1562,7 @@ func (m *EventUnexpectedUnbondingTx) Reset()         { *m = EventUnexpectedUnbon
 func (m *EventUnexpectedUnbondingTx) String() string { return proto.CompactTextString(m) }
 func (*EventUnexpectedUnbondingTx) ProtoMessage()    {}
 func (*EventUnexpectedUnbondingTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{12}
+	return fileDescriptor_74118427820fff75, []int{16}
 }
 func (m *EventUnexpectedUnbondingTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1222,24 +1619,297 @@ func (m *EventUnexpectedUnbondingTx) GetSpendStakeTxBlockIndex() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("babylon.btcstaking.v1.FinalityProviderStatus", FinalityProviderStatus_name, FinalityProviderStatus_value)
-	proto.RegisterType((*EventFinalityProviderCreated)(nil), "babylon.btcstaking.v1.EventFinalityProviderCreated")
-	proto.RegisterType((*EventFinalityProviderEdited)(nil), "babylon.btcstaking.v1.EventFinalityProviderEdited")
-	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
-	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
-	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
-	proto.RegisterType((*EventPowerDistUpdate_EventSlashedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventSlashedFinalityProvider")
-	proto.RegisterType((*EventPowerDistUpdate_EventJailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventJailedFinalityProvider")
-	proto.RegisterType((*EventPowerDistUpdate_EventUnjailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventUnjailedFinalityProvider")
-	proto.RegisterType((*EventFinalityProviderStatusChange)(nil), "babylon.btcstaking.v1.EventFinalityProviderStatusChange")
-	proto.RegisterType((*EventBTCDelegationCreated)(nil), "babylon.btcstaking.v1.EventBTCDelegationCreated")
-	proto.RegisterType((*EventCovenantSignatureReceived)(nil), "babylon.btcstaking.v1.EventCovenantSignatureReceived")
-	proto.RegisterType((*EventCovenantQuorumReached)(nil), "babylon.btcstaking.v1.EventCovenantQuorumReached")
-	proto.RegisterType((*EventBTCDelegationInclusionProofReceived)(nil), "babylon.btcstaking.v1.EventBTCDelegationInclusionProofReceived")
+// EventBTCDelegationExtended is the event emitted when an extension of a BTC
+// delegation becomes active and takes over the voting power of the BTC
+// delegation it extends
+type EventBTCDelegationExtended struct {
+	// prev_staking_tx_hash is the staking tx hash of the extended BTC delegation
+	PrevStakingTxHash string `protobuf:"bytes,1,opt,name=prev_staking_tx_hash,json=prevStakingTxHash,proto3" json:"prev_staking_tx_hash,omitempty"`
+	// new_staking_tx_hash is the staking tx hash of the extension
+	NewStakingTxHash string `protobuf:"bytes,2,opt,name=new_staking_tx_hash,json=newStakingTxHash,proto3" json:"new_staking_tx_hash,omitempty"`
+	// start_height is the start BTC height of the extension
+	StartHeight string `protobuf:"bytes,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end BTC height of the extension
+	EndHeight string `protobuf:"bytes,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *EventBTCDelegationExtended) Reset()         { *m = EventBTCDelegationExtended{} }
+func (m *EventBTCDelegationExtended) String() string { return proto.CompactTextString(m) }
+func (*EventBTCDelegationExtended) ProtoMessage()    {}
+func (*EventBTCDelegationExtended) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{17}
+}
+func (m *EventBTCDelegationExtended) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCDelegationExtended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCDelegationExtended.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCDelegationExtended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCDelegationExtended.Merge(m, src)
+}
+func (m *EventBTCDelegationExtended) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCDelegationExtended) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCDelegationExtended.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCDelegationExtended proto.InternalMessageInfo
+
+func (m *EventBTCDelegationExtended) GetPrevStakingTxHash() string {
+	if m != nil {
+		return m.PrevStakingTxHash
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationExtended) GetNewStakingTxHash() string {
+	if m != nil {
+		return m.NewStakingTxHash
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationExtended) GetStartHeight() string {
+	if m != nil {
+		return m.StartHeight
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationExtended) GetEndHeight() string {
+	if m != nil {
+		return m.EndHeight
+	}
+	return ""
+}
+
+// EventBTCDelegationRedelegated is the event emitted when a redelegation of a
+// BTC delegation becomes active and moves the voting power of the BTC
+// delegation it succeeds to other finality providers
+type EventBTCDelegationRedelegated struct {
+	// prev_staking_tx_hash is the staking tx hash of the redelegated BTC delegation
+	PrevStakingTxHash string `protobuf:"bytes,1,opt,name=prev_staking_tx_hash,json=prevStakingTxHash,proto3" json:"prev_staking_tx_hash,omitempty"`
+	// new_staking_tx_hash is the staking tx hash of the redelegation
+	NewStakingTxHash string `protobuf:"bytes,2,opt,name=new_staking_tx_hash,json=newStakingTxHash,proto3" json:"new_staking_tx_hash,omitempty"`
+	// prev_fp_btc_pk_list is the list of BTC PKs of the finality providers
+	// that the voting power is moved from
+	PrevFpBtcPkList []string `protobuf:"bytes,3,rep,name=prev_fp_btc_pk_list,json=prevFpBtcPkList,proto3" json:"prev_fp_btc_pk_list,omitempty"`
+	// new_fp_btc_pk_list is the list of BTC PKs of the finality providers
+	// that the voting power is moved to
+	NewFpBtcPkList []string `protobuf:"bytes,4,rep,name=new_fp_btc_pk_list,json=newFpBtcPkList,proto3" json:"new_fp_btc_pk_list,omitempty"`
+	// start_height is the start BTC height of the redelegation
+	StartHeight string `protobuf:"bytes,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end BTC height of the redelegation
+	EndHeight string `protobuf:"bytes,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *EventBTCDelegationRedelegated) Reset()         { *m = EventBTCDelegationRedelegated{} }
+func (m *EventBTCDelegationRedelegated) String() string { return proto.CompactTextString(m) }
+func (*EventBTCDelegationRedelegated) ProtoMessage()    {}
+func (*EventBTCDelegationRedelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{18}
+}
+func (m *EventBTCDelegationRedelegated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBTCDelegationRedelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBTCDelegationRedelegated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBTCDelegationRedelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBTCDelegationRedelegated.Merge(m, src)
+}
+func (m *EventBTCDelegationRedelegated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBTCDelegationRedelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBTCDelegationRedelegated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBTCDelegationRedelegated proto.InternalMessageInfo
+
+func (m *EventBTCDelegationRedelegated) GetPrevStakingTxHash() string {
+	if m != nil {
+		return m.PrevStakingTxHash
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationRedelegated) GetNewStakingTxHash() string {
+	if m != nil {
+		return m.NewStakingTxHash
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationRedelegated) GetPrevFpBtcPkList() []string {
+	if m != nil {
+		return m.PrevFpBtcPkList
+	}
+	return nil
+}
+
+func (m *EventBTCDelegationRedelegated) GetNewFpBtcPkList() []string {
+	if m != nil {
+		return m.NewFpBtcPkList
+	}
+	return nil
+}
+
+func (m *EventBTCDelegationRedelegated) GetStartHeight() string {
+	if m != nil {
+		return m.StartHeight
+	}
+	return ""
+}
+
+func (m *EventBTCDelegationRedelegated) GetEndHeight() string {
+	if m != nil {
+		return m.EndHeight
+	}
+	return ""
+}
+
+// EventAllowedStakingTxHashesAdded is the event emitted when staking tx hashes
+// are added to the allow list
+type EventAllowedStakingTxHashesAdded struct {
+	// staking_tx_hashes is the list of staking tx hashes that are newly added
+	StakingTxHashes []string `protobuf:"bytes,1,rep,name=staking_tx_hashes,json=stakingTxHashes,proto3" json:"staking_tx_hashes,omitempty"`
+}
+
+func (m *EventAllowedStakingTxHashesAdded) Reset()         { *m = EventAllowedStakingTxHashesAdded{} }
+func (m *EventAllowedStakingTxHashesAdded) String() string { return proto.CompactTextString(m) }
+func (*EventAllowedStakingTxHashesAdded) ProtoMessage()    {}
+func (*EventAllowedStakingTxHashesAdded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{19}
+}
+func (m *EventAllowedStakingTxHashesAdded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAllowedStakingTxHashesAdded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAllowedStakingTxHashesAdded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAllowedStakingTxHashesAdded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAllowedStakingTxHashesAdded.Merge(m, src)
+}
+func (m *EventAllowedStakingTxHashesAdded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAllowedStakingTxHashesAdded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAllowedStakingTxHashesAdded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAllowedStakingTxHashesAdded proto.InternalMessageInfo
+
+func (m *EventAllowedStakingTxHashesAdded) GetStakingTxHashes() []string {
+	if m != nil {
+		return m.StakingTxHashes
+	}
+	return nil
+}
+
+// EventAllowedStakingTxHashesRemoved is the event emitted when staking tx
+// hashes are removed from the allow list
+type EventAllowedStakingTxHashesRemoved struct {
+	// staking_tx_hashes is the list of staking tx hashes that are removed
+	StakingTxHashes []string `protobuf:"bytes,1,rep,name=staking_tx_hashes,json=stakingTxHashes,proto3" json:"staking_tx_hashes,omitempty"`
+}
+
+func (m *EventAllowedStakingTxHashesRemoved) Reset()         { *m = EventAllowedStakingTxHashesRemoved{} }
+func (m *EventAllowedStakingTxHashesRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventAllowedStakingTxHashesRemoved) ProtoMessage()    {}
+func (*EventAllowedStakingTxHashesRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{20}
+}
+func (m *EventAllowedStakingTxHashesRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAllowedStakingTxHashesRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAllowedStakingTxHashesRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAllowedStakingTxHashesRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAllowedStakingTxHashesRemoved.Merge(m, src)
+}
+func (m *EventAllowedStakingTxHashesRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAllowedStakingTxHashesRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAllowedStakingTxHashesRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAllowedStakingTxHashesRemoved proto.InternalMessageInfo
+
+func (m *EventAllowedStakingTxHashesRemoved) GetStakingTxHashes() []string {
+	if m != nil {
+		return m.StakingTxHashes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("babylon.btcstaking.v1.FinalityProviderStatus", FinalityProviderStatus_name, FinalityProviderStatus_value)
+	proto.RegisterType((*EventFinalityProviderCreated)(nil), "babylon.btcstaking.v1.EventFinalityProviderCreated")
+	proto.RegisterType((*EventFinalityProviderEdited)(nil), "babylon.btcstaking.v1.EventFinalityProviderEdited")
+	proto.RegisterType((*EventFinalityProviderCommissionChangeScheduled)(nil), "babylon.btcstaking.v1.EventFinalityProviderCommissionChangeScheduled")
+	proto.RegisterType((*EventFinalityProviderRetired)(nil), "babylon.btcstaking.v1.EventFinalityProviderRetired")
+	proto.RegisterType((*EventBTCDelegationFinalityProviderRetired)(nil), "babylon.btcstaking.v1.EventBTCDelegationFinalityProviderRetired")
+	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
+	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
+	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
+	proto.RegisterType((*EventPowerDistUpdate_EventSlashedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventSlashedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventJailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventJailedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventUnjailedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventUnjailedFinalityProvider")
+	proto.RegisterType((*EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate.EventCommissionUpdatedFinalityProvider")
+	proto.RegisterType((*EventFinalityProviderStatusChange)(nil), "babylon.btcstaking.v1.EventFinalityProviderStatusChange")
+	proto.RegisterType((*EventBTCDelegationCreated)(nil), "babylon.btcstaking.v1.EventBTCDelegationCreated")
+	proto.RegisterType((*EventCovenantSignatureReceived)(nil), "babylon.btcstaking.v1.EventCovenantSignatureReceived")
+	proto.RegisterType((*EventCovenantQuorumReached)(nil), "babylon.btcstaking.v1.EventCovenantQuorumReached")
+	proto.RegisterType((*EventBTCDelegationInclusionProofReceived)(nil), "babylon.btcstaking.v1.EventBTCDelegationInclusionProofReceived")
 	proto.RegisterType((*EventBTCDelgationUnbondedEarly)(nil), "babylon.btcstaking.v1.EventBTCDelgationUnbondedEarly")
 	proto.RegisterType((*EventBTCDelegationExpired)(nil), "babylon.btcstaking.v1.EventBTCDelegationExpired")
+	proto.RegisterType((*EventBTCDelegationExpiringSoon)(nil), "babylon.btcstaking.v1.EventBTCDelegationExpiringSoon")
 	proto.RegisterType((*EventUnexpectedUnbondingTx)(nil), "babylon.btcstaking.v1.EventUnexpectedUnbondingTx")
+	proto.RegisterType((*EventBTCDelegationExtended)(nil), "babylon.btcstaking.v1.EventBTCDelegationExtended")
+	proto.RegisterType((*EventBTCDelegationRedelegated)(nil), "babylon.btcstaking.v1.EventBTCDelegationRedelegated")
+	proto.RegisterType((*EventAllowedStakingTxHashesAdded)(nil), "babylon.btcstaking.v1.EventAllowedStakingTxHashesAdded")
+	proto.RegisterType((*EventAllowedStakingTxHashesRemoved)(nil), "babylon.btcstaking.v1.EventAllowedStakingTxHashesRemoved")
 }
 
 func init() {
@@ -1247,86 +1917,115 @@ func init() {
 }
 
 var fileDescriptor_74118427820fff75 = []byte{
-	// 1264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0xdb, 0xc6,
-	0x12, 0xb7, 0x24, 0x7f, 0xae, 0x1d, 0xc7, 0xd9, 0xe7, 0x17, 0xc8, 0x7a, 0x89, 0xe2, 0x28, 0x1f,
-	0xf0, 0xcb, 0x7b, 0x91, 0xf2, 0x61, 0xa0, 0xbd, 0x5a, 0xb6, 0x1c, 0x29, 0x35, 0x1c, 0x55, 0xb2,
-	0x03, 0xb4, 0x17, 0x62, 0x49, 0x8e, 0xa5, 0xad, 0xa8, 0x5d, 0x82, 0x5c, 0xca, 0xd2, 0xbd, 0x40,
-	0xaf, 0x39, 0x17, 0xe8, 0xbd, 0xb7, 0xf6, 0xcf, 0xe8, 0xa5, 0x40, 0x2e, 0x05, 0x8a, 0x1e, 0x8a,
-	0x22, 0x39, 0xf4, 0xbf, 0x28, 0x0a, 0xee, 0x2e, 0x29, 0xd1, 0xa6, 0x12, 0xbb, 0x48, 0x2e, 0x82,
-	0x76, 0xf7, 0x37, 0xf3, 0x9b, 0xf9, 0xed, 0xce, 0x0c, 0x88, 0x4a, 0x26, 0x31, 0x47, 0x0e, 0x67,
-	0x15, 0x53, 0x58, 0xbe, 0x20, 0x3d, 0xca, 0x3a, 0x95, 0xc1, 0xe3, 0x0a, 0x0c, 0x80, 0x09, 0xbf,
-	0xec, 0x7a, 0x5c, 0x70, 0xfc, 0x6f, 0x8d, 0x29, 0x8f, 0x31, 0xe5, 0xc1, 0xe3, 0xc2, 0x7a, 0x87,
-	0x77, 0xb8, 0x44, 0x54, 0xc2, 0x7f, 0x0a, 0x5c, 0xb8, 0x6b, 0x71, 0xbf, 0xcf, 0xfd, 0xca, 0xd8,
-	0x99, 0x09, 0x82, 0x3c, 0x8e, 0xd6, 0x1a, 0x75, 0x3f, 0x9d, 0x76, 0x82, 0x40, 0xe1, 0x36, 0x94,
-	0x37, 0x43, 0xd1, 0xa8, 0x85, 0x3e, 0xba, 0x46, 0xfa, 0x94, 0xf1, 0x8a, 0xfc, 0x55, 0x5b, 0xa5,
-	0x6f, 0xb3, 0xe8, 0x46, 0x2d, 0x8c, 0x7c, 0x9f, 0x32, 0xe2, 0x50, 0x31, 0x6a, 0x7a, 0x7c, 0x40,
-	0x6d, 0xf0, 0x76, 0x3d, 0x20, 0x02, 0x6c, 0x7c, 0x07, 0x21, 0x53, 0x58, 0x86, 0xdb, 0x33, 0xba,
-	0x30, 0xcc, 0x67, 0x36, 0x33, 0x5b, 0x4b, 0xd5, 0xb9, 0xef, 0xff, 0xfc, 0xf1, 0x41, 0xa6, 0xb5,
-	0x68, 0x0a, 0xab, 0xd9, 0xab, 0xc3, 0x10, 0x6f, 0xa0, 0x59, 0x62, 0xdb, 0x5e, 0x3e, 0x3b, 0x79,
-	0x2c, 0xb7, 0xf0, 0x3d, 0x84, 0x2c, 0xde, 0xef, 0x53, 0xdf, 0xa7, 0x9c, 0xe5, 0x73, 0x93, 0x80,
-	0x89, 0x03, 0x9c, 0x47, 0x0b, 0x7d, 0xce, 0x68, 0x0f, 0xbc, 0xfc, 0x6c, 0x88, 0x69, 0x45, 0x4b,
-	0x5c, 0x40, 0x8b, 0xd4, 0x06, 0x26, 0xa8, 0x18, 0xe5, 0xe7, 0xe4, 0x51, 0xbc, 0x0e, 0xad, 0x4e,
-	0xc1, 0xf4, 0xa9, 0x80, 0xfc, 0xbc, 0xb2, 0xd2, 0x4b, 0xfc, 0x5f, 0xb4, 0xe6, 0x83, 0x15, 0x78,
-	0x54, 0x8c, 0x0c, 0x8b, 0x33, 0x41, 0x2c, 0x91, 0x5f, 0x90, 0x90, 0xab, 0xd1, 0xfe, 0xae, 0xda,
-	0x0e, 0x9d, 0xd8, 0x20, 0x08, 0x75, 0xfc, 0xfc, 0xa2, 0x72, 0xa2, 0x97, 0xa5, 0xbf, 0x32, 0xe8,
-	0x3f, 0xa9, 0xe2, 0xd4, 0x6c, 0x7a, 0x61, 0x6d, 0x92, 0x02, 0x64, 0x2f, 0x20, 0x40, 0x6e, 0xba,
-	0x00, 0xb3, 0xd3, 0x05, 0x98, 0x7b, 0xbf, 0x00, 0xf3, 0xef, 0x15, 0x60, 0x21, 0x29, 0xc0, 0xab,
-	0x0c, 0xba, 0x29, 0x05, 0xa8, 0x1e, 0xed, 0xee, 0x81, 0x03, 0x1d, 0x22, 0x28, 0x67, 0x6d, 0x41,
-	0x04, 0x1c, 0xbb, 0x36, 0x11, 0x80, 0xef, 0xa3, 0xab, 0xfa, 0xf9, 0x19, 0x62, 0x68, 0x74, 0x89,
-	0xdf, 0x55, 0x3a, 0xb4, 0xae, 0xe8, 0xed, 0xa3, 0x61, 0x9d, 0xf8, 0x5d, 0xfc, 0x0c, 0x2d, 0x31,
-	0x38, 0x35, 0xfc, 0xd0, 0x54, 0x8a, 0xb0, 0xfa, 0xe4, 0x41, 0x39, 0xb5, 0x48, 0xca, 0xe7, 0xb8,
-	0x02, 0xbf, 0xb5, 0xc8, 0xe0, 0x54, 0xd2, 0x96, 0x4e, 0xd0, 0x75, 0x19, 0x51, 0x1b, 0x1c, 0xb0,
-	0x04, 0x1d, 0x40, 0xdb, 0x21, 0x7e, 0x97, 0xb2, 0x0e, 0x3e, 0x40, 0x8b, 0x10, 0xde, 0x0e, 0xb3,
-	0x40, 0xc6, 0xb0, 0xfc, 0xe4, 0xd1, 0x14, 0x86, 0x73, 0xb6, 0x35, 0x6d, 0xd7, 0x8a, 0x3d, 0x94,
-	0xbe, 0x9e, 0x47, 0xeb, 0x92, 0xa8, 0xc9, 0x4f, 0xc1, 0xdb, 0xa3, 0xbe, 0xd0, 0x19, 0x53, 0x84,
-	0xfc, 0xd0, 0x0c, 0x6c, 0xe3, 0xc4, 0xd5, 0x44, 0xf5, 0x29, 0x44, 0x69, 0x0e, 0xd4, 0x66, 0x5b,
-	0xb9, 0x38, 0xfb, 0xb0, 0xea, 0x33, 0xad, 0x25, 0xed, 0x7d, 0xdf, 0xc5, 0x27, 0x68, 0xe9, 0x2b,
-	0x42, 0x1d, 0xc5, 0x94, 0x95, 0x4c, 0xcf, 0x2e, 0xcd, 0xf4, 0x5c, 0x7a, 0x48, 0x21, 0x5a, 0x54,
-	0xbe, 0xf7, 0x5d, 0xec, 0xa0, 0xe5, 0x80, 0x8d, 0x99, 0x72, 0x92, 0xa9, 0x71, 0x69, 0xa6, 0x63,
-	0xed, 0x23, 0x85, 0x0b, 0x45, 0xfe, 0xf7, 0x5d, 0xdc, 0x41, 0xeb, 0x61, 0xd5, 0xd8, 0xe0, 0xa8,
-	0xe7, 0x60, 0x04, 0xd2, 0x87, 0x7c, 0xdb, 0xcb, 0x4f, 0xb6, 0xdf, 0x45, 0x3b, 0xed, 0x19, 0xd6,
-	0x67, 0x5a, 0xd7, 0x4c, 0x61, 0xed, 0x81, 0x33, 0xb1, 0x59, 0xe8, 0xea, 0xd6, 0x36, 0x45, 0x6b,
-	0x5c, 0x47, 0x59, 0xb7, 0x27, 0x6f, 0x70, 0xa5, 0xfa, 0xe9, 0x6f, 0xbf, 0xdf, 0xda, 0xee, 0x50,
-	0xd1, 0x0d, 0xcc, 0xb2, 0xc5, 0xfb, 0x15, 0x1d, 0x84, 0x43, 0x4c, 0xff, 0x21, 0xe5, 0xd1, 0xb2,
-	0x22, 0x46, 0x2e, 0xf8, 0xe5, 0x6a, 0xa3, 0xf9, 0x74, 0xfb, 0x51, 0x33, 0x30, 0x3f, 0x83, 0x51,
-	0x2b, 0xeb, 0xf6, 0x0a, 0x1d, 0xdd, 0x27, 0xd2, 0xb5, 0xfe, 0x80, 0x44, 0x54, 0xd7, 0xe3, 0x34,
-	0xa9, 0x3f, 0x1c, 0x55, 0x75, 0x16, 0x65, 0x61, 0x50, 0x02, 0x74, 0x3b, 0xb5, 0x03, 0xaa, 0xba,
-	0xdc, 0xed, 0x12, 0xd6, 0x01, 0x7c, 0x03, 0xcd, 0xab, 0x3e, 0x98, 0xec, 0x81, 0x73, 0xb2, 0x07,
-	0xe2, 0xd2, 0xd9, 0xd2, 0x1f, 0x37, 0xc9, 0xb8, 0xaa, 0x5f, 0xe7, 0xd0, 0xc6, 0xf9, 0x1b, 0x8e,
-	0x66, 0xd0, 0xff, 0xd0, 0xea, 0x64, 0x93, 0x39, 0xdb, 0x6b, 0x57, 0xc6, 0xad, 0x06, 0x86, 0xf8,
-	0x13, 0xb4, 0x1e, 0x81, 0x79, 0x20, 0xdc, 0x40, 0x18, 0x94, 0xd9, 0x30, 0x4c, 0x32, 0x63, 0x0d,
-	0x79, 0x21, 0x11, 0x8d, 0x10, 0x80, 0xff, 0x8f, 0x56, 0x5d, 0xe2, 0x91, 0xbe, 0x6f, 0x0c, 0xc0,
-	0x3b, 0x3f, 0xad, 0xae, 0xa8, 0xc3, 0x97, 0xea, 0x0c, 0x3f, 0x43, 0x37, 0x4f, 0xb4, 0x26, 0xe1,
-	0xa8, 0x95, 0xa2, 0x18, 0x4a, 0x05, 0x5f, 0x86, 0x38, 0xbb, 0x99, 0x1b, 0x1b, 0x6f, 0x9c, 0x9c,
-	0xd1, 0xaf, 0x1a, 0x4a, 0xe3, 0x87, 0xf1, 0x3e, 0x42, 0xd7, 0xc2, 0x60, 0x62, 0x6b, 0x69, 0x3c,
-	0x37, 0xc9, 0xbc, 0xaa, 0xce, 0xab, 0xd1, 0x44, 0xd9, 0x42, 0x2b, 0xb1, 0x1c, 0xb4, 0xaf, 0x47,
-	0x5f, 0x04, 0x5e, 0x8e, 0xc4, 0xa0, 0x7d, 0x08, 0x53, 0x0a, 0x98, 0xc9, 0x99, 0x1d, 0x63, 0x17,
-	0x12, 0x29, 0xc5, 0x87, 0x12, 0xbd, 0x85, 0x56, 0x26, 0xd0, 0x43, 0x35, 0x0d, 0x63, 0xbf, 0x63,
-	0xec, 0x30, 0x79, 0xa5, 0x4b, 0xe9, 0x57, 0xfa, 0x4b, 0x06, 0x15, 0xe5, 0x95, 0xee, 0xf2, 0x01,
-	0x30, 0xc2, 0x44, 0x9b, 0x76, 0x18, 0x11, 0x81, 0x07, 0x2d, 0xb0, 0x80, 0x0e, 0xc0, 0xc6, 0x0f,
-	0xa7, 0x0c, 0x8f, 0x38, 0xbe, 0xe4, 0x0c, 0xd9, 0x46, 0xff, 0xb2, 0xb4, 0xaf, 0x49, 0xad, 0x12,
-	0x17, 0xbb, 0x16, 0x21, 0x62, 0xb5, 0x0e, 0xd1, 0x66, 0x6c, 0x35, 0x4e, 0xcf, 0x8f, 0x82, 0x91,
-	0x2e, 0x12, 0x17, 0x7d, 0x33, 0x82, 0x1f, 0x47, 0xe8, 0x38, 0xf2, 0x3a, 0x0c, 0x4b, 0x1c, 0x15,
-	0x12, 0x69, 0x7d, 0x1e, 0x70, 0x2f, 0xe8, 0xb7, 0x80, 0x58, 0xdd, 0xcb, 0xa7, 0x74, 0x91, 0xda,
-	0xf8, 0x39, 0x83, 0xb6, 0xce, 0xd7, 0x46, 0x83, 0x59, 0x4e, 0x10, 0xbe, 0xc4, 0xa6, 0xc7, 0xf9,
-	0xc9, 0x3f, 0x95, 0x54, 0x3d, 0x25, 0x4f, 0x18, 0x5d, 0xa0, 0x9d, 0xae, 0x48, 0x86, 0xb0, 0x2c,
-	0x8f, 0xea, 0xf2, 0x04, 0xdf, 0x45, 0x08, 0x98, 0x1d, 0xe1, 0x12, 0x82, 0x2d, 0x01, 0xb3, 0x35,
-	0x2a, 0x91, 0xcf, 0x6c, 0x7a, 0x3e, 0xdf, 0x45, 0x0f, 0x43, 0xe5, 0xa3, 0xd2, 0x51, 0x5a, 0x83,
-	0x5d, 0x23, 0x9e, 0x33, 0xfa, 0x78, 0x59, 0x24, 0xe2, 0xcb, 0xa5, 0xc7, 0xc7, 0xd2, 0x5a, 0x51,
-	0x6d, 0xe8, 0x52, 0xef, 0xe3, 0xdc, 0xef, 0x37, 0x59, 0xfd, 0xa2, 0x8e, 0x19, 0x0c, 0x5d, 0xb0,
-	0x04, 0xd8, 0xc7, 0x13, 0xb5, 0x76, 0xf9, 0x22, 0xf1, 0xdd, 0xf0, 0xa6, 0x64, 0xd3, 0x88, 0x4d,
-	0x92, 0x45, 0x22, 0x11, 0xed, 0x10, 0xa0, 0xad, 0x76, 0x50, 0xe1, 0xac, 0x15, 0x90, 0xb0, 0xa3,
-	0x49, 0xe3, 0x84, 0x50, 0xd7, 0x13, 0xc6, 0x12, 0x35, 0xc5, 0x85, 0xe9, 0x70, 0xab, 0xa7, 0xbb,
-	0x6f, 0xf8, 0x16, 0xae, 0xa4, 0xba, 0xa8, 0x86, 0x28, 0xd9, 0x81, 0x1f, 0xfc, 0x90, 0x41, 0xd7,
-	0xd3, 0x07, 0x0d, 0xbe, 0x87, 0x6e, 0xef, 0x37, 0x0e, 0x77, 0x0e, 0x1a, 0x47, 0x5f, 0x18, 0xcd,
-	0xd6, 0x8b, 0x97, 0x8d, 0xbd, 0x5a, 0xcb, 0x68, 0x1f, 0xed, 0x1c, 0x1d, 0xb7, 0x8d, 0xc6, 0xe1,
-	0xce, 0xee, 0x51, 0xe3, 0x65, 0x6d, 0x6d, 0x06, 0xdf, 0x41, 0xb7, 0xa6, 0xc2, 0x34, 0x28, 0xf3,
-	0x4e, 0xd0, 0xf3, 0x9d, 0xc6, 0x41, 0x6d, 0x6f, 0x2d, 0x8b, 0xef, 0xa2, 0xcd, 0xa9, 0xa0, 0xf6,
-	0xc1, 0x4e, 0xbb, 0x5e, 0xdb, 0x5b, 0xcb, 0x55, 0x0f, 0x7f, 0x7a, 0x53, 0xcc, 0xbc, 0x7e, 0x53,
-	0xcc, 0xfc, 0xf1, 0xa6, 0x98, 0x79, 0xf5, 0xb6, 0x38, 0xf3, 0xfa, 0x6d, 0x71, 0xe6, 0xd7, 0xb7,
-	0xc5, 0x99, 0x2f, 0x2f, 0x30, 0x78, 0x87, 0x93, 0x9f, 0x72, 0x72, 0x0a, 0x9b, 0xf3, 0xf2, 0xab,
-	0xec, 0xe9, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x17, 0xaf, 0x52, 0x0e, 0x64, 0x0e, 0x00, 0x00,
+	// 1715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0xf5, 0xe1, 0xd8, 0xcf, 0x1f, 0x71, 0x18, 0x6f, 0xa0, 0x38, 0x1b, 0xc7, 0xcb, 0xec,
+	0x06, 0x5e, 0x77, 0x2d, 0x67, 0xb3, 0x41, 0x3f, 0xd0, 0x93, 0x64, 0xc9, 0x91, 0xb6, 0xae, 0xd7,
+	0xa5, 0xec, 0x00, 0xed, 0x85, 0xa0, 0xc8, 0x27, 0x69, 0x6a, 0x8a, 0x24, 0xc8, 0x91, 0x2c, 0xdd,
+	0x7a, 0x6b, 0x2f, 0x05, 0x7a, 0x2e, 0xd0, 0x43, 0x6f, 0x45, 0x4f, 0x3d, 0x2c, 0xda, 0x73, 0x6f,
+	0xbd, 0x14, 0x08, 0xb6, 0x58, 0xa0, 0xc8, 0x61, 0x51, 0x24, 0x87, 0xfe, 0x17, 0x45, 0x31, 0x1f,
+	0x94, 0x48, 0x99, 0x72, 0xa4, 0x85, 0xdb, 0x8b, 0xe1, 0x99, 0xf9, 0xbd, 0xaf, 0xdf, 0xbc, 0x79,
+	0xef, 0x51, 0xa0, 0x35, 0xcd, 0xe6, 0xd0, 0xf1, 0xdc, 0x83, 0x26, 0xb5, 0x42, 0x6a, 0x5e, 0x10,
+	0xb7, 0x7d, 0xd0, 0xff, 0xf4, 0x00, 0xfb, 0xe8, 0xd2, 0xb0, 0xe8, 0x07, 0x1e, 0xf5, 0xd4, 0xf7,
+	0x24, 0xa6, 0x38, 0xc6, 0x14, 0xfb, 0x9f, 0x6e, 0x6d, 0xb6, 0xbd, 0xb6, 0xc7, 0x11, 0x07, 0xec,
+	0x3f, 0x01, 0xde, 0x7a, 0x92, 0xae, 0x30, 0x26, 0x2a, 0x70, 0x77, 0xcc, 0x2e, 0x71, 0xbd, 0x03,
+	0xfe, 0x57, 0x6e, 0xdd, 0xb7, 0xbc, 0xb0, 0xeb, 0x85, 0x86, 0xd0, 0x29, 0x16, 0xe2, 0x48, 0xfb,
+	0x6d, 0x06, 0xde, 0xaf, 0x32, 0x9f, 0x8e, 0x88, 0x6b, 0x3a, 0x84, 0x0e, 0x4f, 0x03, 0xaf, 0x4f,
+	0x6c, 0x0c, 0x0e, 0x03, 0x34, 0x29, 0xda, 0xea, 0x63, 0x80, 0x26, 0xb5, 0x0c, 0xff, 0xc2, 0xe8,
+	0xe0, 0xa0, 0xa0, 0xec, 0x28, 0xbb, 0xcb, 0xe5, 0xfc, 0x1f, 0xfe, 0xfd, 0xa7, 0x3d, 0x45, 0x5f,
+	0x6a, 0x52, 0xeb, 0xf4, 0xa2, 0x86, 0x03, 0xf5, 0x3e, 0xe4, 0x4c, 0xdb, 0x0e, 0x0a, 0x99, 0xf8,
+	0x31, 0xdf, 0x52, 0x3f, 0x02, 0xb0, 0xbc, 0x6e, 0x97, 0x84, 0x21, 0xf1, 0xdc, 0x42, 0x36, 0x0e,
+	0x88, 0x1d, 0xa8, 0x05, 0xb8, 0xd5, 0xf5, 0x5c, 0x72, 0x81, 0x41, 0x21, 0xc7, 0x30, 0x7a, 0xb4,
+	0x54, 0xb7, 0x60, 0x89, 0xd8, 0xe8, 0x52, 0x42, 0x87, 0x85, 0x3c, 0x3f, 0x1a, 0xad, 0x99, 0xd4,
+	0x25, 0x36, 0x43, 0x42, 0xb1, 0xb0, 0x28, 0xa4, 0xe4, 0x52, 0xfd, 0x18, 0x36, 0x42, 0xb4, 0x7a,
+	0x01, 0xa1, 0x43, 0xc3, 0xf2, 0x5c, 0x6a, 0x5a, 0xb4, 0x70, 0x8b, 0x43, 0x6e, 0x47, 0xfb, 0x87,
+	0x62, 0x9b, 0x29, 0xb1, 0x91, 0x9a, 0xc4, 0x09, 0x0b, 0x4b, 0x42, 0x89, 0x5c, 0x6a, 0xff, 0x51,
+	0xe0, 0x41, 0x2a, 0x39, 0x55, 0x9b, 0xcc, 0xcc, 0x4d, 0x92, 0x80, 0xcc, 0x0c, 0x04, 0x64, 0xa7,
+	0x13, 0x90, 0x9b, 0x4e, 0x40, 0xfe, 0xdd, 0x04, 0x2c, 0xbe, 0x93, 0x80, 0x5b, 0x49, 0x02, 0xfe,
+	0xa8, 0x40, 0x31, 0x3d, 0x3b, 0x46, 0x8e, 0x1f, 0x76, 0x4c, 0xb7, 0x8d, 0x0d, 0xab, 0x83, 0x76,
+	0xcf, 0xb9, 0x61, 0x4e, 0x3e, 0x81, 0x75, 0x6c, 0xb5, 0xd0, 0xa2, 0xa4, 0x8f, 0x06, 0x25, 0x5d,
+	0x4c, 0xe6, 0xcf, 0xda, 0xe8, 0xf0, 0x8c, 0x74, 0x51, 0xfb, 0x85, 0x32, 0x25, 0x95, 0x75, 0xa4,
+	0x24, 0x98, 0xd5, 0xb5, 0x1f, 0xc2, 0xbd, 0x40, 0xe0, 0x0d, 0xf9, 0xe0, 0x8c, 0x0e, 0x92, 0x76,
+	0x87, 0x26, 0xdd, 0xdc, 0x94, 0xa0, 0xb2, 0xc0, 0xd4, 0x38, 0x44, 0xfb, 0xbd, 0x02, 0x1f, 0x73,
+	0x17, 0xca, 0x67, 0x87, 0x15, 0x74, 0xb0, 0x6d, 0x52, 0xe2, 0xb9, 0xd3, 0xfc, 0xd9, 0x87, 0xdb,
+	0xf2, 0xe9, 0x1a, 0x74, 0x60, 0x74, 0xcc, 0xb0, 0x93, 0x74, 0x6a, 0x4d, 0x9e, 0x9e, 0x0d, 0x6a,
+	0x66, 0xd8, 0x51, 0x77, 0x61, 0xad, 0xe5, 0x1b, 0xb1, 0x08, 0x92, 0xbc, 0xb5, 0xfc, 0x72, 0x14,
+	0xc3, 0x03, 0xc8, 0x87, 0xd4, 0xa4, 0x13, 0x74, 0x89, 0x3d, 0xed, 0xd7, 0x19, 0x78, 0x78, 0xd5,
+	0xc7, 0x06, 0x3b, 0x3b, 0xf7, 0x6d, 0x93, 0xa2, 0xfa, 0x64, 0x8a, 0x5f, 0x93, 0x0e, 0xbd, 0x80,
+	0x65, 0x17, 0x2f, 0x0d, 0x61, 0x8a, 0x39, 0xb3, 0xfe, 0x6c, 0xaf, 0x98, 0x5a, 0xd2, 0x8a, 0x57,
+	0x6c, 0xf5, 0x42, 0x7d, 0xc9, 0xc5, 0x4b, 0x6e, 0x56, 0xfd, 0x01, 0xac, 0x30, 0x2c, 0x06, 0x06,
+	0xaf, 0x22, 0xc2, 0xeb, 0xc2, 0x57, 0x5f, 0xee, 0x6f, 0xca, 0x5a, 0x55, 0xb2, 0xed, 0x00, 0xc3,
+	0xb0, 0x41, 0x03, 0xe2, 0xb6, 0x75, 0x10, 0x60, 0xb6, 0xa9, 0x3e, 0x86, 0xf5, 0x31, 0x29, 0x0e,
+	0x09, 0x69, 0x21, 0xb7, 0x93, 0xdd, 0x5d, 0xd6, 0x57, 0x24, 0x1d, 0xc7, 0x24, 0xa4, 0xea, 0x03,
+	0x58, 0xa6, 0x1e, 0x35, 0x1d, 0x23, 0x34, 0x29, 0x7f, 0x27, 0x39, 0x7d, 0x89, 0x6f, 0x34, 0x4c,
+	0xaa, 0xb5, 0xe0, 0x1e, 0xa7, 0xa3, 0x81, 0x8e, 0x48, 0xa6, 0x86, 0x63, 0x86, 0x1d, 0xe2, 0xb6,
+	0xd5, 0x63, 0x58, 0x42, 0x76, 0x61, 0xae, 0x85, 0x9c, 0x80, 0x95, 0x67, 0x4f, 0xa7, 0x84, 0x77,
+	0x45, 0xb6, 0x2a, 0xe5, 0xf4, 0x91, 0x06, 0xed, 0x1f, 0x4b, 0xb0, 0xc9, 0x0d, 0x9d, 0x7a, 0x97,
+	0x18, 0x54, 0x48, 0x48, 0x25, 0xdd, 0x04, 0x20, 0x64, 0x62, 0x68, 0x1b, 0x2d, 0x5f, 0x1a, 0xaa,
+	0x4d, 0x31, 0x94, 0xa6, 0x40, 0x6c, 0x36, 0x84, 0x8a, 0xc9, 0x5c, 0xab, 0x2d, 0xe8, 0xcb, 0x52,
+	0xfb, 0x91, 0xaf, 0xb6, 0x60, 0xf9, 0xe7, 0x26, 0x71, 0x84, 0xa5, 0x0c, 0xb7, 0xf4, 0x62, 0x6e,
+	0x4b, 0x9f, 0x73, 0x0d, 0x29, 0x86, 0x96, 0x84, 0xee, 0x23, 0x5f, 0x75, 0x60, 0xa5, 0xe7, 0x8e,
+	0x2d, 0x65, 0xb9, 0xa5, 0xfa, 0xdc, 0x96, 0xce, 0xa5, 0x8e, 0x14, 0x5b, 0x10, 0xe9, 0x3f, 0xf2,
+	0xd5, 0x36, 0x6c, 0xb2, 0x04, 0xb0, 0xd1, 0x11, 0xb9, 0x68, 0xf4, 0xb8, 0x0e, 0x5e, 0x2c, 0x57,
+	0x9e, 0x3d, 0xbf, 0xce, 0xec, 0xb4, 0x37, 0x50, 0x5b, 0xd0, 0xef, 0x34, 0xa9, 0x55, 0x41, 0x27,
+	0xfe, 0x30, 0x7e, 0xa5, 0xc0, 0x7b, 0xe3, 0xf2, 0x24, 0xcd, 0xf0, 0x08, 0xf3, 0xdc, 0x94, 0x3e,
+	0x77, 0x84, 0xe3, 0x3a, 0x2a, 0x76, 0xd3, 0x42, 0xbd, 0x6b, 0x5d, 0x01, 0xf9, 0x5b, 0x1d, 0x59,
+	0xeb, 0xa6, 0x5c, 0xbb, 0x5a, 0x83, 0x8c, 0x7f, 0xc1, 0x93, 0x69, 0xb5, 0xfc, 0xfd, 0xd7, 0xdf,
+	0x3c, 0x7a, 0xde, 0x26, 0xb4, 0xd3, 0x6b, 0x16, 0x2d, 0xaf, 0x7b, 0x20, 0x9d, 0x74, 0xcc, 0x66,
+	0xb8, 0x4f, 0xbc, 0x68, 0x79, 0x40, 0x87, 0x3e, 0x86, 0xc5, 0x72, 0xfd, 0xf4, 0xb3, 0xe7, 0x4f,
+	0x4f, 0x7b, 0xcd, 0x1f, 0xe1, 0x50, 0xcf, 0xf8, 0x17, 0x5b, 0x6d, 0xd9, 0x03, 0xd3, 0xaf, 0xfd,
+	0x06, 0x0d, 0x11, 0x59, 0x97, 0xa6, 0xdd, 0xfa, 0x0d, 0x9a, 0xfa, 0xab, 0x02, 0x4f, 0x66, 0xe3,
+	0xff, 0xe6, 0x8c, 0xaa, 0x3f, 0x4e, 0x69, 0x7a, 0xfb, 0xaf, 0xbf, 0x79, 0xf4, 0x40, 0x14, 0xb9,
+	0xd0, 0xbe, 0x28, 0x12, 0xef, 0xa0, 0x6b, 0xd2, 0x4e, 0xf1, 0x18, 0xdb, 0xa6, 0x35, 0xac, 0xa0,
+	0xf5, 0xd5, 0x97, 0xfb, 0x20, 0x6b, 0x60, 0x05, 0xad, 0x78, 0x73, 0x2c, 0xe7, 0x20, 0x83, 0x7d,
+	0x0d, 0xe1, 0x83, 0xd4, 0x9e, 0x27, 0x6a, 0xac, 0x68, 0xce, 0xea, 0xfb, 0xb0, 0x28, 0x2a, 0x64,
+	0xb2, 0xbf, 0xe4, 0x79, 0xd3, 0x53, 0xb5, 0xc9, 0x32, 0x3e, 0xee, 0x8a, 0x51, 0x85, 0xd6, 0x5e,
+	0x65, 0xe1, 0xfe, 0xd5, 0x07, 0x13, 0xcd, 0x88, 0xdf, 0x81, 0xf5, 0x78, 0xc3, 0x98, 0x6c, 0xae,
+	0xab, 0xe3, 0xb6, 0x81, 0x03, 0xf5, 0x7b, 0xb0, 0x19, 0x81, 0xbd, 0x1e, 0xf5, 0x7b, 0xd4, 0x20,
+	0xae, 0x3d, 0xd9, 0xcd, 0x54, 0x09, 0xf9, 0x82, 0x23, 0xea, 0x0c, 0xc0, 0xa6, 0x01, 0xdf, 0x0c,
+	0xcc, 0x6e, 0x68, 0xf4, 0x31, 0xb8, 0x3a, 0x4d, 0xae, 0x89, 0xc3, 0x97, 0xe2, 0x4c, 0x7d, 0x01,
+	0x0f, 0x5b, 0x92, 0x13, 0x36, 0xf7, 0x72, 0x52, 0x64, 0x9f, 0x08, 0xb9, 0x8b, 0xbc, 0x4f, 0x44,
+	0xc2, 0xf7, 0x5b, 0x13, 0xfc, 0xf1, 0xe6, 0x11, 0x32, 0x7f, 0x9f, 0xc2, 0x1d, 0xd9, 0x9c, 0x62,
+	0xad, 0x37, 0x1f, 0xb7, 0xbc, 0x2e, 0xce, 0x47, 0xed, 0x77, 0x17, 0x56, 0x47, 0x74, 0xb0, 0xa1,
+	0x65, 0x31, 0x0e, 0x5e, 0x89, 0xc8, 0x20, 0x5d, 0x64, 0x21, 0xf5, 0xdc, 0xa6, 0xe7, 0xda, 0x23,
+	0xec, 0xad, 0x44, 0x48, 0xa3, 0x43, 0x8e, 0xde, 0x85, 0xd5, 0x18, 0x7a, 0x20, 0xa6, 0xd5, 0x91,
+	0xde, 0x31, 0x76, 0x90, 0xbc, 0xd2, 0xe5, 0xf4, 0x2b, 0xfd, 0x5a, 0x81, 0x6d, 0xf9, 0x06, 0xfa,
+	0xe8, 0x9a, 0x2e, 0x6d, 0x90, 0xb6, 0x6b, 0xd2, 0x5e, 0x80, 0x3a, 0x5a, 0x48, 0xfa, 0xf3, 0x0f,
+	0x28, 0xcf, 0xe1, 0xae, 0x25, 0x75, 0x4d, 0x1d, 0x53, 0x36, 0x22, 0xc4, 0x88, 0xad, 0x13, 0xd8,
+	0x19, 0x49, 0x8d, 0xc3, 0x0b, 0x23, 0x67, 0xb8, 0x8a, 0xc4, 0x45, 0x3f, 0x8c, 0xe0, 0xe7, 0x11,
+	0x7a, 0xe4, 0x79, 0x0d, 0x07, 0x9a, 0x07, 0x5b, 0x89, 0xb0, 0x7e, 0xd2, 0xf3, 0x82, 0x5e, 0x57,
+	0x47, 0x93, 0x8d, 0xa8, 0xf3, 0x86, 0x34, 0xcb, 0xdb, 0xf8, 0xbb, 0x02, 0xbb, 0x57, 0xdf, 0x46,
+	0xdd, 0xb5, 0x9c, 0x1e, 0xcb, 0xc4, 0xd3, 0xc0, 0xf3, 0x5a, 0xdf, 0x96, 0x52, 0x91, 0x4a, 0x01,
+	0x4d, 0x9d, 0x41, 0x57, 0xf8, 0x91, 0x18, 0x3d, 0xd5, 0x0f, 0x01, 0xd0, 0xb5, 0x23, 0x5c, 0x82,
+	0xb0, 0x65, 0x74, 0x6d, 0x89, 0x4a, 0xc4, 0x93, 0x4b, 0x8f, 0xe7, 0x77, 0x51, 0x62, 0x88, 0x78,
+	0x44, 0x38, 0x82, 0x6b, 0xb4, 0xab, 0x66, 0xe0, 0x0c, 0xff, 0x77, 0x51, 0x24, 0xfc, 0xcb, 0xa6,
+	0xfb, 0xe7, 0xa6, 0x95, 0xa2, 0xea, 0xc0, 0xff, 0x36, 0x33, 0xf5, 0x2c, 0xf7, 0xfb, 0xe7, 0x4c,
+	0x82, 0x8f, 0xb8, 0x41, 0x96, 0x7b, 0x9e, 0xe7, 0xce, 0x6b, 0xf5, 0x49, 0x72, 0xde, 0x4d, 0xce,
+	0xf1, 0xb1, 0xe1, 0xf6, 0x9d, 0x35, 0x2c, 0x3b, 0x63, 0x0d, 0xd3, 0xe2, 0x03, 0x70, 0xf2, 0xda,
+	0xa3, 0x39, 0x78, 0x22, 0x81, 0xf2, 0x53, 0x12, 0x68, 0x0f, 0xd6, 0x90, 0x45, 0x3e, 0x8c, 0x80,
+	0x89, 0xe2, 0xb6, 0x2a, 0xce, 0xe4, 0xd7, 0xd0, 0x2f, 0x33, 0xf2, 0x29, 0x9e, 0xbb, 0x38, 0xf0,
+	0xd1, 0xa2, 0x68, 0x9f, 0xc7, 0x8a, 0xd4, 0xfc, 0xd5, 0x25, 0xf4, 0x99, 0x87, 0x9c, 0xa0, 0x91,
+	0x48, 0xb2, 0xba, 0x70, 0x44, 0x83, 0x01, 0xa4, 0x54, 0x09, 0xb6, 0x26, 0xa5, 0xd0, 0x64, 0x34,
+	0x72, 0xe1, 0x44, 0x86, 0xdd, 0x4b, 0x08, 0x73, 0xd4, 0x14, 0x15, 0x4d, 0xc7, 0xb3, 0x2e, 0x64,
+	0xdb, 0x62, 0x6c, 0xae, 0xa5, 0xaa, 0x28, 0x33, 0x14, 0x6f, 0x5d, 0xda, 0x6b, 0x45, 0x32, 0x31,
+	0x91, 0x42, 0x14, 0xd9, 0x9b, 0x52, 0xbf, 0x0b, 0x9b, 0x7e, 0x80, 0x7d, 0xe3, 0x5a, 0x3a, 0xee,
+	0x30, 0x48, 0x63, 0x92, 0x12, 0x99, 0xbd, 0x09, 0xb1, 0x24, 0x25, 0x22, 0x8f, 0xaf, 0x79, 0x8d,
+	0xd9, 0x19, 0x6b, 0x4a, 0x2e, 0x3d, 0x25, 0xb4, 0xbf, 0xa4, 0x7e, 0x50, 0xea, 0x68, 0x8b, 0xff,
+	0xff, 0xef, 0xf1, 0x7d, 0x02, 0x77, 0xb9, 0xb5, 0x89, 0xef, 0x42, 0xfe, 0x56, 0xf4, 0xdb, 0xec,
+	0xe8, 0x28, 0xf6, 0x6d, 0xb8, 0x07, 0x2a, 0xb3, 0x91, 0xfa, 0x11, 0xb9, 0xee, 0xe2, 0x65, 0x1c,
+	0x3b, 0xc9, 0x5c, 0x7e, 0x46, 0xe6, 0x16, 0xa7, 0x30, 0x77, 0x02, 0x3b, 0x9c, 0xb8, 0x92, 0xe3,
+	0x78, 0x97, 0x68, 0x27, 0xc2, 0xc0, 0xb0, 0x64, 0xb3, 0xdc, 0xd8, 0x13, 0xe3, 0x47, 0x2c, 0x7e,
+	0x0c, 0x0b, 0x8a, 0x88, 0x25, 0x4c, 0x0a, 0x68, 0xa7, 0xa0, 0x5d, 0xa3, 0x4f, 0xc7, 0xae, 0xd7,
+	0x9f, 0x4f, 0xe3, 0xde, 0xd7, 0x0a, 0xdc, 0x4b, 0x1f, 0x2d, 0xd5, 0x8f, 0xe0, 0x83, 0xa3, 0xfa,
+	0x49, 0xe9, 0xb8, 0x7e, 0xf6, 0x53, 0xe3, 0x54, 0xff, 0xe2, 0x65, 0xbd, 0x52, 0xd5, 0x8d, 0xc6,
+	0x59, 0xe9, 0xec, 0xbc, 0x61, 0xd4, 0x4f, 0x4a, 0x87, 0x67, 0xf5, 0x97, 0xd5, 0x8d, 0x05, 0xf5,
+	0x31, 0x3c, 0x9a, 0x0a, 0x93, 0x20, 0xe5, 0x5a, 0xd0, 0xe7, 0xa5, 0xfa, 0x71, 0xb5, 0xb2, 0x91,
+	0x51, 0x3f, 0x84, 0x9d, 0xa9, 0xa0, 0xc6, 0x71, 0xa9, 0x51, 0xab, 0x56, 0x36, 0xb2, 0xd7, 0xa2,
+	0xf4, 0xea, 0x59, 0x5d, 0xaf, 0x56, 0x36, 0x72, 0xe5, 0x93, 0xbf, 0xbd, 0xd9, 0x56, 0x5e, 0xbd,
+	0xd9, 0x56, 0xfe, 0xf5, 0x66, 0x5b, 0xf9, 0xcd, 0xdb, 0xed, 0x85, 0x57, 0x6f, 0xb7, 0x17, 0xfe,
+	0xf9, 0x76, 0x7b, 0xe1, 0x67, 0x33, 0xcc, 0xf7, 0x83, 0xf8, 0x4f, 0xb0, 0x7c, 0xd8, 0x6f, 0x2e,
+	0xf2, 0x5f, 0x53, 0x3f, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x68, 0xb9, 0x36, 0xf6,
+	0x15, 0x00, 0x00,
 }
 
 func (m *EventFinalityProviderCreated) Marshal() (dAtA []byte, err error) {
@@ -1480,7 +2179,7 @@ func (m *EventFinalityProviderEdited) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *EventBTCDelegationStateUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventFinalityProviderCommissionChangeScheduled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1490,32 +2189,41 @@ func (m *EventBTCDelegationStateUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventBTCDelegationStateUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventFinalityProviderCommissionChangeScheduled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBTCDelegationStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventFinalityProviderCommissionChangeScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NewState != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewState))
+	if len(m.EffectiveTime) > 0 {
+		i -= len(m.EffectiveTime)
+		copy(dAtA[i:], m.EffectiveTime)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EffectiveTime)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+	if len(m.Commission) > 0 {
+		i -= len(m.Commission)
+		copy(dAtA[i:], m.Commission)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Commission)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcPkHex) > 0 {
+		i -= len(m.BtcPkHex)
+		copy(dAtA[i:], m.BtcPkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BtcPkHex)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSelectiveSlashing) Marshal() (dAtA []byte, err error) {
+func (m *EventFinalityProviderRetired) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1525,32 +2233,34 @@ func (m *EventSelectiveSlashing) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSelectiveSlashing) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventFinalityProviderRetired) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSelectiveSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventFinalityProviderRetired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Evidence != nil {
-		{
-			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if len(m.RetiredBabylonHeight) > 0 {
+		i -= len(m.RetiredBabylonHeight)
+		copy(dAtA[i:], m.RetiredBabylonHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RetiredBabylonHeight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcPkHex) > 0 {
+		i -= len(m.BtcPkHex)
+		copy(dAtA[i:], m.BtcPkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BtcPkHex)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPowerDistUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventBTCDelegationFinalityProviderRetired) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1560,59 +2270,194 @@ func (m *EventPowerDistUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventPowerDistUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBTCDelegationFinalityProviderRetired) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPowerDistUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBTCDelegationFinalityProviderRetired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Ev != nil {
-		{
-			size := m.Ev.Size()
-			i -= size
-			if _, err := m.Ev.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventPowerDistUpdate_SlashedFp) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBTCDelegationStateUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventPowerDistUpdate_SlashedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SlashedFp != nil {
-		{
-			size, err := m.SlashedFp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *EventPowerDistUpdate_JailedFp) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *EventBTCDelegationStateUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventPowerDistUpdate_JailedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBTCDelegationStateUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.JailedFp != nil {
-		{
-			size, err := m.JailedFp.MarshalToSizedBuffer(dAtA[:i])
+	_ = i
+	var l int
+	_ = l
+	if m.TotalSat != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FpBtcPkList[iNdEx])
+			copy(dAtA[i:], m.FpBtcPkList[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.FpBtcPkList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewState != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewState))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSelectiveSlashing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSelectiveSlashing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSelectiveSlashing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Evidence != nil {
+		{
+			size, err := m.Evidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPowerDistUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ev != nil {
+		{
+			size := m.Ev.Size()
+			i -= size
+			if _, err := m.Ev.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPowerDistUpdate_SlashedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_SlashedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SlashedFp != nil {
+		{
+			size, err := m.SlashedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventPowerDistUpdate_JailedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_JailedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JailedFp != nil {
+		{
+			size, err := m.JailedFp.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1666,6 +2511,27 @@ func (m *EventPowerDistUpdate_BtcDelStateUpdate) MarshalToSizedBuffer(dAtA []byt
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventPowerDistUpdate_CommissionUpdatedFp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_CommissionUpdatedFp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CommissionUpdatedFp != nil {
+		{
+			size, err := m.CommissionUpdatedFp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1771,6 +2637,53 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) MarshalToSizedBuffe
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventFinalityProviderStatusChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2109,6 +3022,73 @@ func (m *EventBTCDelegationExpired) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBTCDelegationExpiringSoon) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDelegationExpiringSoon) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelegationExpiringSoon) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpiryHeight) > 0 {
+		i -= len(m.ExpiryHeight)
+		copy(dAtA[i:], m.ExpiryHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExpiryHeight)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.EndHeight) > 0 {
+		i -= len(m.EndHeight)
+		copy(dAtA[i:], m.EndHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EndHeight)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalSat) > 0 {
+		i -= len(m.TotalSat)
+		copy(dAtA[i:], m.TotalSat)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TotalSat)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FinalityProviderBtcPksHex) > 0 {
+		for iNdEx := len(m.FinalityProviderBtcPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FinalityProviderBtcPksHex[iNdEx])
+			copy(dAtA[i:], m.FinalityProviderBtcPksHex[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.FinalityProviderBtcPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventUnexpectedUnbondingTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2158,24 +3138,208 @@ func (m *EventUnexpectedUnbondingTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventBTCDelegationExtended) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventFinalityProviderCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BtcPkHex)
+
+func (m *EventBTCDelegationExtended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelegationExtended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndHeight) > 0 {
+		i -= len(m.EndHeight)
+		copy(dAtA[i:], m.EndHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EndHeight)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StartHeight) > 0 {
+		i -= len(m.StartHeight)
+		copy(dAtA[i:], m.StartHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StartHeight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewStakingTxHash) > 0 {
+		i -= len(m.NewStakingTxHash)
+		copy(dAtA[i:], m.NewStakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PrevStakingTxHash) > 0 {
+		i -= len(m.PrevStakingTxHash)
+		copy(dAtA[i:], m.PrevStakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PrevStakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBTCDelegationRedelegated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDelegationRedelegated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelegationRedelegated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndHeight) > 0 {
+		i -= len(m.EndHeight)
+		copy(dAtA[i:], m.EndHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EndHeight)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StartHeight) > 0 {
+		i -= len(m.StartHeight)
+		copy(dAtA[i:], m.StartHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StartHeight)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewFpBtcPkList) > 0 {
+		for iNdEx := len(m.NewFpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NewFpBtcPkList[iNdEx])
+			copy(dAtA[i:], m.NewFpBtcPkList[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.NewFpBtcPkList[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PrevFpBtcPkList) > 0 {
+		for iNdEx := len(m.PrevFpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PrevFpBtcPkList[iNdEx])
+			copy(dAtA[i:], m.PrevFpBtcPkList[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PrevFpBtcPkList[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NewStakingTxHash) > 0 {
+		i -= len(m.NewStakingTxHash)
+		copy(dAtA[i:], m.NewStakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewStakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PrevStakingTxHash) > 0 {
+		i -= len(m.PrevStakingTxHash)
+		copy(dAtA[i:], m.PrevStakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PrevStakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAllowedStakingTxHashesAdded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAllowedStakingTxHashesAdded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAllowedStakingTxHashesAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashes) > 0 {
+		for iNdEx := len(m.StakingTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingTxHashes[iNdEx])
+			copy(dAtA[i:], m.StakingTxHashes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAllowedStakingTxHashesRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAllowedStakingTxHashesRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAllowedStakingTxHashesRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashes) > 0 {
+		for iNdEx := len(m.StakingTxHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingTxHashes[iNdEx])
+			copy(dAtA[i:], m.StakingTxHashes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHashes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventFinalityProviderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BtcPkHex)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -2247,6 +3411,65 @@ func (m *EventFinalityProviderEdited) Size() (n int) {
 	return n
 }
 
+func (m *EventFinalityProviderCommissionChangeScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Commission)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.EffectiveTime)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFinalityProviderRetired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RetiredBabylonHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBTCDelegationFinalityProviderRetired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventBTCDelegationStateUpdate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2260,6 +3483,19 @@ func (m *EventBTCDelegationStateUpdate) Size() (n int) {
 	if m.NewState != 0 {
 		n += 1 + sovEvents(uint64(m.NewState))
 	}
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, s := range m.FpBtcPkList {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovEvents(uint64(m.TotalSat))
+	}
 	return n
 }
 
@@ -2336,6 +3572,18 @@ func (m *EventPowerDistUpdate_BtcDelStateUpdate) Size() (n int) {
 	}
 	return n
 }
+func (m *EventPowerDistUpdate_CommissionUpdatedFp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommissionUpdatedFp != nil {
+		l = m.CommissionUpdatedFp.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2375,6 +3623,23 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Size() (n int) {
 	return n
 }
 
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventFinalityProviderStatusChange) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2540,7 +3805,7 @@ func (m *EventBTCDelegationExpired) Size() (n int) {
 	return n
 }
 
-func (m *EventUnexpectedUnbondingTx) Size() (n int) {
+func (m *EventBTCDelegationExpiringSoon) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2550,33 +3815,160 @@ func (m *EventUnexpectedUnbondingTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.SpendStakeTxHash)
+	l = len(m.StakerAddr)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.SpendStakeTxHeaderHash)
+	if len(m.FinalityProviderBtcPksHex) > 0 {
+		for _, s := range m.FinalityProviderBtcPksHex {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.TotalSat)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.SpendStakeTxBlockIndex != 0 {
-		n += 1 + sovEvents(uint64(m.SpendStakeTxBlockIndex))
+	l = len(m.EndHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExpiryHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *EventFinalityProviderCreated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+func (m *EventUnexpectedUnbondingTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SpendStakeTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SpendStakeTxHeaderHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SpendStakeTxBlockIndex != 0 {
+		n += 1 + sovEvents(uint64(m.SpendStakeTxBlockIndex))
+	}
+	return n
+}
+
+func (m *EventBTCDelegationExtended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrevStakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StartHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.EndHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBTCDelegationRedelegated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PrevStakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewStakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PrevFpBtcPkList) > 0 {
+		for _, s := range m.PrevFpBtcPkList {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.NewFpBtcPkList) > 0 {
+		for _, s := range m.NewFpBtcPkList {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.StartHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.EndHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAllowedStakingTxHashesAdded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakingTxHashes) > 0 {
+		for _, s := range m.StakingTxHashes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventAllowedStakingTxHashesRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakingTxHashes) > 0 {
+		for _, s := range m.StakingTxHashes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventFinalityProviderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowEvents
 			}
@@ -3150,7 +4542,7 @@ func (m *EventFinalityProviderEdited) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventFinalityProviderCommissionChangeScheduled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3173,15 +4565,15 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFinalityProviderCommissionChangeScheduled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFinalityProviderCommissionChangeScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPkHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3209,13 +4601,13 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			m.BtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			m.NewState = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3225,11 +4617,56 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewState |= BTCDelegationStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3251,7 +4688,7 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
+func (m *EventFinalityProviderRetired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3274,17 +4711,17 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSelectiveSlashing: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFinalityProviderRetired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSelectiveSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFinalityProviderRetired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3294,27 +4731,55 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &SelectiveSlashingEvidence{}
+			m.BtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredBabylonHeight", wireType)
 			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RetiredBabylonHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3337,7 +4802,7 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventBTCDelegationFinalityProviderRetired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3356,21 +4821,1312 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventPowerDistUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPowerDistUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDelegationFinalityProviderRetired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelegationFinalityProviderRetired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+			}
+			m.NewState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewState |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkList = append(m.FpBtcPkList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			m.TotalSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSelectiveSlashing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSelectiveSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &SelectiveSlashingEvidence{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPowerDistUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPowerDistUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventSlashedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_SlashedFp{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventJailedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_JailedFp{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventUnjailedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_UnjailedFp{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelStateUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventBTCDelegationStateUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_BtcDelStateUpdate{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionUpdatedFp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ev = &EventPowerDistUpdate_CommissionUpdatedFp{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPowerDistUpdate_EventCommissionUpdatedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommissionUpdatedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommissionUpdatedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFinalityProviderStatusChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalityProviderStatusChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalityProviderStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPk = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDelegationCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelegationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingOutputIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParamsVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviderBtcPksHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviderBtcPksHex = append(m.FinalityProviderBtcPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedFp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3380,32 +6136,29 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventPowerDistUpdate_EventSlashedFinalityProvider{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Ev = &EventPowerDistUpdate_SlashedFp{v}
+			m.StakingTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedFp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3415,32 +6168,29 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventPowerDistUpdate_EventJailedFinalityProvider{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Ev = &EventPowerDistUpdate_JailedFp{v}
+			m.UnbondingTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnjailedFp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3450,32 +6200,29 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventPowerDistUpdate_EventUnjailedFinalityProvider{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Ev = &EventPowerDistUpdate_UnjailedFp{v}
+			m.UnbondingTx = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelStateUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3485,26 +6232,23 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &EventBTCDelegationStateUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Ev = &EventPowerDistUpdate_BtcDelStateUpdate{v}
+			m.NewState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3527,7 +6271,7 @@ func (m *EventPowerDistUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *EventCovenantSignatureReceived) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3550,17 +6294,17 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCovenantSignatureReceived: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSlashedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCovenantSignatureReceived: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3570,82 +6314,29 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) Unmarshal(dAtA []byt
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventJailedFinalityProvider: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventJailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantBtcPkHex", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3655,82 +6346,29 @@ func (m *EventPowerDistUpdate_EventJailedFinalityProvider) Unmarshal(dAtA []byte
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CovenantBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventUnjailedFinalityProvider: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUnjailedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSignatureHex", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3740,26 +6378,23 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []by
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CovenantUnbondingSignatureHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3782,7 +6417,7 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) Unmarshal(dAtA []by
 	}
 	return nil
 }
-func (m *EventFinalityProviderStatusChange) Unmarshal(dAtA []byte) error {
+func (m *EventCovenantQuorumReached) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3805,15 +6440,15 @@ func (m *EventFinalityProviderStatusChange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFinalityProviderStatusChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCovenantQuorumReached: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFinalityProviderStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCovenantQuorumReached: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3841,7 +6476,7 @@ func (m *EventFinalityProviderStatusChange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcPk = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3896,7 +6531,7 @@ func (m *EventFinalityProviderStatusChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
+func (m *EventBTCDelegationInclusionProofReceived) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3919,15 +6554,15 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBTCDelegationCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBTCDelegationInclusionProofReceived: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBTCDelegationCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBTCDelegationInclusionProofReceived: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3955,11 +6590,11 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3987,11 +6622,11 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingOutputIndex = string(dAtA[iNdEx:postIndex])
+			m.StartHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4019,11 +6654,11 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParamsVersion = string(dAtA[iNdEx:postIndex])
+			m.EndHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviderBtcPksHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4051,11 +6686,61 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviderBtcPksHex = append(m.FinalityProviderBtcPksHex, string(dAtA[iNdEx:postIndex]))
+			m.NewState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCDelgationUnbondedEarly) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDelgationUnbondedEarly: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelgationUnbondedEarly: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4083,11 +6768,11 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakerBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4115,11 +6800,11 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTime = string(dAtA[iNdEx:postIndex])
+			m.StartHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4147,11 +6832,61 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTime = string(dAtA[iNdEx:postIndex])
+			m.NewState = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBTCDelegationExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBTCDelegationExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelegationExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4179,9 +6914,9 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTx = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
 			}
@@ -4234,7 +6969,7 @@ func (m *EventBTCDelegationCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCovenantSignatureReceived) Unmarshal(dAtA []byte) error {
+func (m *EventBTCDelegationExpiringSoon) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4257,10 +6992,10 @@ func (m *EventCovenantSignatureReceived) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCovenantSignatureReceived: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBTCDelegationExpiringSoon: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCovenantSignatureReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBTCDelegationExpiringSoon: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4297,7 +7032,7 @@ func (m *EventCovenantSignatureReceived) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4325,11 +7060,11 @@ func (m *EventCovenantSignatureReceived) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSignatureHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviderBtcPksHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4357,7 +7092,103 @@ func (m *EventCovenantSignatureReceived) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantUnbondingSignatureHex = string(dAtA[iNdEx:postIndex])
+			m.FinalityProviderBtcPksHex = append(m.FinalityProviderBtcPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4380,7 +7211,7 @@ func (m *EventCovenantSignatureReceived) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCovenantQuorumReached) Unmarshal(dAtA []byte) error {
+func (m *EventUnexpectedUnbondingTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4403,10 +7234,10 @@ func (m *EventCovenantQuorumReached) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCovenantQuorumReached: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUnexpectedUnbondingTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCovenantQuorumReached: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUnexpectedUnbondingTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4443,7 +7274,7 @@ func (m *EventCovenantQuorumReached) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4471,8 +7302,59 @@ func (m *EventCovenantQuorumReached) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewState = string(dAtA[iNdEx:postIndex])
+			m.SpendStakeTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxHeaderHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendStakeTxHeaderHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxBlockIndex", wireType)
+			}
+			m.SpendStakeTxBlockIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendStakeTxBlockIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4494,7 +7376,7 @@ func (m *EventCovenantQuorumReached) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBTCDelegationInclusionProofReceived) Unmarshal(dAtA []byte) error {
+func (m *EventBTCDelegationExtended) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4517,15 +7399,15 @@ func (m *EventBTCDelegationInclusionProofReceived) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBTCDelegationInclusionProofReceived: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBTCDelegationExtended: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBTCDelegationInclusionProofReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBTCDelegationExtended: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevStakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4553,11 +7435,11 @@ func (m *EventBTCDelegationInclusionProofReceived) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			m.PrevStakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4585,11 +7467,11 @@ func (m *EventBTCDelegationInclusionProofReceived) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartHeight = string(dAtA[iNdEx:postIndex])
+			m.NewStakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4617,11 +7499,11 @@ func (m *EventBTCDelegationInclusionProofReceived) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndHeight = string(dAtA[iNdEx:postIndex])
+			m.StartHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4649,7 +7531,7 @@ func (m *EventBTCDelegationInclusionProofReceived) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewState = string(dAtA[iNdEx:postIndex])
+			m.EndHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4672,7 +7554,7 @@ func (m *EventBTCDelegationInclusionProofReceived) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *EventBTCDelgationUnbondedEarly) Unmarshal(dAtA []byte) error {
+func (m *EventBTCDelegationRedelegated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4695,15 +7577,15 @@ func (m *EventBTCDelgationUnbondedEarly) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBTCDelgationUnbondedEarly: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBTCDelegationRedelegated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBTCDelgationUnbondedEarly: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBTCDelegationRedelegated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevStakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4731,11 +7613,11 @@ func (m *EventBTCDelgationUnbondedEarly) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			m.PrevStakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStakingTxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4763,11 +7645,11 @@ func (m *EventBTCDelgationUnbondedEarly) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StartHeight = string(dAtA[iNdEx:postIndex])
+			m.NewStakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevFpBtcPkList", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4795,61 +7677,43 @@ func (m *EventBTCDelgationUnbondedEarly) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewState = string(dAtA[iNdEx:postIndex])
+			m.PrevFpBtcPkList = append(m.PrevFpBtcPkList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFpBtcPkList", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventBTCDelegationExpired) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventBTCDelegationExpired: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBTCDelegationExpired: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NewFpBtcPkList = append(m.NewFpBtcPkList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4877,11 +7741,11 @@ func (m *EventBTCDelegationExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			m.StartHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4909,7 +7773,7 @@ func (m *EventBTCDelegationExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewState = string(dAtA[iNdEx:postIndex])
+			m.EndHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4932,7 +7796,7 @@ func (m *EventBTCDelegationExpired) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUnexpectedUnbondingTx) Unmarshal(dAtA []byte) error {
+func (m *EventAllowedStakingTxHashesAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4955,15 +7819,15 @@ func (m *EventUnexpectedUnbondingTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUnexpectedUnbondingTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAllowedStakingTxHashesAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUnexpectedUnbondingTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAllowedStakingTxHashesAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4991,43 +7855,61 @@ func (m *EventUnexpectedUnbondingTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashes = append(m.StakingTxHashes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAllowedStakingTxHashesRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendStakeTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAllowedStakingTxHashesRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAllowedStakingTxHashesRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxHeaderHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5055,27 +7937,8 @@ func (m *EventUnexpectedUnbondingTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendStakeTxHeaderHash = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashes = append(m.StakingTxHashes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxBlockIndex", wireType)
-			}
-			m.SpendStakeTxBlockIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SpendStakeTxBlockIndex |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -38,9 +38,47 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
+
+	// each finality provider has at most one pending commission rate change
+	pendingFps := make(map[string]struct{}, len(gs.PendingCommissions))
+	for _, entry := range gs.PendingCommissions {
+		if err := entry.Validate(); err != nil {
+			return fmt.Errorf("invalid pending commission: %w", err)
+		}
+		fpBTCPKHex := entry.FpBtcPk.MarshalHex()
+		if _, ok := pendingFps[fpBTCPKHex]; ok {
+			return fmt.Errorf("duplicate pending commission of finality provider %s", fpBTCPKHex)
+		}
+		pendingFps[fpBTCPKHex] = struct{}{}
+	}
+
+	// the commission history is indexed by the effective time of the changes
+	historyKeys := make(map[string]struct{}, len(gs.CommissionHistory))
+	for _, entry := range gs.CommissionHistory {
+		if err := entry.Validate(); err != nil {
+			return fmt.Errorf("invalid commission history: %w", err)
+		}
+		key := fmt.Sprintf("%s/%d", entry.FpBtcPk.MarshalHex(), entry.Change.EffectiveTime.UnixNano())
+		if _, ok := historyKeys[key]; ok {
+			return fmt.Errorf("duplicate commission history entry %s", key)
+		}
+		historyKeys[key] = struct{}{}
+	}
+
 	return nil
 }
 
+// Validate ensures the commission change entry is well-formed
+func (e *CommissionChangeEntry) Validate() error {
+	if e.FpBtcPk == nil {
+		return fmt.Errorf("empty finality provider BTC PK")
+	}
+	if _, err := e.FpBtcPk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid finality provider BTC PK: %w", err)
+	}
+	return e.Change.Validate()
+}
+
 // GenesisStateFromAppState returns x/btcstaking GenesisState given raw application
 // genesis state.
 func GenesisStateFromAppState(cdc codec.Codec, appState map[string]json.RawMessage) GenesisState {
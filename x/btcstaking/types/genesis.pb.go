@@ -38,6 +38,12 @@ type GenesisState struct {
 	BtcDelegators []*BTCDelegator `protobuf:"bytes,6,rep,name=btc_delegators,json=btcDelegators,proto3" json:"btc_delegators,omitempty"`
 	// all the events and its indexes.
 	Events []*EventIndex `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	// pending_commissions are the requested commission rate changes of the
+	// finality providers that do not take effect yet.
+	PendingCommissions []*CommissionChangeEntry `protobuf:"bytes,8,rep,name=pending_commissions,json=pendingCommissions,proto3" json:"pending_commissions,omitempty"`
+	// commission_history are the commission rate changes of the finality
+	// providers that took effect.
+	CommissionHistory []*CommissionChangeEntry `protobuf:"bytes,9,rep,name=commission_history,json=commissionHistory,proto3" json:"commission_history,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +121,20 @@ func (m *GenesisState) GetEvents() []*EventIndex {
 	return nil
 }
 
+func (m *GenesisState) GetPendingCommissions() []*CommissionChangeEntry {
+	if m != nil {
+		return m.PendingCommissions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommissionHistory() []*CommissionChangeEntry {
+	if m != nil {
+		return m.CommissionHistory
+	}
+	return nil
+}
+
 // BlockHeightBbnToBtc stores the btc <-> bbn block.
 type BlockHeightBbnToBtc struct {
 	// block_height_bbn is the height of the block in the babylon chain.
@@ -220,6 +240,55 @@ func (m *BTCDelegator) GetIdx() *BTCDelegatorDelegationIndex {
 	return nil
 }
 
+// CommissionChangeEntry contains a commission rate change and the finality
+// provider it belongs to.
+type CommissionChangeEntry struct {
+	// fp_btc_pk the finality provider btc public key.
+	FpBtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// change the commission rate change.
+	Change CommissionChange `protobuf:"bytes,2,opt,name=change,proto3" json:"change"`
+}
+
+func (m *CommissionChangeEntry) Reset()         { *m = CommissionChangeEntry{} }
+func (m *CommissionChangeEntry) String() string { return proto.CompactTextString(m) }
+func (*CommissionChangeEntry) ProtoMessage()    {}
+func (*CommissionChangeEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85d7b95fa5620238, []int{3}
+}
+func (m *CommissionChangeEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionChangeEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionChangeEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionChangeEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionChangeEntry.Merge(m, src)
+}
+func (m *CommissionChangeEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionChangeEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionChangeEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionChangeEntry proto.InternalMessageInfo
+
+func (m *CommissionChangeEntry) GetChange() CommissionChange {
+	if m != nil {
+		return m.Change
+	}
+	return CommissionChange{}
+}
+
 // EventIndex contains the event and its index.
 type EventIndex struct {
 	// idx is the index the event was stored.
@@ -234,7 +303,7 @@ func (m *EventIndex) Reset()         { *m = EventIndex{} }
 func (m *EventIndex) String() string { return proto.CompactTextString(m) }
 func (*EventIndex) ProtoMessage()    {}
 func (*EventIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_85d7b95fa5620238, []int{3}
+	return fileDescriptor_85d7b95fa5620238, []int{4}
 }
 func (m *EventIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,6 +357,7 @@ func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.btcstaking.v1.GenesisState")
 	proto.RegisterType((*BlockHeightBbnToBtc)(nil), "babylon.btcstaking.v1.BlockHeightBbnToBtc")
 	proto.RegisterType((*BTCDelegator)(nil), "babylon.btcstaking.v1.BTCDelegator")
+	proto.RegisterType((*CommissionChangeEntry)(nil), "babylon.btcstaking.v1.CommissionChangeEntry")
 	proto.RegisterType((*EventIndex)(nil), "babylon.btcstaking.v1.EventIndex")
 }
 
@@ -296,42 +366,48 @@ func init() {
 }
 
 var fileDescriptor_85d7b95fa5620238 = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x6f, 0xd3, 0x3c,
-	0x1c, 0xc7, 0x9b, 0x76, 0xeb, 0x9e, 0xc7, 0xed, 0xca, 0xe6, 0x81, 0x14, 0x55, 0x22, 0x94, 0x0e,
-	0x41, 0x05, 0x22, 0x61, 0xdd, 0x90, 0xe0, 0x48, 0x56, 0xfe, 0x0c, 0x04, 0x8a, 0x42, 0xd9, 0x61,
-	0x97, 0x2a, 0x76, 0xdc, 0xd4, 0x6a, 0x66, 0x47, 0xb1, 0x57, 0xda, 0x2b, 0x57, 0x2e, 0xbc, 0x2c,
-	0x6e, 0xec, 0x88, 0x38, 0x20, 0xd4, 0xbe, 0x11, 0x54, 0x27, 0x23, 0x2d, 0xb4, 0xa3, 0x12, 0xb7,
-	0x24, 0xfa, 0xfc, 0x3e, 0xfe, 0x7e, 0xf3, 0x93, 0x0c, 0x76, 0x91, 0x87, 0x46, 0x21, 0x67, 0x16,
-	0x92, 0x58, 0x48, 0xaf, 0x4f, 0x59, 0x60, 0x0d, 0xf6, 0xac, 0x80, 0x30, 0x22, 0xa8, 0x30, 0xa3,
-	0x98, 0x4b, 0x0e, 0xaf, 0xa5, 0x90, 0x99, 0x41, 0xe6, 0x60, 0xaf, 0x7a, 0x35, 0xe0, 0x01, 0x57,
-	0x84, 0x35, 0x7d, 0x4a, 0xe0, 0x6a, 0x7d, 0xb1, 0x31, 0xf2, 0x62, 0xef, 0x34, 0x15, 0x56, 0x6f,
-	0x2f, 0x66, 0x66, 0xf4, 0x97, 0xba, 0xc8, 0x80, 0x30, 0x99, 0xba, 0xea, 0x5f, 0x0a, 0xa0, 0xfc,
-	0x3c, 0x89, 0xfb, 0x56, 0x7a, 0x92, 0xc0, 0x87, 0xa0, 0x98, 0x1c, 0xa6, 0x6b, 0xb5, 0x42, 0xa3,
-	0xd4, 0xbc, 0x6e, 0x2e, 0x8c, 0x6f, 0x3a, 0x0a, 0x72, 0x53, 0x18, 0x1e, 0x03, 0xd8, 0xa5, 0xcc,
-	0x0b, 0xa9, 0x1c, 0x75, 0xa2, 0x98, 0x0f, 0xa8, 0x4f, 0x62, 0xa1, 0xe7, 0x95, 0xe2, 0xce, 0x12,
-	0xc5, 0xb3, 0x74, 0xc0, 0x49, 0x79, 0x77, 0xbb, 0xfb, 0xdb, 0x17, 0x01, 0x5f, 0x83, 0x2b, 0x48,
-	0xe2, 0x8e, 0x4f, 0x42, 0x12, 0x78, 0x92, 0x72, 0x26, 0xf4, 0x82, 0x92, 0xde, 0x5a, 0x22, 0xb5,
-	0xdb, 0x87, 0xad, 0x5f, 0xb0, 0x5b, 0x41, 0x12, 0x67, 0xaf, 0x02, 0x9e, 0x80, 0x1d, 0x14, 0x72,
-	0xdc, 0xef, 0xf4, 0x08, 0x0d, 0x7a, 0xb2, 0x83, 0x7b, 0x1e, 0x65, 0x42, 0x5f, 0x57, 0xca, 0xbb,
-	0xcb, 0x94, 0xd3, 0x89, 0x17, 0x6a, 0xc0, 0x46, 0xac, 0xcd, 0x6d, 0x89, 0xdd, 0x6d, 0x94, 0x7d,
-	0x3c, 0x54, 0x12, 0xf8, 0x12, 0x54, 0x66, 0xa2, 0xf2, 0x58, 0xe8, 0x45, 0xa5, 0xdd, 0xfd, 0x6b,
-	0x52, 0x1e, 0xbb, 0x9b, 0x59, 0x50, 0x1e, 0x0b, 0xf8, 0x18, 0x14, 0x93, 0x35, 0xe9, 0x1b, 0xca,
-	0x71, 0x73, 0x89, 0xe3, 0xe9, 0x14, 0x3a, 0x62, 0x3e, 0x19, 0xba, 0xe9, 0x40, 0x9d, 0x82, 0x9d,
-	0x05, 0x81, 0x61, 0x03, 0x6c, 0xcd, 0x35, 0x47, 0x88, 0xe9, 0x5a, 0x4d, 0x6b, 0xac, 0xb9, 0x15,
-	0x34, 0x87, 0xff, 0x49, 0x4a, 0xac, 0xe7, 0x6b, 0x5a, 0x63, 0x73, 0x9e, 0x94, 0xb8, 0xfe, 0x21,
-	0x0f, 0xca, 0xb3, 0x2d, 0x60, 0x0b, 0x14, 0xa8, 0x3f, 0x54, 0xde, 0x52, 0xb3, 0xb9, 0x42, 0xef,
-	0x6c, 0x37, 0x49, 0x89, 0xe9, 0x38, 0x6c, 0x83, 0xff, 0xbb, 0xd1, 0xf4, 0xd8, 0x4e, 0xd4, 0x57,
-	0x27, 0x97, 0xed, 0x47, 0xdf, 0xbe, 0xdf, 0x38, 0x08, 0xa8, 0xec, 0x9d, 0x21, 0x13, 0xf3, 0x53,
-	0x2b, 0x35, 0x87, 0x1e, 0x12, 0xf7, 0x29, 0xbf, 0x78, 0xb5, 0xe4, 0x28, 0x22, 0xc2, 0xb4, 0x8f,
-	0x9c, 0xfd, 0x83, 0x07, 0xce, 0x19, 0x7a, 0x45, 0x46, 0xee, 0x46, 0x37, 0xb2, 0x25, 0x76, 0xfa,
-	0xf0, 0x18, 0x00, 0x9f, 0x84, 0x17, 0xda, 0xc2, 0x3f, 0x6a, 0xff, 0xf3, 0x49, 0xa8, 0xbc, 0xf5,
-	0x8f, 0x1a, 0x00, 0xd9, 0x1a, 0xe0, 0x56, 0xf6, 0x0b, 0xd6, 0x92, 0x3a, 0x2b, 0xff, 0x4f, 0xf8,
-	0x04, 0xac, 0xab, 0x25, 0xaa, 0x74, 0xa5, 0xe6, 0xbd, 0xcb, 0x96, 0xee, 0xf0, 0xf7, 0x24, 0x6e,
-	0x51, 0x21, 0xdf, 0x45, 0xbe, 0x27, 0x89, 0x9b, 0x4c, 0xda, 0x6f, 0x3e, 0x8f, 0x0d, 0xed, 0x7c,
-	0x6c, 0x68, 0x3f, 0xc6, 0x86, 0xf6, 0x69, 0x62, 0xe4, 0xce, 0x27, 0x46, 0xee, 0xeb, 0xc4, 0xc8,
-	0x9d, 0xac, 0xd0, 0x73, 0x38, 0x7b, 0x53, 0xa8, 0xd2, 0xa8, 0xa8, 0xae, 0x89, 0xfd, 0x9f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x54, 0x96, 0xa0, 0xb9, 0xea, 0x04, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4f, 0x4f, 0xdb, 0x3e,
+	0x18, 0xc7, 0x1b, 0x0a, 0x05, 0xcc, 0x9f, 0x1f, 0x98, 0x1f, 0x52, 0x84, 0xb4, 0xc2, 0xca, 0xb4,
+	0x55, 0xfb, 0x93, 0x8e, 0xc2, 0xa4, 0xed, 0xb8, 0x14, 0x36, 0xd8, 0xb4, 0xa9, 0xca, 0x18, 0x07,
+	0xa6, 0x29, 0x8a, 0x1d, 0x37, 0xb1, 0x9a, 0xda, 0x51, 0x6c, 0x3a, 0x72, 0xdd, 0x95, 0xcb, 0x5e,
+	0xcc, 0x5e, 0x04, 0x47, 0x8e, 0xd3, 0x0e, 0x68, 0x82, 0x37, 0x32, 0xd5, 0x09, 0xa4, 0x65, 0x0d,
+	0x43, 0xdb, 0x6e, 0x8d, 0xfb, 0xfd, 0x7e, 0x9e, 0xc7, 0xcf, 0xd7, 0x36, 0x58, 0x45, 0x0e, 0x8a,
+	0x03, 0xce, 0x6a, 0x48, 0x62, 0x21, 0x9d, 0x36, 0x65, 0x5e, 0xad, 0xbb, 0x56, 0xf3, 0x08, 0x23,
+	0x82, 0x0a, 0x23, 0x8c, 0xb8, 0xe4, 0x70, 0x31, 0x15, 0x19, 0x99, 0xc8, 0xe8, 0xae, 0x2d, 0xfd,
+	0xef, 0x71, 0x8f, 0x2b, 0x45, 0xad, 0xf7, 0x2b, 0x11, 0x2f, 0x55, 0x86, 0x13, 0x43, 0x27, 0x72,
+	0x3a, 0x29, 0x70, 0xe9, 0xee, 0x70, 0x4d, 0x1f, 0xfe, 0x5a, 0x16, 0xe9, 0x12, 0x26, 0x53, 0x56,
+	0xe5, 0x68, 0x0c, 0x4c, 0xbf, 0x4c, 0xda, 0x7d, 0x27, 0x1d, 0x49, 0xe0, 0x13, 0x50, 0x4a, 0x8a,
+	0xe9, 0xda, 0x4a, 0xb1, 0x3a, 0x55, 0xbf, 0x65, 0x0c, 0x6d, 0xdf, 0x68, 0x2a, 0x91, 0x95, 0x8a,
+	0xe1, 0x1e, 0x80, 0x2d, 0xca, 0x9c, 0x80, 0xca, 0xd8, 0x0e, 0x23, 0xde, 0xa5, 0x2e, 0x89, 0x84,
+	0x3e, 0xa2, 0x10, 0xf7, 0x72, 0x10, 0x2f, 0x52, 0x43, 0x33, 0xd5, 0x5b, 0xf3, 0xad, 0x2b, 0x2b,
+	0x02, 0xbe, 0x01, 0xff, 0x21, 0x89, 0x6d, 0x97, 0x04, 0xc4, 0x73, 0x24, 0xe5, 0x4c, 0xe8, 0x45,
+	0x05, 0xbd, 0x93, 0x03, 0x35, 0x77, 0x1b, 0x9b, 0x97, 0x62, 0x6b, 0x16, 0x49, 0x9c, 0x7d, 0x0a,
+	0xb8, 0x0f, 0x16, 0x50, 0xc0, 0x71, 0xdb, 0xf6, 0x09, 0xf5, 0x7c, 0x69, 0x63, 0xdf, 0xa1, 0x4c,
+	0xe8, 0x63, 0x0a, 0x79, 0x3f, 0x0f, 0xd9, 0x73, 0x6c, 0x2b, 0x83, 0x89, 0xd8, 0x2e, 0x37, 0x25,
+	0xb6, 0xe6, 0x51, 0xb6, 0xd8, 0x50, 0x10, 0xf8, 0x0a, 0xcc, 0xf6, 0xb5, 0xca, 0x23, 0xa1, 0x97,
+	0x14, 0x76, 0xf5, 0xb7, 0x9d, 0xf2, 0xc8, 0x9a, 0xc9, 0x1a, 0xe5, 0x91, 0x80, 0xcf, 0x40, 0x29,
+	0x89, 0x49, 0x1f, 0x57, 0x8c, 0xdb, 0x39, 0x8c, 0xad, 0x9e, 0x68, 0x87, 0xb9, 0xe4, 0xd0, 0x4a,
+	0x0d, 0xf0, 0x23, 0x58, 0x08, 0x09, 0x73, 0x29, 0xf3, 0x6c, 0xcc, 0x3b, 0x1d, 0x2a, 0x84, 0x9a,
+	0xda, 0x84, 0xe2, 0x3c, 0xcc, 0xe1, 0x34, 0x2e, 0x95, 0x0d, 0xdf, 0x61, 0x1e, 0xd9, 0x62, 0x32,
+	0x8a, 0x2d, 0x98, 0x82, 0xb2, 0x7f, 0x05, 0xfc, 0x00, 0x60, 0x86, 0xb5, 0x7d, 0x2a, 0x24, 0x8f,
+	0x62, 0x7d, 0xf2, 0x0f, 0xe8, 0xf3, 0x19, 0x67, 0x3b, 0xc1, 0x54, 0x28, 0x58, 0x18, 0x32, 0x6c,
+	0x58, 0x05, 0x73, 0x03, 0xa9, 0x21, 0xc4, 0x74, 0x6d, 0x45, 0xab, 0x8e, 0x5a, 0xb3, 0x68, 0x40,
+	0xfe, 0xab, 0x52, 0x62, 0x7d, 0x64, 0x45, 0xab, 0xce, 0x0c, 0x2a, 0x25, 0xae, 0x7c, 0x1e, 0x01,
+	0xd3, 0xfd, 0x09, 0xc0, 0x4d, 0x50, 0xa4, 0xee, 0xa1, 0xe2, 0x4e, 0xd5, 0xeb, 0x37, 0xc8, 0x2c,
+	0x3b, 0x57, 0x49, 0x00, 0x3d, 0x3b, 0xdc, 0x05, 0x93, 0xad, 0xb0, 0x57, 0xd6, 0x0e, 0xdb, 0xaa,
+	0xf2, 0xb4, 0xf9, 0xf4, 0xfb, 0xe9, 0xf2, 0x86, 0x47, 0xa5, 0x7f, 0x80, 0x0c, 0xcc, 0x3b, 0xb5,
+	0x94, 0x1c, 0x38, 0x48, 0x3c, 0xa2, 0xfc, 0xe2, 0xb3, 0x26, 0xe3, 0x90, 0x08, 0xc3, 0xdc, 0x69,
+	0xae, 0x6f, 0x3c, 0x6e, 0x1e, 0xa0, 0xd7, 0x24, 0xb6, 0xc6, 0x5b, 0xa1, 0x29, 0x71, 0xb3, 0x0d,
+	0xf7, 0x00, 0x70, 0x49, 0x70, 0x81, 0x2d, 0xfe, 0x25, 0x76, 0xc2, 0x25, 0x81, 0xe2, 0x56, 0xbe,
+	0x6a, 0x60, 0x71, 0x68, 0x38, 0x83, 0xfb, 0xd0, 0xfe, 0xd5, 0x3e, 0xb6, 0x40, 0x09, 0xab, 0x22,
+	0x6a, 0x34, 0xf9, 0x2f, 0xc3, 0xd5, 0x9e, 0xcc, 0xd1, 0xe3, 0xd3, 0xe5, 0x82, 0x95, 0x9a, 0x2b,
+	0x47, 0x1a, 0x00, 0xd9, 0xc9, 0x87, 0x73, 0x59, 0x72, 0xa3, 0x49, 0x0a, 0x37, 0x3e, 0x06, 0xf0,
+	0x39, 0x18, 0x53, 0xf7, 0x46, 0x0d, 0x75, 0xaa, 0xfe, 0xe0, 0xba, 0x7b, 0xd6, 0xe4, 0x9f, 0x48,
+	0xb4, 0x49, 0x85, 0x7c, 0x1f, 0xba, 0x8e, 0x24, 0x56, 0xe2, 0x34, 0xdf, 0x1e, 0x9f, 0x95, 0xb5,
+	0x93, 0xb3, 0xb2, 0xf6, 0xe3, 0xac, 0xac, 0x7d, 0x39, 0x2f, 0x17, 0x4e, 0xce, 0xcb, 0x85, 0x6f,
+	0xe7, 0xe5, 0xc2, 0xfe, 0x0d, 0xa6, 0x75, 0xd8, 0xff, 0x38, 0xab, 0xd1, 0xa1, 0x92, 0x7a, 0x99,
+	0xd7, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x89, 0x83, 0x63, 0x91, 0x5d, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -354,6 +430,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CommissionHistory) > 0 {
+		for iNdEx := len(m.CommissionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommissionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PendingCommissions) > 0 {
+		for iNdEx := len(m.PendingCommissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Events) > 0 {
 		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -533,6 +637,51 @@ func (m *BTCDelegator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionChangeEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionChangeEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionChangeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Change.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventIndex) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -631,6 +780,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingCommissions) > 0 {
+		for _, e := range m.PendingCommissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommissionHistory) > 0 {
+		for _, e := range m.CommissionHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -670,6 +831,21 @@ func (m *BTCDelegator) Size() (n int) {
 	return n
 }
 
+func (m *CommissionChangeEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Change.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *EventIndex) Size() (n int) {
 	if m == nil {
 		return 0
@@ -928,6 +1104,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommissions = append(m.PendingCommissions, &CommissionChangeEntry{})
+			if err := m.PendingCommissions[len(m.PendingCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommissionHistory = append(m.CommissionHistory, &CommissionChangeEntry{})
+			if err := m.CommissionHistory[len(m.CommissionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1193,6 +1437,124 @@ func (m *BTCDelegator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CommissionChangeEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionChangeEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionChangeEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Change", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Change.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,15 +1,23 @@
 package types_test
 
 import (
+	"math/rand"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+	require.NoError(t, err)
+	now := time.Now().UTC()
+
 	tests := []struct {
 		desc     string
 		genState func() *types.GenesisState
@@ -132,6 +140,78 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid commission changes",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				d.PendingCommissions = []*types.CommissionChangeEntry{
+					{FpBtcPk: fpBTCPK, Change: types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(1, 1), now, types.CommissionChangeDelay)},
+				}
+				d.CommissionHistory = []*types.CommissionChangeEntry{
+					{FpBtcPk: fpBTCPK, Change: types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(2, 1), now.Add(-time.Hour), 0)},
+					{FpBtcPk: fpBTCPK, Change: types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(3, 1), now, 0)},
+				}
+				return d
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate pending commission changes are invalid",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				d.PendingCommissions = []*types.CommissionChangeEntry{
+					{FpBtcPk: fpBTCPK, Change: types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(1, 1), now, types.CommissionChangeDelay)},
+					{FpBtcPk: fpBTCPK, Change: types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(2, 1), now, types.CommissionChangeDelay)},
+				}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate commission history entries are invalid",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				d.CommissionHistory = []*types.CommissionChangeEntry{
+					{FpBtcPk: fpBTCPK, Change: types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(2, 1), now, 0)},
+					{FpBtcPk: fpBTCPK, Change: types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(3, 1), now, 0)},
+				}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "commission rate above 1 is invalid",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				d.PendingCommissions = []*types.CommissionChangeEntry{
+					{FpBtcPk: fpBTCPK, Change: types.NewCommissionChange(sdkmath.LegacyNewDec(2), now, types.CommissionChangeDelay)},
+				}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "commission change taking effect before its request is invalid",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				d.CommissionHistory = []*types.CommissionChangeEntry{
+					{FpBtcPk: fpBTCPK, Change: types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(2, 1), now, -time.Hour)},
+				}
+				return d
+			},
+			valid: false,
+		},
+		{
+			desc: "commission change without finality provider is invalid",
+			genState: func() *types.GenesisState {
+				d := types.DefaultGenesis()
+				d.PendingCommissions = []*types.CommissionChangeEntry{
+					{Change: types.NewCommissionChange(sdkmath.LegacyNewDecWithPrec(1, 1), now, types.CommissionChangeDelay)},
+				}
+				return d
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -24,7 +24,15 @@ var (
 	// 0x05 was used for something else in the past
 	BTCHeightKey = []byte{0x06} // key prefix for the BTC heights
 	// 0x07 was used for something else in the past
-	PowerDistUpdateKey        = []byte{0x08}             // key prefix for power distribution update events
-	AllowedStakingTxHashesKey = collections.NewPrefix(9) // key prefix for allowed staking tx hashes
-	HeightToVersionMapKey     = []byte{0x10}             // key prefix for height to version map
+	PowerDistUpdateKey        = []byte{0x08}              // key prefix for power distribution update events
+	AllowedStakingTxHashesKey = collections.NewPrefix(9)  // key prefix for allowed staking tx hashes
+	HeightToVersionMapKey     = []byte{0x10}              // key prefix for height to version map
+	PendingCommissionKey      = collections.NewPrefix(17) // key prefix for pending commission rate changes
+	CommissionHistoryKey      = collections.NewPrefix(18) // key prefix for commission rate history
+	StakerBTCPKIndexKey       = collections.NewPrefix(19) // key prefix for BTC delegations by staker BTC PK
+	StakerAddrIndexKey        = collections.NewPrefix(20) // key prefix for BTC delegations by staker address
+	TotalStakedSatKey         = collections.NewPrefix(21) // key prefix for satoshis staked in all active BTC delegations
+	FpStakedSatKey            = collections.NewPrefix(22) // key prefix for satoshis staked to each finality provider
+	ActiveStakeExpiryKey      = collections.NewPrefix(23) // key prefix for active BTC delegations by expiry BTC height
+	ExpiryWarningHeightKey    = collections.NewPrefix(24) // key prefix for the expiry BTC height up to which expiry warnings are emitted
 )
@@ -9,6 +9,8 @@ import (
 const (
 	MetricsKeyCreateFinalityProvider         = "create_finality_provider"
 	MetricsKeyCreateBTCDelegation            = "create_btc_delegation"
+	MetricsKeyExtendBTCDelegation            = "extend_btc_delegation"
+	MetricsKeyRedelegateBTCDelegation        = "redelegate_btc_delegation"
 	MetricsKeyAddCovenantSigs                = "add_covenant_sigs"
 	MetricsKeyAddBTCDelegationInclusionProof = "add_btc_delegation_inclusion_proof"
 	MetricsKeyBTCUndelegate                  = "btc_undelegate"
@@ -15,10 +15,15 @@ var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgCreateFinalityProvider{}
 	_ sdk.Msg = &MsgEditFinalityProvider{}
+	_ sdk.Msg = &MsgRetireFinalityProvider{}
 	_ sdk.Msg = &MsgCreateBTCDelegation{}
+	_ sdk.Msg = &MsgExtendBTCDelegation{}
+	_ sdk.Msg = &MsgRedelegateBTCDelegation{}
 	_ sdk.Msg = &MsgAddCovenantSigs{}
 	_ sdk.Msg = &MsgBTCUndelegate{}
 	_ sdk.Msg = &MsgAddBTCDelegationInclusionProof{}
+	_ sdk.Msg = &MsgAddAllowedStakingTxHashes{}
+	_ sdk.Msg = &MsgRemoveAllowedStakingTxHashes{}
 )
 
 func (m *MsgCreateFinalityProvider) ValidateBasic() error {
@@ -43,6 +48,12 @@ func (m *MsgCreateFinalityProvider) ValidateBasic() error {
 	if m.Pop == nil {
 		return fmt.Errorf("empty proof of possession")
 	}
+	if m.CommissionRates == nil {
+		return fmt.Errorf("empty commission rates")
+	}
+	if err := m.CommissionRates.Validate(*m.Commission); err != nil {
+		return err
+	}
 	if _, err := sdk.AccAddressFromBech32(m.Addr); err != nil {
 		return fmt.Errorf("invalid FP addr: %s - %v", m.Addr, err)
 	}
@@ -72,6 +83,20 @@ func (m *MsgEditFinalityProvider) ValidateBasic() error {
 	return nil
 }
 
+func (m *MsgRetireFinalityProvider) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Addr); err != nil {
+		return fmt.Errorf("invalid finality provider address: %s - %w", m.Addr, err)
+	}
+	if len(m.BtcPk) != bbn.BIP340PubKeyLen {
+		return fmt.Errorf("malformed BTC PK")
+	}
+	if _, err := bbn.NewBIP340PubKey(m.BtcPk); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 	if _, err := ParseCreateDelegationMessage(m); err != nil {
 		return err
@@ -80,6 +105,83 @@ func (m *MsgCreateBTCDelegation) ValidateBasic() error {
 	return nil
 }
 
+func (m *MsgExtendBTCDelegation) ValidateBasic() error {
+	return validateSuccessorDelegation(m.StakerAddr, m.PrevStakingTxHash, m.StakingTx)
+}
+
+// ToCreateBTCDelegationMsg converts the extension into a MsgCreateBTCDelegation
+// that inherits the staker BTC PK, the proof of possession and the finality
+// providers of the extended BTC delegation, so that the extension is parsed
+// and validated in the same way as a new BTC delegation
+func (m *MsgExtendBTCDelegation) ToCreateBTCDelegationMsg(prevDel *BTCDelegation) *MsgCreateBTCDelegation {
+	return &MsgCreateBTCDelegation{
+		StakerAddr:                    m.StakerAddr,
+		Pop:                           prevDel.Pop,
+		BtcPk:                         prevDel.BtcPk,
+		FpBtcPkList:                   prevDel.FpBtcPkList,
+		StakingTime:                   m.StakingTime,
+		StakingValue:                  m.StakingValue,
+		StakingTx:                     m.StakingTx,
+		StakingTxInclusionProof:       m.StakingTxInclusionProof,
+		SlashingTx:                    m.SlashingTx,
+		DelegatorSlashingSig:          m.DelegatorSlashingSig,
+		UnbondingTime:                 m.UnbondingTime,
+		UnbondingTx:                   m.UnbondingTx,
+		UnbondingValue:                m.UnbondingValue,
+		UnbondingSlashingTx:           m.UnbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: m.DelegatorUnbondingSlashingSig,
+	}
+}
+
+func (m *MsgRedelegateBTCDelegation) ValidateBasic() error {
+	if len(m.FpBtcPkList) == 0 {
+		return ErrEmptyFpList
+	}
+	return validateSuccessorDelegation(m.StakerAddr, m.PrevStakingTxHash, m.StakingTx)
+}
+
+// ToCreateBTCDelegationMsg converts the redelegation into a
+// MsgCreateBTCDelegation to the given finality providers that inherits the
+// staker BTC PK and the proof of possession of the redelegated BTC delegation
+func (m *MsgRedelegateBTCDelegation) ToCreateBTCDelegationMsg(prevDel *BTCDelegation) *MsgCreateBTCDelegation {
+	return &MsgCreateBTCDelegation{
+		StakerAddr:                    m.StakerAddr,
+		Pop:                           prevDel.Pop,
+		BtcPk:                         prevDel.BtcPk,
+		FpBtcPkList:                   m.FpBtcPkList,
+		StakingTime:                   m.StakingTime,
+		StakingValue:                  m.StakingValue,
+		StakingTx:                     m.StakingTx,
+		StakingTxInclusionProof:       m.StakingTxInclusionProof,
+		SlashingTx:                    m.SlashingTx,
+		DelegatorSlashingSig:          m.DelegatorSlashingSig,
+		UnbondingTime:                 m.UnbondingTime,
+		UnbondingTx:                   m.UnbondingTx,
+		UnbondingValue:                m.UnbondingValue,
+		UnbondingSlashingTx:           m.UnbondingSlashingTx,
+		DelegatorUnbondingSlashingSig: m.DelegatorUnbondingSlashingSig,
+	}
+}
+
+// validateSuccessorDelegation performs the stateless checks shared by the
+// messages that register a successor of an existing BTC delegation
+func validateSuccessorDelegation(stakerAddr string, prevStakingTxHash string, stakingTx []byte) error {
+	if _, err := sdk.AccAddressFromBech32(stakerAddr); err != nil {
+		return fmt.Errorf("invalid staker address: %w", err)
+	}
+	if len(prevStakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("previous staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if _, err := chainhash.NewHashFromStr(prevStakingTxHash); err != nil {
+		return fmt.Errorf("invalid previous staking tx hash: %w", err)
+	}
+	if len(stakingTx) == 0 {
+		return fmt.Errorf("empty staking tx")
+	}
+
+	return nil
+}
+
 func (m *MsgAddCovenantSigs) ValidateBasic() error {
 	if m.Pk == nil {
 		return fmt.Errorf("empty BTC covenant public key")
@@ -107,6 +209,13 @@ func (m *MsgAddCovenantSigs) ValidateBasic() error {
 		return fmt.Errorf("empty covenant signature")
 	}
 
+	// the signature on the transition tx is only given for successors
+	if m.TransitionTxSig != nil {
+		if _, err := m.TransitionTxSig.ToBTCSig(); err != nil {
+			return fmt.Errorf("invalid covenant transition signature: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -163,3 +272,46 @@ func (m *MsgAddBTCDelegationInclusionProof) ValidateBasic() error {
 
 	return nil
 }
+
+func (m *MsgAddAllowedStakingTxHashes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	_, err := ParseStakingTxHashesHex(m.StakingTxHashesHex)
+	return err
+}
+
+func (m *MsgRemoveAllowedStakingTxHashes) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority address: %w", err)
+	}
+	_, err := ParseStakingTxHashesHex(m.StakingTxHashesHex)
+	return err
+}
+
+// ParseStakingTxHashesHex parses the given non-empty list of staking tx hashes
+// in BTC format, and ensures there are no duplicates
+func ParseStakingTxHashesHex(txHashesHex []string) ([]*chainhash.Hash, error) {
+	if len(txHashesHex) == 0 {
+		return nil, fmt.Errorf("empty staking tx hash list")
+	}
+
+	txHashes := make([]*chainhash.Hash, 0, len(txHashesHex))
+	seen := make(map[chainhash.Hash]struct{}, len(txHashesHex))
+	for _, txHashHex := range txHashesHex {
+		if len(txHashHex) != chainhash.MaxHashStringSize {
+			return nil, fmt.Errorf("staking tx hash %s is not %d", txHashHex, chainhash.MaxHashStringSize)
+		}
+		txHash, err := chainhash.NewHashFromStr(txHashHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid staking tx hash %s: %w", txHashHex, err)
+		}
+		if _, ok := seen[*txHash]; ok {
+			return nil, fmt.Errorf("duplicated staking tx hash %s", txHashHex)
+		}
+		seen[*txHash] = struct{}{}
+		txHashes = append(txHashes, txHash)
+	}
+
+	return txHashes, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	bbntypes "github.com/babylonlabs-io/babylon/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
@@ -22,6 +23,7 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 
 	fp, err := datagen.GenRandomFinalityProvider(r)
 	require.NoError(t, err)
+	highCommission := sdkmath.LegacyNewDecWithPrec(6, 1)
 
 	invalidAddr := "bbnbadaddr"
 
@@ -33,33 +35,36 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 		{
 			"valid: msg create fp",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
-				Pop:         fp.Pop,
+				Addr:            fp.Addr,
+				Description:     fp.Description,
+				Commission:      fp.Commission,
+				BtcPk:           fp.BtcPk,
+				Pop:             fp.Pop,
+				CommissionRates: fp.CommissionInfo.Rates(),
 			},
 			nil,
 		},
 		{
 			"invalid: empty commission",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  nil,
-				BtcPk:       fp.BtcPk,
-				Pop:         fp.Pop,
+				Addr:            fp.Addr,
+				Description:     fp.Description,
+				Commission:      nil,
+				BtcPk:           fp.BtcPk,
+				Pop:             fp.Pop,
+				CommissionRates: fp.CommissionInfo.Rates(),
 			},
 			fmt.Errorf("empty commission"),
 		},
 		{
 			"invalid: empty description",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: nil,
-				Commission:  fp.Commission,
-				BtcPk:       fp.BtcPk,
-				Pop:         fp.Pop,
+				Addr:            fp.Addr,
+				Description:     nil,
+				Commission:      fp.Commission,
+				BtcPk:           fp.BtcPk,
+				Pop:             fp.Pop,
+				CommissionRates: fp.CommissionInfo.Rates(),
 			},
 			fmt.Errorf("empty description"),
 		},
@@ -74,9 +79,10 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 					SecurityContact: fp.Description.SecurityContact,
 					Details:         fp.Description.Details,
 				},
-				Commission: fp.Commission,
-				BtcPk:      fp.BtcPk,
-				Pop:        fp.Pop,
+				Commission:      fp.Commission,
+				BtcPk:           fp.BtcPk,
+				Pop:             fp.Pop,
+				CommissionRates: fp.CommissionInfo.Rates(),
 			},
 			fmt.Errorf("empty moniker"),
 		},
@@ -91,64 +97,111 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 					SecurityContact: fp.Description.SecurityContact,
 					Details:         fp.Description.Details,
 				},
-				Commission: fp.Commission,
-				BtcPk:      fp.BtcPk,
-				Pop:        fp.Pop,
+				Commission:      fp.Commission,
+				BtcPk:           fp.BtcPk,
+				Pop:             fp.Pop,
+				CommissionRates: fp.CommissionInfo.Rates(),
 			},
 			errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid moniker length; got: %d, max: %d", len(randBigMoniker), stktypes.MaxMonikerLength),
 		},
 		{
 			"invalid: empty BTC pk",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       nil,
-				Pop:         fp.Pop,
+				Addr:            fp.Addr,
+				Description:     fp.Description,
+				Commission:      fp.Commission,
+				BtcPk:           nil,
+				Pop:             fp.Pop,
+				CommissionRates: fp.CommissionInfo.Rates(),
 			},
 			fmt.Errorf("empty BTC public key"),
 		},
 		{
 			"invalid: invalid BTC pk",
 			&types.MsgCreateFinalityProvider{
-				Addr:        fp.Addr,
-				Description: fp.Description,
-				Commission:  fp.Commission,
-				BtcPk:       (*bbntypes.BIP340PubKey)(&bigBtcPK),
-				Pop:         fp.Pop,
+				Addr:            fp.Addr,
+				Description:     fp.Description,
+				Commission:      fp.Commission,
+				BtcPk:           (*bbntypes.BIP340PubKey)(&bigBtcPK),
+				Pop:             fp.Pop,
+				CommissionRates: fp.CommissionInfo.Rates(),
 			},
 			fmt.Errorf("invalid BTC public key: %v", fmt.Errorf("bad pubkey byte string size (want %v, have %v)", 32, len(bigBtcPK))),
 		},
 		{
 			"invalid: empty PoP",
+			&types.MsgCreateFinalityProvider{
+				Addr:            fp.Addr,
+				Description:     fp.Description,
+				Commission:      fp.Commission,
+				BtcPk:           fp.BtcPk,
+				Pop:             nil,
+				CommissionRates: fp.CommissionInfo.Rates(),
+			},
+			fmt.Errorf("empty proof of possession"),
+		},
+		{
+			"invalid: empty PoP",
+			&types.MsgCreateFinalityProvider{
+				Addr:            fp.Addr,
+				Description:     fp.Description,
+				Commission:      fp.Commission,
+				BtcPk:           fp.BtcPk,
+				Pop:             nil,
+				CommissionRates: fp.CommissionInfo.Rates(),
+			},
+			fmt.Errorf("empty proof of possession"),
+		},
+		{
+			"invalid: empty commission rates",
 			&types.MsgCreateFinalityProvider{
 				Addr:        fp.Addr,
 				Description: fp.Description,
 				Commission:  fp.Commission,
 				BtcPk:       fp.BtcPk,
-				Pop:         nil,
+				Pop:         fp.Pop,
 			},
-			fmt.Errorf("empty proof of possession"),
+			fmt.Errorf("empty commission rates"),
 		},
 		{
-			"invalid: empty PoP",
+			"invalid: max change rate greater than max rate",
 			&types.MsgCreateFinalityProvider{
 				Addr:        fp.Addr,
 				Description: fp.Description,
 				Commission:  fp.Commission,
 				BtcPk:       fp.BtcPk,
-				Pop:         nil,
+				Pop:         fp.Pop,
+				CommissionRates: &types.CommissionRates{
+					MaxRate:       sdkmath.LegacyNewDecWithPrec(5, 1),
+					MaxChangeRate: sdkmath.LegacyNewDecWithPrec(6, 1),
+				},
 			},
-			fmt.Errorf("empty proof of possession"),
+			types.ErrInvalidCommissionRates.Wrap("max change rate cannot be more than the max rate"),
 		},
 		{
-			"invalid: bad addr",
+			"invalid: commission greater than max rate",
 			&types.MsgCreateFinalityProvider{
-				Addr:        invalidAddr,
+				Addr:        fp.Addr,
 				Description: fp.Description,
-				Commission:  fp.Commission,
+				Commission:  &highCommission,
 				BtcPk:       fp.BtcPk,
 				Pop:         fp.Pop,
+				CommissionRates: &types.CommissionRates{
+					MaxRate:       sdkmath.LegacyNewDecWithPrec(5, 1),
+					MaxChangeRate: sdkmath.LegacyNewDecWithPrec(1, 1),
+				},
+			},
+			types.ErrCommissionGTMaxRateLimit,
+		},
+		{
+			"invalid: bad addr",
+			&types.MsgCreateFinalityProvider{
+				Addr:            invalidAddr,
+				Description:     fp.Description,
+				Commission:      fp.Commission,
+				BtcPk:           fp.BtcPk,
+				Pop:             fp.Pop,
+				CommissionRates: fp.CommissionInfo.Rates(),
 			},
 			fmt.Errorf("invalid FP addr: %s - %v", invalidAddr, fmt.Errorf("decoding bech32 failed: invalid separator index -1")),
 		},
@@ -162,6 +215,7 @@ func TestMsgCreateFinalityProviderValidateBasic(t *testing.T) {
 				Pop: &types.ProofOfPossessionBTC{
 					BtcSig: nil,
 				},
+				CommissionRates: fp.CommissionInfo.Rates(),
 			},
 			fmt.Errorf("empty BTC signature"),
 		},
@@ -81,6 +81,11 @@ func DefaultParams() Params {
 		// Allow list can only be enabled by upgrade
 		AllowListExpirationHeight: 0,
 		BtcActivationHeight:       0,
+		// The default stake caps are 0, which means there is no cap
+		MaxTotalStakedSat: 0,
+		MaxFpStakedSat:    0,
+		// The default expiry warning is 0, which means no warning is emitted
+		ExpiryWarningBlocks: 0,
 	}
 }
 
@@ -143,6 +148,15 @@ func validateStakingAmout(minStakingAmt, maxStakingAmt int64) error {
 	return nil
 }
 
+func validateStakeCaps(maxTotalStakedSat, maxFpStakedSat uint64) error {
+	// 0 means there is no cap
+	if maxTotalStakedSat > 0 && maxFpStakedSat > maxTotalStakedSat {
+		return fmt.Errorf("maximum stake of a finality provider cannot be greater than maximum total stake")
+	}
+
+	return nil
+}
+
 func validateStakingTime(minStakingTime, maxStakingTime uint32) error {
 	if minStakingTime == 0 {
 		return fmt.Errorf("minimum staking time has to be positive")
@@ -203,6 +217,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateStakeCaps(p.MaxTotalStakedSat, p.MaxFpStakedSat); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -221,6 +239,20 @@ func (p Params) HasCovenantPK(pk *bbn.BIP340PubKey) bool {
 	return false
 }
 
+// HasSameCovenantCommittee returns whether the given params have the same
+// covenant members and covenant quorum
+func (p Params) HasSameCovenantCommittee(other *Params) bool {
+	if p.CovenantQuorum != other.CovenantQuorum || len(p.CovenantPks) != len(other.CovenantPks) {
+		return false
+	}
+	for i := range other.CovenantPks {
+		if !p.HasCovenantPK(&other.CovenantPks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (p Params) CovenantPksHex() []string {
 	covPksHex := make([]string, 0, len(p.CovenantPks))
 	for _, pk := range p.CovenantPks {
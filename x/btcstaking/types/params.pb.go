@@ -74,6 +74,22 @@ type Params struct {
 	AllowListExpirationHeight uint64 `protobuf:"varint,14,opt,name=allow_list_expiration_height,json=allowListExpirationHeight,proto3" json:"allow_list_expiration_height,omitempty"`
 	// btc_activation_height is the btc height from which parameters are activated (inclusive)
 	BtcActivationHeight uint32 `protobuf:"varint,15,opt,name=btc_activation_height,json=btcActivationHeight,proto3" json:"btc_activation_height,omitempty"`
+	// PARAMETERS COVERING STAKE CAPS
+	// max_total_staked_sat is the maximum of satoshis locked in all active BTC
+	// delegations. A BTC delegation that would exceed it cannot become active.
+	// setting it to 0 means there is no cap
+	MaxTotalStakedSat uint64 `protobuf:"varint,16,opt,name=max_total_staked_sat,json=maxTotalStakedSat,proto3" json:"max_total_staked_sat,omitempty"`
+	// max_fp_staked_sat is the maximum of satoshis locked in active BTC delegations
+	// restaked to a single finality provider. A BTC delegation that would exceed it
+	// for any of its finality providers cannot become active.
+	// setting it to 0 means there is no cap
+	MaxFpStakedSat uint64 `protobuf:"varint,17,opt,name=max_fp_staked_sat,json=maxFpStakedSat,proto3" json:"max_fp_staked_sat,omitempty"`
+	// PARAMETERS COVERING NOTIFICATIONS
+	// expiry_warning_blocks is the number of BTC blocks before the voting power
+	// of an active BTC delegation expires, i.e., before end_height - unbonding_time,
+	// at which an EventBTCDelegationExpiringSoon is emitted.
+	// setting it to 0 means no warning is emitted
+	ExpiryWarningBlocks uint32 `protobuf:"varint,18,opt,name=expiry_warning_blocks,json=expiryWarningBlocks,proto3" json:"expiry_warning_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -192,6 +208,27 @@ func (m *Params) GetBtcActivationHeight() uint32 {
 	return 0
 }
 
+func (m *Params) GetMaxTotalStakedSat() uint64 {
+	if m != nil {
+		return m.MaxTotalStakedSat
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFpStakedSat() uint64 {
+	if m != nil {
+		return m.MaxFpStakedSat
+	}
+	return 0
+}
+
+func (m *Params) GetExpiryWarningBlocks() uint32 {
+	if m != nil {
+		return m.ExpiryWarningBlocks
+	}
+	return 0
+}
+
 // HeightVersionPair pairs a btc height with a version of the parameters
 type HeightVersionPair struct {
 	// start_height is the height from which the parameters are activated (inclusive)
@@ -361,53 +398,58 @@ func init() {
 }
 
 var fileDescriptor_8d1392776a3e15b9 = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x4e, 0x13, 0x4f,
-	0x1c, 0xef, 0xfe, 0x28, 0xe5, 0xc7, 0xb4, 0x80, 0x2c, 0x10, 0x17, 0xc4, 0xb6, 0xd6, 0x83, 0x8d,
-	0x91, 0xad, 0x05, 0x4c, 0x8c, 0x26, 0x1a, 0x0b, 0xa2, 0x46, 0x34, 0x75, 0xdb, 0x70, 0xd0, 0xc3,
-	0x66, 0x76, 0x3a, 0x6e, 0x27, 0xdd, 0xdd, 0x59, 0x77, 0xa6, 0xb5, 0x7d, 0x0b, 0x4f, 0xc6, 0xa3,
-	0x0f, 0xe1, 0x43, 0x70, 0x24, 0x9e, 0x0c, 0x07, 0x62, 0xe0, 0x45, 0xcc, 0xce, 0xcc, 0x6e, 0x0b,
-	0x62, 0xc2, 0xad, 0x33, 0x9f, 0xbf, 0xdf, 0xdd, 0xee, 0x17, 0x54, 0x1c, 0xe8, 0x8c, 0x3c, 0x1a,
-	0xd4, 0x1c, 0x8e, 0x18, 0x87, 0x3d, 0x12, 0xb8, 0xb5, 0x41, 0xbd, 0x16, 0xc2, 0x08, 0xfa, 0xcc,
-	0x0c, 0x23, 0xca, 0xa9, 0xbe, 0xa2, 0x38, 0xe6, 0x98, 0x63, 0x0e, 0xea, 0x6b, 0xcb, 0x2e, 0x75,
-	0xa9, 0x60, 0xd4, 0xe2, 0x5f, 0x92, 0xbc, 0xb6, 0x8a, 0x28, 0xf3, 0x29, 0xb3, 0x25, 0x20, 0x0f,
-	0x12, 0xaa, 0x7c, 0x9d, 0x01, 0xb9, 0xa6, 0x30, 0xd6, 0x3f, 0x80, 0x02, 0xa2, 0x03, 0x1c, 0xc0,
-	0x80, 0xdb, 0x61, 0x8f, 0x19, 0x5a, 0x79, 0xaa, 0x5a, 0x68, 0x3c, 0x3c, 0x3e, 0x29, 0x6d, 0xbb,
-	0x84, 0x77, 0xfb, 0x8e, 0x89, 0xa8, 0x5f, 0x53, 0xb9, 0x1e, 0x74, 0xd8, 0x06, 0xa1, 0xc9, 0xb1,
-	0xc6, 0x47, 0x21, 0x66, 0x66, 0xe3, 0x55, 0x73, 0x6b, 0xfb, 0x7e, 0xb3, 0xef, 0xbc, 0xc6, 0x23,
-	0x2b, 0x9f, 0xb8, 0x35, 0x7b, 0x4c, 0xbf, 0x03, 0x16, 0x52, 0xf3, 0x4f, 0x7d, 0x1a, 0xf5, 0x7d,
-	0xe3, 0xbf, 0xb2, 0x56, 0x9d, 0xb3, 0xe6, 0x93, 0xeb, 0x77, 0xe2, 0x56, 0xaf, 0x83, 0x15, 0x9f,
-	0x04, 0xb6, 0x9a, 0xc9, 0x1e, 0x40, 0xaf, 0x8f, 0x6d, 0x06, 0xb9, 0x31, 0x55, 0xd6, 0xaa, 0x53,
-	0x96, 0xee, 0x93, 0xa0, 0x25, 0xb1, 0x83, 0x18, 0x6a, 0x41, 0x2e, 0x24, 0x70, 0x78, 0x89, 0x24,
-	0xab, 0x24, 0x70, 0x78, 0x51, 0xf2, 0x00, 0x5c, 0x9f, 0x4c, 0xe1, 0xc4, 0xc7, 0xb6, 0xe3, 0x51,
-	0xd4, 0x63, 0xc6, 0xb4, 0xa8, 0xb5, 0x3c, 0xce, 0x69, 0x13, 0x1f, 0x37, 0x04, 0x26, 0x64, 0x13,
-	0x49, 0x93, 0xb2, 0x9c, 0x92, 0xa5, 0x59, 0x13, 0xb2, 0x7b, 0x40, 0x67, 0x1e, 0x64, 0xdd, 0x58,
-	0x13, 0xf6, 0x6c, 0x86, 0x22, 0x12, 0x72, 0x63, 0xa6, 0xac, 0x55, 0x0b, 0xd6, 0xb5, 0x04, 0x69,
-	0xf6, 0x5a, 0xe2, 0x5e, 0xdf, 0x56, 0xdd, 0x12, 0x05, 0x1f, 0xda, 0x1f, 0xb1, 0x1c, 0xe8, 0x7f,
-	0x31, 0xd0, 0x52, 0xdc, 0x4d, 0xa1, 0xed, 0xe1, 0x1e, 0x16, 0x13, 0x1d, 0x80, 0xb9, 0x54, 0x11,
-	0x41, 0x8e, 0x8d, 0xd9, 0xb2, 0x56, 0x9d, 0x6d, 0xd4, 0x0f, 0x4f, 0x4a, 0x99, 0xe3, 0x93, 0xd2,
-	0x0d, 0xf9, 0xd6, 0x59, 0xa7, 0x67, 0x12, 0x5a, 0xf3, 0x21, 0xef, 0x9a, 0xfb, 0xd8, 0x85, 0x68,
-	0xb4, 0x8b, 0xd1, 0xcf, 0x1f, 0x1b, 0x40, 0xfd, 0x29, 0x76, 0x31, 0xb2, 0x0a, 0x89, 0x8f, 0x05,
-	0x39, 0xd6, 0x37, 0xc1, 0x4a, 0x3f, 0x70, 0x68, 0xd0, 0xb9, 0x38, 0x30, 0x10, 0x03, 0x2f, 0xa5,
-	0xe0, 0xc4, 0xbc, 0x77, 0xc1, 0xe2, 0x58, 0x93, 0x74, 0xcf, 0x8b, 0xee, 0x0b, 0x29, 0xa0, 0x7a,
-	0xb7, 0x40, 0x3c, 0x8e, 0x8d, 0xa8, 0xef, 0x13, 0xc6, 0x08, 0x0d, 0x64, 0xfb, 0x82, 0x68, 0x7f,
-	0xfb, 0x0a, 0xed, 0xad, 0x45, 0x9f, 0x04, 0x3b, 0xa9, 0x5c, 0x94, 0xde, 0x03, 0xe5, 0x0e, 0xf6,
-	0xb0, 0x0b, 0x79, 0x6c, 0x88, 0x22, 0x2c, 0x7f, 0x38, 0x90, 0x61, 0xdb, 0x85, 0x2c, 0xee, 0x64,
-	0xcc, 0x95, 0xb5, 0x6a, 0xd6, 0x5a, 0x1f, 0xf3, 0x76, 0x14, 0xad, 0x01, 0x19, 0x7e, 0x01, 0xd9,
-	0x1e, 0xc6, 0xfa, 0x53, 0xb0, 0x0e, 0x3d, 0x8f, 0x7e, 0xb6, 0x3d, 0xc2, 0xb8, 0x8d, 0x87, 0x21,
-	0x89, 0xa4, 0x53, 0x17, 0x13, 0xb7, 0xcb, 0x8d, 0x79, 0xe1, 0xb1, 0x2a, 0x38, 0xfb, 0x84, 0xf1,
-	0xe7, 0x29, 0xe3, 0xa5, 0x20, 0xc4, 0x4f, 0xcf, 0xe1, 0xc8, 0x86, 0x88, 0x93, 0xc1, 0x39, 0xe5,
-	0x82, 0x7c, 0x7a, 0x0e, 0x47, 0xcf, 0x52, 0x4c, 0x6a, 0x1e, 0x65, 0xbf, 0x7d, 0x2f, 0x65, 0x2a,
-	0x4d, 0xb0, 0x28, 0xcf, 0x07, 0x38, 0x8a, 0xe7, 0x6a, 0x42, 0x12, 0xe9, 0xb7, 0x40, 0x81, 0x71,
-	0x18, 0xf1, 0xc4, 0x45, 0x13, 0xf9, 0x79, 0x71, 0xa7, 0x12, 0x0d, 0x30, 0x33, 0x90, 0x0a, 0xf5,
-	0x81, 0x25, 0xc7, 0x4a, 0x1b, 0xe8, 0x92, 0xd3, 0xa6, 0xca, 0xf3, 0x0d, 0x0c, 0xf5, 0x27, 0x60,
-	0x3a, 0x84, 0x24, 0x92, 0x9f, 0x7b, 0x7e, 0xb3, 0x6a, 0x5e, 0xba, 0x58, 0xcc, 0xbf, 0xba, 0x58,
-	0x52, 0x56, 0xc1, 0xa0, 0xd0, 0xe2, 0x34, 0xc2, 0x1d, 0xb5, 0x45, 0x26, 0xf2, 0xb5, 0x73, 0xf9,
-	0xfa, 0x63, 0x90, 0x93, 0x2b, 0x4c, 0x14, 0xcb, 0x6f, 0xde, 0xfc, 0x47, 0x94, 0x34, 0x6a, 0x64,
-	0xe3, 0x77, 0x6f, 0x29, 0x49, 0xe3, 0xed, 0xe1, 0x69, 0x51, 0x3b, 0x3a, 0x2d, 0x6a, 0xbf, 0x4f,
-	0x8b, 0xda, 0x97, 0xb3, 0x62, 0xe6, 0xe8, 0xac, 0x98, 0xf9, 0x75, 0x56, 0xcc, 0xbc, 0xbf, 0xc2,
-	0x72, 0x1a, 0x4e, 0x6e, 0x52, 0xb1, 0xa9, 0x9c, 0x9c, 0x58, 0x7f, 0x5b, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0xef, 0x8a, 0x09, 0x6e, 0x6c, 0x05, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x18, 0x8d, 0x69, 0x36, 0xbb, 0x3b, 0x49, 0xdb, 0x8d, 0x77, 0x23, 0xdc, 0x52, 0x92, 0x10, 0x0e,
+	0x04, 0x44, 0x6d, 0x92, 0x16, 0x09, 0x81, 0x04, 0x22, 0x2d, 0x01, 0x44, 0x41, 0xc1, 0x89, 0x8a,
+	0x04, 0x87, 0xd1, 0xd8, 0x99, 0x3a, 0xa3, 0xd8, 0x1e, 0xe3, 0x99, 0xa4, 0xce, 0xbf, 0xe0, 0xc8,
+	0x91, 0x13, 0xbf, 0x80, 0x1f, 0xd1, 0x63, 0xc5, 0x09, 0xf5, 0x50, 0xa1, 0xf6, 0x8f, 0x20, 0xcf,
+	0x8c, 0x1d, 0xb7, 0x74, 0xa5, 0xde, 0x3c, 0xf3, 0xde, 0xfb, 0xbe, 0xf7, 0x66, 0xc6, 0x1f, 0xe8,
+	0x38, 0xc8, 0x59, 0xf9, 0x34, 0xb4, 0x1c, 0xee, 0x32, 0x8e, 0xe6, 0x24, 0xf4, 0xac, 0x65, 0xcf,
+	0x8a, 0x50, 0x8c, 0x02, 0x66, 0x46, 0x31, 0xe5, 0x54, 0x6f, 0x28, 0x8e, 0xb9, 0xe6, 0x98, 0xcb,
+	0xde, 0xee, 0x2b, 0x8f, 0x7a, 0x54, 0x30, 0xac, 0xf4, 0x4b, 0x92, 0x77, 0x77, 0x5c, 0xca, 0x02,
+	0xca, 0xa0, 0x04, 0xe4, 0x42, 0x42, 0x9d, 0x3f, 0x9f, 0x81, 0xca, 0x48, 0x14, 0xd6, 0x7f, 0x01,
+	0x35, 0x97, 0x2e, 0x71, 0x88, 0x42, 0x0e, 0xa3, 0x39, 0x33, 0xb4, 0xf6, 0x46, 0xb7, 0x36, 0xf8,
+	0xe4, 0xea, 0xba, 0x75, 0xe8, 0x11, 0x3e, 0x5b, 0x38, 0xa6, 0x4b, 0x03, 0x4b, 0xf5, 0xf5, 0x91,
+	0xc3, 0xf6, 0x09, 0xcd, 0x96, 0x16, 0x5f, 0x45, 0x98, 0x99, 0x83, 0x6f, 0x47, 0x07, 0x87, 0x1f,
+	0x8d, 0x16, 0xce, 0x77, 0x78, 0x65, 0x57, 0xb3, 0x6a, 0xa3, 0x39, 0xd3, 0xdf, 0x03, 0xdb, 0x79,
+	0xf1, 0x5f, 0x17, 0x34, 0x5e, 0x04, 0xc6, 0x1b, 0x6d, 0xad, 0xbb, 0x69, 0x6f, 0x65, 0xdb, 0x3f,
+	0x8a, 0x5d, 0xbd, 0x07, 0x1a, 0x01, 0x09, 0xa1, 0xca, 0x04, 0x97, 0xc8, 0x5f, 0x60, 0xc8, 0x10,
+	0x37, 0x36, 0xda, 0x5a, 0x77, 0xc3, 0xd6, 0x03, 0x12, 0x8e, 0x25, 0x76, 0x9a, 0x42, 0x63, 0xc4,
+	0x85, 0x04, 0x25, 0x0f, 0x48, 0xca, 0x4a, 0x82, 0x92, 0xfb, 0x92, 0x8f, 0xc1, 0x9b, 0xc5, 0x2e,
+	0x9c, 0x04, 0x18, 0x3a, 0x3e, 0x75, 0xe7, 0xcc, 0x78, 0x22, 0x6c, 0xbd, 0x5a, 0xf7, 0x99, 0x90,
+	0x00, 0x0f, 0x04, 0x26, 0x64, 0x85, 0x4e, 0x45, 0x59, 0x45, 0xc9, 0xf2, 0x5e, 0x05, 0xd9, 0x87,
+	0x40, 0x67, 0x3e, 0x62, 0xb3, 0x54, 0x13, 0xcd, 0x21, 0x73, 0x63, 0x12, 0x71, 0xe3, 0x69, 0x5b,
+	0xeb, 0xd6, 0xec, 0x17, 0x19, 0x32, 0x9a, 0x8f, 0xc5, 0xbe, 0x7e, 0xa8, 0xbc, 0x65, 0x0a, 0x9e,
+	0xc0, 0x33, 0x2c, 0x03, 0x3d, 0x13, 0x81, 0x5e, 0xa6, 0xde, 0x14, 0x3a, 0x49, 0x86, 0x58, 0x24,
+	0x3a, 0x05, 0x9b, 0xb9, 0x22, 0x46, 0x1c, 0x1b, 0xcf, 0xdb, 0x5a, 0xf7, 0xf9, 0xa0, 0x77, 0x71,
+	0xdd, 0x2a, 0x5d, 0x5d, 0xb7, 0xde, 0x92, 0xb7, 0xce, 0xa6, 0x73, 0x93, 0x50, 0x2b, 0x40, 0x7c,
+	0x66, 0x9e, 0x60, 0x0f, 0xb9, 0xab, 0x63, 0xec, 0xfe, 0xfd, 0xd7, 0x3e, 0x50, 0x8f, 0xe2, 0x18,
+	0xbb, 0x76, 0x2d, 0xab, 0x63, 0x23, 0x8e, 0xf5, 0x3e, 0x68, 0x2c, 0x42, 0x87, 0x86, 0xd3, 0xfb,
+	0x81, 0x81, 0x08, 0xfc, 0x32, 0x07, 0x0b, 0x79, 0x3f, 0x00, 0xf5, 0xb5, 0x26, 0xf3, 0x5e, 0x15,
+	0xde, 0xb7, 0x73, 0x40, 0xf9, 0x1e, 0x83, 0x34, 0x0e, 0x74, 0x69, 0x10, 0x10, 0xc6, 0x08, 0x0d,
+	0xa5, 0xfb, 0x9a, 0x70, 0xff, 0xee, 0x23, 0xdc, 0xdb, 0xf5, 0x80, 0x84, 0x47, 0xb9, 0x5c, 0x98,
+	0x1e, 0x82, 0xf6, 0x14, 0xfb, 0xd8, 0x43, 0x3c, 0x2d, 0xe8, 0xc6, 0x58, 0x7e, 0x38, 0x88, 0x61,
+	0xe8, 0x21, 0x96, 0x7a, 0x32, 0x36, 0xdb, 0x5a, 0xb7, 0x6c, 0xef, 0xad, 0x79, 0x47, 0x8a, 0x36,
+	0x40, 0x0c, 0x7f, 0x8d, 0xd8, 0x10, 0x63, 0xfd, 0x0b, 0xb0, 0x87, 0x7c, 0x9f, 0x9e, 0x43, 0x9f,
+	0x30, 0x0e, 0x71, 0x12, 0x91, 0x58, 0x56, 0x9a, 0x61, 0xe2, 0xcd, 0xb8, 0xb1, 0x25, 0x6a, 0xec,
+	0x08, 0xce, 0x09, 0x61, 0xfc, 0xab, 0x9c, 0xf1, 0x8d, 0x20, 0xa4, 0xa7, 0xe7, 0x70, 0x17, 0x22,
+	0x97, 0x93, 0xe5, 0x1d, 0xe5, 0xb6, 0x3c, 0x3d, 0x87, 0xbb, 0x5f, 0xe6, 0x98, 0xd2, 0x58, 0x20,
+	0x7d, 0x45, 0x90, 0x53, 0x8e, 0x7c, 0xf1, 0xd4, 0xf0, 0x54, 0x1c, 0xe0, 0x0b, 0xd1, 0xac, 0x1e,
+	0xa0, 0x64, 0x92, 0x42, 0x63, 0x81, 0xa4, 0x47, 0xf8, 0x3e, 0x48, 0x37, 0xe1, 0x59, 0x54, 0x64,
+	0xd7, 0x05, 0x7b, 0x2b, 0x40, 0xc9, 0x30, 0x5a, 0x53, 0xfb, 0xa0, 0x21, 0x52, 0xac, 0xe0, 0x39,
+	0x8a, 0xc3, 0xf4, 0x7a, 0xd4, 0x6d, 0xea, 0xd2, 0x8f, 0x04, 0x7f, 0x92, 0x98, 0xbc, 0xcd, 0x4f,
+	0xcb, 0xbf, 0xff, 0xd1, 0x2a, 0x75, 0x46, 0xa0, 0x2e, 0xfd, 0x9d, 0xe2, 0x38, 0x3d, 0xe7, 0x11,
+	0x22, 0xb1, 0xfe, 0x0e, 0xa8, 0x31, 0x8e, 0x62, 0x9e, 0xa5, 0xd2, 0x44, 0xd3, 0xaa, 0xd8, 0x53,
+	0x69, 0x0c, 0xf0, 0x74, 0x29, 0x15, 0xea, 0x87, 0xcf, 0x96, 0x9d, 0x09, 0xd0, 0x25, 0x67, 0x42,
+	0x55, 0xcd, 0xef, 0x51, 0xa4, 0x7f, 0x0e, 0x9e, 0x44, 0x88, 0xc4, 0x72, 0xfc, 0x54, 0xfb, 0x5d,
+	0xf3, 0xc1, 0x41, 0x67, 0xfe, 0xcf, 0x8b, 0x2d, 0x65, 0x1d, 0x0c, 0x6a, 0x63, 0x4e, 0x63, 0x3c,
+	0x55, 0x53, 0xad, 0xd0, 0x5f, 0xbb, 0xd3, 0x5f, 0xff, 0x0c, 0x54, 0xe4, 0x48, 0x15, 0xc6, 0xaa,
+	0xfd, 0xb7, 0x5f, 0xd3, 0x4a, 0x16, 0x1a, 0x94, 0xd3, 0xb7, 0x68, 0x2b, 0xc9, 0xe0, 0x87, 0x8b,
+	0x9b, 0xa6, 0x76, 0x79, 0xd3, 0xd4, 0xfe, 0xbd, 0x69, 0x6a, 0xbf, 0xdd, 0x36, 0x4b, 0x97, 0xb7,
+	0xcd, 0xd2, 0x3f, 0xb7, 0xcd, 0xd2, 0xcf, 0x8f, 0x18, 0x96, 0x49, 0x71, 0xb2, 0x8b, 0xc9, 0xe9,
+	0x54, 0xc4, 0x38, 0x3e, 0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x22, 0xcb, 0x84, 0xfc, 0x05,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -430,6 +472,27 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryWarningBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExpiryWarningBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxFpStakedSat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFpStakedSat))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxTotalStakedSat != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTotalStakedSat))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.BtcActivationHeight != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BtcActivationHeight))
 		i--
@@ -701,6 +764,15 @@ func (m *Params) Size() (n int) {
 	if m.BtcActivationHeight != 0 {
 		n += 1 + sovParams(uint64(m.BtcActivationHeight))
 	}
+	if m.MaxTotalStakedSat != 0 {
+		n += 2 + sovParams(uint64(m.MaxTotalStakedSat))
+	}
+	if m.MaxFpStakedSat != 0 {
+		n += 2 + sovParams(uint64(m.MaxFpStakedSat))
+	}
+	if m.ExpiryWarningBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ExpiryWarningBlocks))
+	}
 	return n
 }
 
@@ -1129,6 +1201,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalStakedSat", wireType)
+			}
+			m.MaxTotalStakedSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalStakedSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFpStakedSat", wireType)
+			}
+			m.MaxFpStakedSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFpStakedSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryWarningBlocks", wireType)
+			}
+			m.ExpiryWarningBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryWarningBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2,6 +2,22 @@ package types
 
 import (
 	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Rules of MsgCreateBTCDelegation reported by the ValidateBTCDelegation query
+const (
+	RuleParseMessage           = "parse_message"
+	RuleProofOfPossession      = "proof_of_possession"
+	RuleStakingTxNotDuplicated = "staking_tx_not_duplicated"
+	RuleFinalityProvider       = "finality_provider"
+	RuleParams                 = "params"
+	RuleUnbondingTime          = "unbonding_time"
+	RuleStakingTx              = "staking_tx"
+	RuleUnbondingTx            = "unbonding_tx"
+	RuleAllowList              = "allow_list"
+	RuleStakeCap               = "stake_cap"
 )
 
 func delegatorUnbondingInfoToResponse(ui *DelegatorUnbondingInfo) *DelegatorUnbondingInfoResponse {
@@ -19,22 +35,24 @@ func delegatorUnbondingInfoToResponse(ui *DelegatorUnbondingInfo) *DelegatorUnbo
 // NewBTCDelegationResponse returns a new delegation response structure.
 func NewBTCDelegationResponse(btcDel *BTCDelegation, status BTCDelegationStatus) (resp *BTCDelegationResponse) {
 	resp = &BTCDelegationResponse{
-		StakerAddr:           btcDel.StakerAddr,
-		BtcPk:                btcDel.BtcPk,
-		FpBtcPkList:          btcDel.FpBtcPkList,
-		StakingTime:          btcDel.StakingTime,
-		StartHeight:          btcDel.StartHeight,
-		EndHeight:            btcDel.EndHeight,
-		TotalSat:             btcDel.TotalSat,
-		StakingTxHex:         hex.EncodeToString(btcDel.StakingTx),
-		DelegatorSlashSigHex: btcDel.DelegatorSig.ToHexStr(),
-		CovenantSigs:         btcDel.CovenantSigs,
-		StakingOutputIdx:     btcDel.StakingOutputIdx,
-		Active:               status == BTCDelegationStatus_ACTIVE,
-		StatusDesc:           status.String(),
-		UnbondingTime:        btcDel.UnbondingTime,
-		UndelegationResponse: nil,
-		ParamsVersion:        btcDel.ParamsVersion,
+		StakerAddr:             btcDel.StakerAddr,
+		BtcPk:                  btcDel.BtcPk,
+		FpBtcPkList:            btcDel.FpBtcPkList,
+		StakingTime:            btcDel.StakingTime,
+		StartHeight:            btcDel.StartHeight,
+		EndHeight:              btcDel.EndHeight,
+		TotalSat:               btcDel.TotalSat,
+		StakingTxHex:           hex.EncodeToString(btcDel.StakingTx),
+		DelegatorSlashSigHex:   btcDel.DelegatorSig.ToHexStr(),
+		CovenantSigs:           btcDel.CovenantSigs,
+		StakingOutputIdx:       btcDel.StakingOutputIdx,
+		Active:                 status == BTCDelegationStatus_ACTIVE,
+		StatusDesc:             status.String(),
+		UnbondingTime:          btcDel.UnbondingTime,
+		UndelegationResponse:   nil,
+		ParamsVersion:          btcDel.ParamsVersion,
+		PrevStakingTxHash:      btcDel.PrevStakingTxHash,
+		CovenantTransitionSigs: btcDel.CovenantTransitionSigs,
 	}
 
 	if btcDel.SlashingTx != nil {
@@ -82,5 +100,32 @@ func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight uint64) *Fi
 		Jailed:               f.Jailed,
 		Height:               bbnBlockHeight,
 		HighestVotedHeight:   f.HighestVotedHeight,
+		RetiredBabylonHeight: f.RetiredBabylonHeight,
+		CommissionInfo:       f.CommissionInfo,
+	}
+}
+
+// NewBTCDelegationValidationFailure returns the failure of the given rule of
+// MsgCreateBTCDelegation with the given error
+func NewBTCDelegationValidationFailure(rule string, err error) BTCDelegationValidationFailure {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	return BTCDelegationValidationFailure{
+		Rule:      rule,
+		Codespace: codespace,
+		Code:      code,
+		Message:   err.Error(),
+	}
+}
+
+// NewStakeCapacity returns the stake capacity with the given staked satoshis
+// under the given stake cap, where 0 means there is no cap
+func NewStakeCapacity(stakedSat, maxStakedSat uint64) StakeCapacity {
+	capacity := StakeCapacity{
+		StakedSat:    stakedSat,
+		MaxStakedSat: maxStakedSat,
+	}
+	if maxStakedSat > stakedSat {
+		capacity.RemainingSat = maxStakedSat - stakedSat
 	}
+	return capacity
 }
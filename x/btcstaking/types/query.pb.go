@@ -615,6 +615,259 @@ func (m *QueryBTCDelegationsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryBTCDelegationsByStakerBTCPKRequest is the request type for the
+// Query/BTCDelegationsByStakerBTCPK RPC method.
+type QueryBTCDelegationsByStakerBTCPKRequest struct {
+	// staker_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the staker
+	// the PK follows encoding in BIP-340 spec
+	StakerBtcPkHex string `protobuf:"bytes,1,opt,name=staker_btc_pk_hex,json=stakerBtcPkHex,proto3" json:"staker_btc_pk_hex,omitempty"`
+	// status is the queried status for BTC delegations
+	Status BTCDelegationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) Reset() {
+	*m = QueryBTCDelegationsByStakerBTCPKRequest{}
+}
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByStakerBTCPKRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationsByStakerBTCPKRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{12}
+}
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByStakerBTCPKRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByStakerBTCPKRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByStakerBTCPKRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByStakerBTCPKRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) GetStakerBtcPkHex() string {
+	if m != nil {
+		return m.StakerBtcPkHex
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) GetStatus() BTCDelegationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BTCDelegationStatus_PENDING
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBTCDelegationsByStakerBTCPKResponse is the response type for the
+// Query/BTCDelegationsByStakerBTCPK RPC method.
+type QueryBTCDelegationsByStakerBTCPKResponse struct {
+	// btc_delegations contains all the queried BTC delegations of the staker
+	// under the given status
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) Reset() {
+	*m = QueryBTCDelegationsByStakerBTCPKResponse{}
+}
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByStakerBTCPKResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationsByStakerBTCPKResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{13}
+}
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByStakerBTCPKResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByStakerBTCPKResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByStakerBTCPKResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByStakerBTCPKResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBTCDelegationsByStakerAddrRequest is the request type for the
+// Query/BTCDelegationsByStakerAddr RPC method.
+type QueryBTCDelegationsByStakerAddrRequest struct {
+	// staker_addr is the Babylon address of the staker
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// status is the queried status for BTC delegations
+	Status BTCDelegationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByStakerAddrRequest) Reset() {
+	*m = QueryBTCDelegationsByStakerAddrRequest{}
+}
+func (m *QueryBTCDelegationsByStakerAddrRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByStakerAddrRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationsByStakerAddrRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{14}
+}
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByStakerAddrRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByStakerAddrRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByStakerAddrRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByStakerAddrRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByStakerAddrRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByStakerAddrRequest) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
+}
+
+func (m *QueryBTCDelegationsByStakerAddrRequest) GetStatus() BTCDelegationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BTCDelegationStatus_PENDING
+}
+
+func (m *QueryBTCDelegationsByStakerAddrRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBTCDelegationsByStakerAddrResponse is the response type for the
+// Query/BTCDelegationsByStakerAddr RPC method.
+type QueryBTCDelegationsByStakerAddrResponse struct {
+	// btc_delegations contains all the queried BTC delegations of the staker
+	// under the given status
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) Reset() {
+	*m = QueryBTCDelegationsByStakerAddrResponse{}
+}
+func (m *QueryBTCDelegationsByStakerAddrResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationsByStakerAddrResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationsByStakerAddrResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{15}
+}
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationsByStakerAddrResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationsByStakerAddrResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationsByStakerAddrResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationsByStakerAddrResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBTCDelegationsByStakerAddrResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) GetBtcDelegations() []*BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
+}
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFinalityProviderDelegationsRequest is the request type for the
 // Query/FinalityProviderDelegations RPC method.
 type QueryFinalityProviderDelegationsRequest struct {
@@ -632,7 +885,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Reset() {
 func (m *QueryFinalityProviderDelegationsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderDelegationsRequest) ProtoMessage()    {}
 func (*QueryFinalityProviderDelegationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{12}
+	return fileDescriptor_74d49d26f7429697, []int{16}
 }
 func (m *QueryFinalityProviderDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -690,7 +943,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Reset() {
 func (m *QueryFinalityProviderDelegationsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFinalityProviderDelegationsResponse) ProtoMessage()    {}
 func (*QueryFinalityProviderDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{13}
+	return fileDescriptor_74d49d26f7429697, []int{17}
 }
 func (m *QueryFinalityProviderDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,25 +986,31 @@ func (m *QueryFinalityProviderDelegationsResponse) GetPagination() *query.PageRe
 	return nil
 }
 
-// QueryBTCDelegationRequest is the request type to retrieve a BTC delegation by
-// staking tx hash
-type QueryBTCDelegationRequest struct {
-	// Hash of staking transaction in btc format
-	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+// QueryFinalityProviderCommissionHistoryRequest is the request type for the
+// Query/FinalityProviderCommissionHistory RPC method.
+type QueryFinalityProviderCommissionHistoryRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBTCDelegationRequest) Reset()         { *m = QueryBTCDelegationRequest{} }
-func (m *QueryBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBTCDelegationRequest) ProtoMessage()    {}
-func (*QueryBTCDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{14}
+func (m *QueryFinalityProviderCommissionHistoryRequest) Reset() {
+	*m = QueryFinalityProviderCommissionHistoryRequest{}
 }
-func (m *QueryBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFinalityProviderCommissionHistoryRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderCommissionHistoryRequest) ProtoMessage() {}
+func (*QueryFinalityProviderCommissionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{18}
+}
+func (m *QueryFinalityProviderCommissionHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBTCDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFinalityProviderCommissionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBTCDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFinalityProviderCommissionHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -761,44 +1020,61 @@ func (m *QueryBTCDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryBTCDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBTCDelegationRequest.Merge(m, src)
+func (m *QueryFinalityProviderCommissionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderCommissionHistoryRequest.Merge(m, src)
 }
-func (m *QueryBTCDelegationRequest) XXX_Size() int {
+func (m *QueryFinalityProviderCommissionHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBTCDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBTCDelegationRequest.DiscardUnknown(m)
+func (m *QueryFinalityProviderCommissionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderCommissionHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBTCDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFinalityProviderCommissionHistoryRequest proto.InternalMessageInfo
 
-func (m *QueryBTCDelegationRequest) GetStakingTxHashHex() string {
+func (m *QueryFinalityProviderCommissionHistoryRequest) GetFpBtcPkHex() string {
 	if m != nil {
-		return m.StakingTxHashHex
+		return m.FpBtcPkHex
 	}
 	return ""
 }
 
-// QueryBTCDelegationResponse is response type matching QueryBTCDelegationRequest
-// and containing BTC delegation information
-type QueryBTCDelegationResponse struct {
-	// BTCDelegation represents the client needed information of an BTCDelegation.
-	BtcDelegation *BTCDelegationResponse `protobuf:"bytes,1,opt,name=btc_delegation,json=btcDelegation,proto3" json:"btc_delegation,omitempty"`
+func (m *QueryFinalityProviderCommissionHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryBTCDelegationResponse) Reset()         { *m = QueryBTCDelegationResponse{} }
-func (m *QueryBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBTCDelegationResponse) ProtoMessage()    {}
-func (*QueryBTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{15}
+// QueryFinalityProviderCommissionHistoryResponse is the response type for the
+// Query/FinalityProviderCommissionHistory RPC method.
+type QueryFinalityProviderCommissionHistoryResponse struct {
+	// history contains the commission rate changes that took effect, in
+	// ascending order of their effective time
+	History []CommissionChange `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	// pending is the commission rate change that does not take effect yet,
+	// if any
+	Pending *CommissionChange `protobuf:"bytes,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryFinalityProviderCommissionHistoryResponse) Reset() {
+	*m = QueryFinalityProviderCommissionHistoryResponse{}
+}
+func (m *QueryFinalityProviderCommissionHistoryResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProviderCommissionHistoryResponse) ProtoMessage() {}
+func (*QueryFinalityProviderCommissionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{19}
+}
+func (m *QueryFinalityProviderCommissionHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFinalityProviderCommissionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBTCDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFinalityProviderCommissionHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -808,85 +1084,63 @@ func (m *QueryBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryBTCDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBTCDelegationResponse.Merge(m, src)
+func (m *QueryFinalityProviderCommissionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderCommissionHistoryResponse.Merge(m, src)
 }
-func (m *QueryBTCDelegationResponse) XXX_Size() int {
+func (m *QueryFinalityProviderCommissionHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBTCDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBTCDelegationResponse.DiscardUnknown(m)
+func (m *QueryFinalityProviderCommissionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderCommissionHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBTCDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFinalityProviderCommissionHistoryResponse proto.InternalMessageInfo
 
-func (m *QueryBTCDelegationResponse) GetBtcDelegation() *BTCDelegationResponse {
+func (m *QueryFinalityProviderCommissionHistoryResponse) GetHistory() []CommissionChange {
 	if m != nil {
-		return m.BtcDelegation
+		return m.History
 	}
 	return nil
 }
 
-// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
-type BTCDelegationResponse struct {
-	// staker_addr is the address to receive rewards from BTC delegation.
-	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
-	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
-	// this BTC delegation delegates to
-	FpBtcPkList []github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
-	// staking_time is the number of blocks for which the delegation is locked on BTC chain
-	StakingTime uint32 `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
-	// start_height is the start BTC height of the BTC delegation
-	// it is the start BTC height of the timelock
-	StartHeight uint32 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// end_height is the end height of the BTC delegation
-	// it is the end BTC height of the timelock - w
-	EndHeight uint32 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
-	// total_sat is the total amount of BTC stakes in this delegation
-	// quantified in satoshi
-	TotalSat uint64 `protobuf:"varint,7,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
-	// staking_tx_hex is the hex string of staking tx
-	StakingTxHex string `protobuf:"bytes,8,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
-	// slashing_tx_hex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,9,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slash_sig_hex is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
-	// It will be a part of the witness for the staking tx output.
-	DelegatorSlashSigHex string `protobuf:"bytes,10,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
-	// covenant_sigs is a list of adaptor signatures on the slashing tx
-	// by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,11,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
-	// staking_output_idx is the index of the staking output in the staking tx
-	StakingOutputIdx uint32 `protobuf:"varint,12,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
-	// whether this delegation is active
-	Active bool `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
-	// descriptive status of current delegation.
-	StatusDesc string `protobuf:"bytes,14,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// unbonding_time used in unbonding output timelock path and in slashing transactions
-	// change outputs
-	UnbondingTime uint32 `protobuf:"varint,15,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// undelegation_response is the undelegation info of this delegation.
-	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,16,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
-	// params version used to validate delegation
-	ParamsVersion uint32 `protobuf:"varint,17,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+func (m *QueryFinalityProviderCommissionHistoryResponse) GetPending() *CommissionChange {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
 }
 
-func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
-func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegationResponse) ProtoMessage()    {}
-func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{16}
+func (m *QueryFinalityProviderCommissionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+
+// StakeCapacity is the satoshis staked in active BTC delegations under a
+// stake cap
+type StakeCapacity struct {
+	// staked_sat is the satoshis locked in active BTC delegations
+	StakedSat uint64 `protobuf:"varint,1,opt,name=staked_sat,json=stakedSat,proto3" json:"staked_sat,omitempty"`
+	// max_staked_sat is the stake cap. 0 means there is no cap
+	MaxStakedSat uint64 `protobuf:"varint,2,opt,name=max_staked_sat,json=maxStakedSat,proto3" json:"max_staked_sat,omitempty"`
+	// remaining_sat is the satoshis that can still become active without
+	// exceeding the stake cap. It is 0 if there is no cap
+	RemainingSat uint64 `protobuf:"varint,3,opt,name=remaining_sat,json=remainingSat,proto3" json:"remaining_sat,omitempty"`
+}
+
+func (m *StakeCapacity) Reset()         { *m = StakeCapacity{} }
+func (m *StakeCapacity) String() string { return proto.CompactTextString(m) }
+func (*StakeCapacity) ProtoMessage()    {}
+func (*StakeCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{20}
+}
+func (m *StakeCapacity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StakeCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StakeCapacity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -896,143 +1150,97 @@ func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+func (m *StakeCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeCapacity.Merge(m, src)
 }
-func (m *BTCDelegationResponse) XXX_Size() int {
+func (m *StakeCapacity) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
-
-func (m *BTCDelegationResponse) GetStakerAddr() string {
-	if m != nil {
-		return m.StakerAddr
-	}
-	return ""
+func (m *StakeCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeCapacity.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetStakingTime() uint32 {
-	if m != nil {
-		return m.StakingTime
-	}
-	return 0
-}
+var xxx_messageInfo_StakeCapacity proto.InternalMessageInfo
 
-func (m *BTCDelegationResponse) GetStartHeight() uint32 {
+func (m *StakeCapacity) GetStakedSat() uint64 {
 	if m != nil {
-		return m.StartHeight
+		return m.StakedSat
 	}
 	return 0
 }
 
-func (m *BTCDelegationResponse) GetEndHeight() uint32 {
+func (m *StakeCapacity) GetMaxStakedSat() uint64 {
 	if m != nil {
-		return m.EndHeight
+		return m.MaxStakedSat
 	}
 	return 0
 }
 
-func (m *BTCDelegationResponse) GetTotalSat() uint64 {
+func (m *StakeCapacity) GetRemainingSat() uint64 {
 	if m != nil {
-		return m.TotalSat
+		return m.RemainingSat
 	}
 	return 0
 }
 
-func (m *BTCDelegationResponse) GetStakingTxHex() string {
-	if m != nil {
-		return m.StakingTxHex
-	}
-	return ""
-}
-
-func (m *BTCDelegationResponse) GetSlashingTxHex() string {
-	if m != nil {
-		return m.SlashingTxHex
-	}
-	return ""
-}
-
-func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
-	if m != nil {
-		return m.DelegatorSlashSigHex
-	}
-	return ""
+// QueryStakeCapacityRequest is the request type for the
+// Query/StakeCapacity RPC method.
+type QueryStakeCapacityRequest struct {
 }
 
-func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
-	if m != nil {
-		return m.CovenantSigs
-	}
-	return nil
+func (m *QueryStakeCapacityRequest) Reset()         { *m = QueryStakeCapacityRequest{} }
+func (m *QueryStakeCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeCapacityRequest) ProtoMessage()    {}
+func (*QueryStakeCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{21}
 }
-
-func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
-	if m != nil {
-		return m.StakingOutputIdx
-	}
-	return 0
+func (m *QueryStakeCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *BTCDelegationResponse) GetActive() bool {
-	if m != nil {
-		return m.Active
+func (m *QueryStakeCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakeCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
 }
-
-func (m *BTCDelegationResponse) GetStatusDesc() string {
-	if m != nil {
-		return m.StatusDesc
-	}
-	return ""
+func (m *QueryStakeCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeCapacityRequest.Merge(m, src)
 }
-
-func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
-	if m != nil {
-		return m.UnbondingTime
-	}
-	return 0
+func (m *QueryStakeCapacityRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
-	if m != nil {
-		return m.UndelegationResponse
-	}
-	return nil
+func (m *QueryStakeCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
-	if m != nil {
-		return m.ParamsVersion
-	}
-	return 0
-}
+var xxx_messageInfo_QueryStakeCapacityRequest proto.InternalMessageInfo
 
-// DelegatorUnbondingInfoResponse provides all necessary info about transaction
-// which spent the staking output
-type DelegatorUnbondingInfoResponse struct {
-	// spend_stake_tx_hex is the transaction which spent the staking output. It is
-	// filled only if the spend_stake_tx_hex is different than the unbonding_tx_hex
-	SpendStakeTxHex string `protobuf:"bytes,1,opt,name=spend_stake_tx_hex,json=spendStakeTxHex,proto3" json:"spend_stake_tx_hex,omitempty"`
+// QueryStakeCapacityResponse is the response type for the
+// Query/StakeCapacity RPC method.
+type QueryStakeCapacityResponse struct {
+	// capacity is the satoshis staked in all active BTC delegations under
+	// the total stake cap
+	Capacity StakeCapacity `protobuf:"bytes,1,opt,name=capacity,proto3" json:"capacity"`
 }
 
-func (m *DelegatorUnbondingInfoResponse) Reset()         { *m = DelegatorUnbondingInfoResponse{} }
-func (m *DelegatorUnbondingInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegatorUnbondingInfoResponse) ProtoMessage()    {}
-func (*DelegatorUnbondingInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{17}
+func (m *QueryStakeCapacityResponse) Reset()         { *m = QueryStakeCapacityResponse{} }
+func (m *QueryStakeCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakeCapacityResponse) ProtoMessage()    {}
+func (*QueryStakeCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{22}
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryStakeCapacityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryStakeCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorUnbondingInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryStakeCapacityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1042,62 +1250,50 @@ func (m *DelegatorUnbondingInfoResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorUnbondingInfoResponse.Merge(m, src)
+func (m *QueryStakeCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakeCapacityResponse.Merge(m, src)
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Size() int {
+func (m *QueryStakeCapacityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorUnbondingInfoResponse.DiscardUnknown(m)
+func (m *QueryStakeCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakeCapacityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegatorUnbondingInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryStakeCapacityResponse proto.InternalMessageInfo
 
-func (m *DelegatorUnbondingInfoResponse) GetSpendStakeTxHex() string {
+func (m *QueryStakeCapacityResponse) GetCapacity() StakeCapacity {
 	if m != nil {
-		return m.SpendStakeTxHex
+		return m.Capacity
 	}
-	return ""
-}
-
-// BTCUndelegationResponse provides all necessary info about the undeleagation
-type BTCUndelegationResponse struct {
-	// unbonding_tx is the transaction which will transfer the funds from staking
-	// output to unbonding output. Unbonding output will usually have lower timelock
-	// than staking output. The unbonding tx as string hex.
-	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
-	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
-	// by covenant members
-	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,2,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
-	// slashingTxHex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,3,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slashing_sig is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk).
-	// It will be a part of the witness for the unbonding tx output.
-	// The delegator slashing sig as string hex.
-	DelegatorSlashingSigHex string `protobuf:"bytes,4,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
-	// covenant_slashing_sigs is a list of adaptor signatures on the
-	// unbonding slashing tx by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,5,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
-	// btc_undelegation_info contains all necessary info about the transaction
-	// which spent the staking output
-	DelegatorUnbondingInfoResponse *DelegatorUnbondingInfoResponse `protobuf:"bytes,6,opt,name=delegator_unbonding_info_response,json=delegatorUnbondingInfoResponse,proto3" json:"delegator_unbonding_info_response,omitempty"`
+	return StakeCapacity{}
 }
 
-func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
-func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCUndelegationResponse) ProtoMessage()    {}
-func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{18}
-}
-func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+// FinalityProviderStakeCapacity is the stake capacity of a finality provider
+type FinalityProviderStakeCapacity struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// capacity is the satoshis staked to the finality provider under the per
+	// finality provider stake cap. A BTC delegation restaked to it also has
+	// to fit in the remaining capacity under the total stake cap
+	Capacity StakeCapacity `protobuf:"bytes,2,opt,name=capacity,proto3" json:"capacity"`
+	// accepts_delegations indicates whether the finality provider accepts new
+	// BTC delegations, i.e., it is neither slashed nor retired
+	AcceptsDelegations bool `protobuf:"varint,3,opt,name=accepts_delegations,json=acceptsDelegations,proto3" json:"accepts_delegations,omitempty"`
+}
+
+func (m *FinalityProviderStakeCapacity) Reset()         { *m = FinalityProviderStakeCapacity{} }
+func (m *FinalityProviderStakeCapacity) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderStakeCapacity) ProtoMessage()    {}
+func (*FinalityProviderStakeCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{23}
+}
+func (m *FinalityProviderStakeCapacity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FinalityProviderStakeCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FinalityProviderStakeCapacity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1107,77 +1303,62 @@ func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
+func (m *FinalityProviderStakeCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderStakeCapacity.Merge(m, src)
 }
-func (m *BTCUndelegationResponse) XXX_Size() int {
+func (m *FinalityProviderStakeCapacity) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
+func (m *FinalityProviderStakeCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderStakeCapacity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_FinalityProviderStakeCapacity proto.InternalMessageInfo
 
-func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+func (m *FinalityProviderStakeCapacity) GetFpBtcPkHex() string {
 	if m != nil {
-		return m.UnbondingTxHex
+		return m.FpBtcPkHex
 	}
 	return ""
 }
 
-func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
-	if m != nil {
-		return m.CovenantUnbondingSigList
-	}
-	return nil
-}
-
-func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
+func (m *FinalityProviderStakeCapacity) GetCapacity() StakeCapacity {
 	if m != nil {
-		return m.SlashingTxHex
+		return m.Capacity
 	}
-	return ""
+	return StakeCapacity{}
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+func (m *FinalityProviderStakeCapacity) GetAcceptsDelegations() bool {
 	if m != nil {
-		return m.DelegatorSlashingSigHex
+		return m.AcceptsDelegations
 	}
-	return ""
+	return false
 }
 
-func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
-	if m != nil {
-		return m.CovenantSlashingSigs
-	}
-	return nil
+// QueryFinalityProvidersStakeCapacityRequest is the request type for the
+// Query/FinalityProvidersStakeCapacity RPC method.
+type QueryFinalityProvidersStakeCapacityRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorUnbondingInfoResponse() *DelegatorUnbondingInfoResponse {
-	if m != nil {
-		return m.DelegatorUnbondingInfoResponse
-	}
-	return nil
+func (m *QueryFinalityProvidersStakeCapacityRequest) Reset() {
+	*m = QueryFinalityProvidersStakeCapacityRequest{}
 }
-
-// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
-type BTCDelegatorDelegationsResponse struct {
-	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
+func (m *QueryFinalityProvidersStakeCapacityRequest) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
-func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
-func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{19}
+func (*QueryFinalityProvidersStakeCapacityRequest) ProtoMessage() {}
+func (*QueryFinalityProvidersStakeCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{24}
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFinalityProvidersStakeCapacityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFinalityProvidersStakeCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFinalityProvidersStakeCapacityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1187,68 +1368,53 @@ func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
+func (m *QueryFinalityProvidersStakeCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProvidersStakeCapacityRequest.Merge(m, src)
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
+func (m *QueryFinalityProvidersStakeCapacityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
+func (m *QueryFinalityProvidersStakeCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProvidersStakeCapacityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFinalityProvidersStakeCapacityRequest proto.InternalMessageInfo
 
-func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+func (m *QueryFinalityProvidersStakeCapacityRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Dels
+		return m.Pagination
 	}
 	return nil
 }
 
-// FinalityProviderResponse defines a finality provider with voting power information.
-type FinalityProviderResponse struct {
-	// description defines the description terms for the finality provider.
-	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	// commission defines the commission rate of the finality provider.
-	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
-	// addr is the address to receive commission from delegations.
-	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
-	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// pop is the proof of possession of the BTC_PK by the fp addr.
-	// Essentially is the signature where the BTC SK sigs the fp addr.
-	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
-	// slashed_babylon_height indicates the Babylon height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
-	// slashed_btc_height indicates the BTC height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBtcHeight uint32 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
-	// height is the queried Babylon height
-	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
-	// jailed defines whether the finality provider is jailed
-	Jailed bool `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
-	// highest_voted_height is the highest height for which the
-	// finality provider has voted
-	HighestVotedHeight uint32 `protobuf:"varint,10,opt,name=highest_voted_height,json=highestVotedHeight,proto3" json:"highest_voted_height,omitempty"`
+// QueryFinalityProvidersStakeCapacityResponse is the response type for the
+// Query/FinalityProvidersStakeCapacity RPC method.
+type QueryFinalityProvidersStakeCapacityResponse struct {
+	// finality_providers contains the stake capacity of each finality provider
+	FinalityProviders []FinalityProviderStakeCapacity `protobuf:"bytes,1,rep,name=finality_providers,json=finalityProviders,proto3" json:"finality_providers"`
+	// total_capacity is the satoshis staked in all active BTC delegations
+	// under the total stake cap
+	TotalCapacity StakeCapacity `protobuf:"bytes,2,opt,name=total_capacity,json=totalCapacity,proto3" json:"total_capacity"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
-func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
-func (*FinalityProviderResponse) ProtoMessage()    {}
-func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{20}
+func (m *QueryFinalityProvidersStakeCapacityResponse) Reset() {
+	*m = QueryFinalityProvidersStakeCapacityResponse{}
 }
-func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFinalityProvidersStakeCapacityResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryFinalityProvidersStakeCapacityResponse) ProtoMessage() {}
+func (*QueryFinalityProvidersStakeCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{25}
+}
+func (m *QueryFinalityProvidersStakeCapacityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFinalityProvidersStakeCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFinalityProvidersStakeCapacityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1258,1982 +1424,7010 @@ func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
+func (m *QueryFinalityProvidersStakeCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProvidersStakeCapacityResponse.Merge(m, src)
 }
-func (m *FinalityProviderResponse) XXX_Size() int {
+func (m *QueryFinalityProvidersStakeCapacityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
+func (m *QueryFinalityProvidersStakeCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProvidersStakeCapacityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFinalityProvidersStakeCapacityResponse proto.InternalMessageInfo
 
-func (m *FinalityProviderResponse) GetDescription() *types.Description {
+func (m *QueryFinalityProvidersStakeCapacityResponse) GetFinalityProviders() []FinalityProviderStakeCapacity {
 	if m != nil {
-		return m.Description
+		return m.FinalityProviders
 	}
 	return nil
 }
 
-func (m *FinalityProviderResponse) GetAddr() string {
+func (m *QueryFinalityProvidersStakeCapacityResponse) GetTotalCapacity() StakeCapacity {
 	if m != nil {
-		return m.Addr
+		return m.TotalCapacity
 	}
-	return ""
+	return StakeCapacity{}
 }
 
-func (m *FinalityProviderResponse) GetPop() *ProofOfPossessionBTC {
+func (m *QueryFinalityProvidersStakeCapacityResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Pop
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
-	if m != nil {
-		return m.SlashedBabylonHeight
-	}
-	return 0
+// QueryFinalityProviderStakeCapacityRequest is the request type for the
+// Query/FinalityProviderStakeCapacity RPC method.
+type QueryFinalityProviderStakeCapacityRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
 }
 
-func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint32 {
-	if m != nil {
-		return m.SlashedBtcHeight
-	}
-	return 0
+func (m *QueryFinalityProviderStakeCapacityRequest) Reset() {
+	*m = QueryFinalityProviderStakeCapacityRequest{}
 }
-
-func (m *FinalityProviderResponse) GetHeight() uint64 {
-	if m != nil {
-		return m.Height
-	}
-	return 0
+func (m *QueryFinalityProviderStakeCapacityRequest) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *FinalityProviderResponse) GetJailed() bool {
-	if m != nil {
-		return m.Jailed
+func (*QueryFinalityProviderStakeCapacityRequest) ProtoMessage() {}
+func (*QueryFinalityProviderStakeCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{26}
+}
+func (m *QueryFinalityProviderStakeCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderStakeCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderStakeCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *QueryFinalityProviderStakeCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderStakeCapacityRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderStakeCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderStakeCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderStakeCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) GetHighestVotedHeight() uint32 {
+var xxx_messageInfo_QueryFinalityProviderStakeCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderStakeCapacityRequest) GetFpBtcPkHex() string {
 	if m != nil {
-		return m.HighestVotedHeight
+		return m.FpBtcPkHex
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
-	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
-	proto.RegisterType((*QueryParamsByBTCHeightRequest)(nil), "babylon.btcstaking.v1.QueryParamsByBTCHeightRequest")
-	proto.RegisterType((*QueryParamsByBTCHeightResponse)(nil), "babylon.btcstaking.v1.QueryParamsByBTCHeightResponse")
-	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
-	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
-	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
-	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
-	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
-	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
-	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
-	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
-	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
-	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
-	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
-	proto.RegisterType((*DelegatorUnbondingInfoResponse)(nil), "babylon.btcstaking.v1.DelegatorUnbondingInfoResponse")
-	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
-	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
-	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
+// QueryFinalityProviderStakeCapacityResponse is the response type for the
+// Query/FinalityProviderStakeCapacity RPC method.
+type QueryFinalityProviderStakeCapacityResponse struct {
+	// finality_provider is the stake capacity of the finality provider
+	FinalityProvider FinalityProviderStakeCapacity `protobuf:"bytes,1,opt,name=finality_provider,json=finalityProvider,proto3" json:"finality_provider"`
+	// total_capacity is the satoshis staked in all active BTC delegations
+	// under the total stake cap
+	TotalCapacity StakeCapacity `protobuf:"bytes,2,opt,name=total_capacity,json=totalCapacity,proto3" json:"total_capacity"`
 }
 
-func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
-
-var fileDescriptor_74d49d26f7429697 = []byte{
-	// 1772 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0xdb, 0xc8,
-	0x19, 0x0f, 0x6d, 0x45, 0xb1, 0x3f, 0xf9, 0x39, 0xeb, 0x24, 0x8c, 0xbc, 0x91, 0x1d, 0x35, 0x9b,
-	0x38, 0x0f, 0x8b, 0xb1, 0xe3, 0x34, 0x2d, 0x82, 0x6c, 0x1b, 0xd9, 0xbb, 0x9b, 0x74, 0x37, 0x8d,
-	0x4b, 0x25, 0x7b, 0xe8, 0x4b, 0xa0, 0xc8, 0x11, 0xc5, 0x46, 0xe2, 0x30, 0x9c, 0x91, 0x2b, 0x23,
-	0x30, 0x50, 0xf4, 0xd0, 0x73, 0x81, 0xf6, 0x8f, 0x28, 0xd0, 0x4b, 0x81, 0xe6, 0xd2, 0x43, 0xd1,
-	0xeb, 0xf6, 0xb6, 0x48, 0x2f, 0xc5, 0x1e, 0x82, 0x22, 0x29, 0x50, 0xa0, 0x40, 0xef, 0x45, 0x4f,
-	0x05, 0x67, 0x86, 0x0f, 0x49, 0xa4, 0x6c, 0x79, 0x7d, 0x23, 0xe7, 0x7b, 0xff, 0xe6, 0xf7, 0xcd,
-	0xc7, 0x21, 0x5c, 0x6a, 0x18, 0x8d, 0xfd, 0x36, 0x71, 0xb5, 0x06, 0x33, 0x29, 0x33, 0x9e, 0x3b,
-	0xae, 0xad, 0xed, 0x6d, 0x68, 0x2f, 0xba, 0xd8, 0xdf, 0xaf, 0x78, 0x3e, 0x61, 0x04, 0x9d, 0x95,
-	0x2a, 0x95, 0x58, 0xa5, 0xb2, 0xb7, 0x51, 0x5c, 0xb2, 0x89, 0x4d, 0xb8, 0x86, 0x16, 0x3c, 0x09,
-	0xe5, 0xe2, 0xfb, 0x36, 0x21, 0x76, 0x1b, 0x6b, 0x86, 0xe7, 0x68, 0x86, 0xeb, 0x12, 0x66, 0x30,
-	0x87, 0xb8, 0x54, 0x4a, 0x2f, 0x98, 0x84, 0x76, 0x08, 0xad, 0x0b, 0x33, 0xf1, 0x22, 0x45, 0x97,
-	0xc5, 0x9b, 0x16, 0x27, 0xd1, 0xc0, 0xcc, 0xd8, 0x08, 0xdf, 0xa5, 0xd6, 0x75, 0xa9, 0xd5, 0x30,
-	0x28, 0x16, 0x49, 0x46, 0x8a, 0x9e, 0x61, 0x3b, 0x2e, 0x8f, 0x26, 0x75, 0xcb, 0xe9, 0xa5, 0x79,
-	0x86, 0x6f, 0x74, 0xc2, 0xa8, 0x57, 0xd2, 0x75, 0x12, 0x95, 0x0a, 0xbd, 0x95, 0x0c, 0x5f, 0xc4,
-	0x13, 0x0a, 0xe5, 0x25, 0x40, 0x3f, 0x08, 0xd2, 0xd9, 0xe5, 0xde, 0x75, 0xfc, 0xa2, 0x8b, 0x29,
-	0x2b, 0xeb, 0xf0, 0x5e, 0xdf, 0x2a, 0xf5, 0x88, 0x4b, 0x31, 0xba, 0x07, 0x79, 0x91, 0x85, 0xaa,
-	0xac, 0x2a, 0x6b, 0x85, 0xcd, 0x8b, 0x95, 0x54, 0x88, 0x2b, 0xc2, 0xac, 0x9a, 0xfb, 0xe2, 0xcd,
-	0xca, 0x29, 0x5d, 0x9a, 0x94, 0xef, 0xc2, 0x72, 0xc2, 0x67, 0x75, 0xff, 0x73, 0xec, 0x53, 0x87,
-	0xb8, 0x32, 0x24, 0x52, 0xe1, 0xcc, 0x9e, 0x58, 0xe1, 0xce, 0x67, 0xf5, 0xf0, 0xb5, 0xfc, 0x23,
-	0x78, 0x3f, 0xdd, 0xf0, 0x24, 0xb2, 0xfa, 0x10, 0x2e, 0xf6, 0x39, 0xaf, 0x3e, 0xdd, 0x7e, 0x88,
-	0x1d, 0xbb, 0xc5, 0xc2, 0xbc, 0x2e, 0x02, 0x34, 0x98, 0x59, 0x6f, 0xf1, 0x45, 0x99, 0xda, 0x74,
-	0x83, 0x99, 0x42, 0xab, 0xfc, 0x73, 0x28, 0x65, 0xd9, 0x9f, 0x40, 0x7a, 0x49, 0x54, 0x26, 0xfa,
-	0x51, 0xb1, 0x65, 0xe2, 0x1f, 0x3b, 0xae, 0xd1, 0x76, 0xd8, 0xfe, 0xae, 0x4f, 0xf6, 0x1c, 0x0b,
-	0xfb, 0xe1, 0x1e, 0xa2, 0x8f, 0x01, 0x62, 0x6a, 0xc9, 0xd8, 0x57, 0x2a, 0x92, 0xbb, 0x01, 0x0f,
-	0x2b, 0xa2, 0x59, 0x24, 0x0f, 0x2b, 0xbb, 0x86, 0x8d, 0xa5, 0xad, 0x9e, 0xb0, 0x2c, 0xff, 0x55,
-	0x91, 0x25, 0xa6, 0x44, 0x92, 0x25, 0xfe, 0x14, 0x50, 0x53, 0x0a, 0x83, 0x16, 0x11, 0x52, 0x55,
-	0x59, 0x9d, 0x5c, 0x2b, 0x6c, 0x6a, 0x19, 0xe5, 0x0e, 0x7a, 0x0b, 0x9d, 0xe9, 0x8b, 0xcd, 0xc1,
-	0x38, 0xe8, 0x93, 0xbe, 0x52, 0x26, 0x78, 0x29, 0x57, 0x0f, 0x2d, 0x45, 0xfa, 0x4b, 0xd6, 0xf2,
-	0x40, 0x52, 0x69, 0x38, 0xb8, 0xc0, 0xec, 0x12, 0xcc, 0x36, 0xbd, 0x7a, 0xb0, 0xdf, 0xde, 0xf3,
-	0x7a, 0x0b, 0xf7, 0x38, 0x6c, 0xd3, 0x3a, 0x34, 0xbd, 0x2a, 0x33, 0x77, 0x9f, 0x3f, 0xc4, 0xbd,
-	0xf2, 0x41, 0x06, 0xee, 0x11, 0x18, 0x3f, 0x86, 0xc5, 0x21, 0x30, 0x24, 0xfc, 0x63, 0x63, 0xb1,
-	0x30, 0x88, 0x45, 0xf9, 0x77, 0x0a, 0x14, 0x79, 0xfc, 0xea, 0xd3, 0xed, 0x1d, 0xdc, 0xc6, 0xb6,
-	0x38, 0xa7, 0xc2, 0x02, 0xaa, 0x90, 0xa7, 0xcc, 0x60, 0x5d, 0x41, 0xb6, 0xb9, 0xcd, 0xeb, 0x19,
-	0x11, 0xfb, 0xac, 0x6b, 0xdc, 0x42, 0x97, 0x96, 0x03, 0xc4, 0x99, 0x38, 0x36, 0x71, 0xfe, 0xac,
-	0xc8, 0x8e, 0x1f, 0x4c, 0x55, 0x02, 0xf5, 0x0c, 0xe6, 0x03, 0xa4, 0xad, 0x58, 0x24, 0x29, 0x73,
-	0xf3, 0x28, 0x49, 0x47, 0x18, 0xcd, 0x35, 0x98, 0x99, 0x70, 0x7f, 0x72, 0x64, 0xf9, 0xad, 0x02,
-	0x57, 0x53, 0xb7, 0x3a, 0x05, 0xf7, 0xc3, 0x89, 0x73, 0x62, 0xb0, 0xfe, 0x4b, 0x81, 0xb5, 0xc3,
-	0xd3, 0x92, 0x18, 0xfb, 0x70, 0x21, 0x81, 0x31, 0xf1, 0x53, 0xd0, 0xfe, 0xe6, 0xa1, 0x68, 0x93,
-	0x34, 0xd7, 0xfa, 0xf9, 0x18, 0xf7, 0x3e, 0x85, 0x93, 0xdb, 0x80, 0xef, 0xc1, 0x85, 0x61, 0xfe,
-	0x84, 0x88, 0xaf, 0xc3, 0x7b, 0x32, 0xd9, 0x3a, 0xeb, 0xd5, 0x5b, 0x06, 0x6d, 0x25, 0x70, 0x5f,
-	0x90, 0xa2, 0xa7, 0xbd, 0x87, 0x06, 0x6d, 0x05, 0x6d, 0xfb, 0x22, 0xad, 0x6d, 0x22, 0x98, 0x6a,
-	0x30, 0xd7, 0x4f, 0x45, 0xd9, 0xb0, 0xe3, 0x31, 0x71, 0xb6, 0x8f, 0x89, 0xe5, 0xff, 0xe5, 0xe1,
-	0x6c, 0x7a, 0xb8, 0x6f, 0x43, 0x21, 0x70, 0x86, 0xfd, 0xba, 0x61, 0x59, 0xe2, 0x70, 0x98, 0xae,
-	0xaa, 0xaf, 0x5f, 0xad, 0x2f, 0x49, 0x94, 0x1e, 0x58, 0x96, 0x8f, 0x29, 0xad, 0x31, 0xdf, 0x71,
-	0x6d, 0x1d, 0x84, 0x72, 0xb0, 0x88, 0x9e, 0x40, 0x5e, 0xb0, 0x8c, 0x03, 0x3b, 0x53, 0xfd, 0xd6,
-	0x57, 0x6f, 0x56, 0xb6, 0x6c, 0x87, 0xb5, 0xba, 0x8d, 0x8a, 0x49, 0x3a, 0x9a, 0xcc, 0xb7, 0x6d,
-	0x34, 0xe8, 0xba, 0x43, 0xc2, 0x57, 0x8d, 0xed, 0x7b, 0x98, 0x56, 0xaa, 0x8f, 0x76, 0x6f, 0x6f,
-	0xdd, 0xda, 0xed, 0x36, 0x3e, 0xc5, 0xfb, 0xfa, 0xe9, 0x46, 0xc0, 0x4c, 0xf4, 0x13, 0x98, 0x8b,
-	0x99, 0xdb, 0x76, 0x28, 0x53, 0x27, 0x57, 0x27, 0xbf, 0x96, 0xe3, 0x82, 0x24, 0xfd, 0x67, 0x0e,
-	0x6f, 0x8c, 0x99, 0x68, 0x9b, 0x9c, 0x0e, 0x56, 0x73, 0x7c, 0x8a, 0x15, 0xc2, 0xfd, 0x71, 0x3a,
-	0x58, 0xaa, 0xf8, 0x2c, 0x9c, 0xb1, 0xa7, 0x23, 0x15, 0x9f, 0x89, 0x59, 0x1a, 0x0c, 0x61, 0xec,
-	0x5a, 0xa1, 0x42, 0x5e, 0x0c, 0x61, 0xec, 0x5a, 0x52, 0xbc, 0x0c, 0xd3, 0x8c, 0x30, 0xa3, 0x5d,
-	0xa7, 0x06, 0x53, 0xcf, 0xac, 0x2a, 0x6b, 0x39, 0x7d, 0x8a, 0x2f, 0xd4, 0x0c, 0x86, 0x2e, 0xc3,
-	0x5c, 0x92, 0x28, 0xb8, 0xa7, 0x4e, 0x71, 0x8e, 0xcc, 0xc4, 0x1c, 0xc1, 0x3d, 0x74, 0x05, 0xe6,
-	0x69, 0xdb, 0xa0, 0xad, 0x84, 0xda, 0x34, 0x57, 0x9b, 0x0d, 0x97, 0x85, 0xde, 0x1d, 0x38, 0x1f,
-	0x37, 0x13, 0x17, 0xd5, 0xa9, 0x63, 0x73, 0x7d, 0xe0, 0xfa, 0x4b, 0x91, 0xb8, 0x16, 0x48, 0x6b,
-	0x8e, 0x1d, 0x98, 0x3d, 0x83, 0x59, 0x93, 0xec, 0x61, 0xd7, 0x70, 0x59, 0xa0, 0x4f, 0xd5, 0x02,
-	0xef, 0xbd, 0x5b, 0x19, 0xfc, 0xda, 0x96, 0xba, 0x0f, 0x2c, 0xc3, 0x0b, 0x3c, 0x39, 0xb6, 0x6b,
-	0xb0, 0xae, 0x8f, 0xa9, 0x3e, 0x13, 0xba, 0xa9, 0x39, 0x36, 0x45, 0x37, 0x01, 0x85, 0xb5, 0x91,
-	0x2e, 0xf3, 0xba, 0xac, 0xee, 0x58, 0x3d, 0x75, 0x86, 0xe3, 0x13, 0xf6, 0xc0, 0x13, 0x2e, 0x78,
-	0x64, 0xf5, 0xd0, 0x39, 0xc8, 0x1b, 0x26, 0x73, 0xf6, 0xb0, 0x3a, 0xbb, 0xaa, 0xac, 0x4d, 0xe9,
-	0xf2, 0x0d, 0xad, 0x70, 0x3a, 0xb2, 0x2e, 0xad, 0x5b, 0x98, 0x9a, 0xea, 0x9c, 0x38, 0xba, 0xc4,
-	0xd2, 0x0e, 0xa6, 0x26, 0xfa, 0x00, 0xe6, 0xba, 0x6e, 0x83, 0xb8, 0x56, 0xb4, 0x8d, 0xf3, 0x3c,
-	0xc4, 0x6c, 0xb4, 0xca, 0x37, 0xd2, 0x84, 0xb3, 0x5d, 0x37, 0xee, 0xa1, 0xba, 0x2f, 0xf9, 0xae,
-	0x2e, 0xf0, 0x66, 0xaa, 0x64, 0x37, 0xd3, 0xb3, 0x84, 0x59, 0xd4, 0x4e, 0x4b, 0xdd, 0x94, 0xd5,
-	0x20, 0x17, 0xf1, 0x6d, 0x54, 0x0f, 0x3f, 0x8c, 0x16, 0x45, 0x2e, 0x62, 0x55, 0x7e, 0x1c, 0x96,
-	0x1f, 0x43, 0x29, 0x3a, 0x9c, 0x9e, 0x85, 0x59, 0x3e, 0x72, 0x9b, 0x24, 0x72, 0x74, 0x03, 0x10,
-	0xf5, 0x02, 0x56, 0xf1, 0xee, 0x0a, 0x37, 0x5d, 0x9c, 0x1f, 0xf3, 0x5c, 0x52, 0x0b, 0x04, 0x7c,
-	0xdb, 0xcb, 0xff, 0x9d, 0x84, 0xf3, 0x19, 0x79, 0xa2, 0x35, 0x58, 0x48, 0xa0, 0x93, 0x74, 0x13,
-	0xa3, 0x26, 0xc8, 0x63, 0xc2, 0x72, 0xc4, 0x82, 0xd8, 0x24, 0xe0, 0x0f, 0x6f, 0xbc, 0x09, 0xce,
-	0x89, 0xcb, 0x19, 0x30, 0x45, 0x24, 0xe0, 0x55, 0xa8, 0xa1, 0xa3, 0xa8, 0xb8, 0x9a, 0x63, 0xf3,
-	0x8e, 0x4b, 0x61, 0xf2, 0x64, 0x1a, 0x93, 0xef, 0x41, 0x71, 0x80, 0xc9, 0x61, 0x32, 0x81, 0x49,
-	0x8e, 0x9b, 0x9c, 0xef, 0x27, 0xb3, 0x88, 0x12, 0x18, 0x37, 0xe1, 0x5c, 0xcc, 0xe7, 0x84, 0x2d,
-	0x55, 0x4f, 0x1f, 0x93, 0xd8, 0x4b, 0x11, 0xb1, 0xe3, 0x48, 0x14, 0xfd, 0x42, 0x81, 0x4b, 0x71,
-	0x96, 0x31, 0x66, 0x8e, 0xdb, 0x24, 0x31, 0xbf, 0xf2, 0x9c, 0x5f, 0x77, 0x32, 0x62, 0x8e, 0xe6,
-	0x81, 0x5e, 0xb2, 0x46, 0xca, 0xcb, 0x26, 0xac, 0x1c, 0x32, 0x0a, 0xd1, 0x77, 0x21, 0x67, 0xe1,
-	0xf6, 0xf1, 0x3e, 0x5f, 0xb8, 0x65, 0xf9, 0x55, 0x0e, 0xd4, 0xcc, 0x2f, 0xca, 0x8f, 0xa0, 0x10,
-	0x34, 0xa6, 0xef, 0x78, 0x89, 0xd1, 0xf4, 0x8d, 0x70, 0xa2, 0xc6, 0x11, 0xc4, 0x38, 0xdd, 0x89,
-	0x55, 0xf5, 0xa4, 0x1d, 0x7a, 0x0c, 0x60, 0x92, 0x4e, 0xc7, 0xa1, 0xd1, 0x75, 0x62, 0xba, 0xba,
-	0xfe, 0xd5, 0x9b, 0x95, 0x65, 0xe1, 0x88, 0x5a, 0xcf, 0x2b, 0x0e, 0xd1, 0x3a, 0x06, 0x6b, 0x55,
-	0x3e, 0xc3, 0xb6, 0x61, 0xee, 0xef, 0x60, 0xf3, 0xf5, 0xab, 0x75, 0x90, 0x71, 0x76, 0xb0, 0xa9,
-	0x27, 0x1c, 0xa0, 0x9b, 0x90, 0xe3, 0xd3, 0x6b, 0xf2, 0x90, 0xe9, 0xc5, 0xb5, 0x12, 0x73, 0x2b,
-	0x77, 0x32, 0x73, 0xeb, 0x3e, 0x4c, 0x7a, 0xc4, 0xe3, 0xc3, 0xa2, 0xb0, 0x79, 0x23, 0xeb, 0x4e,
-	0xe5, 0x13, 0xd2, 0x7c, 0xd2, 0xdc, 0x25, 0x94, 0x62, 0x9e, 0x75, 0xf5, 0xe9, 0xb6, 0x1e, 0xd8,
-	0xa1, 0x2d, 0x38, 0xc7, 0x79, 0x8b, 0xad, 0xba, 0x34, 0x4d, 0x4e, 0x97, 0x9c, 0xbe, 0x24, 0xa5,
-	0x55, 0x21, 0x94, 0x83, 0x26, 0x38, 0x6f, 0x43, 0xab, 0xf8, 0x52, 0x78, 0x46, 0x9e, 0xb7, 0xd2,
-	0x22, 0xbc, 0x1b, 0x06, 0xe7, 0xad, 0xd4, 0x98, 0xe2, 0x3e, 0xe5, 0x5b, 0xb0, 0xfe, 0x33, 0xc3,
-	0x69, 0x63, 0x8b, 0x8f, 0x98, 0x29, 0x5d, 0xbe, 0xa1, 0x5b, 0xb0, 0xd4, 0x72, 0xec, 0x16, 0xa6,
-	0xac, 0xbe, 0x47, 0x18, 0x8e, 0xe6, 0x1d, 0x70, 0xff, 0x48, 0xca, 0x3e, 0x0f, 0x44, 0x22, 0xc2,
-	0xe6, 0xbf, 0x0b, 0x70, 0x9a, 0x7f, 0xd6, 0xa0, 0x5f, 0x29, 0x90, 0x17, 0x37, 0x48, 0x74, 0x2d,
-	0x03, 0x8c, 0xe1, 0x7b, 0x7e, 0xf1, 0xfa, 0x51, 0x54, 0x65, 0x1f, 0x7c, 0xf0, 0xcb, 0xbf, 0xfd,
-	0xf3, 0x37, 0x13, 0x2b, 0xe8, 0xa2, 0x36, 0xea, 0xff, 0x04, 0xfa, 0xbd, 0x02, 0xf3, 0x03, 0x37,
-	0x75, 0xb4, 0x79, 0x78, 0x98, 0xc1, 0xff, 0x01, 0xc5, 0xdb, 0x63, 0xd9, 0xc8, 0x1c, 0x35, 0x9e,
-	0xe3, 0x35, 0x74, 0x75, 0x64, 0x8e, 0xda, 0x4b, 0x39, 0x3a, 0x0e, 0xd0, 0x5f, 0x14, 0x58, 0x1c,
-	0xba, 0xba, 0xa3, 0xad, 0xa3, 0xc4, 0x1e, 0xfc, 0x53, 0x50, 0xbc, 0x33, 0xa6, 0x95, 0xcc, 0xf9,
-	0x3e, 0xcf, 0xf9, 0x2e, 0xba, 0x33, 0x3a, 0xe7, 0x98, 0x6f, 0xda, 0xcb, 0xf8, 0xf9, 0x00, 0xfd,
-	0x51, 0x81, 0xc5, 0xa1, 0x9b, 0xf9, 0xe8, 0x0a, 0xb2, 0x7e, 0x19, 0x8c, 0xae, 0x20, 0xf3, 0xfa,
-	0x5f, 0xde, 0xe0, 0x15, 0xdc, 0x40, 0xd7, 0x32, 0x2a, 0x18, 0xfe, 0x37, 0x80, 0x5e, 0x2b, 0xb0,
-	0x30, 0xe8, 0x10, 0xdd, 0x1e, 0x27, 0x7c, 0x98, 0xf3, 0xd6, 0x78, 0x46, 0x32, 0xe5, 0x1a, 0x4f,
-	0xf9, 0x31, 0xfa, 0xf4, 0xc8, 0x29, 0x6b, 0x2f, 0xfb, 0x2e, 0x78, 0x07, 0xc3, 0x2a, 0xe8, 0x0f,
-	0x0a, 0xcc, 0xf5, 0xdf, 0x75, 0xd1, 0xc6, 0xa8, 0xec, 0x52, 0xaf, 0xf0, 0xc5, 0xcd, 0x71, 0x4c,
-	0x64, 0x39, 0x77, 0x79, 0x39, 0x1b, 0x48, 0xd3, 0x32, 0xff, 0x0b, 0x26, 0x6f, 0x7e, 0xda, 0x4b,
-	0xf1, 0x71, 0x77, 0x80, 0xfe, 0xa3, 0xc0, 0xf2, 0x88, 0x7b, 0x24, 0xfa, 0x70, 0x1c, 0x74, 0x53,
-	0x8a, 0xf9, 0xce, 0xb1, 0xed, 0x65, 0x65, 0x8f, 0x79, 0x65, 0x9f, 0xa0, 0x8f, 0x8e, 0xbf, 0x51,
-	0x89, 0xc2, 0xd1, 0x9f, 0x14, 0x98, 0xed, 0xc3, 0x10, 0xdd, 0x3a, 0x32, 0xdc, 0x61, 0x4d, 0x1b,
-	0x63, 0x58, 0xc8, 0x2a, 0xb6, 0x79, 0x15, 0xf7, 0xd1, 0xbd, 0x23, 0xed, 0x0f, 0xdf, 0x9e, 0xc1,
-	0x9b, 0xed, 0x41, 0xf5, 0xfb, 0x5f, 0xbc, 0x2d, 0x29, 0x5f, 0xbe, 0x2d, 0x29, 0xff, 0x78, 0x5b,
-	0x52, 0x7e, 0xfd, 0xae, 0x74, 0xea, 0xcb, 0x77, 0xa5, 0x53, 0x7f, 0x7f, 0x57, 0x3a, 0xf5, 0xc3,
-	0x23, 0x0c, 0xd2, 0x5e, 0x32, 0x22, 0x9f, 0xaa, 0x8d, 0x3c, 0xff, 0x03, 0x7c, 0xfb, 0xff, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x78, 0x4e, 0x15, 0x86, 0x4b, 0x17, 0x00, 0x00,
+func (m *QueryFinalityProviderStakeCapacityResponse) Reset() {
+	*m = QueryFinalityProviderStakeCapacityResponse{}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
-	// ParamsByBTCHeight queries the parameters of the module for a specific BTC height
-	ParamsByBTCHeight(ctx context.Context, in *QueryParamsByBTCHeightRequest, opts ...grpc.CallOption) (*QueryParamsByBTCHeightResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+func (m *QueryFinalityProviderStakeCapacityResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (*QueryFinalityProviderStakeCapacityResponse) ProtoMessage() {}
+func (*QueryFinalityProviderStakeCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{27}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFinalityProviderStakeCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFinalityProviderStakeCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderStakeCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFinalityProviderStakeCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderStakeCapacityResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderStakeCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderStakeCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderStakeCapacityResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
-	out := new(QueryParamsByVersionResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFinalityProviderStakeCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderStakeCapacityResponse) GetFinalityProvider() FinalityProviderStakeCapacity {
+	if m != nil {
+		return m.FinalityProvider
 	}
-	return out, nil
+	return FinalityProviderStakeCapacity{}
 }
 
-func (c *queryClient) ParamsByBTCHeight(ctx context.Context, in *QueryParamsByBTCHeightRequest, opts ...grpc.CallOption) (*QueryParamsByBTCHeightResponse, error) {
-	out := new(QueryParamsByBTCHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByBTCHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFinalityProviderStakeCapacityResponse) GetTotalCapacity() StakeCapacity {
+	if m != nil {
+		return m.TotalCapacity
 	}
-	return out, nil
+	return StakeCapacity{}
 }
 
-func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
-	out := new(QueryFinalityProvidersResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBTCDelegationRequest is the request type to retrieve a BTC delegation by
+// staking tx hash
+type QueryBTCDelegationRequest struct {
+	// Hash of staking transaction in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
-	out := new(QueryFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBTCDelegationRequest) Reset()         { *m = QueryBTCDelegationRequest{} }
+func (m *QueryBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{28}
+}
+func (m *QueryBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBTCDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationRequest.Merge(m, src)
+}
+func (m *QueryBTCDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
-	out := new(QueryBTCDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBTCDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
-	out := new(QueryFinalityProviderDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryBTCDelegationResponse is response type matching QueryBTCDelegationRequest
+// and containing BTC delegation information
+type QueryBTCDelegationResponse struct {
+	// BTCDelegation represents the client needed information of an BTCDelegation.
+	BtcDelegation *BTCDelegationResponse `protobuf:"bytes,1,opt,name=btc_delegation,json=btcDelegation,proto3" json:"btc_delegation,omitempty"`
 }
 
-func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
-	out := new(QueryBTCDelegationResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBTCDelegationResponse) Reset()         { *m = QueryBTCDelegationResponse{} }
+func (m *QueryBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{29}
+}
+func (m *QueryBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBTCDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version of past params.
-	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
-	// ParamsByBTCHeight queries the parameters of the module for a specific BTC height
-	ParamsByBTCHeight(context.Context, *QueryParamsByBTCHeightRequest) (*QueryParamsByBTCHeightResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+func (m *QueryBTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationResponse.Merge(m, src)
+}
+func (m *QueryBTCDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryBTCDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryBTCDelegationResponse) GetBtcDelegation() *BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegation
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryValidateBTCDelegationRequest is the request type for the
+// Query/ValidateBTCDelegation RPC method.
+type QueryValidateBTCDelegationRequest struct {
+	// msg is the MsgCreateBTCDelegation to be validated
+	Msg *MsgCreateBTCDelegation `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
 }
-func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
+
+func (m *QueryValidateBTCDelegationRequest) Reset()         { *m = QueryValidateBTCDelegationRequest{} }
+func (m *QueryValidateBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateBTCDelegationRequest) ProtoMessage()    {}
+func (*QueryValidateBTCDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{30}
 }
-func (*UnimplementedQueryServer) ParamsByBTCHeight(ctx context.Context, req *QueryParamsByBTCHeightRequest) (*QueryParamsByBTCHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByBTCHeight not implemented")
+func (m *QueryValidateBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
+func (m *QueryValidateBTCDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateBTCDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+func (m *QueryValidateBTCDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateBTCDelegationRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+func (m *QueryValidateBTCDelegationRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+func (m *QueryValidateBTCDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateBTCDelegationRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+
+var xxx_messageInfo_QueryValidateBTCDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryValidateBTCDelegationRequest) GetMsg() *MsgCreateBTCDelegation {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryValidateBTCDelegationResponse is the response type for the
+// Query/ValidateBTCDelegation RPC method.
+type QueryValidateBTCDelegationResponse struct {
+	// valid is true if MsgCreateBTCDelegation would be accepted against the
+	// current state
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// failures contains every failing rule, if any
+	Failures []BTCDelegationValidationFailure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryValidateBTCDelegationResponse) Reset()         { *m = QueryValidateBTCDelegationResponse{} }
+func (m *QueryValidateBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateBTCDelegationResponse) ProtoMessage()    {}
+func (*QueryValidateBTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{31}
+}
+func (m *QueryValidateBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateBTCDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryValidateBTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateBTCDelegationResponse.Merge(m, src)
+}
+func (m *QueryValidateBTCDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateBTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateBTCDelegationResponse.DiscardUnknown(m)
 }
 
-func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+var xxx_messageInfo_QueryValidateBTCDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryValidateBTCDelegationResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_ParamsByBTCHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByBTCHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByBTCHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByBTCHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByBTCHeight(ctx, req.(*QueryParamsByBTCHeightRequest))
+func (m *QueryValidateBTCDelegationResponse) GetFailures() []BTCDelegationValidationFailure {
+	if m != nil {
+		return m.Failures
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// BTCDelegationValidationFailure is a rule of MsgCreateBTCDelegation that
+// the validated message fails
+type BTCDelegationValidationFailure struct {
+	// rule is the name of the failing rule
+	Rule string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	// codespace is the codespace of the error returned by the rule
+	Codespace string `protobuf:"bytes,2,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code is the code of the error returned by the rule
+	Code uint32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	// message is the error message returned by the rule
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+func (m *BTCDelegationValidationFailure) Reset()         { *m = BTCDelegationValidationFailure{} }
+func (m *BTCDelegationValidationFailure) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationValidationFailure) ProtoMessage()    {}
+func (*BTCDelegationValidationFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{32}
+}
+func (m *BTCDelegationValidationFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationValidationFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationValidationFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *BTCDelegationValidationFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationValidationFailure.Merge(m, src)
+}
+func (m *BTCDelegationValidationFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationValidationFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationValidationFailure.DiscardUnknown(m)
 }
 
-func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+var xxx_messageInfo_BTCDelegationValidationFailure proto.InternalMessageInfo
+
+func (m *BTCDelegationValidationFailure) GetRule() string {
+	if m != nil {
+		return m.Rule
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+func (m *BTCDelegationValidationFailure) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegation(ctx, in)
+func (m *BTCDelegationValidationFailure) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
+	return 0
+}
+
+func (m *BTCDelegationValidationFailure) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsByVersion",
-			Handler:    _Query_ParamsByVersion_Handler,
-		},
-		{
-			MethodName: "ParamsByBTCHeight",
-			Handler:    _Query_ParamsByBTCHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviders",
-			Handler:    _Query_FinalityProviders_Handler,
-		},
-		{
-			MethodName: "FinalityProvider",
-			Handler:    _Query_FinalityProvider_Handler,
-		},
-		{
-			MethodName: "BTCDelegations",
-			Handler:    _Query_BTCDelegations_Handler,
-		},
-		{
-			MethodName: "FinalityProviderDelegations",
-			Handler:    _Query_FinalityProviderDelegations_Handler,
-		},
-		{
-			MethodName: "BTCDelegation",
-			Handler:    _Query_BTCDelegation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btcstaking/v1/query.proto",
+// QueryAllowedStakingTxHashRequest is the request type for the
+// Query/AllowedStakingTxHash RPC method.
+type QueryAllowedStakingTxHashRequest struct {
+	// staking_tx_hash_hex is the hash of the staking tx in BTC format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllowedStakingTxHashRequest) Reset()         { *m = QueryAllowedStakingTxHashRequest{} }
+func (m *QueryAllowedStakingTxHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedStakingTxHashRequest) ProtoMessage()    {}
+func (*QueryAllowedStakingTxHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{33}
+}
+func (m *QueryAllowedStakingTxHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedStakingTxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedStakingTxHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllowedStakingTxHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedStakingTxHashRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAllowedStakingTxHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedStakingTxHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedStakingTxHashRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowedStakingTxHashRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedStakingTxHashRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllowedStakingTxHashResponse is the response type for the
+// Query/AllowedStakingTxHash RPC method.
+type QueryAllowedStakingTxHashResponse struct {
+	// allowed indicates whether the staking tx hash is in the allow list
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// allow_list_enabled indicates whether the allow list is enforced at
+	// the current height
+	AllowListEnabled bool `protobuf:"varint,2,opt,name=allow_list_enabled,json=allowListEnabled,proto3" json:"allow_list_enabled,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllowedStakingTxHashResponse) Reset()         { *m = QueryAllowedStakingTxHashResponse{} }
+func (m *QueryAllowedStakingTxHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedStakingTxHashResponse) ProtoMessage()    {}
+func (*QueryAllowedStakingTxHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{34}
+}
+func (m *QueryAllowedStakingTxHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedStakingTxHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedStakingTxHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllowedStakingTxHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedStakingTxHashResponse.Merge(m, src)
 }
-
-func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllowedStakingTxHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedStakingTxHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedStakingTxHashResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryAllowedStakingTxHashResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedStakingTxHashResponse) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllowedStakingTxHashResponse) GetAllowListEnabled() bool {
+	if m != nil {
+		return m.AllowListEnabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllowedStakingTxHashesRequest is the request type for the
+// Query/AllowedStakingTxHashes RPC method.
+type QueryAllowedStakingTxHashesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllowedStakingTxHashesRequest) Reset()         { *m = QueryAllowedStakingTxHashesRequest{} }
+func (m *QueryAllowedStakingTxHashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedStakingTxHashesRequest) ProtoMessage()    {}
+func (*QueryAllowedStakingTxHashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{35}
+}
+func (m *QueryAllowedStakingTxHashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedStakingTxHashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedStakingTxHashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsByBTCHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllowedStakingTxHashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedStakingTxHashesRequest.Merge(m, src)
 }
-
-func (m *QueryParamsByBTCHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllowedStakingTxHashesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsByBTCHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryAllowedStakingTxHashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedStakingTxHashesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByBTCHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllowedStakingTxHashesRequest proto.InternalMessageInfo
+
+func (m *QueryAllowedStakingTxHashesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsByBTCHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllowedStakingTxHashesResponse is the response type for the
+// Query/AllowedStakingTxHashes RPC method.
+type QueryAllowedStakingTxHashesResponse struct {
+	// staking_tx_hashes_hex is the list of staking tx hashes in BTC format
+	// in the allow list
+	StakingTxHashesHex []string `protobuf:"bytes,1,rep,name=staking_tx_hashes_hex,json=stakingTxHashesHex,proto3" json:"staking_tx_hashes_hex,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsByBTCHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAllowedStakingTxHashesResponse) Reset()         { *m = QueryAllowedStakingTxHashesResponse{} }
+func (m *QueryAllowedStakingTxHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllowedStakingTxHashesResponse) ProtoMessage()    {}
+func (*QueryAllowedStakingTxHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{36}
+}
+func (m *QueryAllowedStakingTxHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllowedStakingTxHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllowedStakingTxHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAllowedStakingTxHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllowedStakingTxHashesResponse.Merge(m, src)
 }
-
-func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllowedStakingTxHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllowedStakingTxHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllowedStakingTxHashesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAllowedStakingTxHashesResponse proto.InternalMessageInfo
+
+func (m *QueryAllowedStakingTxHashesResponse) GetStakingTxHashesHex() []string {
+	if m != nil {
+		return m.StakingTxHashesHex
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllowedStakingTxHashesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
+type BTCDelegationResponse struct {
+	// staker_addr is the address to receive rewards from BTC delegation.
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
+	// this BTC delegation delegates to
+	FpBtcPkList []github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// staking_time is the number of blocks for which the delegation is locked on BTC chain
+	StakingTime uint32 `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// start_height is the start BTC height of the BTC delegation
+	// it is the start BTC height of the timelock
+	StartHeight uint32 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end height of the BTC delegation
+	// it is the end BTC height of the timelock - w
+	EndHeight uint32 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// total_sat is the total amount of BTC stakes in this delegation
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,7,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// staking_tx_hex is the hex string of staking tx
+	StakingTxHex string `protobuf:"bytes,8,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// slashing_tx_hex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,9,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slash_sig_hex is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
+	// It will be a part of the witness for the staking tx output.
+	DelegatorSlashSigHex string `protobuf:"bytes,10,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
+	// covenant_sigs is a list of adaptor signatures on the slashing tx
+	// by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,11,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,12,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// whether this delegation is active
+	Active bool `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
+	// descriptive status of current delegation.
+	StatusDesc string `protobuf:"bytes,14,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// unbonding_time used in unbonding output timelock path and in slashing transactions
+	// change outputs
+	UnbondingTime uint32 `protobuf:"varint,15,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// undelegation_response is the undelegation info of this delegation.
+	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,16,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
+	// params version used to validate delegation
+	ParamsVersion uint32 `protobuf:"varint,17,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// prev_staking_tx_hash is the staking tx hash of the BTC delegation that
+	// this BTC delegation extends or redelegates, if any
+	PrevStakingTxHash string `protobuf:"bytes,18,opt,name=prev_staking_tx_hash,json=prevStakingTxHash,proto3" json:"prev_staking_tx_hash,omitempty"`
+	// covenant_transition_sigs is the list of signatures of the covenant members
+	// on the staking tx spending the staking output of the previous BTC
+	// delegation through its unbonding path, if any
+	CovenantTransitionSigs []*SignatureInfo `protobuf:"bytes,19,rep,name=covenant_transition_sigs,json=covenantTransitionSigs,proto3" json:"covenant_transition_sigs,omitempty"`
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
+func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationResponse) ProtoMessage()    {}
+func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{37}
+}
+func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+}
+func (m *BTCDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
+
+func (m *BTCDelegationResponse) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCDelegationResponse) GetStakingTime() uint32 {
+	if m != nil {
+		return m.StakingTime
+	}
+	return 0
 }
 
-func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *BTCDelegationResponse) GetStartHeight() uint32 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetEndHeight() uint32 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCDelegationResponse) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
+	}
+	return 0
 }
 
-func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		{
-			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *BTCDelegationResponse) GetStakingTxHex() string {
+	if m != nil {
+		return m.StakingTxHex
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashSigHex
+	}
+	return ""
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSigs
 	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
+	if m != nil {
+		return m.StakingOutputIdx
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BtcDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *BTCDelegationResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStatusDesc() string {
+	if m != nil {
+		return m.StatusDesc
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
+	if m != nil {
+		return m.UndelegationResponse
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetPrevStakingTxHash() string {
+	if m != nil {
+		return m.PrevStakingTxHash
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingTxHashHex) > 0 {
-		i -= len(m.StakingTxHashHex)
-		copy(dAtA[i:], m.StakingTxHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *BTCDelegationResponse) GetCovenantTransitionSigs() []*SignatureInfo {
+	if m != nil {
+		return m.CovenantTransitionSigs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// DelegatorUnbondingInfoResponse provides all necessary info about transaction
+// which spent the staking output
+type DelegatorUnbondingInfoResponse struct {
+	// spend_stake_tx_hex is the transaction which spent the staking output. It is
+	// filled only if the spend_stake_tx_hex is different than the unbonding_tx_hex
+	SpendStakeTxHex string `protobuf:"bytes,1,opt,name=spend_stake_tx_hex,json=spendStakeTxHex,proto3" json:"spend_stake_tx_hex,omitempty"`
 }
 
-func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DelegatorUnbondingInfoResponse) Reset()         { *m = DelegatorUnbondingInfoResponse{} }
+func (m *DelegatorUnbondingInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegatorUnbondingInfoResponse) ProtoMessage()    {}
+func (*DelegatorUnbondingInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{38}
 }
-
-func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		{
-			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *DelegatorUnbondingInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorUnbondingInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DelegatorUnbondingInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorUnbondingInfoResponse.Merge(m, src)
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorUnbondingInfoResponse.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DelegatorUnbondingInfoResponse proto.InternalMessageInfo
+
+func (m *DelegatorUnbondingInfoResponse) GetSpendStakeTxHex() string {
+	if m != nil {
+		return m.SpendStakeTxHex
+	}
+	return ""
 }
 
-func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ParamsVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if m.UndelegationResponse != nil {
-		{
-			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.StakingOutputIdx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.CovenantSigs) > 0 {
-		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if len(m.DelegatorSlashSigHex) > 0 {
-		i -= len(m.DelegatorSlashSigHex)
-		copy(dAtA[i:], m.DelegatorSlashSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.StakingTxHex) > 0 {
-		i -= len(m.StakingTxHex)
-		copy(dAtA[i:], m.StakingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.TotalSat != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.EndHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.StartHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.StakingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+// BTCUndelegationResponse provides all necessary info about the undeleagation
+type BTCUndelegationResponse struct {
+	// unbonding_tx is the transaction which will transfer the funds from staking
+	// output to unbonding output. Unbonding output will usually have lower timelock
+	// than staking output. The unbonding tx as string hex.
+	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
+	// by covenant members
+	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,2,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
+	// slashingTxHex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,3,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slashing_sig is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk).
+	// It will be a part of the witness for the unbonding tx output.
+	// The delegator slashing sig as string hex.
+	DelegatorSlashingSigHex string `protobuf:"bytes,4,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
+	// covenant_slashing_sigs is a list of adaptor signatures on the
+	// unbonding slashing tx by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,5,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
+	// btc_undelegation_info contains all necessary info about the transaction
+	// which spent the staking output
+	DelegatorUnbondingInfoResponse *DelegatorUnbondingInfoResponse `protobuf:"bytes,6,opt,name=delegator_unbonding_info_response,json=delegatorUnbondingInfoResponse,proto3" json:"delegator_unbonding_info_response,omitempty"`
+}
+
+func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
+func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCUndelegationResponse) ProtoMessage()    {}
+func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{39}
+}
+func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
+}
+func (m *BTCUndelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
+
+func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+	if m != nil {
+		return m.UnbondingTxHex
 	}
-	if len(m.StakerAddr) > 0 {
-		i -= len(m.StakerAddr)
-		copy(dAtA[i:], m.StakerAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
+	if m != nil {
+		return m.CovenantUnbondingSigList
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DelegatorUnbondingInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DelegatorUnbondingInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashingSigHex
+	}
+	return ""
 }
 
-func (m *DelegatorUnbondingInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpendStakeTxHex) > 0 {
-		i -= len(m.SpendStakeTxHex)
-		copy(dAtA[i:], m.SpendStakeTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpendStakeTxHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSlashingSigs
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCUndelegationResponse) GetDelegatorUnbondingInfoResponse() *DelegatorUnbondingInfoResponse {
+	if m != nil {
+		return m.DelegatorUnbondingInfoResponse
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
+type BTCDelegatorDelegationsResponse struct {
+	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
 }
 
-func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DelegatorUnbondingInfoResponse != nil {
-		{
-			size, err := m.DelegatorUnbondingInfoResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
+func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
+func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{40}
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
+
+func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+	if m != nil {
+		return m.Dels
+	}
+	return nil
+}
+
+// FinalityProviderResponse defines a finality provider with voting power information.
+type FinalityProviderResponse struct {
+	// description defines the description terms for the finality provider.
+	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// commission defines the commission rate of the finality provider.
+	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
+	// addr is the address to receive commission from delegations.
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// pop is the proof of possession of the BTC_PK by the fp addr.
+	// Essentially is the signature where the BTC SK sigs the fp addr.
+	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
+	// slashed_babylon_height indicates the Babylon height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
+	// slashed_btc_height indicates the BTC height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBtcHeight uint32 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// height is the queried Babylon height
+	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// jailed defines whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// highest_voted_height is the highest height for which the
+	// finality provider has voted
+	HighestVotedHeight uint32 `protobuf:"varint,10,opt,name=highest_voted_height,json=highestVotedHeight,proto3" json:"highest_voted_height,omitempty"`
+	// retired_babylon_height indicates the Babylon height when
+	// the finality provider announced its retirement.
+	// if it's 0 then the finality provider is not retired
+	RetiredBabylonHeight uint64 `protobuf:"varint,11,opt,name=retired_babylon_height,json=retiredBabylonHeight,proto3" json:"retired_babylon_height,omitempty"`
+	// commission_info defines the limits on changing the commission rate
+	// of the finality provider
+	CommissionInfo *CommissionInfo `protobuf:"bytes,12,opt,name=commission_info,json=commissionInfo,proto3" json:"commission_info,omitempty"`
+}
+
+func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
+func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderResponse) ProtoMessage()    {}
+func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{41}
+}
+func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.DelegatorSlashingSigHex) > 0 {
-		i -= len(m.DelegatorSlashingSigHex)
-		copy(dAtA[i:], m.DelegatorSlashingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
+}
+func (m *FinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+
+func (m *FinalityProviderResponse) GetDescription() *types.Description {
+	if m != nil {
+		return m.Description
 	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *FinalityProviderResponse) GetAddr() string {
+	if m != nil {
+		return m.Addr
 	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return ""
+}
+
+func (m *FinalityProviderResponse) GetPop() *ProofOfPossessionBTC {
+	if m != nil {
+		return m.Pop
 	}
-	if len(m.UnbondingTxHex) > 0 {
-		i -= len(m.UnbondingTxHex)
-		copy(dAtA[i:], m.UnbondingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
+	if m != nil {
+		return m.SlashedBabylonHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint32 {
+	if m != nil {
+		return m.SlashedBtcHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Dels) > 0 {
-		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *FinalityProviderResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetHighestVotedHeight() uint32 {
+	if m != nil {
+		return m.HighestVotedHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetRetiredBabylonHeight() uint64 {
+	if m != nil {
+		return m.RetiredBabylonHeight
+	}
+	return 0
 }
 
-func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HighestVotedHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.HighestVotedHeight))
-		i--
-		dAtA[i] = 0x50
+func (m *FinalityProviderResponse) GetCommissionInfo() *CommissionInfo {
+	if m != nil {
+		return m.CommissionInfo
 	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.SlashedBtcHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.SlashedBabylonHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
+	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
+	proto.RegisterType((*QueryParamsByBTCHeightRequest)(nil), "babylon.btcstaking.v1.QueryParamsByBTCHeightRequest")
+	proto.RegisterType((*QueryParamsByBTCHeightResponse)(nil), "babylon.btcstaking.v1.QueryParamsByBTCHeightResponse")
+	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
+	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
+	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
+	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
+	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
+	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
+	proto.RegisterType((*QueryBTCDelegationsByStakerBTCPKRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByStakerBTCPKRequest")
+	proto.RegisterType((*QueryBTCDelegationsByStakerBTCPKResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByStakerBTCPKResponse")
+	proto.RegisterType((*QueryBTCDelegationsByStakerAddrRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByStakerAddrRequest")
+	proto.RegisterType((*QueryBTCDelegationsByStakerAddrResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsByStakerAddrResponse")
+	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
+	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
+	proto.RegisterType((*QueryFinalityProviderCommissionHistoryRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCommissionHistoryRequest")
+	proto.RegisterType((*QueryFinalityProviderCommissionHistoryResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderCommissionHistoryResponse")
+	proto.RegisterType((*StakeCapacity)(nil), "babylon.btcstaking.v1.StakeCapacity")
+	proto.RegisterType((*QueryStakeCapacityRequest)(nil), "babylon.btcstaking.v1.QueryStakeCapacityRequest")
+	proto.RegisterType((*QueryStakeCapacityResponse)(nil), "babylon.btcstaking.v1.QueryStakeCapacityResponse")
+	proto.RegisterType((*FinalityProviderStakeCapacity)(nil), "babylon.btcstaking.v1.FinalityProviderStakeCapacity")
+	proto.RegisterType((*QueryFinalityProvidersStakeCapacityRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersStakeCapacityRequest")
+	proto.RegisterType((*QueryFinalityProvidersStakeCapacityResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersStakeCapacityResponse")
+	proto.RegisterType((*QueryFinalityProviderStakeCapacityRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderStakeCapacityRequest")
+	proto.RegisterType((*QueryFinalityProviderStakeCapacityResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderStakeCapacityResponse")
+	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
+	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
+	proto.RegisterType((*QueryValidateBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryValidateBTCDelegationRequest")
+	proto.RegisterType((*QueryValidateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryValidateBTCDelegationResponse")
+	proto.RegisterType((*BTCDelegationValidationFailure)(nil), "babylon.btcstaking.v1.BTCDelegationValidationFailure")
+	proto.RegisterType((*QueryAllowedStakingTxHashRequest)(nil), "babylon.btcstaking.v1.QueryAllowedStakingTxHashRequest")
+	proto.RegisterType((*QueryAllowedStakingTxHashResponse)(nil), "babylon.btcstaking.v1.QueryAllowedStakingTxHashResponse")
+	proto.RegisterType((*QueryAllowedStakingTxHashesRequest)(nil), "babylon.btcstaking.v1.QueryAllowedStakingTxHashesRequest")
+	proto.RegisterType((*QueryAllowedStakingTxHashesResponse)(nil), "babylon.btcstaking.v1.QueryAllowedStakingTxHashesResponse")
+	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
+	proto.RegisterType((*DelegatorUnbondingInfoResponse)(nil), "babylon.btcstaking.v1.DelegatorUnbondingInfoResponse")
+	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
+	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
+	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
+
+var fileDescriptor_74d49d26f7429697 = []byte{
+	// 2757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x49, 0x6c, 0xdb, 0xd8,
+	0x19, 0x0e, 0x2d, 0xaf, 0xbf, 0x2d, 0x2f, 0x2f, 0x8e, 0xa3, 0x91, 0x27, 0x72, 0xcc, 0x99, 0xec,
+	0xb1, 0x14, 0x3b, 0x4e, 0xb3, 0x21, 0x99, 0x58, 0x76, 0x12, 0x67, 0xb2, 0x39, 0x94, 0x93, 0x02,
+	0x5d, 0x46, 0xa0, 0xa8, 0x67, 0x8a, 0xb5, 0x44, 0x2a, 0x24, 0xe5, 0x91, 0x11, 0x18, 0x18, 0x14,
+	0xe8, 0x5c, 0x7a, 0x29, 0x30, 0x3d, 0xf5, 0x56, 0xf4, 0x52, 0xa0, 0x97, 0x02, 0xcd, 0xa5, 0x2d,
+	0x8a, 0x1e, 0x3b, 0x29, 0x50, 0x60, 0x90, 0x5e, 0xda, 0x39, 0x04, 0x83, 0xa4, 0xe8, 0x72, 0x28,
+	0x8a, 0x5e, 0x8a, 0x1e, 0x8b, 0xb7, 0x70, 0x91, 0x44, 0xd2, 0x92, 0xec, 0xa2, 0x9d, 0x9b, 0xc8,
+	0xf7, 0x6f, 0xdf, 0xbf, 0xbc, 0xf7, 0xf3, 0x7f, 0x82, 0xd9, 0x82, 0x5c, 0xd8, 0x2e, 0x1b, 0x7a,
+	0xa6, 0x60, 0x2b, 0x96, 0x2d, 0x6f, 0x6a, 0xba, 0x9a, 0xd9, 0x9a, 0xcf, 0x3c, 0xad, 0x61, 0x73,
+	0x3b, 0x5d, 0x35, 0x0d, 0xdb, 0x40, 0x87, 0x38, 0x49, 0xda, 0x23, 0x49, 0x6f, 0xcd, 0x27, 0x27,
+	0x55, 0x43, 0x35, 0x28, 0x45, 0x86, 0xfc, 0x62, 0xc4, 0xc9, 0xb7, 0x55, 0xc3, 0x50, 0xcb, 0x38,
+	0x23, 0x57, 0xb5, 0x8c, 0xac, 0xeb, 0x86, 0x2d, 0xdb, 0x9a, 0xa1, 0x5b, 0x7c, 0xf5, 0x2d, 0xc5,
+	0xb0, 0x2a, 0x86, 0x95, 0x67, 0x6c, 0xec, 0x81, 0x2f, 0xbd, 0xcb, 0x9e, 0x32, 0x9e, 0x11, 0x05,
+	0x6c, 0xcb, 0xf3, 0xce, 0x33, 0xa7, 0x3a, 0xcd, 0xa9, 0x0a, 0xb2, 0x85, 0x99, 0x91, 0x2e, 0x61,
+	0x55, 0x56, 0x35, 0x9d, 0x6a, 0xe3, 0xb4, 0x62, 0x30, 0xb4, 0xaa, 0x6c, 0xca, 0x15, 0x47, 0xeb,
+	0xf1, 0x60, 0x1a, 0x1f, 0x52, 0x46, 0x37, 0x13, 0x22, 0xcb, 0xa8, 0x72, 0x82, 0x54, 0x30, 0x81,
+	0x5d, 0x67, 0xeb, 0xe2, 0x24, 0xa0, 0x47, 0xc4, 0xdc, 0x35, 0xaa, 0x5d, 0xc2, 0x4f, 0x6b, 0xd8,
+	0xb2, 0x45, 0x09, 0x0e, 0x36, 0xbc, 0xb5, 0xaa, 0x86, 0x6e, 0x61, 0x74, 0x15, 0xfa, 0x99, 0x95,
+	0x09, 0xe1, 0xa8, 0x70, 0x72, 0x78, 0xe1, 0x48, 0x3a, 0x30, 0x04, 0x69, 0xc6, 0x96, 0xed, 0xfd,
+	0xf4, 0xd5, 0xcc, 0x01, 0x89, 0xb3, 0x88, 0x17, 0x61, 0xda, 0x27, 0x33, 0xbb, 0xfd, 0x04, 0x9b,
+	0x96, 0x66, 0xe8, 0x5c, 0x25, 0x4a, 0xc0, 0xc0, 0x16, 0x7b, 0x43, 0x85, 0xc7, 0x25, 0xe7, 0x51,
+	0xfc, 0x3a, 0xbc, 0x1d, 0xcc, 0xb8, 0x1f, 0x56, 0x5d, 0x87, 0x23, 0x0d, 0xc2, 0xb3, 0xeb, 0xcb,
+	0xab, 0x58, 0x53, 0x4b, 0xb6, 0x63, 0xd7, 0x11, 0x80, 0x82, 0xad, 0xe4, 0x4b, 0xf4, 0x25, 0x37,
+	0x6d, 0xa8, 0x60, 0x2b, 0x8c, 0x4a, 0xfc, 0x10, 0x52, 0x61, 0xfc, 0xfb, 0x60, 0x9e, 0xdf, 0x2b,
+	0x3d, 0x8d, 0x5e, 0x51, 0xb9, 0xe1, 0xb7, 0x34, 0x5d, 0x2e, 0x6b, 0xf6, 0xf6, 0x9a, 0x69, 0x6c,
+	0x69, 0x45, 0x6c, 0x3a, 0x31, 0x44, 0xb7, 0x00, 0xbc, 0xd4, 0xe3, 0xba, 0x8f, 0xa7, 0x79, 0x6e,
+	0x93, 0x3c, 0x4d, 0xb3, 0x62, 0xe2, 0x79, 0x9a, 0x5e, 0x93, 0x55, 0xcc, 0x79, 0x25, 0x1f, 0xa7,
+	0xf8, 0x42, 0xe0, 0x10, 0x03, 0x34, 0x71, 0x88, 0x1f, 0x00, 0xda, 0xe0, 0x8b, 0xa4, 0x84, 0xd8,
+	0x6a, 0x42, 0x38, 0x1a, 0x3b, 0x39, 0xbc, 0x90, 0x09, 0x81, 0xdb, 0x2c, 0xcd, 0x11, 0x26, 0x4d,
+	0x6c, 0x34, 0xeb, 0x41, 0xb7, 0x1b, 0xa0, 0xf4, 0x50, 0x28, 0x27, 0x76, 0x85, 0xc2, 0xe5, 0xf9,
+	0xb1, 0x2c, 0xf1, 0x54, 0x6a, 0x55, 0xce, 0x7c, 0x36, 0x0b, 0xf1, 0x8d, 0x6a, 0x9e, 0xc4, 0xbb,
+	0xba, 0x99, 0x2f, 0xe1, 0x3a, 0x75, 0xdb, 0x90, 0x04, 0x1b, 0xd5, 0xac, 0xad, 0xac, 0x6d, 0xae,
+	0xe2, 0xba, 0xb8, 0x13, 0xe2, 0x77, 0xd7, 0x19, 0xdf, 0x80, 0x89, 0x16, 0x67, 0x70, 0xf7, 0x77,
+	0xec, 0x8b, 0xf1, 0x66, 0x5f, 0x88, 0x3f, 0x16, 0x20, 0x49, 0xf5, 0x67, 0xd7, 0x97, 0x57, 0x70,
+	0x19, 0xab, 0x6c, 0x1f, 0x73, 0x00, 0x64, 0xa1, 0xdf, 0xb2, 0x65, 0xbb, 0xc6, 0x92, 0x6d, 0x74,
+	0xe1, 0x74, 0x88, 0xc6, 0x06, 0xee, 0x1c, 0xe5, 0x90, 0x38, 0x67, 0x53, 0xe2, 0xf4, 0x74, 0x9d,
+	0x38, 0xbf, 0x12, 0x78, 0xc5, 0x37, 0x9b, 0xca, 0x1d, 0xf5, 0x18, 0xc6, 0x88, 0xa7, 0x8b, 0xde,
+	0x12, 0x4f, 0x99, 0xb3, 0xed, 0x18, 0xed, 0xfa, 0x68, 0xb4, 0x60, 0x2b, 0x3e, 0xf1, 0xfb, 0x97,
+	0x2c, 0x5f, 0x08, 0x70, 0x22, 0xc0, 0xfe, 0xec, 0x76, 0xce, 0x96, 0x37, 0xb1, 0x99, 0x5d, 0x5f,
+	0x5e, 0xbb, 0xeb, 0xf8, 0xfd, 0x14, 0x4c, 0x58, 0xf4, 0x6d, 0x6b, 0xf2, 0x8c, 0xb2, 0x05, 0x27,
+	0x81, 0x7c, 0x21, 0xea, 0xd9, 0xa7, 0x10, 0xc5, 0xba, 0x0e, 0xd1, 0x6f, 0x05, 0x38, 0xb9, 0x3b,
+	0xc4, 0x2f, 0x49, 0xbc, 0xfe, 0x21, 0xc0, 0xf1, 0x08, 0x30, 0x4b, 0xc5, 0xa2, 0x5b, 0xe7, 0x97,
+	0x61, 0x98, 0x87, 0x4b, 0x2e, 0x16, 0x59, 0x75, 0x0e, 0x65, 0x13, 0x2f, 0x9f, 0xcf, 0x4d, 0x72,
+	0xbd, 0x84, 0x18, 0x5b, 0x56, 0xce, 0x36, 0x35, 0x5d, 0x95, 0xc0, 0x72, 0x25, 0xfc, 0x5f, 0x85,
+	0xef, 0x45, 0x74, 0x86, 0x32, 0xc4, 0x5f, 0x92, 0xe8, 0x7d, 0xdf, 0xc1, 0xd2, 0xbc, 0x19, 0x06,
+	0xec, 0x72, 0xbb, 0x6f, 0xd3, 0xfb, 0xb6, 0x89, 0xfd, 0xc5, 0xa9, 0x90, 0x48, 0xb3, 0xb8, 0x8f,
+	0x4d, 0x78, 0xcb, 0xe7, 0x63, 0xc3, 0x0c, 0xf0, 0xf6, 0x57, 0x76, 0xf5, 0xb6, 0x11, 0x24, 0x5a,
+	0x3a, 0xec, 0xf9, 0xbd, 0x81, 0x60, 0xff, 0x02, 0xf0, 0x03, 0x01, 0xe6, 0x02, 0x91, 0x2e, 0x1b,
+	0x95, 0x8a, 0x66, 0x91, 0xa6, 0x63, 0x55, 0xb3, 0x6c, 0xc3, 0xdc, 0xfe, 0x1f, 0x84, 0xe1, 0x3b,
+	0x3d, 0x90, 0x6e, 0xd7, 0x38, 0x1e, 0x8c, 0xdb, 0x30, 0x50, 0x62, 0xaf, 0xb8, 0xeb, 0x4f, 0x84,
+	0xb8, 0xde, 0x13, 0xb1, 0x5c, 0x92, 0x75, 0x15, 0xf3, 0x16, 0xcc, 0xe1, 0x46, 0x4b, 0x30, 0x50,
+	0xc5, 0x7a, 0x51, 0xd3, 0x55, 0xd7, 0xbd, 0xed, 0x09, 0x92, 0x1c, 0xbe, 0xa6, 0x20, 0xc5, 0xba,
+	0x0f, 0xd2, 0x36, 0xc4, 0x69, 0x6d, 0x2f, 0xcb, 0x55, 0x59, 0xd1, 0xec, 0x6d, 0xd2, 0x9e, 0xd2,
+	0xcd, 0xa9, 0x98, 0xb7, 0x64, 0xd6, 0x9e, 0xf6, 0x4a, 0x43, 0xec, 0x4d, 0x4e, 0xb6, 0xd1, 0xbb,
+	0x30, 0x5a, 0x91, 0xeb, 0x79, 0x1f, 0x49, 0x0f, 0x25, 0x19, 0xa9, 0xc8, 0xf5, 0x9c, 0x4b, 0xf5,
+	0x0e, 0xc4, 0x4d, 0x5c, 0x91, 0x35, 0x5d, 0xd3, 0x55, 0x4a, 0x14, 0x63, 0x44, 0xee, 0xcb, 0x9c,
+	0x6c, 0x8b, 0xd3, 0xf0, 0x16, 0x8d, 0x40, 0x83, 0x7e, 0xe7, 0x83, 0xa1, 0xc8, 0xbb, 0x92, 0xa6,
+	0x45, 0x1e, 0x8a, 0x5b, 0x30, 0xa8, 0xf0, 0x77, 0xbc, 0x13, 0x7a, 0x37, 0xc4, 0x85, 0x0d, 0xfc,
+	0x3c, 0x10, 0x2e, 0xaf, 0xf8, 0x4b, 0x01, 0x8e, 0x34, 0x27, 0x40, 0xa3, 0x3b, 0xda, 0x4a, 0x49,
+	0xcf, 0x98, 0x9e, 0xee, 0x8d, 0x41, 0x19, 0x38, 0x28, 0x2b, 0x0a, 0xae, 0xda, 0x56, 0x43, 0x99,
+	0x13, 0xd7, 0x0d, 0x4a, 0x88, 0x2f, 0xf9, 0x2a, 0x55, 0xb4, 0xe1, 0x74, 0x70, 0x1f, 0x1d, 0xe4,
+	0xd1, 0x7d, 0x6b, 0xdf, 0x9f, 0xf7, 0xc0, 0x99, 0xb6, 0xd4, 0xf2, 0x58, 0x69, 0x11, 0xbd, 0xfc,
+	0x62, 0x9b, 0xfd, 0x6b, 0x90, 0xe3, 0x02, 0xda, 0xfa, 0x47, 0x30, 0x6a, 0x1b, 0xb6, 0x5c, 0xce,
+	0xef, 0x21, 0x1e, 0x71, 0x2a, 0xc1, 0x8d, 0xff, 0xbe, 0x15, 0xda, 0x03, 0x38, 0x15, 0xe8, 0xb5,
+	0xc0, 0x58, 0xb5, 0xf1, 0xd9, 0xf0, 0x57, 0x21, 0x24, 0xfa, 0xc1, 0x51, 0x50, 0xc3, 0x3f, 0x22,
+	0xf6, 0x12, 0x84, 0x96, 0xef, 0x89, 0xff, 0x42, 0x0c, 0xc4, 0xf7, 0xf9, 0x46, 0xd1, 0xd4, 0x40,
+	0x30, 0x57, 0xcd, 0xc1, 0x41, 0x2e, 0x2d, 0x6f, 0xd7, 0xf3, 0x25, 0xd9, 0x2a, 0xf9, 0x1c, 0x36,
+	0xce, 0x97, 0xd6, 0xeb, 0xab, 0xb2, 0x55, 0x22, 0x6e, 0x7b, 0x1a, 0xf4, 0xb5, 0xe3, 0x7a, 0x29,
+	0x07, 0xa3, 0x8d, 0x3d, 0x0d, 0x77, 0x51, 0x67, 0x2d, 0x4d, 0xbc, 0xa1, 0xa5, 0x11, 0x8b, 0x30,
+	0x4b, 0x55, 0x3e, 0x91, 0xcb, 0x5a, 0x51, 0xb6, 0x71, 0x20, 0x8c, 0xf7, 0x20, 0x56, 0xb1, 0x54,
+	0xae, 0x6e, 0x2e, 0x44, 0xdd, 0x7d, 0x4b, 0x5d, 0x36, 0x71, 0x8b, 0x08, 0xc2, 0x29, 0x7e, 0x22,
+	0x80, 0x18, 0xa5, 0x86, 0x23, 0x9c, 0x84, 0xbe, 0x2d, 0x42, 0x40, 0x35, 0x0d, 0x4a, 0xec, 0x01,
+	0x7d, 0x15, 0x06, 0x37, 0x64, 0xad, 0x5c, 0x33, 0x31, 0xe9, 0x42, 0x49, 0x65, 0x5e, 0x68, 0x07,
+	0x31, 0x57, 0xa5, 0x19, 0xfa, 0x2d, 0xc6, 0xed, 0xec, 0x69, 0x8e, 0x30, 0xf1, 0x23, 0x01, 0x52,
+	0xd1, 0x2c, 0x08, 0x41, 0xaf, 0x59, 0x2b, 0x63, 0x1e, 0x31, 0xfa, 0x1b, 0xbd, 0x0d, 0x43, 0x8a,
+	0x51, 0xc4, 0x56, 0x55, 0x56, 0x30, 0xcd, 0x9f, 0x21, 0xc9, 0x7b, 0x41, 0x38, 0xc8, 0x03, 0xad,
+	0xc6, 0xb8, 0x44, 0x7f, 0xa3, 0x04, 0x0c, 0x54, 0xb0, 0x65, 0xc9, 0x2a, 0x4e, 0xf4, 0x52, 0x7a,
+	0xe7, 0x51, 0x7c, 0x04, 0x47, 0xa9, 0x5f, 0x96, 0xca, 0x65, 0xe3, 0x43, 0x5c, 0xcc, 0xf9, 0x33,
+	0xa2, 0xcb, 0x24, 0xda, 0xe4, 0x11, 0x0d, 0x16, 0xc9, 0x3d, 0x9d, 0x80, 0x01, 0x99, 0xad, 0x73,
+	0x5f, 0x3b, 0x8f, 0xe8, 0x2c, 0x20, 0xfa, 0x33, 0x5f, 0xd6, 0x2c, 0x3b, 0x8f, 0x75, 0xb9, 0x50,
+	0xc6, 0x45, 0x0a, 0x73, 0x50, 0x1a, 0xa7, 0x2b, 0xf7, 0x34, 0xcb, 0xbe, 0xc9, 0xde, 0x8b, 0x65,
+	0x1e, 0xd7, 0x20, 0x65, 0x78, 0xdf, 0x87, 0x33, 0x3f, 0x14, 0xe0, 0x9d, 0x48, 0x75, 0x1c, 0xdd,
+	0x3c, 0x1c, 0x6a, 0xf2, 0x18, 0xb6, 0xb8, 0xcf, 0x62, 0x27, 0x87, 0x24, 0x64, 0x35, 0xf2, 0x91,
+	0x73, 0x72, 0xdf, 0x1a, 0xcb, 0xef, 0x0e, 0xc2, 0xa1, 0xe0, 0xec, 0xde, 0xc3, 0x67, 0xd8, 0x43,
+	0xe8, 0x67, 0xfb, 0x2d, 0xb5, 0x6c, 0x24, 0x7b, 0xe9, 0xf3, 0x57, 0x33, 0x8b, 0xaa, 0x66, 0x97,
+	0x6a, 0x85, 0xb4, 0x62, 0x54, 0x32, 0xbc, 0x1c, 0xca, 0x72, 0xc1, 0x9a, 0xd3, 0x0c, 0xe7, 0x31,
+	0x63, 0x6f, 0x57, 0xb1, 0x95, 0xce, 0xde, 0x59, 0x3b, 0xbf, 0x78, 0x6e, 0xad, 0x56, 0xb8, 0x8b,
+	0xb7, 0xa5, 0xbe, 0x02, 0xd9, 0xa3, 0xd1, 0x37, 0x61, 0xd4, 0xdb, 0xc3, 0x49, 0xa4, 0x13, 0xb1,
+	0xa3, 0xb1, 0x3d, 0x09, 0x1e, 0xe6, 0xdb, 0x3f, 0xc9, 0x0e, 0x34, 0x0b, 0x23, 0x6e, 0x00, 0xb4,
+	0x0a, 0xcb, 0xfa, 0xb8, 0x34, 0xec, 0xf8, 0x5d, 0xab, 0x60, 0x4e, 0x62, 0xda, 0xce, 0xac, 0xb1,
+	0xcf, 0x25, 0x31, 0x6d, 0x36, 0x53, 0x24, 0xdd, 0x1e, 0xd6, 0x8b, 0x0e, 0x41, 0x3f, 0x1b, 0x46,
+	0x62, 0xbd, 0xc8, 0x97, 0xa7, 0x61, 0x88, 0x6d, 0xe6, 0xa4, 0x87, 0x1b, 0xa0, 0x3d, 0xdc, 0x20,
+	0x7d, 0xc1, 0x5b, 0x41, 0x7f, 0x0a, 0xe0, 0x7a, 0x62, 0x90, 0xd6, 0xcb, 0x88, 0x17, 0x7b, 0x5c,
+	0x47, 0xc7, 0x61, 0xcc, 0x2a, 0xcb, 0x56, 0xc9, 0x47, 0x36, 0x44, 0xc9, 0xe2, 0xce, 0x6b, 0x46,
+	0x77, 0x01, 0x0e, 0x7b, 0x9f, 0x39, 0x74, 0x29, 0x6f, 0x69, 0x2a, 0xa5, 0x07, 0x4a, 0x3f, 0xe9,
+	0x2e, 0xe7, 0xc8, 0x6a, 0x4e, 0x53, 0x09, 0xdb, 0x63, 0x88, 0x2b, 0xc6, 0x16, 0xd6, 0x65, 0xdd,
+	0x26, 0xf4, 0x56, 0x62, 0x98, 0x6e, 0x5f, 0xe7, 0x42, 0x3b, 0x6a, 0x46, 0xbb, 0x54, 0x94, 0xab,
+	0x44, 0x92, 0xa6, 0xea, 0xb2, 0x4d, 0x76, 0x2a, 0x69, 0xc4, 0x11, 0x93, 0xd3, 0x54, 0x8b, 0x94,
+	0xa8, 0x83, 0xcd, 0xa8, 0xd9, 0xd5, 0x9a, 0x9d, 0xd7, 0x8a, 0xf5, 0xc4, 0x08, 0xf5, 0x8f, 0xb3,
+	0x1f, 0x3c, 0xa4, 0x0b, 0x77, 0x8a, 0x75, 0x34, 0x05, 0xfd, 0xb2, 0x62, 0x6b, 0x5b, 0x38, 0x11,
+	0xa7, 0x45, 0xcc, 0x9f, 0xd0, 0x0c, 0x4d, 0x47, 0xbb, 0x46, 0x1a, 0x3a, 0x4b, 0x49, 0x8c, 0xb2,
+	0x43, 0x9c, 0xbd, 0x5a, 0xc1, 0x96, 0x82, 0x8e, 0xc1, 0x68, 0x4d, 0x2f, 0x18, 0xb4, 0xa7, 0x67,
+	0x61, 0x1c, 0xa3, 0x2a, 0xe2, 0xee, 0x5b, 0x1a, 0x48, 0x05, 0x0e, 0xd5, 0x74, 0xef, 0x50, 0xca,
+	0x9b, 0x3c, 0xdf, 0x13, 0xe3, 0xb4, 0x88, 0xd2, 0xe1, 0x7b, 0xf5, 0x63, 0x1f, 0x9b, 0x5b, 0x4b,
+	0x93, 0xb5, 0x80, 0xb7, 0xc4, 0x16, 0x36, 0x23, 0xce, 0x3b, 0x03, 0xe2, 0x09, 0x66, 0x0b, 0x7b,
+	0xcb, 0x87, 0xe4, 0x28, 0x03, 0x93, 0x55, 0x13, 0x6f, 0xe5, 0x9b, 0xaa, 0x3f, 0x81, 0x28, 0xb8,
+	0x09, 0xb2, 0xd6, 0xb0, 0x67, 0xa0, 0x0f, 0x20, 0xe1, 0x46, 0xc8, 0x36, 0x65, 0xdd, 0xd2, 0x28,
+	0x06, 0x1a, 0xac, 0x83, 0x34, 0x58, 0xa1, 0xad, 0x81, 0x13, 0x9d, 0x3b, 0xfa, 0x86, 0x21, 0x4d,
+	0x39, 0x52, 0xd6, 0x5d, 0x21, 0x24, 0x54, 0xe2, 0x7d, 0x48, 0xb9, 0xdf, 0xb1, 0x8f, 0x1d, 0xb7,
+	0x51, 0x0e, 0x07, 0xd9, 0x19, 0x40, 0x16, 0xf9, 0x70, 0x62, 0x5f, 0x2d, 0x4e, 0x16, 0xb2, 0xcd,
+	0x7d, 0x8c, 0xae, 0xd0, 0x26, 0x84, 0xe6, 0xa1, 0xf8, 0xef, 0x18, 0x1c, 0x0e, 0x71, 0x1c, 0x3a,
+	0x09, 0xe3, 0xbe, 0x70, 0xf9, 0xc5, 0x78, 0x61, 0x64, 0xd9, 0xac, 0xc0, 0xb4, 0x0b, 0xda, 0x63,
+	0x21, 0x09, 0x4d, 0x77, 0x82, 0x9e, 0x0e, 0x70, 0xbb, 0xde, 0x73, 0xc1, 0xe5, 0x34, 0x95, 0x6e,
+	0x01, 0x01, 0xa5, 0x15, 0x0b, 0x2a, 0xad, 0xab, 0x90, 0x6c, 0x2a, 0x2d, 0xc7, 0x18, 0xc2, 0xc2,
+	0x8e, 0xcb, 0xc3, 0x8d, 0xd5, 0xc5, 0xb4, 0x10, 0xe6, 0x0d, 0x98, 0xf2, 0x0a, 0xcc, 0xc7, 0x6b,
+	0x25, 0xfa, 0xba, 0xac, 0xb4, 0x49, 0xb7, 0xd2, 0x3c, 0x4d, 0x16, 0xfa, 0x48, 0x80, 0x59, 0xcf,
+	0x4a, 0xcf, 0x67, 0x9a, 0xbe, 0x61, 0x78, 0x09, 0xdf, 0x4f, 0x13, 0x3e, 0xac, 0x39, 0x89, 0xce,
+	0x03, 0x29, 0x55, 0x8c, 0x5c, 0x17, 0x15, 0x98, 0xd9, 0x65, 0x6a, 0x82, 0x6e, 0x40, 0x6f, 0x11,
+	0x97, 0xbb, 0x9b, 0x74, 0x51, 0x4e, 0xf1, 0x17, 0x7d, 0x90, 0x08, 0x1d, 0xf5, 0xdf, 0x84, 0x61,
+	0xb2, 0x53, 0x98, 0x5a, 0xd5, 0x77, 0x8c, 0xbf, 0xe3, 0x9c, 0x91, 0x9e, 0x06, 0x76, 0x40, 0xae,
+	0x78, 0xa4, 0x92, 0x9f, 0x0f, 0xdd, 0x07, 0x50, 0xdc, 0xd1, 0x01, 0xeb, 0x9f, 0xb2, 0x73, 0x9f,
+	0xbf, 0x9a, 0x99, 0x66, 0x82, 0xac, 0xe2, 0x66, 0x5a, 0x33, 0x32, 0x15, 0xd9, 0x2e, 0xa5, 0xef,
+	0x61, 0x55, 0x56, 0xb6, 0x57, 0xb0, 0xf2, 0xf2, 0xf9, 0x1c, 0x70, 0x3d, 0x2b, 0x58, 0x91, 0x7c,
+	0x02, 0xd0, 0x59, 0xe8, 0xa5, 0xc7, 0x69, 0x6c, 0x97, 0xe3, 0x94, 0x52, 0xf9, 0x0e, 0xd2, 0xde,
+	0xfd, 0x39, 0x48, 0xaf, 0x41, 0xac, 0x6a, 0x54, 0xe9, 0xe9, 0x35, 0xbc, 0x70, 0x26, 0xec, 0xb2,
+	0xcb, 0x34, 0x8c, 0x8d, 0x87, 0x1b, 0x6b, 0x86, 0x65, 0x61, 0x6a, 0x75, 0x76, 0x7d, 0x59, 0x22,
+	0x7c, 0x68, 0x11, 0xa6, 0x68, 0xde, 0xe2, 0x62, 0x9e, 0xb3, 0xfa, 0x8f, 0xbb, 0x5e, 0x69, 0x92,
+	0xaf, 0x66, 0xd9, 0x22, 0x3f, 0xf9, 0xc8, 0x01, 0xe0, 0x70, 0x79, 0xb7, 0x75, 0x03, 0xfc, 0x00,
+	0xe0, 0x1c, 0xce, 0xa5, 0x1d, 0x39, 0x00, 0x38, 0xc5, 0x20, 0x95, 0xc9, 0x9f, 0xc8, 0xfb, 0x6f,
+	0xc9, 0x1a, 0xe9, 0xee, 0x86, 0xd8, 0xc1, 0xc0, 0x9e, 0xd0, 0x39, 0x98, 0x2c, 0x69, 0x6a, 0x09,
+	0x5b, 0x76, 0x7e, 0xcb, 0xb0, 0xb1, 0x7b, 0x00, 0x03, 0x95, 0x8f, 0xf8, 0xda, 0x13, 0xb2, 0xc4,
+	0x35, 0x2c, 0xc2, 0x94, 0x89, 0x6d, 0xcd, 0x6c, 0x45, 0x31, 0xcc, 0x50, 0xf0, 0xd5, 0x46, 0x14,
+	0x0f, 0x60, 0xcc, 0x8b, 0x23, 0x2d, 0x25, 0x7a, 0x86, 0x0d, 0x2f, 0x1c, 0xdb, 0x75, 0xe2, 0x44,
+	0x2b, 0x63, 0x54, 0x69, 0x78, 0x5e, 0xf8, 0xdd, 0x34, 0xf4, 0xd1, 0xee, 0x10, 0x7d, 0x2c, 0x40,
+	0x3f, 0xbb, 0x60, 0x44, 0xa7, 0x42, 0x64, 0xb5, 0x5e, 0x03, 0x27, 0x4f, 0xb7, 0x43, 0xca, 0xab,
+	0xf1, 0xd8, 0xb7, 0x7f, 0xff, 0xa7, 0x4f, 0x7a, 0x66, 0xd0, 0x91, 0x4c, 0xd4, 0xf5, 0x36, 0xfa,
+	0x89, 0x00, 0x63, 0x4d, 0x17, 0xb9, 0x68, 0x61, 0x77, 0x35, 0xcd, 0xd7, 0xc5, 0xc9, 0xf3, 0x1d,
+	0xf1, 0x70, 0x1b, 0x33, 0xd4, 0xc6, 0x53, 0xe8, 0x44, 0xa4, 0x8d, 0x99, 0x67, 0xfc, 0x44, 0xdd,
+	0x41, 0xbf, 0x16, 0x60, 0xa2, 0xe5, 0x66, 0x17, 0x2d, 0xb6, 0xa3, 0xbb, 0xf9, 0x22, 0x39, 0x79,
+	0xa1, 0x43, 0x2e, 0x6e, 0xf3, 0x35, 0x6a, 0xf3, 0x45, 0x74, 0x21, 0xda, 0x66, 0x2f, 0xeb, 0x33,
+	0xcf, 0xbc, 0xdf, 0x3b, 0xe8, 0x67, 0x02, 0x4c, 0xb4, 0x4c, 0x7e, 0xa2, 0x11, 0x84, 0xdd, 0x28,
+	0x47, 0x23, 0x08, 0xbd, 0x1d, 0x16, 0xe7, 0x29, 0x82, 0x33, 0xe8, 0x54, 0x08, 0x82, 0xd6, 0x71,
+	0x13, 0x7a, 0x29, 0xc0, 0x78, 0xb3, 0x40, 0x74, 0xbe, 0x13, 0xf5, 0x8e, 0xcd, 0x8b, 0x9d, 0x31,
+	0x71, 0x93, 0x73, 0xd4, 0xe4, 0xfb, 0xe8, 0x6e, 0xdb, 0x26, 0x67, 0x9e, 0x35, 0x4c, 0x80, 0x76,
+	0x5a, 0x49, 0xd0, 0xc7, 0x3d, 0x30, 0xbb, 0xeb, 0xf8, 0x1a, 0xad, 0x74, 0x62, 0x70, 0xd8, 0x68,
+	0x3e, 0x79, 0x73, 0x8f, 0x52, 0xb8, 0x1f, 0xd6, 0xa9, 0x1f, 0x1e, 0xa0, 0x7b, 0xdd, 0xfb, 0xc1,
+	0xb7, 0xa1, 0x39, 0x03, 0xf5, 0x1f, 0x09, 0xcd, 0x53, 0xec, 0x73, 0x51, 0xe6, 0x06, 0x8d, 0xdc,
+	0x92, 0xf3, 0x1d, 0x70, 0x70, 0x30, 0x73, 0x14, 0xcc, 0x09, 0x74, 0x2c, 0x04, 0x0c, 0x6b, 0x37,
+	0xdd, 0xf9, 0xee, 0xdf, 0x04, 0x48, 0x45, 0xcf, 0x4c, 0xd1, 0x52, 0x47, 0x05, 0x11, 0x88, 0x23,
+	0xbb, 0x17, 0x11, 0x1c, 0xd8, 0x0d, 0x0a, 0xec, 0x0a, 0xba, 0xd4, 0x7e, 0x94, 0x9a, 0xb0, 0xfe,
+	0x6b, 0xd7, 0xc1, 0xfa, 0x8d, 0x4e, 0xec, 0x0c, 0x44, 0xba, 0xb4, 0x07, 0x09, 0x1c, 0xe8, 0x1a,
+	0x05, 0xfa, 0x3e, 0x5a, 0xed, 0x3e, 0x1d, 0x9b, 0x80, 0xff, 0x54, 0x80, 0xd1, 0xc6, 0xcb, 0x53,
+	0x14, 0x99, 0x59, 0x81, 0xff, 0xba, 0x48, 0x2e, 0x74, 0xc2, 0xc2, 0xb1, 0x5c, 0xa4, 0x58, 0xe6,
+	0x51, 0x26, 0x13, 0xfa, 0x57, 0x2f, 0xff, 0xbd, 0x42, 0xe6, 0x19, 0xfb, 0x0e, 0xdd, 0x41, 0x7f,
+	0x17, 0x60, 0x3a, 0xe2, 0x32, 0x12, 0x5d, 0xef, 0xc4, 0xcf, 0x01, 0x60, 0xde, 0xeb, 0x9a, 0x9f,
+	0x23, 0xbb, 0x4f, 0x91, 0xdd, 0x46, 0x37, 0xbb, 0x8f, 0x92, 0x0f, 0x38, 0xfa, 0xa7, 0x00, 0xd3,
+	0x11, 0x7f, 0x4f, 0x88, 0xc6, 0xbb, 0xfb, 0x5f, 0x37, 0xa2, 0xf1, 0xb6, 0xf1, 0xbf, 0x08, 0xf1,
+	0x11, 0xc5, 0x7b, 0x17, 0xdd, 0x89, 0xda, 0x57, 0x9c, 0x3f, 0x86, 0xb0, 0x40, 0x36, 0xfe, 0x51,
+	0x64, 0xa7, 0x39, 0xd8, 0xe8, 0xcf, 0x02, 0x24, 0xc3, 0xef, 0xf4, 0xd1, 0xb5, 0xce, 0x4d, 0xf6,
+	0xfd, 0xfb, 0x21, 0x79, 0xbd, 0x5b, 0x76, 0x0e, 0xf8, 0x0e, 0x05, 0xbc, 0x8c, 0x96, 0xa2, 0x01,
+	0x93, 0xcf, 0x0b, 0x0f, 0x2e, 0x79, 0x6a, 0x05, 0xfa, 0x73, 0x01, 0xe2, 0x0d, 0x1a, 0xa3, 0x8f,
+	0x82, 0xa0, 0x59, 0x7c, 0x72, 0xbe, 0x03, 0x0e, 0x8e, 0x60, 0x99, 0x22, 0xb8, 0x86, 0xae, 0xb6,
+	0x55, 0x7c, 0x0c, 0x43, 0xd3, 0xb4, 0x79, 0x07, 0xfd, 0x46, 0x80, 0x43, 0x81, 0xd3, 0x7b, 0x74,
+	0x29, 0xca, 0xa2, 0xa8, 0x7b, 0x85, 0xe4, 0xe5, 0x2e, 0x38, 0x39, 0xa6, 0xcb, 0x14, 0xd3, 0x79,
+	0x31, 0x1d, 0x82, 0x69, 0x8b, 0x73, 0xe7, 0x1b, 0xc1, 0x5d, 0x11, 0x4e, 0xa3, 0x3f, 0x0a, 0x30,
+	0x19, 0x34, 0x40, 0x46, 0x17, 0xa3, 0xcc, 0x89, 0x98, 0xd0, 0x27, 0x2f, 0x75, 0xce, 0xc8, 0x61,
+	0x3c, 0xa0, 0x30, 0x56, 0xd1, 0xad, 0x10, 0x18, 0x7c, 0x2a, 0x9f, 0x6f, 0x19, 0x67, 0x87, 0x44,
+	0xe9, 0x85, 0x00, 0x53, 0xc1, 0xc3, 0x71, 0x74, 0xb9, 0x53, 0x23, 0xdd, 0xf9, 0x7d, 0xf2, 0x4a,
+	0x37, 0xac, 0x1c, 0xe1, 0x25, 0x8a, 0x70, 0x01, 0x9d, 0xeb, 0x14, 0x61, 0xf6, 0xc1, 0xa7, 0xaf,
+	0x53, 0xc2, 0x67, 0xaf, 0x53, 0xc2, 0x17, 0xaf, 0x53, 0xc2, 0xf7, 0xde, 0xa4, 0x0e, 0x7c, 0xf6,
+	0x26, 0x75, 0xe0, 0x0f, 0x6f, 0x52, 0x07, 0xbe, 0xd6, 0xc6, 0x17, 0x7b, 0xdd, 0xaf, 0x86, 0x7e,
+	0xbe, 0x17, 0xfa, 0xe9, 0x7f, 0x80, 0xcf, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x4f, 0xbc,
+	0x94, 0x6d, 0x2d, 0x00, 0x00,
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
+	// ParamsByBTCHeight queries the parameters of the module for a specific BTC height
+	ParamsByBTCHeight(ctx context.Context, in *QueryParamsByBTCHeightRequest, opts ...grpc.CallOption) (*QueryParamsByBTCHeightResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
+	// FinalityProviderCommissionHistory queries the commission rate changes of
+	// a finality provider
+	FinalityProviderCommissionHistory(ctx context.Context, in *QueryFinalityProviderCommissionHistoryRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCommissionHistoryResponse, error)
+	// StakeCapacity queries the satoshis staked in all active BTC delegations
+	// and the remaining capacity under the total stake cap
+	StakeCapacity(ctx context.Context, in *QueryStakeCapacityRequest, opts ...grpc.CallOption) (*QueryStakeCapacityResponse, error)
+	// FinalityProvidersStakeCapacity queries the satoshis staked to each
+	// finality provider and its remaining capacity under the per finality
+	// provider stake cap
+	FinalityProvidersStakeCapacity(ctx context.Context, in *QueryFinalityProvidersStakeCapacityRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersStakeCapacityResponse, error)
+	// FinalityProviderStakeCapacity queries the satoshis staked to a finality
+	// provider and its remaining capacity under the per finality provider
+	// stake cap
+	FinalityProviderStakeCapacity(ctx context.Context, in *QueryFinalityProviderStakeCapacityRequest, opts ...grpc.CallOption) (*QueryFinalityProviderStakeCapacityResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegationsByStakerBTCPK queries all BTC delegations of the given
+	// staker BTC PK under a given status
+	BTCDelegationsByStakerBTCPK(ctx context.Context, in *QueryBTCDelegationsByStakerBTCPKRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByStakerBTCPKResponse, error)
+	// BTCDelegationsByStakerAddr queries all BTC delegations of the given
+	// staker address under a given status
+	BTCDelegationsByStakerAddr(ctx context.Context, in *QueryBTCDelegationsByStakerAddrRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByStakerAddrResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+	// ValidateBTCDelegation runs the checks of MsgCreateBTCDelegation against
+	// the current state without creating the BTC delegation, and returns every
+	// failing rule
+	ValidateBTCDelegation(ctx context.Context, in *QueryValidateBTCDelegationRequest, opts ...grpc.CallOption) (*QueryValidateBTCDelegationResponse, error)
+	// AllowedStakingTxHash checks whether a staking tx hash is in the allow list
+	AllowedStakingTxHash(ctx context.Context, in *QueryAllowedStakingTxHashRequest, opts ...grpc.CallOption) (*QueryAllowedStakingTxHashResponse, error)
+	// AllowedStakingTxHashes queries all staking tx hashes in the allow list
+	AllowedStakingTxHashes(ctx context.Context, in *QueryAllowedStakingTxHashesRequest, opts ...grpc.CallOption) (*QueryAllowedStakingTxHashesResponse, error)
 }
 
-func (m *QueryParamsByVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsByVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
+	out := new(QueryParamsByVersionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsByBTCHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ParamsByBTCHeight(ctx context.Context, in *QueryParamsByBTCHeightRequest, opts ...grpc.CallOption) (*QueryParamsByBTCHeightResponse, error) {
+	out := new(QueryParamsByBTCHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByBTCHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BtcHeight))
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
+	out := new(QueryFinalityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsByBTCHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
+	out := new(QueryFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderCommissionHistory(ctx context.Context, in *QueryFinalityProviderCommissionHistoryRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCommissionHistoryResponse, error) {
+	out := new(QueryFinalityProviderCommissionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderCommissionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) StakeCapacity(ctx context.Context, in *QueryStakeCapacityRequest, opts ...grpc.CallOption) (*QueryStakeCapacityResponse, error) {
+	out := new(QueryStakeCapacityResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/StakeCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) FinalityProvidersStakeCapacity(ctx context.Context, in *QueryFinalityProvidersStakeCapacityRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersStakeCapacityResponse, error) {
+	out := new(QueryFinalityProvidersStakeCapacityResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvidersStakeCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FinalityProviderStakeCapacity(ctx context.Context, in *QueryFinalityProviderStakeCapacityRequest, opts ...grpc.CallOption) (*QueryFinalityProviderStakeCapacityResponse, error) {
+	out := new(QueryFinalityProviderStakeCapacityResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderStakeCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
+	out := new(QueryBTCDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
+	out := new(QueryFinalityProviderDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegationsByStakerBTCPK(ctx context.Context, in *QueryBTCDelegationsByStakerBTCPKRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByStakerBTCPKResponse, error) {
+	out := new(QueryBTCDelegationsByStakerBTCPKResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationsByStakerBTCPK", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BTCDelegationsByStakerAddr(ctx context.Context, in *QueryBTCDelegationsByStakerAddrRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsByStakerAddrResponse, error) {
+	out := new(QueryBTCDelegationsByStakerAddrResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegationsByStakerAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		l = m.FinalityProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
+	out := new(QueryBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ValidateBTCDelegation(ctx context.Context, in *QueryValidateBTCDelegationRequest, opts ...grpc.CallOption) (*QueryValidateBTCDelegationResponse, error) {
+	out := new(QueryValidateBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ValidateBTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
+	return out, nil
+}
+
+func (c *queryClient) AllowedStakingTxHash(ctx context.Context, in *QueryAllowedStakingTxHashRequest, opts ...grpc.CallOption) (*QueryAllowedStakingTxHashResponse, error) {
+	out := new(QueryAllowedStakingTxHashResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/AllowedStakingTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AllowedStakingTxHashes(ctx context.Context, in *QueryAllowedStakingTxHashesRequest, opts ...grpc.CallOption) (*QueryAllowedStakingTxHashesResponse, error) {
+	out := new(QueryAllowedStakingTxHashesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/AllowedStakingTxHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBTCDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version of past params.
+	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
+	// ParamsByBTCHeight queries the parameters of the module for a specific BTC height
+	ParamsByBTCHeight(context.Context, *QueryParamsByBTCHeightRequest) (*QueryParamsByBTCHeightResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
+	// FinalityProviderCommissionHistory queries the commission rate changes of
+	// a finality provider
+	FinalityProviderCommissionHistory(context.Context, *QueryFinalityProviderCommissionHistoryRequest) (*QueryFinalityProviderCommissionHistoryResponse, error)
+	// StakeCapacity queries the satoshis staked in all active BTC delegations
+	// and the remaining capacity under the total stake cap
+	StakeCapacity(context.Context, *QueryStakeCapacityRequest) (*QueryStakeCapacityResponse, error)
+	// FinalityProvidersStakeCapacity queries the satoshis staked to each
+	// finality provider and its remaining capacity under the per finality
+	// provider stake cap
+	FinalityProvidersStakeCapacity(context.Context, *QueryFinalityProvidersStakeCapacityRequest) (*QueryFinalityProvidersStakeCapacityResponse, error)
+	// FinalityProviderStakeCapacity queries the satoshis staked to a finality
+	// provider and its remaining capacity under the per finality provider
+	// stake cap
+	FinalityProviderStakeCapacity(context.Context, *QueryFinalityProviderStakeCapacityRequest) (*QueryFinalityProviderStakeCapacityResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
+	// BTCDelegationsByStakerBTCPK queries all BTC delegations of the given
+	// staker BTC PK under a given status
+	BTCDelegationsByStakerBTCPK(context.Context, *QueryBTCDelegationsByStakerBTCPKRequest) (*QueryBTCDelegationsByStakerBTCPKResponse, error)
+	// BTCDelegationsByStakerAddr queries all BTC delegations of the given
+	// staker address under a given status
+	BTCDelegationsByStakerAddr(context.Context, *QueryBTCDelegationsByStakerAddrRequest) (*QueryBTCDelegationsByStakerAddrResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+	// ValidateBTCDelegation runs the checks of MsgCreateBTCDelegation against
+	// the current state without creating the BTC delegation, and returns every
+	// failing rule
+	ValidateBTCDelegation(context.Context, *QueryValidateBTCDelegationRequest) (*QueryValidateBTCDelegationResponse, error)
+	// AllowedStakingTxHash checks whether a staking tx hash is in the allow list
+	AllowedStakingTxHash(context.Context, *QueryAllowedStakingTxHashRequest) (*QueryAllowedStakingTxHashResponse, error)
+	// AllowedStakingTxHashes queries all staking tx hashes in the allow list
+	AllowedStakingTxHashes(context.Context, *QueryAllowedStakingTxHashesRequest) (*QueryAllowedStakingTxHashesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
+}
+func (*UnimplementedQueryServer) ParamsByBTCHeight(ctx context.Context, req *QueryParamsByBTCHeightRequest) (*QueryParamsByBTCHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByBTCHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderCommissionHistory(ctx context.Context, req *QueryFinalityProviderCommissionHistoryRequest) (*QueryFinalityProviderCommissionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderCommissionHistory not implemented")
+}
+func (*UnimplementedQueryServer) StakeCapacity(ctx context.Context, req *QueryStakeCapacityRequest) (*QueryStakeCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakeCapacity not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProvidersStakeCapacity(ctx context.Context, req *QueryFinalityProvidersStakeCapacityRequest) (*QueryFinalityProvidersStakeCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvidersStakeCapacity not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderStakeCapacity(ctx context.Context, req *QueryFinalityProviderStakeCapacityRequest) (*QueryFinalityProviderStakeCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderStakeCapacity not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationsByStakerBTCPK(ctx context.Context, req *QueryBTCDelegationsByStakerBTCPKRequest) (*QueryBTCDelegationsByStakerBTCPKResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationsByStakerBTCPK not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegationsByStakerAddr(ctx context.Context, req *QueryBTCDelegationsByStakerAddrRequest) (*QueryBTCDelegationsByStakerAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegationsByStakerAddr not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+}
+func (*UnimplementedQueryServer) ValidateBTCDelegation(ctx context.Context, req *QueryValidateBTCDelegationRequest) (*QueryValidateBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateBTCDelegation not implemented")
+}
+func (*UnimplementedQueryServer) AllowedStakingTxHash(ctx context.Context, req *QueryAllowedStakingTxHashRequest) (*QueryAllowedStakingTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedStakingTxHash not implemented")
+}
+func (*UnimplementedQueryServer) AllowedStakingTxHashes(ctx context.Context, req *QueryAllowedStakingTxHashesRequest) (*QueryAllowedStakingTxHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedStakingTxHashes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.BtcDelegations) > 0 {
-		for _, e := range m.BtcDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByVersion(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ParamsByBTCHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByBTCHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for _, e := range m.BtcDelegatorDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByBTCHeight(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByBTCHeight",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByBTCHeight(ctx, req.(*QueryParamsByBTCHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBTCDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.StakingTxHashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviders(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		l = m.BtcDelegation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProvider(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FinalityProviderCommissionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderCommissionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.StakerAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderCommissionHistory(ctx, in)
 	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderCommissionHistory",
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderCommissionHistory(ctx, req.(*QueryFinalityProviderCommissionHistoryRequest))
 	}
-	if m.StakingTime != 0 {
-		n += 1 + sovQuery(uint64(m.StakingTime))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakeCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakeCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
+	if interceptor == nil {
+		return srv.(QueryServer).StakeCapacity(ctx, in)
 	}
-	if m.EndHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndHeight))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/StakeCapacity",
 	}
-	if m.TotalSat != 0 {
-		n += 1 + sovQuery(uint64(m.TotalSat))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakeCapacity(ctx, req.(*QueryStakeCapacityRequest))
 	}
-	l = len(m.StakingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProvidersStakeCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProvidersStakeCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProvidersStakeCapacity(ctx, in)
 	}
-	l = len(m.DelegatorSlashSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvidersStakeCapacity",
 	}
-	if len(m.CovenantSigs) > 0 {
-		for _, e := range m.CovenantSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProvidersStakeCapacity(ctx, req.(*QueryFinalityProvidersStakeCapacityRequest))
 	}
-	if m.StakingOutputIdx != 0 {
-		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviderStakeCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderStakeCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Active {
-		n += 2
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderStakeCapacity(ctx, in)
 	}
-	l = len(m.StatusDesc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderStakeCapacity",
 	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderStakeCapacity(ctx, req.(*QueryFinalityProviderStakeCapacityRequest))
 	}
-	if m.UndelegationResponse != nil {
-		l = m.UndelegationResponse.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ParamsVersion != 0 {
-		n += 2 + sovQuery(uint64(m.ParamsVersion))
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegations(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DelegatorUnbondingInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.SpendStakeTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BTCUndelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BTCDelegationsByStakerBTCPK_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsByStakerBTCPKRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.UnbondingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationsByStakerBTCPK(ctx, in)
 	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for _, e := range m.CovenantUnbondingSigList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationsByStakerBTCPK",
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationsByStakerBTCPK(ctx, req.(*QueryBTCDelegationsByStakerBTCPKRequest))
 	}
-	l = len(m.DelegatorSlashingSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegationsByStakerAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsByStakerAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for _, e := range m.CovenantSlashingSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegationsByStakerAddr(ctx, in)
 	}
-	if m.DelegatorUnbondingInfoResponse != nil {
-		l = m.DelegatorUnbondingInfoResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegationsByStakerAddr",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegationsByStakerAddr(ctx, req.(*QueryBTCDelegationsByStakerAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Dels) > 0 {
-		for _, e := range m.Dels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateBTCDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateBTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ValidateBTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateBTCDelegation(ctx, req.(*QueryValidateBTCDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedStakingTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedStakingTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedStakingTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/AllowedStakingTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedStakingTxHash(ctx, req.(*QueryAllowedStakingTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllowedStakingTxHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedStakingTxHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedStakingTxHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/AllowedStakingTxHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedStakingTxHashes(ctx, req.(*QueryAllowedStakingTxHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsByVersion",
+			Handler:    _Query_ParamsByVersion_Handler,
+		},
+		{
+			MethodName: "ParamsByBTCHeight",
+			Handler:    _Query_ParamsByBTCHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviders",
+			Handler:    _Query_FinalityProviders_Handler,
+		},
+		{
+			MethodName: "FinalityProvider",
+			Handler:    _Query_FinalityProvider_Handler,
+		},
+		{
+			MethodName: "FinalityProviderCommissionHistory",
+			Handler:    _Query_FinalityProviderCommissionHistory_Handler,
+		},
+		{
+			MethodName: "StakeCapacity",
+			Handler:    _Query_StakeCapacity_Handler,
+		},
+		{
+			MethodName: "FinalityProvidersStakeCapacity",
+			Handler:    _Query_FinalityProvidersStakeCapacity_Handler,
+		},
+		{
+			MethodName: "FinalityProviderStakeCapacity",
+			Handler:    _Query_FinalityProviderStakeCapacity_Handler,
+		},
+		{
+			MethodName: "BTCDelegations",
+			Handler:    _Query_BTCDelegations_Handler,
+		},
+		{
+			MethodName: "FinalityProviderDelegations",
+			Handler:    _Query_FinalityProviderDelegations_Handler,
+		},
+		{
+			MethodName: "BTCDelegationsByStakerBTCPK",
+			Handler:    _Query_BTCDelegationsByStakerBTCPK_Handler,
+		},
+		{
+			MethodName: "BTCDelegationsByStakerAddr",
+			Handler:    _Query_BTCDelegationsByStakerAddr_Handler,
+		},
+		{
+			MethodName: "BTCDelegation",
+			Handler:    _Query_BTCDelegation_Handler,
+		},
+		{
+			MethodName: "ValidateBTCDelegation",
+			Handler:    _Query_ValidateBTCDelegation_Handler,
+		},
+		{
+			MethodName: "AllowedStakingTxHash",
+			Handler:    _Query_AllowedStakingTxHash_Handler,
+		},
+		{
+			MethodName: "AllowedStakingTxHashes",
+			Handler:    _Query_AllowedStakingTxHashes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstaking/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByBTCHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByBTCHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByBTCHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsByBTCHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsByBTCHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByBTCHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		{
+			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakerBtcPkHex) > 0 {
+		i -= len(m.StakerBtcPkHex)
+		copy(dAtA[i:], m.StakerBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByStakerAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByStakerAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByStakerAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCommissionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCommissionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCommissionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderCommissionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderCommissionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderCommissionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingSat))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxStakedSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxStakedSat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StakedSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakedSat))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakeCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakeCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakeCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderStakeCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderStakeCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderStakeCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AcceptsDelegations {
+		i--
+		if m.AcceptsDelegations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersStakeCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersStakeCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersStakeCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProvidersStakeCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersStakeCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersStakeCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TotalCapacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderStakeCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderStakeCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderStakeCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderStakeCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderStakeCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderStakeCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalCapacity.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationValidationFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationValidationFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationValidationFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rule) > 0 {
+		i -= len(m.Rule)
+		copy(dAtA[i:], m.Rule)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rule)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedStakingTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedStakingTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedStakingTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedStakingTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedStakingTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedStakingTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowListEnabled {
+		i--
+		if m.AllowListEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedStakingTxHashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedStakingTxHashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedStakingTxHashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllowedStakingTxHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllowedStakingTxHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllowedStakingTxHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHashesHex) > 0 {
+		for iNdEx := len(m.StakingTxHashesHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingTxHashesHex[iNdEx])
+			copy(dAtA[i:], m.StakingTxHashesHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashesHex[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CovenantTransitionSigs) > 0 {
+		for iNdEx := len(m.CovenantTransitionSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantTransitionSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.PrevStakingTxHash) > 0 {
+		i -= len(m.PrevStakingTxHash)
+		copy(dAtA[i:], m.PrevStakingTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PrevStakingTxHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.UndelegationResponse != nil {
+		{
+			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegatorSlashSigHex) > 0 {
+		i -= len(m.DelegatorSlashSigHex)
+		copy(dAtA[i:], m.DelegatorSlashSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorUnbondingInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorUnbondingInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorUnbondingInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendStakeTxHex) > 0 {
+		i -= len(m.SpendStakeTxHex)
+		copy(dAtA[i:], m.SpendStakeTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpendStakeTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegatorUnbondingInfoResponse != nil {
+		{
+			size, err := m.DelegatorUnbondingInfoResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DelegatorSlashingSigHex) > 0 {
+		i -= len(m.DelegatorSlashingSigHex)
+		copy(dAtA[i:], m.DelegatorSlashingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UnbondingTxHex) > 0 {
+		i -= len(m.UnbondingTxHex)
+		copy(dAtA[i:], m.UnbondingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CommissionInfo != nil {
+		{
+			size, err := m.CommissionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RetiredBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RetiredBabylonHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.HighestVotedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HighestVotedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryParamsByVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByBTCHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcHeight))
+	}
+	return n
+}
+
+func (m *QueryParamsByBTCHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		l = m.FinalityProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByStakerAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsByStakerAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for _, e := range m.BtcDelegatorDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCommissionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderCommissionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakeCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakedSat != 0 {
+		n += 1 + sovQuery(uint64(m.StakedSat))
+	}
+	if m.MaxStakedSat != 0 {
+		n += 1 + sovQuery(uint64(m.MaxStakedSat))
+	}
+	if m.RemainingSat != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingSat))
+	}
+	return n
+}
+
+func (m *QueryStakeCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStakeCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Capacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *FinalityProviderStakeCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Capacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AcceptsDelegations {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersStakeCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersStakeCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderStakeCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderStakeCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalityProvider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBTCDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateBTCDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BTCDelegationValidationFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rule)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedStakingTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedStakingTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	if m.AllowListEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllowedStakingTxHashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowedStakingTxHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakingTxHashesHex) > 0 {
+		for _, s := range m.StakingTxHashesHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovQuery(uint64(m.StakingTime))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.StatusDesc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	}
+	if m.UndelegationResponse != nil {
+		l = m.UndelegationResponse.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 2 + sovQuery(uint64(m.ParamsVersion))
+	}
+	l = len(m.PrevStakingTxHash)
+	if l > 0 {
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantTransitionSigs) > 0 {
+		for _, e := range m.CovenantTransitionSigs {
+			l = e.Size()
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorUnbondingInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpendStakeTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnbondingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for _, e := range m.CovenantUnbondingSigList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for _, e := range m.CovenantSlashingSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.DelegatorUnbondingInfoResponse != nil {
+		l = m.DelegatorUnbondingInfoResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for _, e := range m.Dels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.HighestVotedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.HighestVotedHeight))
+	}
+	if m.RetiredBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RetiredBabylonHeight))
+	}
+	if m.CommissionInfo != nil {
+		l = m.CommissionInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByBTCHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByBTCHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByBTCHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByBTCHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByBTCHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByBTCHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalityProvider == nil {
+				m.FinalityProvider = &FinalityProviderResponse{}
+			}
+			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByStakerBTCPKRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerBTCPKRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerBTCPKRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByStakerBTCPKResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerBTCPKResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerBTCPKResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByStakerAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsByStakerAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsByStakerAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
+			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCommissionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCommissionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCommissionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderCommissionHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderCommissionHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderCommissionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, CommissionChange{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pending == nil {
+				m.Pending = &CommissionChange{}
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedSat", wireType)
+			}
+			m.StakedSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakedSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakedSat", wireType)
+			}
+			m.MaxStakedSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakedSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSat", wireType)
+			}
+			m.RemainingSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *FinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.SlashedBabylonHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
-	}
-	if m.SlashedBtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.Jailed {
-		n += 2
-	}
-	if m.HighestVotedHeight != 0 {
-		n += 1 + sovQuery(uint64(m.HighestVotedHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3256,10 +8450,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3283,7 +8477,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakeCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3306,15 +8500,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakeCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakeCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3341,7 +8535,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3366,7 +8560,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderStakeCapacity) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3389,17 +8583,82 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderStakeCapacity: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderStakeCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptsDelegations", wireType)
 			}
-			m.Version = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3409,11 +8668,12 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.AcceptsDelegations = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3435,7 +8695,7 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProvidersStakeCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3458,15 +8718,15 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProvidersStakeCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProvidersStakeCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3493,7 +8753,10 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3518,7 +8781,7 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByBTCHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProvidersStakeCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3541,17 +8804,17 @@ func (m *QueryParamsByBTCHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByBTCHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProvidersStakeCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByBTCHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProvidersStakeCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
 			}
-			m.BtcHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3561,64 +8824,62 @@ func (m *QueryParamsByBTCHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcHeight |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsByBTCHeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.FinalityProviders = append(m.FinalityProviders, FinalityProviderStakeCapacity{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TotalCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByBTCHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByBTCHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3645,29 +8906,13 @@ func (m *QueryParamsByBTCHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3689,7 +8934,7 @@ func (m *QueryParamsByBTCHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderStakeCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3712,17 +8957,17 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderStakeCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderStakeCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3732,27 +8977,23 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3775,7 +9016,7 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderStakeCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3798,15 +9039,15 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderStakeCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderStakeCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3833,14 +9074,13 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCapacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3867,10 +9107,7 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3895,7 +9132,7 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3918,15 +9155,15 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3954,7 +9191,7 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3977,7 +9214,7 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4000,15 +9237,15 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4035,10 +9272,10 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalityProvider == nil {
-				m.FinalityProvider = &FinalityProviderResponse{}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
 			}
-			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4063,7 +9300,7 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidateBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4086,17 +9323,103 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidateBTCDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidateBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgCreateBTCDelegation{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateBTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4106,14 +9429,15 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Valid = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4140,10 +9464,8 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Failures = append(m.Failures, BTCDelegationValidationFailure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4168,7 +9490,7 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *BTCDelegationValidationFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4191,17 +9513,17 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCDelegationValidationFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCDelegationValidationFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4211,31 +9533,29 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
-			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Rule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4245,27 +9565,74 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4288,7 +9655,7 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedStakingTxHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4311,15 +9678,15 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedStakingTxHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedStakingTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4347,43 +9714,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4406,7 +9737,7 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedStakingTxHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4429,17 +9760,17 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedStakingTxHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedStakingTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4449,31 +9780,17 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
-			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Allowed = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowListEnabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4483,28 +9800,12 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.AllowListEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4526,7 +9827,7 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedStakingTxHashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4549,17 +9850,17 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedStakingTxHashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedStakingTxHashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4569,23 +9870,27 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4608,7 +9913,7 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowedStakingTxHashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4631,15 +9936,47 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowedStakingTxHashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowedStakingTxHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashesHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashesHex = append(m.StakingTxHashesHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4666,10 +10003,10 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BtcDelegation == nil {
-				m.BtcDelegation = &BTCDelegationResponse{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5176,6 +10513,72 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevStakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevStakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantTransitionSigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantTransitionSigs = append(m.CovenantTransitionSigs, &SignatureInfo{})
+			if err := m.CovenantTransitionSigs[len(m.CovenantTransitionSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5913,6 +11316,61 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredBabylonHeight", wireType)
+			}
+			m.RetiredBabylonHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetiredBabylonHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommissionInfo == nil {
+				m.CommissionInfo = &CommissionInfo{}
+			}
+			if err := m.CommissionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
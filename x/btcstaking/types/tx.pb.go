@@ -47,6 +47,9 @@ type MsgCreateFinalityProvider struct {
 	BtcPk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"btc_pk,omitempty"`
 	// pop is the proof of possession of btc_pk over the FP signer address.
 	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
+	// commission_rates defines the limits on changing the commission rate
+	// of the finality provider
+	CommissionRates *CommissionRates `protobuf:"bytes,6,opt,name=commission_rates,json=commissionRates,proto3" json:"commission_rates,omitempty"`
 }
 
 func (m *MsgCreateFinalityProvider) Reset()         { *m = MsgCreateFinalityProvider{} }
@@ -103,6 +106,13 @@ func (m *MsgCreateFinalityProvider) GetPop() *ProofOfPossessionBTC {
 	return nil
 }
 
+func (m *MsgCreateFinalityProvider) GetCommissionRates() *CommissionRates {
+	if m != nil {
+		return m.CommissionRates
+	}
+	return nil
+}
+
 // MsgCreateFinalityProviderResponse is the response for MsgCreateFinalityProvider
 type MsgCreateFinalityProviderResponse struct {
 }
@@ -141,6 +151,8 @@ func (m *MsgCreateFinalityProviderResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgCreateFinalityProviderResponse proto.InternalMessageInfo
 
 // MsgEditFinalityProvider is the message for editing an existing finality provider
+// A change of the commission rate is subject to the commission rates of the
+// finality provider, and takes effect after a delay
 type MsgEditFinalityProvider struct {
 	// addr the address of the finality provider that whishes to edit his information.
 	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
@@ -243,6 +255,98 @@ func (m *MsgEditFinalityProviderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgEditFinalityProviderResponse proto.InternalMessageInfo
 
+// MsgRetireFinalityProvider is the message for retiring an existing finality provider
+type MsgRetireFinalityProvider struct {
+	// addr is the address of the finality provider that wishes to retire.
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of the finality provider to be retired
+	BtcPk []byte `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3" json:"btc_pk,omitempty"`
+}
+
+func (m *MsgRetireFinalityProvider) Reset()         { *m = MsgRetireFinalityProvider{} }
+func (m *MsgRetireFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgRetireFinalityProvider) ProtoMessage()    {}
+func (*MsgRetireFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{4}
+}
+func (m *MsgRetireFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetireFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetireFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetireFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetireFinalityProvider.Merge(m, src)
+}
+func (m *MsgRetireFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetireFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetireFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetireFinalityProvider proto.InternalMessageInfo
+
+func (m *MsgRetireFinalityProvider) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *MsgRetireFinalityProvider) GetBtcPk() []byte {
+	if m != nil {
+		return m.BtcPk
+	}
+	return nil
+}
+
+// MsgRetireFinalityProviderResponse is the response for MsgRetireFinalityProvider
+type MsgRetireFinalityProviderResponse struct {
+}
+
+func (m *MsgRetireFinalityProviderResponse) Reset()         { *m = MsgRetireFinalityProviderResponse{} }
+func (m *MsgRetireFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetireFinalityProviderResponse) ProtoMessage()    {}
+func (*MsgRetireFinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{5}
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetireFinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetireFinalityProviderResponse.Merge(m, src)
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetireFinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetireFinalityProviderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetireFinalityProviderResponse proto.InternalMessageInfo
+
 // MsgCreateBTCDelegation is the message for creating a BTC delegation
 type MsgCreateBTCDelegation struct {
 	// staker_addr is the address to receive rewards from BTC delegation.
@@ -294,7 +398,7 @@ func (m *MsgCreateBTCDelegation) Reset()         { *m = MsgCreateBTCDelegation{}
 func (m *MsgCreateBTCDelegation) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateBTCDelegation) ProtoMessage()    {}
 func (*MsgCreateBTCDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{4}
+	return fileDescriptor_4baddb53e97f38f2, []int{6}
 }
 func (m *MsgCreateBTCDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +498,7 @@ func (m *MsgCreateBTCDelegationResponse) Reset()         { *m = MsgCreateBTCDele
 func (m *MsgCreateBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateBTCDelegationResponse) ProtoMessage()    {}
 func (*MsgCreateBTCDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{5}
+	return fileDescriptor_4baddb53e97f38f2, []int{7}
 }
 func (m *MsgCreateBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -423,28 +527,58 @@ func (m *MsgCreateBTCDelegationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateBTCDelegationResponse proto.InternalMessageInfo
 
-// MsgAddBTCDelegationInclusionProof is the message for adding proof of inclusion of BTC delegation on BTC chain
-type MsgAddBTCDelegationInclusionProof struct {
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// staking_tx_hash is the hash of the staking tx.
-	// It uniquely identifies a BTC delegation
-	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
-	// staking_tx_inclusion_proof is the inclusion proof of the staking tx in BTC chain
-	StakingTxInclusionProof *InclusionProof `protobuf:"bytes,3,opt,name=staking_tx_inclusion_proof,json=stakingTxInclusionProof,proto3" json:"staking_tx_inclusion_proof,omitempty"`
+// MsgExtendBTCDelegation is the message for extending a BTC delegation without
+// unbonding it. The new staking tx spends the staking output of the extended
+// BTC delegation through the unbonding path, i.e., it is co-signed by the
+// covenant committee. The extension restakes to the same finality providers
+// and is owned by the same staker as the extended BTC delegation. Once the
+// extension becomes active, it takes over the voting power of the extended
+// BTC delegation, which becomes unbonded at the same BTC height
+type MsgExtendBTCDelegation struct {
+	// staker_addr is the address of the staker of the extended BTC delegation
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// prev_staking_tx_hash is the staking tx hash of the BTC delegation
+	// to be extended
+	PrevStakingTxHash string `protobuf:"bytes,2,opt,name=prev_staking_tx_hash,json=prevStakingTxHash,proto3" json:"prev_staking_tx_hash,omitempty"`
+	// staking_time is the time lock used in the new staking transaction
+	StakingTime uint32 `protobuf:"varint,3,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// staking_value is the amount of satoshis locked in the new staking output
+	StakingValue int64 `protobuf:"varint,4,opt,name=staking_value,json=stakingValue,proto3" json:"staking_value,omitempty"`
+	// staking_tx is the new staking transaction. Its only input must be the
+	// staking output of the extended BTC delegation, spent through the unbonding
+	// path, i.e., co-signed by the covenant committee via MsgAddCovenantSigs
+	StakingTx []byte `protobuf:"bytes,5,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
+	// staking_tx_inclusion_proof is the inclusion proof of the new staking tx
+	// in BTC chain. It can be submitted later via MsgAddBTCDelegationInclusionProof
+	StakingTxInclusionProof *InclusionProof `protobuf:"bytes,6,opt,name=staking_tx_inclusion_proof,json=stakingTxInclusionProof,proto3" json:"staking_tx_inclusion_proof,omitempty"`
+	// slashing_tx is the slashing tx of the new staking tx
+	SlashingTx *BTCSlashingTx `protobuf:"bytes,7,opt,name=slashing_tx,json=slashingTx,proto3,customtype=BTCSlashingTx" json:"slashing_tx,omitempty"`
+	// delegator_slashing_sig is the signature on the slashing tx by the delegator
+	DelegatorSlashingSig *github_com_babylonlabs_io_babylon_types.BIP340Signature `protobuf:"bytes,8,opt,name=delegator_slashing_sig,json=delegatorSlashingSig,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340Signature" json:"delegator_slashing_sig,omitempty"`
+	// unbonding_time is the time lock used when funds are being unbonded
+	UnbondingTime uint32 `protobuf:"varint,9,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// unbonding_tx is the unbonding transaction of the new staking tx
+	UnbondingTx []byte `protobuf:"bytes,10,opt,name=unbonding_tx,json=unbondingTx,proto3" json:"unbonding_tx,omitempty"`
+	// unbonding_value is amount of satoshis locked in unbonding output
+	UnbondingValue int64 `protobuf:"varint,11,opt,name=unbonding_value,json=unbondingValue,proto3" json:"unbonding_value,omitempty"`
+	// unbonding_slashing_tx is the slashing tx which slash unbonding contract
+	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,12,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
+	// delegator_unbonding_slashing_sig is the signature on the unbonding slashing tx by the delegator
+	DelegatorUnbondingSlashingSig *github_com_babylonlabs_io_babylon_types.BIP340Signature `protobuf:"bytes,13,opt,name=delegator_unbonding_slashing_sig,json=delegatorUnbondingSlashingSig,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340Signature" json:"delegator_unbonding_slashing_sig,omitempty"`
 }
 
-func (m *MsgAddBTCDelegationInclusionProof) Reset()         { *m = MsgAddBTCDelegationInclusionProof{} }
-func (m *MsgAddBTCDelegationInclusionProof) String() string { return proto.CompactTextString(m) }
-func (*MsgAddBTCDelegationInclusionProof) ProtoMessage()    {}
-func (*MsgAddBTCDelegationInclusionProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{6}
+func (m *MsgExtendBTCDelegation) Reset()         { *m = MsgExtendBTCDelegation{} }
+func (m *MsgExtendBTCDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendBTCDelegation) ProtoMessage()    {}
+func (*MsgExtendBTCDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{8}
 }
-func (m *MsgAddBTCDelegationInclusionProof) XXX_Unmarshal(b []byte) error {
+func (m *MsgExtendBTCDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddBTCDelegationInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExtendBTCDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddBTCDelegationInclusionProof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExtendBTCDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -454,59 +588,97 @@ func (m *MsgAddBTCDelegationInclusionProof) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgAddBTCDelegationInclusionProof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddBTCDelegationInclusionProof.Merge(m, src)
+func (m *MsgExtendBTCDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendBTCDelegation.Merge(m, src)
 }
-func (m *MsgAddBTCDelegationInclusionProof) XXX_Size() int {
+func (m *MsgExtendBTCDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddBTCDelegationInclusionProof) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddBTCDelegationInclusionProof.DiscardUnknown(m)
+func (m *MsgExtendBTCDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendBTCDelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddBTCDelegationInclusionProof proto.InternalMessageInfo
+var xxx_messageInfo_MsgExtendBTCDelegation proto.InternalMessageInfo
 
-func (m *MsgAddBTCDelegationInclusionProof) GetSigner() string {
+func (m *MsgExtendBTCDelegation) GetStakerAddr() string {
 	if m != nil {
-		return m.Signer
+		return m.StakerAddr
 	}
 	return ""
 }
 
-func (m *MsgAddBTCDelegationInclusionProof) GetStakingTxHash() string {
+func (m *MsgExtendBTCDelegation) GetPrevStakingTxHash() string {
 	if m != nil {
-		return m.StakingTxHash
+		return m.PrevStakingTxHash
 	}
 	return ""
 }
 
-func (m *MsgAddBTCDelegationInclusionProof) GetStakingTxInclusionProof() *InclusionProof {
+func (m *MsgExtendBTCDelegation) GetStakingTime() uint32 {
+	if m != nil {
+		return m.StakingTime
+	}
+	return 0
+}
+
+func (m *MsgExtendBTCDelegation) GetStakingValue() int64 {
+	if m != nil {
+		return m.StakingValue
+	}
+	return 0
+}
+
+func (m *MsgExtendBTCDelegation) GetStakingTx() []byte {
+	if m != nil {
+		return m.StakingTx
+	}
+	return nil
+}
+
+func (m *MsgExtendBTCDelegation) GetStakingTxInclusionProof() *InclusionProof {
 	if m != nil {
 		return m.StakingTxInclusionProof
 	}
 	return nil
 }
 
-// MsgAddBTCDelegationInclusionProofResponse is the response for MsgAddBTCDelegationInclusionProof
-type MsgAddBTCDelegationInclusionProofResponse struct {
+func (m *MsgExtendBTCDelegation) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
 }
 
-func (m *MsgAddBTCDelegationInclusionProofResponse) Reset() {
-	*m = MsgAddBTCDelegationInclusionProofResponse{}
+func (m *MsgExtendBTCDelegation) GetUnbondingTx() []byte {
+	if m != nil {
+		return m.UnbondingTx
+	}
+	return nil
 }
-func (m *MsgAddBTCDelegationInclusionProofResponse) String() string {
-	return proto.CompactTextString(m)
+
+func (m *MsgExtendBTCDelegation) GetUnbondingValue() int64 {
+	if m != nil {
+		return m.UnbondingValue
+	}
+	return 0
 }
-func (*MsgAddBTCDelegationInclusionProofResponse) ProtoMessage() {}
-func (*MsgAddBTCDelegationInclusionProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{7}
+
+// MsgExtendBTCDelegationResponse is the response for MsgExtendBTCDelegation
+type MsgExtendBTCDelegationResponse struct {
 }
-func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgExtendBTCDelegationResponse) Reset()         { *m = MsgExtendBTCDelegationResponse{} }
+func (m *MsgExtendBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendBTCDelegationResponse) ProtoMessage()    {}
+func (*MsgExtendBTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{9}
+}
+func (m *MsgExtendBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgExtendBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgExtendBTCDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -516,52 +688,74 @@ func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Marshal(b []byte, determ
 		return b[:n], nil
 	}
 }
-func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.Merge(m, src)
+func (m *MsgExtendBTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendBTCDelegationResponse.Merge(m, src)
 }
-func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Size() int {
+func (m *MsgExtendBTCDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.DiscardUnknown(m)
+func (m *MsgExtendBTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendBTCDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgExtendBTCDelegationResponse proto.InternalMessageInfo
 
-// MsgAddCovenantSigs is the message for handling signatures from a covenant member
-type MsgAddCovenantSigs struct {
-	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	// pk is the BTC public key of the covenant member
-	Pk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=pk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"pk,omitempty"`
-	// staking_tx_hash is the hash of the staking tx.
-	// It uniquely identifies a BTC delegation
-	StakingTxHash string `protobuf:"bytes,3,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
-	// sigs is a list of adaptor signatures of the covenant
-	// the order of sigs should respect the order of finality providers
-	// of the corresponding delegation
-	SlashingTxSigs [][]byte `protobuf:"bytes,4,rep,name=slashing_tx_sigs,json=slashingTxSigs,proto3" json:"slashing_tx_sigs,omitempty"`
-	// unbonding_tx_sig is the signature of the covenant on the unbonding tx submitted to babylon
-	// the signature follows encoding in BIP-340 spec
-	UnbondingTxSig *github_com_babylonlabs_io_babylon_types.BIP340Signature `protobuf:"bytes,5,opt,name=unbonding_tx_sig,json=unbondingTxSig,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340Signature" json:"unbonding_tx_sig,omitempty"`
-	// slashing_unbonding_tx_sigs is a list of adaptor signatures of the covenant
-	// on slashing tx corresponding to unbonding tx submitted to babylon
-	// the order of sigs should respect the order of finality providers
-	// of the corresponding delegation
-	SlashingUnbondingTxSigs [][]byte `protobuf:"bytes,6,rep,name=slashing_unbonding_tx_sigs,json=slashingUnbondingTxSigs,proto3" json:"slashing_unbonding_tx_sigs,omitempty"`
+// MsgRedelegateBTCDelegation is the message for moving the voting power of a
+// BTC delegation to other finality providers without unbonding it. The new
+// staking tx is a transition tx that spends the staking output of the
+// redelegated BTC delegation through the unbonding path, i.e., it is pre-signed
+// by the covenant committee via MsgAddCovenantSigs. The redelegated BTC delegation keeps its voting
+// power and remains slashable until the redelegation becomes active, at which
+// BTC height it becomes unbonded
+type MsgRedelegateBTCDelegation struct {
+	// staker_addr is the address of the staker of the redelegated BTC delegation
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// prev_staking_tx_hash is the staking tx hash of the BTC delegation
+	// to be redelegated
+	PrevStakingTxHash string `protobuf:"bytes,2,opt,name=prev_staking_tx_hash,json=prevStakingTxHash,proto3" json:"prev_staking_tx_hash,omitempty"`
+	// fp_btc_pk_list is the list of Bitcoin secp256k1 PKs of the finality
+	// providers to redelegate to. It must be different from the finality
+	// providers of the redelegated BTC delegation
+	FpBtcPkList []github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// staking_time is the time lock used in the transition staking transaction
+	StakingTime uint32 `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// staking_value is the amount of satoshis locked in the new staking output
+	StakingValue int64 `protobuf:"varint,5,opt,name=staking_value,json=stakingValue,proto3" json:"staking_value,omitempty"`
+	// staking_tx is the transition staking transaction. Its only input must be
+	// the staking output of the redelegated BTC delegation
+	StakingTx []byte `protobuf:"bytes,6,opt,name=staking_tx,json=stakingTx,proto3" json:"staking_tx,omitempty"`
+	// staking_tx_inclusion_proof is the inclusion proof of the transition
+	// staking tx in BTC chain. It can be submitted later via
+	// MsgAddBTCDelegationInclusionProof
+	StakingTxInclusionProof *InclusionProof `protobuf:"bytes,7,opt,name=staking_tx_inclusion_proof,json=stakingTxInclusionProof,proto3" json:"staking_tx_inclusion_proof,omitempty"`
+	// slashing_tx is the slashing tx of the new staking tx
+	SlashingTx *BTCSlashingTx `protobuf:"bytes,8,opt,name=slashing_tx,json=slashingTx,proto3,customtype=BTCSlashingTx" json:"slashing_tx,omitempty"`
+	// delegator_slashing_sig is the signature on the slashing tx by the delegator
+	DelegatorSlashingSig *github_com_babylonlabs_io_babylon_types.BIP340Signature `protobuf:"bytes,9,opt,name=delegator_slashing_sig,json=delegatorSlashingSig,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340Signature" json:"delegator_slashing_sig,omitempty"`
+	// unbonding_time is the time lock used when funds are being unbonded
+	UnbondingTime uint32 `protobuf:"varint,10,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// unbonding_tx is the unbonding transaction of the new staking tx
+	UnbondingTx []byte `protobuf:"bytes,11,opt,name=unbonding_tx,json=unbondingTx,proto3" json:"unbonding_tx,omitempty"`
+	// unbonding_value is amount of satoshis locked in unbonding output
+	UnbondingValue int64 `protobuf:"varint,12,opt,name=unbonding_value,json=unbondingValue,proto3" json:"unbonding_value,omitempty"`
+	// unbonding_slashing_tx is the slashing tx which slash unbonding contract
+	UnbondingSlashingTx *BTCSlashingTx `protobuf:"bytes,13,opt,name=unbonding_slashing_tx,json=unbondingSlashingTx,proto3,customtype=BTCSlashingTx" json:"unbonding_slashing_tx,omitempty"`
+	// delegator_unbonding_slashing_sig is the signature on the unbonding slashing tx by the delegator
+	DelegatorUnbondingSlashingSig *github_com_babylonlabs_io_babylon_types.BIP340Signature `protobuf:"bytes,14,opt,name=delegator_unbonding_slashing_sig,json=delegatorUnbondingSlashingSig,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340Signature" json:"delegator_unbonding_slashing_sig,omitempty"`
 }
 
-func (m *MsgAddCovenantSigs) Reset()         { *m = MsgAddCovenantSigs{} }
-func (m *MsgAddCovenantSigs) String() string { return proto.CompactTextString(m) }
-func (*MsgAddCovenantSigs) ProtoMessage()    {}
-func (*MsgAddCovenantSigs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{8}
+func (m *MsgRedelegateBTCDelegation) Reset()         { *m = MsgRedelegateBTCDelegation{} }
+func (m *MsgRedelegateBTCDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegateBTCDelegation) ProtoMessage()    {}
+func (*MsgRedelegateBTCDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{10}
 }
-func (m *MsgAddCovenantSigs) XXX_Unmarshal(b []byte) error {
+func (m *MsgRedelegateBTCDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddCovenantSigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRedelegateBTCDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddCovenantSigs.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRedelegateBTCDelegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -571,62 +765,97 @@ func (m *MsgAddCovenantSigs) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgAddCovenantSigs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddCovenantSigs.Merge(m, src)
+func (m *MsgRedelegateBTCDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegateBTCDelegation.Merge(m, src)
 }
-func (m *MsgAddCovenantSigs) XXX_Size() int {
+func (m *MsgRedelegateBTCDelegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddCovenantSigs) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddCovenantSigs.DiscardUnknown(m)
+func (m *MsgRedelegateBTCDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegateBTCDelegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddCovenantSigs proto.InternalMessageInfo
+var xxx_messageInfo_MsgRedelegateBTCDelegation proto.InternalMessageInfo
 
-func (m *MsgAddCovenantSigs) GetSigner() string {
+func (m *MsgRedelegateBTCDelegation) GetStakerAddr() string {
 	if m != nil {
-		return m.Signer
+		return m.StakerAddr
 	}
 	return ""
 }
 
-func (m *MsgAddCovenantSigs) GetStakingTxHash() string {
+func (m *MsgRedelegateBTCDelegation) GetPrevStakingTxHash() string {
 	if m != nil {
-		return m.StakingTxHash
+		return m.PrevStakingTxHash
 	}
 	return ""
 }
 
-func (m *MsgAddCovenantSigs) GetSlashingTxSigs() [][]byte {
+func (m *MsgRedelegateBTCDelegation) GetStakingTime() uint32 {
 	if m != nil {
-		return m.SlashingTxSigs
+		return m.StakingTime
+	}
+	return 0
+}
+
+func (m *MsgRedelegateBTCDelegation) GetStakingValue() int64 {
+	if m != nil {
+		return m.StakingValue
+	}
+	return 0
+}
+
+func (m *MsgRedelegateBTCDelegation) GetStakingTx() []byte {
+	if m != nil {
+		return m.StakingTx
 	}
 	return nil
 }
 
-func (m *MsgAddCovenantSigs) GetSlashingUnbondingTxSigs() [][]byte {
+func (m *MsgRedelegateBTCDelegation) GetStakingTxInclusionProof() *InclusionProof {
 	if m != nil {
-		return m.SlashingUnbondingTxSigs
+		return m.StakingTxInclusionProof
 	}
 	return nil
 }
 
-// MsgAddCovenantSigsResponse is the response for MsgAddCovenantSigs
-type MsgAddCovenantSigsResponse struct {
+func (m *MsgRedelegateBTCDelegation) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
 }
 
-func (m *MsgAddCovenantSigsResponse) Reset()         { *m = MsgAddCovenantSigsResponse{} }
-func (m *MsgAddCovenantSigsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgAddCovenantSigsResponse) ProtoMessage()    {}
-func (*MsgAddCovenantSigsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{9}
+func (m *MsgRedelegateBTCDelegation) GetUnbondingTx() []byte {
+	if m != nil {
+		return m.UnbondingTx
+	}
+	return nil
 }
-func (m *MsgAddCovenantSigsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgRedelegateBTCDelegation) GetUnbondingValue() int64 {
+	if m != nil {
+		return m.UnbondingValue
+	}
+	return 0
+}
+
+// MsgRedelegateBTCDelegationResponse is the response for MsgRedelegateBTCDelegation
+type MsgRedelegateBTCDelegationResponse struct {
+}
+
+func (m *MsgRedelegateBTCDelegationResponse) Reset()         { *m = MsgRedelegateBTCDelegationResponse{} }
+func (m *MsgRedelegateBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegateBTCDelegationResponse) ProtoMessage()    {}
+func (*MsgRedelegateBTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{11}
+}
+func (m *MsgRedelegateBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddCovenantSigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRedelegateBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddCovenantSigsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRedelegateBTCDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -636,30 +865,260 @@ func (m *MsgAddCovenantSigsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgAddCovenantSigsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddCovenantSigsResponse.Merge(m, src)
+func (m *MsgRedelegateBTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegateBTCDelegationResponse.Merge(m, src)
 }
-func (m *MsgAddCovenantSigsResponse) XXX_Size() int {
+func (m *MsgRedelegateBTCDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddCovenantSigsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddCovenantSigsResponse.DiscardUnknown(m)
+func (m *MsgRedelegateBTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegateBTCDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddCovenantSigsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRedelegateBTCDelegationResponse proto.InternalMessageInfo
 
-// MsgBTCUndelegate is the message for handling signature on unbonding tx
-// from its delegator. This signature effectively proves that the delegator
-// wants to unbond this BTC delegation
-type MsgBTCUndelegate struct {
+// MsgAddBTCDelegationInclusionProof is the message for adding proof of inclusion of BTC delegation on BTC chain
+type MsgAddBTCDelegationInclusionProof struct {
 	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 	// staking_tx_hash is the hash of the staking tx.
 	// It uniquely identifies a BTC delegation
 	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
-	// stake_spending_tx is a bitcoin transaction that spends the staking transaction
-	// i.e it has staking output as an input
-	StakeSpendingTx []byte `protobuf:"bytes,3,opt,name=stake_spending_tx,json=stakeSpendingTx,proto3" json:"stake_spending_tx,omitempty"`
-	// spend_spending_tx_inclusion_proof is the proof of inclusion of the
+	// staking_tx_inclusion_proof is the inclusion proof of the staking tx in BTC chain
+	StakingTxInclusionProof *InclusionProof `protobuf:"bytes,3,opt,name=staking_tx_inclusion_proof,json=stakingTxInclusionProof,proto3" json:"staking_tx_inclusion_proof,omitempty"`
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) Reset()         { *m = MsgAddBTCDelegationInclusionProof{} }
+func (m *MsgAddBTCDelegationInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*MsgAddBTCDelegationInclusionProof) ProtoMessage()    {}
+func (*MsgAddBTCDelegationInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{12}
+}
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBTCDelegationInclusionProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProof.Merge(m, src)
+}
+func (m *MsgAddBTCDelegationInclusionProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBTCDelegationInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddBTCDelegationInclusionProof proto.InternalMessageInfo
+
+func (m *MsgAddBTCDelegationInclusionProof) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) GetStakingTxInclusionProof() *InclusionProof {
+	if m != nil {
+		return m.StakingTxInclusionProof
+	}
+	return nil
+}
+
+// MsgAddBTCDelegationInclusionProofResponse is the response for MsgAddBTCDelegationInclusionProof
+type MsgAddBTCDelegationInclusionProofResponse struct {
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) Reset() {
+	*m = MsgAddBTCDelegationInclusionProofResponse{}
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAddBTCDelegationInclusionProofResponse) ProtoMessage() {}
+func (*MsgAddBTCDelegationInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{13}
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.Merge(m, src)
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddBTCDelegationInclusionProofResponse proto.InternalMessageInfo
+
+// MsgAddCovenantSigs is the message for handling signatures from a covenant member
+type MsgAddCovenantSigs struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// pk is the BTC public key of the covenant member
+	Pk *github_com_babylonlabs_io_babylon_types.BIP340PubKey `protobuf:"bytes,2,opt,name=pk,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340PubKey" json:"pk,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,3,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// sigs is a list of adaptor signatures of the covenant
+	// the order of sigs should respect the order of finality providers
+	// of the corresponding delegation
+	SlashingTxSigs [][]byte `protobuf:"bytes,4,rep,name=slashing_tx_sigs,json=slashingTxSigs,proto3" json:"slashing_tx_sigs,omitempty"`
+	// unbonding_tx_sig is the signature of the covenant on the unbonding tx submitted to babylon
+	// the signature follows encoding in BIP-340 spec
+	UnbondingTxSig *github_com_babylonlabs_io_babylon_types.BIP340Signature `protobuf:"bytes,5,opt,name=unbonding_tx_sig,json=unbondingTxSig,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340Signature" json:"unbonding_tx_sig,omitempty"`
+	// slashing_unbonding_tx_sigs is a list of adaptor signatures of the covenant
+	// on slashing tx corresponding to unbonding tx submitted to babylon
+	// the order of sigs should respect the order of finality providers
+	// of the corresponding delegation
+	SlashingUnbondingTxSigs [][]byte `protobuf:"bytes,6,rep,name=slashing_unbonding_tx_sigs,json=slashingUnbondingTxSigs,proto3" json:"slashing_unbonding_tx_sigs,omitempty"`
+	// transition_tx_sig is the signature of the covenant on the staking tx of a
+	// successor BTC delegation, which spends the staking output of the previous
+	// BTC delegation through its unbonding path. It must be provided iff the BTC
+	// delegation is created via MsgExtendBTCDelegation or MsgRedelegateBTCDelegation
+	TransitionTxSig *github_com_babylonlabs_io_babylon_types.BIP340Signature `protobuf:"bytes,7,opt,name=transition_tx_sig,json=transitionTxSig,proto3,customtype=github.com/babylonlabs-io/babylon/types.BIP340Signature" json:"transition_tx_sig,omitempty"`
+}
+
+func (m *MsgAddCovenantSigs) Reset()         { *m = MsgAddCovenantSigs{} }
+func (m *MsgAddCovenantSigs) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantSigs) ProtoMessage()    {}
+func (*MsgAddCovenantSigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{14}
+}
+func (m *MsgAddCovenantSigs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCovenantSigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantSigs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCovenantSigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantSigs.Merge(m, src)
+}
+func (m *MsgAddCovenantSigs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCovenantSigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantSigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCovenantSigs proto.InternalMessageInfo
+
+func (m *MsgAddCovenantSigs) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddCovenantSigs) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *MsgAddCovenantSigs) GetSlashingTxSigs() [][]byte {
+	if m != nil {
+		return m.SlashingTxSigs
+	}
+	return nil
+}
+
+func (m *MsgAddCovenantSigs) GetSlashingUnbondingTxSigs() [][]byte {
+	if m != nil {
+		return m.SlashingUnbondingTxSigs
+	}
+	return nil
+}
+
+// MsgAddCovenantSigsResponse is the response for MsgAddCovenantSigs
+type MsgAddCovenantSigsResponse struct {
+}
+
+func (m *MsgAddCovenantSigsResponse) Reset()         { *m = MsgAddCovenantSigsResponse{} }
+func (m *MsgAddCovenantSigsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantSigsResponse) ProtoMessage()    {}
+func (*MsgAddCovenantSigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{15}
+}
+func (m *MsgAddCovenantSigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCovenantSigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantSigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCovenantSigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantSigsResponse.Merge(m, src)
+}
+func (m *MsgAddCovenantSigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCovenantSigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantSigsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCovenantSigsResponse proto.InternalMessageInfo
+
+// MsgBTCUndelegate is the message for handling signature on unbonding tx
+// from its delegator. This signature effectively proves that the delegator
+// wants to unbond this BTC delegation
+type MsgBTCUndelegate struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,2,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// stake_spending_tx is a bitcoin transaction that spends the staking transaction
+	// i.e it has staking output as an input
+	StakeSpendingTx []byte `protobuf:"bytes,3,opt,name=stake_spending_tx,json=stakeSpendingTx,proto3" json:"stake_spending_tx,omitempty"`
+	// spend_spending_tx_inclusion_proof is the proof of inclusion of the
 	// stake_spending_tx in the BTC chain
 	StakeSpendingTxInclusionProof *InclusionProof `protobuf:"bytes,4,opt,name=stake_spending_tx_inclusion_proof,json=stakeSpendingTxInclusionProof,proto3" json:"stake_spending_tx_inclusion_proof,omitempty"`
 }
@@ -668,7 +1127,7 @@ func (m *MsgBTCUndelegate) Reset()         { *m = MsgBTCUndelegate{} }
 func (m *MsgBTCUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegate) ProtoMessage()    {}
 func (*MsgBTCUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{10}
+	return fileDescriptor_4baddb53e97f38f2, []int{16}
 }
 func (m *MsgBTCUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +1192,7 @@ func (m *MsgBTCUndelegateResponse) Reset()         { *m = MsgBTCUndelegateRespon
 func (m *MsgBTCUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegateResponse) ProtoMessage()    {}
 func (*MsgBTCUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{11}
+	return fileDescriptor_4baddb53e97f38f2, []int{17}
 }
 func (m *MsgBTCUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +1239,7 @@ func (m *MsgSelectiveSlashingEvidence) Reset()         { *m = MsgSelectiveSlashi
 func (m *MsgSelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSelectiveSlashingEvidence) ProtoMessage()    {}
 func (*MsgSelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{12}
+	return fileDescriptor_4baddb53e97f38f2, []int{18}
 }
 func (m *MsgSelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,7 +1297,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Reset()         { *m = MsgSelecti
 func (m *MsgSelectiveSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSelectiveSlashingEvidenceResponse) ProtoMessage()    {}
 func (*MsgSelectiveSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{13}
+	return fileDescriptor_4baddb53e97f38f2, []int{19}
 }
 func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1343,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{14}
+	return fileDescriptor_4baddb53e97f38f2, []int{20}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +1394,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{15}
+	return fileDescriptor_4baddb53e97f38f2, []int{21}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -964,113 +1423,338 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
-	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
-	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
-	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
-	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
-	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
-	proto.RegisterType((*MsgAddBTCDelegationInclusionProof)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProof")
-	proto.RegisterType((*MsgAddBTCDelegationInclusionProofResponse)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProofResponse")
-	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
-	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
-	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
-	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
-	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
-	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+// MsgAddAllowedStakingTxHashes defines a message for adding staking tx hashes
+// to the allow list, which is enforced before `allow_list_expiration_height`.
+type MsgAddAllowedStakingTxHashes struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// staking_tx_hashes_hex is the list of staking tx hashes in BTC format
+	// to be added to the allow list
+	StakingTxHashesHex []string `protobuf:"bytes,2,rep,name=staking_tx_hashes_hex,json=stakingTxHashesHex,proto3" json:"staking_tx_hashes_hex,omitempty"`
+}
+
+func (m *MsgAddAllowedStakingTxHashes) Reset()         { *m = MsgAddAllowedStakingTxHashes{} }
+func (m *MsgAddAllowedStakingTxHashes) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedStakingTxHashes) ProtoMessage()    {}
+func (*MsgAddAllowedStakingTxHashes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{22}
+}
+func (m *MsgAddAllowedStakingTxHashes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedStakingTxHashes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedStakingTxHashes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAllowedStakingTxHashes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedStakingTxHashes.Merge(m, src)
+}
+func (m *MsgAddAllowedStakingTxHashes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedStakingTxHashes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedStakingTxHashes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAllowedStakingTxHashes proto.InternalMessageInfo
+
+func (m *MsgAddAllowedStakingTxHashes) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddAllowedStakingTxHashes) GetStakingTxHashesHex() []string {
+	if m != nil {
+		return m.StakingTxHashesHex
+	}
+	return nil
+}
+
+// MsgAddAllowedStakingTxHashesResponse is the response to the
+// MsgAddAllowedStakingTxHashes message.
+type MsgAddAllowedStakingTxHashesResponse struct {
+}
+
+func (m *MsgAddAllowedStakingTxHashesResponse) Reset()         { *m = MsgAddAllowedStakingTxHashesResponse{} }
+func (m *MsgAddAllowedStakingTxHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAllowedStakingTxHashesResponse) ProtoMessage()    {}
+func (*MsgAddAllowedStakingTxHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{23}
+}
+func (m *MsgAddAllowedStakingTxHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAllowedStakingTxHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAllowedStakingTxHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAllowedStakingTxHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAllowedStakingTxHashesResponse.Merge(m, src)
+}
+func (m *MsgAddAllowedStakingTxHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAllowedStakingTxHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAllowedStakingTxHashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddAllowedStakingTxHashesResponse proto.InternalMessageInfo
+
+// MsgRemoveAllowedStakingTxHashes defines a message for removing staking tx
+// hashes from the allow list.
+type MsgRemoveAllowedStakingTxHashes struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// staking_tx_hashes_hex is the list of staking tx hashes in BTC format
+	// to be removed from the allow list
+	StakingTxHashesHex []string `protobuf:"bytes,2,rep,name=staking_tx_hashes_hex,json=stakingTxHashesHex,proto3" json:"staking_tx_hashes_hex,omitempty"`
+}
+
+func (m *MsgRemoveAllowedStakingTxHashes) Reset()         { *m = MsgRemoveAllowedStakingTxHashes{} }
+func (m *MsgRemoveAllowedStakingTxHashes) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowedStakingTxHashes) ProtoMessage()    {}
+func (*MsgRemoveAllowedStakingTxHashes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{24}
+}
+func (m *MsgRemoveAllowedStakingTxHashes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowedStakingTxHashes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowedStakingTxHashes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAllowedStakingTxHashes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowedStakingTxHashes.Merge(m, src)
+}
+func (m *MsgRemoveAllowedStakingTxHashes) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowedStakingTxHashes) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowedStakingTxHashes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAllowedStakingTxHashes proto.InternalMessageInfo
+
+func (m *MsgRemoveAllowedStakingTxHashes) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveAllowedStakingTxHashes) GetStakingTxHashesHex() []string {
+	if m != nil {
+		return m.StakingTxHashesHex
+	}
+	return nil
+}
+
+// MsgRemoveAllowedStakingTxHashesResponse is the response to the
+// MsgRemoveAllowedStakingTxHashes message.
+type MsgRemoveAllowedStakingTxHashesResponse struct {
+}
+
+func (m *MsgRemoveAllowedStakingTxHashesResponse) Reset() {
+	*m = MsgRemoveAllowedStakingTxHashesResponse{}
+}
+func (m *MsgRemoveAllowedStakingTxHashesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAllowedStakingTxHashesResponse) ProtoMessage()    {}
+func (*MsgRemoveAllowedStakingTxHashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{25}
+}
+func (m *MsgRemoveAllowedStakingTxHashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAllowedStakingTxHashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAllowedStakingTxHashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAllowedStakingTxHashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAllowedStakingTxHashesResponse.Merge(m, src)
+}
+func (m *MsgRemoveAllowedStakingTxHashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAllowedStakingTxHashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAllowedStakingTxHashesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveAllowedStakingTxHashesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateFinalityProvider)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProvider")
+	proto.RegisterType((*MsgCreateFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgCreateFinalityProviderResponse")
+	proto.RegisterType((*MsgEditFinalityProvider)(nil), "babylon.btcstaking.v1.MsgEditFinalityProvider")
+	proto.RegisterType((*MsgEditFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgEditFinalityProviderResponse")
+	proto.RegisterType((*MsgRetireFinalityProvider)(nil), "babylon.btcstaking.v1.MsgRetireFinalityProvider")
+	proto.RegisterType((*MsgRetireFinalityProviderResponse)(nil), "babylon.btcstaking.v1.MsgRetireFinalityProviderResponse")
+	proto.RegisterType((*MsgCreateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegation")
+	proto.RegisterType((*MsgCreateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgCreateBTCDelegationResponse")
+	proto.RegisterType((*MsgExtendBTCDelegation)(nil), "babylon.btcstaking.v1.MsgExtendBTCDelegation")
+	proto.RegisterType((*MsgExtendBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgExtendBTCDelegationResponse")
+	proto.RegisterType((*MsgRedelegateBTCDelegation)(nil), "babylon.btcstaking.v1.MsgRedelegateBTCDelegation")
+	proto.RegisterType((*MsgRedelegateBTCDelegationResponse)(nil), "babylon.btcstaking.v1.MsgRedelegateBTCDelegationResponse")
+	proto.RegisterType((*MsgAddBTCDelegationInclusionProof)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProof")
+	proto.RegisterType((*MsgAddBTCDelegationInclusionProofResponse)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProofResponse")
+	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
+	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
+	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
+	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
+	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
+	proto.RegisterType((*MsgSelectiveSlashingEvidenceResponse)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidenceResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.btcstaking.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.btcstaking.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddAllowedStakingTxHashes)(nil), "babylon.btcstaking.v1.MsgAddAllowedStakingTxHashes")
+	proto.RegisterType((*MsgAddAllowedStakingTxHashesResponse)(nil), "babylon.btcstaking.v1.MsgAddAllowedStakingTxHashesResponse")
+	proto.RegisterType((*MsgRemoveAllowedStakingTxHashes)(nil), "babylon.btcstaking.v1.MsgRemoveAllowedStakingTxHashes")
+	proto.RegisterType((*MsgRemoveAllowedStakingTxHashesResponse)(nil), "babylon.btcstaking.v1.MsgRemoveAllowedStakingTxHashesResponse")
 }
 
 func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
 
 var fileDescriptor_4baddb53e97f38f2 = []byte{
-	// 1344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x6f, 0xd3, 0x56,
-	0x14, 0xaf, 0x9b, 0xb6, 0xa3, 0x27, 0xfd, 0x87, 0x29, 0x6d, 0xea, 0xd1, 0x24, 0x0d, 0x50, 0x0a,
-	0xac, 0x0e, 0x05, 0xc6, 0x58, 0xab, 0x49, 0x23, 0x6d, 0x11, 0x68, 0x64, 0x44, 0x4e, 0xba, 0x87,
-	0x49, 0x53, 0xe4, 0xd8, 0xb7, 0xce, 0x55, 0x13, 0x5f, 0xcf, 0xd7, 0x89, 0x52, 0x4d, 0x9a, 0xa6,
-	0x6d, 0xd2, 0x9e, 0x26, 0xed, 0x69, 0x0f, 0xd3, 0x3e, 0x04, 0x0f, 0x7c, 0x08, 0x1e, 0x11, 0xda,
-	0xc3, 0xd4, 0x87, 0x6a, 0x82, 0x07, 0x3e, 0xc3, 0xa6, 0x49, 0x9b, 0x7c, 0x6d, 0x5f, 0x3b, 0x21,
-	0xee, 0x1f, 0xc2, 0x5b, 0xee, 0x3d, 0xbf, 0xf3, 0xef, 0x77, 0xce, 0x3d, 0xf7, 0xc6, 0x90, 0xae,
-	0xa9, 0xb5, 0xfd, 0x06, 0x31, 0xf3, 0x35, 0x47, 0xa3, 0x8e, 0xba, 0x87, 0x4d, 0x23, 0xdf, 0x5e,
-	0xcb, 0x3b, 0x1d, 0xd9, 0xb2, 0x89, 0x43, 0xc4, 0xf3, 0xbe, 0x5c, 0x0e, 0xe5, 0x72, 0x7b, 0x4d,
-	0x9a, 0x35, 0x88, 0x41, 0x18, 0x22, 0xef, 0xfe, 0xf2, 0xc0, 0xd2, 0x82, 0x46, 0x68, 0x93, 0xd0,
-	0xaa, 0x27, 0xf0, 0x16, 0xbe, 0x68, 0xde, 0x5b, 0xe5, 0x9b, 0x94, 0xd9, 0x6f, 0x52, 0xc3, 0x17,
-	0xe4, 0xfa, 0x07, 0x60, 0xa9, 0xb6, 0xda, 0x0c, 0x94, 0x2f, 0xf9, 0xca, 0xa1, 0xbc, 0x86, 0x1c,
-	0x75, 0x2d, 0x58, 0xfb, 0xa8, 0x4c, 0x8c, 0x25, 0x62, 0xf9, 0x80, 0xe5, 0xfe, 0x80, 0x48, 0x66,
-	0x0c, 0x97, 0xfb, 0x67, 0x18, 0x16, 0x8a, 0xd4, 0xd8, 0xb4, 0x91, 0xea, 0xa0, 0xfb, 0xd8, 0x54,
-	0x1b, 0xd8, 0xd9, 0x2f, 0xd9, 0xa4, 0x8d, 0x75, 0x64, 0x8b, 0x1f, 0xc0, 0x88, 0xaa, 0xeb, 0x76,
-	0x4a, 0xc8, 0x0a, 0x2b, 0xe3, 0x85, 0xd4, 0x8b, 0xa7, 0xab, 0xb3, 0x7e, 0xa6, 0xf7, 0x74, 0xdd,
-	0x46, 0x94, 0x96, 0x1d, 0x1b, 0x9b, 0x86, 0xc2, 0x50, 0xe2, 0x36, 0x24, 0x75, 0x44, 0x35, 0x1b,
-	0x5b, 0x0e, 0x26, 0x66, 0x6a, 0x38, 0x2b, 0xac, 0x24, 0x6f, 0x5e, 0x94, 0x7d, 0x8d, 0x90, 0x51,
-	0x96, 0x90, 0xbc, 0x15, 0x42, 0x95, 0xa8, 0x9e, 0x58, 0x04, 0xd0, 0x48, 0xb3, 0x89, 0x29, 0x75,
-	0xad, 0x24, 0x98, 0xeb, 0xd5, 0x83, 0xc3, 0xcc, 0xfb, 0x9e, 0x21, 0xaa, 0xef, 0xc9, 0x98, 0xe4,
-	0x9b, 0xaa, 0x53, 0x97, 0x1f, 0x21, 0x43, 0xd5, 0xf6, 0xb7, 0x90, 0xf6, 0xe2, 0xe9, 0x2a, 0xf8,
-	0x7e, 0xb6, 0x90, 0xa6, 0x44, 0x0c, 0x88, 0x8f, 0x61, 0xac, 0xe6, 0x68, 0x55, 0x6b, 0x2f, 0x35,
-	0x92, 0x15, 0x56, 0x26, 0x0a, 0x77, 0x0f, 0x0e, 0x33, 0xb7, 0x0d, 0xec, 0xd4, 0x5b, 0x35, 0x59,
-	0x23, 0xcd, 0xbc, 0x4f, 0x54, 0x43, 0xad, 0xd1, 0x55, 0x4c, 0x82, 0x65, 0xde, 0xd9, 0xb7, 0x10,
-	0x95, 0x0b, 0x0f, 0x4b, 0xb7, 0x6e, 0xdf, 0x28, 0xb5, 0x6a, 0x9f, 0xa1, 0x7d, 0x65, 0xb4, 0xe6,
-	0x68, 0xa5, 0x3d, 0xf1, 0x13, 0x48, 0x58, 0xc4, 0x4a, 0x8d, 0xb2, 0xf4, 0xae, 0xcb, 0x7d, 0x9b,
-	0x46, 0x2e, 0xd9, 0x84, 0xec, 0x3e, 0xde, 0x2d, 0x11, 0x4a, 0x11, 0x8b, 0xa3, 0x50, 0xd9, 0x54,
-	0x5c, 0xbd, 0xf5, 0xf1, 0xef, 0x5f, 0x3f, 0xb9, 0xc6, 0x08, 0xcb, 0x5d, 0x84, 0xa5, 0x58, 0xee,
-	0x15, 0x44, 0x2d, 0x62, 0x52, 0x94, 0xfb, 0x4f, 0x80, 0xf9, 0x22, 0x35, 0xb6, 0x75, 0xec, 0x0c,
-	0x58, 0x9f, 0xf3, 0x9c, 0x09, 0xb7, 0x34, 0x13, 0x41, 0x3e, 0x3d, 0x65, 0x4b, 0xbc, 0x93, 0xb2,
-	0x8d, 0x0c, 0x58, 0xb6, 0x28, 0x4d, 0x4b, 0x90, 0x89, 0x21, 0x80, 0x93, 0xf4, 0xd3, 0x19, 0x98,
-	0xe3, 0x54, 0x16, 0x2a, 0x9b, 0x5b, 0xa8, 0x81, 0x0c, 0x95, 0xc5, 0xf5, 0x31, 0x24, 0xdd, 0x1c,
-	0x90, 0x5d, 0x3d, 0x11, 0x55, 0xe0, 0x81, 0xdd, 0xcd, 0xa0, 0xd2, 0xc3, 0x6f, 0x57, 0xe9, 0x48,
-	0xe7, 0x25, 0xde, 0x4d, 0xe7, 0x7d, 0x05, 0x53, 0xbb, 0x56, 0xd5, 0xb3, 0x59, 0x6d, 0x60, 0xea,
-	0xa4, 0x46, 0xb2, 0x89, 0x81, 0x0c, 0x27, 0x77, 0xad, 0x82, 0x6b, 0xfa, 0x11, 0xa6, 0x8e, 0xb8,
-	0x04, 0x13, 0x7e, 0x5e, 0x55, 0x07, 0x37, 0x11, 0xeb, 0xf0, 0x49, 0x25, 0xe9, 0xef, 0x55, 0x70,
-	0x13, 0x89, 0x17, 0x61, 0x32, 0x80, 0xb4, 0xd5, 0x46, 0x0b, 0xa5, 0xc6, 0xb2, 0xc2, 0x4a, 0x42,
-	0x09, 0xf4, 0xbe, 0x70, 0xf7, 0xc4, 0x45, 0x00, 0x6e, 0xa7, 0x93, 0x7a, 0x8f, 0xf5, 0xda, 0x78,
-	0x60, 0xa5, 0x23, 0xd6, 0x40, 0x0a, 0xc5, 0x55, 0x6c, 0x6a, 0x8d, 0x96, 0x4b, 0x9b, 0x3b, 0x49,
-	0xc9, 0x6e, 0xea, 0x0c, 0x23, 0xfb, 0x72, 0x0c, 0xd9, 0x0f, 0x03, 0x34, 0x63, 0x5d, 0x99, 0xe7,
-	0x56, 0xbb, 0x05, 0xe2, 0x4d, 0x48, 0xd2, 0x86, 0x4a, 0xeb, 0x7e, 0x0c, 0xe3, 0x8c, 0xff, 0xb3,
-	0x07, 0x87, 0x99, 0xc9, 0x42, 0x65, 0xb3, 0xec, 0x4b, 0x2a, 0x1d, 0x05, 0x28, 0xff, 0x2d, 0x7e,
-	0x0d, 0x73, 0xba, 0xd7, 0x36, 0xc4, 0xae, 0x72, 0x6d, 0x8a, 0x8d, 0x14, 0x30, 0xf5, 0x8d, 0x83,
-	0xc3, 0xcc, 0x47, 0xa7, 0x63, 0xb9, 0x8c, 0x0d, 0x53, 0x75, 0x5a, 0x36, 0x52, 0x66, 0xb9, 0xe9,
-	0xc0, 0x7b, 0x19, 0x1b, 0xe2, 0x65, 0x98, 0x6a, 0x99, 0x35, 0x62, 0xea, 0x9c, 0xf3, 0x24, 0xe3,
-	0x7c, 0x92, 0xef, 0x32, 0xd6, 0x97, 0x60, 0x22, 0x02, 0xeb, 0xa4, 0x26, 0x18, 0xa5, 0xc9, 0x10,
-	0xd4, 0x11, 0xaf, 0xc0, 0x74, 0x08, 0xf1, 0x4a, 0x33, 0xc9, 0x4a, 0x13, 0x3a, 0xf0, 0x8a, 0xb3,
-	0x0d, 0xe7, 0x43, 0x60, 0x94, 0xa3, 0xa9, 0x38, 0x8e, 0xce, 0x71, 0x7c, 0xb8, 0x29, 0xfe, 0x28,
-	0x40, 0x36, 0x64, 0xab, 0x8f, 0x45, 0x97, 0xb7, 0xe9, 0xc1, 0x79, 0x5b, 0xe4, 0x4e, 0x76, 0x7a,
-	0xa3, 0x28, 0x63, 0x63, 0x7d, 0xc6, 0x9d, 0x12, 0xd1, 0xf3, 0x9d, 0xcb, 0x42, 0xba, 0xff, 0x20,
-	0xe0, 0xb3, 0xe2, 0x0f, 0x81, 0x8d, 0xdd, 0x7b, 0xba, 0xde, 0x25, 0xef, 0xe9, 0xa0, 0x39, 0x18,
-	0xa3, 0xd8, 0x30, 0x91, 0x3f, 0x31, 0x14, 0x7f, 0x25, 0x2e, 0xc3, 0x74, 0xa4, 0x7b, 0xeb, 0x2a,
-	0xad, 0xb3, 0xf9, 0x30, 0xae, 0x4c, 0xf2, 0x5e, 0x7c, 0xa0, 0xd2, 0xfa, 0x31, 0x5d, 0x9e, 0x78,
-	0x17, 0x5d, 0xbe, 0x9e, 0x74, 0xb3, 0xf7, 0x03, 0xcb, 0x5d, 0x87, 0xab, 0xc7, 0x66, 0xc5, 0x39,
-	0xf8, 0x7b, 0x18, 0x44, 0x0f, 0xbd, 0x49, 0xda, 0xc8, 0x54, 0x4d, 0xa7, 0x8c, 0x0d, 0x1a, 0x9b,
-	0xf4, 0x03, 0x18, 0x0e, 0x6e, 0x8d, 0x01, 0x86, 0xcd, 0xb0, 0xb5, 0xd7, 0x8f, 0xbe, 0x44, 0x3f,
-	0xfa, 0x56, 0x60, 0x26, 0xd2, 0x9c, 0x6e, 0x37, 0x51, 0x6f, 0xd8, 0x29, 0x53, 0xe1, 0x91, 0x65,
-	0x31, 0x23, 0x98, 0x89, 0x1e, 0x0e, 0xd6, 0x78, 0xa3, 0x83, 0x37, 0xde, 0x54, 0xe4, 0x74, 0xb9,
-	0x47, 0x75, 0x03, 0x24, 0x1e, 0x50, 0xaf, 0x3f, 0x9a, 0x1a, 0x63, 0xa1, 0xcd, 0x07, 0x88, 0x9d,
-	0x2e, 0x5d, 0xda, 0x5d, 0xa8, 0x0b, 0x20, 0xbd, 0x49, 0x3d, 0xaf, 0xcc, 0xbf, 0x02, 0xcc, 0x14,
-	0xa9, 0x51, 0xa8, 0x6c, 0xee, 0x98, 0x7e, 0xef, 0xa3, 0x81, 0x9b, 0xf1, 0x1a, 0x9c, 0x65, 0x67,
-	0xa4, 0x4a, 0x2d, 0xc4, 0xa7, 0x08, 0xbb, 0x94, 0x14, 0x66, 0x00, 0x95, 0xfd, 0xfd, 0x4a, 0x47,
-	0x24, 0xb0, 0xf4, 0x06, 0xf6, 0x8d, 0xfe, 0x1d, 0x39, 0x4d, 0xff, 0x2e, 0xf6, 0xb8, 0x38, 0xaa,
-	0x8b, 0x25, 0x48, 0xf5, 0x66, 0xcf, 0xa9, 0xf9, 0x4d, 0x80, 0x0b, 0x45, 0x6a, 0x94, 0x51, 0x03,
-	0x69, 0x0e, 0x6e, 0xa3, 0x60, 0x10, 0x6c, 0xbb, 0x6f, 0x01, 0x53, 0x1b, 0x9c, 0xa6, 0x55, 0x38,
-	0x67, 0x23, 0x8d, 0xb4, 0x91, 0x8d, 0xf4, 0xaa, 0x7f, 0xd3, 0x52, 0xff, 0xf6, 0x56, 0x66, 0xb8,
-	0xe8, 0xbe, 0x7b, 0x67, 0x96, 0xf7, 0xba, 0x03, 0x5f, 0x86, 0x4b, 0x47, 0xc5, 0xc6, 0x93, 0xf8,
-	0x55, 0x80, 0xe9, 0x22, 0x35, 0x76, 0x2c, 0x5d, 0x75, 0x50, 0x89, 0xbd, 0xfc, 0xc5, 0x3b, 0x30,
-	0xae, 0xb6, 0x9c, 0x3a, 0xb1, 0xb1, 0xb3, 0x7f, 0xec, 0x03, 0x25, 0x84, 0x8a, 0x1b, 0x30, 0xe6,
-	0xfd, 0x77, 0xf0, 0x9f, 0x28, 0x8b, 0x71, 0x4f, 0x14, 0x06, 0x2a, 0x8c, 0x3c, 0x3b, 0xcc, 0x0c,
-	0x29, 0xbe, 0xca, 0xfa, 0x94, 0x1b, 0x7d, 0x68, 0x2c, 0xb7, 0xc0, 0x9e, 0x99, 0xd1, 0xb8, 0x82,
-	0x98, 0x6f, 0xfe, 0x70, 0x06, 0x12, 0x45, 0x6a, 0xb8, 0x43, 0x7f, 0x2e, 0xe6, 0x9f, 0xc2, 0x8d,
-	0x18, 0xd7, 0xb1, 0xef, 0x5b, 0xe9, 0xee, 0x69, 0x35, 0x82, 0x70, 0xc4, 0x6f, 0x61, 0xb6, 0xef,
-	0x6b, 0x58, 0x8e, 0xb7, 0xd8, 0x0f, 0x2f, 0xdd, 0x39, 0x1d, 0x9e, 0xfb, 0xff, 0x06, 0xce, 0xf5,
-	0x7b, 0x68, 0xae, 0x1e, 0x97, 0x50, 0x17, 0x5c, 0xfa, 0xf0, 0x54, 0x70, 0xee, 0xfc, 0x77, 0x01,
-	0xd2, 0xc7, 0x5c, 0x5d, 0x47, 0x30, 0x7b, 0xb4, 0xa6, 0xf4, 0xe9, 0xdb, 0x6a, 0xf2, 0xf0, 0x08,
-	0x4c, 0xf7, 0x5e, 0x2a, 0x57, 0x8f, 0x34, 0x1a, 0x85, 0x4a, 0x6b, 0x27, 0x86, 0x72, 0x87, 0x18,
-	0x26, 0xbb, 0x67, 0xe5, 0x95, 0x78, 0x1b, 0x5d, 0x40, 0x29, 0x7f, 0x42, 0x20, 0x77, 0xf5, 0xb3,
-	0x00, 0x0b, 0xf1, 0xc3, 0xe7, 0x56, 0xbc, 0xb9, 0x58, 0x25, 0x69, 0xe3, 0x2d, 0x94, 0x78, 0x3c,
-	0xbb, 0x30, 0xd1, 0x35, 0x46, 0x96, 0xe3, 0x8d, 0x45, 0x71, 0x92, 0x7c, 0x32, 0x5c, 0xe0, 0x47,
-	0x1a, 0xfd, 0xee, 0xf5, 0x93, 0x6b, 0x42, 0xe1, 0xf3, 0x67, 0x2f, 0xd3, 0xc2, 0xf3, 0x97, 0x69,
-	0xe1, 0xaf, 0x97, 0x69, 0xe1, 0x97, 0x57, 0xe9, 0xa1, 0xe7, 0xaf, 0xd2, 0x43, 0x7f, 0xbe, 0x4a,
-	0x0f, 0x7d, 0x79, 0x82, 0xe7, 0x40, 0x27, 0xfa, 0x21, 0x82, 0xdd, 0xb8, 0xb5, 0x31, 0xf6, 0x05,
-	0xe2, 0xd6, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x58, 0x6d, 0xbc, 0x82, 0x97, 0x11, 0x00, 0x00,
+	// 1710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6f, 0xdb, 0xc8,
+	0x19, 0x36, 0x2d, 0x59, 0xb6, 0x5e, 0x49, 0xfe, 0x60, 0xfc, 0x21, 0xb3, 0xb1, 0x6c, 0x2b, 0x89,
+	0xe3, 0x24, 0xb5, 0x14, 0xc7, 0x69, 0x9a, 0xd8, 0x68, 0x51, 0xcb, 0x76, 0x90, 0xa0, 0x71, 0xe3,
+	0x52, 0x76, 0x0f, 0x05, 0x0a, 0x81, 0x12, 0xc7, 0x14, 0x61, 0x89, 0x64, 0x39, 0xb4, 0x2a, 0xa3,
+	0x40, 0x51, 0x14, 0x05, 0x72, 0x2a, 0xd0, 0x53, 0x80, 0x5d, 0xe4, 0x27, 0xec, 0x21, 0x87, 0x9c,
+	0xf6, 0x17, 0x64, 0x0f, 0x0b, 0x04, 0xc1, 0x1e, 0x16, 0x3e, 0x18, 0x0b, 0xe7, 0x90, 0x3f, 0xb1,
+	0xc0, 0x2e, 0x38, 0x22, 0x87, 0x94, 0x4c, 0x52, 0x92, 0x65, 0x27, 0x7b, 0x13, 0x39, 0xcf, 0xfb,
+	0x31, 0xcf, 0xfb, 0x3e, 0x33, 0x9c, 0x11, 0xa4, 0x8a, 0x42, 0xf1, 0xa8, 0xa2, 0x2a, 0xd9, 0xa2,
+	0x51, 0xc2, 0x86, 0x70, 0x20, 0x2b, 0x52, 0xb6, 0xb6, 0x9c, 0x35, 0xea, 0x19, 0x4d, 0x57, 0x0d,
+	0x95, 0x9d, 0xb0, 0xc6, 0x33, 0xce, 0x78, 0xa6, 0xb6, 0xcc, 0x8d, 0x4b, 0xaa, 0xa4, 0x12, 0x44,
+	0xd6, 0xfc, 0xd5, 0x00, 0x73, 0xd3, 0x25, 0x15, 0x57, 0x55, 0x5c, 0x68, 0x0c, 0x34, 0x1e, 0xac,
+	0xa1, 0xa9, 0xc6, 0x53, 0xb6, 0x8a, 0x89, 0xff, 0x2a, 0x96, 0xac, 0x81, 0xb4, 0x77, 0x02, 0x9a,
+	0xa0, 0x0b, 0x55, 0xdb, 0xf8, 0xba, 0x65, 0xec, 0x8c, 0x17, 0x91, 0x21, 0x2c, 0xdb, 0xcf, 0x16,
+	0x6a, 0xd6, 0xc7, 0x93, 0xaa, 0x59, 0x80, 0x05, 0x6f, 0x80, 0x6b, 0x66, 0x04, 0x97, 0xfe, 0x36,
+	0x04, 0xd3, 0xdb, 0x58, 0xda, 0xd0, 0x91, 0x60, 0xa0, 0xc7, 0xb2, 0x22, 0x54, 0x64, 0xe3, 0x68,
+	0x47, 0x57, 0x6b, 0xb2, 0x88, 0x74, 0xf6, 0xd7, 0x10, 0x16, 0x44, 0x51, 0x4f, 0x32, 0x73, 0xcc,
+	0x62, 0x34, 0x97, 0x7c, 0xff, 0x66, 0x69, 0xdc, 0x9a, 0xe9, 0xba, 0x28, 0xea, 0x08, 0xe3, 0xbc,
+	0xa1, 0xcb, 0x8a, 0xc4, 0x13, 0x14, 0xbb, 0x05, 0x31, 0x11, 0xe1, 0x92, 0x2e, 0x6b, 0x86, 0xac,
+	0x2a, 0xc9, 0xfe, 0x39, 0x66, 0x31, 0x76, 0xef, 0x5a, 0xc6, 0xb2, 0x70, 0x18, 0x25, 0x13, 0xca,
+	0x6c, 0x3a, 0x50, 0xde, 0x6d, 0xc7, 0x6e, 0x03, 0x94, 0xd4, 0x6a, 0x55, 0xc6, 0xd8, 0xf4, 0x12,
+	0x22, 0xa1, 0x97, 0x8e, 0x4f, 0x66, 0x7f, 0xd5, 0x70, 0x84, 0xc5, 0x83, 0x8c, 0xac, 0x66, 0xab,
+	0x82, 0x51, 0xce, 0x3c, 0x43, 0x92, 0x50, 0x3a, 0xda, 0x44, 0xa5, 0xf7, 0x6f, 0x96, 0xc0, 0x8a,
+	0xb3, 0x89, 0x4a, 0xbc, 0xcb, 0x01, 0xfb, 0x1c, 0x22, 0x45, 0xa3, 0x54, 0xd0, 0x0e, 0x92, 0xe1,
+	0x39, 0x66, 0x31, 0x9e, 0x7b, 0x78, 0x7c, 0x32, 0x7b, 0x5f, 0x92, 0x8d, 0xf2, 0x61, 0x31, 0x53,
+	0x52, 0xab, 0x59, 0x8b, 0xa8, 0x8a, 0x50, 0xc4, 0x4b, 0xb2, 0x6a, 0x3f, 0x66, 0x8d, 0x23, 0x0d,
+	0xe1, 0x4c, 0xee, 0xe9, 0xce, 0xca, 0xfd, 0xbb, 0x3b, 0x87, 0xc5, 0x3f, 0xa2, 0x23, 0x7e, 0xa0,
+	0x68, 0x94, 0x76, 0x0e, 0xd8, 0xdf, 0x41, 0x48, 0x53, 0xb5, 0xe4, 0x00, 0x99, 0xde, 0x9d, 0x8c,
+	0x67, 0xd3, 0x64, 0x76, 0x74, 0x55, 0xdd, 0x7f, 0xbe, 0xbf, 0xa3, 0x62, 0x8c, 0x48, 0x1e, 0xb9,
+	0xdd, 0x0d, 0xde, 0xb4, 0x63, 0xff, 0x0c, 0xa3, 0x4e, 0x76, 0x05, 0x5d, 0x30, 0x10, 0x4e, 0x46,
+	0x88, 0xaf, 0x05, 0x1f, 0x5f, 0x1b, 0x14, 0xce, 0x9b, 0x68, 0x7e, 0xa4, 0xd4, 0xfc, 0x62, 0x35,
+	0xfa, 0x9f, 0x8f, 0xaf, 0x6f, 0x93, 0x1a, 0xa4, 0xaf, 0xc1, 0xbc, 0x6f, 0x39, 0x79, 0x84, 0x35,
+	0x55, 0xc1, 0x28, 0xfd, 0x13, 0x03, 0x53, 0xdb, 0x58, 0xda, 0x12, 0x65, 0xa3, 0xc7, 0x92, 0x4f,
+	0x50, 0x72, 0xcd, 0x6a, 0xc7, 0x6d, 0x8a, 0x5a, 0x3a, 0x21, 0x74, 0x21, 0x9d, 0x10, 0xee, 0xb1,
+	0x13, 0xdc, 0x34, 0xcd, 0xc3, 0xac, 0x0f, 0x01, 0x94, 0xa4, 0x03, 0x22, 0x0c, 0x1e, 0x19, 0xb2,
+	0x8e, 0x2e, 0x85, 0xa5, 0xb3, 0x65, 0xf3, 0x0e, 0x46, 0x33, 0x7a, 0x31, 0x04, 0x93, 0xb4, 0xb8,
+	0xb9, 0xdd, 0x8d, 0x4d, 0x54, 0x41, 0x92, 0x40, 0x98, 0x7a, 0x04, 0x31, 0x93, 0x55, 0xa4, 0x17,
+	0x3a, 0x4a, 0x0b, 0x1a, 0x60, 0xf3, 0xa5, 0xdd, 0xce, 0xfd, 0xe7, 0x6c, 0x67, 0x47, 0x5e, 0xa1,
+	0x8b, 0x91, 0xd7, 0xdf, 0x60, 0x78, 0x5f, 0x2b, 0x34, 0x7c, 0x16, 0x2a, 0x32, 0x36, 0x92, 0xe1,
+	0xb9, 0x50, 0x4f, 0x8e, 0x63, 0xfb, 0x5a, 0xce, 0x74, 0xfd, 0x4c, 0xc6, 0x06, 0x3b, 0x0f, 0x71,
+	0x6b, 0x5e, 0x05, 0x43, 0xae, 0x22, 0x22, 0xe3, 0x04, 0x1f, 0xb3, 0xde, 0xed, 0xca, 0x55, 0xc4,
+	0x5e, 0x83, 0x84, 0x0d, 0xa9, 0x09, 0x95, 0x43, 0x44, 0xe4, 0x19, 0xe2, 0x6d, 0xbb, 0xbf, 0x98,
+	0xef, 0xd8, 0x19, 0x00, 0xea, 0xa7, 0x9e, 0x1c, 0x24, 0x75, 0x8d, 0xda, 0x5e, 0xea, 0x6c, 0x11,
+	0x38, 0x67, 0xb8, 0x20, 0x2b, 0xa5, 0xca, 0x21, 0xd1, 0xbb, 0x66, 0x12, 0x99, 0x1c, 0x22, 0x64,
+	0xdf, 0xf0, 0x21, 0xfb, 0xa9, 0x8d, 0x26, 0xac, 0xf3, 0x53, 0xd4, 0x6b, 0xf3, 0x00, 0x7b, 0x0f,
+	0x62, 0xb8, 0x22, 0xe0, 0xb2, 0x95, 0x43, 0x94, 0xf0, 0x3f, 0x76, 0x7c, 0x32, 0x9b, 0xc8, 0xed,
+	0x6e, 0xe4, 0xad, 0x91, 0xdd, 0x3a, 0x0f, 0x98, 0xfe, 0x66, 0xff, 0x0e, 0x93, 0x62, 0xa3, 0x6d,
+	0x54, 0xbd, 0x40, 0xad, 0xb1, 0x2c, 0x25, 0x81, 0x98, 0xaf, 0x1d, 0x9f, 0xcc, 0xfe, 0xb6, 0x3b,
+	0x96, 0xf3, 0xb2, 0xa4, 0x08, 0xc6, 0xa1, 0x8e, 0xf8, 0x71, 0xea, 0xda, 0x8e, 0x9e, 0x97, 0x25,
+	0xf6, 0x06, 0x0c, 0x1f, 0x2a, 0x45, 0x55, 0x11, 0x29, 0xe7, 0x31, 0xc2, 0x79, 0x82, 0xbe, 0x25,
+	0xac, 0xcf, 0x43, 0xdc, 0x05, 0xab, 0x27, 0xe3, 0x84, 0xd2, 0x98, 0x03, 0xaa, 0xb3, 0x37, 0x61,
+	0xc4, 0x81, 0x34, 0x4a, 0x93, 0x20, 0xa5, 0x71, 0x02, 0x34, 0x8a, 0xb3, 0x05, 0x13, 0x0e, 0xd0,
+	0xcd, 0xd1, 0xb0, 0x1f, 0x47, 0x57, 0x28, 0xde, 0x79, 0xc9, 0xfe, 0x97, 0x81, 0x39, 0x87, 0x2d,
+	0x0f, 0x8f, 0x26, 0x6f, 0x23, 0xbd, 0xf3, 0x36, 0x43, 0x83, 0xec, 0xb5, 0x66, 0x91, 0x97, 0xa5,
+	0xd5, 0x51, 0x73, 0x9d, 0x70, 0xeb, 0x3b, 0x3d, 0x07, 0x29, 0xef, 0x85, 0x80, 0xae, 0x15, 0xdf,
+	0x44, 0xc8, 0x5a, 0xb1, 0x55, 0x37, 0x90, 0x22, 0x5e, 0xd8, 0x5a, 0x91, 0x85, 0x71, 0x4d, 0x47,
+	0xb5, 0x82, 0xab, 0xb5, 0xcb, 0x02, 0x2e, 0x93, 0xc5, 0x23, 0xca, 0x8f, 0x99, 0x63, 0x79, 0xbb,
+	0x59, 0x9f, 0x08, 0xb8, 0x7c, 0x46, 0x6d, 0xa1, 0x0e, 0xd4, 0x16, 0x6e, 0xab, 0xb6, 0x81, 0xee,
+	0xd4, 0x16, 0xb9, 0x0c, 0xb5, 0x0d, 0xf6, 0xa6, 0xb6, 0xa1, 0x4f, 0xa7, 0xb6, 0x68, 0x27, 0x6a,
+	0x83, 0x8e, 0xd4, 0x16, 0xeb, 0x4e, 0x6d, 0xf1, 0x8b, 0x57, 0x5b, 0xe2, 0xb3, 0xa9, 0xcd, 0x43,
+	0x4a, 0x54, 0x6d, 0x5f, 0x0d, 0x02, 0x47, 0xf6, 0x6f, 0xcb, 0x35, 0xfa, 0x7c, 0x8a, 0x3b, 0xbb,
+	0x7d, 0x86, 0x2e, 0x73, 0xfb, 0x0c, 0x77, 0x20, 0xe8, 0x81, 0xb6, 0x82, 0x8e, 0x74, 0x27, 0xe8,
+	0xc1, 0xcb, 0x10, 0xf4, 0x50, 0x6f, 0x82, 0x8e, 0x7e, 0x3a, 0x41, 0x43, 0x27, 0x82, 0x8e, 0x75,
+	0x24, 0xe8, 0x78, 0x77, 0x82, 0x4e, 0x5c, 0xbc, 0xa0, 0x87, 0x3f, 0x83, 0xa0, 0xaf, 0x43, 0xda,
+	0x5f, 0xad, 0x54, 0xd4, 0xdf, 0x31, 0xe4, 0xa3, 0x7c, 0x5d, 0x6c, 0x16, 0x7d, 0x4b, 0x17, 0x4d,
+	0x42, 0x04, 0xcb, 0x92, 0x82, 0x2c, 0x59, 0xf3, 0xd6, 0x13, 0xbb, 0x00, 0x23, 0xde, 0x9a, 0x4d,
+	0xe0, 0x26, 0xbd, 0x06, 0x77, 0x7a, 0xe8, 0x22, 0x3a, 0x7d, 0x35, 0x66, 0x32, 0x60, 0x25, 0x96,
+	0xbe, 0x03, 0xb7, 0xda, 0xce, 0x8a, 0x72, 0x70, 0x1a, 0x02, 0xb6, 0x81, 0xde, 0x50, 0x6b, 0x48,
+	0x11, 0x14, 0x23, 0x2f, 0x4b, 0xd8, 0x77, 0xd2, 0x4f, 0xa0, 0xdf, 0x3e, 0xe4, 0xf4, 0xb0, 0xe0,
+	0xf4, 0x6b, 0x07, 0x5e, 0xf4, 0x85, 0xbc, 0xe8, 0x5b, 0x84, 0x51, 0x57, 0x83, 0x9a, 0x1d, 0x85,
+	0x1b, 0xe7, 0x05, 0x7e, 0xd8, 0x91, 0x2d, 0xc9, 0x19, 0xc1, 0xa8, 0x5b, 0x20, 0xa4, 0xf9, 0x06,
+	0x7a, 0x6f, 0xbe, 0x61, 0x97, 0xc2, 0x4c, 0xb9, 0xae, 0x01, 0x47, 0x13, 0x6a, 0x8d, 0x67, 0x1e,
+	0xf4, 0xcd, 0xd4, 0xa6, 0x6c, 0xc4, 0x5e, 0x93, 0x2d, 0x66, 0x25, 0x18, 0x33, 0x74, 0x41, 0xc1,
+	0xb2, 0x59, 0x12, 0x3b, 0xc9, 0xc1, 0xde, 0x93, 0x1c, 0x71, 0xbc, 0x92, 0x48, 0xcd, 0x1d, 0x71,
+	0x95, 0x6c, 0x5e, 0x2d, 0x35, 0xa6, 0x2d, 0xf0, 0x23, 0x03, 0xa3, 0xdb, 0x58, 0xca, 0xed, 0x6e,
+	0xec, 0x29, 0xb6, 0x60, 0x7a, 0xee, 0xfa, 0xdb, 0x30, 0x46, 0x04, 0x59, 0xc0, 0x1a, 0xa2, 0x4b,
+	0x16, 0x39, 0x40, 0xf2, 0xc4, 0x01, 0xca, 0x5b, 0xef, 0x77, 0xeb, 0xac, 0x0a, 0xf3, 0x67, 0xb0,
+	0x67, 0x84, 0x12, 0xee, 0x46, 0x28, 0x33, 0x2d, 0x21, 0x82, 0xe4, 0xc2, 0x41, 0xb2, 0x75, 0xf6,
+	0x94, 0x9a, 0x2f, 0x19, 0xb8, 0xba, 0x8d, 0xa5, 0x3c, 0xaa, 0xa0, 0x92, 0x21, 0xd7, 0x90, 0xbd,
+	0xea, 0x6c, 0x99, 0xe7, 0x76, 0xa5, 0xd4, 0x3b, 0x4d, 0x4b, 0x70, 0x45, 0x47, 0x25, 0xb5, 0x86,
+	0x74, 0x24, 0x16, 0xac, 0x6d, 0x1d, 0x5b, 0x27, 0x6d, 0x7e, 0x94, 0x0e, 0x3d, 0x36, 0x37, 0xe8,
+	0xfc, 0x41, 0x73, 0xe2, 0x0b, 0x70, 0x3d, 0x28, 0x37, 0x3a, 0x89, 0x97, 0x0c, 0x8c, 0x6c, 0x63,
+	0x69, 0x4f, 0x13, 0x05, 0x03, 0xed, 0x90, 0xab, 0x48, 0xf6, 0x01, 0x44, 0x85, 0x43, 0xa3, 0xac,
+	0xea, 0xb2, 0x71, 0xd4, 0xf6, 0x73, 0xc5, 0x81, 0xb2, 0x6b, 0x10, 0x69, 0x5c, 0x66, 0x5a, 0xd7,
+	0x09, 0x33, 0x7e, 0xd7, 0x09, 0x04, 0x94, 0x0b, 0xbf, 0x3d, 0x99, 0xed, 0xe3, 0x2d, 0x93, 0xd5,
+	0x61, 0x33, 0x7b, 0xc7, 0x59, 0x7a, 0x9a, 0x5c, 0x52, 0xb9, 0xf3, 0xa2, 0x39, 0x7f, 0xd1, 0x20,
+	0x7e, 0x5d, 0x14, 0xd7, 0x2b, 0x15, 0xf5, 0x1f, 0x48, 0x6c, 0xfa, 0x06, 0x42, 0xe7, 0x9f, 0xc0,
+	0x32, 0x4c, 0xb4, 0x14, 0x06, 0xe1, 0x42, 0x19, 0xd5, 0x93, 0xfd, 0x73, 0xa1, 0xc5, 0x28, 0xcf,
+	0xe2, 0xe6, 0x38, 0x4f, 0x50, 0xfd, 0x4c, 0xda, 0x0d, 0xde, 0x7d, 0x53, 0xa3, 0x73, 0x78, 0xc5,
+	0x90, 0x3b, 0x28, 0x1e, 0x55, 0xd5, 0x1a, 0xfa, 0xe5, 0x4d, 0xe3, 0x16, 0xdc, 0x6c, 0x93, 0x9d,
+	0x3d, 0x93, 0x7b, 0x5f, 0x27, 0x20, 0xb4, 0x8d, 0x25, 0x73, 0xbf, 0x9f, 0xf4, 0xb9, 0x48, 0xbe,
+	0xeb, 0xd3, 0x08, 0xbe, 0x77, 0x95, 0xdc, 0xc3, 0x6e, 0x2d, 0xec, 0x74, 0xd8, 0x7f, 0xc1, 0xb8,
+	0xe7, 0xcd, 0x66, 0xc6, 0xdf, 0xa3, 0x17, 0x9e, 0x7b, 0xd0, 0x1d, 0x9e, 0xc6, 0x37, 0x69, 0xf0,
+	0xb9, 0x36, 0x0c, 0xa0, 0xc1, 0xdb, 0x22, 0x88, 0x86, 0xe0, 0xdb, 0x42, 0xf6, 0x9f, 0x70, 0xc5,
+	0xeb, 0xa6, 0x70, 0xa9, 0x1d, 0xaf, 0x4d, 0x70, 0xee, 0x37, 0x5d, 0xc1, 0xdd, 0xc1, 0xbd, 0xae,
+	0x1e, 0x02, 0x82, 0x7b, 0xc0, 0x83, 0x82, 0x07, 0x9c, 0xc6, 0xd8, 0x17, 0x0c, 0x4c, 0xf9, 0x1d,
+	0xc5, 0x96, 0x83, 0xf8, 0xf4, 0x34, 0xe1, 0x1e, 0x75, 0x6d, 0x42, 0x33, 0x79, 0xc5, 0x40, 0xaa,
+	0xcd, 0xf7, 0x63, 0x40, 0x81, 0x83, 0x2d, 0xb9, 0x3f, 0x9c, 0xd7, 0x92, 0xa6, 0xa7, 0xc2, 0x48,
+	0xeb, 0x97, 0xdd, 0xad, 0x40, 0xa7, 0x6e, 0x28, 0xb7, 0xdc, 0x31, 0x94, 0x06, 0x94, 0x21, 0xd1,
+	0xfc, 0x1d, 0x71, 0xd3, 0xdf, 0x47, 0x13, 0x90, 0xcb, 0x76, 0x08, 0xa4, 0xa1, 0xfe, 0xc7, 0xc0,
+	0xb4, 0xff, 0xc6, 0xbc, 0xe2, 0xef, 0xce, 0xd7, 0x88, 0x5b, 0x3b, 0x87, 0x11, 0xcd, 0x67, 0x1f,
+	0xe2, 0x4d, 0x5b, 0xec, 0x82, 0xbf, 0x33, 0x37, 0x8e, 0xcb, 0x74, 0x86, 0x6b, 0x9a, 0xb7, 0xff,
+	0xbe, 0xb8, 0x12, 0x58, 0x33, 0x6f, 0xa3, 0xa0, 0x79, 0xb7, 0xdd, 0xe6, 0xd8, 0x97, 0x0c, 0x5c,
+	0x0d, 0xde, 0xe3, 0x82, 0xe4, 0xe5, 0x6f, 0xc7, 0xfd, 0xfe, 0x7c, 0x76, 0x76, 0x62, 0xdc, 0xc0,
+	0xbf, 0x3f, 0xbe, 0xbe, 0xcd, 0xe4, 0xfe, 0xf4, 0xf6, 0x34, 0xc5, 0xbc, 0x3b, 0x4d, 0x31, 0x3f,
+	0x9c, 0xa6, 0x98, 0xff, 0x7f, 0x48, 0xf5, 0xbd, 0xfb, 0x90, 0xea, 0xfb, 0xfe, 0x43, 0xaa, 0xef,
+	0xaf, 0x1d, 0x1c, 0x5e, 0xea, 0xee, 0xbf, 0x57, 0xc9, 0xa7, 0x77, 0x31, 0x42, 0xfe, 0x57, 0x5d,
+	0xf9, 0x39, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x6c, 0xb0, 0xf6, 0x6d, 0x1e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1089,8 +1773,17 @@ type MsgClient interface {
 	CreateFinalityProvider(ctx context.Context, in *MsgCreateFinalityProvider, opts ...grpc.CallOption) (*MsgCreateFinalityProviderResponse, error)
 	// EditFinalityProvider edits an existing finality provider
 	EditFinalityProvider(ctx context.Context, in *MsgEditFinalityProvider, opts ...grpc.CallOption) (*MsgEditFinalityProviderResponse, error)
+	// RetireFinalityProvider announces the retirement of an existing finality provider
+	RetireFinalityProvider(ctx context.Context, in *MsgRetireFinalityProvider, opts ...grpc.CallOption) (*MsgRetireFinalityProviderResponse, error)
 	// CreateBTCDelegation creates a new BTC delegation
 	CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error)
+	// ExtendBTCDelegation registers a new BTC delegation that spends the staking
+	// output of an existing BTC delegation as its successor
+	ExtendBTCDelegation(ctx context.Context, in *MsgExtendBTCDelegation, opts ...grpc.CallOption) (*MsgExtendBTCDelegationResponse, error)
+	// RedelegateBTCDelegation registers a new BTC delegation to other finality
+	// providers that spends the staking output of an existing BTC delegation
+	// as its successor
+	RedelegateBTCDelegation(ctx context.Context, in *MsgRedelegateBTCDelegation, opts ...grpc.CallOption) (*MsgRedelegateBTCDelegationResponse, error)
 	// AddBTCDelegationInclusionProof adds inclusion proof of a given delegation on BTC chain
 	AddBTCDelegationInclusionProof(ctx context.Context, in *MsgAddBTCDelegationInclusionProof, opts ...grpc.CallOption) (*MsgAddBTCDelegationInclusionProofResponse, error)
 	// AddCovenantSigs handles signatures from a covenant member
@@ -1102,6 +1795,10 @@ type MsgClient interface {
 	SelectiveSlashingEvidence(ctx context.Context, in *MsgSelectiveSlashingEvidence, opts ...grpc.CallOption) (*MsgSelectiveSlashingEvidenceResponse, error)
 	// UpdateParams updates the btcstaking module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// AddAllowedStakingTxHashes adds staking tx hashes to the allow list.
+	AddAllowedStakingTxHashes(ctx context.Context, in *MsgAddAllowedStakingTxHashes, opts ...grpc.CallOption) (*MsgAddAllowedStakingTxHashesResponse, error)
+	// RemoveAllowedStakingTxHashes removes staking tx hashes from the allow list.
+	RemoveAllowedStakingTxHashes(ctx context.Context, in *MsgRemoveAllowedStakingTxHashes, opts ...grpc.CallOption) (*MsgRemoveAllowedStakingTxHashesResponse, error)
 }
 
 type msgClient struct {
@@ -1130,6 +1827,15 @@ func (c *msgClient) EditFinalityProvider(ctx context.Context, in *MsgEditFinalit
 	return out, nil
 }
 
+func (c *msgClient) RetireFinalityProvider(ctx context.Context, in *MsgRetireFinalityProvider, opts ...grpc.CallOption) (*MsgRetireFinalityProviderResponse, error) {
+	out := new(MsgRetireFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/RetireFinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDelegation, opts ...grpc.CallOption) (*MsgCreateBTCDelegationResponse, error) {
 	out := new(MsgCreateBTCDelegationResponse)
 	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/CreateBTCDelegation", in, out, opts...)
@@ -1139,6 +1845,24 @@ func (c *msgClient) CreateBTCDelegation(ctx context.Context, in *MsgCreateBTCDel
 	return out, nil
 }
 
+func (c *msgClient) ExtendBTCDelegation(ctx context.Context, in *MsgExtendBTCDelegation, opts ...grpc.CallOption) (*MsgExtendBTCDelegationResponse, error) {
+	out := new(MsgExtendBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/ExtendBTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedelegateBTCDelegation(ctx context.Context, in *MsgRedelegateBTCDelegation, opts ...grpc.CallOption) (*MsgRedelegateBTCDelegationResponse, error) {
+	out := new(MsgRedelegateBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/RedelegateBTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddBTCDelegationInclusionProof(ctx context.Context, in *MsgAddBTCDelegationInclusionProof, opts ...grpc.CallOption) (*MsgAddBTCDelegationInclusionProofResponse, error) {
 	out := new(MsgAddBTCDelegationInclusionProofResponse)
 	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddBTCDelegationInclusionProof", in, out, opts...)
@@ -1184,14 +1908,41 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) AddAllowedStakingTxHashes(ctx context.Context, in *MsgAddAllowedStakingTxHashes, opts ...grpc.CallOption) (*MsgAddAllowedStakingTxHashesResponse, error) {
+	out := new(MsgAddAllowedStakingTxHashesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddAllowedStakingTxHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowedStakingTxHashes(ctx context.Context, in *MsgRemoveAllowedStakingTxHashes, opts ...grpc.CallOption) (*MsgRemoveAllowedStakingTxHashesResponse, error) {
+	out := new(MsgRemoveAllowedStakingTxHashesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/RemoveAllowedStakingTxHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateFinalityProvider creates a new finality provider
 	CreateFinalityProvider(context.Context, *MsgCreateFinalityProvider) (*MsgCreateFinalityProviderResponse, error)
 	// EditFinalityProvider edits an existing finality provider
 	EditFinalityProvider(context.Context, *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error)
+	// RetireFinalityProvider announces the retirement of an existing finality provider
+	RetireFinalityProvider(context.Context, *MsgRetireFinalityProvider) (*MsgRetireFinalityProviderResponse, error)
 	// CreateBTCDelegation creates a new BTC delegation
 	CreateBTCDelegation(context.Context, *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error)
+	// ExtendBTCDelegation registers a new BTC delegation that spends the staking
+	// output of an existing BTC delegation as its successor
+	ExtendBTCDelegation(context.Context, *MsgExtendBTCDelegation) (*MsgExtendBTCDelegationResponse, error)
+	// RedelegateBTCDelegation registers a new BTC delegation to other finality
+	// providers that spends the staking output of an existing BTC delegation
+	// as its successor
+	RedelegateBTCDelegation(context.Context, *MsgRedelegateBTCDelegation) (*MsgRedelegateBTCDelegationResponse, error)
 	// AddBTCDelegationInclusionProof adds inclusion proof of a given delegation on BTC chain
 	AddBTCDelegationInclusionProof(context.Context, *MsgAddBTCDelegationInclusionProof) (*MsgAddBTCDelegationInclusionProofResponse, error)
 	// AddCovenantSigs handles signatures from a covenant member
@@ -1203,6 +1954,10 @@ type MsgServer interface {
 	SelectiveSlashingEvidence(context.Context, *MsgSelectiveSlashingEvidence) (*MsgSelectiveSlashingEvidenceResponse, error)
 	// UpdateParams updates the btcstaking module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// AddAllowedStakingTxHashes adds staking tx hashes to the allow list.
+	AddAllowedStakingTxHashes(context.Context, *MsgAddAllowedStakingTxHashes) (*MsgAddAllowedStakingTxHashesResponse, error)
+	// RemoveAllowedStakingTxHashes removes staking tx hashes from the allow list.
+	RemoveAllowedStakingTxHashes(context.Context, *MsgRemoveAllowedStakingTxHashes) (*MsgRemoveAllowedStakingTxHashesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1215,9 +1970,18 @@ func (*UnimplementedMsgServer) CreateFinalityProvider(ctx context.Context, req *
 func (*UnimplementedMsgServer) EditFinalityProvider(ctx context.Context, req *MsgEditFinalityProvider) (*MsgEditFinalityProviderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditFinalityProvider not implemented")
 }
+func (*UnimplementedMsgServer) RetireFinalityProvider(ctx context.Context, req *MsgRetireFinalityProvider) (*MsgRetireFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireFinalityProvider not implemented")
+}
 func (*UnimplementedMsgServer) CreateBTCDelegation(ctx context.Context, req *MsgCreateBTCDelegation) (*MsgCreateBTCDelegationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBTCDelegation not implemented")
 }
+func (*UnimplementedMsgServer) ExtendBTCDelegation(ctx context.Context, req *MsgExtendBTCDelegation) (*MsgExtendBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendBTCDelegation not implemented")
+}
+func (*UnimplementedMsgServer) RedelegateBTCDelegation(ctx context.Context, req *MsgRedelegateBTCDelegation) (*MsgRedelegateBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateBTCDelegation not implemented")
+}
 func (*UnimplementedMsgServer) AddBTCDelegationInclusionProof(ctx context.Context, req *MsgAddBTCDelegationInclusionProof) (*MsgAddBTCDelegationInclusionProofResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddBTCDelegationInclusionProof not implemented")
 }
@@ -1233,6 +1997,12 @@ func (*UnimplementedMsgServer) SelectiveSlashingEvidence(ctx context.Context, re
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) AddAllowedStakingTxHashes(ctx context.Context, req *MsgAddAllowedStakingTxHashes) (*MsgAddAllowedStakingTxHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedStakingTxHashes not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAllowedStakingTxHashes(ctx context.Context, req *MsgRemoveAllowedStakingTxHashes) (*MsgRemoveAllowedStakingTxHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedStakingTxHashes not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1274,6 +2044,24 @@ func _Msg_EditFinalityProvider_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RetireFinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetireFinalityProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetireFinalityProvider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/RetireFinalityProvider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetireFinalityProvider(ctx, req.(*MsgRetireFinalityProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateBTCDelegation)
 	if err := dec(in); err != nil {
@@ -1292,6 +2080,42 @@ func _Msg_CreateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExtendBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendBTCDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendBTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/ExtendBTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendBTCDelegation(ctx, req.(*MsgExtendBTCDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedelegateBTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateBTCDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateBTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/RedelegateBTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateBTCDelegation(ctx, req.(*MsgRedelegateBTCDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddBTCDelegationInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddBTCDelegationInclusionProof)
 	if err := dec(in); err != nil {
@@ -1382,6 +2206,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddAllowedStakingTxHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedStakingTxHashes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedStakingTxHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddAllowedStakingTxHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedStakingTxHashes(ctx, req.(*MsgAddAllowedStakingTxHashes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowedStakingTxHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedStakingTxHashes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedStakingTxHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/RemoveAllowedStakingTxHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedStakingTxHashes(ctx, req.(*MsgRemoveAllowedStakingTxHashes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.btcstaking.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1394,10 +2254,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EditFinalityProvider",
 			Handler:    _Msg_EditFinalityProvider_Handler,
 		},
+		{
+			MethodName: "RetireFinalityProvider",
+			Handler:    _Msg_RetireFinalityProvider_Handler,
+		},
 		{
 			MethodName: "CreateBTCDelegation",
 			Handler:    _Msg_CreateBTCDelegation_Handler,
 		},
+		{
+			MethodName: "ExtendBTCDelegation",
+			Handler:    _Msg_ExtendBTCDelegation_Handler,
+		},
+		{
+			MethodName: "RedelegateBTCDelegation",
+			Handler:    _Msg_RedelegateBTCDelegation_Handler,
+		},
 		{
 			MethodName: "AddBTCDelegationInclusionProof",
 			Handler:    _Msg_AddBTCDelegationInclusionProof_Handler,
@@ -1418,6 +2290,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "AddAllowedStakingTxHashes",
+			Handler:    _Msg_AddAllowedStakingTxHashes_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedStakingTxHashes",
+			Handler:    _Msg_RemoveAllowedStakingTxHashes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/btcstaking/v1/tx.proto",
@@ -1443,6 +2323,18 @@ func (m *MsgCreateFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.CommissionRates != nil {
+		{
+			size, err := m.CommissionRates.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.Pop != nil {
 		{
 			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
@@ -1608,7 +2500,7 @@ func (m *MsgEditFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
+func (m *MsgRetireFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1618,24 +2510,84 @@ func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRetireFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRetireFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.DelegatorUnbondingSlashingSig != nil {
-		{
-			size := m.DelegatorUnbondingSlashingSig.Size()
-			i -= size
-			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.BtcPk) > 0 {
+		i -= len(m.BtcPk)
+		copy(dAtA[i:], m.BtcPk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BtcPk)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetireFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetireFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetireFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateBTCDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegatorUnbondingSlashingSig != nil {
+		{
+			size := m.DelegatorUnbondingSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x7a
@@ -1793,7 +2745,7 @@ func (m *MsgCreateBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddBTCDelegationInclusionProof) Marshal() (dAtA []byte, err error) {
+func (m *MsgExtendBTCDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1803,148 +2755,128 @@ func (m *MsgAddBTCDelegationInclusionProof) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddBTCDelegationInclusionProof) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgExtendBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddBTCDelegationInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgExtendBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StakingTxInclusionProof != nil {
+	if m.DelegatorUnbondingSlashingSig != nil {
 		{
-			size, err := m.StakingTxInclusionProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.DelegatorUnbondingSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x6a
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x62
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if m.UnbondingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddBTCDelegationInclusionProofResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x58
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddBTCDelegationInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddBTCDelegationInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddCovenantSigs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgAddCovenantSigs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgAddCovenantSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SlashingUnbondingTxSigs) > 0 {
-		for iNdEx := len(m.SlashingUnbondingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashingUnbondingTxSigs[iNdEx])
-			copy(dAtA[i:], m.SlashingUnbondingTxSigs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingUnbondingTxSigs[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.UnbondingTxSig != nil {
+	if m.DelegatorSlashingSig != nil {
 		{
-			size := m.UnbondingTxSig.Size()
+			size := m.DelegatorSlashingSig.Size()
 			i -= size
-			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x42
 	}
-	if len(m.SlashingTxSigs) > 0 {
-		for iNdEx := len(m.SlashingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.SlashingTxSigs[iNdEx])
-			copy(dAtA[i:], m.SlashingTxSigs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingTxSigs[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x3a
 	}
-	if m.Pk != nil {
+	if m.StakingTxInclusionProof != nil {
 		{
-			size := m.Pk.Size()
-			i -= size
-			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.StakingTxInclusionProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StakingTx) > 0 {
+		i -= len(m.StakingTx)
+		copy(dAtA[i:], m.StakingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTx)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StakingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PrevStakingTxHash) > 0 {
+		i -= len(m.PrevStakingTxHash)
+		copy(dAtA[i:], m.PrevStakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PrevStakingTxHash)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgExtendBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1954,12 +2886,12 @@ func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgExtendBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgExtendBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1967,7 +2899,7 @@ func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgRedelegateBTCDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1977,53 +2909,142 @@ func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRedelegateBTCDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRedelegateBTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.StakeSpendingTxInclusionProof != nil {
+	if m.DelegatorUnbondingSlashingSig != nil {
 		{
-			size, err := m.StakeSpendingTxInclusionProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.DelegatorUnbondingSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorUnbondingSlashingSig.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x72
 	}
-	if len(m.StakeSpendingTx) > 0 {
-		i -= len(m.StakeSpendingTx)
-		copy(dAtA[i:], m.StakeSpendingTx)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakeSpendingTx)))
+	if m.UnbondingSlashingTx != nil {
+		{
+			size := m.UnbondingSlashingTx.Size()
+			i -= size
+			if _, err := m.UnbondingSlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x6a
 	}
-	if len(m.StakingTxHash) > 0 {
-		i -= len(m.StakingTxHash)
-		copy(dAtA[i:], m.StakingTxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+	if m.UnbondingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingValue))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.UnbondingTx) > 0 {
+		i -= len(m.UnbondingTx)
+		copy(dAtA[i:], m.UnbondingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UnbondingTx)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DelegatorSlashingSig != nil {
+		{
+			size := m.DelegatorSlashingSig.Size()
+			i -= size
+			if _, err := m.DelegatorSlashingSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SlashingTx != nil {
+		{
+			size := m.SlashingTx.Size()
+			i -= size
+			if _, err := m.SlashingTx.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.StakingTxInclusionProof != nil {
+		{
+			size, err := m.StakingTxInclusionProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StakingTx) > 0 {
+		i -= len(m.StakingTx)
+		copy(dAtA[i:], m.StakingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTx)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StakingValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingValue))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PrevStakingTxHash) > 0 {
+		i -= len(m.PrevStakingTxHash)
+		copy(dAtA[i:], m.PrevStakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PrevStakingTxHash)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakerAddr)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRedelegateBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2033,12 +3054,12 @@ func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRedelegateBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRedelegateBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2046,7 +3067,7 @@ func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddBTCDelegationInclusionProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2056,20 +3077,25 @@ func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddBTCDelegationInclusionProof) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddBTCDelegationInclusionProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecoveredFpBtcSk) > 0 {
-		i -= len(m.RecoveredFpBtcSk)
-		copy(dAtA[i:], m.RecoveredFpBtcSk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+	if m.StakingTxInclusionProof != nil {
+		{
+			size, err := m.StakingTxInclusionProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2090,7 +3116,7 @@ func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddBTCDelegationInclusionProofResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2100,12 +3126,12 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddBTCDelegationInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddBTCDelegationInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2113,7 +3139,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddCovenantSigs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2123,37 +3149,88 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantSigs) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantSigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.TransitionTxSig != nil {
+		{
+			size := m.TransitionTxSig.Size()
+			i -= size
+			if _, err := m.TransitionTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x3a
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.SlashingUnbondingTxSigs) > 0 {
+		for iNdEx := len(m.SlashingUnbondingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingUnbondingTxSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingUnbondingTxSigs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingUnbondingTxSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.UnbondingTxSig != nil {
+		{
+			size := m.UnbondingTxSig.Size()
+			i -= size
+			if _, err := m.UnbondingTxSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SlashingTxSigs) > 0 {
+		for iNdEx := len(m.SlashingTxSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SlashingTxSigs[iNdEx])
+			copy(dAtA[i:], m.SlashingTxSigs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SlashingTxSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddCovenantSigsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2163,12 +3240,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantSigsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2176,233 +3253,384 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgEditFinalityProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.StakeSpendingTxInclusionProof != nil {
+		{
+			size, err := m.StakeSpendingTxInclusionProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.BtcPk)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakeSpendingTx) > 0 {
+		i -= len(m.StakeSpendingTx)
+		copy(dAtA[i:], m.StakeSpendingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakeSpendingTx)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBTCDelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakerAddr)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.StakingTime != 0 {
-		n += 1 + sovTx(uint64(m.StakingTime))
-	}
-	if m.StakingValue != 0 {
-		n += 1 + sovTx(uint64(m.StakingValue))
-	}
-	l = len(m.StakingTx)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.StakingTxInclusionProof != nil {
-		l = m.StakingTxInclusionProof.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SlashingTx != nil {
-		l = m.SlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DelegatorSlashingSig != nil {
-		l = m.DelegatorSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingTime))
-	}
-	l = len(m.UnbondingTx)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnbondingValue != 0 {
-		n += 1 + sovTx(uint64(m.UnbondingValue))
+	if len(m.RecoveredFpBtcSk) > 0 {
+		i -= len(m.RecoveredFpBtcSk)
+		copy(dAtA[i:], m.RecoveredFpBtcSk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.UnbondingSlashingTx != nil {
-		l = m.UnbondingSlashingTx.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.DelegatorUnbondingSlashingSig != nil {
-		l = m.DelegatorUnbondingSlashingSig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSelectiveSlashingEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddBTCDelegationInclusionProof) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.StakingTxInclusionProof != nil {
-		l = m.StakingTxInclusionProof.Size()
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddBTCDelegationInclusionProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddCovenantSigs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Signer)
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedStakingTxHashes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedStakingTxHashes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedStakingTxHashes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashesHex) > 0 {
+		for iNdEx := len(m.StakingTxHashesHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingTxHashesHex[iNdEx])
+			copy(dAtA[i:], m.StakingTxHashesHex[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHashesHex[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAllowedStakingTxHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAllowedStakingTxHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAllowedStakingTxHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedStakingTxHashes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedStakingTxHashes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedStakingTxHashes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashesHex) > 0 {
+		for iNdEx := len(m.StakingTxHashesHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakingTxHashesHex[iNdEx])
+			copy(dAtA[i:], m.StakingTxHashesHex[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHashesHex[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAllowedStakingTxHashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAllowedStakingTxHashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAllowedStakingTxHashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Pk != nil {
-		l = m.Pk.Size()
+	if m.Description != nil {
+		l = m.Description.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.StakingTxHash)
-	if l > 0 {
+	if m.Commission != nil {
+		l = m.Commission.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.SlashingTxSigs) > 0 {
-		for _, b := range m.SlashingTxSigs {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.UnbondingTxSig != nil {
-		l = m.UnbondingTxSig.Size()
+	if m.Pop != nil {
+		l = m.Pop.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.SlashingUnbondingTxSigs) > 0 {
-		for _, b := range m.SlashingUnbondingTxSigs {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if m.CommissionRates != nil {
+		l = m.CommissionRates.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgAddCovenantSigsResponse) Size() (n int) {
+func (m *MsgCreateFinalityProviderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2411,32 +3639,58 @@ func (m *MsgAddCovenantSigsResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgBTCUndelegate) Size() (n int) {
+func (m *MsgEditFinalityProvider) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Signer)
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.StakingTxHash)
+	l = len(m.BtcPk)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.StakeSpendingTx)
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetireFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.StakeSpendingTxInclusionProof != nil {
-		l = m.StakeSpendingTxInclusionProof.Size()
+	l = len(m.BtcPk)
+	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgBTCUndelegateResponse) Size() (n int) {
+func (m *MsgRetireFinalityProviderResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2445,67 +3699,1724 @@ func (m *MsgBTCUndelegateResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
+func (m *MsgCreateBTCDelegation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Signer)
+	l = len(m.StakerAddr)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.StakingTxHash)
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovTx(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovTx(uint64(m.StakingValue))
+	}
+	l = len(m.StakingTx)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.RecoveredFpBtcSk)
+	if m.StakingTxInclusionProof != nil {
+		l = m.StakingTxInclusionProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorSlashingSig != nil {
+		l = m.DelegatorSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingTime))
+	}
+	l = len(m.UnbondingTx)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExtendBTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PrevStakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovTx(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovTx(uint64(m.StakingValue))
+	}
+	l = len(m.StakingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StakingTxInclusionProof != nil {
+		l = m.StakingTxInclusionProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorSlashingSig != nil {
+		l = m.DelegatorSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingTime))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExtendBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedelegateBTCDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PrevStakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovTx(uint64(m.StakingTime))
+	}
+	if m.StakingValue != 0 {
+		n += 1 + sovTx(uint64(m.StakingValue))
+	}
+	l = len(m.StakingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StakingTxInclusionProof != nil {
+		l = m.StakingTxInclusionProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SlashingTx != nil {
+		l = m.SlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorSlashingSig != nil {
+		l = m.DelegatorSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingTime))
+	}
+	l = len(m.UnbondingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnbondingValue != 0 {
+		n += 1 + sovTx(uint64(m.UnbondingValue))
+	}
+	if m.UnbondingSlashingTx != nil {
+		l = m.UnbondingSlashingTx.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DelegatorUnbondingSlashingSig != nil {
+		l = m.DelegatorUnbondingSlashingSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRedelegateBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBTCDelegationInclusionProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StakingTxInclusionProof != nil {
+		l = m.StakingTxInclusionProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddBTCDelegationInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddCovenantSigs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SlashingTxSigs) > 0 {
+		for _, b := range m.SlashingTxSigs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UnbondingTxSig != nil {
+		l = m.UnbondingTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SlashingUnbondingTxSigs) > 0 {
+		for _, b := range m.SlashingUnbondingTxSigs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TransitionTxSig != nil {
+		l = m.TransitionTxSig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddCovenantSigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBTCUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakeSpendingTx)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StakeSpendingTxInclusionProof != nil {
+		l = m.StakeSpendingTxInclusionProof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBTCUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecoveredFpBtcSk)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAllowedStakingTxHashes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingTxHashesHex) > 0 {
+		for _, s := range m.StakingTxHashesHex {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddAllowedStakingTxHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAllowedStakingTxHashes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.StakingTxHashesHex) > 0 {
+		for _, s := range m.StakingTxHashesHex {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveAllowedStakingTxHashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommissionRates == nil {
+				m.CommissionRates = &CommissionRates{}
+			}
+			if err := m.CommissionRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcPk == nil {
+				m.BtcPk = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetireFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetireFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetireFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
+			if m.BtcPk == nil {
+				m.BtcPk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetireFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetireFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetireFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+			}
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTx == nil {
+				m.StakingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInclusionProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingTxInclusionProof == nil {
+				m.StakingTxInclusionProof = &InclusionProof{}
+			}
+			if err := m.StakingTxInclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340Signature
+			m.DelegatorSlashingSig = &v
+			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+			}
+			m.UnbondingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSelectiveSlashingEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendBTCDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2528,15 +5439,15 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendBTCDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2564,13 +5475,13 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevStakingTxHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2580,33 +5491,101 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
+			m.PrevStakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
+			}
+			m.StakingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTx = append(m.StakingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakingTx == nil {
+				m.StakingTx = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInclusionProof", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2616,31 +5595,31 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StakingTxInclusionProof == nil {
+				m.StakingTxInclusionProof = &InclusionProof{}
+			}
+			if err := m.StakingTxInclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2667,17 +5646,17 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v BTCSlashingTx
+			m.SlashingTx = &v
+			if err := m.SlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2687,133 +5666,32 @@ func (m *MsgCreateFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossessionBTC{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonlabs_io_babylon_types.BIP340Signature
+			m.DelegatorSlashingSig = &v
+			if err := m.DelegatorSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateFinalityProviderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			var stringLen uint64
+			m.UnbondingTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2823,27 +5701,14 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UnbondingTime |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2870,16 +5735,35 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcPk = append(m.BtcPk[:0], dAtA[iNdEx:postIndex]...)
-			if m.BtcPk == nil {
-				m.BtcPk = []byte{}
+			m.UnbondingTx = append(m.UnbondingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.UnbondingTx == nil {
+				m.UnbondingTx = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
+			}
+			m.UnbondingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2889,33 +5773,32 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2925,25 +5808,24 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonlabs_io_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2968,7 +5850,7 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2991,10 +5873,10 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendBTCDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3018,7 +5900,7 @@ func (m *MsgEditFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegateBTCDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3041,49 +5923,17 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegateBTCDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegateBTCDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakerAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3093,33 +5943,29 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossessionBTC{}
-			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevStakingTxHash", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3129,28 +5975,25 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PrevStakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
 			}
@@ -3185,7 +6028,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
 			}
@@ -3204,7 +6047,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingValue", wireType)
 			}
@@ -3223,7 +6066,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingTx", wireType)
 			}
@@ -3257,7 +6100,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				m.StakingTx = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInclusionProof", wireType)
 			}
@@ -3293,7 +6136,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTx", wireType)
 			}
@@ -3328,7 +6171,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSig", wireType)
 			}
@@ -3363,7 +6206,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
@@ -3382,7 +6225,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 12:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTx", wireType)
 			}
@@ -3416,7 +6259,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				m.UnbondingTx = []byte{}
 			}
 			iNdEx = postIndex
-		case 13:
+		case 12:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
 			}
@@ -3435,7 +6278,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 14:
+		case 13:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
 			}
@@ -3470,7 +6313,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 14:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
 			}
@@ -3499,9 +6342,209 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340Signature
-			m.DelegatorUnbondingSlashingSig = &v
-			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonlabs_io_babylon_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedelegateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedelegateBTCDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedelegateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInclusionProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingTxInclusionProof == nil {
+				m.StakingTxInclusionProof = &InclusionProof{}
+			}
+			if err := m.StakingTxInclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3526,7 +6569,7 @@ func (m *MsgCreateBTCDelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3549,10 +6592,10 @@ func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3576,7 +6619,7 @@ func (m *MsgCreateBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3599,10 +6642,10 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3638,6 +6681,41 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
@@ -3669,11 +6747,11 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 			}
 			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInclusionProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3683,25 +6761,123 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StakingTxInclusionProof == nil {
-				m.StakingTxInclusionProof = &InclusionProof{}
+			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
 			}
-			if err := m.StakingTxInclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340Signature
+			m.UnbondingTxSig = &v
+			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransitionTxSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340Signature
+			m.TransitionTxSig = &v
+			if err := m.TransitionTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3726,7 +6902,7 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3749,10 +6925,10 @@ func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3776,7 +6952,7 @@ func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
+func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3799,10 +6975,10 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBTCUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBTCUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3838,41 +7014,6 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.Pk = &v
-			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
@@ -3904,41 +7045,9 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			}
 			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeSpendingTx", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3965,17 +7074,16 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340Signature
-			m.UnbondingTxSig = &v
-			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakeSpendingTx = append(m.StakeSpendingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.StakeSpendingTx == nil {
+				m.StakeSpendingTx = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeSpendingTxInclusionProof", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3985,23 +7093,27 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
+			if m.StakeSpendingTxInclusionProof == nil {
+				m.StakeSpendingTxInclusionProof = &InclusionProof{}
+			}
+			if err := m.StakeSpendingTxInclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4024,7 +7136,7 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4047,10 +7159,10 @@ func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBTCUndelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBTCUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4074,7 +7186,7 @@ func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4097,10 +7209,10 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBTCUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBTCUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4169,7 +7281,7 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeSpendingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4196,14 +7308,146 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakeSpendingTx = append(m.StakeSpendingTx[:0], dAtA[iNdEx:postIndex]...)
-			if m.StakeSpendingTx == nil {
-				m.StakeSpendingTx = []byte{}
+			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
+			if m.RecoveredFpBtcSk == nil {
+				m.RecoveredFpBtcSk = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeSpendingTxInclusionProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4230,10 +7474,7 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StakeSpendingTxInclusionProof == nil {
-				m.StakeSpendingTxInclusionProof = &InclusionProof{}
-			}
-			if err := m.StakeSpendingTxInclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4258,7 +7499,7 @@ func (m *MsgBTCUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4281,10 +7522,10 @@ func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBTCUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBTCUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4308,7 +7549,7 @@ func (m *MsgBTCUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAllowedStakingTxHashes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4331,15 +7572,15 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAllowedStakingTxHashes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAllowedStakingTxHashes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4367,11 +7608,11 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashesHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4399,41 +7640,7 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredFpBtcSk", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecoveredFpBtcSk = append(m.RecoveredFpBtcSk[:0], dAtA[iNdEx:postIndex]...)
-			if m.RecoveredFpBtcSk == nil {
-				m.RecoveredFpBtcSk = []byte{}
-			}
+			m.StakingTxHashesHex = append(m.StakingTxHashesHex, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4456,7 +7663,7 @@ func (m *MsgSelectiveSlashingEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddAllowedStakingTxHashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4479,10 +7686,10 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddAllowedStakingTxHashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSelectiveSlashingEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddAllowedStakingTxHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4506,7 +7713,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAllowedStakingTxHashes) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4529,10 +7736,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAllowedStakingTxHashes: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAllowedStakingTxHashes: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4569,9 +7776,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashesHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4581,24 +7788,23 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StakingTxHashesHex = append(m.StakingTxHashesHex, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4621,7 +7827,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveAllowedStakingTxHashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4644,10 +7850,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveAllowedStakingTxHashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveAllowedStakingTxHashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -22,16 +22,47 @@ func ValidateParsedMessageAgainstTheParams(
 	net *chaincfg.Params,
 ) (*ParamsValidationResult, error) {
 	// 1. Validate unbonding time first as it will be used in other checks
-	// Check unbonding time (staking time from unbonding tx) is not less than min unbonding time
+	if err := ValidateUnbondingTimeAgainstTheParams(pm, parameters); err != nil {
+		return nil, err
+	}
+
+	// 2. Validate all data related to staking tx
+	stakingOutputIdx, err := ValidateStakingTxAgainstTheParams(pm, parameters, net)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Validate all data related to unbonding tx
+	if err := ValidateUnbondingTxAgainstTheParams(pm, parameters, net, stakingOutputIdx); err != nil {
+		return nil, err
+	}
+
+	return &ParamsValidationResult{
+		StakingOutputIdx:   stakingOutputIdx,
+		UnbondingOutputIdx: 0, // unbonding output always has only 1 output
+	}, nil
+}
+
+// ValidateUnbondingTimeAgainstTheParams checks the unbonding time (staking
+// time from unbonding tx) is equal to the unbonding time in the parameters
+func ValidateUnbondingTimeAgainstTheParams(pm *ParsedCreateDelegationMessage, parameters *Params) error {
 	if uint32(pm.UnbondingTime) != parameters.UnbondingTimeBlocks {
-		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding time %d must be equal to %d",
+		return ErrInvalidUnbondingTx.Wrapf("unbonding time %d must be equal to %d",
 			pm.UnbondingTime, parameters.UnbondingTimeBlocks)
 	}
+	return nil
+}
 
-	stakingTxHash := pm.StakingTx.Transaction.TxHash()
+// ValidateStakingTxAgainstTheParams validates the staking tx of the parsed
+// message against parameters, and returns the index of the staking output
+func ValidateStakingTxAgainstTheParams(
+	pm *ParsedCreateDelegationMessage,
+	parameters *Params,
+	net *chaincfg.Params,
+) (uint32, error) {
 	covenantPks := parameters.MustGetCovenantPks()
 
-	// 2. Validate all data related to staking tx:
+	// Validate all data related to staking tx:
 	// - it has valid staking output
 	// - that staking time and value are correct
 	// - slashing tx is relevant to staking tx
@@ -46,18 +77,18 @@ func ValidateParsedMessageAgainstTheParams(
 		net,
 	)
 	if err != nil {
-		return nil, ErrInvalidStakingTx.Wrapf("failed to build staking info: %v", err)
+		return 0, ErrInvalidStakingTx.Wrapf("failed to build staking info: %v", err)
 	}
 
 	stakingOutputIdx, err := bbn.GetOutputIdxInBTCTx(pm.StakingTx.Transaction, stakingInfo.StakingOutput)
 
 	if err != nil {
-		return nil, ErrInvalidStakingTx.Wrap("staking tx does not contain expected staking output")
+		return 0, ErrInvalidStakingTx.Wrap("staking tx does not contain expected staking output")
 	}
 
 	if uint32(pm.StakingTime) < parameters.MinStakingTimeBlocks ||
 		uint32(pm.StakingTime) > parameters.MaxStakingTimeBlocks {
-		return nil, ErrInvalidStakingTx.Wrapf(
+		return 0, ErrInvalidStakingTx.Wrapf(
 			"staking time %d is out of bounds. Min: %d, Max: %d",
 			pm.StakingTime,
 			parameters.MinStakingTimeBlocks,
@@ -67,7 +98,7 @@ func ValidateParsedMessageAgainstTheParams(
 
 	if pm.StakingTx.Transaction.TxOut[stakingOutputIdx].Value < parameters.MinStakingValueSat ||
 		pm.StakingTx.Transaction.TxOut[stakingOutputIdx].Value > parameters.MaxStakingValueSat {
-		return nil, ErrInvalidStakingTx.Wrapf(
+		return 0, ErrInvalidStakingTx.Wrapf(
 			"staking value %d is out of bounds. Min: %d, Max: %d",
 			pm.StakingTx.Transaction.TxOut[stakingOutputIdx].Value,
 			parameters.MinStakingValueSat,
@@ -86,7 +117,7 @@ func ValidateParsedMessageAgainstTheParams(
 		pm.UnbondingTime,
 		net,
 	); err != nil {
-		return nil, ErrInvalidStakingTx.Wrap(err.Error())
+		return 0, ErrInvalidStakingTx.Wrap(err.Error())
 	}
 
 	slashingSpendInfo, err := stakingInfo.SlashingPathSpendInfo()
@@ -101,10 +132,24 @@ func ValidateParsedMessageAgainstTheParams(
 		pm.StakerPK.PublicKey,
 		pm.StakerStakingSlashingTxSig.BIP340Signature.MustMarshal(),
 	); err != nil {
-		return nil, ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
+		return 0, ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
 	}
 
-	// 3. Validate all data related to unbonding tx:
+	return stakingOutputIdx, nil
+}
+
+// ValidateUnbondingTxAgainstTheParams validates the unbonding tx of the
+// parsed message against parameters and the staking output it spends
+func ValidateUnbondingTxAgainstTheParams(
+	pm *ParsedCreateDelegationMessage,
+	parameters *Params,
+	net *chaincfg.Params,
+	stakingOutputIdx uint32,
+) error {
+	stakingTxHash := pm.StakingTx.Transaction.TxHash()
+	covenantPks := parameters.MustGetCovenantPks()
+
+	// 1. Validate all data related to unbonding tx:
 	// - it is valid BTC pre-signed transaction
 	// - it has valid unbonding output
 	// - slashing tx is relevant to unbonding tx
@@ -112,7 +157,7 @@ func ValidateParsedMessageAgainstTheParams(
 	if err := btcstaking.CheckPreSignedUnbondingTxSanity(
 		pm.UnbondingTx.Transaction,
 	); err != nil {
-		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding tx is not a valid pre-signed transaction: %v", err)
+		return ErrInvalidUnbondingTx.Wrapf("unbonding tx is not a valid pre-signed transaction: %v", err)
 	}
 
 	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
@@ -125,17 +170,17 @@ func ValidateParsedMessageAgainstTheParams(
 		net,
 	)
 	if err != nil {
-		return nil, ErrInvalidUnbondingTx.Wrapf("failed to build the unbonding info: %v", err)
+		return ErrInvalidUnbondingTx.Wrapf("failed to build the unbonding info: %v", err)
 	}
 
 	unbondingTx := pm.UnbondingTx.Transaction
 	if !bytes.Equal(unbondingTx.TxOut[0].PkScript, unbondingInfo.UnbondingOutput.PkScript) {
-		return nil, ErrInvalidUnbondingTx.
+		return ErrInvalidUnbondingTx.
 			Wrapf("the unbonding output script is not expected, expected: %x, got: %s",
 				unbondingInfo.UnbondingOutput.PkScript, unbondingTx.TxOut[0].PkScript)
 	}
 	if unbondingTx.TxOut[0].Value != unbondingInfo.UnbondingOutput.Value {
-		return nil, ErrInvalidUnbondingTx.
+		return ErrInvalidUnbondingTx.
 			Wrapf("the unbonding output value is not expected, expected: %d, got: %d",
 				unbondingInfo.UnbondingOutput.Value, unbondingTx.TxOut[0].Value)
 	}
@@ -152,7 +197,7 @@ func ValidateParsedMessageAgainstTheParams(
 		net,
 	)
 	if err != nil {
-		return nil, ErrInvalidUnbondingTx.Wrapf("err: %v", err)
+		return ErrInvalidUnbondingTx.Wrapf("err: %v", err)
 	}
 
 	unbondingSlashingSpendInfo, err := unbondingInfo.SlashingPathSpendInfo()
@@ -167,18 +212,18 @@ func ValidateParsedMessageAgainstTheParams(
 		pm.StakerPK.PublicKey,
 		pm.StakerUnbondingSlashingSig.BIP340Signature.MustMarshal(),
 	); err != nil {
-		return nil, ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
+		return ErrInvalidSlashingTx.Wrapf("invalid delegator signature: %v", err)
 	}
 
-	// 4. Check that unbonding tx input is pointing to staking tx
+	// 2. Check that unbonding tx input is pointing to staking tx
 	if !pm.UnbondingTx.Transaction.TxIn[0].PreviousOutPoint.Hash.IsEqual(&stakingTxHash) {
-		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding transaction must spend staking output")
+		return ErrInvalidUnbondingTx.Wrapf("unbonding transaction must spend staking output")
 	}
 
 	if pm.UnbondingTx.Transaction.TxIn[0].PreviousOutPoint.Index != stakingOutputIdx {
-		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding transaction input must spend staking output")
+		return ErrInvalidUnbondingTx.Wrapf("unbonding transaction input must spend staking output")
 	}
-	// 5. Check unbonding tx fees against staking tx.
+	// 3. Check unbonding tx fees against staking tx.
 	// - fee is larger than 0
 	// - ubonding output value is at least `MinUnbondingValue` percent of staking output value
 	if pm.UnbondingTx.Transaction.TxOut[0].Value >= pm.StakingTx.Transaction.TxOut[stakingOutputIdx].Value {
@@ -187,18 +232,15 @@ func ValidateParsedMessageAgainstTheParams(
 		// burden on staker to choose right fee.
 		// Unbonding tx should not be replaceable at babylon level (and by extension on btc level), as this would
 		// allow staker to spam the network with unbonding txs, which would force covenant and finality provider to send signatures.
-		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding tx fee must be larger that 0")
+		return ErrInvalidUnbondingTx.Wrapf("unbonding tx fee must be larger that 0")
 	}
 
-	// 6. Check that unbonding tx fee is as expected.
+	// 4. Check that unbonding tx fee is as expected.
 	unbondingTxFee := pm.StakingTx.Transaction.TxOut[stakingOutputIdx].Value - pm.UnbondingTx.Transaction.TxOut[0].Value
 
 	if unbondingTxFee != parameters.UnbondingFeeSat {
-		return nil, ErrInvalidUnbondingTx.Wrapf("unbonding tx fee must be %d, but got %d", parameters.UnbondingFeeSat, unbondingTxFee)
+		return ErrInvalidUnbondingTx.Wrapf("unbonding tx fee must be %d, but got %d", parameters.UnbondingFeeSat, unbondingTxFee)
 	}
 
-	return &ParamsValidationResult{
-		StakingOutputIdx:   stakingOutputIdx,
-		UnbondingOutputIdx: 0, // unbonding output always has only 1 output
-	}, nil
+	return nil
 }
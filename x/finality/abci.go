@@ -41,6 +41,8 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 			k.HandleLiveness(ctx, heightToExamine)
 
 			k.HandleRewarding(ctx, heightToExamine)
+
+			k.HandlePruning(ctx, heightToExamine)
 		}
 	}
 
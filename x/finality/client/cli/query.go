@@ -1,10 +1,13 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
@@ -15,6 +18,9 @@ import (
 const (
 	flagQueriedBlockStatus = "queried-block-status"
 	flagStartHeight        = "start-height"
+	flagFromEpoch          = "from-epoch"
+	flagToEpoch            = "to-epoch"
+	flagDeposit            = "deposit"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -39,8 +45,12 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdListBlocks(),
 		CmdVotesAtHeight(),
 		CmdListEvidences(),
+		CmdSlashingTxs(),
 		CmdSigningInfo(),
 		CmdAllSigningInfo(),
+		CmdFinalityProviderPerformance(),
+		CmdFinalityStatus(),
+		CmdDraftResumeFinalityProposal(),
 	)
 
 	return cmd
@@ -345,6 +355,39 @@ func CmdListEvidences() *cobra.Command {
 	return cmd
 }
 
+func CmdSlashingTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slashing-txs [fp-pk-hex]",
+		Short: "retrieve the fully signed slashing txs of the BTC delegations restaked to a slashed finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SlashingTxs(cmd.Context(), &types.QuerySlashingTxsRequest{
+				FpBtcPkHex: args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "slashing-txs")
+
+	return cmd
+}
+
 func CmdSigningInfo() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "signing-info [fp-pk-hex]",
@@ -408,3 +451,150 @@ func CmdAllSigningInfo() *cobra.Command {
 
 	return cmd
 }
+
+func CmdFinalityProviderPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fp-performance [fp_btc_pk_hex]",
+		Short: "show the lifetime and per-epoch voting performance of a given finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			fromEpoch, err := cmd.Flags().GetUint64(flagFromEpoch)
+			if err != nil {
+				return err
+			}
+			toEpoch, err := cmd.Flags().GetUint64(flagToEpoch)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProviderPerformance(cmd.Context(), &types.QueryFinalityProviderPerformanceRequest{
+				FpBtcPkHex: args[0],
+				FromEpoch:  fromEpoch,
+				ToEpoch:    toEpoch,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fp-performance")
+	cmd.Flags().Uint64(flagFromEpoch, 0, "First epoch of the per-epoch performance rollups (inclusive)")
+	cmd.Flags().Uint64(flagToEpoch, 0, "Last epoch of the per-epoch performance rollups (inclusive), 0 for no upper bound")
+
+	return cmd
+}
+
+func CmdFinalityStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-status",
+		Short: "show whether finality is stalled and the voting power missing at the earliest non-finalized block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityStatus(cmd.Context(), &types.QueryFinalityStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// resumeFinalityProposal is the draft of a governance proposal in the format
+// accepted by `tx gov submit-proposal`
+type resumeFinalityProposal struct {
+	Messages  []json.RawMessage `json:"messages"`
+	Metadata  string            `json:"metadata"`
+	Deposit   string            `json:"deposit"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
+func CmdDraftResumeFinalityProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "draft-resume-finality-proposal",
+		Short: "draft a governance proposal that resumes the stalled finality by jailing the finality providers that have not voted",
+		Long: `Draft a governance proposal that resumes the stalled finality by jailing the finality providers that have not voted.
+The drafted proposal is printed and can be submitted via "tx gov submit-proposal" after being reviewed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityStatus(cmd.Context(), &types.QueryFinalityStatusRequest{})
+			if err != nil {
+				return err
+			}
+			if !res.Stalled {
+				return fmt.Errorf("finality is not stalled")
+			}
+			if len(res.MissingFps) == 0 {
+				return fmt.Errorf("finality is stalled at height %d, but all the finality providers have voted", res.StallHeight)
+			}
+
+			deposit, err := cmd.Flags().GetString(flagDeposit)
+			if err != nil {
+				return err
+			}
+
+			fpPksHex := make([]string, 0, len(res.MissingFps))
+			for _, fp := range res.MissingFps {
+				fpPksHex = append(fpPksHex, fp.FpBtcPkHex)
+			}
+			msg := &types.MsgResumeFinalityProposal{
+				Authority:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				FpPksHex:      fpPksHex,
+				HaltingHeight: uint32(res.StallHeight),
+			}
+			msgJSON, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+			if err != nil {
+				return err
+			}
+
+			proposal := resumeFinalityProposal{
+				Messages: []json.RawMessage{msgJSON},
+				Deposit:  deposit,
+				Title:    fmt.Sprintf("Resume finality stalled at height %d", res.StallHeight),
+				Summary: fmt.Sprintf(
+					"Finality has been stalled at height %d for %d blocks. Jail %d finality providers that have not voted, "+
+						"holding %d out of %d voting power, to resume finality.",
+					res.StallHeight, res.StalledBlocks, len(fpPksHex), res.MissingVotingPower, res.TotalVotingPower,
+				),
+			}
+			proposalJSON, err := json.MarshalIndent(proposal, "", " ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(proposalJSON)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagDeposit, "", "Deposit of the drafted proposal, e.g., 10000000ubbn")
+
+	return cmd
+}
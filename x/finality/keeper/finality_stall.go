@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/finality/types"
+)
+
+// HandleFinalityStall detects whether no block has been finalized for
+// `finality_stall_threshold` blocks. Upon detecting a new stall, it emits an
+// event listing the finality providers that have not voted for the earliest
+// non-finalized block, which is the input to `MsgResumeFinalityProposal`
+func (k Keeper) HandleFinalityStall(ctx context.Context) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	threshold := k.GetParams(ctx).FinalityStallThreshold
+	if threshold == 0 || !k.IsFinalityActive(ctx) {
+		return
+	}
+
+	currentHeight := uint64(sdkCtx.HeaderInfo().Height)
+	detectedStallHeight := k.getDetectedStallHeight(ctx)
+	stallHeight, pending := k.getStallHeight(ctx)
+	if !pending || currentHeight-stallHeight < threshold {
+		if detectedStallHeight != 0 {
+			// the detected stall is over
+			k.removeDetectedStallHeight(ctx)
+			k.Logger(sdkCtx).Info(
+				"finality resumed",
+				"stall_height", detectedStallHeight,
+				"current_height", currentHeight,
+			)
+		}
+		return
+	}
+
+	if detectedStallHeight == stallHeight {
+		// the stall at this height has already been reported
+		return
+	}
+
+	missingFps, totalPower, missingPower := k.getMissingFinalityProviders(ctx, stallHeight)
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		types.NewEventFinalityStalled(stallHeight, currentHeight, totalPower, missingPower, missingFps),
+	); err != nil {
+		panic(err)
+	}
+	k.setDetectedStallHeight(ctx, stallHeight)
+
+	k.Logger(sdkCtx).Error(
+		"finality stalled",
+		"stall_height", stallHeight,
+		"current_height", currentHeight,
+		"total_voting_power", totalPower,
+		"missing_voting_power", missingPower,
+		"num_missing_fps", len(missingFps),
+	)
+}
+
+// GetFinalityStatus returns whether finality is stalled, together with the
+// breakdown of the voting power missing at the earliest non-finalized block
+func (k Keeper) GetFinalityStatus(ctx context.Context) *types.QueryFinalityStatusResponse {
+	status := &types.QueryFinalityStatusResponse{}
+	if !k.IsFinalityActive(ctx) {
+		return status
+	}
+
+	stallHeight, pending := k.getStallHeight(ctx)
+	if !pending {
+		return status
+	}
+
+	missingFps, totalPower, missingPower := k.getMissingFinalityProviders(ctx, stallHeight)
+	threshold := k.GetParams(ctx).FinalityStallThreshold
+
+	status.StallHeight = stallHeight
+	status.StalledBlocks = uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height) - stallHeight
+	status.Stalled = threshold > 0 && status.StalledBlocks >= threshold
+	status.TotalVotingPower = totalPower
+	status.VotedPower = totalPower - missingPower
+	status.MissingVotingPower = missingPower
+	status.MissingFps = missingFps
+
+	return status
+}
+
+// getStallHeight returns the height of the earliest non-finalized block that
+// has a finality provider set, and whether such a block exists
+func (k Keeper) getStallHeight(ctx context.Context) (uint64, bool) {
+	activatedHeight, err := k.GetBTCStakingActivatedHeight(ctx)
+	if err != nil {
+		return 0, false
+	}
+
+	// same as TallyBlocks, blocks are finalized since max(activatedHeight, nextHeightToFinalize)
+	stallHeight := k.getNextHeightToFinalize(ctx)
+	if stallHeight < activatedHeight {
+		stallHeight = activatedHeight
+	}
+
+	// the block is not indexed yet, i.e., all the indexed blocks are
+	// either finalized or non-finalizable
+	if stallHeight > uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height) {
+		return 0, false
+	}
+	if _, err := k.GetBlock(ctx, stallHeight); err != nil {
+		return 0, false
+	}
+	if !k.HasVotingPowerTable(ctx, stallHeight) {
+		return 0, false
+	}
+
+	return stallHeight, true
+}
+
+// getMissingFinalityProviders returns the finality providers with voting power
+// that have not voted for the block at the given height, ordered by voting power
+// in descending order, together with the total and the missing voting power
+func (k Keeper) getMissingFinalityProviders(ctx context.Context, height uint64) ([]types.FinalityProviderMissingPower, uint64, uint64) {
+	totalPower := uint64(0)
+	missingPower := uint64(0)
+	missingFps := []types.FinalityProviderMissingPower{}
+	for _, fp := range k.GetVotingPowerTableOrdered(ctx, height) {
+		if fp.VotingPower == 0 {
+			continue
+		}
+		totalPower += fp.VotingPower
+		if k.HasSig(ctx, height, fp.FpPk) {
+			continue
+		}
+		missingPower += fp.VotingPower
+		missingFps = append(missingFps, types.FinalityProviderMissingPower{
+			FpBtcPkHex:  fp.FpPk.MarshalHex(),
+			VotingPower: fp.VotingPower,
+		})
+	}
+
+	// sort deterministically by voting power and then by BTC PK
+	sort.SliceStable(missingFps, func(i, j int) bool {
+		if missingFps[i].VotingPower != missingFps[j].VotingPower {
+			return missingFps[i].VotingPower > missingFps[j].VotingPower
+		}
+		return missingFps[i].FpBtcPkHex < missingFps[j].FpBtcPkHex
+	})
+
+	return missingFps, totalPower, missingPower
+}
+
+// getDetectedStallHeight gets the height of the last detected finality stall
+// that is not over yet. Zero means there is no ongoing stall
+func (k Keeper) getDetectedStallHeight(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.FinalityStallHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setDetectedStallHeight records the height of the detected finality stall
+func (k Keeper) setDetectedStallHeight(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.FinalityStallHeightKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
+
+// removeDetectedStallHeight removes the record of the detected finality stall
+func (k Keeper) removeDetectedStallHeight(ctx context.Context) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.FinalityStallHeightKey); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/finality/types"
+)
+
+func FuzzHandleFinalityStall(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper)
+
+		threshold := datagen.RandomInt(r, 10) + 1
+		params := fKeeper.GetParams(ctx)
+		params.FinalityStallThreshold = threshold
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// index a list of blocks where the blocks before a random height have
+		// QCs while the blocks since this height do not have QCs
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		numBlocks := datagen.RandomInt(r, 10) + 1
+		stallHeight := activatedHeight + datagen.RandomInt(r, int(numBlocks))
+		curHeight := activatedHeight + numBlocks - 1
+		for i := activatedHeight; i <= curHeight; i++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:    i,
+				AppHash:   datagen.GenRandomByteArray(r, 32),
+				Finalized: false,
+			})
+			if i >= stallHeight {
+				err := giveNoQCToHeight(r, ctx, fKeeper, i)
+				require.NoError(t, err)
+			} else {
+				err := giveQCToHeight(r, ctx, fKeeper, i)
+				require.NoError(t, err)
+			}
+		}
+		ctx = datagen.WithCtxHeight(ctx, curHeight)
+		fKeeper.TallyBlocks(ctx)
+
+		// the status reports the stall height and the missing voting power
+		// of the 3 finality providers that have not voted
+		res, err := fKeeper.FinalityStatus(ctx, &types.QueryFinalityStatusRequest{})
+		require.NoError(t, err)
+		require.Equal(t, stallHeight, res.StallHeight)
+		require.Equal(t, curHeight-stallHeight, res.StalledBlocks)
+		require.Equal(t, curHeight-stallHeight >= threshold, res.Stalled)
+		require.Equal(t, uint64(4), res.TotalVotingPower)
+		require.Equal(t, uint64(1), res.VotedPower)
+		require.Equal(t, uint64(3), res.MissingVotingPower)
+		require.Len(t, res.MissingFps, 3)
+
+		// no stall event is emitted before reaching the threshold
+		ctx = datagen.WithCtxHeight(ctx, stallHeight+threshold-1)
+		fKeeper.HandleFinalityStall(ctx)
+		require.Empty(t, ctx.EventManager().Events())
+
+		// the stall event is emitted once the threshold is reached
+		ctx = datagen.WithCtxHeight(ctx, stallHeight+threshold)
+		fKeeper.HandleFinalityStall(ctx)
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, "babylon.finality.v1.EventFinalityStalled", events[0].Type)
+
+		// the stall event is only emitted once for the same stall
+		ctx = datagen.WithCtxHeight(ctx, stallHeight+threshold+1)
+		fKeeper.HandleFinalityStall(ctx)
+		require.Len(t, ctx.EventManager().Events(), 1)
+
+		// the missing finality providers vote, and the stall is over
+		for i := stallHeight; i <= curHeight; i++ {
+			for _, fp := range fKeeper.GetVotingPowerTableOrdered(ctx, i) {
+				if fKeeper.HasSig(ctx, i, fp.FpPk) {
+					continue
+				}
+				sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, i, fp.FpPk, sig)
+			}
+		}
+		ctx = datagen.WithCtxHeight(ctx, curHeight)
+		fKeeper.TallyBlocks(ctx)
+		fKeeper.HandleFinalityStall(ctx)
+		require.Len(t, ctx.EventManager().Events(), 1)
+
+		res, err = fKeeper.FinalityStatus(ctx, &types.QueryFinalityStatusRequest{})
+		require.NoError(t, err)
+		require.False(t, res.Stalled)
+		require.Zero(t, res.StallHeight)
+		require.Empty(t, res.MissingFps)
+	})
+}
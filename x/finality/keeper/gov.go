@@ -41,17 +41,24 @@ func (k Keeper) HandleResumeFinalityProposal(ctx sdk.Context, fpPksHex []string,
 			return fmt.Errorf("the finality provider %s has voted for height %d", fpPkHex, haltingHeight)
 		}
 
-		err = k.jailSluggishFinalityProvider(ctx, fpPk)
-		if err != nil && !errors.Is(err, bstypes.ErrFpAlreadyJailed) {
-			return fmt.Errorf("failed to jail the finality provider %s: %w", fpPkHex, err)
-		}
-
 		// update signing info
 		signInfo, err := k.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
 		if err != nil {
 			return fmt.Errorf("the signing info of finality provider %s is not created: %w", fpPkHex, err)
 		}
-		signInfo.JailedUntil = currentTime.Add(params.JailDuration)
+
+		err = k.jailSluggishFinalityProvider(ctx, fpPk, &signInfo)
+		switch {
+		case errors.Is(err, bstypes.ErrFpAlreadyJailed):
+			// the jailing is already recorded, only extend the jailing period
+			// without shortening an escalated one
+			jailedUntil := currentTime.Add(params.JailDurationForCount(signInfo.JailCount))
+			if jailedUntil.After(signInfo.JailedUntil) {
+				signInfo.JailedUntil = jailedUntil
+			}
+		case err != nil:
+			return fmt.Errorf("failed to jail the finality provider %s: %w", fpPkHex, err)
+		}
 		signInfo.MissedBlocksCounter = 0
 		if err := k.DeleteMissedBlockBitmap(ctx, fpPk); err != nil {
 			return fmt.Errorf("failed to remove the missed block bit map for finality provider %s: %w", fpPkHex, err)
@@ -12,6 +12,7 @@ import (
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
 	bbntypes "github.com/babylonlabs-io/babylon/types"
+	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	"github.com/babylonlabs-io/babylon/x/finality/keeper"
 	"github.com/babylonlabs-io/babylon/x/finality/types"
 )
@@ -24,6 +25,7 @@ func TestHandleResumeFinalityProposal(t *testing.T) {
 	bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
 	iKeeper := types.NewMockIncentiveKeeper(ctrl)
 	cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	cKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).Return(uint64(1)).AnyTimes()
 	fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper)
 
 	haltingHeight := uint64(100)
@@ -78,6 +80,73 @@ func TestHandleResumeFinalityProposal(t *testing.T) {
 	}
 }
 
+func TestHandleResumeFinalityProposalExtendsJailing(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+	iKeeper := types.NewMockIncentiveKeeper(ctrl)
+	cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper)
+
+	params := types.DefaultParams()
+	params.JailDurationMultiplier = 2
+	require.NoError(t, fKeeper.SetParams(ctx, params))
+
+	haltingHeight := uint64(100)
+	ctx = datagen.WithCtxHeight(ctx, haltingHeight)
+	currentTime := ctx.HeaderInfo().Time
+
+	// the finality providers are already jailed for the third time
+	fpPks := generateNFpPks(t, r, 2)
+	setupActiveFps(t, fpPks, haltingHeight, fKeeper, ctx)
+	escalatedDuration := params.JailDurationForCount(3)
+	jailedUntil := []time.Time{
+		// the escalated jailing outlasts the proposal
+		currentTime.Add(escalatedDuration + time.Hour),
+		// the escalated jailing is about to end
+		currentTime.Add(time.Minute),
+	}
+	for i, fpPk := range fpPks {
+		signInfo, err := fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
+		require.NoError(t, err)
+		signInfo.JailCount = 3
+		signInfo.JailedUntil = jailedUntil[i]
+		require.NoError(t, fKeeper.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), signInfo))
+	}
+	fKeeper.SetBlock(ctx, &types.IndexedBlock{
+		Height:  haltingHeight,
+		AppHash: datagen.GenRandomByteArray(r, 32),
+	})
+	dc := types.NewVotingPowerDistCache()
+	for i := range fpPks {
+		fKeeper.SetVotingPower(ctx, fpPks[i].MustMarshal(), haltingHeight, 1)
+		dc.AddFinalityProviderDistInfo(&types.FinalityProviderDistInfo{
+			BtcPk:          &fpPks[i],
+			TotalBondedSat: 1,
+			IsTimestamped:  true,
+		})
+	}
+	dc.ApplyActiveFinalityProviders(uint32(len(fpPks)))
+	fKeeper.SetVotingPowerDistCache(ctx, haltingHeight, dc)
+
+	bsKeeper.EXPECT().JailFinalityProvider(ctx, gomock.Any()).Return(bstypes.ErrFpAlreadyJailed).Times(len(fpPks))
+	iKeeper.EXPECT().RewardBTCStaking(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return().AnyTimes()
+	err := fKeeper.HandleResumeFinalityProposal(ctx, publicKeysToHex(fpPks), uint32(haltingHeight))
+	require.NoError(t, err)
+
+	// the jailing periods are never shortened, and are extended by the
+	// escalated jail duration
+	expectedJailedUntil := []time.Time{jailedUntil[0], currentTime.Add(escalatedDuration)}
+	for i, fpPk := range fpPks {
+		signInfo, err := fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
+		require.NoError(t, err)
+		require.Equal(t, expectedJailedUntil[i], signInfo.JailedUntil)
+		require.Equal(t, uint32(3), signInfo.JailCount)
+	}
+}
+
 func generateNFpPks(t *testing.T, r *rand.Rand, n int) []bbntypes.BIP340PubKey {
 	fpPks := make([]bbntypes.BIP340PubKey, 0, n)
 	for i := 0; i < n; i++ {
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -35,6 +37,10 @@ func (k Keeper) FinalityProviderPowerAtHeight(ctx context.Context, req *types.Qu
 		return nil, bstypes.ErrFpNotFound
 	}
 
+	if k.IsHeightPruned(ctx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
+
 	store := k.votingPowerBbnBlockHeightStore(ctx, req.Height)
 	iter := store.ReverseIterator(nil, nil)
 	defer iter.Close()
@@ -73,6 +79,10 @@ func (k Keeper) ActiveFinalityProvidersAtHeight(ctx context.Context, req *types.
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsHeightPruned(sdkCtx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
+
 	store := k.votingPowerBbnBlockHeightStore(sdkCtx, req.Height)
 
 	var finalityProvidersWithMeta []*bstypes.FinalityProviderWithMeta
@@ -241,6 +251,9 @@ func (k Keeper) VotesAtHeight(ctx context.Context, req *types.QueryVotesAtHeight
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsHeightPruned(sdkCtx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
 
 	// get the sig set of babylon block at given height
 	btcPks := []bbn.BIP340PubKey{}
@@ -325,6 +338,47 @@ func (k Keeper) ListEvidences(ctx context.Context, req *types.QueryListEvidences
 	return resp, nil
 }
 
+// SlashingTxs returns the fully signed slashing txs of the active and
+// unbonding BTC delegations restaked to a slashed finality provider
+func (k Keeper) SlashingTxs(ctx context.Context, req *types.QuerySlashingTxsRequest) (*types.QuerySlashingTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	// the BTC SK of the finality provider is extracted from its evidence
+	evidence := k.GetFirstSlashableEvidence(ctx, fpBTCPK)
+	if evidence == nil {
+		return nil, types.ErrNoSlashableEvidence
+	}
+	fpSK, err := evidence.ExtractBTCSK()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to extract BTC SK from the evidence: %v", err)
+	}
+
+	slashingTxs, pageRes, err := k.BTCStakingKeeper.BuildSlashingTxsWithWitness(ctx, fpSK, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	slashingTxsResp := make([]*types.BTCDelegationSlashingTxs, len(slashingTxs))
+	for i, delSlashingTxs := range slashingTxs {
+		slashingTxsResp[i], err = convertToSlashingTxsResponse(delSlashingTxs)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &types.QuerySlashingTxsResponse{
+		SlashingTxs: slashingTxsResp,
+		Pagination:  pageRes,
+	}, nil
+}
+
 // SigningInfo returns signing-info of a specific finality provider.
 func (k Keeper) SigningInfo(ctx context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
 	if req == nil {
@@ -373,12 +427,73 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	return &types.QuerySigningInfosResponse{SigningInfos: convertToSigningInfosResponse(signInfos), Pagination: pageRes}, nil
 }
 
+// FinalityProviderPerformance returns the lifetime performance of a finality provider
+// together with its per-epoch rollups within the given epoch range
+func (k Keeper) FinalityProviderPerformance(ctx context.Context, req *types.QueryFinalityProviderPerformanceRequest) (*types.QueryFinalityProviderPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal finality provider BTC PK hex: %v", err)
+	}
+
+	if req.ToEpoch != 0 && req.FromEpoch > req.ToEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "from epoch %d is larger than to epoch %d", req.FromEpoch, req.ToEpoch)
+	}
+
+	total, err := k.GetFinalityProviderPerformance(ctx, fpBTCPK)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	epochs, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.FinalityProviderEpochPerformance,
+		req.Pagination,
+		func(key collections.Pair[[]byte, uint64], _ types.FinalityProviderPerformance) (bool, error) {
+			epoch := key.K2()
+			return epoch >= req.FromEpoch && (req.ToEpoch == 0 || epoch <= req.ToEpoch), nil
+		},
+		func(key collections.Pair[[]byte, uint64], perf types.FinalityProviderPerformance) (types.EpochFinalityProviderPerformance, error) {
+			return types.EpochFinalityProviderPerformance{
+				EpochNum:    key.K2(),
+				Performance: perf,
+			}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](fpBTCPK.MustMarshal()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFinalityProviderPerformanceResponse{
+		Total:      total,
+		Epochs:     epochs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FinalityStatus returns whether finality is stalled, together with the breakdown
+// of the voting power missing at the earliest non-finalized block
+func (k Keeper) FinalityStatus(ctx context.Context, req *types.QueryFinalityStatusRequest) (*types.QueryFinalityStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return k.GetFinalityStatus(ctx), nil
+}
+
 func convertToSigningInfoResponse(info types.FinalityProviderSigningInfo) types.SigningInfoResponse {
 	return types.SigningInfoResponse{
 		FpBtcPkHex:          info.FpBtcPk.MarshalHex(),
 		StartHeight:         info.StartHeight,
 		MissedBlocksCounter: info.MissedBlocksCounter,
 		JailedUntil:         info.JailedUntil,
+		JailHistory:         info.JailHistory,
+		Tombstoned:          info.Tombstoned,
+		JailCount:           info.JailCount,
 	}
 }
 
@@ -390,6 +505,28 @@ func convertToSigningInfosResponse(signInfos []types.FinalityProviderSigningInfo
 	return response
 }
 
+func convertToSlashingTxsResponse(slashingTxs *bstypes.BTCDelegationSlashingTxs) (*types.BTCDelegationSlashingTxs, error) {
+	resp := &types.BTCDelegationSlashingTxs{
+		StakingTxHash: slashingTxs.StakingTxHash,
+		Status:        slashingTxs.Status.String(),
+	}
+	if slashingTxs.SlashingTx != nil {
+		slashingTxBytes, err := bbn.SerializeBTCTx(slashingTxs.SlashingTx)
+		if err != nil {
+			return nil, err
+		}
+		resp.SlashingTxHex = hex.EncodeToString(slashingTxBytes)
+	}
+	if slashingTxs.UnbondingSlashingTx != nil {
+		unbondingSlashingTxBytes, err := bbn.SerializeBTCTx(slashingTxs.UnbondingSlashingTx)
+		if err != nil {
+			return nil, err
+		}
+		resp.UnbondingSlashingTxHex = hex.EncodeToString(unbondingSlashingTxBytes)
+	}
+	return resp, nil
+}
+
 func convertToEvidenceResponse(evidence *types.Evidence) *types.EvidenceResponse {
 	return &types.EvidenceResponse{
 		FpBtcPkHex:           evidence.FpBtcPk.MarshalHex(),
@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -502,6 +503,64 @@ func FuzzQueryEvidence(f *testing.F) {
 	})
 }
 
+func FuzzSlashingTxs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		keeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil, nil)
+		ctx = sdk.UnwrapSDKContext(ctx)
+
+		// set random BTC SK PK
+		sk, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		bip340PK := bbn.NewBIP340PubKeyFromBTCPK(sk.PubKey())
+
+		// the slashing txs cannot be queried without a slashable evidence
+		_, err = keeper.SlashingTxs(ctx, &types.QuerySlashingTxsRequest{FpBtcPkHex: bip340PK.MarshalHex()})
+		require.ErrorIs(t, err, types.ErrNoSlashableEvidence)
+
+		evidence, err := datagen.GenRandomEvidence(r, sk, datagen.RandomInt(r, 100)+1)
+		require.NoError(t, err)
+		keeper.SetEvidence(ctx, evidence)
+
+		// the BTC staking module builds the slashing txs with the BTC SK
+		// extracted from the evidence
+		stakingTxHash := datagen.GenRandomHexStr(r, 32)
+		slashingTx := datagen.CreateDummyTx()
+		unbondingSlashingTx := datagen.CreateDummyTx()
+		bsKeeper.EXPECT().BuildSlashingTxsWithWitness(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, fpSK *btcec.PrivateKey, _ *query.PageRequest) ([]*bstypes.BTCDelegationSlashingTxs, *query.PageResponse, error) {
+				require.True(t, bip340PK.Equals(bbn.NewBIP340PubKeyFromBTCPK(fpSK.PubKey())))
+				return []*bstypes.BTCDelegationSlashingTxs{
+					{
+						StakingTxHash:       stakingTxHash,
+						Status:              bstypes.BTCDelegationStatus_ACTIVE,
+						SlashingTx:          slashingTx,
+						UnbondingSlashingTx: unbondingSlashingTx,
+					},
+				}, &query.PageResponse{}, nil
+			},
+		)
+
+		resp, err := keeper.SlashingTxs(ctx, &types.QuerySlashingTxsRequest{FpBtcPkHex: bip340PK.MarshalHex()})
+		require.NoError(t, err)
+		require.Len(t, resp.SlashingTxs, 1)
+		require.Equal(t, stakingTxHash, resp.SlashingTxs[0].StakingTxHash)
+		require.Equal(t, bstypes.BTCDelegationStatus_ACTIVE.String(), resp.SlashingTxs[0].Status)
+		actualSlashingTx, _, err := bbn.NewBTCTxFromHex(resp.SlashingTxs[0].SlashingTxHex)
+		require.NoError(t, err)
+		require.Equal(t, slashingTx.TxHash(), actualSlashingTx.TxHash())
+		actualUnbondingSlashingTx, _, err := bbn.NewBTCTxFromHex(resp.SlashingTxs[0].UnbondingSlashingTxHex)
+		require.NoError(t, err)
+		require.Equal(t, unbondingSlashingTx.TxHash(), actualUnbondingSlashingTx.TxHash())
+	})
+}
+
 func FuzzListEvidences(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
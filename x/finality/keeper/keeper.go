@@ -29,6 +29,10 @@ type (
 		FinalityProviderSigningTracker collections.Map[[]byte, types.FinalityProviderSigningInfo]
 		// FinalityProviderMissedBlockBitmap key: BIP340PubKey bytes | value: byte key for a finality provider's missed block bitmap chunk
 		FinalityProviderMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+		// FinalityProviderPerformanceTracker key: BIP340PubKey bytes | value: lifetime FinalityProviderPerformance
+		FinalityProviderPerformanceTracker collections.Map[[]byte, types.FinalityProviderPerformance]
+		// FinalityProviderEpochPerformance key: (BIP340PubKey bytes, epoch number) | value: FinalityProviderPerformance within the epoch
+		FinalityProviderEpochPerformance collections.Map[collections.Pair[[]byte, uint64], types.FinalityProviderPerformance]
 	}
 )
 
@@ -63,6 +67,20 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		FinalityProviderPerformanceTracker: collections.NewMap(
+			sb,
+			types.FinalityProviderPerformanceKeyPrefix,
+			"finality_provider_performance",
+			collections.BytesKey,
+			codec.CollValue[types.FinalityProviderPerformance](cdc),
+		),
+		FinalityProviderEpochPerformance: collections.NewMap(
+			sb,
+			types.FinalityProviderEpochPerformanceKeyPrefix,
+			"finality_provider_epoch_performance",
+			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
+			codec.CollValue[types.FinalityProviderPerformance](cdc),
+		),
 	}
 }
 
@@ -70,6 +88,9 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 	// update voting power distribution
 	k.UpdatePowerDist(ctx)
 
+	// detect whether finality is stalled
+	k.HandleFinalityStall(ctx)
+
 	return nil
 }
 
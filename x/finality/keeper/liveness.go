@@ -17,6 +17,8 @@ func (k Keeper) HandleLiveness(ctx context.Context, height int64) {
 	vpTableOrdered := k.GetVotingPowerTableOrdered(ctx, uint64(height))
 	// get all the voters for the height
 	voterBTCPKs := k.GetVoters(ctx, uint64(height))
+	// get the epoch of the height for recording the performance of finality providers
+	epoch := k.CheckpointingKeeper.GetEpochByHeight(ctx, uint64(height))
 
 	// Iterate over all the finality providers which *should* have signed this block
 	// store whether or not they have actually signed it, identify sluggish
@@ -27,6 +29,10 @@ func (k Keeper) HandleLiveness(ctx context.Context, height int64) {
 		_, ok := voterBTCPKs[fpPkHex]
 		missed := !ok
 
+		if err := k.recordFinalityProviderVote(ctx, fpWithVp.FpPk, epoch, missed); err != nil {
+			panic(fmt.Errorf("failed to record the vote of finality provider %s: %w", fpPkHex, err))
+		}
+
 		err := k.HandleFinalityProviderLiveness(ctx, fpWithVp.FpPk, missed, height)
 		if err != nil {
 			panic(fmt.Errorf("failed to handle liveness of finality provider %s: %w", fpPkHex, err))
@@ -58,6 +64,21 @@ func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.
 		return nil
 	}
 
+	// don't update missed blocks when finality provider has retired and has
+	// left the active set at the given height, as it is released from voting
+	// after its exit
+	if fp.IsRetired() {
+		if vp := k.GetVotingPower(ctx, fpPk.MustMarshal(), uint64(height)); vp == 0 {
+			k.Logger(sdkCtx).Debug(
+				"skip handling liveness of exited finality provider",
+				"height", height,
+				"public_key", fpPk.MarshalHex(),
+				"retired_babylon_height", fp.RetiredBabylonHeight,
+			)
+			return nil
+		}
+	}
+
 	updated, signInfo, err := k.updateSigningInfo(ctx, fpPk, missed, height)
 	if err != nil {
 		return err
@@ -84,11 +105,10 @@ func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.
 	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
 		updated = true
 
-		if err := k.jailSluggishFinalityProvider(ctx, fpPk); err != nil {
+		if err := k.jailSluggishFinalityProvider(ctx, fpPk, signInfo); err != nil {
 			return fmt.Errorf("failed to jail sluggish finality provider %s: %w", fpPk.MarshalHex(), err)
 		}
 
-		signInfo.JailedUntil = sdkCtx.HeaderInfo().Time.Add(params.JailDuration)
 		// we need to reset the counter & bitmap so that the finality provider won't be
 		// immediately jailed after unjailing.
 		signInfo.MissedBlocksCounter = 0
@@ -100,6 +120,8 @@ func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.
 			"finality provider is jailed",
 			"height", height,
 			"public_key", fpPk.MarshalHex(),
+			"jail_count", signInfo.JailCount,
+			"tombstoned", signInfo.Tombstoned,
 		)
 	}
 
@@ -180,14 +202,34 @@ func (k Keeper) updateSigningInfo(
 	return modifiedSignInfo, &signInfo, nil
 }
 
-func (k Keeper) jailSluggishFinalityProvider(ctx context.Context, fpBtcPk *types.BIP340PubKey) error {
+// jailSluggishFinalityProvider jails the given finality provider and records the
+// jailing in its signing info. The jailing period grows with the number of times
+// the finality provider has been jailed, and the finality provider is tombstoned
+// once it reaches the maximum number of jailings. The caller is responsible for
+// persisting the updated signing info
+func (k Keeper) jailSluggishFinalityProvider(
+	ctx context.Context,
+	fpBtcPk *types.BIP340PubKey,
+	signInfo *finalitytypes.FinalityProviderSigningInfo,
+) error {
 	err := k.BTCStakingKeeper.JailFinalityProvider(ctx, fpBtcPk.MustMarshal())
 	if err != nil {
 		return err
 	}
 
-	err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(
-		finalitytypes.NewEventJailedFinalityProvider(fpBtcPk),
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	params := k.GetParams(ctx)
+	jailedAt := sdkCtx.HeaderInfo().Time
+	jailedUntil := jailedAt.Add(params.JailDurationForCount(signInfo.JailCount + 1))
+	signInfo.JailedUntil = jailedUntil
+	signInfo.AddJailRecord(finalitytypes.JailRecord{
+		Height:      sdkCtx.HeaderInfo().Height,
+		JailedAt:    jailedAt,
+		JailedUntil: jailedUntil,
+	})
+
+	err = sdkCtx.EventManager().EmitTypedEvent(
+		finalitytypes.NewEventJailedFinalityProvider(fpBtcPk, signInfo.JailCount, jailedUntil),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to emit sluggish finality provider detected event: %w", err)
@@ -195,5 +237,21 @@ func (k Keeper) jailSluggishFinalityProvider(ctx context.Context, fpBtcPk *types
 
 	finalitytypes.IncrementJailedFinalityProviderCounter()
 
+	epoch := k.CheckpointingKeeper.GetEpochByHeight(ctx, uint64(sdkCtx.HeaderInfo().Height))
+	if err := k.recordFinalityProviderJailed(ctx, fpBtcPk, epoch); err != nil {
+		return fmt.Errorf("failed to record the jailing of finality provider %s: %w", fpBtcPk.MarshalHex(), err)
+	}
+
+	if params.MaxJailCount > 0 && signInfo.JailCount >= params.MaxJailCount {
+		signInfo.Tombstoned = true
+
+		err = sdkCtx.EventManager().EmitTypedEvent(
+			finalitytypes.NewEventTombstonedFinalityProvider(fpBtcPk, signInfo.JailCount),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to emit tombstoned finality provider event: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -30,6 +30,7 @@ func FuzzHandleLiveness(f *testing.F) {
 
 		iKeeper := types.NewMockIncentiveKeeper(ctrl)
 		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		cKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).Return(uint64(1)).AnyTimes()
 		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper)
 		blockTime := time.Now()
 		ctx = ctx.WithHeaderInfo(header.Info{Time: blockTime})
@@ -81,6 +82,128 @@ func FuzzHandleLiveness(f *testing.F) {
 	})
 }
 
+func FuzzHandleLiveness_RetiredFinalityProvider(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper)
+
+		fpPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		otherFpPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(&bstypes.FinalityProvider{RetiredBabylonHeight: 1}, nil).AnyTimes()
+		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(true).AnyTimes()
+		signingInfo := types.NewFinalityProviderSigningInfo(
+			fpPk,
+			1,
+			0,
+		)
+		err = fKeeper.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), signingInfo)
+		require.NoError(t, err)
+
+		// the retired finality provider still has voting power until it exits
+		// at a random height. Liveness is handled for heights lagging behind
+		// the latest voting power table, which already excludes the retired
+		// finality provider
+		numMissed := int64(datagen.RandomInt(r, 10) + 1)
+		for height := int64(1); height <= numMissed; height++ {
+			fKeeper.SetVotingPower(ctx, fpPk.MustMarshal(), uint64(height), datagen.RandomInt(r, 1000)+1)
+		}
+		for height := numMissed + 1; height <= 2*numMissed+1; height++ {
+			fKeeper.SetVotingPower(ctx, otherFpPk.MustMarshal(), uint64(height), datagen.RandomInt(r, 1000)+1)
+		}
+
+		// the retired finality provider is still obligated to vote at the
+		// heights at which it has voting power
+		height := int64(1)
+		for ; height <= numMissed; height++ {
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fpPk, true, height)
+			require.NoError(t, err)
+		}
+		signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
+		require.NoError(t, err)
+		require.Equal(t, numMissed, signingInfo.MissedBlocksCounter)
+
+		// after the retired finality provider has exited, its missed blocks
+		// are not counted anymore
+		for ; height <= 2*numMissed; height++ {
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fpPk, true, height)
+			require.NoError(t, err)
+		}
+		signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
+		require.NoError(t, err)
+		require.Equal(t, numMissed, signingInfo.MissedBlocksCounter)
+	})
+}
+
+func FuzzHandleLiveness_EscalatingJailing(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		bsKeeper.EXPECT().JailFinalityProvider(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		cKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).Return(uint64(1)).AnyTimes()
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper)
+		blockTime := time.Now()
+		ctx = ctx.WithHeaderInfo(header.Info{Time: blockTime})
+
+		// jail durations double with each jailing and the finality provider
+		// is tombstoned after a random number of jailings
+		params := fKeeper.GetParams(ctx)
+		params.JailDurationMultiplier = 2
+		params.MaxJailCount = uint32(datagen.RandomInt(r, 4) + 2)
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		fpPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(&bstypes.FinalityProvider{Jailed: false}, nil).AnyTimes()
+		signingInfo := types.NewFinalityProviderSigningInfo(
+			fpPk,
+			1,
+			0,
+		)
+		err = fKeeper.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), signingInfo)
+		require.NoError(t, err)
+
+		// keep missing blocks until the finality provider is tombstoned
+		expectedJailDuration := params.JailDuration
+		height := params.SignedBlocksWindow + 2
+		for jailCount := uint32(1); jailCount <= params.MaxJailCount; jailCount++ {
+			for {
+				err := fKeeper.HandleFinalityProviderLiveness(ctx, fpPk, true, height)
+				require.NoError(t, err)
+				height++
+				signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
+				require.NoError(t, err)
+				if signingInfo.JailCount == jailCount {
+					break
+				}
+			}
+
+			require.Equal(t, blockTime.Add(expectedJailDuration).Unix(), signingInfo.JailedUntil.Unix())
+			require.Equal(t, signingInfo.JailedUntil, signingInfo.JailHistory[jailCount-1].JailedUntil)
+			require.Equal(t, jailCount == params.MaxJailCount, signingInfo.Tombstoned)
+			expectedJailDuration *= 2
+		}
+	})
+}
+
 // FuzzHandleLivenessDeterminism tests the property of determinism of
 // HandleLiveness by creating two helpers with the same steps to jailing
 // and asserting the jailing events should be with the same order
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the finality module state from consensus version 1 to
+// 2. Since version 2, the total voting power and voted power of each height
+// are accumulated as voting power tables and votes are stored, so they are
+// recomputed for all the heights that are not finalised yet.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.recomputeTally(ctx)
+	return nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/prefix"
+	"cosmossdk.io/store/rootmulti"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/finality/keeper"
+	"github.com/babylonlabs-io/babylon/x/finality/types"
+)
+
+func FuzzMigrate1to2(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeperWithStore(t, db, stateStore, bsKeeper, iKeeper, cKeeper)
+		dParams := types.DefaultParams()
+		dParams.FinalityActivationHeight = 0
+		require.NoError(t, fKeeper.SetParams(ctx, dParams))
+
+		// index blocks with random finality provider sets, where all finality
+		// providers but one vote for each block so that it reaches a quorum
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		numBlocks := datagen.RandomInt(r, 10) + 1
+		expectedTotal := map[uint64]uint64{}
+		expectedVoted := map[uint64]uint64{}
+		for height := activatedHeight; height < activatedHeight+numBlocks; height++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:  height,
+				AppHash: datagen.GenRandomByteArray(r, 32),
+			})
+			numFps := int(datagen.RandomInt(r, 5) + 4)
+			for i := 0; i < numFps; i++ {
+				fpPk, err := datagen.GenRandomBIP340PubKey(r)
+				require.NoError(t, err)
+				fKeeper.SetVotingPower(ctx, fpPk.MustMarshal(), height, 1)
+				expectedTotal[height]++
+				if i == 0 {
+					continue
+				}
+				sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, height, fpPk, sig)
+				expectedVoted[height]++
+			}
+		}
+		ctx = datagen.WithCtxHeight(ctx, activatedHeight+numBlocks-1)
+
+		// wipe the tally, as in the state of consensus version 1
+		kvStore := ctx.KVStore(stateStore.(*rootmulti.Store).StoreKeysByName()[types.StoreKey])
+		for _, key := range [][]byte{types.TotalVotingPowerKey, types.VotedPowerKey} {
+			tallyStore := prefix.NewStore(kvStore, key)
+			iter := tallyStore.Iterator(nil, nil)
+			var keys [][]byte
+			for ; iter.Valid(); iter.Next() {
+				keys = append(keys, iter.Key())
+			}
+			iter.Close()
+			for _, k := range keys {
+				tallyStore.Delete(k)
+			}
+		}
+
+		// without the migration, no block can be finalised
+		fKeeper.TallyBlocks(ctx)
+		ib, err := fKeeper.GetBlock(ctx, activatedHeight)
+		require.NoError(t, err)
+		require.False(t, ib.Finalized)
+
+		err = keeper.NewMigrator(*fKeeper).Migrate1to2(ctx)
+		require.NoError(t, err)
+		for height := activatedHeight; height < activatedHeight+numBlocks; height++ {
+			require.Equal(t, expectedTotal[height], fKeeper.GetTotalVotingPower(ctx, height))
+			require.Equal(t, expectedVoted[height], fKeeper.GetVotedPower(ctx, height))
+		}
+
+		// all the blocks are finalised after the migration
+		fKeeper.TallyBlocks(ctx)
+		for height := activatedHeight; height < activatedHeight+numBlocks; height++ {
+			ib, err := fKeeper.GetBlock(ctx, height)
+			require.NoError(t, err)
+			require.True(t, ib.Finalized)
+		}
+	})
+}
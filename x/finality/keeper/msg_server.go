@@ -84,6 +84,11 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	if !should {
 		return nil, types.ErrSigHeightOutdated.Wrapf("height: %d", req.BlockHeight)
 	}
+	// the votes and voting power table at a pruned height are gone, so a
+	// vote on it can be neither tallied nor checked for equivocation
+	if ms.IsHeightPruned(ctx, req.BlockHeight) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.BlockHeight)
+	}
 
 	fpPK := req.FpBtcPk
 
@@ -320,6 +325,11 @@ func (ms msgServer) UnjailFinalityProvider(ctx context.Context, req *types.MsgUn
 		return nil, fmt.Errorf("failed to get the signing info of finality provider %s: %w", fpPk.MarshalHex(), err)
 	}
 
+	// cannot be unjailed if it has been jailed too many times
+	if info.Tombstoned {
+		return nil, types.ErrFpTombstoned.Wrapf("finality provider %s has been jailed %d times", fpPk.MarshalHex(), info.JailCount)
+	}
+
 	// cannot be unjailed until jailing period is passed
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	curBlockTime := sdkCtx.HeaderInfo().Time
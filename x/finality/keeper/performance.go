@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/finality/types"
+)
+
+// recordFinalityProviderVote records whether a finality provider that is
+// eligible to vote for a block in the given epoch has voted for it or missed it
+func (k Keeper) recordFinalityProviderVote(ctx context.Context, fpBtcPk *bbn.BIP340PubKey, epoch uint64, missed bool) error {
+	return k.updateFinalityProviderPerformance(ctx, fpBtcPk, epoch, func(p *types.FinalityProviderPerformance) {
+		p.BlocksEligible++
+		if missed {
+			p.BlocksMissed++
+		} else {
+			p.BlocksVoted++
+		}
+	})
+}
+
+// recordFinalityProviderJailed records that a finality provider is jailed
+// in the given epoch
+func (k Keeper) recordFinalityProviderJailed(ctx context.Context, fpBtcPk *bbn.BIP340PubKey, epoch uint64) error {
+	return k.updateFinalityProviderPerformance(ctx, fpBtcPk, epoch, func(p *types.FinalityProviderPerformance) {
+		p.TimesJailed++
+	})
+}
+
+// updateFinalityProviderPerformance applies the given update to both the
+// lifetime and the per-epoch performance of the given finality provider
+func (k Keeper) updateFinalityProviderPerformance(
+	ctx context.Context,
+	fpBtcPk *bbn.BIP340PubKey,
+	epoch uint64,
+	update func(p *types.FinalityProviderPerformance),
+) error {
+	fpBtcPkBytes := fpBtcPk.MustMarshal()
+
+	total, err := k.GetFinalityProviderPerformance(ctx, fpBtcPk)
+	if err != nil {
+		return err
+	}
+	update(&total)
+	if err := k.FinalityProviderPerformanceTracker.Set(ctx, fpBtcPkBytes, total); err != nil {
+		return err
+	}
+
+	epochKey := collections.Join(fpBtcPkBytes, epoch)
+	epochPerf, err := k.FinalityProviderEpochPerformance.Get(ctx, epochKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	update(&epochPerf)
+	return k.FinalityProviderEpochPerformance.Set(ctx, epochKey, epochPerf)
+}
+
+// GetFinalityProviderPerformance returns the lifetime performance of the
+// given finality provider, which is empty if the finality provider has never
+// been eligible to vote
+func (k Keeper) GetFinalityProviderPerformance(ctx context.Context, fpBtcPk *bbn.BIP340PubKey) (types.FinalityProviderPerformance, error) {
+	perf, err := k.FinalityProviderPerformanceTracker.Get(ctx, fpBtcPk.MustMarshal())
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.FinalityProviderPerformance{}, err
+	}
+	return perf, nil
+}
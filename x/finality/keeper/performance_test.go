@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/core/header"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
+	"github.com/babylonlabs-io/babylon/x/finality/types"
+)
+
+func FuzzFinalityProviderPerformance(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper)
+
+		// each epoch contains a fixed number of blocks
+		epochInterval := datagen.RandomInt(r, 5) + 1
+		cKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ interface{}, height uint64) uint64 {
+				return height/epochInterval + 1
+			},
+		).AnyTimes()
+
+		fpPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(&bstypes.FinalityProvider{Jailed: false}, nil).AnyTimes()
+		signingInfo := types.NewFinalityProviderSigningInfo(fpPk, 1, 0)
+		err = fKeeper.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), signingInfo)
+		require.NoError(t, err)
+
+		// the finality provider randomly votes or misses each height
+		numHeights := datagen.RandomInt(r, 50) + 1
+		expectedEpochs := map[uint64]types.FinalityProviderPerformance{}
+		expectedTotal := types.FinalityProviderPerformance{}
+		for h := uint64(1); h <= numHeights; h++ {
+			ctx = ctx.WithHeaderInfo(header.Info{Height: int64(h)})
+			fKeeper.SetVotingPower(ctx, fpPk.MustMarshal(), h, 1)
+			epoch := h/epochInterval + 1
+			perf := expectedEpochs[epoch]
+			perf.BlocksEligible++
+			expectedTotal.BlocksEligible++
+			if datagen.OneInN(r, 2) {
+				sig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, h, fpPk, sig)
+				perf.BlocksVoted++
+				expectedTotal.BlocksVoted++
+			} else {
+				perf.BlocksMissed++
+				expectedTotal.BlocksMissed++
+			}
+			expectedEpochs[epoch] = perf
+
+			fKeeper.HandleLiveness(ctx, int64(h))
+		}
+
+		// the lifetime performance sums up all the heights
+		res, err := fKeeper.FinalityProviderPerformance(ctx, &types.QueryFinalityProviderPerformanceRequest{
+			FpBtcPkHex: fpPk.MarshalHex(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, expectedTotal, res.Total)
+		require.Len(t, res.Epochs, len(expectedEpochs))
+		for _, epochPerf := range res.Epochs {
+			require.Equal(t, expectedEpochs[epochPerf.EpochNum], epochPerf.Performance)
+		}
+
+		// only the epochs within the range are returned
+		lastEpoch := numHeights/epochInterval + 1
+		fromEpoch := datagen.RandomInt(r, int(lastEpoch)) + 1
+		toEpoch := fromEpoch + datagen.RandomInt(r, int(lastEpoch-fromEpoch)+1)
+		res, err = fKeeper.FinalityProviderPerformance(ctx, &types.QueryFinalityProviderPerformanceRequest{
+			FpBtcPkHex: fpPk.MarshalHex(),
+			FromEpoch:  fromEpoch,
+			ToEpoch:    toEpoch,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expectedTotal, res.Total)
+		numEpochsInRange := 0
+		for epoch := range expectedEpochs {
+			if epoch >= fromEpoch && epoch <= toEpoch {
+				numEpochsInRange++
+			}
+		}
+		require.Len(t, res.Epochs, numEpochsInRange)
+		for _, epochPerf := range res.Epochs {
+			require.GreaterOrEqual(t, epochPerf.EpochNum, fromEpoch)
+			require.LessOrEqual(t, epochPerf.EpochNum, toEpoch)
+			require.Equal(t, expectedEpochs[epochPerf.EpochNum], epochPerf.Performance)
+		}
+
+		// an invalid epoch range is rejected
+		_, err = fKeeper.FinalityProviderPerformance(ctx, &types.QueryFinalityProviderPerformanceRequest{
+			FpBtcPkHex: fpPk.MarshalHex(),
+			FromEpoch:  toEpoch + 1,
+			ToEpoch:    toEpoch,
+		})
+		require.Error(t, err)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"sort"
 
 	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -123,6 +124,35 @@ func (k Keeper) handleFPStateUpdates(ctx context.Context, prevDc, newDc *ftypes.
 
 		k.Logger(sdkCtx).Info("a new finality provider becomes inactive", "pk", fp.BtcPk.MarshalHex())
 	}
+
+	// a retired finality provider exits once it has lost all its voting power
+	removedFPs := newDc.FindRemovedFinalityProviders(prevDc)
+	for _, fp := range removedFPs {
+		if !k.isRetiredFinalityProvider(ctx, fp.BtcPk) {
+			continue
+		}
+
+		statusChangeEvent := types.NewFinalityProviderStatusChangeEvent(fp.BtcPk, types.FinalityProviderStatus_FINALITY_PROVIDER_STATUS_RETIRED)
+		if err := sdkCtx.EventManager().EmitTypedEvent(statusChangeEvent); err != nil {
+			panic(fmt.Errorf(
+				"failed to emit FinalityProviderStatusChangeEvent with status %s: %w",
+				types.FinalityProviderStatus_FINALITY_PROVIDER_STATUS_RETIRED.String(), err))
+		}
+
+		k.Logger(sdkCtx).Info("a retired finality provider exits", "pk", fp.BtcPk.MarshalHex())
+	}
+}
+
+// isRetiredFinalityProvider returns whether the finality provider with the
+// given BTC PK has announced its retirement
+func (k Keeper) isRetiredFinalityProvider(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) bool {
+	fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPK.MustMarshal())
+	if err != nil {
+		// a finality provider in the voting power distribution cache
+		// must be registered
+		panic(fmt.Errorf("failed to get finality provider %s: %w", fpBTCPK.MarshalHex(), err))
+	}
+	return fp.IsRetired()
 }
 
 // handleActivatedFinalityProvider updates the signing info start height or create a new signing info
@@ -167,6 +197,7 @@ func (k Keeper) recordMetrics(dc *ftypes.VotingPowerDistCache) {
 // - slashed finality providers
 // - newly jailed finality providers
 // - newly unjailed finality providers
+// - finality providers with updated commission rates
 func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	ctx context.Context,
 	dc *ftypes.VotingPowerDistCache,
@@ -185,6 +216,9 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 	jailedFPs := map[string]struct{}{}
 	// a map where key is unjailed finality providers' BTC PK
 	unjailedFPs := map[string]struct{}{}
+	// a map where key is finality providers' BTC PK and value is the
+	// updated commission rate
+	commissionUpdatedFPs := map[string]*sdkmath.LegacyDec{}
 
 	// simple cache to load fp by his btc pk hex
 	fpByBtcPkHex := map[string]*types.FinalityProvider{}
@@ -203,6 +237,9 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 			if err != nil {
 				panic(err) // only programming error
 			}
+			// notify subscribers about the state update, enriched with the
+			// BTC delegation's details
+			types.EmitBTCDelegationStateUpdateEvent(sdkCtx, btcDel, delEvent.NewState)
 
 			switch delEvent.NewState {
 			case types.BTCDelegationStatus_ACTIVE:
@@ -244,6 +281,9 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 		case *types.EventPowerDistUpdate_UnjailedFp:
 			// record unjailed fps
 			unjailedFPs[typedEvent.UnjailedFp.Pk.MarshalHex()] = struct{}{}
+		case *types.EventPowerDistUpdate_CommissionUpdatedFp:
+			// record fps with updated commission rates
+			commissionUpdatedFPs[typedEvent.CommissionUpdatedFp.Pk.MarshalHex()] = typedEvent.CommissionUpdatedFp.Commission
 		}
 	}
 
@@ -283,6 +323,11 @@ func (k Keeper) ProcessAllPowerDistUpdateEvents(
 			fp.IsJailed = false
 		}
 
+		// apply the updated commission rate of the fp
+		if commission, ok := commissionUpdatedFPs[fpBTCPKHex]; ok {
+			fp.Commission = commission
+		}
+
 		// process all new BTC delegations under this finality provider
 		if fpActiveSats, ok := activedSatsByFpBtcPk[fpBTCPKHex]; ok {
 			// handle new BTC delegations for this finality provider
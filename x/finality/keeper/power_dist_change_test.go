@@ -6,11 +6,14 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	testutil "github.com/babylonlabs-io/babylon/testutil/btcstaking-helper"
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/types"
 	btclctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
 	"github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	ftypes "github.com/babylonlabs-io/babylon/x/finality/types"
@@ -560,10 +563,28 @@ func FuzzBTCDelegationEvents_NoPreApproval(f *testing.F) {
 		// due to no timestamped randomness
 		babylonHeight += 1
 		h.SetCtxHeight(babylonHeight)
+		h.Ctx = h.Ctx.WithEventManager(sdk.NewEventManager())
 		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip)
 		h.BeginBlocker()
 		require.Zero(t, h.FinalityKeeper.GetVotingPower(h.Ctx, *fp.BtcPk, babylonHeight))
 
+		// the state update is emitted with the BTC delegation's details when
+		// it is applied to the voting power distribution
+		var emittedStateUpdates []*types.EventBTCDelegationStateUpdate
+		for _, ev := range h.Ctx.EventManager().Events() {
+			if ev.Type != "babylon.btcstaking.v1.EventBTCDelegationStateUpdate" {
+				continue
+			}
+			typedEv, err := sdk.ParseTypedEvent(abci.Event(ev))
+			require.NoError(t, err)
+			emittedStateUpdates = append(emittedStateUpdates, typedEv.(*types.EventBTCDelegationStateUpdate))
+		}
+		require.Equal(t, []*types.EventBTCDelegationStateUpdate{
+			types.NewEventBTCDelegationStateUpdate(actualDel, types.BTCDelegationStatus_ACTIVE),
+		}, emittedStateUpdates)
+		require.Equal(t, msgCreateBTCDel.StakerAddr, emittedStateUpdates[0].StakerAddr)
+		require.Equal(t, []string{bbn.NewBIP340PubKeyFromBTCPK(fpPK).MarshalHex()}, emittedStateUpdates[0].FpBtcPkList)
+
 		// ensure this finality provider has voting power at the current height after having timestamped pub rand
 		babylonHeight += 1
 		h.SetCtxHeight(babylonHeight)
@@ -18,8 +18,11 @@ type FpWithVotingPower struct {
 }
 
 func (k Keeper) SetVotingPower(ctx context.Context, fpBTCPK []byte, height uint64, power uint64) {
+	oldPower := k.GetVotingPower(ctx, fpBTCPK, height)
 	store := k.votingPowerBbnBlockHeightStore(ctx, height)
 	store.Set(fpBTCPK, sdk.Uint64ToBigEndian(power))
+	// keep the tally of this height consistent with the voting power table
+	k.updateTallyOnVotingPowerChange(ctx, fpBTCPK, height, oldPower, power)
 }
 
 // GetVotingPower gets the voting power of a given finality provider at a given Babylon height
@@ -130,8 +133,14 @@ func (k Keeper) GetVotingPowerTableOrdered(ctx context.Context, height uint64) [
 // GetBTCStakingActivatedHeight returns the height when the BTC staking protocol is activated
 // i.e., the first height where a finality provider has voting power
 // Before the BTC staking protocol is activated, we don't index or tally any block
+// NOTE: once the voting power tables start being pruned, the activated height
+// is read from the record written by the pruner rather than from the earliest
+// voting power table
 func (k Keeper) GetBTCStakingActivatedHeight(ctx context.Context) (uint64, error) {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if bz := storeAdapter.Get(types.BTCStakingActivatedHeightKey); bz != nil {
+		return sdk.BigEndianToUint64(bz), nil
+	}
 	votingPowerStore := prefix.NewStore(storeAdapter, types.VotingPowerKey)
 	iter := votingPowerStore.Iterator(nil, nil)
 	defer iter.Close()
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/finality/types"
+)
+
+// HandlePruning prunes the public randomness, votes, voting power tables, tallies
+// and voting power distribution caches of heights that are older than the last
+// finalized height minus the parameterized retention. Heights that are still
+// needed by tallying, rewarding or liveness are never pruned. At most
+// `types.MaxPrunedHeightsPerBlock` heights are pruned per invocation. The
+// per-epoch finality provider performance of the epochs whose heights are all
+// pruned is removed as well. Evidences, signing info, lifetime finality
+// provider performance and indexed blocks are kept intact.
+func (k Keeper) HandlePruning(ctx context.Context, heightToExamine int64) {
+	retention := k.GetParams(ctx).StateRetentionBlocks
+	if retention == 0 {
+		// pruning is disabled
+		return
+	}
+
+	pruneEndHeight, ok := k.getPruneEndHeight(ctx, heightToExamine, retention)
+	if !ok {
+		return
+	}
+
+	startHeight := k.GetNextHeightToPrune(ctx)
+	if startHeight == 0 {
+		// first time to prune, start from the activated height and persist it
+		// as the voting power table at this height is about to be pruned
+		activatedHeight, err := k.GetBTCStakingActivatedHeight(ctx)
+		if err != nil {
+			panic(err)
+		}
+		k.setBTCStakingActivatedHeight(ctx, activatedHeight)
+		startHeight = activatedHeight
+	}
+
+	if startHeight >= pruneEndHeight {
+		return
+	}
+	if pruneEndHeight-startHeight > types.MaxPrunedHeightsPerBlock {
+		pruneEndHeight = startHeight + types.MaxPrunedHeightsPerBlock
+	}
+
+	for height := startHeight; height < pruneEndHeight; height++ {
+		k.pruneHeight(ctx, height)
+	}
+	k.setNextHeightToPrune(ctx, pruneEndHeight)
+	k.pruneFinalityProviderEpochPerformance(ctx, pruneEndHeight)
+
+	k.Logger(sdk.UnwrapSDKContext(ctx)).Debug(
+		"pruned finality state",
+		"from_height", startHeight,
+		"to_height", pruneEndHeight-1,
+	)
+}
+
+// getPruneEndHeight returns the height (exclusive) below which the finality
+// state can be pruned, and whether there is anything that can be pruned
+func (k Keeper) getPruneEndHeight(ctx context.Context, heightToExamine int64, retention uint64) (uint64, bool) {
+	// the state of a height is no longer needed once the height is
+	// finalized (or non-finalizable), rewarded and examined for liveness
+	nextHeightToFinalize := k.getNextHeightToFinalize(ctx)
+	nextHeightToReward := k.GetNextHeightToReward(ctx)
+	if nextHeightToFinalize == 0 || nextHeightToReward == 0 {
+		return 0, false
+	}
+	safeHeight := min(nextHeightToFinalize-1, nextHeightToReward-1, uint64(heightToExamine))
+
+	if safeHeight <= retention {
+		return 0, false
+	}
+
+	return safeHeight - retention, true
+}
+
+// pruneHeight removes the public randomness, votes, voting power table, tally
+// and voting power distribution cache at the given height
+func (k Keeper) pruneHeight(ctx context.Context, height uint64) {
+	heightBytes := sdk.Uint64ToBigEndian(height)
+
+	// public randomness is only set upon a vote from a finality provider with
+	// voting power, so the voting power table tells us whose public randomness
+	// to remove
+	vpStore := k.votingPowerBbnBlockHeightStore(ctx, height)
+	fpBTCPKs := collectKeys(vpStore)
+	pubRandStore := k.pubRandStore(ctx)
+	for _, fpBTCPK := range fpBTCPKs {
+		prefix.NewStore(pubRandStore, fpBTCPK).Delete(heightBytes)
+		vpStore.Delete(fpBTCPK)
+	}
+
+	voteStore := k.voteHeightStore(ctx, height)
+	for _, fpBTCPK := range collectKeys(voteStore) {
+		voteStore.Delete(fpBTCPK)
+	}
+
+	k.RemoveVotingPowerDistCache(ctx, height)
+	k.removeTally(ctx, height)
+}
+
+// pruneFinalityProviderEpochPerformance removes the per-epoch performance of
+// all finality providers in the epochs that ended before the given height
+func (k Keeper) pruneFinalityProviderEpochPerformance(ctx context.Context, height uint64) {
+	// the epoch of the given height may not have ended yet
+	endEpoch := k.CheckpointingKeeper.GetEpochByHeight(ctx, height)
+	if endEpoch <= k.getNextEpochPerformanceToPrune(ctx) {
+		return
+	}
+
+	var keysToRemove []collections.Pair[[]byte, uint64]
+	err := k.FinalityProviderEpochPerformance.Walk(ctx, nil, func(key collections.Pair[[]byte, uint64], _ types.FinalityProviderPerformance) (bool, error) {
+		if key.K2() < endEpoch {
+			keysToRemove = append(keysToRemove, key)
+		}
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+	for _, key := range keysToRemove {
+		if err := k.FinalityProviderEpochPerformance.Remove(ctx, key); err != nil {
+			panic(err)
+		}
+	}
+	k.setNextEpochPerformanceToPrune(ctx, endEpoch)
+}
+
+// getNextEpochPerformanceToPrune gets the next epoch whose finality provider
+// performance to prune, where the performance of all epochs below it has been
+// pruned
+func (k Keeper) getNextEpochPerformanceToPrune(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextEpochPerformanceToPruneKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setNextEpochPerformanceToPrune sets the next epoch whose finality provider
+// performance to prune as the given epoch
+func (k Keeper) setNextEpochPerformanceToPrune(ctx context.Context, epoch uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.NextEpochPerformanceToPruneKey, sdk.Uint64ToBigEndian(epoch)); err != nil {
+		panic(err)
+	}
+}
+
+// IsHeightPruned returns whether the finality state at the given height
+// has been pruned
+func (k Keeper) IsHeightPruned(ctx context.Context, height uint64) bool {
+	return height < k.GetNextHeightToPrune(ctx)
+}
+
+// GetNextHeightToPrune gets the next height to prune, where all heights
+// below it have been pruned. Zero means nothing has been pruned yet
+func (k Keeper) GetNextHeightToPrune(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextHeightToPruneKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setNextHeightToPrune sets the next height to prune as the given height
+func (k Keeper) setNextHeightToPrune(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.NextHeightToPruneKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
+
+// setBTCStakingActivatedHeight records the height when the BTC staking protocol
+// is activated
+func (k Keeper) setBTCStakingActivatedHeight(ctx context.Context, height uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	storeAdapter.Set(types.BTCStakingActivatedHeightKey, sdk.Uint64ToBigEndian(height))
+}
+
+// collectKeys returns all the keys of the given store. Keys are collected
+// before deletion as the store must not be written while being iterated
+func collectKeys(store prefix.Store) [][]byte {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+	return keys
+}
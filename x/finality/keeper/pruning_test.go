@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/types"
+	"github.com/babylonlabs-io/babylon/x/finality/types"
+)
+
+func FuzzHandlePruning(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		epochInterval := datagen.RandomInt(r, 10) + 1
+		cKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, height uint64) uint64 {
+			return height / epochInterval
+		}).AnyTimes()
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper)
+
+		retention := datagen.RandomInt(r, 20) + 1
+		params := fKeeper.GetParams(ctx)
+		params.StateRetentionBlocks = retention
+		err := fKeeper.SetParams(ctx, params)
+		require.NoError(t, err)
+
+		// a finality provider that has voting power and votes on every height
+		// since the activated height
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		numHeights := retention + datagen.RandomInt(r, 2*types.MaxPrunedHeightsPerBlock) + 1
+		curHeight := activatedHeight + numHeights - 1
+		fpSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(fpSK.PubKey())
+		for h := activatedHeight; h <= curHeight; h++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:    h,
+				AppHash:   datagen.GenRandomByteArray(r, 32),
+				Finalized: false,
+			})
+			fKeeper.SetVotingPower(ctx, fpBTCPK.MustMarshal(), h, 1)
+			dc := types.NewVotingPowerDistCache()
+			dc.AddFinalityProviderDistInfo(&types.FinalityProviderDistInfo{
+				BtcPk:          fpBTCPK,
+				TotalBondedSat: 1,
+				IsTimestamped:  true,
+			})
+			fKeeper.SetVotingPowerDistCache(ctx, h, dc)
+			votedSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+			require.NoError(t, err)
+			fKeeper.SetSig(ctx, h, fpBTCPK, votedSig)
+			randListInfo, err := datagen.GenRandomPubRandList(r, 1)
+			require.NoError(t, err)
+			fKeeper.SetPubRand(ctx, fpBTCPK, h, randListInfo.PRList[0])
+		}
+		// the finality provider has a lifetime performance record and a
+		// performance record in every epoch
+		lifetimePerf := types.FinalityProviderPerformance{BlocksEligible: numHeights, BlocksVoted: numHeights}
+		require.NoError(t, fKeeper.FinalityProviderPerformanceTracker.Set(ctx, fpBTCPK.MustMarshal(), lifetimePerf))
+		curEpoch := curHeight / epochInterval
+		for epoch := uint64(0); epoch <= curEpoch; epoch++ {
+			err := fKeeper.FinalityProviderEpochPerformance.Set(
+				ctx,
+				collections.Join(fpBTCPK.MustMarshal(), epoch),
+				types.FinalityProviderPerformance{BlocksEligible: 1, BlocksVoted: 1},
+			)
+			require.NoError(t, err)
+		}
+
+		// finalise and reward all heights
+		ctx = datagen.WithCtxHeight(ctx, curHeight)
+		fKeeper.TallyBlocks(ctx)
+		fKeeper.SetNextHeightToReward(ctx, curHeight+1)
+
+		fKeeper.HandlePruning(ctx, int64(curHeight))
+
+		expectedPrunedHeights := min(curHeight-retention-activatedHeight, types.MaxPrunedHeightsPerBlock)
+		nextHeightToPrune := activatedHeight + expectedPrunedHeights
+		if expectedPrunedHeights > 0 {
+			require.Equal(t, nextHeightToPrune, fKeeper.GetNextHeightToPrune(ctx))
+		} else {
+			// nothing has been pruned yet
+			require.Zero(t, fKeeper.GetNextHeightToPrune(ctx))
+		}
+
+		for h := activatedHeight; h <= curHeight; h++ {
+			pruned := h < nextHeightToPrune
+			require.Equal(t, pruned, fKeeper.IsHeightPruned(ctx, h))
+			require.Equal(t, !pruned, fKeeper.HasVotingPowerTable(ctx, h))
+			require.Equal(t, !pruned, fKeeper.HasSig(ctx, h, fpBTCPK))
+			require.Equal(t, !pruned, fKeeper.HasPubRand(ctx, fpBTCPK, h))
+			require.Equal(t, pruned, fKeeper.GetVotingPowerDistCache(ctx, h) == nil)
+
+			// indexed blocks are kept intact
+			ib, err := fKeeper.GetBlock(ctx, h)
+			require.NoError(t, err)
+			require.True(t, ib.Finalized)
+		}
+
+		// the per-epoch performance is pruned for the epochs whose heights are
+		// all pruned
+		var prunedEpochs uint64
+		if expectedPrunedHeights > 0 {
+			prunedEpochs = nextHeightToPrune / epochInterval
+		}
+		for epoch := uint64(0); epoch <= curEpoch; epoch++ {
+			has, err := fKeeper.FinalityProviderEpochPerformance.Has(ctx, collections.Join(fpBTCPK.MustMarshal(), epoch))
+			require.NoError(t, err)
+			require.Equal(t, epoch >= prunedEpochs, has)
+		}
+		// the lifetime performance is kept intact
+		perf, err := fKeeper.GetFinalityProviderPerformance(ctx, fpBTCPK)
+		require.NoError(t, err)
+		require.Equal(t, lifetimePerf, perf)
+
+		// the activated height is unaffected by pruning
+		gotActivatedHeight, err := fKeeper.GetBTCStakingActivatedHeight(ctx)
+		require.NoError(t, err)
+		require.Equal(t, activatedHeight, gotActivatedHeight)
+
+		// queries at a pruned height return an error
+		if expectedPrunedHeights > 0 {
+			_, err = fKeeper.VotesAtHeight(ctx, &types.QueryVotesAtHeightRequest{Height: activatedHeight})
+			require.ErrorIs(t, err, types.ErrHeightPruned)
+		}
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/babylonlabs-io/babylon/x/finality/types"
@@ -39,20 +41,22 @@ func (k Keeper) TallyBlocks(ctx context.Context) {
 	// - has finality providers, finalised: impossible to happen, panic
 	// - does not have finality providers, finalised: impossible to happen, panic
 	// After this for loop, the blocks since earliest activated height are either finalised or non-finalisable
+	//
+	// The voted power of each height is accumulated as votes arrive, so tallying a block
+	// only takes a constant number of reads.
 	for i := startHeight; i <= uint64(sdkCtx.HeaderInfo().Height); i++ {
 		ib, err := k.GetBlock(ctx, i)
 		if err != nil {
 			panic(err) // failing to get an existing block is a programming error
 		}
 
-		// get the finality provider set of this block
-		fpSet := k.GetVotingPowerTable(ctx, ib.Height)
+		// check whether this block has a finality provider set
+		hasFpSet := k.HasVotingPowerTable(ctx, ib.Height)
 
 		switch {
-		case fpSet != nil && !ib.Finalized:
+		case hasFpSet && !ib.Finalized:
 			// has finality providers, non-finalised: tally and try to finalise the block
-			voterBTCPKs := k.GetVoters(ctx, ib.Height)
-			if tally(fpSet, voterBTCPKs) {
+			if hasQuorum(k.GetTotalVotingPower(ctx, ib.Height), k.GetVotedPower(ctx, ib.Height)) {
 				// if this block gets >2/3 votes, finalise it
 				k.finalizeBlock(ctx, ib)
 			} else {
@@ -60,16 +64,16 @@ func (k Keeper) TallyBlocks(ctx context.Context) {
 				// thus, we need to break here
 				break
 			}
-		case fpSet == nil && !ib.Finalized:
+		case !hasFpSet && !ib.Finalized:
 			// does not have finality providers, non-finalised: not finalisable,
 			// increment the next height to finalise and continue
 			k.setNextHeightToFinalize(ctx, ib.Height+1)
 			continue
-		case fpSet != nil && ib.Finalized:
+		case hasFpSet && ib.Finalized:
 			// has finality providers and the block has finalised
 			// this can only be a programming error
 			panic(fmt.Errorf("block %d is finalized, but last finalized height in DB does not reach here", ib.Height))
-		case fpSet == nil && ib.Finalized:
+		case !hasFpSet && ib.Finalized:
 			// does not have finality providers, finalised: impossible to happen, panic
 			panic(fmt.Errorf("block %d is finalized, but does not have a finality provider set", ib.Height))
 		}
@@ -88,18 +92,124 @@ func (k Keeper) finalizeBlock(ctx context.Context, block *types.IndexedBlock) {
 	types.RecordLastFinalizedHeight(block.Height)
 }
 
-// tally checks whether a block with the given finality provider set and votes reaches a quorum or not
-func tally(fpSet map[string]uint64, voterBTCPKs map[string]struct{}) bool {
-	totalPower := uint64(0)
-	votedPower := uint64(0)
-	for pkStr, power := range fpSet {
-		totalPower += power
-		if _, ok := voterBTCPKs[pkStr]; ok {
-			votedPower += power
+// hasQuorum checks whether the voted power of a block reaches a quorum
+// of the total voting power of its finality provider set or not
+func hasQuorum(totalPower uint64, votedPower uint64) bool {
+	return votedPower*3 > totalPower*2
+}
+
+// GetTotalVotingPower gets the total voting power of the finality provider set at
+// the given height
+func (k Keeper) GetTotalVotingPower(ctx context.Context, height uint64) uint64 {
+	return getHeightUint64(k.totalVotingPowerStore(ctx), height)
+}
+
+// GetVotedPower gets the accumulated voting power of the finality providers that
+// have voted for the block at the given height
+func (k Keeper) GetVotedPower(ctx context.Context, height uint64) uint64 {
+	return getHeightUint64(k.votedPowerStore(ctx), height)
+}
+
+// addVotedPower accumulates the voting power of a new voter into the voted
+// power at the given height
+func (k Keeper) addVotedPower(ctx context.Context, height uint64, power uint64) {
+	if power == 0 {
+		return
+	}
+	store := k.votedPowerStore(ctx)
+	setHeightUint64(store, height, getHeightUint64(store, height)+power)
+}
+
+// updateTallyOnVotingPowerChange updates the total voting power, and the voted power
+// if the finality provider has voted, at the given height upon a change of the
+// finality provider's voting power
+func (k Keeper) updateTallyOnVotingPowerChange(ctx context.Context, fpBTCPK []byte, height uint64, oldPower uint64, newPower uint64) {
+	if oldPower == newPower {
+		return
+	}
+
+	totalStore := k.totalVotingPowerStore(ctx)
+	setHeightUint64(totalStore, height, getHeightUint64(totalStore, height)-oldPower+newPower)
+
+	// votes are not necessarily stored after the voting power table, e.g., upon
+	// genesis import, so the voted power needs to follow the voting power as well
+	if k.voteHeightStore(ctx, height).Has(fpBTCPK) {
+		votedStore := k.votedPowerStore(ctx)
+		setHeightUint64(votedStore, height, getHeightUint64(votedStore, height)-oldPower+newPower)
+	}
+}
+
+// recomputeTally recomputes the total voting power and voted power of all the
+// heights that are not finalised yet from their voting power tables and votes
+func (k Keeper) recomputeTally(ctx context.Context) {
+	activatedHeight, err := k.GetBTCStakingActivatedHeight(ctx)
+	if err != nil {
+		// BTC staking is not activated, thus there is nothing to tally
+		return
+	}
+
+	startHeight := k.getNextHeightToFinalize(ctx)
+	if startHeight < activatedHeight {
+		startHeight = activatedHeight
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	totalStore := k.totalVotingPowerStore(ctx)
+	votedStore := k.votedPowerStore(ctx)
+	for height := startHeight; height <= uint64(sdkCtx.HeaderInfo().Height); height++ {
+		fpSet := k.GetVotingPowerTable(ctx, height)
+		if fpSet == nil {
+			continue
 		}
+
+		totalPower, votedPower := uint64(0), uint64(0)
+		for _, power := range fpSet {
+			totalPower += power
+		}
+		for voter := range k.GetVoters(ctx, height) {
+			votedPower += fpSet[voter]
+		}
+
+		setHeightUint64(totalStore, height, totalPower)
+		setHeightUint64(votedStore, height, votedPower)
 	}
+}
 
-	return votedPower*3 > totalPower*2
+// removeTally removes the total voting power and voted power at the given height
+func (k Keeper) removeTally(ctx context.Context, height uint64) {
+	heightBytes := sdk.Uint64ToBigEndian(height)
+	k.totalVotingPowerStore(ctx).Delete(heightBytes)
+	k.votedPowerStore(ctx).Delete(heightBytes)
+}
+
+func getHeightUint64(store prefix.Store, height uint64) uint64 {
+	bz := store.Get(sdk.Uint64ToBigEndian(height))
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func setHeightUint64(store prefix.Store, height uint64, value uint64) {
+	store.Set(sdk.Uint64ToBigEndian(height), sdk.Uint64ToBigEndian(value))
+}
+
+// totalVotingPowerStore returns the KVStore of the total voting power
+// prefix: TotalVotingPowerKey
+// key: Babylon block height
+// value: total voting power of the finality provider set
+func (k Keeper) totalVotingPowerStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.TotalVotingPowerKey)
+}
+
+// votedPowerStore returns the KVStore of the accumulated voted power
+// prefix: VotedPowerKey
+// key: Babylon block height
+// value: accumulated voting power of the finality providers that have voted
+func (k Keeper) votedPowerStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.VotedPowerKey)
 }
 
 // setNextHeightToFinalize sets the next height to finalise as the given height
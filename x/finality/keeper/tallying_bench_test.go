@@ -38,7 +38,6 @@ func benchmarkTallyBlocks(b *testing.B, numFPs int) {
 		votedFpPK, err := datagen.GenRandomBIP340PubKey(r)
 		require.NoError(b, err)
 		fpSet[votedFpPK.MarshalHex()] = 1
-		fKeeper.SetVotingPower(ctx, []byte(votedFpPK.MarshalHex()), activatedHeight, 1)
 	}
 
 	// TODO: test incentive
@@ -72,9 +71,10 @@ func benchmarkTallyBlocks(b *testing.B, numFPs int) {
 			Finalized: false,
 		})
 		// give votes to the block
-		for fpPKHex := range fpSet {
+		for fpPKHex, power := range fpSet {
 			votedFpPK, err := bbn.NewBIP340PubKeyFromHex(fpPKHex)
 			require.NoError(b, err)
+			fKeeper.SetVotingPower(ctx, votedFpPK.MustMarshal(), height, power)
 			votedSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
 			require.NoError(b, err)
 			fKeeper.SetSig(ctx, height, votedFpPK, votedSig)
@@ -167,3 +167,55 @@ func giveNoQCToHeight(r *rand.Rand, ctx sdk.Context, fKeeper *keeper.Keeper, hei
 
 	return nil
 }
+
+func FuzzTallying_IncrementalVotedPower(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper)
+
+		height := datagen.RandomInt(r, 100) + 1
+		numFps := int(datagen.RandomInt(r, 10) + 1)
+		powers := map[string]uint64{}
+		voted := map[string]bool{}
+		fpPks := make([]*bbn.BIP340PubKey, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			fpPk, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			fpPks = append(fpPks, fpPk)
+		}
+
+		// randomly interleave voting power updates and votes, in any order
+		for i := 0; i < 5*numFps; i++ {
+			fpPk := fpPks[r.Intn(numFps)]
+			if datagen.OneInN(r, 2) {
+				power := datagen.RandomInt(r, 1000)
+				fKeeper.SetVotingPower(ctx, fpPk.MustMarshal(), height, power)
+				powers[fpPk.MarshalHex()] = power
+			} else {
+				votedSig, err := bbn.NewSchnorrEOTSSig(datagen.GenRandomByteArray(r, 32))
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, height, fpPk, votedSig)
+				voted[fpPk.MarshalHex()] = true
+			}
+
+			// the tally is consistent with the voting power table and votes
+			expectedTotal, expectedVoted := uint64(0), uint64(0)
+			for fpPkHex, power := range powers {
+				expectedTotal += power
+				if voted[fpPkHex] {
+					expectedVoted += power
+				}
+			}
+			require.Equal(t, expectedTotal, fKeeper.GetTotalVotingPower(ctx, height))
+			require.Equal(t, expectedVoted, fKeeper.GetVotedPower(ctx, height))
+		}
+	})
+}
@@ -14,7 +14,13 @@ import (
 
 func (k Keeper) SetSig(ctx context.Context, height uint64, fpBtcPK *bbn.BIP340PubKey, sig *bbn.SchnorrEOTSSig) {
 	store := k.voteHeightStore(ctx, height)
-	store.Set(fpBtcPK.MustMarshal(), sig.MustMarshal())
+	fpBTCPKBytes := fpBtcPK.MustMarshal()
+	isNewVote := !store.Has(fpBTCPKBytes)
+	store.Set(fpBTCPKBytes, sig.MustMarshal())
+	if isNewVote {
+		// accumulate the voter's voting power into the tally of this height
+		k.addVotedPower(ctx, height, k.GetVotingPower(ctx, fpBTCPKBytes, height))
+	}
 }
 
 func (k Keeper) HasSig(ctx context.Context, height uint64, fpBtcPK *bbn.BIP340PubKey) bool {
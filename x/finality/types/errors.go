@@ -23,4 +23,6 @@ var (
 	ErrBTCStakingNotActivated         = errorsmod.Register(ModuleName, 1114, "the BTC staking protocol is not activated yet")
 	ErrFinalityNotActivated           = errorsmod.Register(ModuleName, 1115, "finality is not active yet")
 	ErrSigHeightOutdated              = errorsmod.Register(ModuleName, 1116, "the voting block is already finalized and timestamped")
+	ErrHeightPruned                   = errorsmod.Register(ModuleName, 1117, "the finality state at the given height has been pruned")
+	ErrFpTombstoned                   = errorsmod.Register(ModuleName, 1118, "the finality provider is tombstoned and cannot be unjailed")
 )
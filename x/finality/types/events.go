@@ -1,6 +1,10 @@
 package types
 
-import "github.com/babylonlabs-io/babylon/types"
+import (
+	"time"
+
+	"github.com/babylonlabs-io/babylon/types"
+)
 
 func NewEventSlashedFinalityProvider(evidence *Evidence) *EventSlashedFinalityProvider {
 	return &EventSlashedFinalityProvider{
@@ -8,6 +12,27 @@ func NewEventSlashedFinalityProvider(evidence *Evidence) *EventSlashedFinalityPr
 	}
 }
 
-func NewEventJailedFinalityProvider(fpPk *types.BIP340PubKey) *EventJailedFinalityProvider {
-	return &EventJailedFinalityProvider{PublicKey: fpPk.MarshalHex()}
+func NewEventJailedFinalityProvider(fpPk *types.BIP340PubKey, jailCount uint32, jailedUntil time.Time) *EventJailedFinalityProvider {
+	return &EventJailedFinalityProvider{
+		PublicKey:   fpPk.MarshalHex(),
+		JailCount:   jailCount,
+		JailedUntil: jailedUntil,
+	}
+}
+
+func NewEventTombstonedFinalityProvider(fpPk *types.BIP340PubKey, jailCount uint32) *EventTombstonedFinalityProvider {
+	return &EventTombstonedFinalityProvider{
+		PublicKey: fpPk.MarshalHex(),
+		JailCount: jailCount,
+	}
+}
+
+func NewEventFinalityStalled(stallHeight, currentHeight, totalPower, missingPower uint64, missingFps []FinalityProviderMissingPower) *EventFinalityStalled {
+	return &EventFinalityStalled{
+		StallHeight:        stallHeight,
+		CurrentHeight:      currentHeight,
+		TotalVotingPower:   totalPower,
+		MissingVotingPower: missingPower,
+		MissingFps:         missingFps,
+	}
 }
@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -74,6 +79,11 @@ func (m *EventSlashedFinalityProvider) GetEvidence() *Evidence {
 type EventJailedFinalityProvider struct {
 	// public_key is the BTC public key of the finality provider
 	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// jail_count is the number of times the finality provider has been jailed,
+	// including this one
+	JailCount uint32 `protobuf:"varint,2,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
+	// jailed_until is the time until which the finality provider is jailed
+	JailedUntil time.Time `protobuf:"bytes,3,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
 }
 
 func (m *EventJailedFinalityProvider) Reset()         { *m = EventJailedFinalityProvider{} }
@@ -116,30 +126,204 @@ func (m *EventJailedFinalityProvider) GetPublicKey() string {
 	return ""
 }
 
+func (m *EventJailedFinalityProvider) GetJailCount() uint32 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
+func (m *EventJailedFinalityProvider) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+// EventTombstonedFinalityProvider is the event emitted when a finality provider
+// reaches the maximum number of jailings and is permanently jailed
+type EventTombstonedFinalityProvider struct {
+	// public_key is the BTC public key of the finality provider
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// jail_count is the number of times the finality provider has been jailed
+	JailCount uint32 `protobuf:"varint,2,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
+}
+
+func (m *EventTombstonedFinalityProvider) Reset()         { *m = EventTombstonedFinalityProvider{} }
+func (m *EventTombstonedFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*EventTombstonedFinalityProvider) ProtoMessage()    {}
+func (*EventTombstonedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{2}
+}
+func (m *EventTombstonedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTombstonedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTombstonedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTombstonedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTombstonedFinalityProvider.Merge(m, src)
+}
+func (m *EventTombstonedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTombstonedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTombstonedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTombstonedFinalityProvider proto.InternalMessageInfo
+
+func (m *EventTombstonedFinalityProvider) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *EventTombstonedFinalityProvider) GetJailCount() uint32 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
+// EventFinalityStalled is the event emitted when no block has been finalized
+// for `finality_stall_threshold` blocks
+type EventFinalityStalled struct {
+	// stall_height is the height of the earliest non-finalized block
+	StallHeight uint64 `protobuf:"varint,1,opt,name=stall_height,json=stallHeight,proto3" json:"stall_height,omitempty"`
+	// current_height is the height at which the stall is detected
+	CurrentHeight uint64 `protobuf:"varint,2,opt,name=current_height,json=currentHeight,proto3" json:"current_height,omitempty"`
+	// total_voting_power is the total voting power of the finality provider
+	// set at the stall height
+	TotalVotingPower uint64 `protobuf:"varint,3,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// missing_voting_power is the voting power of the finality providers that
+	// have not voted for the block at the stall height
+	MissingVotingPower uint64 `protobuf:"varint,4,opt,name=missing_voting_power,json=missingVotingPower,proto3" json:"missing_voting_power,omitempty"`
+	// missing_fps is the list of finality providers that have not voted for
+	// the block at the stall height
+	MissingFps []FinalityProviderMissingPower `protobuf:"bytes,5,rep,name=missing_fps,json=missingFps,proto3" json:"missing_fps"`
+}
+
+func (m *EventFinalityStalled) Reset()         { *m = EventFinalityStalled{} }
+func (m *EventFinalityStalled) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityStalled) ProtoMessage()    {}
+func (*EventFinalityStalled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{3}
+}
+func (m *EventFinalityStalled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityStalled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityStalled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalityStalled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityStalled.Merge(m, src)
+}
+func (m *EventFinalityStalled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityStalled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityStalled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalityStalled proto.InternalMessageInfo
+
+func (m *EventFinalityStalled) GetStallHeight() uint64 {
+	if m != nil {
+		return m.StallHeight
+	}
+	return 0
+}
+
+func (m *EventFinalityStalled) GetCurrentHeight() uint64 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+func (m *EventFinalityStalled) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *EventFinalityStalled) GetMissingVotingPower() uint64 {
+	if m != nil {
+		return m.MissingVotingPower
+	}
+	return 0
+}
+
+func (m *EventFinalityStalled) GetMissingFps() []FinalityProviderMissingPower {
+	if m != nil {
+		return m.MissingFps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventSlashedFinalityProvider)(nil), "babylon.finality.v1.EventSlashedFinalityProvider")
 	proto.RegisterType((*EventJailedFinalityProvider)(nil), "babylon.finality.v1.EventJailedFinalityProvider")
+	proto.RegisterType((*EventTombstonedFinalityProvider)(nil), "babylon.finality.v1.EventTombstonedFinalityProvider")
+	proto.RegisterType((*EventFinalityStalled)(nil), "babylon.finality.v1.EventFinalityStalled")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/events.proto", fileDescriptor_c34c03aae5e3e6bf) }
 
 var fileDescriptor_c34c03aae5e3e6bf = []byte{
-	// 231 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x52, 0xc2, 0xa6, 0x0d, 0xae, 0x00, 0xac, 0x51, 0x29,
-	0x92, 0x4b, 0xc6, 0x15, 0x64, 0x50, 0x70, 0x4e, 0x62, 0x71, 0x46, 0x6a, 0x8a, 0x1b, 0x54, 0x36,
-	0xa0, 0x28, 0xbf, 0x2c, 0x33, 0x25, 0xb5, 0x48, 0xc8, 0x92, 0x8b, 0x23, 0x15, 0xc4, 0xca, 0x4b,
-	0x4e, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd5, 0xc3, 0x62, 0x97, 0x9e, 0x2b, 0x54,
-	0x51, 0x10, 0x5c, 0xb9, 0x92, 0x0d, 0x97, 0x34, 0xd8, 0x68, 0xaf, 0xc4, 0xcc, 0x1c, 0x2c, 0x26,
-	0xcb, 0x72, 0x71, 0x15, 0x94, 0x26, 0xe5, 0x64, 0x26, 0xc7, 0x67, 0xa7, 0x56, 0x82, 0xcd, 0xe6,
-	0x0c, 0xe2, 0x84, 0x88, 0x78, 0xa7, 0x56, 0x3a, 0xf9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x51, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e,
-	0xd4, 0x29, 0x39, 0x89, 0x49, 0xc5, 0xba, 0x99, 0xf9, 0x30, 0xae, 0x7e, 0x05, 0xc2, 0xcb, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xdf, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf0,
-	0x48, 0xe5, 0x2e, 0x4a, 0x01, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xae, 0xa0, 0xd5, 0xdd, 0x10, 0x0a, 0x3d, 0x54, 0x85, 0xa5, 0x25, 0x12, 0x52,
+	0x0f, 0x90, 0xd0, 0x72, 0xe2, 0x5a, 0xb4, 0x81, 0xf8, 0x23, 0x4d, 0xd9, 0x40, 0xc0, 0x25, 0x72,
+	0x52, 0x37, 0xf5, 0x70, 0xec, 0x28, 0x76, 0x02, 0xf9, 0x16, 0x3b, 0x73, 0xe6, 0xc3, 0xec, 0xb8,
+	0x23, 0x27, 0x40, 0xed, 0x17, 0x41, 0x7e, 0xe3, 0x8c, 0x3f, 0xea, 0x71, 0x37, 0xfb, 0x79, 0x7e,
+	0xef, 0xf3, 0xbe, 0x7a, 0x6d, 0x34, 0x8e, 0x70, 0x54, 0x31, 0xc1, 0xfd, 0x25, 0xe5, 0x98, 0x51,
+	0x55, 0xf9, 0xe5, 0xd4, 0x27, 0x25, 0xe1, 0x4a, 0x7a, 0x59, 0x2e, 0x94, 0xb0, 0xef, 0x18, 0xc2,
+	0x6b, 0x08, 0xaf, 0x9c, 0x0e, 0xfb, 0x89, 0x48, 0x04, 0xf8, 0xbe, 0x3e, 0xd5, 0xe8, 0x70, 0x94,
+	0x08, 0x91, 0x30, 0xe2, 0xc3, 0x2d, 0x2a, 0x96, 0xbe, 0xa2, 0x29, 0x91, 0x0a, 0xa7, 0x99, 0x01,
+	0xdc, 0x6d, 0xdd, 0xae, 0x72, 0x81, 0x71, 0x3f, 0xa0, 0x7b, 0x87, 0xba, 0xff, 0x09, 0xc3, 0x72,
+	0x45, 0x16, 0x47, 0xc6, 0x3d, 0xce, 0x45, 0x49, 0x17, 0x24, 0xb7, 0x9f, 0xa2, 0x5d, 0xa2, 0x4f,
+	0x3c, 0x26, 0x03, 0x6b, 0x6c, 0x4d, 0x7a, 0xb3, 0x03, 0x6f, 0xcb, 0x88, 0xde, 0xa1, 0x81, 0x82,
+	0x2b, 0xdc, 0xfd, 0x66, 0xa1, 0xbb, 0x90, 0xfd, 0x12, 0x53, 0xb6, 0x25, 0xfa, 0x00, 0xa1, 0xac,
+	0x88, 0x18, 0x8d, 0xc3, 0x4f, 0xa4, 0x82, 0xf0, 0x6e, 0xd0, 0xad, 0x95, 0x57, 0xa4, 0xd2, 0xf6,
+	0x19, 0xa6, 0x2c, 0x8c, 0x45, 0xc1, 0xd5, 0xa0, 0x3d, 0xb6, 0x26, 0xfb, 0x41, 0x57, 0x2b, 0xcf,
+	0xb4, 0x60, 0x3f, 0x47, 0x7b, 0x67, 0x90, 0x1b, 0x16, 0x5c, 0x51, 0x36, 0xd8, 0x81, 0xe1, 0x86,
+	0x5e, 0xbd, 0x14, 0xaf, 0x59, 0x8a, 0x77, 0xda, 0x2c, 0x65, 0xbe, 0x7b, 0xf1, 0x63, 0xd4, 0x3a,
+	0xff, 0x39, 0xb2, 0x82, 0x5e, 0x5d, 0xf9, 0x56, 0x17, 0xba, 0x21, 0x1a, 0xc1, 0x94, 0xa7, 0x22,
+	0x8d, 0xa4, 0x12, 0xfc, 0xba, 0x27, 0x75, 0xbf, 0xb6, 0x51, 0x1f, 0x3a, 0x34, 0xb9, 0x27, 0x0a,
+	0x33, 0x46, 0x16, 0xf6, 0x7d, 0xb4, 0x27, 0xf5, 0x31, 0x5c, 0x11, 0x9a, 0xac, 0x14, 0x04, 0x77,
+	0x82, 0x1e, 0x68, 0x2f, 0x40, 0xb2, 0x1f, 0xa0, 0x5b, 0x71, 0x91, 0xe7, 0x84, 0xab, 0x06, 0x6a,
+	0x03, 0xb4, 0x6f, 0x54, 0x83, 0x3d, 0x44, 0xb6, 0x12, 0x0a, 0xb3, 0xb0, 0x14, 0x8a, 0xf2, 0x24,
+	0xcc, 0xc4, 0x67, 0x92, 0xc3, 0x4a, 0x3a, 0xc1, 0x6d, 0x70, 0xde, 0x81, 0x71, 0xac, 0x75, 0xfb,
+	0x31, 0xea, 0xa7, 0x54, 0x4a, 0x0d, 0xfe, 0xc3, 0x77, 0x80, 0xb7, 0x8d, 0xf7, 0x77, 0xc5, 0x7b,
+	0xd4, 0x6b, 0x2a, 0x96, 0x99, 0x1c, 0xdc, 0x18, 0xef, 0x4c, 0x7a, 0xb3, 0xe9, 0xd6, 0x8f, 0xf0,
+	0xff, 0xf2, 0xde, 0xd4, 0x75, 0x90, 0x33, 0xef, 0xe8, 0x27, 0x08, 0x90, 0xc9, 0x3a, 0xca, 0xe4,
+	0xfc, 0xf5, 0xc5, 0xda, 0xb1, 0x2e, 0xd7, 0x8e, 0xf5, 0x6b, 0xed, 0x58, 0xe7, 0x1b, 0xa7, 0x75,
+	0xb9, 0x71, 0x5a, 0xdf, 0x37, 0x4e, 0xeb, 0xe3, 0x2c, 0xa1, 0x6a, 0x55, 0x44, 0x5e, 0x2c, 0x52,
+	0xdf, 0x34, 0x62, 0x38, 0x92, 0x8f, 0xa8, 0x68, 0xae, 0xfe, 0x97, 0x3f, 0x3f, 0x5b, 0x55, 0x19,
+	0x91, 0xd1, 0x4d, 0x78, 0xf6, 0x27, 0xbf, 0x07, 0x00, 0x6e, 0x13, 0xae, 0x24, 0x68, 0x03, 0x00,
+	0x00,
 }
 
 func (m *EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -197,6 +381,19 @@ func (m *EventJailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintEvents(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.JailCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.PublicKey) > 0 {
 		i -= len(m.PublicKey)
 		copy(dAtA[i:], m.PublicKey)
@@ -207,6 +404,98 @@ func (m *EventJailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *EventTombstonedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTombstonedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTombstonedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFinalityStalled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityStalled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityStalled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingFps) > 0 {
+		for iNdEx := len(m.MissingFps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissingFps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MissingVotingPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MissingVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StallHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StallHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -241,6 +530,54 @@ func (m *EventJailedFinalityProvider) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.JailCount != 0 {
+		n += 1 + sovEvents(uint64(m.JailCount))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventTombstonedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovEvents(uint64(m.JailCount))
+	}
+	return n
+}
+
+func (m *EventFinalityStalled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StallHeight != 0 {
+		n += 1 + sovEvents(uint64(m.StallHeight))
+	}
+	if m.CurrentHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentHeight))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvents(uint64(m.TotalVotingPower))
+	}
+	if m.MissingVotingPower != 0 {
+		n += 1 + sovEvents(uint64(m.MissingVotingPower))
+	}
+	if len(m.MissingFps) > 0 {
+		for _, e := range m.MissingFps {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -397,6 +734,319 @@ func (m *EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
 			}
 			m.PublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTombstonedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTombstonedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTombstonedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFinalityStalled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalityStalled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalityStalled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StallHeight", wireType)
+			}
+			m.StallHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StallHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingVotingPower", wireType)
+			}
+			m.MissingVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingFps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingFps = append(m.MissingFps, FinalityProviderMissingPower{})
+			if err := m.MissingFps[len(m.MissingFps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
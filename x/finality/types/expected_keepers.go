@@ -3,7 +3,9 @@ package types
 import (
 	"context"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	bstypes "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	etypes "github.com/babylonlabs-io/babylon/x/epoching/types"
@@ -22,6 +24,7 @@ type BTCStakingKeeper interface {
 	JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	UpdateFinalityProvider(ctx context.Context, fp *bstypes.FinalityProvider) error
+	BuildSlashingTxsWithWitness(ctx context.Context, fpSK *btcec.PrivateKey, pagination *query.PageRequest) ([]*bstypes.BTCDelegationSlashingTxs, *query.PageResponse, error)
 }
 
 type CheckpointingKeeper interface {
@@ -398,6 +398,16 @@ type FinalityProviderSigningInfo struct {
 	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
 	// Timestamp until which the validator is jailed due to liveness downtime.
 	JailedUntil time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// jail_history is the list of the most recent times the finality provider
+	// has been jailed due to liveness downtime, from the earliest to the latest.
+	// At most MaxJailHistoryLength records are kept.
+	JailHistory []JailRecord `protobuf:"bytes,5,rep,name=jail_history,json=jailHistory,proto3" json:"jail_history"`
+	// tombstoned indicates whether the finality provider has been jailed for
+	// the maximum number of times and thus is permanently jailed
+	Tombstoned bool `protobuf:"varint,6,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// jail_count is the total number of times the finality provider has been
+	// jailed due to liveness downtime
+	JailCount uint32 `protobuf:"varint,7,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
 }
 
 func (m *FinalityProviderSigningInfo) Reset()         { *m = FinalityProviderSigningInfo{} }
@@ -454,6 +464,224 @@ func (m *FinalityProviderSigningInfo) GetJailedUntil() time.Time {
 	return time.Time{}
 }
 
+func (m *FinalityProviderSigningInfo) GetJailHistory() []JailRecord {
+	if m != nil {
+		return m.JailHistory
+	}
+	return nil
+}
+
+func (m *FinalityProviderSigningInfo) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *FinalityProviderSigningInfo) GetJailCount() uint32 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
+// JailRecord records a jailing of a finality provider due to liveness downtime
+type JailRecord struct {
+	// height is the Babylon height at which the finality provider is jailed
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// jailed_at is the block time at which the finality provider is jailed
+	JailedAt time.Time `protobuf:"bytes,2,opt,name=jailed_at,json=jailedAt,proto3,stdtime" json:"jailed_at"`
+	// jailed_until is the time until which the finality provider is jailed
+	JailedUntil time.Time `protobuf:"bytes,3,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+}
+
+func (m *JailRecord) Reset()         { *m = JailRecord{} }
+func (m *JailRecord) String() string { return proto.CompactTextString(m) }
+func (*JailRecord) ProtoMessage()    {}
+func (*JailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{6}
+}
+func (m *JailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailRecord.Merge(m, src)
+}
+func (m *JailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailRecord proto.InternalMessageInfo
+
+func (m *JailRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JailRecord) GetJailedAt() time.Time {
+	if m != nil {
+		return m.JailedAt
+	}
+	return time.Time{}
+}
+
+func (m *JailRecord) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+// FinalityProviderPerformance is the aggregated voting performance of a
+// finality provider, either over its lifetime or within a single epoch
+type FinalityProviderPerformance struct {
+	// blocks_eligible is the number of blocks at which the finality provider
+	// had voting power and was thus expected to vote
+	BlocksEligible uint64 `protobuf:"varint,1,opt,name=blocks_eligible,json=blocksEligible,proto3" json:"blocks_eligible,omitempty"`
+	// blocks_voted is the number of eligible blocks the finality provider voted for
+	BlocksVoted uint64 `protobuf:"varint,2,opt,name=blocks_voted,json=blocksVoted,proto3" json:"blocks_voted,omitempty"`
+	// blocks_missed is the number of eligible blocks the finality provider
+	// did not vote for in time
+	BlocksMissed uint64 `protobuf:"varint,3,opt,name=blocks_missed,json=blocksMissed,proto3" json:"blocks_missed,omitempty"`
+	// times_jailed is the number of times the finality provider has been
+	// jailed due to liveness downtime
+	TimesJailed uint64 `protobuf:"varint,4,opt,name=times_jailed,json=timesJailed,proto3" json:"times_jailed,omitempty"`
+}
+
+func (m *FinalityProviderPerformance) Reset()         { *m = FinalityProviderPerformance{} }
+func (m *FinalityProviderPerformance) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderPerformance) ProtoMessage()    {}
+func (*FinalityProviderPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{7}
+}
+func (m *FinalityProviderPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderPerformance.Merge(m, src)
+}
+func (m *FinalityProviderPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderPerformance proto.InternalMessageInfo
+
+func (m *FinalityProviderPerformance) GetBlocksEligible() uint64 {
+	if m != nil {
+		return m.BlocksEligible
+	}
+	return 0
+}
+
+func (m *FinalityProviderPerformance) GetBlocksVoted() uint64 {
+	if m != nil {
+		return m.BlocksVoted
+	}
+	return 0
+}
+
+func (m *FinalityProviderPerformance) GetBlocksMissed() uint64 {
+	if m != nil {
+		return m.BlocksMissed
+	}
+	return 0
+}
+
+func (m *FinalityProviderPerformance) GetTimesJailed() uint64 {
+	if m != nil {
+		return m.TimesJailed
+	}
+	return 0
+}
+
+// FinalityProviderMissingPower is the voting power of an active finality
+// provider that has not voted for a block
+type FinalityProviderMissingPower struct {
+	// fp_btc_pk_hex is the BTC PK of the finality provider in hex
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// voting_power is the voting power of the finality provider at the block
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *FinalityProviderMissingPower) Reset()         { *m = FinalityProviderMissingPower{} }
+func (m *FinalityProviderMissingPower) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderMissingPower) ProtoMessage()    {}
+func (*FinalityProviderMissingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{8}
+}
+func (m *FinalityProviderMissingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderMissingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderMissingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderMissingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderMissingPower.Merge(m, src)
+}
+func (m *FinalityProviderMissingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderMissingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderMissingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderMissingPower proto.InternalMessageInfo
+
+func (m *FinalityProviderMissingPower) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *FinalityProviderMissingPower) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*VotingPowerDistCache)(nil), "babylon.finality.v1.VotingPowerDistCache")
 	proto.RegisterType((*FinalityProviderDistInfo)(nil), "babylon.finality.v1.FinalityProviderDistInfo")
@@ -461,6 +689,9 @@ func init() {
 	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
 	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
 	proto.RegisterType((*FinalityProviderSigningInfo)(nil), "babylon.finality.v1.FinalityProviderSigningInfo")
+	proto.RegisterType((*JailRecord)(nil), "babylon.finality.v1.JailRecord")
+	proto.RegisterType((*FinalityProviderPerformance)(nil), "babylon.finality.v1.FinalityProviderPerformance")
+	proto.RegisterType((*FinalityProviderMissingPower)(nil), "babylon.finality.v1.FinalityProviderMissingPower")
 }
 
 func init() {
@@ -468,65 +699,77 @@ func init() {
 }
 
 var fileDescriptor_ca5b87e52e3e6d02 = []byte{
-	// 916 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x5f, 0x27, 0x9b, 0xec, 0xee, 0xec, 0x6e, 0x68, 0xa6, 0xa1, 0x72, 0x13, 0xd8, 0xdd, 0xae,
-	0x40, 0x5a, 0xa1, 0xc6, 0xa6, 0x69, 0x85, 0x50, 0x6f, 0x75, 0xd2, 0xaa, 0x81, 0x40, 0x57, 0xde,
-	0x94, 0x03, 0x42, 0x1a, 0x8d, 0xed, 0xb1, 0x3d, 0xac, 0x3d, 0x63, 0x79, 0xc6, 0x4b, 0x97, 0x0f,
-	0x80, 0x38, 0x96, 0x1b, 0x47, 0x8e, 0x1c, 0x39, 0xf0, 0x21, 0x7a, 0x42, 0x15, 0x27, 0x14, 0xa4,
-	0x80, 0x92, 0x03, 0x5f, 0x03, 0x79, 0xec, 0xf5, 0x26, 0x11, 0x08, 0xc4, 0x9f, 0x8b, 0x35, 0xef,
-	0xf7, 0x9e, 0xdf, 0x9f, 0xdf, 0x7b, 0xf3, 0x06, 0x0c, 0x1d, 0xec, 0xcc, 0x23, 0xce, 0x4c, 0x9f,
-	0x32, 0x1c, 0x51, 0x39, 0x37, 0x67, 0x77, 0xaa, 0xb3, 0x91, 0xa4, 0x5c, 0x72, 0x78, 0xbd, 0xb4,
-	0x31, 0x2a, 0x7c, 0x76, 0x67, 0xfb, 0xa6, 0xcb, 0x45, 0xcc, 0x05, 0x52, 0x26, 0x66, 0x21, 0x14,
-	0xf6, 0xdb, 0x5b, 0x01, 0x0f, 0x78, 0x81, 0xe7, 0xa7, 0x12, 0xdd, 0xc4, 0x31, 0x65, 0xdc, 0x54,
-	0xdf, 0x12, 0xea, 0x07, 0x9c, 0x07, 0x11, 0x31, 0x95, 0xe4, 0x64, 0xbe, 0x29, 0x69, 0x4c, 0x84,
-	0xc4, 0x71, 0x52, 0x18, 0x0c, 0x7f, 0xd0, 0xc0, 0xd6, 0x47, 0x5c, 0x52, 0x16, 0x8c, 0xf9, 0x67,
-	0x24, 0x3d, 0xa0, 0x42, 0xee, 0x63, 0x37, 0x24, 0xf0, 0x36, 0x80, 0x92, 0x4b, 0x1c, 0xa1, 0x99,
-	0xd2, 0xa2, 0x24, 0x57, 0xeb, 0xda, 0x40, 0x1b, 0xd5, 0xed, 0x6b, 0x4a, 0x73, 0xe1, 0x37, 0xf8,
-	0x09, 0x80, 0x8b, 0xd4, 0xf3, 0x7c, 0x67, 0xd4, 0x23, 0xa9, 0xd0, 0x57, 0x06, 0xab, 0xa3, 0xf6,
-	0xde, 0xae, 0xf1, 0x07, 0xd5, 0x19, 0x8f, 0xca, 0xf3, 0xb8, 0xb4, 0xce, 0x23, 0x1f, 0x32, 0x9f,
-	0xdb, 0x9b, 0xfe, 0x15, 0x8d, 0x80, 0x6f, 0x80, 0x0d, 0x96, 0xc5, 0x08, 0xbb, 0x92, 0xce, 0x08,
-	0xf2, 0x13, 0xa1, 0xaf, 0x0e, 0xb4, 0x51, 0xd7, 0xee, 0xb0, 0x2c, 0x7e, 0xa0, 0xc0, 0x47, 0x89,
-	0xb8, 0x5f, 0xff, 0xf2, 0x9b, 0x7e, 0x6d, 0xf8, 0xf3, 0x0a, 0xd0, 0xff, 0xcc, 0x37, 0x7c, 0x02,
-	0xd6, 0x1d, 0xe9, 0xa2, 0x64, 0xaa, 0x0a, 0xe9, 0x58, 0xef, 0x9e, 0x9c, 0xf6, 0xef, 0x05, 0x54,
-	0x86, 0x99, 0x63, 0xb8, 0x3c, 0x36, 0xcb, 0x44, 0x23, 0xec, 0x88, 0x5d, 0xca, 0x17, 0xa2, 0x29,
-	0xe7, 0x09, 0x11, 0x86, 0x75, 0x38, 0xbe, 0x7b, 0xef, 0xed, 0x71, 0xe6, 0xbc, 0x4f, 0xe6, 0xf6,
-	0x9a, 0x23, 0xdd, 0xf1, 0x14, 0x42, 0x50, 0xc7, 0x9e, 0x97, 0xea, 0x2b, 0xb9, 0x3b, 0x5b, 0x9d,
-	0xe1, 0x07, 0x00, 0xb8, 0x3c, 0x8e, 0xa9, 0x10, 0x94, 0x33, 0x95, 0x69, 0xcb, 0xda, 0x3d, 0x39,
-	0xed, 0xef, 0x14, 0x2d, 0x14, 0xde, 0xd4, 0xa0, 0xdc, 0x8c, 0xb1, 0x0c, 0x8d, 0x23, 0x12, 0x60,
-	0x77, 0x7e, 0x40, 0xdc, 0x1f, 0xbf, 0xdf, 0x05, 0x65, 0x87, 0x0f, 0x88, 0x6b, 0x5f, 0x70, 0x00,
-	0x47, 0xa0, 0xa0, 0x1b, 0x39, 0x9c, 0x79, 0xc4, 0x43, 0x02, 0x4b, 0xbd, 0xae, 0xda, 0xb0, 0xa1,
-	0x70, 0x4b, 0xc1, 0x13, 0x2c, 0xe1, 0x9b, 0x60, 0x83, 0x0a, 0x54, 0x75, 0x98, 0x78, 0xfa, 0xda,
-	0x40, 0x1b, 0x35, 0xed, 0x2e, 0x15, 0xc7, 0x4b, 0x10, 0xee, 0x80, 0x16, 0x15, 0xe8, 0x53, 0x4c,
-	0x23, 0xe2, 0xe9, 0xeb, 0xca, 0xa2, 0x49, 0xc5, 0x7b, 0x4a, 0x86, 0xaf, 0x03, 0x40, 0x05, 0x12,
-	0x11, 0x16, 0x21, 0xf1, 0xf4, 0x86, 0xd2, 0xb6, 0xa8, 0x98, 0x14, 0xc0, 0x10, 0x81, 0xce, 0x21,
-	0xf3, 0xc8, 0x33, 0xe2, 0x59, 0x11, 0x77, 0xa7, 0xf0, 0x06, 0x58, 0x0f, 0x09, 0x0d, 0x42, 0x59,
-	0x4e, 0x46, 0x29, 0xc1, 0x9b, 0xa0, 0x89, 0x93, 0x04, 0x85, 0x58, 0x84, 0x25, 0x37, 0x0d, 0x9c,
-	0x24, 0x8f, 0xb1, 0x08, 0xe1, 0x6b, 0xa0, 0x55, 0x74, 0xf8, 0x73, 0xe2, 0x29, 0x76, 0x9a, 0xf6,
-	0x12, 0x18, 0x7e, 0xa5, 0x81, 0xee, 0x38, 0x73, 0x6c, 0xcc, 0xbc, 0xfd, 0x9c, 0x03, 0x09, 0x6f,
-	0x81, 0x8e, 0x90, 0x38, 0x95, 0xe8, 0x52, 0xa0, 0xb6, 0xc2, 0x1e, 0x17, 0xd1, 0x06, 0x20, 0x9f,
-	0x04, 0x94, 0x64, 0x0e, 0x4a, 0x31, 0xf3, 0x54, 0xc4, 0xba, 0x0d, 0x58, 0x16, 0x97, 0xae, 0x60,
-	0xaf, 0xec, 0x89, 0x8c, 0x09, 0x93, 0x2a, 0x6a, 0xc7, 0xbe, 0x80, 0xe4, 0x9c, 0x90, 0x84, 0xbb,
-	0x21, 0x62, 0x59, 0x5c, 0xb2, 0xdb, 0x54, 0xc0, 0x87, 0x59, 0x3c, 0xfc, 0xa2, 0x0e, 0x9a, 0x0f,
-	0xf3, 0x41, 0x62, 0x2e, 0x81, 0xc7, 0xa0, 0xe5, 0x27, 0xe8, 0x3f, 0x9a, 0xa2, 0x86, 0x9f, 0x58,
-	0x6a, 0x8e, 0x6e, 0x81, 0x8e, 0x93, 0x13, 0xba, 0x28, 0xb2, 0xa8, 0xa0, 0xad, 0xb0, 0xb2, 0xc8,
-	0xa7, 0xa0, 0x59, 0x15, 0xa8, 0x0a, 0xb0, 0xee, 0x9f, 0x9c, 0xf6, 0xdf, 0xf9, 0xbb, 0x71, 0x27,
-	0x6e, 0xc8, 0x78, 0x9a, 0x96, 0x84, 0xd8, 0x8d, 0xa4, 0x64, 0xe6, 0x36, 0x80, 0x2e, 0x66, 0x9c,
-	0x51, 0x17, 0x47, 0xa8, 0xea, 0x59, 0x5d, 0x31, 0x74, 0xad, 0xd2, 0x3c, 0x28, 0x9b, 0x37, 0x04,
-	0x5d, 0x9f, 0xa7, 0xd3, 0xa5, 0xe1, 0x9a, 0x32, 0x6c, 0xe7, 0xe0, 0xc2, 0x26, 0x01, 0x37, 0x96,
-	0x1e, 0xab, 0xad, 0x20, 0x68, 0xa0, 0x86, 0xed, 0x9f, 0xa5, 0xfd, 0xf0, 0xc9, 0xf1, 0x64, 0x42,
-	0x03, 0x7b, 0xab, 0xf2, 0xbc, 0xb8, 0xe3, 0x13, 0x1a, 0x40, 0x1f, 0x6c, 0xaa, 0xac, 0x2e, 0x05,
-	0x6b, 0xfc, 0xeb, 0x60, 0xaf, 0xe4, 0x4e, 0x2f, 0xc4, 0x19, 0x7e, 0xbd, 0x02, 0x76, 0xae, 0xee,
-	0x96, 0x09, 0x0d, 0x18, 0x65, 0x81, 0x5a, 0x2f, 0xff, 0xdb, 0x6c, 0x5c, 0xba, 0x00, 0xf9, 0x6c,
-	0xac, 0x5e, 0xbe, 0x00, 0x7b, 0xe0, 0xd5, 0x7c, 0x5d, 0x10, 0x0f, 0xa9, 0x89, 0x11, 0xc8, 0xe5,
-	0x19, 0x93, 0x24, 0x55, 0x83, 0xb2, 0x6a, 0x5f, 0x2f, 0x94, 0xea, 0xca, 0x8a, 0xfd, 0x42, 0x05,
-	0x8f, 0x40, 0xa7, 0xd8, 0x01, 0x28, 0x63, 0x92, 0x46, 0xaa, 0xe5, 0xed, 0xbd, 0x6d, 0xa3, 0x78,
-	0x31, 0x8c, 0xc5, 0x8b, 0x61, 0x54, 0xab, 0xc3, 0xea, 0xbe, 0x38, 0xed, 0xd7, 0x9e, 0xff, 0xd2,
-	0xd7, 0xbe, 0xfd, 0xed, 0xbb, 0xb7, 0x34, 0xbb, 0x5d, 0xfc, 0xfe, 0x34, 0xff, 0xdb, 0x3a, 0x7a,
-	0x71, 0xd6, 0xd3, 0x5e, 0x9e, 0xf5, 0xb4, 0x5f, 0xcf, 0x7a, 0xda, 0xf3, 0xf3, 0x5e, 0xed, 0xe5,
-	0x79, 0xaf, 0xf6, 0xd3, 0x79, 0xaf, 0xf6, 0xf1, 0xde, 0x5f, 0x57, 0xff, 0x6c, 0xf9, 0x36, 0x2a,
-	0x22, 0x9c, 0x75, 0x15, 0xfd, 0xee, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0x04, 0xb9, 0x65, 0xd0,
-	0x3c, 0x07, 0x00, 0x00,
+	// 1120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xd6, 0x4e, 0x62, 0x8f, 0xed, 0xb4, 0x9d, 0xf6, 0x5f, 0xb9, 0x2f, 0x7f, 0x3b, 0x35,
+	0x20, 0x22, 0xd4, 0xd8, 0x34, 0xad, 0x10, 0xea, 0xad, 0x6e, 0x1b, 0x25, 0x90, 0x52, 0x6b, 0x9d,
+	0xf6, 0x80, 0x90, 0x46, 0xb3, 0xbb, 0xe3, 0xdd, 0x21, 0xbb, 0x33, 0xab, 0x9d, 0x59, 0x13, 0xf3,
+	0x01, 0x10, 0xc7, 0xf2, 0x0d, 0xe0, 0xc6, 0x11, 0x24, 0x4e, 0x7c, 0x82, 0x9e, 0x50, 0xc5, 0x09,
+	0x05, 0x29, 0xa0, 0xe4, 0xc0, 0xd7, 0x40, 0xf3, 0xe2, 0x75, 0x9c, 0x16, 0xf1, 0x52, 0xb8, 0xac,
+	0x76, 0x7e, 0xf3, 0xcc, 0xf3, 0xfe, 0xfc, 0x66, 0x40, 0xc7, 0xc3, 0xde, 0x24, 0xe6, 0xac, 0x37,
+	0xa2, 0x0c, 0xc7, 0x54, 0x4e, 0x7a, 0xe3, 0x9b, 0xc5, 0x7f, 0x37, 0xcd, 0xb8, 0xe4, 0xf0, 0x82,
+	0x95, 0xe9, 0x16, 0xf8, 0xf8, 0xe6, 0x95, 0xcb, 0x3e, 0x17, 0x09, 0x17, 0x48, 0x8b, 0xf4, 0xcc,
+	0xc2, 0xc8, 0x5f, 0xb9, 0x18, 0xf2, 0x90, 0x1b, 0x5c, 0xfd, 0x59, 0xf4, 0x3c, 0x4e, 0x28, 0xe3,
+	0x3d, 0xfd, 0xb5, 0x50, 0x3b, 0xe4, 0x3c, 0x8c, 0x49, 0x4f, 0xaf, 0xbc, 0x7c, 0xd4, 0x93, 0x34,
+	0x21, 0x42, 0xe2, 0x24, 0x35, 0x02, 0x9d, 0x1f, 0x1c, 0x70, 0xf1, 0x09, 0x97, 0x94, 0x85, 0x03,
+	0xfe, 0x09, 0xc9, 0xee, 0x53, 0x21, 0xef, 0x61, 0x3f, 0x22, 0xf0, 0x06, 0x80, 0x92, 0x4b, 0x1c,
+	0xa3, 0xb1, 0xde, 0x45, 0xa9, 0xda, 0x6e, 0x3a, 0xab, 0xce, 0x5a, 0xd9, 0x3d, 0xa7, 0x77, 0x4e,
+	0x1c, 0x83, 0x1f, 0x01, 0x38, 0x75, 0x5d, 0xf9, 0x3b, 0xa6, 0x01, 0xc9, 0x44, 0xf3, 0xcc, 0x6a,
+	0x69, 0xad, 0xb6, 0xb1, 0xde, 0x7d, 0x49, 0x74, 0xdd, 0x4d, 0xfb, 0x3f, 0xb0, 0xd2, 0xca, 0xf2,
+	0x36, 0x1b, 0x71, 0xf7, 0xfc, 0xe8, 0xd4, 0x8e, 0x80, 0xaf, 0x83, 0x15, 0x96, 0x27, 0x08, 0xfb,
+	0x92, 0x8e, 0x09, 0x1a, 0xa5, 0xa2, 0x59, 0x5a, 0x75, 0xd6, 0x1a, 0x6e, 0x9d, 0xe5, 0xc9, 0x5d,
+	0x0d, 0x6e, 0xa6, 0xe2, 0x4e, 0xf9, 0xf3, 0x2f, 0xdb, 0x0b, 0x9d, 0x9f, 0xcf, 0x80, 0xe6, 0x1f,
+	0xe9, 0x86, 0x8f, 0xc0, 0x92, 0x27, 0x7d, 0x94, 0xee, 0xe9, 0x40, 0xea, 0xfd, 0x77, 0x0f, 0x0e,
+	0xdb, 0xb7, 0x43, 0x2a, 0xa3, 0xdc, 0xeb, 0xfa, 0x3c, 0xe9, 0x59, 0x47, 0x63, 0xec, 0x89, 0x75,
+	0xca, 0xa7, 0xcb, 0x9e, 0x9c, 0xa4, 0x44, 0x74, 0xfb, 0xdb, 0x83, 0x5b, 0xb7, 0xdf, 0x1e, 0xe4,
+	0xde, 0xfb, 0x64, 0xe2, 0x2e, 0x7a, 0xd2, 0x1f, 0xec, 0x41, 0x08, 0xca, 0x38, 0x08, 0xb2, 0xe6,
+	0x19, 0xa5, 0xce, 0xd5, 0xff, 0xf0, 0x21, 0x00, 0x3e, 0x4f, 0x12, 0x2a, 0x04, 0xe5, 0x4c, 0x7b,
+	0x5a, 0xed, 0xaf, 0x1f, 0x1c, 0xb6, 0xaf, 0x9a, 0x12, 0x8a, 0x60, 0xaf, 0x4b, 0x79, 0x2f, 0xc1,
+	0x32, 0xea, 0xee, 0x90, 0x10, 0xfb, 0x93, 0xfb, 0xc4, 0xff, 0xf1, 0xbb, 0x75, 0x60, 0x2b, 0x7c,
+	0x9f, 0xf8, 0xee, 0x09, 0x05, 0x70, 0x0d, 0x98, 0x74, 0x23, 0x8f, 0xb3, 0x80, 0x04, 0x48, 0x60,
+	0xd9, 0x2c, 0xeb, 0x32, 0xac, 0x68, 0xbc, 0xaf, 0xe1, 0x21, 0x96, 0xf0, 0x0d, 0xb0, 0x42, 0x05,
+	0x2a, 0x2a, 0x4c, 0x82, 0xe6, 0xe2, 0xaa, 0xb3, 0x56, 0x71, 0x1b, 0x54, 0xec, 0xce, 0x40, 0x78,
+	0x15, 0x54, 0xa9, 0x40, 0x1f, 0x63, 0x1a, 0x93, 0xa0, 0xb9, 0xa4, 0x25, 0x2a, 0x54, 0xbc, 0xa7,
+	0xd7, 0xf0, 0xff, 0x00, 0x50, 0x81, 0x44, 0x8c, 0x45, 0x44, 0x82, 0xe6, 0xb2, 0xde, 0xad, 0x52,
+	0x31, 0x34, 0x40, 0x07, 0x81, 0xfa, 0x36, 0x0b, 0xc8, 0x3e, 0x09, 0xfa, 0x31, 0xf7, 0xf7, 0xe0,
+	0x25, 0xb0, 0x14, 0x11, 0x1a, 0x46, 0xd2, 0x76, 0x86, 0x5d, 0xc1, 0xcb, 0xa0, 0x82, 0xd3, 0x14,
+	0x45, 0x58, 0x44, 0x36, 0x37, 0xcb, 0x38, 0x4d, 0xb7, 0xb0, 0x88, 0xe0, 0x35, 0x50, 0x35, 0x15,
+	0xfe, 0x94, 0x04, 0x3a, 0x3b, 0x15, 0x77, 0x06, 0x74, 0xbe, 0x70, 0x40, 0x63, 0x90, 0x7b, 0x2e,
+	0x66, 0xc1, 0x3d, 0x95, 0x03, 0x09, 0xaf, 0x83, 0xba, 0x90, 0x38, 0x93, 0x68, 0xce, 0x50, 0x4d,
+	0x63, 0x5b, 0xc6, 0xda, 0x2a, 0x50, 0x9d, 0x80, 0xd2, 0xdc, 0x43, 0x19, 0x66, 0x81, 0xb6, 0x58,
+	0x76, 0x01, 0xcb, 0x13, 0xab, 0x0a, 0xb6, 0x6c, 0x4d, 0x64, 0x42, 0x98, 0xd4, 0x56, 0xeb, 0xee,
+	0x09, 0x44, 0xe5, 0x84, 0xa4, 0xdc, 0x8f, 0x10, 0xcb, 0x13, 0x9b, 0xdd, 0x8a, 0x06, 0x3e, 0xc8,
+	0x93, 0xce, 0x67, 0x65, 0x50, 0x79, 0xa0, 0x1a, 0x89, 0xf9, 0x04, 0xee, 0x82, 0xea, 0x28, 0x45,
+	0xff, 0x52, 0x17, 0x2d, 0x8f, 0xd2, 0xbe, 0xee, 0xa3, 0xeb, 0xa0, 0xee, 0xa9, 0x84, 0x4e, 0x83,
+	0x34, 0x11, 0xd4, 0x34, 0x66, 0x83, 0x7c, 0x0c, 0x2a, 0x45, 0x80, 0x3a, 0x80, 0xfe, 0x9d, 0x83,
+	0xc3, 0xf6, 0x3b, 0x7f, 0xd5, 0xee, 0xd0, 0x8f, 0x18, 0xcf, 0x32, 0x9b, 0x10, 0x77, 0x39, 0xb5,
+	0x99, 0xb9, 0x01, 0xa0, 0x8f, 0x19, 0x67, 0xd4, 0xc7, 0x31, 0x2a, 0x6a, 0x56, 0xd6, 0x19, 0x3a,
+	0x57, 0xec, 0xdc, 0xb5, 0xc5, 0xeb, 0x80, 0xc6, 0x88, 0x67, 0x7b, 0x33, 0xc1, 0x45, 0x2d, 0x58,
+	0x53, 0xe0, 0x54, 0x26, 0x05, 0x97, 0x66, 0x1a, 0x0b, 0x56, 0x10, 0x34, 0xd4, 0xcd, 0xf6, 0xcf,
+	0xdc, 0x7e, 0xf0, 0x68, 0x77, 0x38, 0xa4, 0xa1, 0x7b, 0xb1, 0xd0, 0x3c, 0x9d, 0xf1, 0x21, 0x0d,
+	0xe1, 0x08, 0x9c, 0xd7, 0x5e, 0xcd, 0x19, 0x5b, 0x7e, 0x65, 0x63, 0x67, 0x95, 0xd2, 0x13, 0x76,
+	0x3a, 0x5f, 0x95, 0xc0, 0xd5, 0xd3, 0xdc, 0x32, 0xa4, 0x21, 0xa3, 0x2c, 0xd4, 0xf4, 0xf2, 0x9f,
+	0xf5, 0xc6, 0xdc, 0x00, 0xa8, 0xde, 0x28, 0xcd, 0x0f, 0xc0, 0x06, 0xf8, 0x9f, 0xa2, 0x0b, 0x12,
+	0x20, 0xdd, 0x31, 0x02, 0xf9, 0x3c, 0x67, 0x92, 0x64, 0xba, 0x51, 0x4a, 0xee, 0x05, 0xb3, 0xa9,
+	0x47, 0x56, 0xdc, 0x33, 0x5b, 0x70, 0x07, 0xd4, 0x0d, 0x07, 0xa0, 0x9c, 0x49, 0x1a, 0xeb, 0x92,
+	0xd7, 0x36, 0xae, 0x74, 0xcd, 0x8d, 0xd1, 0x9d, 0xde, 0x18, 0xdd, 0x82, 0x3a, 0xfa, 0x8d, 0x67,
+	0x87, 0xed, 0x85, 0xa7, 0xbf, 0xb4, 0x9d, 0xaf, 0x7f, 0xfb, 0xe6, 0x2d, 0xc7, 0xad, 0x99, 0xe3,
+	0x8f, 0xd5, 0x69, 0xb8, 0x65, 0xb4, 0xa1, 0x88, 0x0a, 0xc9, 0xb3, 0x49, 0x73, 0x51, 0x53, 0x7f,
+	0xfb, 0xa5, 0xd4, 0xaf, 0xa8, 0xc6, 0x25, 0x3e, 0xcf, 0x82, 0x7e, 0x59, 0xa9, 0x34, 0x9a, 0xb6,
+	0xcc, 0x49, 0x35, 0xaa, 0x92, 0x27, 0x9e, 0x90, 0x9c, 0x15, 0xfc, 0x74, 0x02, 0x51, 0x0c, 0xa5,
+	0x2d, 0xe9, 0x10, 0x75, 0x95, 0x1b, 0x6e, 0x55, 0x21, 0x3a, 0xb0, 0xce, 0xf7, 0x0e, 0x00, 0x33,
+	0x03, 0xa7, 0x08, 0xaa, 0x54, 0x10, 0xd4, 0x26, 0xa8, 0xda, 0xe8, 0xb1, 0xc9, 0xe8, 0xdf, 0x0a,
+	0xbd, 0x62, 0xce, 0xde, 0x95, 0x2f, 0x64, 0xb1, 0xf4, 0x2a, 0x59, 0xec, 0x7c, 0xeb, 0xbc, 0xd8,
+	0x60, 0x03, 0x92, 0x8d, 0x78, 0x96, 0x60, 0x45, 0x3e, 0x6f, 0x82, 0xb3, 0xb6, 0xc0, 0x24, 0xa6,
+	0x21, 0xf5, 0x62, 0x62, 0xe9, 0x70, 0xc5, 0xc0, 0x0f, 0x2c, 0x5a, 0xf0, 0x89, 0x50, 0xd7, 0x37,
+	0x09, 0xe6, 0xf8, 0x44, 0x3c, 0x51, 0x10, 0x7c, 0x0d, 0x34, 0xac, 0x88, 0xe9, 0x0e, 0xed, 0x7a,
+	0xd9, 0xb5, 0xe7, 0x1e, 0x6a, 0x4c, 0xe9, 0xd1, 0xf7, 0xc9, 0xf4, 0xba, 0x30, 0xd4, 0x58, 0xd3,
+	0x98, 0xb9, 0x31, 0x3a, 0x01, 0xb8, 0x76, 0xda, 0x65, 0x75, 0xb8, 0x78, 0x1a, 0x5c, 0x07, 0x8d,
+	0x62, 0x28, 0x50, 0x44, 0xf6, 0xb5, 0xc7, 0x55, 0x17, 0xd8, 0xf6, 0xde, 0x22, 0xfb, 0xca, 0xca,
+	0xdc, 0x2b, 0xc3, 0x7a, 0x3b, 0x9e, 0x3d, 0x30, 0xfa, 0x3b, 0xcf, 0x8e, 0x5a, 0xce, 0xf3, 0xa3,
+	0x96, 0xf3, 0xeb, 0x51, 0xcb, 0x79, 0x7a, 0xdc, 0x5a, 0x78, 0x7e, 0xdc, 0x5a, 0xf8, 0xe9, 0xb8,
+	0xb5, 0xf0, 0xe1, 0xc6, 0x9f, 0x4f, 0xd7, 0xfe, 0xec, 0xed, 0xa5, 0x07, 0xcd, 0x5b, 0xd2, 0x75,
+	0xb9, 0xf5, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x4b, 0xfa, 0x20, 0x9c, 0x09, 0x00, 0x00,
 }
 
 func (m *VotingPowerDistCache) Marshal() (dAtA []byte, err error) {
@@ -865,6 +1108,35 @@ func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.JailCount != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.JailHistory) > 0 {
+		for iNdEx := len(m.JailHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
 	if err1 != nil {
 		return 0, err1
@@ -898,6 +1170,128 @@ func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *JailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintFinality(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFinality(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimesJailed != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.TimesJailed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlocksMissed != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.BlocksMissed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlocksVoted != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.BlocksVoted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlocksEligible != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.BlocksEligible))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderMissingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderMissingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderMissingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFinality(v)
 	base := offset
@@ -1058,18 +1452,83 @@ func (m *FinalityProviderSigningInfo) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
 	n += 1 + l + sovFinality(uint64(l))
+	if len(m.JailHistory) > 0 {
+		for _, e := range m.JailHistory {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovFinality(uint64(m.JailCount))
+	}
 	return n
 }
 
-func sovFinality(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFinality(x uint64) (n int) {
-	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *JailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFinality(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedAt)
+	n += 1 + l + sovFinality(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovFinality(uint64(l))
+	return n
 }
-func (m *VotingPowerDistCache) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *FinalityProviderPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksEligible != 0 {
+		n += 1 + sovFinality(uint64(m.BlocksEligible))
+	}
+	if m.BlocksVoted != 0 {
+		n += 1 + sovFinality(uint64(m.BlocksVoted))
+	}
+	if m.BlocksMissed != 0 {
+		n += 1 + sovFinality(uint64(m.BlocksMissed))
+	}
+	if m.TimesJailed != 0 {
+		n += 1 + sovFinality(uint64(m.TimesJailed))
+	}
+	return n
+}
+
+func (m *FinalityProviderMissingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func sovFinality(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFinality(x uint64) (n int) {
+	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VotingPowerDistCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -2099,6 +2558,441 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailHistory = append(m.JailHistory, JailRecord{})
+			if err := m.JailHistory[len(m.JailHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JailRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksEligible", wireType)
+			}
+			m.BlocksEligible = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksEligible |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksVoted", wireType)
+			}
+			m.BlocksVoted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksVoted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksMissed", wireType)
+			}
+			m.BlocksMissed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksMissed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimesJailed", wireType)
+			}
+			m.TimesJailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimesJailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderMissingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderMissingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderMissingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			m.VotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
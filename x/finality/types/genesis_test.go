@@ -28,6 +28,24 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "jail duration multiplier too large",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.JailDurationMultiplier = types.MaxJailDurationMultiplier + 1
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "max jail count exceeding the jail history length",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.MaxJailCount = types.MaxJailHistoryLength + 1
+				return genState
+			}(),
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
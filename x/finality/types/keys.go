@@ -35,19 +35,33 @@ const (
 	// As for the storage overhead, with the same factor f, it is as follows:
 	// (N - 256) + (N / ChunkSize) * (512 * f)
 	MissedBlockBitmapChunkSize = 1024 // 2^10 bits
+
+	// MaxPrunedHeightsPerBlock defines the maximum number of heights whose
+	// public randomness, votes and voting power tables are pruned in a single
+	// block, so that catching up with a large retention backlog does not
+	// blow up the EndBlock execution time
+	MaxPrunedHeightsPerBlock = 100
 )
 
 var (
-	BlockKey                                   = []byte{0x01}             // key prefix for blocks
-	VoteKey                                    = []byte{0x02}             // key prefix for votes
-	PubRandKey                                 = []byte{0x03}             // key prefix for public randomness
-	PubRandCommitKey                           = []byte{0x04}             // key prefix for commitment of public randomness
-	ParamsKey                                  = []byte{0x05}             // key prefix for the parameters
-	EvidenceKey                                = []byte{0x06}             // key prefix for evidences
-	NextHeightToFinalizeKey                    = []byte{0x07}             // key prefix for next height to finalise
-	FinalityProviderSigningInfoKeyPrefix       = collections.NewPrefix(8) // key prefix for signing info
-	FinalityProviderMissedBlockBitmapKeyPrefix = collections.NewPrefix(9) // key prefix for missed block bitmap
-	VotingPowerKey                             = []byte{0x10}             // key prefix for the voting power
-	VotingPowerDistCacheKey                    = []byte{0x11}             // key prefix for voting power distribution cache
-	NextHeightToRewardKey                      = []byte{0x012}            // key prefix for next height to reward
+	BlockKey                                   = []byte{0x01}              // key prefix for blocks
+	VoteKey                                    = []byte{0x02}              // key prefix for votes
+	PubRandKey                                 = []byte{0x03}              // key prefix for public randomness
+	PubRandCommitKey                           = []byte{0x04}              // key prefix for commitment of public randomness
+	ParamsKey                                  = []byte{0x05}              // key prefix for the parameters
+	EvidenceKey                                = []byte{0x06}              // key prefix for evidences
+	NextHeightToFinalizeKey                    = []byte{0x07}              // key prefix for next height to finalise
+	FinalityProviderSigningInfoKeyPrefix       = collections.NewPrefix(8)  // key prefix for signing info
+	FinalityProviderMissedBlockBitmapKeyPrefix = collections.NewPrefix(9)  // key prefix for missed block bitmap
+	VotingPowerKey                             = []byte{0x10}              // key prefix for the voting power
+	VotingPowerDistCacheKey                    = []byte{0x11}              // key prefix for voting power distribution cache
+	NextHeightToRewardKey                      = []byte{0x012}             // key prefix for next height to reward
+	NextHeightToPruneKey                       = []byte{0x13}              // key prefix for next height to prune
+	BTCStakingActivatedHeightKey               = []byte{0x14}              // key prefix for the BTC staking activated height
+	FinalityProviderPerformanceKeyPrefix       = collections.NewPrefix(21) // key prefix for lifetime finality provider performance
+	FinalityProviderEpochPerformanceKeyPrefix  = collections.NewPrefix(22) // key prefix for per-epoch finality provider performance
+	TotalVotingPowerKey                        = []byte{0x17}              // key prefix for the total voting power at each height
+	VotedPowerKey                              = []byte{0x18}              // key prefix for the accumulated voted power at each height
+	FinalityStallHeightKey                     = []byte{0x19}              // key prefix for the height of the detected finality stall
+	NextEpochPerformanceToPruneKey             = []byte{0x1a}              // key prefix for the next epoch whose finality provider performance to prune
 )
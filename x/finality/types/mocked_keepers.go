@@ -10,7 +10,9 @@ import (
 
 	types "github.com/babylonlabs-io/babylon/x/btcstaking/types"
 	types0 "github.com/babylonlabs-io/babylon/x/epoching/types"
+	v2 "github.com/btcsuite/btcd/btcec/v2"
 	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -37,6 +39,22 @@ func (m *MockBTCStakingKeeper) EXPECT() *MockBTCStakingKeeperMockRecorder {
 	return m.recorder
 }
 
+// BuildSlashingTxsWithWitness mocks base method.
+func (m *MockBTCStakingKeeper) BuildSlashingTxsWithWitness(ctx context.Context, fpSK *v2.PrivateKey, pagination *query.PageRequest) ([]*types.BTCDelegationSlashingTxs, *query.PageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildSlashingTxsWithWitness", ctx, fpSK, pagination)
+	ret0, _ := ret[0].([]*types.BTCDelegationSlashingTxs)
+	ret1, _ := ret[1].(*query.PageResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BuildSlashingTxsWithWitness indicates an expected call of BuildSlashingTxsWithWitness.
+func (mr *MockBTCStakingKeeperMockRecorder) BuildSlashingTxsWithWitness(ctx, fpSK, pagination interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildSlashingTxsWithWitness", reflect.TypeOf((*MockBTCStakingKeeper)(nil).BuildSlashingTxsWithWitness), ctx, fpSK, pagination)
+}
+
 // ClearPowerDistUpdateEvents mocks base method.
 func (m *MockBTCStakingKeeper) ClearPowerDistUpdateEvents(ctx context.Context, btcHeight uint32) {
 	m.ctrl.T.Helper()
@@ -23,12 +23,34 @@ const (
 	// be 17280 + 220 = 17500.
 	// For now it is set to 1 to avoid breaking dependencies.
 	DefaultFinalityActivationHeight = 1
+	// Pruning of historical finality state is disabled by default
+	DefaultStateRetentionBlocks = 0
+	// Jail durations do not escalate and finality providers are never
+	// tombstoned by default
+	DefaultJailDurationMultiplier = 1
+	DefaultMaxJailCount           = 0
+	// Finality is considered stalled if no block is finalized for 100 blocks
+	DefaultFinalityStallThreshold = 100
 )
 
 var (
 	DefaultMinSignedPerWindow = math.LegacyNewDecWithPrec(5, 1)
 )
 
+const (
+	// MaxJailDurationMultiplier is the maximum factor by which the jail
+	// duration can grow with each subsequent jailing
+	MaxJailDurationMultiplier = 100
+	// MaxJailHistoryLength is the maximum number of jail records kept in the
+	// signing info of a finality provider. The max jail count cannot exceed
+	// it, so that the full jail history of tombstoned finality providers is
+	// kept.
+	MaxJailHistoryLength = 100
+)
+
+// maxDuration is the maximum representable time.Duration
+const maxDuration = time.Duration(1<<63 - 1)
+
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 // DefaultParams returns a default set of parameters
@@ -41,6 +63,10 @@ func DefaultParams() Params {
 		MinPubRand:                 DefaultMinPubRand,
 		JailDuration:               DefaultJailDuration,
 		FinalityActivationHeight:   DefaultFinalityActivationHeight,
+		StateRetentionBlocks:       DefaultStateRetentionBlocks,
+		JailDurationMultiplier:     DefaultJailDurationMultiplier,
+		MaxJailCount:               DefaultMaxJailCount,
+		FinalityStallThreshold:     DefaultFinalityStallThreshold,
 	}
 }
 
@@ -85,6 +111,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateJailDurationMultiplier(p.JailDurationMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateMaxJailCount(p.MaxJailCount); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -142,6 +176,22 @@ func validateMinSignedPerWindow(i interface{}) error {
 	return nil
 }
 
+func validateJailDurationMultiplier(multiplier uint32) error {
+	if multiplier > MaxJailDurationMultiplier {
+		return fmt.Errorf("jail duration multiplier cannot exceed %d: %d", MaxJailDurationMultiplier, multiplier)
+	}
+
+	return nil
+}
+
+func validateMaxJailCount(maxJailCount uint32) error {
+	if maxJailCount > MaxJailHistoryLength {
+		return fmt.Errorf("max jail count cannot exceed %d: %d", MaxJailHistoryLength, maxJailCount)
+	}
+
+	return nil
+}
+
 // MinSignedPerWindowInt returns min signed per window as an integer (vs the decimal in the param)
 func (p *Params) MinSignedPerWindowInt() int64 {
 	signedBlocksWindow := p.SignedBlocksWindow
@@ -151,3 +201,24 @@ func (p *Params) MinSignedPerWindowInt() int64 {
 	//       less than 1.
 	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
 }
+
+// JailDurationForCount returns the jail duration of the jailCount-th jailing
+// (starting from 1) of a finality provider, i.e.,
+// JailDuration * JailDurationMultiplier^(jailCount-1), saturating at the
+// maximum duration
+func (p *Params) JailDurationForCount(jailCount uint32) time.Duration {
+	duration := p.JailDuration
+	if p.JailDurationMultiplier <= 1 {
+		return duration
+	}
+
+	multiplier := time.Duration(p.JailDurationMultiplier)
+	for i := uint32(1); i < jailCount; i++ {
+		if duration > maxDuration/multiplier {
+			return maxDuration
+		}
+		duration *= multiplier
+	}
+
+	return duration
+}
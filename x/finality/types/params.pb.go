@@ -51,6 +51,22 @@ type Params struct {
 	// start to accept finality voting and the minimum allowed value for the public randomness
 	// commit start height.
 	FinalityActivationHeight uint64 `protobuf:"varint,7,opt,name=finality_activation_height,json=finalityActivationHeight,proto3" json:"finality_activation_height,omitempty"`
+	// state_retention_blocks is the number of blocks below the last finalized height
+	// for which public randomness, votes and voting power tables are kept. Older
+	// state is pruned in bounded chunks at the end of each block. Zero disables pruning.
+	StateRetentionBlocks uint64 `protobuf:"varint,8,opt,name=state_retention_blocks,json=stateRetentionBlocks,proto3" json:"state_retention_blocks,omitempty"`
+	// jail_duration_multiplier is the factor by which the jail duration grows
+	// with each subsequent jailing of the same finality provider, i.e., the n-th
+	// jailing lasts jail_duration * jail_duration_multiplier^(n-1).
+	// Values 0 and 1 keep the jail duration fixed.
+	JailDurationMultiplier uint32 `protobuf:"varint,9,opt,name=jail_duration_multiplier,json=jailDurationMultiplier,proto3" json:"jail_duration_multiplier,omitempty"`
+	// max_jail_count is the number of times a finality provider can be jailed
+	// before it is tombstoned, i.e., permanently jailed. Zero disables tombstoning.
+	MaxJailCount uint32 `protobuf:"varint,10,opt,name=max_jail_count,json=maxJailCount,proto3" json:"max_jail_count,omitempty"`
+	// finality_stall_threshold is the number of blocks without a newly finalized
+	// block after which finality is considered stalled and a stall event is
+	// emitted. Zero disables the stall detection.
+	FinalityStallThreshold uint64 `protobuf:"varint,11,opt,name=finality_stall_threshold,json=finalityStallThreshold,proto3" json:"finality_stall_threshold,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,6 +143,34 @@ func (m *Params) GetFinalityActivationHeight() uint64 {
 	return 0
 }
 
+func (m *Params) GetStateRetentionBlocks() uint64 {
+	if m != nil {
+		return m.StateRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetJailDurationMultiplier() uint32 {
+	if m != nil {
+		return m.JailDurationMultiplier
+	}
+	return 0
+}
+
+func (m *Params) GetMaxJailCount() uint32 {
+	if m != nil {
+		return m.MaxJailCount
+	}
+	return 0
+}
+
+func (m *Params) GetFinalityStallThreshold() uint64 {
+	if m != nil {
+		return m.FinalityStallThreshold
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.finality.v1.Params")
 }
@@ -134,38 +178,44 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/params.proto", fileDescriptor_25539c9a61c72ee9) }
 
 var fileDescriptor_25539c9a61c72ee9 = []byte{
-	// 486 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0x31, 0x8f, 0xd3, 0x3e,
-	0x18, 0xc6, 0xeb, 0x7f, 0xfb, 0x2f, 0x52, 0x68, 0x07, 0xc2, 0x21, 0xe5, 0x8a, 0x48, 0x23, 0xa6,
-	0x0a, 0xe9, 0x12, 0xee, 0x90, 0x18, 0x10, 0x4b, 0xab, 0x0a, 0x31, 0x1c, 0x52, 0xd5, 0x43, 0x42,
-	0x62, 0xb1, 0x9c, 0xc4, 0xe7, 0xbe, 0x5c, 0x6c, 0x57, 0xb1, 0xd3, 0x6b, 0xbf, 0x05, 0xe3, 0x8d,
-	0x8c, 0x8c, 0x0c, 0x7c, 0x88, 0xdb, 0x38, 0x31, 0x21, 0x86, 0x03, 0xb5, 0x03, 0x5f, 0x03, 0xc5,
-	0x8e, 0xcb, 0x12, 0xe5, 0xf5, 0xf3, 0x7b, 0xfd, 0xbc, 0x79, 0xf2, 0x7a, 0x51, 0x4a, 0xd2, 0x4d,
-	0x21, 0x45, 0x72, 0x0e, 0x82, 0x14, 0xa0, 0x37, 0xc9, 0xea, 0x38, 0x59, 0x92, 0x92, 0x70, 0x15,
-	0x2f, 0x4b, 0xa9, 0xa5, 0x7f, 0xbf, 0x21, 0x62, 0x47, 0xc4, 0xab, 0xe3, 0xc1, 0x01, 0x93, 0x4c,
-	0x1a, 0x3d, 0xa9, 0xdf, 0x2c, 0x3a, 0xb8, 0x47, 0x38, 0x08, 0x99, 0x98, 0x67, 0x73, 0x74, 0x98,
-	0x49, 0xc5, 0xa5, 0xc2, 0x96, 0xb5, 0x45, 0x23, 0x85, 0x4c, 0x4a, 0x56, 0xd0, 0xc4, 0x54, 0x69,
-	0x75, 0x9e, 0xe4, 0x55, 0x49, 0x34, 0x48, 0x61, 0xf5, 0xc7, 0xdf, 0xda, 0x5e, 0x77, 0x66, 0x26,
-	0xf1, 0xc7, 0xde, 0x23, 0x4e, 0xd6, 0x98, 0x64, 0x1a, 0x56, 0x14, 0xbb, 0x41, 0xea, 0x4b, 0x57,
-	0x90, 0xd3, 0x52, 0x05, 0x28, 0x42, 0xa3, 0xfe, 0x7c, 0xc0, 0xc9, 0x7a, 0x6c, 0x98, 0x57, 0x0d,
-	0x32, 0x73, 0x84, 0xff, 0xd4, 0x3b, 0x50, 0xc0, 0x04, 0xcd, 0x71, 0x5a, 0xc8, 0xec, 0x42, 0xe1,
-	0x4b, 0x10, 0xb9, 0xbc, 0x0c, 0xfe, 0x8b, 0xd0, 0xa8, 0x3d, 0xf7, 0xad, 0x36, 0x31, 0xd2, 0x3b,
-	0xa3, 0xd4, 0x1d, 0x7b, 0x27, 0x05, 0x0c, 0x6b, 0xe0, 0x54, 0x56, 0x3a, 0x68, 0xdb, 0x0e, 0xa7,
-	0x9d, 0x01, 0x7b, 0x6b, 0x15, 0x1f, 0xbc, 0x07, 0x1c, 0x04, 0x6e, 0x7c, 0x96, 0xb4, 0x74, 0x26,
-	0x9d, 0x08, 0x8d, 0x7a, 0x93, 0xe7, 0xd7, 0xb7, 0xc3, 0xd6, 0xcf, 0xdb, 0xe1, 0x43, 0x1b, 0x83,
-	0xca, 0x2f, 0x62, 0x90, 0x09, 0x27, 0x7a, 0x11, 0x9f, 0x52, 0x46, 0xb2, 0xcd, 0x94, 0x66, 0xdf,
-	0xbf, 0x1e, 0x79, 0x4d, 0x4a, 0x53, 0x9a, 0x7d, 0xfe, 0xf3, 0xe5, 0x09, 0x9a, 0xfb, 0x1c, 0xc4,
-	0x99, 0xb9, 0x73, 0x46, 0xcb, 0x66, 0xb8, 0xc8, 0xeb, 0xd5, 0x56, 0xcb, 0x2a, 0xc5, 0x25, 0x11,
-	0x79, 0xf0, 0x7f, 0x84, 0x46, 0x9d, 0xb9, 0xc7, 0x41, 0xcc, 0xaa, 0x74, 0x4e, 0x44, 0xee, 0xbf,
-	0xf1, 0xfa, 0x1f, 0x08, 0x14, 0xd8, 0xa5, 0x1a, 0x74, 0x23, 0x34, 0xba, 0x7b, 0x72, 0x18, 0xdb,
-	0xd8, 0x63, 0x17, 0x7b, 0x3c, 0x6d, 0x80, 0x49, 0xbf, 0x9e, 0xef, 0xea, 0xd7, 0x10, 0x59, 0xdb,
-	0x5e, 0xdd, 0xee, 0x44, 0xff, 0xa5, 0x37, 0xd8, 0xa7, 0x61, 0xfe, 0x83, 0x39, 0xc6, 0x0b, 0x0a,
-	0x6c, 0xa1, 0x83, 0x3b, 0xc6, 0x3e, 0x70, 0xc4, 0x78, 0x0f, 0xbc, 0x36, 0xfa, 0x8b, 0xce, 0xd5,
-	0xa7, 0x61, 0x6b, 0x72, 0x7a, 0xbd, 0x0d, 0xd1, 0xcd, 0x36, 0x44, 0xbf, 0xb7, 0x21, 0xfa, 0xb8,
-	0x0b, 0x5b, 0x37, 0xbb, 0xb0, 0xf5, 0x63, 0x17, 0xb6, 0xde, 0x9f, 0x30, 0xd0, 0x8b, 0x2a, 0x8d,
-	0x33, 0xc9, 0x93, 0x66, 0xdf, 0x0a, 0x92, 0xaa, 0x23, 0x90, 0xae, 0x4c, 0xd6, 0xff, 0x56, 0x54,
-	0x6f, 0x96, 0x54, 0xa5, 0x5d, 0xf3, 0x05, 0xcf, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x27,
-	0x77, 0xaf, 0xc3, 0x02, 0x00, 0x00,
+	// 580 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x93, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x37, 0xf6, 0x8f, 0x3a, 0xdd, 0x0a, 0xc6, 0x5a, 0xa6, 0x2b, 0x66, 0x83, 0x78, 0x58,
+	0x84, 0x26, 0xb6, 0x8a, 0x88, 0x78, 0xe9, 0x5a, 0x44, 0xa4, 0x85, 0x65, 0x5b, 0x10, 0xbc, 0x0c,
+	0x93, 0x64, 0x9a, 0x1d, 0x3b, 0x7f, 0x42, 0x66, 0xb2, 0xdd, 0xfd, 0x16, 0x1e, 0x7b, 0xd3, 0xa3,
+	0x47, 0x0f, 0x7e, 0x88, 0x1e, 0x8b, 0x27, 0xf1, 0x50, 0xa5, 0x7b, 0xf0, 0x6b, 0x48, 0x66, 0x32,
+	0x5b, 0xbd, 0x84, 0xcc, 0xfc, 0x9e, 0x77, 0xde, 0x37, 0xcf, 0x93, 0x01, 0x61, 0x82, 0x93, 0x29,
+	0x93, 0x22, 0x3e, 0xa2, 0x02, 0x33, 0xaa, 0xa7, 0xf1, 0x78, 0x2b, 0x2e, 0x70, 0x89, 0xb9, 0x8a,
+	0x8a, 0x52, 0x6a, 0xe9, 0xdf, 0x69, 0x14, 0x91, 0x53, 0x44, 0xe3, 0xad, 0xce, 0x5a, 0x2e, 0x73,
+	0x69, 0x78, 0x5c, 0xbf, 0x59, 0x69, 0xe7, 0x36, 0xe6, 0x54, 0xc8, 0xd8, 0x3c, 0x9b, 0xad, 0x8d,
+	0x54, 0x2a, 0x2e, 0x15, 0xb2, 0x5a, 0xbb, 0x68, 0x50, 0x90, 0x4b, 0x99, 0x33, 0x12, 0x9b, 0x55,
+	0x52, 0x1d, 0xc5, 0x59, 0x55, 0x62, 0x4d, 0xa5, 0xb0, 0xfc, 0xc1, 0xa7, 0x25, 0xb0, 0x3c, 0x30,
+	0x93, 0xf8, 0x3b, 0xe0, 0x3e, 0xc7, 0x13, 0x84, 0x53, 0x4d, 0xc7, 0x04, 0xb9, 0x41, 0xea, 0x43,
+	0xc7, 0x34, 0x23, 0xa5, 0x82, 0x5e, 0xe8, 0xf5, 0x56, 0x87, 0x1d, 0x8e, 0x27, 0x3b, 0x46, 0xf3,
+	0xba, 0x91, 0x0c, 0x9c, 0xc2, 0x7f, 0x0c, 0xd6, 0x14, 0xcd, 0x05, 0xc9, 0x50, 0xc2, 0x64, 0x7a,
+	0xac, 0xd0, 0x09, 0x15, 0x99, 0x3c, 0x81, 0xd7, 0x42, 0xaf, 0xb7, 0x30, 0xf4, 0x2d, 0xeb, 0x1b,
+	0xf4, 0xce, 0x90, 0xba, 0x62, 0xde, 0x49, 0xd1, 0x1c, 0x69, 0xca, 0x89, 0xac, 0x34, 0x5c, 0xb0,
+	0x15, 0x8e, 0x1d, 0xd0, 0xfc, 0xd0, 0x12, 0x9f, 0x82, 0xbb, 0x9c, 0x0a, 0xd4, 0xf4, 0x29, 0x48,
+	0xe9, 0x9a, 0x2c, 0x86, 0x5e, 0xaf, 0xdd, 0x7f, 0x76, 0x76, 0xd1, 0x6d, 0xfd, 0xbc, 0xe8, 0xde,
+	0xb3, 0x36, 0xa8, 0xec, 0x38, 0xa2, 0x32, 0xe6, 0x58, 0x8f, 0xa2, 0x3d, 0x92, 0xe3, 0x74, 0xba,
+	0x4b, 0xd2, 0xef, 0xdf, 0x36, 0x41, 0xe3, 0xd2, 0x2e, 0x49, 0xbf, 0xfc, 0xf9, 0xfa, 0xc8, 0x1b,
+	0xfa, 0x9c, 0x8a, 0x03, 0x73, 0xe6, 0x80, 0x94, 0xcd, 0x70, 0x21, 0x68, 0xd7, 0xad, 0x8a, 0x2a,
+	0x41, 0x25, 0x16, 0x19, 0x5c, 0x0a, 0xbd, 0xde, 0xe2, 0x10, 0x70, 0x2a, 0x06, 0x55, 0x32, 0xc4,
+	0x22, 0xf3, 0xf7, 0xc1, 0xea, 0x07, 0x4c, 0x19, 0x72, 0xae, 0xc2, 0xe5, 0xd0, 0xeb, 0xad, 0x6c,
+	0x6f, 0x44, 0xd6, 0xf6, 0xc8, 0xd9, 0x1e, 0xed, 0x36, 0x82, 0xfe, 0x6a, 0x3d, 0xdf, 0xe9, 0xaf,
+	0xae, 0x67, 0xdb, 0xb6, 0xeb, 0x72, 0x07, 0xfd, 0x97, 0xa0, 0x33, 0x77, 0xc3, 0xe4, 0x60, 0xb6,
+	0xd1, 0x88, 0xd0, 0x7c, 0xa4, 0xe1, 0x75, 0xd3, 0x1e, 0x3a, 0xc5, 0xce, 0x5c, 0xf0, 0xc6, 0x70,
+	0xff, 0x29, 0x58, 0x57, 0x1a, 0x6b, 0x82, 0x4a, 0xa2, 0x89, 0x30, 0x95, 0x36, 0x06, 0x78, 0xc3,
+	0x54, 0xae, 0x19, 0x3a, 0x74, 0xd0, 0xe6, 0xe0, 0x3f, 0x07, 0xf0, 0xbf, 0x4f, 0x40, 0xbc, 0x62,
+	0x9a, 0x16, 0x8c, 0x92, 0x12, 0xde, 0x34, 0x89, 0xaf, 0xff, 0x3b, 0xe3, 0xfe, 0x9c, 0xfa, 0x0f,
+	0xc1, 0xad, 0xfa, 0x87, 0x31, 0xd5, 0xa9, 0xac, 0x84, 0x86, 0xc0, 0xe8, 0xdb, 0x1c, 0x4f, 0xde,
+	0x62, 0xca, 0x5e, 0xd5, 0x7b, 0xf5, 0xf9, 0x57, 0x09, 0x6b, 0xcc, 0x18, 0xd2, 0xa3, 0x92, 0xa8,
+	0x91, 0x64, 0x19, 0x5c, 0x31, 0x73, 0xad, 0xcf, 0x53, 0xae, 0xf1, 0xa1, 0xa3, 0x2f, 0x16, 0x4f,
+	0x3f, 0x77, 0x5b, 0xfd, 0xbd, 0xb3, 0xcb, 0xc0, 0x3b, 0xbf, 0x0c, 0xbc, 0xdf, 0x97, 0x81, 0xf7,
+	0x71, 0x16, 0xb4, 0xce, 0x67, 0x41, 0xeb, 0xc7, 0x2c, 0x68, 0xbd, 0xdf, 0xce, 0xa9, 0x1e, 0x55,
+	0x49, 0x94, 0x4a, 0x1e, 0x37, 0xf7, 0x87, 0xe1, 0x44, 0x6d, 0x52, 0xe9, 0x96, 0xf1, 0xe4, 0xea,
+	0xca, 0xe9, 0x69, 0x41, 0x54, 0xb2, 0x6c, 0x12, 0x79, 0xf2, 0x77, 0x00, 0xcc, 0x67, 0x57, 0xe4,
+	0x93, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -188,6 +238,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FinalityStallThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalityStallThreshold))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxJailCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxJailCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.JailDurationMultiplier != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.JailDurationMultiplier))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.StateRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StateRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.FinalityActivationHeight != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.FinalityActivationHeight))
 		i--
@@ -270,6 +340,18 @@ func (m *Params) Size() (n int) {
 	if m.FinalityActivationHeight != 0 {
 		n += 1 + sovParams(uint64(m.FinalityActivationHeight))
 	}
+	if m.StateRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.StateRetentionBlocks))
+	}
+	if m.JailDurationMultiplier != 0 {
+		n += 1 + sovParams(uint64(m.JailDurationMultiplier))
+	}
+	if m.MaxJailCount != 0 {
+		n += 1 + sovParams(uint64(m.MaxJailCount))
+	}
+	if m.FinalityStallThreshold != 0 {
+		n += 1 + sovParams(uint64(m.FinalityStallThreshold))
+	}
 	return n
 }
 
@@ -469,6 +551,82 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRetentionBlocks", wireType)
+			}
+			m.StateRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDurationMultiplier", wireType)
+			}
+			m.JailDurationMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailDurationMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJailCount", wireType)
+			}
+			m.MaxJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxJailCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityStallThreshold", wireType)
+			}
+			m.FinalityStallThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalityStallThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
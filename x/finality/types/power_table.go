@@ -63,6 +63,24 @@ func (dc *VotingPowerDistCache) FindNewInactiveFinalityProviders(prevDc *VotingP
 	return newInactiveFps
 }
 
+// FindRemovedFinalityProviders returns the finality providers that are in the
+// previous cache but not in the current one, i.e., have lost all their voting power
+func (dc *VotingPowerDistCache) FindRemovedFinalityProviders(prevDc *VotingPowerDistCache) []*FinalityProviderDistInfo {
+	fps := make(map[string]struct{}, len(dc.FinalityProviders))
+	for _, fp := range dc.FinalityProviders {
+		fps[fp.BtcPk.MarshalHex()] = struct{}{}
+	}
+
+	removedFps := make([]*FinalityProviderDistInfo, 0)
+	for _, fp := range prevDc.FinalityProviders {
+		if _, exists := fps[fp.BtcPk.MarshalHex()]; !exists {
+			removedFps = append(removedFps, fp)
+		}
+	}
+
+	return removedFps
+}
+
 // ApplyActiveFinalityProviders sorts all finality providers, counts the total voting
 // power of top N finality providers, excluding those who don't have timestamped pub rand
 // and records them in cache
@@ -25,6 +25,7 @@ func TestVotingPowerDistCache(t *testing.T) {
 		maxActiveFPs     uint32
 		numActiveFps     uint32
 		numInactiveFps   uint32
+		numRemovedFps    uint32
 		totalVotingPower uint64
 		prevDistCache    *types.VotingPowerDistCache
 		fps              []*types.FinalityProviderDistInfo
@@ -174,6 +175,28 @@ func TestVotingPowerDistCache(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc:             "previous one lost all voting power",
+			maxActiveFPs:     80,
+			numActiveFps:     1,
+			numInactiveFps:   0,
+			numRemovedFps:    1,
+			totalVotingPower: 2000,
+			prevDistCache: types.NewVotingPowerDistCacheWithFinalityProviders(
+				[]*types.FinalityProviderDistInfo{
+					{
+						BtcPk:          fpPubKey1,
+						TotalBondedSat: 1000,
+						IsTimestamped:  true,
+					}}),
+			fps: []*types.FinalityProviderDistInfo{
+				{
+					BtcPk:          fpPubKey2,
+					TotalBondedSat: 2000,
+					IsTimestamped:  true,
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -187,6 +210,9 @@ func TestVotingPowerDistCache(t *testing.T) {
 
 			newInactiveFps := dc.FindNewInactiveFinalityProviders(tc.prevDistCache)
 			require.Equal(t, tc.numInactiveFps, uint32(len(newInactiveFps)))
+
+			removedFps := dc.FindRemovedFinalityProviders(tc.prevDistCache)
+			require.Equal(t, tc.numRemovedFps, uint32(len(removedFps)))
 		})
 	}
 }
@@ -1546,6 +1546,197 @@ func (m *QueryListEvidencesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySlashingTxsRequest is the request type for the
+// Query/SlashingTxs RPC method.
+type QuerySlashingTxsRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK
+	// (in BIP340 format) of the slashed finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashingTxsRequest) Reset()         { *m = QuerySlashingTxsRequest{} }
+func (m *QuerySlashingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingTxsRequest) ProtoMessage()    {}
+func (*QuerySlashingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{27}
+}
+func (m *QuerySlashingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingTxsRequest.Merge(m, src)
+}
+func (m *QuerySlashingTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashingTxsRequest proto.InternalMessageInfo
+
+func (m *QuerySlashingTxsRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
+
+func (m *QuerySlashingTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// BTCDelegationSlashingTxs is the fully signed slashing txs of a BTC
+// delegation restaked to a slashed finality provider
+type BTCDelegationSlashingTxs struct {
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// status is the status of the BTC delegation
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// slashing_tx_hex is the hex str of the fully signed slashing tx spending
+	// the staking output. It is empty if the staking output is already spent
+	// by the unbonding tx
+	SlashingTxHex string `protobuf:"bytes,3,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// unbonding_slashing_tx_hex is the hex str of the fully signed slashing tx
+	// spending the unbonding output
+	UnbondingSlashingTxHex string `protobuf:"bytes,4,opt,name=unbonding_slashing_tx_hex,json=unbondingSlashingTxHex,proto3" json:"unbonding_slashing_tx_hex,omitempty"`
+}
+
+func (m *BTCDelegationSlashingTxs) Reset()         { *m = BTCDelegationSlashingTxs{} }
+func (m *BTCDelegationSlashingTxs) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationSlashingTxs) ProtoMessage()    {}
+func (*BTCDelegationSlashingTxs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{28}
+}
+func (m *BTCDelegationSlashingTxs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationSlashingTxs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationSlashingTxs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationSlashingTxs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationSlashingTxs.Merge(m, src)
+}
+func (m *BTCDelegationSlashingTxs) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationSlashingTxs) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationSlashingTxs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BTCDelegationSlashingTxs proto.InternalMessageInfo
+
+func (m *BTCDelegationSlashingTxs) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *BTCDelegationSlashingTxs) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *BTCDelegationSlashingTxs) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
+	}
+	return ""
+}
+
+func (m *BTCDelegationSlashingTxs) GetUnbondingSlashingTxHex() string {
+	if m != nil {
+		return m.UnbondingSlashingTxHex
+	}
+	return ""
+}
+
+// QuerySlashingTxsResponse is the response type for the
+// Query/SlashingTxs RPC method.
+type QuerySlashingTxsResponse struct {
+	// slashing_txs is the list of slashing txs of the BTC delegations
+	SlashingTxs []*BTCDelegationSlashingTxs `protobuf:"bytes,1,rep,name=slashing_txs,json=slashingTxs,proto3" json:"slashing_txs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySlashingTxsResponse) Reset()         { *m = QuerySlashingTxsResponse{} }
+func (m *QuerySlashingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashingTxsResponse) ProtoMessage()    {}
+func (*QuerySlashingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{29}
+}
+func (m *QuerySlashingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashingTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashingTxsResponse.Merge(m, src)
+}
+func (m *QuerySlashingTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashingTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashingTxsResponse proto.InternalMessageInfo
+
+func (m *QuerySlashingTxsResponse) GetSlashingTxs() []*BTCDelegationSlashingTxs {
+	if m != nil {
+		return m.SlashingTxs
+	}
+	return nil
+}
+
+func (m *QuerySlashingTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QuerySigningInfoRequest is the request type for the Query/SigningInfo RPC
 // method
 type QuerySigningInfoRequest struct {
@@ -1558,7 +1749,7 @@ func (m *QuerySigningInfoRequest) Reset()         { *m = QuerySigningInfoRequest
 func (m *QuerySigningInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoRequest) ProtoMessage()    {}
 func (*QuerySigningInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{27}
+	return fileDescriptor_32bddab77af6fdae, []int{30}
 }
 func (m *QuerySigningInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1606,13 +1797,21 @@ type SigningInfoResponse struct {
 	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
 	// Timestamp until which the validator is jailed due to liveness downtime.
 	JailedUntil time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// jail_history is the list of the most recent times the finality provider
+	// has been jailed due to liveness downtime, from the earliest to the latest
+	JailHistory []JailRecord `protobuf:"bytes,5,rep,name=jail_history,json=jailHistory,proto3" json:"jail_history"`
+	// tombstoned indicates whether the finality provider is permanently jailed
+	Tombstoned bool `protobuf:"varint,6,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// jail_count is the total number of times the finality provider has been
+	// jailed due to liveness downtime
+	JailCount uint32 `protobuf:"varint,7,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
 }
 
 func (m *SigningInfoResponse) Reset()         { *m = SigningInfoResponse{} }
 func (m *SigningInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*SigningInfoResponse) ProtoMessage()    {}
 func (*SigningInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{28}
+	return fileDescriptor_32bddab77af6fdae, []int{31}
 }
 func (m *SigningInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1669,6 +1868,27 @@ func (m *SigningInfoResponse) GetJailedUntil() time.Time {
 	return time.Time{}
 }
 
+func (m *SigningInfoResponse) GetJailHistory() []JailRecord {
+	if m != nil {
+		return m.JailHistory
+	}
+	return nil
+}
+
+func (m *SigningInfoResponse) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *SigningInfoResponse) GetJailCount() uint32 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
 // QuerySigningInfoResponse is the response type for the Query/SigningInfo RPC
 // method
 type QuerySigningInfoResponse struct {
@@ -1679,7 +1899,7 @@ func (m *QuerySigningInfoResponse) Reset()         { *m = QuerySigningInfoRespon
 func (m *QuerySigningInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfoResponse) ProtoMessage()    {}
 func (*QuerySigningInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{29}
+	return fileDescriptor_32bddab77af6fdae, []int{32}
 }
 func (m *QuerySigningInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1725,7 +1945,7 @@ func (m *QuerySigningInfosRequest) Reset()         { *m = QuerySigningInfosReque
 func (m *QuerySigningInfosRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosRequest) ProtoMessage()    {}
 func (*QuerySigningInfosRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{30}
+	return fileDescriptor_32bddab77af6fdae, []int{33}
 }
 func (m *QuerySigningInfosRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1773,7 +1993,7 @@ func (m *QuerySigningInfosResponse) Reset()         { *m = QuerySigningInfosResp
 func (m *QuerySigningInfosResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySigningInfosResponse) ProtoMessage()    {}
 func (*QuerySigningInfosResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32bddab77af6fdae, []int{31}
+	return fileDescriptor_32bddab77af6fdae, []int{34}
 }
 func (m *QuerySigningInfosResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1816,226 +2036,622 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("babylon.finality.v1.QueriedBlockStatus", QueriedBlockStatus_name, QueriedBlockStatus_value)
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.finality.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.finality.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryFinalityProviderPowerAtHeightRequest)(nil), "babylon.finality.v1.QueryFinalityProviderPowerAtHeightRequest")
-	proto.RegisterType((*QueryFinalityProviderPowerAtHeightResponse)(nil), "babylon.finality.v1.QueryFinalityProviderPowerAtHeightResponse")
-	proto.RegisterType((*QueryFinalityProviderCurrentPowerRequest)(nil), "babylon.finality.v1.QueryFinalityProviderCurrentPowerRequest")
-	proto.RegisterType((*QueryFinalityProviderCurrentPowerResponse)(nil), "babylon.finality.v1.QueryFinalityProviderCurrentPowerResponse")
-	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightRequest)(nil), "babylon.finality.v1.QueryActiveFinalityProvidersAtHeightRequest")
-	proto.RegisterType((*ActiveFinalityProvidersAtHeightResponse)(nil), "babylon.finality.v1.ActiveFinalityProvidersAtHeightResponse")
-	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightResponse)(nil), "babylon.finality.v1.QueryActiveFinalityProvidersAtHeightResponse")
-	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.finality.v1.QueryActivatedHeightRequest")
-	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.finality.v1.QueryActivatedHeightResponse")
-	proto.RegisterType((*QueryListPublicRandomnessRequest)(nil), "babylon.finality.v1.QueryListPublicRandomnessRequest")
-	proto.RegisterType((*QueryListPublicRandomnessResponse)(nil), "babylon.finality.v1.QueryListPublicRandomnessResponse")
-	proto.RegisterMapType((map[uint64]*github_com_babylonlabs_io_babylon_types.SchnorrPubRand)(nil), "babylon.finality.v1.QueryListPublicRandomnessResponse.PubRandMapEntry")
-	proto.RegisterType((*PubRandCommitResponse)(nil), "babylon.finality.v1.PubRandCommitResponse")
-	proto.RegisterType((*QueryListPubRandCommitRequest)(nil), "babylon.finality.v1.QueryListPubRandCommitRequest")
-	proto.RegisterType((*QueryListPubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse")
-	proto.RegisterMapType((map[uint64]*PubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse.PubRandCommitMapEntry")
-	proto.RegisterType((*QueryBlockRequest)(nil), "babylon.finality.v1.QueryBlockRequest")
-	proto.RegisterType((*QueryBlockResponse)(nil), "babylon.finality.v1.QueryBlockResponse")
-	proto.RegisterType((*QueryListBlocksRequest)(nil), "babylon.finality.v1.QueryListBlocksRequest")
-	proto.RegisterType((*QueryListBlocksResponse)(nil), "babylon.finality.v1.QueryListBlocksResponse")
-	proto.RegisterType((*QueryVotesAtHeightRequest)(nil), "babylon.finality.v1.QueryVotesAtHeightRequest")
-	proto.RegisterType((*QueryVotesAtHeightResponse)(nil), "babylon.finality.v1.QueryVotesAtHeightResponse")
-	proto.RegisterType((*QueryEvidenceRequest)(nil), "babylon.finality.v1.QueryEvidenceRequest")
-	proto.RegisterType((*EvidenceResponse)(nil), "babylon.finality.v1.EvidenceResponse")
-	proto.RegisterType((*QueryEvidenceResponse)(nil), "babylon.finality.v1.QueryEvidenceResponse")
-	proto.RegisterType((*QueryListEvidencesRequest)(nil), "babylon.finality.v1.QueryListEvidencesRequest")
-	proto.RegisterType((*QueryListEvidencesResponse)(nil), "babylon.finality.v1.QueryListEvidencesResponse")
-	proto.RegisterType((*QuerySigningInfoRequest)(nil), "babylon.finality.v1.QuerySigningInfoRequest")
-	proto.RegisterType((*SigningInfoResponse)(nil), "babylon.finality.v1.SigningInfoResponse")
-	proto.RegisterType((*QuerySigningInfoResponse)(nil), "babylon.finality.v1.QuerySigningInfoResponse")
-	proto.RegisterType((*QuerySigningInfosRequest)(nil), "babylon.finality.v1.QuerySigningInfosRequest")
-	proto.RegisterType((*QuerySigningInfosResponse)(nil), "babylon.finality.v1.QuerySigningInfosResponse")
+// QueryFinalityProviderPerformanceRequest is the request type for the
+// Query/FinalityProviderPerformance RPC method.
+type QueryFinalityProviderPerformanceRequest struct {
+	// fp_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// from_epoch is the first epoch of the queried per-epoch rollups (inclusive)
+	FromEpoch uint64 `protobuf:"varint,2,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	// to_epoch is the last epoch of the queried per-epoch rollups (inclusive).
+	// Zero means no upper bound.
+	ToEpoch uint64 `protobuf:"varint,3,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("babylon/finality/v1/query.proto", fileDescriptor_32bddab77af6fdae) }
-
-var fileDescriptor_32bddab77af6fdae = []byte{
-	// 1974 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0xd7,
-	0x11, 0xd7, 0x93, 0x2c, 0x59, 0x1e, 0x92, 0xb5, 0xf4, 0x24, 0xab, 0x2a, 0x6d, 0x53, 0xd4, 0x26,
-	0xb6, 0x14, 0xd9, 0xde, 0xb5, 0x68, 0xd7, 0x75, 0x8c, 0x38, 0xb6, 0xe8, 0x4a, 0x95, 0x50, 0x59,
-	0x66, 0x56, 0x8e, 0x81, 0xfa, 0xb2, 0x58, 0x52, 0x4b, 0x72, 0x2b, 0xee, 0x47, 0xb8, 0xbb, 0xac,
-	0x84, 0x22, 0x40, 0xd1, 0x43, 0x0e, 0x45, 0x0b, 0x04, 0xe8, 0xa5, 0x3d, 0xe4, 0x50, 0xa0, 0x2d,
-	0x8a, 0xf6, 0xd2, 0x6b, 0xff, 0x03, 0x1f, 0x83, 0xb4, 0x87, 0x22, 0x45, 0xdc, 0xc0, 0x36, 0x50,
-	0x20, 0xa7, 0xfe, 0x09, 0xc5, 0xbe, 0x37, 0xcb, 0xe5, 0x92, 0x4b, 0x72, 0xf5, 0x81, 0x5e, 0x04,
-	0xf1, 0xbd, 0xf9, 0xf8, 0xcd, 0xbc, 0x99, 0xd9, 0x99, 0x81, 0x85, 0xb2, 0x5a, 0x3e, 0x6c, 0x58,
-	0xa6, 0x54, 0xd5, 0x4d, 0xb5, 0xa1, 0xbb, 0x87, 0x52, 0x6b, 0x55, 0xfa, 0xc8, 0xd3, 0x9a, 0x87,
-	0xa2, 0xdd, 0xb4, 0x5c, 0x8b, 0xce, 0x20, 0x81, 0x18, 0x10, 0x88, 0xad, 0xd5, 0xec, 0x6c, 0xcd,
-	0xaa, 0x59, 0xec, 0x5e, 0xf2, 0xff, 0xe3, 0xa4, 0xd9, 0x4b, 0x35, 0xcb, 0xaa, 0x35, 0x34, 0x49,
-	0xb5, 0x75, 0x49, 0x35, 0x4d, 0xcb, 0x55, 0x5d, 0xdd, 0x32, 0x1d, 0xbc, 0x5d, 0xa9, 0x58, 0x8e,
-	0x61, 0x39, 0x52, 0x59, 0x75, 0x34, 0xae, 0x41, 0x6a, 0xad, 0x96, 0x35, 0x57, 0x5d, 0x95, 0x6c,
-	0xb5, 0xa6, 0x9b, 0x8c, 0x18, 0x69, 0xf3, 0x71, 0xa8, 0x6c, 0xb5, 0xa9, 0x1a, 0x81, 0x34, 0x21,
-	0x8e, 0xa2, 0x0d, 0x91, 0xd3, 0x2c, 0x20, 0x1e, 0xf6, 0xab, 0xec, 0x55, 0x25, 0x57, 0x37, 0x34,
-	0xc7, 0x55, 0x0d, 0x1b, 0x09, 0xa6, 0x55, 0x43, 0x37, 0x2d, 0x89, 0xfd, 0xe5, 0x47, 0xc2, 0x2c,
-	0xd0, 0x0f, 0x7c, 0x6c, 0x25, 0xa6, 0x4c, 0xd6, 0x3e, 0xf2, 0x34, 0xc7, 0x15, 0x4a, 0x30, 0x13,
-	0x39, 0x75, 0x6c, 0xcb, 0x74, 0x34, 0xfa, 0x2e, 0x4c, 0x70, 0x50, 0xf3, 0x24, 0x4f, 0x96, 0x53,
-	0x85, 0x8b, 0x62, 0x8c, 0xb3, 0x44, 0xce, 0x54, 0x3c, 0xf3, 0xe2, 0xe5, 0xc2, 0x88, 0x8c, 0x0c,
-	0x42, 0x15, 0xde, 0x61, 0x12, 0x37, 0x90, 0xb0, 0xd4, 0xb4, 0x5a, 0xfa, 0x9e, 0xd6, 0x2c, 0x59,
-	0x3f, 0xd1, 0x9a, 0x6b, 0xee, 0xa6, 0xa6, 0xd7, 0xea, 0x2e, 0xaa, 0xa7, 0x8b, 0x90, 0xa9, 0xda,
-	0x4a, 0xd9, 0xad, 0x28, 0xf6, 0xbe, 0x52, 0xd7, 0x0e, 0x98, 0xba, 0x73, 0x32, 0x54, 0xed, 0xa2,
-	0x5b, 0x29, 0xed, 0x6f, 0x6a, 0x07, 0x74, 0x0e, 0x26, 0xea, 0x8c, 0x67, 0x7e, 0x34, 0x4f, 0x96,
-	0xcf, 0xc8, 0xf8, 0x4b, 0x78, 0x02, 0x2b, 0x49, 0xf4, 0xa0, 0x41, 0x8b, 0x90, 0x6e, 0x59, 0xae,
-	0x6e, 0xd6, 0x14, 0xdb, 0xbf, 0x67, 0x7a, 0xce, 0xc8, 0x29, 0x7e, 0xc6, 0x58, 0x84, 0xc7, 0xb0,
-	0x1c, 0x2b, 0xf0, 0x91, 0xd7, 0x6c, 0x6a, 0xa6, 0xcb, 0x88, 0x92, 0xe3, 0xee, 0xeb, 0x87, 0xa8,
-	0x38, 0x84, 0x17, 0x1a, 0x49, 0x3a, 0x8d, 0xec, 0x81, 0x3d, 0xda, 0x0b, 0xfb, 0x57, 0x04, 0xae,
-	0x31, 0x45, 0x6b, 0x15, 0x57, 0x6f, 0x69, 0xdd, 0xea, 0x9c, 0x6e, 0x97, 0xf7, 0x53, 0xb5, 0x01,
-	0x10, 0x46, 0x2b, 0x53, 0x94, 0x2a, 0x5c, 0x15, 0x79, 0x68, 0x8b, 0x7e, 0x68, 0x8b, 0x3c, 0x79,
-	0x30, 0xb4, 0xc5, 0x92, 0x5a, 0xd3, 0x50, 0xa6, 0xdc, 0xc1, 0x29, 0x7c, 0x33, 0x0a, 0x4b, 0x43,
-	0xa1, 0xa0, 0xd9, 0xcf, 0x00, 0xba, 0x7d, 0x58, 0xbc, 0xfb, 0xe5, 0xcb, 0x85, 0xdb, 0x35, 0xdd,
-	0xad, 0x7b, 0x65, 0xb1, 0x62, 0x19, 0x12, 0x06, 0x5e, 0x43, 0x2d, 0x3b, 0x37, 0x74, 0x2b, 0xf8,
-	0x29, 0xb9, 0x87, 0xb6, 0xe6, 0x88, 0xc5, 0xad, 0xd2, 0xad, 0xdb, 0x37, 0x4b, 0x5e, 0xf9, 0x87,
-	0xda, 0xa1, 0x3c, 0x59, 0x1e, 0x12, 0x33, 0x3d, 0xee, 0x1c, 0xeb, 0x71, 0x27, 0xbd, 0x0d, 0x73,
-	0x4e, 0x43, 0x75, 0xea, 0xda, 0x9e, 0x82, 0xaa, 0x14, 0x14, 0x75, 0x86, 0x11, 0xcf, 0xe2, 0x6d,
-	0x91, 0x5f, 0x72, 0x83, 0xe8, 0x75, 0xa0, 0x6d, 0x2e, 0xb7, 0x12, 0x70, 0x8c, 0xe7, 0xc9, 0x72,
-	0x46, 0x9e, 0x0a, 0x38, 0xdc, 0x0a, 0x52, 0xcf, 0xc1, 0xc4, 0x8f, 0x55, 0xbd, 0xa1, 0xed, 0xcd,
-	0x4f, 0xe4, 0xc9, 0xf2, 0xa4, 0x8c, 0xbf, 0xe8, 0x4d, 0x98, 0xad, 0xeb, 0xb5, 0xba, 0xe6, 0xb8,
-	0x4a, 0xcb, 0x72, 0xb5, 0xbd, 0x40, 0xce, 0x59, 0x26, 0x87, 0xe2, 0xdd, 0x33, 0xff, 0x8a, 0x4b,
-	0x12, 0xde, 0x10, 0xb8, 0x9e, 0xec, 0xf1, 0xd1, 0xe3, 0xfb, 0x40, 0x83, 0x0c, 0x56, 0xec, 0x80,
-	0x6a, 0x9e, 0xe4, 0xc7, 0x96, 0x53, 0x85, 0xf7, 0x62, 0x93, 0x3c, 0xa1, 0x64, 0x79, 0xba, 0xda,
-	0x4d, 0x42, 0x7f, 0x10, 0x13, 0x52, 0x4b, 0x43, 0x43, 0x0a, 0xe5, 0x75, 0xc6, 0xd4, 0x65, 0xb8,
-	0x18, 0x5a, 0xa9, 0xb6, 0xcd, 0x0f, 0x8a, 0xd8, 0x1d, 0xb8, 0x14, 0x7f, 0x3d, 0x38, 0xbb, 0xfc,
-	0xd4, 0xc9, 0x33, 0xc6, 0x6d, 0xdd, 0x71, 0x4b, 0x5e, 0xb9, 0xa1, 0x57, 0x64, 0xd5, 0xdc, 0xb3,
-	0x0c, 0x53, 0x73, 0x9c, 0x23, 0x94, 0xa8, 0xd3, 0x4a, 0x9d, 0x2f, 0x46, 0x61, 0x71, 0x00, 0x1e,
-	0xb4, 0xe6, 0xf7, 0x04, 0xd2, 0xb6, 0x57, 0x56, 0x9a, 0xaa, 0xb9, 0xa7, 0x18, 0xaa, 0x8d, 0xaf,
-	0xb7, 0x11, 0xfb, 0x7a, 0x43, 0xc5, 0x89, 0x25, 0xaf, 0xec, 0x9f, 0x3e, 0x56, 0xed, 0x75, 0xd3,
-	0x6d, 0x1e, 0x16, 0xef, 0x7d, 0xf9, 0x72, 0xe1, 0x4e, 0xd2, 0xfc, 0xdb, 0xad, 0xd4, 0x4d, 0xab,
-	0xd9, 0x44, 0x19, 0x32, 0xd8, 0x6d, 0x61, 0xa7, 0xf6, 0xf8, 0xd9, 0xfb, 0x70, 0xbe, 0x0b, 0x23,
-	0x9d, 0x82, 0xb1, 0x7d, 0xed, 0x10, 0x5f, 0xd3, 0xff, 0x97, 0xce, 0xc2, 0x78, 0x4b, 0x6d, 0x78,
-	0x1a, 0x53, 0x94, 0x96, 0xf9, 0x8f, 0x7b, 0xa3, 0x77, 0x89, 0xd0, 0x82, 0x0b, 0xc8, 0xfe, 0xc8,
-	0x32, 0x0c, 0x3d, 0x8c, 0x8a, 0x3c, 0xa4, 0x4d, 0xcf, 0x50, 0x02, 0x57, 0xa2, 0x34, 0x30, 0x3d,
-	0x03, 0xe9, 0x69, 0x0e, 0xa0, 0xc2, 0x78, 0x0c, 0xcd, 0x74, 0x51, 0x72, 0xc7, 0x09, 0xbd, 0x08,
-	0xe7, 0x34, 0xdb, 0xaa, 0xd4, 0x15, 0xd3, 0x33, 0xb0, 0x96, 0x4c, 0xb2, 0x83, 0x1d, 0xcf, 0x10,
-	0x7e, 0x41, 0xe0, 0x72, 0xa7, 0xf7, 0x3b, 0x11, 0xfc, 0xdf, 0x23, 0xeb, 0x1f, 0xa3, 0x90, 0xeb,
-	0x07, 0x06, 0xdd, 0x71, 0x00, 0x33, 0xed, 0xa8, 0xe2, 0x36, 0x76, 0x04, 0xd7, 0xd6, 0xd0, 0xe0,
-	0xea, 0x95, 0x28, 0x46, 0x4e, 0x83, 0xb7, 0x93, 0xa7, 0xec, 0xae, 0xe3, 0xd3, 0x8b, 0x14, 0xab,
-	0xeb, 0xa9, 0x07, 0xc4, 0xcb, 0xc3, 0xce, 0x78, 0x49, 0x15, 0x56, 0xe2, 0xfb, 0x9b, 0x38, 0xb3,
-	0x3a, 0x63, 0xeb, 0x1a, 0x4c, 0x33, 0x1f, 0x14, 0x1b, 0x56, 0x65, 0x7f, 0xc8, 0x07, 0x56, 0x78,
-	0x8c, 0x0d, 0x18, 0x12, 0xa3, 0xdb, 0xbf, 0x07, 0xe3, 0x65, 0xff, 0x00, 0x1b, 0xad, 0xc5, 0x58,
-	0x20, 0x5b, 0xe6, 0x9e, 0x76, 0xa0, 0xed, 0x71, 0x4e, 0x4e, 0x2f, 0xfc, 0x8e, 0xc0, 0x5c, 0xfb,
-	0x01, 0xd8, 0x4d, 0xbb, 0x64, 0x3d, 0x80, 0x09, 0xc7, 0x55, 0x5d, 0x8f, 0x77, 0x6f, 0xdf, 0x2a,
-	0x2c, 0xf5, 0x7d, 0x3d, 0x1d, 0x85, 0xee, 0x32, 0x72, 0x19, 0xd9, 0x4e, 0x2d, 0xec, 0x3e, 0x23,
-	0xf0, 0xed, 0x1e, 0x8c, 0x61, 0x8b, 0xc9, 0x0c, 0x09, 0xbe, 0x3e, 0x09, 0x2c, 0x47, 0x86, 0xd3,
-	0xfb, 0xae, 0xdc, 0x82, 0xef, 0x30, 0x78, 0xfe, 0x27, 0x35, 0x69, 0xa3, 0x24, 0x58, 0x90, 0x8d,
-	0x63, 0x42, 0xb3, 0x3e, 0x80, 0xb3, 0x3c, 0xa3, 0xb9, 0x5d, 0xe9, 0x13, 0xf4, 0x33, 0x13, 0xac,
-	0x9f, 0x71, 0x84, 0x77, 0x61, 0x96, 0x29, 0x5c, 0xf7, 0x3f, 0xab, 0x66, 0x45, 0x3b, 0x42, 0x13,
-	0xfa, 0xaf, 0x31, 0x98, 0x0a, 0xd9, 0xda, 0xbd, 0xf0, 0xd0, 0xba, 0xb3, 0x08, 0x69, 0xe6, 0x6b,
-	0x25, 0xd2, 0x46, 0xa5, 0xd8, 0x19, 0x36, 0x31, 0x1f, 0xc2, 0x64, 0xbb, 0x74, 0xfa, 0xb5, 0x2f,
-	0x7d, 0xa2, 0x2f, 0xc7, 0x59, 0xac, 0x0a, 0x7e, 0x27, 0x55, 0x51, 0x4d, 0xcb, 0xd4, 0x2b, 0x6a,
-	0x43, 0x51, 0x6d, 0x5b, 0xa9, 0xab, 0x4e, 0x9d, 0xf5, 0x5e, 0x69, 0x79, 0xaa, 0x7d, 0xb3, 0x66,
-	0xdb, 0x9b, 0xaa, 0x53, 0xa7, 0x02, 0x64, 0xaa, 0x56, 0x73, 0x3f, 0x24, 0x1c, 0x67, 0x84, 0x29,
-	0xff, 0x30, 0xa0, 0xb1, 0x61, 0x2e, 0x94, 0xd8, 0x6e, 0x7e, 0x1c, 0xbd, 0xc6, 0xba, 0xaf, 0xe3,
-	0xc1, 0x5e, 0x7f, 0xf2, 0x74, 0x77, 0x57, 0xaf, 0xc9, 0xb3, 0x6d, 0xc9, 0x41, 0x83, 0xb4, 0xab,
-	0xd7, 0x68, 0x15, 0xa6, 0x19, 0xaa, 0x88, 0xb2, 0xb3, 0x27, 0x56, 0x76, 0xde, 0x17, 0xda, 0xa1,
-	0x47, 0x78, 0x0e, 0x17, 0xba, 0x02, 0x03, 0x5f, 0x78, 0x0d, 0x26, 0x35, 0x3c, 0xc3, 0xba, 0x72,
-	0x25, 0x36, 0xbb, 0xba, 0x19, 0xe5, 0x36, 0x9b, 0xf0, 0x09, 0xc1, 0xdc, 0xf0, 0x53, 0x37, 0xa0,
-	0xeb, 0x68, 0x8a, 0xd2, 0x8e, 0xab, 0x36, 0x5d, 0x25, 0x92, 0x21, 0x29, 0x76, 0xb6, 0x79, 0xba,
-	0xf3, 0xc4, 0x9f, 0x09, 0xe6, 0x5b, 0x17, 0x10, 0x34, 0xf5, 0x11, 0x9c, 0x0b, 0x30, 0x07, 0x95,
-	0x24, 0xa1, 0xad, 0x21, 0xdf, 0xe9, 0x15, 0x94, 0xf7, 0xb0, 0xde, 0xed, 0xea, 0x35, 0x53, 0x37,
-	0x6b, 0x5b, 0x66, 0xd5, 0x3a, 0x42, 0xb6, 0x7e, 0x45, 0x60, 0x26, 0xc2, 0x79, 0xa4, 0x84, 0x8d,
-	0x3c, 0x88, 0x6f, 0xc3, 0x58, 0xf4, 0x41, 0x0a, 0x70, 0xc1, 0xd0, 0x1d, 0xc7, 0x1f, 0x51, 0x58,
-	0x19, 0x55, 0x2a, 0x96, 0x67, 0xba, 0x38, 0x05, 0x8d, 0xc9, 0x33, 0xfc, 0x92, 0x57, 0xe9, 0x47,
-	0xfc, 0x8a, 0x6e, 0x43, 0x9a, 0xcf, 0x26, 0x8a, 0x67, 0xba, 0x7a, 0x83, 0xe5, 0x61, 0xaa, 0x90,
-	0x15, 0xf9, 0xfe, 0x41, 0x0c, 0xf6, 0x0f, 0xe2, 0xd3, 0x60, 0xff, 0x50, 0xcc, 0xbc, 0x78, 0xb9,
-	0x30, 0xf2, 0xe9, 0xbf, 0x17, 0xc8, 0x9f, 0xfe, 0xf3, 0xd7, 0x15, 0x22, 0xa7, 0x38, 0xfb, 0x87,
-	0x3e, 0xb7, 0x60, 0xc0, 0x7c, 0xaf, 0x77, 0xda, 0x75, 0x33, 0xed, 0xf0, 0x63, 0x45, 0x37, 0xab,
-	0x16, 0x86, 0xed, 0x72, 0xec, 0x53, 0xc6, 0xf0, 0xe3, 0x12, 0x22, 0xe5, 0x84, 0x57, 0x42, 0xb9,
-	0x57, 0x5d, 0x3b, 0x80, 0xa3, 0xd1, 0x49, 0x8e, 0x1d, 0x9d, 0x7f, 0x0b, 0xd2, 0x24, 0xaa, 0x04,
-	0x8d, 0xda, 0x85, 0x4c, 0xa7, 0x51, 0x41, 0x80, 0x1e, 0xd5, 0xaa, 0x74, 0x87, 0x55, 0xa7, 0x17,
-	0xac, 0x2b, 0x0f, 0x78, 0x43, 0x12, 0xed, 0x01, 0xe8, 0x34, 0x64, 0x76, 0x9e, 0xec, 0x28, 0x1b,
-	0x5b, 0x3b, 0x6b, 0xdb, 0x5b, 0xcf, 0xd7, 0xbf, 0x3f, 0x35, 0x42, 0x33, 0x70, 0x2e, 0xfc, 0x49,
-	0xe8, 0x59, 0x18, 0x5b, 0xdb, 0xf9, 0xd1, 0xd4, 0x68, 0xe1, 0x9b, 0x19, 0x18, 0x67, 0xc6, 0xd3,
-	0x9f, 0x11, 0x98, 0xe0, 0xdb, 0x20, 0xda, 0xbf, 0xd9, 0x88, 0xae, 0x9e, 0xb2, 0xcb, 0xc3, 0x09,
-	0x39, 0x68, 0xe1, 0xad, 0x9f, 0xff, 0xfd, 0xcd, 0xaf, 0x47, 0x2f, 0xd3, 0x8b, 0x52, 0xff, 0xed,
-	0x19, 0xfd, 0x9a, 0xc0, 0xc2, 0x90, 0x59, 0x95, 0x3e, 0xec, 0xaf, 0x32, 0xd9, 0xf6, 0x24, 0xbb,
-	0x76, 0x02, 0x09, 0x68, 0xcd, 0x5d, 0x66, 0x4d, 0x81, 0xde, 0x94, 0x06, 0x6d, 0xfa, 0xc2, 0xe9,
-	0x5c, 0xfa, 0x29, 0xcf, 0xe9, 0x8f, 0xe9, 0x7f, 0x09, 0x5c, 0x1e, 0xb8, 0xee, 0xa2, 0xef, 0xf7,
-	0x87, 0x97, 0x64, 0x1f, 0x97, 0x7d, 0x70, 0x6c, 0x7e, 0x34, 0x6e, 0x87, 0x19, 0xb7, 0x49, 0x37,
-	0x12, 0x1b, 0x17, 0xa9, 0x6c, 0x1f, 0x4b, 0x6c, 0x31, 0x13, 0x9a, 0xfc, 0x86, 0xc0, 0xa5, 0x41,
-	0x1b, 0x34, 0x7a, 0x3f, 0x39, 0xe2, 0x98, 0x45, 0x5e, 0xf6, 0xfd, 0xe3, 0xb2, 0xa3, 0xbd, 0xeb,
-	0xcc, 0xde, 0x07, 0xf4, 0xfe, 0x89, 0xec, 0xa5, 0x7f, 0x20, 0x70, 0xbe, 0x6b, 0x7b, 0x41, 0x6f,
-	0x0e, 0x09, 0xb5, 0x9e, 0x3d, 0x48, 0x76, 0xf5, 0x08, 0x1c, 0x88, 0xff, 0x06, 0xc3, 0xbf, 0x44,
-	0xaf, 0xc4, 0xe2, 0x57, 0x03, 0x2e, 0xfc, 0xac, 0xd0, 0xaf, 0x08, 0xcc, 0xc6, 0x6d, 0x13, 0xe8,
-	0x77, 0x8f, 0xba, 0x7d, 0xe0, 0x88, 0xef, 0x1c, 0x6f, 0x69, 0x21, 0x3c, 0x63, 0xb0, 0x4b, 0x74,
-	0xe7, 0xd8, 0x6e, 0x67, 0x92, 0x59, 0xf7, 0xca, 0x45, 0x2b, 0x0d, 0xdd, 0x71, 0xe9, 0x17, 0x04,
-	0xa6, 0x7b, 0x06, 0x5a, 0x5a, 0x38, 0xd2, 0xf4, 0xcb, 0x2d, 0xbb, 0x75, 0x8c, 0x89, 0x59, 0x78,
-	0xca, 0xcc, 0xda, 0xa1, 0xdb, 0x27, 0x30, 0x2b, 0x32, 0xc1, 0x33, 0xa3, 0x3e, 0x21, 0x30, 0xce,
-	0x2a, 0x3c, 0xbd, 0xda, 0x1f, 0x54, 0xe7, 0x08, 0x9b, 0x5d, 0x1a, 0x4a, 0x87, 0x80, 0xaf, 0x33,
-	0xc0, 0x57, 0xe9, 0xdb, 0xb1, 0x80, 0x79, 0x9f, 0x11, 0x26, 0xf3, 0x2f, 0x09, 0x40, 0x38, 0x09,
-	0xd2, 0x6b, 0x83, 0x5d, 0x14, 0x99, 0x69, 0xb3, 0xd7, 0x93, 0x11, 0x27, 0xfa, 0x62, 0xe0, 0x18,
-	0xf9, 0x19, 0x81, 0x4c, 0x64, 0x88, 0xa3, 0x62, 0x7f, 0x25, 0x71, 0x23, 0x62, 0x56, 0x4a, 0x4c,
-	0x8f, 0xb8, 0xae, 0x31, 0x5c, 0x57, 0xe8, 0x5b, 0xb1, 0xb8, 0x5a, 0x3e, 0x4f, 0xe8, 0xae, 0xbf,
-	0x10, 0x98, 0x0c, 0xba, 0x56, 0xfa, 0x4e, 0x7f, 0x55, 0x5d, 0x73, 0x61, 0x76, 0x25, 0x09, 0x29,
-	0x02, 0xda, 0x64, 0x80, 0x8a, 0xf4, 0xe1, 0x71, 0x23, 0x2e, 0x68, 0xa2, 0xe9, 0x6f, 0x08, 0x64,
-	0x22, 0x2d, 0xfa, 0x20, 0x6f, 0xc6, 0x0d, 0x15, 0x83, 0xbc, 0x19, 0xdb, 0xfb, 0x0b, 0x57, 0x19,
-	0xf8, 0x3c, 0xcd, 0xc5, 0x82, 0x0f, 0xdb, 0xfb, 0x3f, 0x12, 0x48, 0x75, 0x74, 0x57, 0x74, 0x40,
-	0x2c, 0xf5, 0x36, 0xee, 0xd9, 0x1b, 0x09, 0xa9, 0x11, 0xd4, 0x3d, 0x06, 0xea, 0x36, 0x2d, 0xc4,
-	0x82, 0x8a, 0xb4, 0x83, 0xdd, 0xce, 0xa4, 0xbf, 0x25, 0x90, 0xee, 0x6c, 0x24, 0x69, 0x32, 0xdd,
-	0x6d, 0x0f, 0x8a, 0x49, 0xc9, 0x11, 0xeb, 0x0a, 0xc3, 0xfa, 0x36, 0x15, 0x86, 0x63, 0x2d, 0x6e,
-	0xbf, 0x78, 0x95, 0x23, 0x9f, 0xbf, 0xca, 0x91, 0xaf, 0x5f, 0xe5, 0xc8, 0xa7, 0xaf, 0x73, 0x23,
-	0x9f, 0xbf, 0xce, 0x8d, 0xfc, 0xf3, 0x75, 0x6e, 0xe4, 0x79, 0x61, 0xf8, 0x3c, 0x7b, 0x10, 0x0a,
-	0x66, 0xa3, 0x6d, 0x79, 0x82, 0x8d, 0x0e, 0xb7, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x36,
-	0x2c, 0x81, 0xa6, 0x1d, 0x00, 0x00,
+func (m *QueryFinalityProviderPerformanceRequest) Reset() {
+	*m = QueryFinalityProviderPerformanceRequest{}
+}
+func (m *QueryFinalityProviderPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderPerformanceRequest) ProtoMessage()    {}
+func (*QueryFinalityProviderPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{35}
+}
+func (m *QueryFinalityProviderPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderPerformanceRequest.Merge(m, src)
+}
+func (m *QueryFinalityProviderPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderPerformanceRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryFinalityProviderPerformanceRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryFinalityProviderPerformanceRequest) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
-	ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error)
-	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
-	FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error)
-	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
-	FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error)
-	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
-	// there exists 1 finality provider with voting power
-	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
-	// ListPublicRandomness is a range query for public randomness of a given finality provider
-	// NOTE: Babylon only has the knowledge of public randomness that is already revealed by
-	// finality providers, i.e., the finality provider already provides a finality signature
-	// at the corresponding height
-	ListPublicRandomness(ctx context.Context, in *QueryListPublicRandomnessRequest, opts ...grpc.CallOption) (*QueryListPublicRandomnessResponse, error)
-	// ListPubRandCommit is a range query for public randomness commitments of a given finality provider
-	ListPubRandCommit(ctx context.Context, in *QueryListPubRandCommitRequest, opts ...grpc.CallOption) (*QueryListPubRandCommitResponse, error)
-	// Block queries a block at a given height
-	Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error)
-	// ListBlocks is a range query for blocks at a given status
-	ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error)
-	// VotesAtHeight queries finality providers who have signed the block at given height.
-	VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error)
-	// Evidence queries the first evidence which can be used for extracting the BTC SK
-	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
-	// ListEvidences queries is a range query for evidences
-	ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error)
-	// SigningInfo queries the signing info of given finality provider BTC public key
-	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
-	// SigningInfos queries the signing info of all the active finality providers
-	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+func (m *QueryFinalityProviderPerformanceRequest) GetFromEpoch() uint64 {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFinalityProviderPerformanceRequest) GetToEpoch() uint64 {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFinalityProviderPerformanceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// EpochFinalityProviderPerformance is the voting performance of a finality
+// provider within an epoch
+type EpochFinalityProviderPerformance struct {
+	// epoch_num is the epoch number
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// performance is the performance of the finality provider within the epoch
+	Performance FinalityProviderPerformance `protobuf:"bytes,2,opt,name=performance,proto3" json:"performance"`
+}
+
+func (m *EpochFinalityProviderPerformance) Reset()         { *m = EpochFinalityProviderPerformance{} }
+func (m *EpochFinalityProviderPerformance) String() string { return proto.CompactTextString(m) }
+func (*EpochFinalityProviderPerformance) ProtoMessage()    {}
+func (*EpochFinalityProviderPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{36}
+}
+func (m *EpochFinalityProviderPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochFinalityProviderPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochFinalityProviderPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochFinalityProviderPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochFinalityProviderPerformance.Merge(m, src)
+}
+func (m *EpochFinalityProviderPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochFinalityProviderPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochFinalityProviderPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochFinalityProviderPerformance proto.InternalMessageInfo
+
+func (m *EpochFinalityProviderPerformance) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *EpochFinalityProviderPerformance) GetPerformance() FinalityProviderPerformance {
+	if m != nil {
+		return m.Performance
+	}
+	return FinalityProviderPerformance{}
+}
+
+// QueryFinalityProviderPerformanceResponse is the response type for the
+// Query/FinalityProviderPerformance RPC method.
+type QueryFinalityProviderPerformanceResponse struct {
+	// total is the lifetime performance of the finality provider
+	Total FinalityProviderPerformance `protobuf:"bytes,1,opt,name=total,proto3" json:"total"`
+	// epochs is the list of per-epoch performance rollups within the queried range
+	Epochs []EpochFinalityProviderPerformance `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFinalityProviderPerformanceResponse) Reset() {
+	*m = QueryFinalityProviderPerformanceResponse{}
+}
+func (m *QueryFinalityProviderPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityProviderPerformanceResponse) ProtoMessage()    {}
+func (*QueryFinalityProviderPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{37}
+}
+func (m *QueryFinalityProviderPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityProviderPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityProviderPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityProviderPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityProviderPerformanceResponse.Merge(m, src)
+}
+func (m *QueryFinalityProviderPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityProviderPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityProviderPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityProviderPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityProviderPerformanceResponse) GetTotal() FinalityProviderPerformance {
+	if m != nil {
+		return m.Total
+	}
+	return FinalityProviderPerformance{}
+}
+
+func (m *QueryFinalityProviderPerformanceResponse) GetEpochs() []EpochFinalityProviderPerformance {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *QueryFinalityProviderPerformanceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFinalityStatusRequest is the request type for the Query/FinalityStatus
+// RPC method.
+type QueryFinalityStatusRequest struct {
+}
+
+func (m *QueryFinalityStatusRequest) Reset()         { *m = QueryFinalityStatusRequest{} }
+func (m *QueryFinalityStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityStatusRequest) ProtoMessage()    {}
+func (*QueryFinalityStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{38}
+}
+func (m *QueryFinalityStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityStatusRequest.Merge(m, src)
+}
+func (m *QueryFinalityStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityStatusRequest proto.InternalMessageInfo
+
+// QueryFinalityStatusResponse is the response type for the Query/FinalityStatus
+// RPC method.
+type QueryFinalityStatusResponse struct {
+	// stalled indicates whether no block has been finalized for
+	// `finality_stall_threshold` blocks
+	Stalled bool `protobuf:"varint,1,opt,name=stalled,proto3" json:"stalled,omitempty"`
+	// stall_height is the height of the earliest non-finalized block, or zero
+	// if all the blocks are finalized or non-finalizable
+	StallHeight uint64 `protobuf:"varint,2,opt,name=stall_height,json=stallHeight,proto3" json:"stall_height,omitempty"`
+	// stalled_blocks is the number of blocks produced since the stall height
+	StalledBlocks uint64 `protobuf:"varint,3,opt,name=stalled_blocks,json=stalledBlocks,proto3" json:"stalled_blocks,omitempty"`
+	// total_voting_power is the total voting power of the finality provider
+	// set at the stall height
+	TotalVotingPower uint64 `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// voted_power is the voting power of the finality providers that have
+	// voted for the block at the stall height
+	VotedPower uint64 `protobuf:"varint,5,opt,name=voted_power,json=votedPower,proto3" json:"voted_power,omitempty"`
+	// missing_voting_power is the voting power of the finality providers that
+	// have not voted for the block at the stall height
+	MissingVotingPower uint64 `protobuf:"varint,6,opt,name=missing_voting_power,json=missingVotingPower,proto3" json:"missing_voting_power,omitempty"`
+	// missing_fps is the list of finality providers that have not voted for
+	// the block at the stall height, ordered by voting power in descending order
+	MissingFps []FinalityProviderMissingPower `protobuf:"bytes,7,rep,name=missing_fps,json=missingFps,proto3" json:"missing_fps"`
+}
+
+func (m *QueryFinalityStatusResponse) Reset()         { *m = QueryFinalityStatusResponse{} }
+func (m *QueryFinalityStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalityStatusResponse) ProtoMessage()    {}
+func (*QueryFinalityStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32bddab77af6fdae, []int{39}
+}
+func (m *QueryFinalityStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalityStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalityStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalityStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalityStatusResponse.Merge(m, src)
+}
+func (m *QueryFinalityStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalityStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalityStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalityStatusResponse proto.InternalMessageInfo
+
+func (m *QueryFinalityStatusResponse) GetStalled() bool {
+	if m != nil {
+		return m.Stalled
+	}
+	return false
+}
+
+func (m *QueryFinalityStatusResponse) GetStallHeight() uint64 {
+	if m != nil {
+		return m.StallHeight
+	}
+	return 0
+}
+
+func (m *QueryFinalityStatusResponse) GetStalledBlocks() uint64 {
+	if m != nil {
+		return m.StalledBlocks
+	}
+	return 0
+}
+
+func (m *QueryFinalityStatusResponse) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
+	}
+	return 0
+}
+
+func (m *QueryFinalityStatusResponse) GetVotedPower() uint64 {
+	if m != nil {
+		return m.VotedPower
+	}
+	return 0
+}
+
+func (m *QueryFinalityStatusResponse) GetMissingVotingPower() uint64 {
+	if m != nil {
+		return m.MissingVotingPower
+	}
+	return 0
+}
+
+func (m *QueryFinalityStatusResponse) GetMissingFps() []FinalityProviderMissingPower {
+	if m != nil {
+		return m.MissingFps
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("babylon.finality.v1.QueriedBlockStatus", QueriedBlockStatus_name, QueriedBlockStatus_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.finality.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.finality.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryFinalityProviderPowerAtHeightRequest)(nil), "babylon.finality.v1.QueryFinalityProviderPowerAtHeightRequest")
+	proto.RegisterType((*QueryFinalityProviderPowerAtHeightResponse)(nil), "babylon.finality.v1.QueryFinalityProviderPowerAtHeightResponse")
+	proto.RegisterType((*QueryFinalityProviderCurrentPowerRequest)(nil), "babylon.finality.v1.QueryFinalityProviderCurrentPowerRequest")
+	proto.RegisterType((*QueryFinalityProviderCurrentPowerResponse)(nil), "babylon.finality.v1.QueryFinalityProviderCurrentPowerResponse")
+	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightRequest)(nil), "babylon.finality.v1.QueryActiveFinalityProvidersAtHeightRequest")
+	proto.RegisterType((*ActiveFinalityProvidersAtHeightResponse)(nil), "babylon.finality.v1.ActiveFinalityProvidersAtHeightResponse")
+	proto.RegisterType((*QueryActiveFinalityProvidersAtHeightResponse)(nil), "babylon.finality.v1.QueryActiveFinalityProvidersAtHeightResponse")
+	proto.RegisterType((*QueryActivatedHeightRequest)(nil), "babylon.finality.v1.QueryActivatedHeightRequest")
+	proto.RegisterType((*QueryActivatedHeightResponse)(nil), "babylon.finality.v1.QueryActivatedHeightResponse")
+	proto.RegisterType((*QueryListPublicRandomnessRequest)(nil), "babylon.finality.v1.QueryListPublicRandomnessRequest")
+	proto.RegisterType((*QueryListPublicRandomnessResponse)(nil), "babylon.finality.v1.QueryListPublicRandomnessResponse")
+	proto.RegisterMapType((map[uint64]*github_com_babylonlabs_io_babylon_types.SchnorrPubRand)(nil), "babylon.finality.v1.QueryListPublicRandomnessResponse.PubRandMapEntry")
+	proto.RegisterType((*PubRandCommitResponse)(nil), "babylon.finality.v1.PubRandCommitResponse")
+	proto.RegisterType((*QueryListPubRandCommitRequest)(nil), "babylon.finality.v1.QueryListPubRandCommitRequest")
+	proto.RegisterType((*QueryListPubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse")
+	proto.RegisterMapType((map[uint64]*PubRandCommitResponse)(nil), "babylon.finality.v1.QueryListPubRandCommitResponse.PubRandCommitMapEntry")
+	proto.RegisterType((*QueryBlockRequest)(nil), "babylon.finality.v1.QueryBlockRequest")
+	proto.RegisterType((*QueryBlockResponse)(nil), "babylon.finality.v1.QueryBlockResponse")
+	proto.RegisterType((*QueryListBlocksRequest)(nil), "babylon.finality.v1.QueryListBlocksRequest")
+	proto.RegisterType((*QueryListBlocksResponse)(nil), "babylon.finality.v1.QueryListBlocksResponse")
+	proto.RegisterType((*QueryVotesAtHeightRequest)(nil), "babylon.finality.v1.QueryVotesAtHeightRequest")
+	proto.RegisterType((*QueryVotesAtHeightResponse)(nil), "babylon.finality.v1.QueryVotesAtHeightResponse")
+	proto.RegisterType((*QueryEvidenceRequest)(nil), "babylon.finality.v1.QueryEvidenceRequest")
+	proto.RegisterType((*EvidenceResponse)(nil), "babylon.finality.v1.EvidenceResponse")
+	proto.RegisterType((*QueryEvidenceResponse)(nil), "babylon.finality.v1.QueryEvidenceResponse")
+	proto.RegisterType((*QueryListEvidencesRequest)(nil), "babylon.finality.v1.QueryListEvidencesRequest")
+	proto.RegisterType((*QueryListEvidencesResponse)(nil), "babylon.finality.v1.QueryListEvidencesResponse")
+	proto.RegisterType((*QuerySlashingTxsRequest)(nil), "babylon.finality.v1.QuerySlashingTxsRequest")
+	proto.RegisterType((*BTCDelegationSlashingTxs)(nil), "babylon.finality.v1.BTCDelegationSlashingTxs")
+	proto.RegisterType((*QuerySlashingTxsResponse)(nil), "babylon.finality.v1.QuerySlashingTxsResponse")
+	proto.RegisterType((*QuerySigningInfoRequest)(nil), "babylon.finality.v1.QuerySigningInfoRequest")
+	proto.RegisterType((*SigningInfoResponse)(nil), "babylon.finality.v1.SigningInfoResponse")
+	proto.RegisterType((*QuerySigningInfoResponse)(nil), "babylon.finality.v1.QuerySigningInfoResponse")
+	proto.RegisterType((*QuerySigningInfosRequest)(nil), "babylon.finality.v1.QuerySigningInfosRequest")
+	proto.RegisterType((*QuerySigningInfosResponse)(nil), "babylon.finality.v1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryFinalityProviderPerformanceRequest)(nil), "babylon.finality.v1.QueryFinalityProviderPerformanceRequest")
+	proto.RegisterType((*EpochFinalityProviderPerformance)(nil), "babylon.finality.v1.EpochFinalityProviderPerformance")
+	proto.RegisterType((*QueryFinalityProviderPerformanceResponse)(nil), "babylon.finality.v1.QueryFinalityProviderPerformanceResponse")
+	proto.RegisterType((*QueryFinalityStatusRequest)(nil), "babylon.finality.v1.QueryFinalityStatusRequest")
+	proto.RegisterType((*QueryFinalityStatusResponse)(nil), "babylon.finality.v1.QueryFinalityStatusResponse")
+}
+
+func init() { proto.RegisterFile("babylon/finality/v1/query.proto", fileDescriptor_32bddab77af6fdae) }
+
+var fileDescriptor_32bddab77af6fdae = []byte{
+	// 2509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdd, 0x6f, 0x1b, 0x59,
+	0x15, 0xcf, 0x75, 0xbe, 0x8f, 0x9d, 0x36, 0xb9, 0x4d, 0x43, 0xea, 0xb6, 0x49, 0x3a, 0xbb, 0x6d,
+	0xb2, 0x69, 0x6b, 0x27, 0x6e, 0xb7, 0xb4, 0x55, 0xbb, 0x6d, 0x9c, 0x26, 0x24, 0x6c, 0x9a, 0xba,
+	0x93, 0x6c, 0xb5, 0xf4, 0x65, 0x34, 0x76, 0xc6, 0xf6, 0x10, 0x7b, 0x66, 0xd6, 0x73, 0x1d, 0x12,
+	0xa1, 0x95, 0x10, 0x42, 0xfb, 0x80, 0x40, 0x5a, 0x09, 0x09, 0xb1, 0x0f, 0xfb, 0xb0, 0x12, 0x20,
+	0x04, 0x2f, 0x48, 0x08, 0x09, 0x1e, 0x78, 0xef, 0x13, 0x5a, 0x2d, 0x3c, 0xa0, 0x05, 0xca, 0xaa,
+	0xad, 0x58, 0x89, 0x27, 0xfe, 0x04, 0x34, 0xf7, 0x9e, 0xb1, 0x67, 0xec, 0xb1, 0x3d, 0x71, 0x22,
+	0xf6, 0x25, 0x8a, 0xef, 0x3d, 0x1f, 0xbf, 0xf3, 0x71, 0xcf, 0x3d, 0xf7, 0x0c, 0x4c, 0x67, 0xd5,
+	0xec, 0x41, 0xc9, 0x34, 0x92, 0x79, 0xdd, 0x50, 0x4b, 0x3a, 0x3b, 0x48, 0xee, 0x2d, 0x26, 0xdf,
+	0xab, 0x6a, 0x95, 0x83, 0x84, 0x55, 0x31, 0x99, 0x49, 0x4f, 0x21, 0x41, 0xc2, 0x25, 0x48, 0xec,
+	0x2d, 0xc6, 0xc7, 0x0b, 0x66, 0xc1, 0xe4, 0xfb, 0x49, 0xe7, 0x3f, 0x41, 0x1a, 0x3f, 0x57, 0x30,
+	0xcd, 0x42, 0x49, 0x4b, 0xaa, 0x96, 0x9e, 0x54, 0x0d, 0xc3, 0x64, 0x2a, 0xd3, 0x4d, 0xc3, 0xc6,
+	0xdd, 0xf9, 0x9c, 0x69, 0x97, 0x4d, 0x3b, 0x99, 0x55, 0x6d, 0x4d, 0x68, 0x48, 0xee, 0x2d, 0x66,
+	0x35, 0xa6, 0x2e, 0x26, 0x2d, 0xb5, 0xa0, 0x1b, 0x9c, 0x18, 0x69, 0x67, 0x82, 0x50, 0x59, 0x6a,
+	0x45, 0x2d, 0xbb, 0xd2, 0xa4, 0x20, 0x8a, 0x1a, 0x44, 0x41, 0x33, 0x8d, 0x78, 0xf8, 0xaf, 0x6c,
+	0x35, 0x9f, 0x64, 0x7a, 0x59, 0xb3, 0x99, 0x5a, 0xb6, 0x90, 0x60, 0x4c, 0x2d, 0xeb, 0x86, 0x99,
+	0xe4, 0x7f, 0xc5, 0x92, 0x34, 0x0e, 0xf4, 0xb1, 0x83, 0x2d, 0xc3, 0x95, 0xc9, 0xda, 0x7b, 0x55,
+	0xcd, 0x66, 0x52, 0x06, 0x4e, 0xf9, 0x56, 0x6d, 0xcb, 0x34, 0x6c, 0x8d, 0xde, 0x82, 0x01, 0x01,
+	0x6a, 0x92, 0xcc, 0x90, 0xb9, 0x68, 0xea, 0x6c, 0x22, 0xc0, 0x59, 0x09, 0xc1, 0x94, 0xee, 0x7b,
+	0xf6, 0x7c, 0xba, 0x47, 0x46, 0x06, 0x29, 0x0f, 0x6f, 0x70, 0x89, 0xab, 0x48, 0x98, 0xa9, 0x98,
+	0x7b, 0xfa, 0x8e, 0x56, 0xc9, 0x98, 0xdf, 0xd1, 0x2a, 0x4b, 0x6c, 0x4d, 0xd3, 0x0b, 0x45, 0x86,
+	0xea, 0xe9, 0x05, 0x18, 0xc9, 0x5b, 0x4a, 0x96, 0xe5, 0x14, 0x6b, 0x57, 0x29, 0x6a, 0xfb, 0x5c,
+	0xdd, 0xb0, 0x0c, 0x79, 0x2b, 0xcd, 0x72, 0x99, 0xdd, 0x35, 0x6d, 0x9f, 0x4e, 0xc0, 0x40, 0x91,
+	0xf3, 0x4c, 0x46, 0x66, 0xc8, 0x5c, 0x9f, 0x8c, 0xbf, 0xa4, 0x47, 0x30, 0x1f, 0x46, 0x0f, 0x1a,
+	0x74, 0x01, 0x62, 0x7b, 0x26, 0xd3, 0x8d, 0x82, 0x62, 0x39, 0xfb, 0x5c, 0x4f, 0x9f, 0x1c, 0x15,
+	0x6b, 0x9c, 0x45, 0x7a, 0x08, 0x73, 0x81, 0x02, 0x97, 0xab, 0x95, 0x8a, 0x66, 0x30, 0x4e, 0x14,
+	0x1e, 0x77, 0x4b, 0x3f, 0xf8, 0xc5, 0x21, 0xbc, 0xba, 0x91, 0xc4, 0x6b, 0x64, 0x13, 0xec, 0x48,
+	0x33, 0xec, 0x1f, 0x13, 0xb8, 0xcc, 0x15, 0x2d, 0xe5, 0x98, 0xbe, 0xa7, 0x35, 0xaa, 0xb3, 0x1b,
+	0x5d, 0xde, 0x4a, 0xd5, 0x2a, 0x40, 0x3d, 0x5b, 0xb9, 0xa2, 0x68, 0xea, 0x52, 0x42, 0xa4, 0x76,
+	0xc2, 0x49, 0xed, 0x84, 0x38, 0x3c, 0x98, 0xda, 0x89, 0x8c, 0x5a, 0xd0, 0x50, 0xa6, 0xec, 0xe1,
+	0x94, 0xfe, 0x13, 0x81, 0xd9, 0x8e, 0x50, 0xd0, 0xec, 0x27, 0x00, 0x8d, 0x3e, 0x4c, 0xdf, 0xfc,
+	0xfc, 0xf9, 0xf4, 0xf5, 0x82, 0xce, 0x8a, 0xd5, 0x6c, 0x22, 0x67, 0x96, 0x93, 0x98, 0x78, 0x25,
+	0x35, 0x6b, 0x5f, 0xd5, 0x4d, 0xf7, 0x67, 0x92, 0x1d, 0x58, 0x9a, 0x9d, 0x48, 0xaf, 0x67, 0xae,
+	0x5d, 0x5f, 0xc8, 0x54, 0xb3, 0x6f, 0x6b, 0x07, 0xf2, 0x50, 0xb6, 0x43, 0xce, 0x34, 0xb9, 0xb3,
+	0xb7, 0xc9, 0x9d, 0xf4, 0x3a, 0x4c, 0xd8, 0x25, 0xd5, 0x2e, 0x6a, 0x3b, 0x0a, 0xaa, 0x52, 0x50,
+	0x54, 0x1f, 0x27, 0x1e, 0xc7, 0xdd, 0xb4, 0xd8, 0x14, 0x06, 0xd1, 0x2b, 0x40, 0x6b, 0x5c, 0x2c,
+	0xe7, 0x72, 0xf4, 0xcf, 0x90, 0xb9, 0x11, 0x79, 0xd4, 0xe5, 0x60, 0x39, 0xa4, 0x9e, 0x80, 0x81,
+	0x6f, 0xab, 0x7a, 0x49, 0xdb, 0x99, 0x1c, 0x98, 0x21, 0x73, 0x43, 0x32, 0xfe, 0xa2, 0x0b, 0x30,
+	0x5e, 0xd4, 0x0b, 0x45, 0xcd, 0x66, 0xca, 0x9e, 0xc9, 0xb4, 0x1d, 0x57, 0xce, 0x20, 0x97, 0x43,
+	0x71, 0xef, 0x89, 0xb3, 0x25, 0x24, 0x49, 0xaf, 0x08, 0x5c, 0x09, 0x17, 0x7c, 0xf4, 0xf8, 0x2e,
+	0x50, 0xf7, 0x04, 0x2b, 0x96, 0x4b, 0x35, 0x49, 0x66, 0x7a, 0xe7, 0xa2, 0xa9, 0x3b, 0x81, 0x87,
+	0x3c, 0xa4, 0x64, 0x79, 0x2c, 0xdf, 0x48, 0x42, 0xbf, 0x11, 0x90, 0x52, 0xb3, 0x1d, 0x53, 0x0a,
+	0xe5, 0x79, 0x73, 0xea, 0x3c, 0x9c, 0xad, 0x5b, 0xa9, 0xd6, 0xcc, 0x77, 0x8b, 0xd8, 0x0d, 0x38,
+	0x17, 0xbc, 0xdd, 0xfe, 0x74, 0x39, 0x47, 0x67, 0x86, 0x33, 0x6e, 0xe8, 0x36, 0xcb, 0x54, 0xb3,
+	0x25, 0x3d, 0x27, 0xab, 0xc6, 0x8e, 0x59, 0x36, 0x34, 0xdb, 0x3e, 0x44, 0x89, 0x3a, 0xae, 0xa3,
+	0xf3, 0x59, 0x04, 0x2e, 0xb4, 0xc1, 0x83, 0xd6, 0xfc, 0x9c, 0x40, 0xcc, 0xaa, 0x66, 0x95, 0x8a,
+	0x6a, 0xec, 0x28, 0x65, 0xd5, 0xc2, 0xe8, 0xad, 0x06, 0x46, 0xaf, 0xa3, 0xb8, 0x44, 0xa6, 0x9a,
+	0x75, 0x56, 0x1f, 0xaa, 0xd6, 0x8a, 0xc1, 0x2a, 0x07, 0xe9, 0xdb, 0x9f, 0x3f, 0x9f, 0xbe, 0x11,
+	0xf6, 0xfc, 0x6d, 0xe5, 0x8a, 0x86, 0x59, 0xa9, 0xa0, 0x0c, 0x19, 0xac, 0x9a, 0xb0, 0x63, 0x0b,
+	0x7e, 0xfc, 0x2e, 0x9c, 0x6c, 0xc0, 0x48, 0x47, 0xa1, 0x77, 0x57, 0x3b, 0xc0, 0x68, 0x3a, 0xff,
+	0xd2, 0x71, 0xe8, 0xdf, 0x53, 0x4b, 0x55, 0x8d, 0x2b, 0x8a, 0xc9, 0xe2, 0xc7, 0xed, 0xc8, 0x4d,
+	0x22, 0xed, 0xc1, 0x69, 0x64, 0x5f, 0x36, 0xcb, 0x65, 0xbd, 0x9e, 0x15, 0x33, 0x10, 0x33, 0xaa,
+	0x65, 0xc5, 0x75, 0x25, 0x4a, 0x03, 0xa3, 0x5a, 0x46, 0x7a, 0x3a, 0x05, 0x90, 0xe3, 0x3c, 0x65,
+	0xcd, 0x60, 0x28, 0xd9, 0xb3, 0x42, 0xcf, 0xc2, 0xb0, 0x66, 0x99, 0xb9, 0xa2, 0x62, 0x54, 0xcb,
+	0x58, 0x4b, 0x86, 0xf8, 0xc2, 0x66, 0xb5, 0x2c, 0xfd, 0x90, 0xc0, 0x79, 0xaf, 0xf7, 0xbd, 0x08,
+	0xfe, 0xef, 0x99, 0xf5, 0xd7, 0x08, 0x4c, 0xb5, 0x02, 0x83, 0xee, 0xd8, 0x87, 0x53, 0xb5, 0xac,
+	0x12, 0x36, 0x7a, 0x92, 0x6b, 0xbd, 0x63, 0x72, 0x35, 0x4b, 0x4c, 0xf8, 0x56, 0xdd, 0xd8, 0xc9,
+	0xa3, 0x56, 0xc3, 0xf2, 0xf1, 0x65, 0x8a, 0xd9, 0x10, 0xea, 0x36, 0xf9, 0x72, 0xdf, 0x9b, 0x2f,
+	0xd1, 0xd4, 0x7c, 0x70, 0x7f, 0x13, 0x64, 0x96, 0x37, 0xb7, 0x2e, 0xc3, 0x18, 0xf7, 0x41, 0xba,
+	0x64, 0xe6, 0x76, 0x3b, 0x5c, 0xb0, 0xd2, 0x43, 0x6c, 0xc0, 0x90, 0x18, 0xdd, 0xfe, 0x75, 0xe8,
+	0xcf, 0x3a, 0x0b, 0xd8, 0x68, 0x5d, 0x08, 0x04, 0xb2, 0x6e, 0xec, 0x68, 0xfb, 0xda, 0x8e, 0xe0,
+	0x14, 0xf4, 0xd2, 0x27, 0x04, 0x26, 0x6a, 0x01, 0xe0, 0x3b, 0xb5, 0x92, 0x75, 0x0f, 0x06, 0x6c,
+	0xa6, 0xb2, 0xaa, 0xe8, 0xde, 0x4e, 0xa4, 0x66, 0x5b, 0x46, 0x4f, 0x47, 0xa1, 0x5b, 0x9c, 0x5c,
+	0x46, 0xb6, 0x63, 0x4b, 0xbb, 0x8f, 0x09, 0x7c, 0xad, 0x09, 0x63, 0xbd, 0xc5, 0xe4, 0x86, 0xb8,
+	0xb7, 0x4f, 0x08, 0xcb, 0x91, 0xe1, 0xf8, 0xee, 0x95, 0x6b, 0x70, 0x86, 0xc3, 0x73, 0xae, 0xd4,
+	0xb0, 0x8d, 0x92, 0x64, 0x42, 0x3c, 0x88, 0x09, 0xcd, 0x7a, 0x0c, 0x83, 0xe2, 0x44, 0x0b, 0xbb,
+	0x62, 0x47, 0xe8, 0x67, 0x06, 0x78, 0x3f, 0x63, 0x4b, 0xb7, 0x60, 0x9c, 0x2b, 0x5c, 0x71, 0xae,
+	0x55, 0x23, 0xa7, 0x1d, 0xa2, 0x09, 0xfd, 0x7b, 0x2f, 0x8c, 0xd6, 0xd9, 0x6a, 0xbd, 0x70, 0xc7,
+	0xba, 0x73, 0x01, 0x62, 0xdc, 0xd7, 0x8a, 0xaf, 0x8d, 0x8a, 0xf2, 0x35, 0x6c, 0x62, 0xde, 0x81,
+	0xa1, 0x5a, 0xe9, 0x74, 0x6a, 0x5f, 0xec, 0x48, 0x37, 0xc7, 0x20, 0x56, 0x05, 0xa7, 0x93, 0xca,
+	0xa9, 0x86, 0x69, 0xe8, 0x39, 0xb5, 0xa4, 0xa8, 0x96, 0xa5, 0x14, 0x55, 0xbb, 0xc8, 0x7b, 0xaf,
+	0x98, 0x3c, 0x5a, 0xdb, 0x59, 0xb2, 0xac, 0x35, 0xd5, 0x2e, 0x52, 0x09, 0x46, 0xf2, 0x66, 0x65,
+	0xb7, 0x4e, 0xd8, 0xcf, 0x09, 0xa3, 0xce, 0xa2, 0x4b, 0x63, 0xc1, 0x44, 0x5d, 0x62, 0xad, 0xf9,
+	0xb1, 0xf5, 0x02, 0xef, 0xbe, 0xba, 0x83, 0xbd, 0xf2, 0x68, 0x7b, 0x6b, 0x4b, 0x2f, 0xc8, 0xe3,
+	0x35, 0xc9, 0x6e, 0x83, 0xb4, 0xa5, 0x17, 0x68, 0x1e, 0xc6, 0x38, 0x2a, 0x9f, 0xb2, 0xc1, 0x23,
+	0x2b, 0x3b, 0xe9, 0x08, 0xf5, 0xe8, 0x91, 0x9e, 0xc2, 0xe9, 0x86, 0xc4, 0xc0, 0x08, 0x2f, 0xc1,
+	0x90, 0x86, 0x6b, 0x58, 0x57, 0x2e, 0x06, 0x9e, 0xae, 0x46, 0x46, 0xb9, 0xc6, 0x26, 0x7d, 0x40,
+	0xf0, 0x6c, 0x38, 0x47, 0xd7, 0xa5, 0xf3, 0x34, 0x45, 0x31, 0x9b, 0xa9, 0x15, 0xa6, 0xf8, 0x4e,
+	0x48, 0x94, 0xaf, 0xad, 0x1d, 0xef, 0x7b, 0xe2, 0xd7, 0x04, 0xcf, 0x5b, 0x03, 0x10, 0x34, 0x75,
+	0x19, 0x86, 0x5d, 0xcc, 0x6e, 0x25, 0x09, 0x69, 0x6b, 0x9d, 0xef, 0xf8, 0x0a, 0xca, 0x0f, 0xdc,
+	0x82, 0xb7, 0xe5, 0xf4, 0xfc, 0xba, 0x51, 0xd8, 0xde, 0xff, 0x2a, 0x1a, 0xc9, 0x3f, 0x11, 0x98,
+	0x4c, 0x6f, 0x2f, 0x3f, 0xd0, 0x4a, 0x5a, 0x81, 0xaf, 0x78, 0xe0, 0xd0, 0x4b, 0x70, 0xd2, 0x66,
+	0xea, 0xae, 0xf3, 0x0a, 0x62, 0xfb, 0xe2, 0xd4, 0x08, 0x24, 0x23, 0xb8, 0xbc, 0xbd, 0xcf, 0xcf,
+	0xcd, 0x44, 0xed, 0x16, 0x89, 0xf0, 0x6d, 0xf7, 0x72, 0x70, 0xf8, 0x51, 0x1c, 0x17, 0xa0, 0xed,
+	0xf3, 0xf3, 0xef, 0xf0, 0xd7, 0xb4, 0x38, 0xc6, 0xdc, 0x82, 0x33, 0x55, 0x23, 0x6b, 0x1a, 0x3b,
+	0x0e, 0x61, 0x23, 0x47, 0x1f, 0xe7, 0x98, 0xa8, 0x11, 0x6c, 0x79, 0x59, 0xa5, 0xdf, 0x13, 0x98,
+	0x6c, 0x76, 0x23, 0x46, 0x3c, 0x03, 0x31, 0x8f, 0x34, 0x37, 0xe8, 0x57, 0x03, 0x83, 0xde, 0xca,
+	0x09, 0x72, 0xd4, 0xf6, 0x78, 0xe4, 0xd8, 0xc2, 0x7f, 0xc7, 0x8d, 0xbe, 0x5e, 0x30, 0x74, 0xa3,
+	0xb0, 0x6e, 0xe4, 0xcd, 0x43, 0x14, 0xeb, 0x7f, 0x47, 0xe0, 0x94, 0x8f, 0xf3, 0x50, 0xf5, 0xda,
+	0x77, 0x1e, 0x1d, 0x1b, 0x7a, 0xfd, 0xe7, 0x31, 0x05, 0xa7, 0xcb, 0xba, 0x6d, 0x3b, 0x2f, 0x54,
+	0x7e, 0x8b, 0x2a, 0x39, 0xb3, 0x6a, 0x30, 0x7c, 0x04, 0xf7, 0xca, 0xa7, 0xc4, 0xa6, 0xb8, 0xa4,
+	0x97, 0xc5, 0x16, 0xdd, 0x80, 0x98, 0x78, 0x9a, 0x2a, 0x55, 0x83, 0xe9, 0x25, 0x1e, 0xb5, 0x68,
+	0x2a, 0x9e, 0x10, 0xe3, 0xa7, 0x84, 0x3b, 0x7e, 0x4a, 0x6c, 0xbb, 0xe3, 0xa7, 0xf4, 0xc8, 0xb3,
+	0xe7, 0xd3, 0x3d, 0x1f, 0xfe, 0x6b, 0x9a, 0xfc, 0xea, 0xcb, 0xdf, 0xce, 0x13, 0x39, 0x2a, 0xd8,
+	0xdf, 0x71, 0xb8, 0xe9, 0x9a, 0x90, 0xa6, 0x14, 0x75, 0x9b, 0x99, 0x95, 0x83, 0xc9, 0x7e, 0x1e,
+	0xb8, 0xe9, 0xc0, 0xc0, 0x7d, 0x53, 0xd5, 0x4b, 0xb2, 0x96, 0x33, 0x2b, 0x3b, 0x38, 0x5e, 0xe2,
+	0x92, 0xd6, 0x04, 0xa7, 0xd3, 0x98, 0x33, 0xb3, 0x9c, 0xb5, 0x99, 0x69, 0xd4, 0x1e, 0xd1, 0x9e,
+	0x15, 0x7a, 0x1e, 0x80, 0x6b, 0xe2, 0x26, 0xe2, 0xf3, 0x79, 0xd8, 0x59, 0xe1, 0x86, 0x49, 0x65,
+	0x37, 0xbb, 0x02, 0x9c, 0xfd, 0x18, 0x62, 0xb6, 0x58, 0x56, 0x74, 0x23, 0x6f, 0x62, 0xf9, 0x9c,
+	0x0b, 0x04, 0x19, 0xc0, 0xef, 0xa2, 0xb5, 0xeb, 0x5b, 0x52, 0xb6, 0x59, 0x5d, 0xad, 0x28, 0xf8,
+	0x4f, 0x3c, 0xe9, 0xfa, 0xc4, 0xff, 0xd1, 0x2d, 0xd7, 0x7e, 0x25, 0x68, 0xd4, 0x16, 0x8c, 0x78,
+	0x8d, 0x72, 0xcf, 0xcc, 0x61, 0xad, 0x8a, 0x79, 0xac, 0x3a, 0xc6, 0x53, 0xf3, 0x67, 0x02, 0xb3,
+	0xc1, 0xa3, 0x3c, 0xad, 0x92, 0x37, 0x2b, 0x65, 0xf5, 0x50, 0x3d, 0x8f, 0x13, 0xfc, 0x7c, 0xc5,
+	0x2c, 0x2b, 0xfc, 0x25, 0x86, 0x9d, 0xcb, 0xb0, 0xb3, 0xb2, 0xe2, 0x2c, 0xd0, 0x33, 0x30, 0xc4,
+	0x4c, 0xdc, 0x14, 0x6f, 0xb6, 0x41, 0x66, 0x8a, 0x2d, 0x7f, 0x30, 0xfa, 0xba, 0x0e, 0xc6, 0x47,
+	0x04, 0x66, 0xb8, 0xc4, 0x36, 0x06, 0xf9, 0x1f, 0x8f, 0xc4, 0xff, 0x78, 0xa4, 0xef, 0x42, 0xd4,
+	0xaa, 0xd3, 0xa2, 0x73, 0x17, 0x02, 0xc3, 0xd5, 0x46, 0x87, 0x9b, 0x8c, 0x1e, 0x51, 0xd2, 0x4f,
+	0x23, 0x2d, 0xc6, 0x9c, 0x3e, 0x67, 0x63, 0xde, 0x6c, 0x40, 0x3f, 0x33, 0x99, 0x5a, 0xc2, 0xc4,
+	0xec, 0x16, 0x80, 0x10, 0x42, 0xb7, 0x60, 0x80, 0x1b, 0xe8, 0x5c, 0x28, 0x4e, 0xfa, 0xbd, 0x19,
+	0x7c, 0x4f, 0x77, 0x70, 0x9c, 0x3b, 0x6e, 0x16, 0xa2, 0x1a, 0xb2, 0xb0, 0xb7, 0xfb, 0x2c, 0x3c,
+	0x87, 0x6d, 0x46, 0xad, 0xc1, 0x12, 0x4f, 0x22, 0x1c, 0x31, 0xfd, 0x23, 0x82, 0x23, 0xa8, 0xc6,
+	0x6d, 0xf4, 0xd4, 0x24, 0x0c, 0xda, 0x4c, 0x2d, 0x95, 0x34, 0x31, 0x47, 0x18, 0x92, 0xdd, 0x9f,
+	0x58, 0x9a, 0x4b, 0xa5, 0x86, 0x56, 0x9a, 0xaf, 0x61, 0x69, 0xbe, 0x08, 0x27, 0x90, 0x1a, 0x6b,
+	0x33, 0x26, 0xe6, 0x08, 0xae, 0x8a, 0xa2, 0xec, 0xb4, 0xc6, 0xdc, 0x91, 0x8a, 0x6f, 0x86, 0x29,
+	0xc6, 0x92, 0xa3, 0x7c, 0xe7, 0x89, 0x67, 0x90, 0x39, 0x0d, 0x51, 0x31, 0x44, 0x14, 0x64, 0xfd,
+	0x62, 0xba, 0xc1, 0x97, 0x04, 0xc1, 0x02, 0x8c, 0x3b, 0x35, 0xdf, 0x91, 0xe4, 0x13, 0x38, 0xc0,
+	0x29, 0x29, 0xee, 0x79, 0x45, 0xbe, 0x0b, 0x51, 0x97, 0x23, 0x6f, 0xd9, 0x93, 0x83, 0x3c, 0x8a,
+	0x8b, 0xa1, 0x92, 0xe2, 0xa1, 0xe0, 0xe3, 0x72, 0x30, 0x82, 0x80, 0xb2, 0x56, 0x2d, 0x7b, 0xfe,
+	0x9e, 0x78, 0x1b, 0xfb, 0x9f, 0xa3, 0x74, 0x0c, 0x46, 0x36, 0x1f, 0x6d, 0x2a, 0xab, 0xeb, 0x9b,
+	0x4b, 0x1b, 0xeb, 0x4f, 0x57, 0x1e, 0x8c, 0xf6, 0xd0, 0x11, 0x18, 0xae, 0xff, 0x24, 0x74, 0x10,
+	0x7a, 0x97, 0x36, 0xbf, 0x35, 0x1a, 0x49, 0xfd, 0x6e, 0x12, 0xfa, 0x79, 0x7c, 0xe8, 0xf7, 0x08,
+	0x0c, 0x88, 0x0f, 0x13, 0xb4, 0xf5, 0xbb, 0xd7, 0xff, 0x15, 0x24, 0x3e, 0xd7, 0x99, 0x50, 0xc4,
+	0x59, 0x7a, 0xed, 0xfb, 0x7f, 0x79, 0xf5, 0x93, 0xc8, 0x79, 0x7a, 0x36, 0xd9, 0xfa, 0x43, 0x0e,
+	0xfd, 0x82, 0xc0, 0x74, 0x87, 0xb1, 0x29, 0xbd, 0xdf, 0x5a, 0x65, 0xb8, 0x41, 0x7e, 0x7c, 0xe9,
+	0x08, 0x12, 0xd0, 0x9a, 0x9b, 0xdc, 0x9a, 0x14, 0x5d, 0x48, 0xb6, 0xfb, 0xe8, 0x54, 0x1f, 0x14,
+	0x27, 0xbf, 0x2b, 0x92, 0xf8, 0x7d, 0xfa, 0x5f, 0x02, 0xe7, 0xdb, 0x7e, 0x79, 0xa1, 0x6f, 0xb5,
+	0x86, 0x17, 0xe6, 0xd3, 0x50, 0xfc, 0x5e, 0xd7, 0xfc, 0x68, 0xdc, 0x26, 0x37, 0x6e, 0x8d, 0xae,
+	0x86, 0x36, 0xce, 0x77, 0xb3, 0xbc, 0x9f, 0xe4, 0xc7, 0xa1, 0x6e, 0xf2, 0x2b, 0x02, 0xe7, 0xda,
+	0x7d, 0xcc, 0xa1, 0x77, 0xc3, 0x23, 0x0e, 0xf8, 0xa6, 0x14, 0x7f, 0xab, 0x5b, 0x76, 0xb4, 0x77,
+	0x85, 0xdb, 0x7b, 0x8f, 0xde, 0x3d, 0x92, 0xbd, 0xf4, 0x17, 0x04, 0x4e, 0x36, 0x0c, 0xd2, 0xe9,
+	0x42, 0x87, 0x54, 0x6b, 0x1a, 0xc9, 0xc7, 0x17, 0x0f, 0xc1, 0x81, 0xf8, 0xaf, 0x72, 0xfc, 0xb3,
+	0xf4, 0x62, 0x20, 0x7e, 0xd5, 0xe5, 0xc2, 0x3a, 0x4a, 0xff, 0x49, 0x60, 0x3c, 0x68, 0xb0, 0x4d,
+	0xdf, 0x3c, 0xec, 0x20, 0x5c, 0x20, 0xbe, 0xd1, 0xdd, 0xfc, 0x5c, 0x7a, 0xc2, 0x61, 0x67, 0xe8,
+	0x66, 0xd7, 0x6e, 0xe7, 0x92, 0xf9, 0x20, 0x45, 0x88, 0x56, 0x4a, 0xba, 0xcd, 0xe8, 0x67, 0x04,
+	0xc6, 0x9a, 0x66, 0xab, 0x34, 0x75, 0xa8, 0x41, 0xac, 0xb0, 0xec, 0x5a, 0x17, 0xc3, 0x5b, 0x69,
+	0x9b, 0x9b, 0xb5, 0x49, 0x37, 0x8e, 0x60, 0x96, 0x6f, 0x98, 0xcc, 0x8d, 0xfa, 0x80, 0x40, 0x3f,
+	0xaf, 0xf0, 0xf4, 0x52, 0x6b, 0x50, 0xde, 0x69, 0x6a, 0x7c, 0xb6, 0x23, 0x1d, 0x02, 0xbe, 0xc2,
+	0x01, 0x5f, 0xa2, 0xaf, 0x07, 0x02, 0x16, 0xf7, 0x6a, 0xfd, 0x30, 0xff, 0x88, 0x00, 0xd4, 0x87,
+	0x92, 0xf4, 0x72, 0x7b, 0x17, 0xf9, 0xc6, 0xab, 0xf1, 0x2b, 0xe1, 0x88, 0x43, 0xdd, 0x18, 0x38,
+	0xd1, 0xfc, 0x98, 0xc0, 0x88, 0x6f, 0x9e, 0x48, 0x13, 0xad, 0x95, 0x04, 0x4d, 0x2b, 0xe3, 0xc9,
+	0xd0, 0xf4, 0x88, 0xeb, 0x32, 0xc7, 0x75, 0x91, 0xbe, 0x16, 0x88, 0xcb, 0xe9, 0x13, 0x3c, 0xee,
+	0xfa, 0x0d, 0x81, 0x21, 0x77, 0x80, 0x42, 0xdf, 0x68, 0xad, 0xaa, 0x61, 0x44, 0x19, 0x9f, 0x0f,
+	0x43, 0x8a, 0x80, 0xd6, 0x38, 0xa0, 0x34, 0xbd, 0xdf, 0x6d, 0xc6, 0xb9, 0xf3, 0x1c, 0xfa, 0x33,
+	0x02, 0x23, 0xbe, 0x69, 0x51, 0x3b, 0x6f, 0x06, 0xcd, 0xb7, 0xda, 0x79, 0x33, 0x70, 0x0c, 0x25,
+	0x5d, 0xe2, 0xe0, 0x67, 0xe8, 0x54, 0x20, 0xf8, 0xfa, 0xa4, 0xe9, 0x0f, 0x04, 0xa2, 0xde, 0x61,
+	0x4c, 0x9b, 0x5c, 0x6a, 0x1e, 0x21, 0xc5, 0xaf, 0x86, 0xa4, 0x46, 0x50, 0x1b, 0x1c, 0xd4, 0x2a,
+	0x7d, 0xd0, 0xad, 0x47, 0xbd, 0x73, 0x16, 0xfa, 0x4b, 0x07, 0x7a, 0xfd, 0x01, 0xd8, 0x16, 0x7a,
+	0xd3, 0xfc, 0xa3, 0x2d, 0xf4, 0xe6, 0x07, 0xa7, 0x74, 0x9b, 0x43, 0xbf, 0x4e, 0x53, 0x81, 0xd0,
+	0x7d, 0x8f, 0xd9, 0x46, 0xd4, 0xf4, 0x23, 0x02, 0x31, 0xef, 0x33, 0x98, 0x86, 0xd3, 0x5d, 0xf3,
+	0x72, 0x22, 0x2c, 0x39, 0x62, 0x9d, 0xe7, 0x58, 0x5f, 0xa7, 0x52, 0x67, 0xac, 0xf4, 0x4b, 0x02,
+	0x67, 0xdb, 0xbd, 0x0a, 0xef, 0x1c, 0xa2, 0xeb, 0x69, 0x7a, 0x1d, 0xc7, 0xef, 0x76, 0xc9, 0x8d,
+	0x86, 0xbc, 0xcd, 0x0d, 0x59, 0xa1, 0xcb, 0x5d, 0xd7, 0x7c, 0x8f, 0x25, 0x9f, 0x10, 0x38, 0xe1,
+	0x7f, 0x2c, 0xd1, 0x64, 0x67, 0x78, 0xbe, 0x57, 0x57, 0x7c, 0x21, 0x3c, 0x43, 0xa8, 0x5b, 0xa0,
+	0x3e, 0x90, 0xe7, 0x5c, 0xe9, 0x8d, 0x67, 0x2f, 0xa6, 0xc8, 0xa7, 0x2f, 0xa6, 0xc8, 0x17, 0x2f,
+	0xa6, 0xc8, 0x87, 0x2f, 0xa7, 0x7a, 0x3e, 0x7d, 0x39, 0xd5, 0xf3, 0xb7, 0x97, 0x53, 0x3d, 0x4f,
+	0x53, 0x9d, 0x67, 0xf4, 0xfb, 0x75, 0xd1, 0x7c, 0x5c, 0x9f, 0x1d, 0xe0, 0xf3, 0xb0, 0x6b, 0xff,
+	0x0b, 0x00, 0x00, 0xff, 0xff, 0x02, 0x43, 0x8f, 0x0e, 0x7a, 0x26, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ActiveFinalityProvidersAtHeight queries finality providers with non zero voting power at given height.
+	ActiveFinalityProvidersAtHeight(ctx context.Context, in *QueryActiveFinalityProvidersAtHeightRequest, opts ...grpc.CallOption) (*QueryActiveFinalityProvidersAtHeightResponse, error)
+	// FinalityProviderPowerAtHeight queries the voting power of a finality provider at a given height
+	FinalityProviderPowerAtHeight(ctx context.Context, in *QueryFinalityProviderPowerAtHeightRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPowerAtHeightResponse, error)
+	// FinalityProviderCurrentPower queries the voting power of a finality provider at the current height
+	FinalityProviderCurrentPower(ctx context.Context, in *QueryFinalityProviderCurrentPowerRequest, opts ...grpc.CallOption) (*QueryFinalityProviderCurrentPowerResponse, error)
+	// ActivatedHeight queries the height when BTC staking protocol is activated, i.e., the first height when
+	// there exists 1 finality provider with voting power
+	ActivatedHeight(ctx context.Context, in *QueryActivatedHeightRequest, opts ...grpc.CallOption) (*QueryActivatedHeightResponse, error)
+	// ListPublicRandomness is a range query for public randomness of a given finality provider
+	// NOTE: Babylon only has the knowledge of public randomness that is already revealed by
+	// finality providers, i.e., the finality provider already provides a finality signature
+	// at the corresponding height
+	ListPublicRandomness(ctx context.Context, in *QueryListPublicRandomnessRequest, opts ...grpc.CallOption) (*QueryListPublicRandomnessResponse, error)
+	// ListPubRandCommit is a range query for public randomness commitments of a given finality provider
+	ListPubRandCommit(ctx context.Context, in *QueryListPubRandCommitRequest, opts ...grpc.CallOption) (*QueryListPubRandCommitResponse, error)
+	// Block queries a block at a given height
+	Block(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error)
+	// ListBlocks is a range query for blocks at a given status
+	ListBlocks(ctx context.Context, in *QueryListBlocksRequest, opts ...grpc.CallOption) (*QueryListBlocksResponse, error)
+	// VotesAtHeight queries finality providers who have signed the block at given height.
+	VotesAtHeight(ctx context.Context, in *QueryVotesAtHeightRequest, opts ...grpc.CallOption) (*QueryVotesAtHeightResponse, error)
+	// Evidence queries the first evidence which can be used for extracting the BTC SK
+	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
+	// ListEvidences queries is a range query for evidences
+	ListEvidences(ctx context.Context, in *QueryListEvidencesRequest, opts ...grpc.CallOption) (*QueryListEvidencesResponse, error)
+	// SlashingTxs queries the fully signed slashing txs of the active and
+	// unbonding BTC delegations restaked to a slashed finality provider,
+	// using the BTC SK extracted from its first slashable evidence
+	SlashingTxs(ctx context.Context, in *QuerySlashingTxsRequest, opts ...grpc.CallOption) (*QuerySlashingTxsResponse, error)
+	// SigningInfo queries the signing info of given finality provider BTC public key
+	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
+	// SigningInfos queries the signing info of all the active finality providers
+	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// FinalityProviderPerformance queries the lifetime voting performance of a
+	// finality provider together with its per-epoch rollups
+	FinalityProviderPerformance(ctx context.Context, in *QueryFinalityProviderPerformanceRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPerformanceResponse, error)
+	// FinalityStatus queries whether finality is stalled, together with the
+	// breakdown of the voting power missing at the earliest non-finalized block
+	FinalityStatus(ctx context.Context, in *QueryFinalityStatusRequest, opts ...grpc.CallOption) (*QueryFinalityStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
@@ -2146,6 +2762,15 @@ func (c *queryClient) ListEvidences(ctx context.Context, in *QueryListEvidencesR
 	return out, nil
 }
 
+func (c *queryClient) SlashingTxs(ctx context.Context, in *QuerySlashingTxsRequest, opts ...grpc.CallOption) (*QuerySlashingTxsResponse, error) {
+	out := new(QuerySlashingTxsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/SlashingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error) {
 	out := new(QuerySigningInfoResponse)
 	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/SigningInfo", in, out, opts...)
@@ -2164,6 +2789,24 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) FinalityProviderPerformance(ctx context.Context, in *QueryFinalityProviderPerformanceRequest, opts ...grpc.CallOption) (*QueryFinalityProviderPerformanceResponse, error) {
+	out := new(QueryFinalityProviderPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/FinalityProviderPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FinalityStatus(ctx context.Context, in *QueryFinalityStatusRequest, opts ...grpc.CallOption) (*QueryFinalityStatusResponse, error) {
+	out := new(QueryFinalityStatusResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Query/FinalityStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -2194,10 +2837,20 @@ type QueryServer interface {
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// ListEvidences queries is a range query for evidences
 	ListEvidences(context.Context, *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error)
+	// SlashingTxs queries the fully signed slashing txs of the active and
+	// unbonding BTC delegations restaked to a slashed finality provider,
+	// using the BTC SK extracted from its first slashable evidence
+	SlashingTxs(context.Context, *QuerySlashingTxsRequest) (*QuerySlashingTxsResponse, error)
 	// SigningInfo queries the signing info of given finality provider BTC public key
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries the signing info of all the active finality providers
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// FinalityProviderPerformance queries the lifetime voting performance of a
+	// finality provider together with its per-epoch rollups
+	FinalityProviderPerformance(context.Context, *QueryFinalityProviderPerformanceRequest) (*QueryFinalityProviderPerformanceResponse, error)
+	// FinalityStatus queries whether finality is stalled, together with the
+	// breakdown of the voting power missing at the earliest non-finalized block
+	FinalityStatus(context.Context, *QueryFinalityStatusRequest) (*QueryFinalityStatusResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2240,12 +2893,21 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) ListEvidences(ctx context.Context, req *QueryListEvidencesRequest) (*QueryListEvidencesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEvidences not implemented")
 }
+func (*UnimplementedQueryServer) SlashingTxs(ctx context.Context, req *QuerySlashingTxsRequest) (*QuerySlashingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashingTxs not implemented")
+}
 func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfo not implemented")
 }
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) FinalityProviderPerformance(ctx context.Context, req *QueryFinalityProviderPerformanceRequest) (*QueryFinalityProviderPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderPerformance not implemented")
+}
+func (*UnimplementedQueryServer) FinalityStatus(ctx context.Context, req *QueryFinalityStatusRequest) (*QueryFinalityStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityStatus not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2467,6 +3129,24 @@ func _Query_ListEvidences_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SlashingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/SlashingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashingTxs(ctx, req.(*QuerySlashingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySigningInfoRequest)
 	if err := dec(in); err != nil {
@@ -2503,6 +3183,42 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FinalityProviderPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/FinalityProviderPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderPerformance(ctx, req.(*QueryFinalityProviderPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Query/FinalityStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityStatus(ctx, req.(*QueryFinalityStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.finality.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2555,6 +3271,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvidences",
 			Handler:    _Query_ListEvidences_Handler,
 		},
+		{
+			MethodName: "SlashingTxs",
+			Handler:    _Query_SlashingTxs_Handler,
+		},
 		{
 			MethodName: "SigningInfo",
 			Handler:    _Query_SigningInfo_Handler,
@@ -2563,6 +3283,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "FinalityProviderPerformance",
+			Handler:    _Query_FinalityProviderPerformance_Handler,
+		},
+		{
+			MethodName: "FinalityStatus",
+			Handler:    _Query_FinalityStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/finality/v1/query.proto",
@@ -3658,6 +4386,148 @@ func (m *QueryListEvidencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySlashingTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationSlashingTxs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationSlashingTxs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationSlashingTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingSlashingTxHex) > 0 {
+		i -= len(m.UnbondingSlashingTxHex)
+		copy(dAtA[i:], m.UnbondingSlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingSlashingTxHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashingTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashingTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashingTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SlashingTxs) > 0 {
+		for iNdEx := len(m.SlashingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QuerySigningInfoRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3708,12 +4578,41 @@ func (m *SigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
-	if err15 != nil {
-		return 0, err15
+	if m.JailCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.JailHistory) > 0 {
+		for iNdEx := len(m.JailHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err17 != nil {
+		return 0, err17
 	}
-	i -= n15
-	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i -= n17
+	i = encodeVarintQuery(dAtA, i, uint64(n17))
 	i--
 	dAtA[i] = 0x22
 	if m.MissedBlocksCounter != 0 {
@@ -3853,57 +4752,301 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFinalityProviderPerformanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryFinalityProviderPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFinalityProviderPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if m.ToEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToEpoch))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.FromEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EpochFinalityProviderPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochFinalityProviderPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochFinalityProviderPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Performance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityProviderPerformanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalityStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingFps) > 0 {
+		for iNdEx := len(m.MissingFps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissingFps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MissingVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissingVotingPower))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.VotedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotedPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StalledBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StalledBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StallHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StallHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Stalled {
+		i--
+		if m.Stalled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPowerAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.VotingPower != 0 {
@@ -4316,7 +5459,7 @@ func (m *QueryListEvidencesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
+func (m *QuerySlashingTxsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4326,73 +5469,246 @@ func (m *QuerySigningInfoRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *SigningInfoResponse) Size() (n int) {
+func (m *BTCDelegationSlashingTxs) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.FpBtcPkHex)
+	l = len(m.StakingTxHash)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UnbondingSlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
-	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QuerySigningInfoResponse) Size() (n int) {
+func (m *QuerySlashingTxsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.SigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SlashingTxs) > 0 {
+		for _, e := range m.SlashingTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
+func (m *QuerySigningInfoRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QuerySigningInfosResponse) Size() (n int) {
+func (m *SigningInfoResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.SigningInfos) > 0 {
-		for _, e := range m.SigningInfos {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
-	if m.Pagination != nil {
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.JailHistory) > 0 {
+		for _, e := range m.JailHistory {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovQuery(uint64(m.JailCount))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.FromEpoch))
+	}
+	if m.ToEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.ToEpoch))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochFinalityProviderPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	l = m.Performance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFinalityProviderPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
+func (m *QueryFinalityStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFinalityStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stalled {
+		n += 2
+	}
+	if m.StallHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StallHeight))
+	}
+	if m.StalledBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.StalledBlocks))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.TotalVotingPower))
+	}
+	if m.VotedPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotedPower))
+	}
+	if m.MissingVotingPower != 0 {
+		n += 1 + sovQuery(uint64(m.MissingVotingPower))
+	}
+	if len(m.MissingFps) > 0 {
+		for _, e := range m.MissingFps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6247,7 +7563,914 @@ func (m *QueryBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &IndexedBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= QueriedBlockStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &IndexedBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesAtHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVotesAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVotesAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPks", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
+			m.BtcPks = append(m.BtcPks, v)
+			if err := m.BtcPks[len(m.BtcPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CanonicalAppHash == nil {
+				m.CanonicalAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkAppHash == nil {
+				m.ForkAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.SchnorrEOTSSig
+			m.CanonicalFinalitySig = &v
+			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_types.SchnorrEOTSSig
+			m.ForkFinalitySig = &v
+			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Evidence == nil {
+				m.Evidence = &EvidenceResponse{}
+			}
+			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6270,15 +8493,34 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEvidencesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6305,10 +8547,10 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &IndexedBlock{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6333,7 +8575,7 @@ func (m *QueryBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6356,17 +8598,17 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEvidencesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6376,11 +8618,26 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= QueriedBlockStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidences = append(m.Evidences, &EvidenceResponse{})
+			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -6411,7 +8668,7 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6438,7 +8695,7 @@ func (m *QueryListBlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashingTxsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6461,17 +8718,17 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListBlocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashingTxsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashingTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6481,25 +8738,23 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Blocks = append(m.Blocks, &IndexedBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -6531,7 +8786,7 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -6558,7 +8813,7 @@ func (m *QueryListBlocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *BTCDelegationSlashingTxs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6581,17 +8836,17 @@ func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesAtHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCDelegationSlashingTxs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesAtHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCDelegationSlashingTxs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6601,11 +8856,120 @@ func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTxHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingSlashingTxHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6627,7 +8991,7 @@ func (m *QueryVotesAtHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashingTxsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6650,17 +9014,17 @@ func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVotesAtHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashingTxsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVotesAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashingTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6670,24 +9034,59 @@ func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashingTxs = append(m.SlashingTxs, &BTCDelegationSlashingTxs{})
+			if err := m.SlashingTxs[len(m.SlashingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_types.BIP340PubKey
-			m.BtcPks = append(m.BtcPks, v)
-			if err := m.BtcPks[len(m.BtcPks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6712,7 +9111,7 @@ func (m *QueryVotesAtHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6735,10 +9134,10 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6794,7 +9193,7 @@ func (m *QueryEvidenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6817,10 +9216,10 @@ func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SigningInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6857,9 +9256,9 @@ func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.BlockHeight = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6869,16 +9268,16 @@ func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
 			}
-			var byteLen int
+			m.MissedBlocksCounter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6888,32 +9287,16 @@ func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_types.SchnorrPubRand
-			m.PubRand = &v
-			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6923,31 +9306,30 @@ func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CanonicalAppHash == nil {
-				m.CanonicalAppHash = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailHistory", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6957,31 +9339,31 @@ func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ForkAppHash == nil {
-				m.ForkAppHash = []byte{}
+			m.JailHistory = append(m.JailHistory, JailRecord{})
+			if err := m.JailHistory[len(m.JailHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6991,32 +9373,17 @@ func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_types.SchnorrEOTSSig
-			m.CanonicalFinalitySig = &v
-			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Tombstoned = bool(v != 0)
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
 			}
-			var byteLen int
+			m.JailCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7026,27 +9393,11 @@ func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.JailCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_types.SchnorrEOTSSig
-			m.ForkFinalitySig = &v
-			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7068,7 +9419,7 @@ func (m *EvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7091,15 +9442,15 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7126,10 +9477,7 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Evidence == nil {
-				m.Evidence = &EvidenceResponse{}
-			}
-			if err := m.Evidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7154,7 +9502,7 @@ func (m *QueryEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7177,32 +9525,13 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvidencesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvidencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
-			}
-			m.StartHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7259,7 +9588,7 @@ func (m *QueryListEvidencesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7282,15 +9611,15 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvidencesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvidencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Evidences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7317,8 +9646,8 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Evidences = append(m.Evidences, &EvidenceResponse{})
-			if err := m.Evidences[len(m.Evidences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SigningInfos = append(m.SigningInfos, SigningInfoResponse{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7379,89 +9708,7 @@ func (m *QueryListEvidencesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderPerformanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7484,10 +9731,10 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderPerformanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7524,9 +9771,9 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromEpoch", wireType)
 			}
-			m.StartHeight = 0
+			m.FromEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7536,16 +9783,16 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.FromEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToEpoch", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.ToEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7555,14 +9802,14 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.ToEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7589,7 +9836,10 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7614,7 +9864,7 @@ func (m *SigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *EpochFinalityProviderPerformance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7637,15 +9887,34 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochFinalityProviderPerformance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochFinalityProviderPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Performance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7672,7 +9941,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Performance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7697,7 +9966,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityProviderPerformanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7720,13 +9989,80 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityProviderPerformanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityProviderPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochFinalityProviderPerformance{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7756,7 +10092,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -7783,7 +10119,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFinalityStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7806,17 +10142,67 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFinalityStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFinalityStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stalled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7826,29 +10212,110 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			m.Stalled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StallHeight", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.StallHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StallHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalledBlocks", wireType)
 			}
-			m.SigningInfos = append(m.SigningInfos, SigningInfoResponse{})
-			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StalledBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StalledBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			m.VotedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingVotingPower", wireType)
+			}
+			m.MissingVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingFps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7875,10 +10342,8 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MissingFps = append(m.MissingFps, FinalityProviderMissingPower{})
+			if err := m.MissingFps[len(m.MissingFps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
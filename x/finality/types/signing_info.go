@@ -26,6 +26,17 @@ func (si *FinalityProviderSigningInfo) IsJailingPeriodPassed(curBlockTime time.T
 	return si.JailedUntil.Before(curBlockTime), nil
 }
 
+// AddJailRecord records a jailing of the finality provider. Only the latest
+// MaxJailHistoryLength records are kept in the jail history, while the jail
+// count keeps track of all the jailings
+func (si *FinalityProviderSigningInfo) AddJailRecord(record JailRecord) {
+	si.JailCount++
+	si.JailHistory = append(si.JailHistory, record)
+	if len(si.JailHistory) > MaxJailHistoryLength {
+		si.JailHistory = si.JailHistory[len(si.JailHistory)-MaxJailHistoryLength:]
+	}
+}
+
 func (si *FinalityProviderSigningInfo) IncrementMissedBlocksCounter() {
 	si.MissedBlocksCounter++
 }
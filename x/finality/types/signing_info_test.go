@@ -0,0 +1,24 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/x/finality/types"
+)
+
+func TestAddJailRecord(t *testing.T) {
+	var signingInfo types.FinalityProviderSigningInfo
+
+	numJailings := types.MaxJailHistoryLength + 10
+	for height := 1; height <= numJailings; height++ {
+		signingInfo.AddJailRecord(types.JailRecord{Height: int64(height)})
+	}
+
+	// all jailings are counted but only the latest ones are kept
+	require.Equal(t, uint32(numJailings), signingInfo.JailCount)
+	require.Len(t, signingInfo.JailHistory, types.MaxJailHistoryLength)
+	require.Equal(t, int64(11), signingInfo.JailHistory[0].Height)
+	require.Equal(t, int64(numJailings), signingInfo.JailHistory[types.MaxJailHistoryLength-1].Height)
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/incentive/types"
+)
+
+// RewardBTCHeaderRelaying records that the given reporter is the first to relay
+// the given number of new headers to the BTC light client main chain in the
+// current epoch. Once the epoch has ended, its BTC header relaying gauge is
+// distributed to its reporters in proportion to their relayed headers
+func (k Keeper) RewardBTCHeaderRelaying(ctx context.Context, reporter sdk.AccAddress, numHeaders uint32) {
+	if numHeaders == 0 {
+		return
+	}
+
+	epoch := k.epochingKeeper.GetEpoch(ctx).EpochNumber
+	store := k.relayedHeadersStore(ctx, epoch)
+	relayedHeaders := uint64(numHeaders)
+	if relayedHeadersBytes := store.Get(reporter); relayedHeadersBytes != nil {
+		relayedHeaders += sdk.BigEndianToUint64(relayedHeadersBytes)
+	}
+	store.Set(reporter, sdk.Uint64ToBigEndian(relayedHeaders))
+}
+
+// DistributeBTCHeaderRelayingRewards distributes the BTC header relaying gauges
+// of the epochs before the current one to the reporters who relayed new headers
+// in these epochs, in proportion to the number of their relayed headers. The
+// gauge of an epoch without relayed headers is carried over to the current
+// epoch. It is invoked upon every `BeginBlock`.
+func (k Keeper) DistributeBTCHeaderRelayingRewards(ctx context.Context) {
+	epoch := k.epochingKeeper.GetEpoch(ctx).EpochNumber
+
+	// find the gauges of the ended epochs
+	gaugeStore := k.btcHeaderRelayingGaugeStore(ctx)
+	endedEpochs := []uint64{}
+	it := gaugeStore.Iterator(nil, sdk.Uint64ToBigEndian(epoch))
+	for ; it.Valid(); it.Next() {
+		endedEpochs = append(endedEpochs, sdk.BigEndianToUint64(it.Key()))
+	}
+	it.Close()
+
+	for _, endedEpoch := range endedEpochs {
+		gauge := k.GetBTCHeaderRelayingGauge(ctx, endedEpoch)
+		k.deleteBTCHeaderRelayingGauge(ctx, endedEpoch)
+
+		reporters, relayedHeaders, totalRelayedHeaders := k.getRelayedHeaders(ctx, endedEpoch)
+		if totalRelayedHeaders == 0 {
+			// no header has been relayed in this epoch, carry the gauge over
+			k.addToBTCHeaderRelayingGauge(ctx, epoch, gauge.Coins)
+			continue
+		}
+		for i, reporter := range reporters {
+			portion := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(relayedHeaders[i])).
+				QuoInt(sdkmath.NewIntFromUint64(totalRelayedHeaders))
+			k.accumulateRewardGauge(ctx, types.HeaderRelayerType, reporter, types.GetCoinsPortion(gauge.Coins, portion))
+		}
+	}
+
+	// remove the relayed headers of the ended epochs
+	relayedHeadersStore := k.allRelayedHeadersStore(ctx)
+	keys := [][]byte{}
+	it = relayedHeadersStore.Iterator(nil, sdk.Uint64ToBigEndian(epoch))
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		relayedHeadersStore.Delete(key)
+	}
+}
+
+// getRelayedHeaders returns the reporters who relayed new headers in the given
+// epoch, the number of headers relayed by each of them, and the total number of
+// relayed headers
+func (k Keeper) getRelayedHeaders(ctx context.Context, epoch uint64) ([]sdk.AccAddress, []uint64, uint64) {
+	reporters := []sdk.AccAddress{}
+	relayedHeaders := []uint64{}
+	var total uint64
+
+	it := k.relayedHeadersStore(ctx, epoch).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		reporters = append(reporters, sdk.AccAddress(it.Key()))
+		numHeaders := sdk.BigEndianToUint64(it.Value())
+		relayedHeaders = append(relayedHeaders, numHeaders)
+		total += numHeaders
+	}
+	return reporters, relayedHeaders, total
+}
+
+func (k Keeper) accumulateBTCHeaderRelayingReward(ctx context.Context, btcHeaderRelayingReward sdk.Coins) {
+	// do nothing if there is no reward for BTC header relaying
+	if !btcHeaderRelayingReward.IsAllPositive() {
+		return
+	}
+
+	// update BTC header relaying gauge of the current epoch
+	epoch := k.epochingKeeper.GetEpoch(ctx).EpochNumber
+	k.addToBTCHeaderRelayingGauge(ctx, epoch, btcHeaderRelayingReward)
+
+	// transfer the BTC header relaying reward from fee collector account to incentive module account
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, btcHeaderRelayingReward)
+	if err != nil {
+		// this can only be programming error and is unrecoverable
+		panic(err)
+	}
+}
+
+func (k Keeper) addToBTCHeaderRelayingGauge(ctx context.Context, epoch uint64, coins sdk.Coins) {
+	gauge := k.GetBTCHeaderRelayingGauge(ctx, epoch)
+	if gauge == nil {
+		gauge = types.NewGauge()
+	}
+	gauge.Coins = gauge.Coins.Add(coins...)
+	k.SetBTCHeaderRelayingGauge(ctx, epoch, gauge)
+}
+
+func (k Keeper) SetBTCHeaderRelayingGauge(ctx context.Context, epoch uint64, gauge *types.Gauge) {
+	store := k.btcHeaderRelayingGaugeStore(ctx)
+	gaugeBytes := k.cdc.MustMarshal(gauge)
+	store.Set(sdk.Uint64ToBigEndian(epoch), gaugeBytes)
+}
+
+// GetBTCHeaderRelayingGauge returns the gauge of rewards for the headers relayed
+// in the given epoch, or nil if there is no such reward
+func (k Keeper) GetBTCHeaderRelayingGauge(ctx context.Context, epoch uint64) *types.Gauge {
+	store := k.btcHeaderRelayingGaugeStore(ctx)
+	gaugeBytes := store.Get(sdk.Uint64ToBigEndian(epoch))
+	if gaugeBytes == nil {
+		return nil
+	}
+
+	var gauge types.Gauge
+	k.cdc.MustUnmarshal(gaugeBytes, &gauge)
+	return &gauge
+}
+
+func (k Keeper) deleteBTCHeaderRelayingGauge(ctx context.Context, epoch uint64) {
+	store := k.btcHeaderRelayingGaugeStore(ctx)
+	store.Delete(sdk.Uint64ToBigEndian(epoch))
+}
+
+// btcHeaderRelayingGaugeStore returns the KVStore of the gauge of total reward for
+// BTC header relaying at each epoch
+// prefix: BTCHeaderRelayingGaugeKey
+// key: gauge epoch
+// value: gauge of rewards for BTC header relaying at this epoch
+func (k Keeper) btcHeaderRelayingGaugeStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCHeaderRelayingGaugeKey)
+}
+
+// allRelayedHeadersStore returns the KVStore of the number of new headers
+// relayed by each reporter at each epoch
+// prefix: BTCRelayedHeadersKey
+// key: (epoch, reporter address)
+// value: number of new headers relayed by the reporter at this epoch
+func (k Keeper) allRelayedHeadersStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCRelayedHeadersKey)
+}
+
+// relayedHeadersStore returns the KVStore of the number of new headers relayed
+// by each reporter at the given epoch
+// prefix: BTCRelayedHeadersKey || epoch
+// key: reporter address
+// value: number of new headers relayed by the reporter at this epoch
+func (k Keeper) relayedHeadersStore(ctx context.Context, epoch uint64) prefix.Store {
+	return prefix.NewStore(k.allRelayedHeadersStore(ctx), sdk.Uint64ToBigEndian(epoch))
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	epochingtypes "github.com/babylonlabs-io/babylon/x/epoching/types"
+	"github.com/babylonlabs-io/babylon/x/incentive/types"
+)
+
+func FuzzRewardBTCHeaderRelaying(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// mock epoching keeper returning the current epoch
+		epoch := datagen.GenRandomEpochNum(r)
+		curEpoch := epoch
+		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).DoAndReturn(func(_ context.Context) *epochingtypes.Epoch {
+			return &epochingtypes.Epoch{EpochNumber: curEpoch}
+		}).AnyTimes()
+		k, ctx := testkeeper.IncentiveKeeper(t, nil, nil, epochingKeeper)
+
+		// random reporters relay random numbers of new headers in the epoch
+		numReporters := int(datagen.RandomInt(r, 5)) + 1
+		reporters := make([]sdk.AccAddress, numReporters)
+		relayedHeaders := make([]uint32, numReporters)
+		var totalRelayedHeaders uint32
+		for i := range reporters {
+			reporters[i] = datagen.GenRandomAccount().GetAddress()
+			// relay headers in two messages
+			numHeaders1 := uint32(datagen.RandomInt(r, 10)) + 1
+			numHeaders2 := uint32(datagen.RandomInt(r, 10))
+			k.RewardBTCHeaderRelaying(ctx, reporters[i], numHeaders1)
+			k.RewardBTCHeaderRelaying(ctx, reporters[i], numHeaders2)
+			relayedHeaders[i] = numHeaders1 + numHeaders2
+			totalRelayedHeaders += relayedHeaders[i]
+		}
+
+		// set a random gauge for the epoch
+		gauge := datagen.GenRandomGauge(r)
+		k.SetBTCHeaderRelayingGauge(ctx, epoch, gauge)
+
+		// nothing is distributed before the epoch ends
+		k.DistributeBTCHeaderRelayingRewards(ctx)
+		for _, reporter := range reporters {
+			require.Nil(t, k.GetRewardGauge(ctx, types.HeaderRelayerType, reporter))
+		}
+		require.Equal(t, gauge, k.GetBTCHeaderRelayingGauge(ctx, epoch))
+
+		// once the epoch has ended, the gauge is distributed to the reporters
+		// in proportion to their relayed headers
+		curEpoch = epoch + 1
+		k.DistributeBTCHeaderRelayingRewards(ctx)
+		distributedCoins := sdk.NewCoins()
+		for i, reporter := range reporters {
+			portion := sdkmath.LegacyNewDec(int64(relayedHeaders[i])).QuoInt64(int64(totalRelayedHeaders))
+			expectedReward := types.GetCoinsPortion(gauge.Coins, portion)
+			rg := k.GetRewardGauge(ctx, types.HeaderRelayerType, reporter)
+			if expectedReward.IsZero() {
+				require.Nil(t, rg)
+				continue
+			}
+			require.NotNil(t, rg)
+			require.Equal(t, expectedReward, rg.Coins)
+			distributedCoins = distributedCoins.Add(rg.Coins...)
+		}
+		require.True(t, gauge.Coins.IsAllGTE(distributedCoins))
+		require.Nil(t, k.GetBTCHeaderRelayingGauge(ctx, epoch))
+
+		// the gauge of an epoch without relayed headers is carried over
+		nextGauge := datagen.GenRandomGauge(r)
+		k.SetBTCHeaderRelayingGauge(ctx, epoch+1, nextGauge)
+		curEpoch = epoch + 2
+		k.DistributeBTCHeaderRelayingRewards(ctx)
+		require.Nil(t, k.GetBTCHeaderRelayingGauge(ctx, epoch+1))
+		require.Equal(t, nextGauge, k.GetBTCHeaderRelayingGauge(ctx, epoch+2))
+
+		// the rewards of the reporters are unchanged
+		for i, reporter := range reporters {
+			portion := sdkmath.LegacyNewDec(int64(relayedHeaders[i])).QuoInt64(int64(totalRelayedHeaders))
+			expectedReward := types.GetCoinsPortion(gauge.Coins, portion)
+			if expectedReward.IsZero() {
+				continue
+			}
+			require.Equal(t, expectedReward, k.GetRewardGauge(ctx, types.HeaderRelayerType, reporter).Coins)
+		}
+	})
+}
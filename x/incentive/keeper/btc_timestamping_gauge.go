@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+	"github.com/babylonlabs-io/babylon/x/incentive/types"
+)
+
+// RewardBTCTimestamping distributes rewards in the BTC timestamping gauge of a given
+// finalized epoch to the submitters/reporters of its BTC checkpoint submissions.
+// The best submission receives types.BestSubmissionPortion of the gauge and the other
+// submissions equally share the rest, whose remainder goes to the reporter of the
+// best submission. If there is no other submission, the best submission receives
+// the entire gauge. The reward of each submission is split
+// between its submitter and reporter according to types.SubmitterPortion. The
+// gauge is removed once its rewards are distributed.
+func (k Keeper) RewardBTCTimestamping(ctx context.Context, epoch uint64, rdi *btcctypes.RewardDistInfo) {
+	gauge := k.GetBTCTimestampingGauge(ctx, epoch)
+	if gauge == nil {
+		// no reward has been intercepted for this epoch
+		return
+	}
+
+	coinsForBest := gauge.Coins
+	if len(rdi.Others) > 0 {
+		coinsForBest = gauge.GetCoinsPortion(types.BestSubmissionPortion)
+		coinsForOthers := gauge.Coins.Sub(coinsForBest...)
+		otherPortion := sdkmath.LegacyOneDec().QuoInt64(int64(len(rdi.Others)))
+		coinsForEachOther := types.GetCoinsPortion(coinsForOthers, otherPortion)
+		for _, other := range rdi.Others {
+			k.rewardCheckpointAddressPair(ctx, other, coinsForEachOther)
+			coinsForOthers = coinsForOthers.Sub(coinsForEachOther...)
+		}
+		// the remainder of rounding down the shares of the other submissions
+		// goes to the reporter of the best submission, so that the entire
+		// gauge is distributed
+		k.accumulateRewardGauge(ctx, types.ReporterType, rdi.Best.Reporter, coinsForOthers)
+	}
+	k.rewardCheckpointAddressPair(ctx, rdi.Best, coinsForBest)
+
+	k.deleteBTCTimestampingGauge(ctx, epoch)
+}
+
+// rewardCheckpointAddressPair splits the given reward between the submitter and the
+// reporter of a checkpoint submission
+func (k Keeper) rewardCheckpointAddressPair(ctx context.Context, pair *btcctypes.CheckpointAddressPair, reward sdk.Coins) {
+	coinsForSubmitter := types.GetCoinsPortion(reward, types.SubmitterPortion)
+	coinsForReporter := reward.Sub(coinsForSubmitter...)
+	k.accumulateRewardGauge(ctx, types.SubmitterType, pair.Submitter, coinsForSubmitter)
+	k.accumulateRewardGauge(ctx, types.ReporterType, pair.Reporter, coinsForReporter)
+}
+
+func (k Keeper) accumulateBTCTimestampingReward(ctx context.Context, btcTimestampingReward sdk.Coins) {
+	// do nothing if there is no reward for BTC timestamping
+	if !btcTimestampingReward.IsAllPositive() {
+		return
+	}
+
+	// update BTC timestamping gauge of the current epoch
+	epoch := k.epochingKeeper.GetEpoch(ctx).EpochNumber
+	gauge := k.GetBTCTimestampingGauge(ctx, epoch)
+	if gauge == nil {
+		gauge = types.NewGauge()
+	}
+	gauge.Coins = gauge.Coins.Add(btcTimestampingReward...)
+	k.SetBTCTimestampingGauge(ctx, epoch, gauge)
+
+	// transfer the BTC timestamping reward from fee collector account to incentive module account
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, btcTimestampingReward)
+	if err != nil {
+		// this can only be programming error and is unrecoverable
+		panic(err)
+	}
+}
+
+func (k Keeper) SetBTCTimestampingGauge(ctx context.Context, epoch uint64, gauge *types.Gauge) {
+	store := k.btcTimestampingGaugeStore(ctx)
+	gaugeBytes := k.cdc.MustMarshal(gauge)
+	store.Set(sdk.Uint64ToBigEndian(epoch), gaugeBytes)
+}
+
+func (k Keeper) GetBTCTimestampingGauge(ctx context.Context, epoch uint64) *types.Gauge {
+	store := k.btcTimestampingGaugeStore(ctx)
+	gaugeBytes := store.Get(sdk.Uint64ToBigEndian(epoch))
+	if gaugeBytes == nil {
+		return nil
+	}
+
+	var gauge types.Gauge
+	k.cdc.MustUnmarshal(gaugeBytes, &gauge)
+	return &gauge
+}
+
+func (k Keeper) deleteBTCTimestampingGauge(ctx context.Context, epoch uint64) {
+	store := k.btcTimestampingGaugeStore(ctx)
+	store.Delete(sdk.Uint64ToBigEndian(epoch))
+}
+
+// btcTimestampingGaugeStore returns the KVStore of the gauge of total reward for
+// BTC timestamping at each epoch
+// prefix: BTCTimestampingGaugeKey
+// key: gauge epoch
+// value: gauge of rewards for BTC timestamping at this epoch
+func (k Keeper) btcTimestampingGaugeStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.BTCTimestampingGaugeKey)
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	btcctypes "github.com/babylonlabs-io/babylon/x/btccheckpoint/types"
+	"github.com/babylonlabs-io/babylon/x/incentive/types"
+)
+
+func FuzzRewardBTCTimestamping(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		t.Parallel()
+		r := rand.New(rand.NewSource(seed))
+
+		k, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil)
+
+		// set a random gauge
+		epoch := datagen.GenRandomEpochNum(r)
+		gauge := datagen.GenRandomGauge(r)
+		k.SetBTCTimestampingGauge(ctx, epoch, gauge)
+
+		// randomly generate the address pairs of the best submission and other submissions
+		rdi := datagen.GenRandomBTCTimestampingRewardDistInfo(r)
+
+		// expected rewards of each address pair
+		coinsForBest := gauge.Coins
+		coinsForEachOther := sdk.NewCoins()
+		remainder := sdk.NewCoins()
+		if len(rdi.Others) > 0 {
+			coinsForBest = gauge.GetCoinsPortion(types.BestSubmissionPortion)
+			remainder = gauge.Coins.Sub(coinsForBest...)
+			coinsForEachOther = types.GetCoinsPortion(remainder, sdkmath.LegacyOneDec().QuoInt64(int64(len(rdi.Others))))
+			for range rdi.Others {
+				remainder = remainder.Sub(coinsForEachOther...)
+			}
+		}
+
+		// distribute rewards in the gauge to submitters/reporters
+		k.RewardBTCTimestamping(ctx, epoch, rdi)
+
+		// assertRewardGauges asserts the reward gauges of the submitter and reporter
+		// of the given address pair, where the reporter additionally receives
+		// the given extra reward
+		assertRewardGauges := func(pair *btcctypes.CheckpointAddressPair, reward sdk.Coins, extraForReporter sdk.Coins) {
+			coinsForSubmitter := types.GetCoinsPortion(reward, types.SubmitterPortion)
+			coinsForReporter := reward.Sub(coinsForSubmitter...).Add(extraForReporter...)
+			if coinsForSubmitter.IsAllPositive() {
+				rg := k.GetRewardGauge(ctx, types.SubmitterType, pair.Submitter)
+				require.NotNil(t, rg)
+				require.Equal(t, coinsForSubmitter, rg.Coins)
+			}
+			if coinsForReporter.IsAllPositive() {
+				rg := k.GetRewardGauge(ctx, types.ReporterType, pair.Reporter)
+				require.NotNil(t, rg)
+				require.Equal(t, coinsForReporter, rg.Coins)
+			}
+		}
+
+		// the reporter of the best submission receives the remainder of the
+		// shares of the other submissions
+		assertRewardGauges(rdi.Best, coinsForBest, remainder)
+		for _, other := range rdi.Others {
+			assertRewardGauges(other, coinsForEachOther, sdk.NewCoins())
+		}
+
+		// the entire gauge is distributed
+		distributedCoins := coinsForBest.Add(remainder...)
+		for range rdi.Others {
+			distributedCoins = distributedCoins.Add(coinsForEachOther...)
+		}
+		require.Equal(t, gauge.Coins, distributedCoins)
+
+		// the gauge is removed after its rewards are distributed
+		require.Nil(t, k.GetBTCTimestampingGauge(ctx, epoch))
+	})
+}
@@ -7,7 +7,8 @@ import (
 )
 
 // HandleCoinsInFeeCollector intercepts a portion of coins in fee collector, and distributes
-// them to BTC staking gauge of the current height.
+// them to BTC staking gauge of the current height, and BTC timestamping and BTC header relaying
+// gauges of the current epoch.
 // It is invoked upon every `BeginBlock`.
 // adapted from https://github.com/cosmos/cosmos-sdk/blob/release/v0.47.x/x/distribution/keeper/allocation.go#L15-L26
 func (k Keeper) HandleCoinsInFeeCollector(ctx context.Context) {
@@ -32,4 +33,16 @@ func (k Keeper) HandleCoinsInFeeCollector(ctx context.Context) {
 	btcStakingPortion := params.BTCStakingPortion()
 	btcStakingReward := types.GetCoinsPortion(feesCollectedInt, btcStakingPortion)
 	k.accumulateBTCStakingReward(ctx, btcStakingReward)
+
+	// record BTC timestamping gauge for the current epoch, and transfer corresponding
+	// amount from fee collector account to incentive module account
+	btcTimestampingPortion := params.BTCTimestampingPortion()
+	btcTimestampingReward := types.GetCoinsPortion(feesCollectedInt, btcTimestampingPortion)
+	k.accumulateBTCTimestampingReward(ctx, btcTimestampingReward)
+
+	// record BTC header relaying gauge for the current epoch, and transfer
+	// corresponding amount from fee collector account to incentive module account
+	btcHeaderRelayingPortion := params.BTCHeaderRelayingPortion()
+	btcHeaderRelayingReward := types.GetCoinsPortion(feesCollectedInt, btcHeaderRelayingPortion)
+	k.accumulateBTCHeaderRelayingReward(ctx, btcHeaderRelayingReward)
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/babylonlabs-io/babylon/testutil/datagen"
 	testkeeper "github.com/babylonlabs-io/babylon/testutil/keeper"
+	epochingtypes "github.com/babylonlabs-io/babylon/x/epoching/types"
 	"github.com/babylonlabs-io/babylon/x/incentive/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -38,6 +39,8 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 
 		// mock epoching keeper
 		epochingKeeper := types.NewMockEpochingKeeper(ctrl)
+		epoch := datagen.GenRandomEpochNum(r)
+		epochingKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: epoch}).Times(2)
 
 		keeper, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, accountKeeper, epochingKeeper)
 		height := datagen.RandomInt(r, 1000)
@@ -48,6 +51,10 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		params := keeper.GetParams(ctx)
 		feesForBTCStaking := types.GetCoinsPortion(fees, params.BTCStakingPortion())
 		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCStaking)).Times(1)
+		feesForBTCTimestamping := types.GetCoinsPortion(fees, params.BTCTimestampingPortion())
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCTimestamping)).Times(1)
+		feesForBTCHeaderRelaying := types.GetCoinsPortion(fees, params.BTCHeaderRelayingPortion())
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), gomock.Eq(authtypes.FeeCollectorName), gomock.Eq(types.ModuleName), gomock.Eq(feesForBTCHeaderRelaying)).Times(1)
 
 		// handle coins in fee collector
 		keeper.HandleCoinsInFeeCollector(ctx)
@@ -57,5 +64,15 @@ func FuzzInterceptFeeCollector(f *testing.F) {
 		btcStakingGauge := keeper.GetBTCStakingGauge(ctx, height)
 		require.NotNil(t, btcStakingGauge)
 		require.Equal(t, btcStakingFee, btcStakingGauge.Coins)
+
+		// assert correctness of BTC timestamping gauge at epoch
+		btcTimestampingGauge := keeper.GetBTCTimestampingGauge(ctx, epoch)
+		require.NotNil(t, btcTimestampingGauge)
+		require.Equal(t, feesForBTCTimestamping, btcTimestampingGauge.Coins)
+
+		// assert correctness of BTC header relaying gauge at epoch
+		btcHeaderRelayingGauge := keeper.GetBTCHeaderRelayingGauge(ctx, epoch)
+		require.NotNil(t, btcHeaderRelayingGauge)
+		require.Equal(t, feesForBTCHeaderRelaying, btcHeaderRelayingGauge.Coins)
 	})
 }
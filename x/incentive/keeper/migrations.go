@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/x/incentive/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the incentive module state from consensus version 1
+// to 2. Version 2 introduces the BtcTimestampingPortion parameter, which is
+// set to its default value if it is absent in the stored parameters.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if !params.BtcTimestampingPortion.IsNil() {
+		return nil
+	}
+	params.BtcTimestampingPortion = types.DefaultParams().BtcTimestampingPortion
+	return m.keeper.setParams(ctx, params)
+}
+
+// Migrate2to3 migrates the incentive module state from consensus version 2
+// to 3. Version 3 introduces the BtcHeaderRelayingPortion parameter, which is
+// set to its default value. It cannot be told apart from a zero portion since
+// the 1->2 migration stores the absent portion as zero.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.BtcHeaderRelayingPortion = types.DefaultParams().BtcHeaderRelayingPortion
+	return m.keeper.SetParams(ctx, params)
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	keepertest "github.com/babylonlabs-io/babylon/testutil/keeper"
+	"github.com/babylonlabs-io/babylon/x/incentive/keeper"
+	"github.com/babylonlabs-io/babylon/x/incentive/types"
+)
+
+func TestMigrate(t *testing.T) {
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+	k, ctx := keepertest.IncentiveKeeperWithStore(t, db, stateStore, nil, nil, nil)
+
+	// store the parameters of consensus version 1, which only have the
+	// BTC staking portion
+	btcStakingPortion := sdkmath.LegacyNewDecWithPrec(7, 1)
+	btcStakingPortionBytes, err := btcStakingPortion.Marshal()
+	require.NoError(t, err)
+	paramsBytes := protowire.AppendTag(nil, 1, protowire.BytesType)
+	paramsBytes = protowire.AppendBytes(paramsBytes, btcStakingPortionBytes)
+	kvStore := ctx.KVStore(stateStore.(*rootmulti.Store).StoreKeysByName()[types.StoreKey])
+	kvStore.Set(types.ParamsKey, paramsBytes)
+	require.True(t, k.GetParams(ctx).BtcTimestampingPortion.IsNil())
+
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+	params := k.GetParams(ctx)
+	require.True(t, btcStakingPortion.Equal(params.BtcStakingPortion))
+	require.True(t, types.DefaultParams().BtcTimestampingPortion.Equal(params.BtcTimestampingPortion))
+
+	err = keeper.NewMigrator(*k).Migrate2to3(ctx)
+	require.NoError(t, err)
+	params = k.GetParams(ctx)
+	require.True(t, btcStakingPortion.Equal(params.BtcStakingPortion))
+	require.True(t, types.DefaultParams().BtcTimestampingPortion.Equal(params.BtcTimestampingPortion))
+	require.True(t, types.DefaultParams().BtcHeaderRelayingPortion.Equal(params.BtcHeaderRelayingPortion))
+	require.NoError(t, params.Validate())
+
+	// the 1->2 migration does not overwrite an existing BTC timestamping portion
+	params.BtcTimestampingPortion = sdkmath.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+	err = keeper.NewMigrator(*k).Migrate1to2(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+}
@@ -10,6 +10,11 @@ func (k Keeper) SetParams(ctx context.Context, p types.Params) error {
 	if err := p.Validate(); err != nil {
 		return err
 	}
+	return k.setParams(ctx, p)
+}
+
+// setParams stores the x/incentive module parameters without validating them.
+func (k Keeper) setParams(ctx context.Context, p types.Params) error {
 	store := k.storeService.OpenKVStore(ctx)
 	bz := k.cdc.MustMarshal(&p)
 	return store.Set(types.ParamsKey, bz)
@@ -7,6 +7,17 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+var (
+	// BestSubmissionPortion is the portion of the BTC timestamping reward of an
+	// epoch that goes to the best checkpoint submission, if there are other
+	// valid submissions in the epoch
+	BestSubmissionPortion = math.LegacyNewDecWithPrec(5, 1) // 0.5
+	// SubmitterPortion is the portion of the BTC timestamping reward of a
+	// checkpoint submission that goes to its submitter. The rest goes to its
+	// reporter
+	SubmitterPortion = math.LegacyNewDecWithPrec(5, 1) // 0.5
+)
+
 func NewGauge(coins ...sdk.Coin) *Gauge {
 	return &Gauge{
 		Coins: coins,
@@ -61,10 +72,13 @@ type StakeholderType byte
 const (
 	FinalityProviderType StakeholderType = iota
 	BTCDelegationType
+	SubmitterType
+	ReporterType
+	HeaderRelayerType
 )
 
 func GetAllStakeholderTypes() []StakeholderType {
-	return []StakeholderType{FinalityProviderType, BTCDelegationType}
+	return []StakeholderType{FinalityProviderType, BTCDelegationType, SubmitterType, ReporterType, HeaderRelayerType}
 }
 
 func NewStakeHolderType(stBytes []byte) (StakeholderType, error) {
@@ -76,6 +90,12 @@ func NewStakeHolderType(stBytes []byte) (StakeholderType, error) {
 		return FinalityProviderType, nil
 	case byte(BTCDelegationType):
 		return BTCDelegationType, nil
+	case byte(SubmitterType):
+		return SubmitterType, nil
+	case byte(ReporterType):
+		return ReporterType, nil
+	case byte(HeaderRelayerType):
+		return HeaderRelayerType, nil
 	default:
 		return FinalityProviderType, fmt.Errorf("invalid stBytes")
 	}
@@ -87,6 +107,12 @@ func NewStakeHolderTypeFromString(stStr string) (StakeholderType, error) {
 		return FinalityProviderType, nil
 	case "btc_delegation":
 		return BTCDelegationType, nil
+	case "submitter":
+		return SubmitterType, nil
+	case "reporter":
+		return ReporterType, nil
+	case "header_relayer":
+		return HeaderRelayerType, nil
 	default:
 		return FinalityProviderType, fmt.Errorf("invalid stStr")
 	}
@@ -101,6 +127,12 @@ func (st StakeholderType) String() string {
 		return "finality_provider"
 	} else if st == BTCDelegationType {
 		return "btc_delegation"
+	} else if st == SubmitterType {
+		return "submitter"
+	} else if st == ReporterType {
+		return "reporter"
+	} else if st == HeaderRelayerType {
+		return "header_relayer"
 	}
 	panic("invalid stakeholder type")
 }
@@ -30,6 +30,9 @@ var (
 	FinalityProviderHistoricalRewardsKeyPrefix = collections.NewPrefix(7) // key prefix for storing the Historical rewards of finality provider by addr and period
 	BTCDelegationRewardsTrackerKeyPrefix       = collections.NewPrefix(8) // key prefix for BTC delegation rewards tracker info (del,fp) => BTCDelegationRewardsTracker
 	BTCDelegatorToFPKey                        = []byte{0x9}              // key prefix for storing the map reference from delegation to finality provider (del) => fp
+	BTCTimestampingGaugeKey                    = []byte{0x0a}             // key prefix for BTC timestamping gauge at each epoch
+	BTCHeaderRelayingGaugeKey                  = []byte{0x0b}             // key prefix for BTC header relaying gauge at each epoch
+	BTCRelayedHeadersKey                       = []byte{0x0c}             // key prefix for the number of new BTC headers relayed by each reporter at each epoch
 )
 
 // GetWithdrawAddrKey creates the key for a delegator's withdraw addr.
@@ -18,7 +18,9 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		BtcStakingPortion: math.LegacyNewDecWithPrec(6, 1), // 6 * 10^{-1} = 0.6
+		BtcStakingPortion:        math.LegacyNewDecWithPrec(6, 1), // 6 * 10^{-1} = 0.6
+		BtcTimestampingPortion:   math.LegacyNewDecWithPrec(5, 2), // 5 * 10^{-2} = 0.05
+		BtcHeaderRelayingPortion: math.LegacyNewDecWithPrec(1, 2), // 1 * 10^{-2} = 0.01
 	}
 }
 
@@ -29,7 +31,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // TotalPortion calculates the sum of portions of all stakeholders
 func (p *Params) TotalPortion() math.LegacyDec {
-	sum := p.BtcStakingPortion
+	sum := p.BTCStakingPortion()
+	sum = sum.Add(p.BTCTimestampingPortion())
+	sum = sum.Add(p.BTCHeaderRelayingPortion())
 	return sum
 }
 
@@ -38,11 +42,30 @@ func (p *Params) BTCStakingPortion() math.LegacyDec {
 	return p.BtcStakingPortion
 }
 
+// BTCTimestampingPortion calculates the sum of portions of all BTC timestamping stakeholders
+func (p *Params) BTCTimestampingPortion() math.LegacyDec {
+	return p.BtcTimestampingPortion
+}
+
+// BTCHeaderRelayingPortion calculates the sum of portions of all BTC header relaying stakeholders
+func (p *Params) BTCHeaderRelayingPortion() math.LegacyDec {
+	return p.BtcHeaderRelayingPortion
+}
+
 // Validate validates the set of params
 func (p Params) Validate() error {
 	if p.BtcStakingPortion.IsNil() {
 		return fmt.Errorf("BtcStakingPortion should not be nil")
 	}
+	if p.BtcTimestampingPortion.IsNil() {
+		return fmt.Errorf("BtcTimestampingPortion should not be nil")
+	}
+	if p.BtcHeaderRelayingPortion.IsNil() {
+		return fmt.Errorf("BtcHeaderRelayingPortion should not be nil")
+	}
+	if p.BtcStakingPortion.IsNegative() || p.BtcTimestampingPortion.IsNegative() || p.BtcHeaderRelayingPortion.IsNegative() {
+		return fmt.Errorf("portions should not be negative")
+	}
 
 	// sum of all portions should be less than 1
 	if p.TotalPortion().GTE(math.LegacyOneDec()) {
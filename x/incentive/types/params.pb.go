@@ -34,6 +34,16 @@ type Params struct {
 	// NOTE: the portion of each Finality Provider/delegation is calculated by using its voting
 	// power and finality provider's commission
 	BtcStakingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=btc_staking_portion,json=btcStakingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_staking_portion"`
+	// btc_timestamping_portion is the portion of rewards that goes to vigilante
+	// submitters/reporters of BTC checkpoints
+	// NOTE: the rewards of an epoch are distributed to the submitters/reporters of
+	// its BTC checkpoint submissions upon the epoch being finalized
+	BtcTimestampingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=btc_timestamping_portion,json=btcTimestampingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_timestamping_portion"`
+	// btc_header_relaying_portion is the portion of rewards that goes to reporters
+	// relaying BTC headers to the BTC light client
+	// NOTE: the rewards are accumulated in a pool, which is entirely distributed to
+	// the reporter who first relays new headers to the BTC light client main chain
+	BtcHeaderRelayingPortion cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=btc_header_relaying_portion,json=btcHeaderRelayingPortion,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"btc_header_relaying_portion"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,23 +85,27 @@ func init() {
 func init() { proto.RegisterFile("babylon/incentive/params.proto", fileDescriptor_c42276168f0adf4b) }
 
 var fileDescriptor_c42276168f0adf4b = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcc, 0x4b, 0x4e, 0xcd, 0x2b, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x84, 0xca, 0xeb, 0xc1,
-	0xe5, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xb2, 0xfa, 0x20, 0x16, 0x44, 0xa1, 0x94, 0x64,
-	0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2, 0x81, 0x48, 0x29, 0x15, 0x72, 0xb1,
-	0x05, 0x80, 0xcd, 0x14, 0x4a, 0xe4, 0x12, 0x4e, 0x2a, 0x49, 0x8e, 0x2f, 0x2e, 0x49, 0xcc, 0xce,
-	0xcc, 0x4b, 0x8f, 0x2f, 0xc8, 0x2f, 0x2a, 0xc9, 0xcc, 0xcf, 0x93, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x74, 0x32, 0x3c, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0x69, 0x88, 0xe6, 0xe2, 0x94, 0x6c,
-	0xbd, 0xcc, 0x7c, 0xfd, 0xdc, 0xc4, 0x92, 0x0c, 0x3d, 0x9f, 0xd4, 0xf4, 0xc4, 0xe4, 0x4a, 0x97,
-	0xd4, 0xe4, 0x4b, 0x5b, 0x74, 0xb9, 0xa0, 0x66, 0xbb, 0xa4, 0x26, 0x07, 0x09, 0x26, 0x95, 0x24,
-	0x07, 0x43, 0x0c, 0x0b, 0x80, 0x98, 0x65, 0xc5, 0x32, 0x63, 0x81, 0x3c, 0x83, 0x93, 0xef, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0xfd, 0x96, 0x93, 0x98, 0x54, 0xac, 0x9b, 0x99, 0x0f, 0xe3,
-	0xea, 0x57, 0x20, 0x05, 0x46, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x23, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xfd, 0x78, 0x13, 0x2e, 0x01, 0x00, 0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x31, 0x4b, 0x33, 0x31,
+	0x18, 0x80, 0xef, 0xfa, 0x7d, 0x14, 0xcc, 0xd6, 0x2a, 0x52, 0x5b, 0x48, 0xc5, 0xc9, 0xa5, 0x17,
+	0xa4, 0x9b, 0x63, 0xe9, 0xe0, 0xa0, 0x50, 0xaa, 0x93, 0xcb, 0x91, 0xa4, 0xe1, 0x1a, 0xda, 0xdc,
+	0x1b, 0x2e, 0xaf, 0xe2, 0xfd, 0x0b, 0x47, 0x47, 0x7f, 0x84, 0x3f, 0xa2, 0x63, 0x71, 0x12, 0x87,
+	0x22, 0xed, 0x1f, 0x91, 0xbb, 0x5c, 0xe5, 0xe6, 0x6e, 0x79, 0x79, 0x92, 0xe7, 0x21, 0xbc, 0x84,
+	0x0a, 0x2e, 0xf2, 0x25, 0xa4, 0x4c, 0xa7, 0x52, 0xa5, 0xa8, 0x9f, 0x15, 0xb3, 0x3c, 0xe3, 0xc6,
+	0x45, 0x36, 0x03, 0x84, 0x76, 0xab, 0xe2, 0xd1, 0x1f, 0xef, 0x9e, 0x24, 0x90, 0x40, 0x49, 0x59,
+	0x71, 0xf2, 0x17, 0xbb, 0x67, 0x12, 0x9c, 0x01, 0x17, 0x7b, 0xe0, 0x07, 0x8f, 0x2e, 0xd6, 0x0d,
+	0xd2, 0x9c, 0x94, 0xd2, 0x36, 0x27, 0xc7, 0x02, 0x65, 0xec, 0x90, 0x2f, 0x74, 0x9a, 0xc4, 0x16,
+	0x32, 0xd4, 0x90, 0x76, 0xc2, 0xf3, 0xf0, 0xf2, 0x68, 0x74, 0xb5, 0xda, 0xf4, 0x83, 0xef, 0x4d,
+	0xbf, 0xe7, 0x5f, 0xbb, 0xd9, 0x22, 0xd2, 0xc0, 0x0c, 0xc7, 0x79, 0x74, 0xab, 0x12, 0x2e, 0xf3,
+	0xb1, 0x92, 0x9f, 0x1f, 0x03, 0x52, 0xc9, 0xc7, 0x4a, 0x4e, 0x5b, 0x02, 0xe5, 0xbd, 0x97, 0x4d,
+	0xbc, 0xab, 0xbd, 0x20, 0x9d, 0x22, 0x81, 0xda, 0x28, 0x87, 0xdc, 0xd8, 0x7a, 0xa7, 0x71, 0x68,
+	0xe7, 0x54, 0xa0, 0x7c, 0xa8, 0x19, 0xf7, 0x31, 0x4b, 0x7a, 0x45, 0x6c, 0xae, 0xf8, 0x4c, 0x65,
+	0x71, 0xa6, 0x96, 0x3c, 0xaf, 0xf7, 0xfe, 0x1d, 0xda, 0x2b, 0xbe, 0x70, 0x53, 0x4a, 0xa7, 0x95,
+	0xb3, 0x2a, 0x5e, 0xff, 0x7f, 0x7b, 0xef, 0x07, 0xa3, 0xbb, 0xd5, 0x96, 0x86, 0xeb, 0x2d, 0x0d,
+	0x7f, 0xb6, 0x34, 0x7c, 0xdd, 0xd1, 0x60, 0xbd, 0xa3, 0xc1, 0xd7, 0x8e, 0x06, 0x8f, 0xc3, 0x44,
+	0xe3, 0xfc, 0x49, 0x44, 0x12, 0x0c, 0xab, 0x76, 0xb7, 0xe4, 0xc2, 0x0d, 0x34, 0xec, 0x47, 0xf6,
+	0x52, 0x5b, 0x36, 0xe6, 0x56, 0x39, 0xd1, 0x2c, 0x17, 0x35, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff,
+	0x20, 0xf4, 0xe5, 0xbd, 0x0e, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +128,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BtcHeaderRelayingPortion.Size()
+		i -= size
+		if _, err := m.BtcHeaderRelayingPortion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BtcTimestampingPortion.Size()
+		i -= size
+		if _, err := m.BtcTimestampingPortion.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size := m.BtcStakingPortion.Size()
 		i -= size
@@ -146,6 +180,10 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.BtcStakingPortion.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.BtcTimestampingPortion.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BtcHeaderRelayingPortion.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -218,6 +256,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcTimestampingPortion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BtcTimestampingPortion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeaderRelayingPortion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BtcHeaderRelayingPortion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
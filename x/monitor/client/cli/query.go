@@ -1,11 +1,14 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 
 	"github.com/babylonlabs-io/babylon/x/monitor/types"
 )
@@ -21,5 +24,100 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdParams())
+	cmd.AddCommand(CmdCheckpointLatency())
+	cmd.AddCommand(CmdCheckpointLatencies())
+
+	return cmd
+}
+
+func CmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "shows the parameters of the module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCheckpointLatency() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-latency [epoch]",
+		Short: "retrieve the latency metrics of the checkpoint of the given epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCheckpointLatencyRequest{EpochNum: epoch}
+			res, err := queryClient.CheckpointLatency(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCheckpointLatencies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoint-latencies [start-epoch] [end-epoch]",
+		Short: "retrieve the latency metrics of the checkpoints of the epochs in the given inclusive range",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startEpoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endEpoch, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCheckpointLatenciesRequest{
+				StartEpoch: startEpoch,
+				EndEpoch:   endEpoch,
+			}
+			res, err := queryClient.CheckpointLatencies(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
 	return cmd
 }
@@ -42,3 +42,51 @@ func (k Keeper) ReportedCheckpointBtcHeight(c context.Context, req *types.QueryR
 
 	return &types.QueryReportedCheckpointBtcHeightResponse{BtcLightClientHeight: btcHeight}, nil
 }
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+func (k Keeper) CheckpointLatency(c context.Context, req *types.QueryCheckpointLatencyRequest) (*types.QueryCheckpointLatencyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	timeline, err := k.GetCheckpointTimeline(ctx, req.EpochNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCheckpointLatencyResponse{Latency: timeline.ToResponse()}, nil
+}
+
+func (k Keeper) CheckpointLatencies(c context.Context, req *types.QueryCheckpointLatenciesRequest) (*types.QueryCheckpointLatenciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "start epoch %d is greater than end epoch %d", req.StartEpoch, req.EndEpoch)
+	}
+	if req.EndEpoch-req.StartEpoch >= types.MaxEpochsPerRequest {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch range cannot cover more than %d epochs", types.MaxEpochsPerRequest)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	timelines := k.GetCheckpointTimelines(ctx, req.StartEpoch, req.EndEpoch)
+	latencies := make([]*types.CheckpointLatencyResponse, len(timelines))
+	for i, timeline := range timelines {
+		latencies[i] = timeline.ToResponse()
+	}
+
+	return &types.QueryCheckpointLatenciesResponse{Latencies: latencies}, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	ltypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
 	checkpointingtypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
 	etypes "github.com/babylonlabs-io/babylon/x/epoching/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,12 +13,15 @@ import (
 type HandledHooks interface {
 	etypes.EpochingHooks
 	checkpointingtypes.CheckpointingHooks
+	ltypes.BTCLightClientHooks
 }
 
 type Hooks struct {
 	k Keeper
 }
 
+var _ HandledHooks = Hooks{}
+
 // Hooks Create new distribution hooks
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
 
@@ -25,6 +29,7 @@ func (h Hooks) AfterEpochBegins(ctx context.Context, epoch uint64) {}
 
 func (h Hooks) AfterEpochEnds(ctx context.Context, epoch uint64) {
 	h.k.updateBtcLightClientHeightForEpoch(ctx, epoch)
+	h.k.recordEpochEnded(ctx, epoch)
 }
 
 func (h Hooks) BeforeSlashThreshold(ctx context.Context, valSet etypes.ValidatorSet) {}
@@ -36,17 +41,40 @@ func (h Hooks) AfterRawCheckpointSealed(ctx context.Context, epoch uint64) error
 	return nil
 }
 func (h Hooks) AfterRawCheckpointConfirmed(ctx context.Context, epoch uint64) error {
+	h.k.recordCheckpointMilestone(ctx, epoch, checkpointingtypes.Confirmed)
 	return nil
 }
 
 func (h Hooks) AfterRawCheckpointForgotten(ctx context.Context, ckpt *checkpointingtypes.RawCheckpoint) error {
+	// the checkpoint goes back to the sealed status, so all of its later
+	// milestones are recorded again once reached
+	h.k.clearCheckpointMilestones(
+		ctx,
+		ckpt.EpochNum,
+		checkpointingtypes.Submitted,
+		checkpointingtypes.Confirmed,
+		checkpointingtypes.Finalized,
+	)
 	return h.k.removeCheckpointRecord(ctx, ckpt)
 }
 
 func (h Hooks) AfterRawCheckpointFinalized(ctx context.Context, epoch uint64) error {
+	h.k.recordCheckpointMilestone(ctx, epoch, checkpointingtypes.Finalized)
 	return nil
 }
 
 func (h Hooks) AfterRawCheckpointBlsSigVerified(ctx context.Context, ckpt *checkpointingtypes.RawCheckpoint) error {
-	return h.k.updateBtcLightClientHeightForCheckpoint(ctx, ckpt)
+	if err := h.k.updateBtcLightClientHeightForCheckpoint(ctx, ckpt); err != nil {
+		return err
+	}
+	h.k.recordCheckpointMilestone(ctx, ckpt.EpochNum, checkpointingtypes.Submitted)
+	return nil
+}
+
+func (h Hooks) AfterBTCRollBack(ctx context.Context, headerInfo *ltypes.BTCHeaderInfo) {
+	h.k.annotateReorgedEpochs(ctx, headerInfo)
 }
+
+func (h Hooks) AfterBTCRollForward(ctx context.Context, headerInfo *ltypes.BTCHeaderInfo) {}
+
+func (h Hooks) AfterBTCHeaderInserted(ctx context.Context, headerInfo *ltypes.BTCHeaderInfo) {}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/app"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	"github.com/babylonlabs-io/babylon/x/monitor/types"
+)
+
+func FuzzAnnotateReorgedEpochs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		lck := babylonApp.BTCLightClientKeeper
+		mk := babylonApp.MonitorKeeper
+
+		// epoch 1 ends with the base header as the tip
+		root := lck.GetBaseBTCHeader(ctx)
+		mk.Hooks().AfterEpochEnds(ctx, 1)
+
+		// epoch 2 ends after 10 headers are inserted
+		chain := datagen.GenRandomValidChainStartingFrom(
+			r,
+			root.Header.ToBlockHeader(),
+			nil,
+			10,
+		)
+		err := lck.InsertHeadersWithHookAndEvents(ctx, datagen.HeaderToHeaderBytes(chain))
+		require.NoError(t, err)
+		mk.Hooks().AfterEpochEnds(ctx, 2)
+
+		// a longer fork starting from the base header rolls back the tip recorded
+		// at the end of epoch 2, but not the one of epoch 1
+		fork := datagen.GenRandomValidChainStartingFrom(
+			r,
+			root.Header.ToBlockHeader(),
+			nil,
+			11,
+		)
+		err = lck.InsertHeadersWithHookAndEvents(ctx, datagen.HeaderToHeaderBytes(fork))
+		require.NoError(t, err)
+
+		reorg := lck.GetLatestReorg(ctx)
+		require.NotNil(t, reorg)
+		require.True(t, reorg.ForkPoint.Eq(root))
+
+		var annotatedEpochs []uint64
+		for _, a := range reorg.Annotations {
+			if a.Module == types.ModuleName {
+				annotatedEpochs = a.Epochs
+			}
+		}
+		require.Equal(t, []uint64{2}, annotatedEpochs)
+	})
+}
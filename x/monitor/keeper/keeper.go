@@ -6,11 +6,14 @@ import (
 	"fmt"
 	"math"
 
+	lctypes "github.com/babylonlabs-io/babylon/x/btclightclient/types"
 	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
 	"github.com/babylonlabs-io/babylon/x/monitor/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -19,6 +22,9 @@ type (
 		cdc                  codec.BinaryCodec
 		storeService         corestoretypes.KVStoreService
 		btcLightClientKeeper types.BTCLightClientKeeper
+		// the address capable of executing a MsgUpdateParams message. Typically, this
+		// should be the x/gov module account.
+		authority string
 	}
 )
 
@@ -26,14 +32,21 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
 	bk types.BTCLightClientKeeper,
+	authority string,
 ) Keeper {
 	return Keeper{
 		cdc:                  cdc,
 		storeService:         storeService,
 		btcLightClientKeeper: bk,
+		authority:            authority,
 	}
 }
 
+// GetAuthority returns the x/monitor module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -59,6 +72,33 @@ func (k Keeper) updateBtcLightClientHeightForEpoch(ctx context.Context, epoch ui
 	}
 }
 
+// annotateReorgedEpochs records in the light client reorg log the epochs
+// whose light client height at epoch end was rolled back by the reorg with
+// the given fork point. Epochs are iterated from the most recent one and the
+// iteration stops at the first epoch that ended below the fork point.
+func (k Keeper) annotateReorgedEpochs(ctx context.Context, forkPoint *lctypes.BTCHeaderInfo) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.EpochEndLightClientHeightPrefix)
+
+	it := store.ReverseIterator(nil, nil)
+	defer it.Close()
+
+	var epochs []uint64
+	for ; it.Valid(); it.Next() {
+		btcHeight, err := bytesToBtcHeight(it.Value())
+		if err != nil {
+			panic("invalid data in database")
+		}
+		if btcHeight <= forkPoint.Height {
+			break
+		}
+		// prepend so that epochs are in ascending order
+		epochs = append([]uint64{sdk.BigEndianToUint64(it.Key())}, epochs...)
+	}
+
+	k.btcLightClientKeeper.AnnotateLatestReorg(ctx, types.ModuleName, epochs)
+}
+
 func (k Keeper) updateBtcLightClientHeightForCheckpoint(ctx context.Context, ckpt *ckpttypes.RawCheckpoint) error {
 	store := k.storeService.OpenKVStore(ctx)
 	ckptHashStr := ckpt.HashStr()
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
+	"github.com/babylonlabs-io/babylon/x/monitor/types"
+)
+
+// currentMilestone returns the milestone at the current Babylon block
+func (k Keeper) currentMilestone(ctx context.Context) *types.CheckpointMilestone {
+	return &types.CheckpointMilestone{
+		BtcLightClientHeight: k.btcLightClientKeeper.GetTipInfo(ctx).Height,
+		Time:                 sdk.UnwrapSDKContext(ctx).HeaderInfo().Time,
+	}
+}
+
+// recordEpochEnded starts the checkpoint timeline of the given epoch, which
+// has just ended
+func (k Keeper) recordEpochEnded(ctx context.Context, epoch uint64) {
+	k.setCheckpointTimeline(ctx, &types.CheckpointTimeline{
+		EpochNum:   epoch,
+		EpochEnded: *k.currentMilestone(ctx),
+	})
+}
+
+// recordCheckpointMilestone records the milestone of the checkpoint of the
+// given epoch reaching the given status, unless it is already recorded. The
+// latency of the milestone is exported as metrics, and an
+// EventCheckpointLatencyExceeded is emitted if it is above the threshold set
+// in params.
+func (k Keeper) recordCheckpointMilestone(ctx context.Context, epoch uint64, status ckpttypes.CheckpointStatus) {
+	timeline, err := k.GetCheckpointTimeline(ctx, epoch)
+	if err != nil {
+		// the epoch ended before timelines were recorded
+		return
+	}
+	if timeline.Milestone(status) != nil {
+		return
+	}
+
+	timeline.SetMilestone(status, k.currentMilestone(ctx))
+	k.setCheckpointTimeline(ctx, timeline)
+
+	latency := timeline.Latency(status)
+	types.RecordCheckpointLatency(epoch, status, latency)
+
+	params := k.GetParams(ctx)
+	threshold := params.LatencyThreshold(status)
+	if !latency.Exceeds(&threshold) {
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.Logger(sdkCtx).Info(
+		"checkpoint latency exceeds the threshold",
+		"epoch", epoch,
+		"status", status.String(),
+		"btc_blocks", latency.BtcBlocks,
+		"duration", latency.Duration,
+	)
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventCheckpointLatencyExceeded{
+		EpochNum:  epoch,
+		Status:    status,
+		Latency:   *latency,
+		Threshold: threshold,
+	}); err != nil {
+		panic(err)
+	}
+}
+
+// clearCheckpointMilestones removes the milestones of the checkpoint of the
+// given epoch reaching the given statuses, so that they are recorded again
+// once reached
+func (k Keeper) clearCheckpointMilestones(ctx context.Context, epoch uint64, statuses ...ckpttypes.CheckpointStatus) {
+	timeline, err := k.GetCheckpointTimeline(ctx, epoch)
+	if err != nil {
+		return
+	}
+
+	for _, status := range statuses {
+		timeline.SetMilestone(status, nil)
+	}
+	k.setCheckpointTimeline(ctx, timeline)
+}
+
+func (k Keeper) setCheckpointTimeline(ctx context.Context, timeline *types.CheckpointTimeline) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetCheckpointTimelineKey(timeline.EpochNum), k.cdc.MustMarshal(timeline)); err != nil {
+		panic(err)
+	}
+}
+
+// GetCheckpointTimeline returns the checkpoint timeline of the given epoch
+func (k Keeper) GetCheckpointTimeline(ctx context.Context, epoch uint64) (*types.CheckpointTimeline, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetCheckpointTimelineKey(epoch))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil, types.ErrTimelineNotFound.Wrapf("epoch %d", epoch)
+	}
+
+	var timeline types.CheckpointTimeline
+	k.cdc.MustUnmarshal(bz, &timeline)
+	return &timeline, nil
+}
+
+// GetCheckpointTimelines returns the checkpoint timelines of the epochs in
+// the given inclusive range, omitting the epochs without timeline
+func (k Keeper) GetCheckpointTimelines(ctx context.Context, startEpoch, endEpoch uint64) []*types.CheckpointTimeline {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.CheckpointTimelinePrefix)
+
+	var end []byte
+	// the iterator end is exclusive
+	if endEpoch < ^uint64(0) {
+		end = sdk.Uint64ToBigEndian(endEpoch + 1)
+	}
+	it := store.Iterator(sdk.Uint64ToBigEndian(startEpoch), end)
+	defer it.Close()
+
+	var timelines []*types.CheckpointTimeline
+	for ; it.Valid(); it.Next() {
+		var timeline types.CheckpointTimeline
+		k.cdc.MustUnmarshal(it.Value(), &timeline)
+		timelines = append(timelines, &timeline)
+	}
+	return timelines
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/app"
+	appparams "github.com/babylonlabs-io/babylon/app/params"
+	"github.com/babylonlabs-io/babylon/testutil/datagen"
+	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
+	"github.com/babylonlabs-io/babylon/x/monitor/keeper"
+	"github.com/babylonlabs-io/babylon/x/monitor/types"
+)
+
+func FuzzCheckpointLatency(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		babylonApp := app.Setup(t, false)
+		ctx := babylonApp.NewContext(false)
+		lck := babylonApp.BTCLightClientKeeper
+		mk := babylonApp.MonitorKeeper
+
+		queryHelper := baseapp.NewQueryServerTestHelper(ctx, babylonApp.InterfaceRegistry())
+		types.RegisterQueryServer(queryHelper, mk)
+		queryClient := types.NewQueryClient(queryHelper)
+
+		// only the finalization threshold is exceeded
+		params := types.Params{
+			SubmissionLatencyThreshold:   types.CheckpointLatency{BtcBlocks: 100},
+			ConfirmationLatencyThreshold: types.CheckpointLatency{Duration: 100 * time.Hour},
+			FinalizationLatencyThreshold: types.CheckpointLatency{BtcBlocks: 100, Duration: time.Hour},
+		}
+		msgServer := keeper.NewMsgServerImpl(mk)
+		_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: datagen.GenRandomAccount().Address,
+			Params:    params,
+		})
+		require.Error(t, err)
+		_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+			Authority: appparams.AccGov.String(),
+			Params:    params,
+		})
+		require.NoError(t, err)
+		paramsResp, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, params, paramsResp.Params)
+
+		// advances the Babylon chain by the given duration and the BTC light
+		// client by the given number of headers
+		startTime := time.Unix(int64(datagen.RandomInt(r, 1000000000)), 0).UTC()
+		ctx = ctx.WithHeaderInfo(header.Info{Height: 1, Time: startTime})
+		advance := func(d time.Duration, numHeaders uint32) {
+			ctx = ctx.WithHeaderInfo(header.Info{
+				Height: ctx.HeaderInfo().Height + 1,
+				Time:   ctx.HeaderInfo().Time.Add(d),
+			}).WithEventManager(sdk.NewEventManager())
+			if numHeaders == 0 {
+				return
+			}
+			chain := datagen.GenRandomValidChainStartingFrom(r, lck.GetTipInfo(ctx).Header.ToBlockHeader(), nil, numHeaders)
+			require.NoError(t, lck.InsertHeadersWithHookAndEvents(ctx, datagen.HeaderToHeaderBytes(chain)))
+		}
+		latencyExceededEvents := func() []sdk.Event {
+			var events []sdk.Event
+			for _, e := range ctx.EventManager().Events() {
+				if e.Type == "babylon.monitor.v1.EventCheckpointLatencyExceeded" {
+					events = append(events, e)
+				}
+			}
+			return events
+		}
+
+		// epoch 1 ends
+		epochEndHeight := lck.GetTipInfo(ctx).Height
+		mk.Hooks().AfterEpochEnds(ctx, 1)
+		resp, err := queryClient.CheckpointLatency(ctx, &types.QueryCheckpointLatencyRequest{EpochNum: 1})
+		require.NoError(t, err)
+		require.Equal(t, epochEndHeight, resp.Latency.EpochEndBtcHeight)
+		require.Equal(t, startTime, resp.Latency.EpochEndTime)
+		require.Nil(t, resp.Latency.SubmissionLatency)
+		require.Nil(t, resp.Latency.ConfirmationLatency)
+		require.Nil(t, resp.Latency.FinalizationLatency)
+
+		// the checkpoint is reported to Babylon, only the first report counts
+		submissionBlocks := uint32(datagen.RandomInt(r, 10)) + 1
+		advance(10*time.Minute, submissionBlocks)
+		ckpt := datagen.GenRandomRawCheckpoint(r)
+		ckpt.EpochNum = 1
+		require.NoError(t, mk.Hooks().AfterRawCheckpointBlsSigVerified(ctx, ckpt))
+		require.Empty(t, latencyExceededEvents())
+		advance(10*time.Minute, 1)
+		require.NoError(t, mk.Hooks().AfterRawCheckpointBlsSigVerified(ctx, ckpt))
+
+		// the checkpoint is confirmed and then finalized
+		advance(30*time.Minute, 6)
+		require.NoError(t, mk.Hooks().AfterRawCheckpointConfirmed(ctx, 1))
+		require.Empty(t, latencyExceededEvents())
+		advance(40*time.Minute, 10)
+		require.NoError(t, mk.Hooks().AfterRawCheckpointFinalized(ctx, 1))
+		events := latencyExceededEvents()
+		require.Len(t, events, 1)
+		evt, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+		require.NoError(t, err)
+		require.Equal(t, &types.EventCheckpointLatencyExceeded{
+			EpochNum:  1,
+			Status:    ckpttypes.Finalized,
+			Latency:   types.CheckpointLatency{BtcBlocks: submissionBlocks + 17, Duration: 90 * time.Minute},
+			Threshold: params.FinalizationLatencyThreshold,
+		}, evt)
+
+		resp, err = queryClient.CheckpointLatency(ctx, &types.QueryCheckpointLatencyRequest{EpochNum: 1})
+		require.NoError(t, err)
+		require.Equal(t, &types.CheckpointLatency{BtcBlocks: submissionBlocks, Duration: 10 * time.Minute}, resp.Latency.SubmissionLatency)
+		require.Equal(t, &types.CheckpointLatency{BtcBlocks: submissionBlocks + 7, Duration: 50 * time.Minute}, resp.Latency.ConfirmationLatency)
+		require.Equal(t, &types.CheckpointLatency{BtcBlocks: submissionBlocks + 17, Duration: 90 * time.Minute}, resp.Latency.FinalizationLatency)
+
+		// a forgotten checkpoint loses all of its milestones, which are
+		// recorded again once reached
+		require.NoError(t, mk.Hooks().AfterRawCheckpointForgotten(ctx, ckpt))
+		resp, err = queryClient.CheckpointLatency(ctx, &types.QueryCheckpointLatencyRequest{EpochNum: 1})
+		require.NoError(t, err)
+		require.Nil(t, resp.Latency.SubmissionLatency)
+		require.Nil(t, resp.Latency.ConfirmationLatency)
+		require.Nil(t, resp.Latency.FinalizationLatency)
+		advance(10*time.Minute, 1)
+		require.NoError(t, mk.Hooks().AfterRawCheckpointBlsSigVerified(ctx, ckpt))
+		resp, err = queryClient.CheckpointLatency(ctx, &types.QueryCheckpointLatencyRequest{EpochNum: 1})
+		require.NoError(t, err)
+		require.Equal(t, &types.CheckpointLatency{BtcBlocks: submissionBlocks + 18, Duration: 100 * time.Minute}, resp.Latency.SubmissionLatency)
+		require.Nil(t, resp.Latency.ConfirmationLatency)
+		require.Nil(t, resp.Latency.FinalizationLatency)
+
+		// milestones of epochs that did not end are not recorded
+		require.NoError(t, mk.Hooks().AfterRawCheckpointConfirmed(ctx, 3))
+		_, err = queryClient.CheckpointLatency(ctx, &types.QueryCheckpointLatencyRequest{EpochNum: 3})
+		require.ErrorIs(t, err, types.ErrTimelineNotFound)
+
+		// range query
+		numEpochs := datagen.RandomInt(r, 10) + 2
+		for epoch := uint64(2); epoch <= numEpochs; epoch++ {
+			advance(time.Hour, 0)
+			mk.Hooks().AfterEpochEnds(ctx, epoch)
+		}
+		rangeResp, err := queryClient.CheckpointLatencies(ctx, &types.QueryCheckpointLatenciesRequest{StartEpoch: 0, EndEpoch: numEpochs + 10})
+		require.NoError(t, err)
+		require.Len(t, rangeResp.Latencies, int(numEpochs))
+		for i, latency := range rangeResp.Latencies {
+			require.Equal(t, uint64(i+1), latency.EpochNum)
+		}
+		rangeResp, err = queryClient.CheckpointLatencies(ctx, &types.QueryCheckpointLatenciesRequest{StartEpoch: 2, EndEpoch: 2})
+		require.NoError(t, err)
+		require.Len(t, rangeResp.Latencies, 1)
+		require.Equal(t, uint64(2), rangeResp.Latencies[0].EpochNum)
+
+		_, err = queryClient.CheckpointLatencies(ctx, &types.QueryCheckpointLatenciesRequest{StartEpoch: 2, EndEpoch: 1})
+		require.Error(t, err)
+		_, err = queryClient.CheckpointLatencies(ctx, &types.QueryCheckpointLatenciesRequest{StartEpoch: 0, EndEpoch: types.MaxEpochsPerRequest})
+		require.Error(t, err)
+	})
+}
@@ -8,4 +8,5 @@ import (
 type BTCLightClientKeeper interface {
 	GetTipInfo(ctx context.Context) *lc.BTCHeaderInfo
 	GetBaseBTCHeader(ctx context.Context) *lc.BTCHeaderInfo
+	AnnotateLatestReorg(ctx context.Context, module string, epochs []uint64)
 }
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/babylonlabs-io/babylon/x/monitor/types"
 	"github.com/stretchr/testify/require"
@@ -18,6 +19,25 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc: "valid latency thresholds",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					SubmissionLatencyThreshold:   types.CheckpointLatency{BtcBlocks: 10},
+					FinalizationLatencyThreshold: types.CheckpointLatency{BtcBlocks: 200, Duration: 48 * time.Hour},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "negative latency threshold duration",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					ConfirmationLatencyThreshold: types.CheckpointLatency{Duration: -time.Hour},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -26,8 +26,13 @@ const (
 var (
 	EpochEndLightClientHeightPrefix           = []byte{1}
 	CheckpointReportedLightClientHeightPrefix = []byte{2}
+	ParamsKey                                 = []byte{3}
+	CheckpointTimelinePrefix                  = []byte{4}
 )
 
+// MaxEpochsPerRequest is the maximum number of epochs covered by a range query
+const MaxEpochsPerRequest uint64 = 100
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
@@ -36,6 +41,10 @@ func GetEpochEndLightClientHeightKey(e uint64) []byte {
 	return append(EpochEndLightClientHeightPrefix, sdk.Uint64ToBigEndian(e)...)
 }
 
+func GetCheckpointTimelineKey(e uint64) []byte {
+	return append(CheckpointTimelinePrefix, sdk.Uint64ToBigEndian(e)...)
+}
+
 func GetCheckpointReportedLightClientHeightKey(hashString string) ([]byte, error) {
 	hashBytes, err := types.FromStringToCkptHash(hashString)
 	if err != nil {
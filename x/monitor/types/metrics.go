@@ -0,0 +1,53 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+
+	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
+)
+
+const (
+	/* Metrics for monitoring checkpointing health */
+
+	// MetricsKeyCheckpointLatencyBtcBlocks is the key of the gauge recording
+	// the number of BTC blocks between the end of the last epoch whose
+	// checkpoint reached a milestone and the milestone
+	MetricsKeyCheckpointLatencyBtcBlocks = "checkpoint_latency_btc_blocks"
+	// MetricsKeyCheckpointLatencySeconds is the key of the gauge recording the
+	// number of seconds between the end of the last epoch whose checkpoint
+	// reached a milestone and the milestone
+	MetricsKeyCheckpointLatencySeconds = "checkpoint_latency_seconds"
+	// MetricsKeyCheckpointLatencyEpoch is the key of the gauge recording the
+	// last epoch whose checkpoint reached a milestone
+	MetricsKeyCheckpointLatencyEpoch = "checkpoint_latency_epoch"
+
+	// MetricsLabelCheckpointStatus is the label of the status of the checkpoint
+	// at the milestone
+	MetricsLabelCheckpointStatus = "status"
+)
+
+// RecordCheckpointLatency records the latency of the checkpoint of the given
+// epoch reaching the milestone with the given status. It is triggered when
+// the milestone is reached.
+func RecordCheckpointLatency(epoch uint64, status ckpttypes.CheckpointStatus, latency *CheckpointLatency) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(telemetry.MetricLabelNameModule, ModuleName),
+		telemetry.NewLabel(MetricsLabelCheckpointStatus, status.String()),
+	}
+	telemetry.SetGaugeWithLabels(
+		[]string{MetricsKeyCheckpointLatencyEpoch},
+		float32(epoch),
+		labels,
+	)
+	telemetry.SetGaugeWithLabels(
+		[]string{MetricsKeyCheckpointLatencyBtcBlocks},
+		float32(latency.BtcBlocks),
+		labels,
+	)
+	telemetry.SetGaugeWithLabels(
+		[]string{MetricsKeyCheckpointLatencySeconds},
+		float32(latency.Duration.Seconds()),
+		labels,
+	)
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
+)
+
+// MilestoneStatuses are the statuses of the checkpoint whose milestones are
+// recorded in the checkpoint timeline of an epoch
+var MilestoneStatuses = []ckpttypes.CheckpointStatus{
+	ckpttypes.Submitted,
+	ckpttypes.Confirmed,
+	ckpttypes.Finalized,
+}
+
+// NewCheckpointLatency returns the latency between the two given milestones.
+// As the BTC light client height may decrease upon BTC reorgs, the number of
+// BTC blocks is zero if the second milestone is at a lower height.
+func NewCheckpointLatency(from, to *CheckpointMilestone) *CheckpointLatency {
+	var btcBlocks uint32
+	if to.BtcLightClientHeight > from.BtcLightClientHeight {
+		btcBlocks = to.BtcLightClientHeight - from.BtcLightClientHeight
+	}
+
+	return &CheckpointLatency{
+		BtcBlocks: btcBlocks,
+		Duration:  to.Time.Sub(from.Time),
+	}
+}
+
+// Exceeds returns true if the latency is above the given threshold, whose
+// zero fields are not checked
+func (l *CheckpointLatency) Exceeds(threshold *CheckpointLatency) bool {
+	if threshold.BtcBlocks != 0 && l.BtcBlocks > threshold.BtcBlocks {
+		return true
+	}
+	return threshold.Duration != 0 && l.Duration > threshold.Duration
+}
+
+// Milestone returns the milestone of the checkpoint reaching the given
+// status, or nil if it is not reached yet
+func (t *CheckpointTimeline) Milestone(status ckpttypes.CheckpointStatus) *CheckpointMilestone {
+	switch status {
+	case ckpttypes.Submitted:
+		return t.Submitted
+	case ckpttypes.Confirmed:
+		return t.Confirmed
+	case ckpttypes.Finalized:
+		return t.Finalized
+	default:
+		panic(fmt.Sprintf("no checkpoint milestone with status %s", status))
+	}
+}
+
+// SetMilestone sets the milestone of the checkpoint reaching the given status
+func (t *CheckpointTimeline) SetMilestone(status ckpttypes.CheckpointStatus, milestone *CheckpointMilestone) {
+	switch status {
+	case ckpttypes.Submitted:
+		t.Submitted = milestone
+	case ckpttypes.Confirmed:
+		t.Confirmed = milestone
+	case ckpttypes.Finalized:
+		t.Finalized = milestone
+	default:
+		panic(fmt.Sprintf("no checkpoint milestone with status %s", status))
+	}
+}
+
+// Latency returns the latency between the end of the epoch and the milestone
+// of the checkpoint reaching the given status, or nil if it is not reached yet
+func (t *CheckpointTimeline) Latency(status ckpttypes.CheckpointStatus) *CheckpointLatency {
+	milestone := t.Milestone(status)
+	if milestone == nil {
+		return nil
+	}
+	return NewCheckpointLatency(&t.EpochEnded, milestone)
+}
+
+func (t *CheckpointTimeline) ToResponse() *CheckpointLatencyResponse {
+	return &CheckpointLatencyResponse{
+		EpochNum:            t.EpochNum,
+		EpochEndBtcHeight:   t.EpochEnded.BtcLightClientHeight,
+		EpochEndTime:        t.EpochEnded.Time,
+		SubmissionLatency:   t.Latency(ckpttypes.Submitted),
+		ConfirmationLatency: t.Latency(ckpttypes.Confirmed),
+		FinalizationLatency: t.Latency(ckpttypes.Finalized),
+	}
+}
@@ -0,0 +1,1010 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: babylon/monitor/v1/monitor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CheckpointMilestone records the BTC light client height and the Babylon
+// block time at which the checkpoint of an epoch reached a stage of its
+// lifecycle
+type CheckpointMilestone struct {
+	// btc_light_client_height is the height of the BTC light client tip
+	BtcLightClientHeight uint32 `protobuf:"varint,1,opt,name=btc_light_client_height,json=btcLightClientHeight,proto3" json:"btc_light_client_height,omitempty"`
+	// time is the timestamp of the Babylon block
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *CheckpointMilestone) Reset()         { *m = CheckpointMilestone{} }
+func (m *CheckpointMilestone) String() string { return proto.CompactTextString(m) }
+func (*CheckpointMilestone) ProtoMessage()    {}
+func (*CheckpointMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b4616c249e8d12d, []int{0}
+}
+func (m *CheckpointMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointMilestone.Merge(m, src)
+}
+func (m *CheckpointMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointMilestone proto.InternalMessageInfo
+
+func (m *CheckpointMilestone) GetBtcLightClientHeight() uint32 {
+	if m != nil {
+		return m.BtcLightClientHeight
+	}
+	return 0
+}
+
+func (m *CheckpointMilestone) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// CheckpointTimeline records the milestones of the checkpoint of an epoch,
+// starting from the end of the epoch. Milestones that are not reached yet are
+// nil.
+type CheckpointTimeline struct {
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// epoch_ended is recorded when the epoch ends
+	EpochEnded CheckpointMilestone `protobuf:"bytes,2,opt,name=epoch_ended,json=epochEnded,proto3" json:"epoch_ended"`
+	// submitted is recorded when the checkpoint is first reported to Babylon
+	Submitted *CheckpointMilestone `protobuf:"bytes,3,opt,name=submitted,proto3" json:"submitted,omitempty"`
+	// confirmed is recorded when the checkpoint becomes k-deep on BTC
+	Confirmed *CheckpointMilestone `protobuf:"bytes,4,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// finalized is recorded when the checkpoint becomes w-deep on BTC
+	Finalized *CheckpointMilestone `protobuf:"bytes,5,opt,name=finalized,proto3" json:"finalized,omitempty"`
+}
+
+func (m *CheckpointTimeline) Reset()         { *m = CheckpointTimeline{} }
+func (m *CheckpointTimeline) String() string { return proto.CompactTextString(m) }
+func (*CheckpointTimeline) ProtoMessage()    {}
+func (*CheckpointTimeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b4616c249e8d12d, []int{1}
+}
+func (m *CheckpointTimeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointTimeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointTimeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointTimeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointTimeline.Merge(m, src)
+}
+func (m *CheckpointTimeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointTimeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointTimeline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointTimeline proto.InternalMessageInfo
+
+func (m *CheckpointTimeline) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *CheckpointTimeline) GetEpochEnded() CheckpointMilestone {
+	if m != nil {
+		return m.EpochEnded
+	}
+	return CheckpointMilestone{}
+}
+
+func (m *CheckpointTimeline) GetSubmitted() *CheckpointMilestone {
+	if m != nil {
+		return m.Submitted
+	}
+	return nil
+}
+
+func (m *CheckpointTimeline) GetConfirmed() *CheckpointMilestone {
+	if m != nil {
+		return m.Confirmed
+	}
+	return nil
+}
+
+func (m *CheckpointTimeline) GetFinalized() *CheckpointMilestone {
+	if m != nil {
+		return m.Finalized
+	}
+	return nil
+}
+
+// CheckpointLatency is the latency between the end of an epoch and a
+// milestone of its checkpoint
+type CheckpointLatency struct {
+	// btc_blocks is the number of BTC blocks by which the BTC light client has
+	// been extended
+	BtcBlocks uint32 `protobuf:"varint,1,opt,name=btc_blocks,json=btcBlocks,proto3" json:"btc_blocks,omitempty"`
+	// duration is the wall-clock time, measured with Babylon block timestamps
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *CheckpointLatency) Reset()         { *m = CheckpointLatency{} }
+func (m *CheckpointLatency) String() string { return proto.CompactTextString(m) }
+func (*CheckpointLatency) ProtoMessage()    {}
+func (*CheckpointLatency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b4616c249e8d12d, []int{2}
+}
+func (m *CheckpointLatency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointLatency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointLatency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointLatency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointLatency.Merge(m, src)
+}
+func (m *CheckpointLatency) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointLatency) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointLatency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointLatency proto.InternalMessageInfo
+
+func (m *CheckpointLatency) GetBtcBlocks() uint32 {
+	if m != nil {
+		return m.BtcBlocks
+	}
+	return 0
+}
+
+func (m *CheckpointLatency) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CheckpointMilestone)(nil), "babylon.monitor.v1.CheckpointMilestone")
+	proto.RegisterType((*CheckpointTimeline)(nil), "babylon.monitor.v1.CheckpointTimeline")
+	proto.RegisterType((*CheckpointLatency)(nil), "babylon.monitor.v1.CheckpointLatency")
+}
+
+func init() { proto.RegisterFile("babylon/monitor/v1/monitor.proto", fileDescriptor_5b4616c249e8d12d) }
+
+var fileDescriptor_5b4616c249e8d12d = []byte{
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbf, 0x8e, 0xd3, 0x30,
+	0x18, 0xaf, 0x8f, 0x80, 0xae, 0x3e, 0x31, 0x60, 0x4e, 0x22, 0x14, 0x91, 0x56, 0x5d, 0xb8, 0x85,
+	0x44, 0x05, 0x21, 0x21, 0x16, 0xa4, 0x1e, 0x27, 0x21, 0x71, 0xdc, 0x10, 0x31, 0xb1, 0x54, 0xb1,
+	0xe3, 0x26, 0xd6, 0x39, 0xfe, 0xa2, 0xe6, 0xcb, 0x89, 0xf2, 0x00, 0xcc, 0x37, 0x32, 0xf2, 0x38,
+	0x37, 0xde, 0x84, 0x98, 0x00, 0xb5, 0x0b, 0x8f, 0x81, 0xec, 0x24, 0xad, 0xc4, 0xb1, 0x74, 0xf3,
+	0xe7, 0xdf, 0x3f, 0xdb, 0xbf, 0x84, 0x8e, 0x78, 0xc2, 0x97, 0x1a, 0x4c, 0x54, 0x80, 0x51, 0x08,
+	0x8b, 0xe8, 0x62, 0xd2, 0x2d, 0xc3, 0x72, 0x01, 0x08, 0x8c, 0xb5, 0x8c, 0xb0, 0xdb, 0xbe, 0x98,
+	0x0c, 0x0e, 0x33, 0xc8, 0xc0, 0xc1, 0x91, 0x5d, 0x35, 0xcc, 0x41, 0x90, 0x01, 0x64, 0x5a, 0x46,
+	0x6e, 0xe2, 0xf5, 0x3c, 0x4a, 0xeb, 0x45, 0x82, 0x0a, 0x4c, 0x8b, 0x0f, 0xff, 0xc5, 0x51, 0x15,
+	0xb2, 0xc2, 0xa4, 0x28, 0x1b, 0xc2, 0xf8, 0x0b, 0xa1, 0xf7, 0x8f, 0x73, 0x29, 0xce, 0x4b, 0x50,
+	0x06, 0xdf, 0x2b, 0x2d, 0x2b, 0x04, 0x23, 0xd9, 0x0b, 0xfa, 0x80, 0xa3, 0x98, 0x69, 0x95, 0xe5,
+	0x38, 0x13, 0x5a, 0x49, 0x83, 0xb3, 0x5c, 0xda, 0xc9, 0x27, 0x23, 0x72, 0x74, 0x37, 0x3e, 0xe4,
+	0x28, 0x4e, 0xed, 0x7c, 0xec, 0xc0, 0xb7, 0x0e, 0x63, 0x2f, 0xa9, 0x67, 0x13, 0xfc, 0xbd, 0x11,
+	0x39, 0x3a, 0x78, 0x36, 0x08, 0x9b, 0xf8, 0xb0, 0x8b, 0x0f, 0x3f, 0x74, 0xf1, 0xd3, 0xfd, 0xab,
+	0x9f, 0xc3, 0xde, 0xe5, 0xaf, 0x21, 0x89, 0x9d, 0x62, 0xfc, 0x7d, 0x8f, 0xb2, 0xed, 0x41, 0x2c,
+	0x4f, 0x2b, 0x23, 0xd9, 0x23, 0xda, 0x97, 0x25, 0x88, 0x7c, 0x66, 0xea, 0xc2, 0x25, 0x7b, 0xf1,
+	0xbe, 0xdb, 0x38, 0xab, 0x0b, 0x76, 0x46, 0x0f, 0x1a, 0x50, 0x9a, 0x54, 0xa6, 0x6d, 0xe8, 0x93,
+	0xf0, 0xe6, 0xeb, 0x85, 0xff, 0xb9, 0xe2, 0xd4, 0xb3, 0x27, 0x88, 0xa9, 0x73, 0x38, 0xb1, 0x06,
+	0xec, 0x84, 0xf6, 0xab, 0x9a, 0x17, 0x0a, 0x51, 0xa6, 0xfe, 0xad, 0x9d, 0xdc, 0xe2, 0xad, 0xd2,
+	0xda, 0x08, 0x30, 0x73, 0xb5, 0x28, 0x64, 0xea, 0x7b, 0x3b, 0xda, 0x6c, 0x94, 0xd6, 0x66, 0xae,
+	0x4c, 0xa2, 0xd5, 0x67, 0x99, 0xfa, 0xb7, 0x77, 0xb4, 0xd9, 0x28, 0xc7, 0x4b, 0x7a, 0x6f, 0xcb,
+	0x38, 0x4d, 0x50, 0x1a, 0xb1, 0x64, 0x8f, 0x29, 0xb5, 0xf5, 0x72, 0x0d, 0xe2, 0xbc, 0x6a, 0x1b,
+	0xed, 0x73, 0x14, 0x53, 0xb7, 0xc1, 0x5e, 0xd3, 0xfd, 0xee, 0x43, 0x6a, 0x5f, 0xf5, 0xe1, 0x8d,
+	0x2a, 0xdf, 0xb4, 0x84, 0xa6, 0xc9, 0xaf, 0xb6, 0xc9, 0x8d, 0xe8, 0x95, 0xf7, 0xe7, 0xdb, 0x90,
+	0x4c, 0xdf, 0x5d, 0xad, 0x02, 0x72, 0xbd, 0x0a, 0xc8, 0xef, 0x55, 0x40, 0x2e, 0xd7, 0x41, 0xef,
+	0x7a, 0x1d, 0xf4, 0x7e, 0xac, 0x83, 0xde, 0xc7, 0x49, 0xa6, 0x30, 0xaf, 0x79, 0x28, 0xa0, 0x88,
+	0xda, 0x2b, 0xe9, 0x84, 0x57, 0x4f, 0x15, 0x74, 0x63, 0xf4, 0x69, 0xf3, 0x7f, 0xe0, 0xb2, 0x94,
+	0x15, 0xbf, 0xe3, 0x92, 0x9f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x5b, 0x0e, 0x58, 0x3f,
+	0x03, 0x00, 0x00,
+}
+
+func (this *CheckpointLatency) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CheckpointLatency)
+	if !ok {
+		that2, ok := that.(CheckpointLatency)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BtcBlocks != that1.BtcBlocks {
+		return false
+	}
+	if this.Duration != that1.Duration {
+		return false
+	}
+	return true
+}
+func (m *CheckpointMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMonitor(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.BtcLightClientHeight != 0 {
+		i = encodeVarintMonitor(dAtA, i, uint64(m.BtcLightClientHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointTimeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointTimeline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointTimeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finalized != nil {
+		{
+			size, err := m.Finalized.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMonitor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Confirmed != nil {
+		{
+			size, err := m.Confirmed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMonitor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Submitted != nil {
+		{
+			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMonitor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.EpochEnded.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMonitor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochNum != 0 {
+		i = encodeVarintMonitor(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointLatency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointLatency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointLatency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintMonitor(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.BtcBlocks != 0 {
+		i = encodeVarintMonitor(dAtA, i, uint64(m.BtcBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMonitor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMonitor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CheckpointMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		n += 1 + sovMonitor(uint64(m.BtcLightClientHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMonitor(uint64(l))
+	return n
+}
+
+func (m *CheckpointTimeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovMonitor(uint64(m.EpochNum))
+	}
+	l = m.EpochEnded.Size()
+	n += 1 + l + sovMonitor(uint64(l))
+	if m.Submitted != nil {
+		l = m.Submitted.Size()
+		n += 1 + l + sovMonitor(uint64(l))
+	}
+	if m.Confirmed != nil {
+		l = m.Confirmed.Size()
+		n += 1 + l + sovMonitor(uint64(l))
+	}
+	if m.Finalized != nil {
+		l = m.Finalized.Size()
+		n += 1 + l + sovMonitor(uint64(l))
+	}
+	return n
+}
+
+func (m *CheckpointLatency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcBlocks != 0 {
+		n += 1 + sovMonitor(uint64(m.BtcBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovMonitor(uint64(l))
+	return n
+}
+
+func sovMonitor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMonitor(x uint64) (n int) {
+	return sovMonitor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CheckpointMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMonitor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			}
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMonitor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointTimeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMonitor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointTimeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointTimeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEnded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochEnded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Submitted == nil {
+				m.Submitted = &CheckpointMilestone{}
+			}
+			if err := m.Submitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confirmed == nil {
+				m.Confirmed = &CheckpointMilestone{}
+			}
+			if err := m.Confirmed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finalized == nil {
+				m.Finalized = &CheckpointMilestone{}
+			}
+			if err := m.Finalized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMonitor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckpointLatency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMonitor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckpointLatency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckpointLatency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcBlocks", wireType)
+			}
+			m.BtcBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMonitor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMonitor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMonitor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMonitor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMonitor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMonitor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMonitor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMonitor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMonitor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMonitor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMonitor = fmt.Errorf("proto: unexpected end of group")
+)
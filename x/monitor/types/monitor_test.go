@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
+	"github.com/babylonlabs-io/babylon/x/monitor/types"
+)
+
+func TestCheckpointLatency(t *testing.T) {
+	epochEnd := time.Unix(1700000000, 0)
+	timeline := &types.CheckpointTimeline{
+		EpochNum:   1,
+		EpochEnded: types.CheckpointMilestone{BtcLightClientHeight: 100, Time: epochEnd},
+		Submitted:  &types.CheckpointMilestone{BtcLightClientHeight: 103, Time: epochEnd.Add(30 * time.Minute)},
+		// the light client height decreased because of a BTC reorg
+		Confirmed: &types.CheckpointMilestone{BtcLightClientHeight: 99, Time: epochEnd.Add(time.Hour)},
+	}
+
+	require.Equal(t, &types.CheckpointLatency{BtcBlocks: 3, Duration: 30 * time.Minute}, timeline.Latency(ckpttypes.Submitted))
+	require.Equal(t, &types.CheckpointLatency{BtcBlocks: 0, Duration: time.Hour}, timeline.Latency(ckpttypes.Confirmed))
+	require.Nil(t, timeline.Latency(ckpttypes.Finalized))
+
+	resp := timeline.ToResponse()
+	require.Equal(t, uint32(100), resp.EpochEndBtcHeight)
+	require.Equal(t, epochEnd, resp.EpochEndTime)
+	require.Equal(t, timeline.Latency(ckpttypes.Submitted), resp.SubmissionLatency)
+	require.Nil(t, resp.FinalizationLatency)
+
+	latency := timeline.Latency(ckpttypes.Submitted)
+	// zero fields of the threshold are not checked
+	require.False(t, latency.Exceeds(&types.CheckpointLatency{}))
+	require.False(t, latency.Exceeds(&types.CheckpointLatency{BtcBlocks: 3}))
+	require.True(t, latency.Exceeds(&types.CheckpointLatency{BtcBlocks: 2}))
+	require.False(t, latency.Exceeds(&types.CheckpointLatency{Duration: 30 * time.Minute}))
+	require.True(t, latency.Exceeds(&types.CheckpointLatency{Duration: 29 * time.Minute}))
+	require.True(t, latency.Exceeds(&types.CheckpointLatency{BtcBlocks: 10, Duration: 29 * time.Minute}))
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	ckpttypes "github.com/babylonlabs-io/babylon/x/checkpointing/types"
+)
+
+// DefaultParams returns a default set of parameters, in which all latency
+// thresholds are disabled
+func DefaultParams() Params {
+	return Params{}
+}
+
+func validateLatencyThreshold(name string, threshold CheckpointLatency) error {
+	if threshold.Duration < 0 {
+		return fmt.Errorf("%s duration must be non-negative, got %s", name, threshold.Duration)
+	}
+
+	return nil
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateLatencyThreshold("submission latency threshold", p.SubmissionLatencyThreshold); err != nil {
+		return err
+	}
+	if err := validateLatencyThreshold("confirmation latency threshold", p.ConfirmationLatencyThreshold); err != nil {
+		return err
+	}
+	if err := validateLatencyThreshold("finalization latency threshold", p.FinalizationLatencyThreshold); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LatencyThreshold returns the latency threshold of the checkpoint milestone
+// with the given status
+func (p *Params) LatencyThreshold(status ckpttypes.CheckpointStatus) CheckpointLatency {
+	switch status {
+	case ckpttypes.Submitted:
+		return p.SubmissionLatencyThreshold
+	case ckpttypes.Confirmed:
+		return p.ConfirmationLatencyThreshold
+	case ckpttypes.Finalized:
+		return p.FinalizationLatencyThreshold
+	default:
+		panic(fmt.Sprintf("no checkpoint milestone with status %s", status))
+	}
+}
@@ -6,21 +6,26 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -219,43 +224,445 @@ func (m *QueryReportedCheckpointBtcHeightResponse) GetBtcLightClientHeight() uin
 	return 0
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// CheckpointLatencyResponse is the latency metrics of the checkpoint of an
+// epoch. Latencies of milestones that are not reached yet are nil.
+type CheckpointLatencyResponse struct {
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// epoch_end_btc_height is the height of the BTC light client when the epoch
+	// ended
+	EpochEndBtcHeight uint32 `protobuf:"varint,2,opt,name=epoch_end_btc_height,json=epochEndBtcHeight,proto3" json:"epoch_end_btc_height,omitempty"`
+	// epoch_end_time is the timestamp of the Babylon block ending the epoch
+	EpochEndTime time.Time `protobuf:"bytes,3,opt,name=epoch_end_time,json=epochEndTime,proto3,stdtime" json:"epoch_end_time"`
+	// submission_latency is the latency of the checkpoint being reported to
+	// Babylon
+	SubmissionLatency *CheckpointLatency `protobuf:"bytes,4,opt,name=submission_latency,json=submissionLatency,proto3" json:"submission_latency,omitempty"`
+	// confirmation_latency is the latency of the checkpoint becoming k-deep
+	ConfirmationLatency *CheckpointLatency `protobuf:"bytes,5,opt,name=confirmation_latency,json=confirmationLatency,proto3" json:"confirmation_latency,omitempty"`
+	// finalization_latency is the latency of the checkpoint becoming w-deep
+	FinalizationLatency *CheckpointLatency `protobuf:"bytes,6,opt,name=finalization_latency,json=finalizationLatency,proto3" json:"finalization_latency,omitempty"`
+}
+
+func (m *CheckpointLatencyResponse) Reset()         { *m = CheckpointLatencyResponse{} }
+func (m *CheckpointLatencyResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckpointLatencyResponse) ProtoMessage()    {}
+func (*CheckpointLatencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{6}
+}
+func (m *CheckpointLatencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointLatencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointLatencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckpointLatencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointLatencyResponse.Merge(m, src)
+}
+func (m *CheckpointLatencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointLatencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointLatencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointLatencyResponse proto.InternalMessageInfo
+
+func (m *CheckpointLatencyResponse) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+func (m *CheckpointLatencyResponse) GetEpochEndBtcHeight() uint32 {
+	if m != nil {
+		return m.EpochEndBtcHeight
+	}
+	return 0
+}
+
+func (m *CheckpointLatencyResponse) GetEpochEndTime() time.Time {
+	if m != nil {
+		return m.EpochEndTime
+	}
+	return time.Time{}
+}
+
+func (m *CheckpointLatencyResponse) GetSubmissionLatency() *CheckpointLatency {
+	if m != nil {
+		return m.SubmissionLatency
+	}
+	return nil
+}
+
+func (m *CheckpointLatencyResponse) GetConfirmationLatency() *CheckpointLatency {
+	if m != nil {
+		return m.ConfirmationLatency
+	}
+	return nil
+}
+
+func (m *CheckpointLatencyResponse) GetFinalizationLatency() *CheckpointLatency {
+	if m != nil {
+		return m.FinalizationLatency
+	}
+	return nil
+}
+
+// QueryCheckpointLatencyRequest defines a query type for CheckpointLatency
+// RPC method
+type QueryCheckpointLatencyRequest struct {
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+}
+
+func (m *QueryCheckpointLatencyRequest) Reset()         { *m = QueryCheckpointLatencyRequest{} }
+func (m *QueryCheckpointLatencyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointLatencyRequest) ProtoMessage()    {}
+func (*QueryCheckpointLatencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{7}
+}
+func (m *QueryCheckpointLatencyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointLatencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointLatencyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointLatencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointLatencyRequest.Merge(m, src)
+}
+func (m *QueryCheckpointLatencyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointLatencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointLatencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointLatencyRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointLatencyRequest) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
+	}
+	return 0
+}
+
+// QueryCheckpointLatencyResponse defines a response type for
+// CheckpointLatency RPC method
+type QueryCheckpointLatencyResponse struct {
+	Latency *CheckpointLatencyResponse `protobuf:"bytes,1,opt,name=latency,proto3" json:"latency,omitempty"`
+}
+
+func (m *QueryCheckpointLatencyResponse) Reset()         { *m = QueryCheckpointLatencyResponse{} }
+func (m *QueryCheckpointLatencyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointLatencyResponse) ProtoMessage()    {}
+func (*QueryCheckpointLatencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{8}
+}
+func (m *QueryCheckpointLatencyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointLatencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointLatencyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointLatencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointLatencyResponse.Merge(m, src)
+}
+func (m *QueryCheckpointLatencyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointLatencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointLatencyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointLatencyResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointLatencyResponse) GetLatency() *CheckpointLatencyResponse {
+	if m != nil {
+		return m.Latency
+	}
+	return nil
+}
+
+// QueryCheckpointLatenciesRequest defines a query type for
+// CheckpointLatencies RPC method
+type QueryCheckpointLatenciesRequest struct {
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch   uint64 `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+}
+
+func (m *QueryCheckpointLatenciesRequest) Reset()         { *m = QueryCheckpointLatenciesRequest{} }
+func (m *QueryCheckpointLatenciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointLatenciesRequest) ProtoMessage()    {}
+func (*QueryCheckpointLatenciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{9}
+}
+func (m *QueryCheckpointLatenciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointLatenciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointLatenciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointLatenciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointLatenciesRequest.Merge(m, src)
+}
+func (m *QueryCheckpointLatenciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointLatenciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointLatenciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointLatenciesRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointLatenciesRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *QueryCheckpointLatenciesRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+// QueryCheckpointLatenciesResponse defines a response type for
+// CheckpointLatencies RPC method. Epochs whose end was not recorded are
+// omitted.
+type QueryCheckpointLatenciesResponse struct {
+	Latencies []*CheckpointLatencyResponse `protobuf:"bytes,1,rep,name=latencies,proto3" json:"latencies,omitempty"`
+}
+
+func (m *QueryCheckpointLatenciesResponse) Reset()         { *m = QueryCheckpointLatenciesResponse{} }
+func (m *QueryCheckpointLatenciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointLatenciesResponse) ProtoMessage()    {}
+func (*QueryCheckpointLatenciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8aafb034c55a8f2, []int{10}
+}
+func (m *QueryCheckpointLatenciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointLatenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointLatenciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCheckpointLatenciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointLatenciesResponse.Merge(m, src)
+}
+func (m *QueryCheckpointLatenciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointLatenciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointLatenciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointLatenciesResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointLatenciesResponse) GetLatencies() []*CheckpointLatencyResponse {
+	if m != nil {
+		return m.Latencies
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryEndedEpochBtcHeightRequest)(nil), "babylon.monitor.v1.QueryEndedEpochBtcHeightRequest")
 	proto.RegisterType((*QueryEndedEpochBtcHeightResponse)(nil), "babylon.monitor.v1.QueryEndedEpochBtcHeightResponse")
 	proto.RegisterType((*QueryReportedCheckpointBtcHeightRequest)(nil), "babylon.monitor.v1.QueryReportedCheckpointBtcHeightRequest")
 	proto.RegisterType((*QueryReportedCheckpointBtcHeightResponse)(nil), "babylon.monitor.v1.QueryReportedCheckpointBtcHeightResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.monitor.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.monitor.v1.QueryParamsResponse")
+	proto.RegisterType((*CheckpointLatencyResponse)(nil), "babylon.monitor.v1.CheckpointLatencyResponse")
+	proto.RegisterType((*QueryCheckpointLatencyRequest)(nil), "babylon.monitor.v1.QueryCheckpointLatencyRequest")
+	proto.RegisterType((*QueryCheckpointLatencyResponse)(nil), "babylon.monitor.v1.QueryCheckpointLatencyResponse")
+	proto.RegisterType((*QueryCheckpointLatenciesRequest)(nil), "babylon.monitor.v1.QueryCheckpointLatenciesRequest")
+	proto.RegisterType((*QueryCheckpointLatenciesResponse)(nil), "babylon.monitor.v1.QueryCheckpointLatenciesResponse")
 }
 
 func init() { proto.RegisterFile("babylon/monitor/v1/query.proto", fileDescriptor_a8aafb034c55a8f2) }
 
 var fileDescriptor_a8aafb034c55a8f2 = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x8b, 0x13, 0x31,
-	0x18, 0xed, 0xf8, 0x8b, 0x36, 0xe0, 0x25, 0x0a, 0x4a, 0x2b, 0x63, 0x99, 0x83, 0x16, 0xa4, 0x13,
-	0x6a, 0xf5, 0xa4, 0x78, 0x68, 0xa9, 0x14, 0x14, 0xc1, 0xb9, 0xe9, 0x65, 0xc8, 0xa4, 0x61, 0x12,
-	0x3a, 0x93, 0xa4, 0x93, 0x4c, 0xb1, 0x94, 0x5e, 0x3c, 0x7a, 0x12, 0xfc, 0x47, 0xfc, 0x33, 0xbc,
-	0x08, 0x05, 0x2f, 0x1e, 0x97, 0x76, 0xff, 0x90, 0x65, 0xd2, 0xee, 0x5c, 0x76, 0xba, 0x65, 0x77,
-	0x8f, 0xf9, 0x5e, 0xde, 0xfb, 0xbe, 0xf7, 0xbd, 0x04, 0xb8, 0x11, 0x8e, 0x16, 0x89, 0x14, 0x28,
-	0x95, 0x82, 0x1b, 0x99, 0xa1, 0x79, 0x0f, 0xcd, 0x72, 0x9a, 0x2d, 0x7c, 0x95, 0x49, 0x23, 0x21,
-	0xdc, 0xe3, 0xfe, 0x1e, 0xf7, 0xe7, 0xbd, 0xe6, 0x93, 0x58, 0xca, 0x38, 0xa1, 0x08, 0x2b, 0x8e,
-	0xb0, 0x10, 0xd2, 0x60, 0xc3, 0xa5, 0xd0, 0x3b, 0x86, 0xf7, 0x0e, 0x3c, 0xfd, 0x5c, 0x08, 0x8c,
-	0xc4, 0x84, 0x4e, 0x46, 0x4a, 0x12, 0x36, 0x30, 0x64, 0x4c, 0x79, 0xcc, 0x4c, 0x40, 0x67, 0x39,
-	0xd5, 0x06, 0xb6, 0x40, 0x83, 0x16, 0x40, 0x28, 0xf2, 0xf4, 0xb1, 0xd3, 0x76, 0x3a, 0x77, 0x82,
-	0xba, 0x2d, 0x7c, 0xca, 0x53, 0xef, 0x0b, 0x68, 0x1f, 0xe6, 0x6b, 0x25, 0x85, 0xa6, 0xf0, 0x35,
-	0x78, 0x14, 0x19, 0x12, 0x26, 0x45, 0x31, 0x24, 0x09, 0xa7, 0xc2, 0x84, 0xcc, 0x5e, 0xb1, 0x72,
-	0xf7, 0x83, 0x87, 0x91, 0x21, 0x1f, 0x8b, 0xf3, 0xd0, 0x82, 0x3b, 0xba, 0xf7, 0x1e, 0x3c, 0xb7,
-	0xd2, 0x01, 0x55, 0x32, 0x33, 0x74, 0x32, 0x64, 0x94, 0x4c, 0x95, 0xe4, 0xc2, 0x54, 0x8d, 0x48,
-	0xa6, 0xca, 0x84, 0x0c, 0x6b, 0x66, 0x35, 0x1b, 0x41, 0xbd, 0x28, 0x8c, 0xb1, 0x66, 0x1e, 0x06,
-	0x9d, 0xe3, 0x3a, 0x37, 0x1a, 0xf5, 0xe5, 0x8f, 0xdb, 0xe0, 0xae, 0xed, 0x01, 0x7f, 0x3b, 0xe0,
-	0x41, 0xc5, 0x2e, 0x60, 0xdf, 0xbf, 0x18, 0x8d, 0x7f, 0x64, 0xf3, 0xcd, 0x57, 0x57, 0x23, 0xed,
-	0x3c, 0x78, 0xfe, 0xf7, 0x7f, 0xa7, 0xbf, 0x6e, 0x75, 0xe0, 0x33, 0x54, 0xf1, 0x5a, 0x6c, 0x70,
-	0x1a, 0x2d, 0xcb, 0x44, 0x57, 0xf0, 0xaf, 0x03, 0x5a, 0x97, 0xec, 0x06, 0xbe, 0x39, 0x38, 0xc5,
-	0xf1, 0x64, 0x9a, 0x6f, 0xaf, 0x47, 0xde, 0x5b, 0xe9, 0x5b, 0x2b, 0x5d, 0xf8, 0xa2, 0xca, 0x0a,
-	0x29, 0x89, 0x1a, 0x2d, 0xcb, 0xf8, 0x57, 0x83, 0x0f, 0x7f, 0x36, 0xae, 0xb3, 0xde, 0xb8, 0xce,
-	0xc9, 0xc6, 0x75, 0x7e, 0x6e, 0xdd, 0xda, 0x7a, 0xeb, 0xd6, 0xfe, 0x6f, 0xdd, 0xda, 0xd7, 0x5e,
-	0xcc, 0x0d, 0xcb, 0x23, 0x9f, 0xc8, 0xf4, 0x5c, 0x30, 0xc1, 0x91, 0xee, 0x72, 0x59, 0xea, 0x7f,
-	0x2b, 0x3b, 0x98, 0x85, 0xa2, 0x3a, 0xba, 0x67, 0xbf, 0x49, 0xff, 0x2c, 0x00, 0x00, 0xff, 0xff,
-	0x85, 0xb8, 0x2a, 0x58, 0x7a, 0x03, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xeb, 0x44,
+	0x18, 0x8d, 0xdb, 0xde, 0xdc, 0x76, 0x0a, 0x48, 0x9d, 0x44, 0x22, 0xb8, 0xc5, 0x89, 0x2c, 0xd1,
+	0x56, 0x42, 0xb5, 0x95, 0xb4, 0x48, 0x08, 0x2a, 0x16, 0x29, 0x85, 0xaa, 0xad, 0xf8, 0xb1, 0xba,
+	0x00, 0x36, 0x96, 0xed, 0x4c, 0xe3, 0x51, 0xed, 0x19, 0x37, 0x33, 0xa9, 0x08, 0x51, 0x36, 0x3c,
+	0x41, 0x11, 0x2f, 0xc2, 0x8a, 0x2d, 0xdb, 0x2e, 0x40, 0xaa, 0xc4, 0x86, 0x15, 0xa0, 0x96, 0x07,
+	0x41, 0x1e, 0x8f, 0x9d, 0x84, 0xda, 0x49, 0xc3, 0xdd, 0x25, 0xdf, 0xcf, 0xf9, 0xce, 0x37, 0x73,
+	0xe6, 0x18, 0x68, 0xae, 0xe3, 0x0e, 0x02, 0x4a, 0xcc, 0x90, 0x12, 0xcc, 0x69, 0xcf, 0xbc, 0x69,
+	0x9a, 0xd7, 0x7d, 0xd4, 0x1b, 0x18, 0x51, 0x8f, 0x72, 0x0a, 0xa1, 0xcc, 0x1b, 0x32, 0x6f, 0xdc,
+	0x34, 0xd5, 0x6a, 0x97, 0x76, 0xa9, 0x48, 0x9b, 0xf1, 0xaf, 0xa4, 0x52, 0xdd, 0xea, 0x52, 0xda,
+	0x0d, 0x90, 0xe9, 0x44, 0xd8, 0x74, 0x08, 0xa1, 0xdc, 0xe1, 0x98, 0x12, 0x26, 0xb3, 0x75, 0x99,
+	0x15, 0xff, 0xdc, 0xfe, 0xa5, 0xc9, 0x71, 0x88, 0x18, 0x77, 0xc2, 0x48, 0x16, 0x34, 0x72, 0x88,
+	0xa4, 0x33, 0x25, 0x44, 0x4e, 0x45, 0xe4, 0xf4, 0x9c, 0x50, 0xce, 0xd0, 0x3f, 0x02, 0xf5, 0x2f,
+	0x63, 0xea, 0xc7, 0xa4, 0x83, 0x3a, 0xc7, 0x11, 0xf5, 0xfc, 0x36, 0xf7, 0x4e, 0x10, 0xee, 0xfa,
+	0xdc, 0x42, 0xd7, 0x7d, 0xc4, 0x38, 0xdc, 0x04, 0x6b, 0x28, 0x4e, 0xd8, 0xa4, 0x1f, 0xd6, 0x94,
+	0x86, 0xb2, 0xbb, 0x62, 0xad, 0x8a, 0xc0, 0x67, 0xfd, 0x50, 0xff, 0x1a, 0x34, 0x8a, 0xfb, 0x59,
+	0x44, 0x09, 0x43, 0xf0, 0x3d, 0xf0, 0xa6, 0xcb, 0x3d, 0x3b, 0x88, 0x83, 0xb6, 0x17, 0x60, 0x44,
+	0xb8, 0xed, 0x8b, 0x12, 0x01, 0xf7, 0xba, 0x55, 0x75, 0xb9, 0x77, 0x1e, 0xff, 0x3f, 0x12, 0xc9,
+	0xa4, 0x5d, 0xff, 0x04, 0xec, 0x08, 0x68, 0x0b, 0x45, 0xb4, 0xc7, 0x51, 0xe7, 0xc8, 0x47, 0xde,
+	0x55, 0x44, 0x31, 0xe1, 0x79, 0x14, 0xbd, 0xab, 0x88, 0xdb, 0xbe, 0xc3, 0x7c, 0x81, 0xb9, 0x66,
+	0xad, 0xc6, 0x81, 0x13, 0x87, 0xf9, 0xba, 0x03, 0x76, 0xe7, 0xe3, 0xbc, 0x1a, 0xd5, 0x2a, 0x80,
+	0x62, 0xc4, 0x17, 0xe2, 0x68, 0x25, 0x2b, 0xfd, 0x73, 0x50, 0x99, 0x8a, 0xca, 0x19, 0xef, 0x83,
+	0x72, 0x72, 0x05, 0x02, 0x72, 0xbd, 0xa5, 0x1a, 0x4f, 0xf5, 0x62, 0x24, 0x3d, 0xed, 0x95, 0xbb,
+	0x3f, 0xeb, 0x25, 0x4b, 0xd6, 0xeb, 0xbf, 0x2c, 0x83, 0xb7, 0xc6, 0xec, 0xcf, 0x1d, 0x8e, 0x88,
+	0x37, 0xc8, 0x70, 0x67, 0xdd, 0x13, 0x34, 0x41, 0x35, 0x49, 0x22, 0xd2, 0xb1, 0xe3, 0x15, 0xe5,
+	0x56, 0x4b, 0x62, 0xab, 0x0d, 0x91, 0x3b, 0x26, 0x9d, 0xec, 0x44, 0xe0, 0x29, 0x78, 0x63, 0xdc,
+	0x10, 0x0b, 0xaf, 0xb6, 0x2c, 0xd9, 0x26, 0xaa, 0x34, 0x52, 0x55, 0x1a, 0x17, 0xa9, 0x2a, 0xdb,
+	0xab, 0x31, 0xdb, 0xdb, 0xbf, 0xea, 0x8a, 0xf5, 0x5a, 0x0a, 0x18, 0x27, 0xe1, 0x05, 0x80, 0xac,
+	0xef, 0x86, 0x98, 0x31, 0x4c, 0x89, 0x1d, 0x24, 0xbc, 0x6b, 0x2b, 0x02, 0xef, 0x9d, 0xbc, 0xed,
+	0x9f, 0x2e, 0xb9, 0x31, 0x06, 0x90, 0x21, 0xf8, 0x15, 0xa8, 0x7a, 0x94, 0x5c, 0xe2, 0x5e, 0x28,
+	0x5e, 0x4d, 0x86, 0xfb, 0x62, 0x11, 0xdc, 0xca, 0x24, 0xc4, 0x04, 0xf2, 0x25, 0x26, 0x4e, 0x80,
+	0xbf, 0x9b, 0x46, 0x2e, 0x2f, 0x84, 0x3c, 0x09, 0x21, 0x83, 0xfa, 0x21, 0x78, 0x5b, 0x48, 0x22,
+	0xe7, 0x16, 0x9f, 0xf1, 0xd8, 0x30, 0xd0, 0x8a, 0xba, 0xa5, 0x06, 0x3e, 0x05, 0x2f, 0x53, 0xb2,
+	0x89, 0xb8, 0xf6, 0x9e, 0x47, 0x56, 0xf6, 0x5b, 0x69, 0xb7, 0x6e, 0x4b, 0x5f, 0xf8, 0x6f, 0x29,
+	0x46, 0xa9, 0xbc, 0x61, 0x1d, 0xac, 0x33, 0xee, 0xf4, 0xb8, 0x2d, 0xf8, 0x49, 0xb2, 0x40, 0x84,
+	0x84, 0x11, 0x88, 0x5d, 0x48, 0x47, 0xa6, 0x97, 0xe4, 0x2e, 0x24, 0x71, 0x09, 0x9d, 0x4a, 0xe3,
+	0xc8, 0x1d, 0x20, 0xb7, 0x39, 0x03, 0x6b, 0x41, 0x1a, 0xac, 0x29, 0x8d, 0xe5, 0xc5, 0xf7, 0x19,
+	0xf7, 0xb7, 0x7e, 0x78, 0x09, 0x5e, 0x88, 0x89, 0xf0, 0x27, 0x05, 0x54, 0x72, 0xfc, 0x0a, 0xee,
+	0xe7, 0x61, 0xcf, 0x71, 0x47, 0xf5, 0x60, 0xb1, 0xa6, 0x84, 0x97, 0x6e, 0x7c, 0xff, 0xfb, 0x3f,
+	0x3f, 0x2e, 0xed, 0xc2, 0x6d, 0x33, 0xc7, 0xa0, 0xc5, 0x81, 0x31, 0x73, 0x98, 0x09, 0x61, 0x04,
+	0x7f, 0x53, 0xc0, 0xe6, 0x0c, 0xff, 0x82, 0x1f, 0x16, 0xb2, 0x98, 0xef, 0x9e, 0xea, 0xe1, 0xff,
+	0x6b, 0x96, 0xab, 0xec, 0x8b, 0x55, 0xf6, 0xe0, 0xbb, 0x79, 0xab, 0x78, 0x59, 0x23, 0x33, 0x87,
+	0x99, 0x45, 0x8f, 0xe0, 0x08, 0x94, 0x13, 0x87, 0x83, 0xdb, 0x85, 0xc3, 0xa7, 0xcc, 0x54, 0xdd,
+	0x99, 0x5b, 0x27, 0xf9, 0xe8, 0x82, 0xcf, 0x16, 0x54, 0xcd, 0xc2, 0x6f, 0x1f, 0xfc, 0x59, 0x01,
+	0x1b, 0x4f, 0x44, 0x03, 0x9b, 0x85, 0x23, 0x8a, 0x9e, 0xab, 0xda, 0x5a, 0xa4, 0x45, 0x12, 0xfc,
+	0x40, 0x10, 0x3c, 0x80, 0xad, 0xd9, 0x07, 0x96, 0xba, 0xce, 0x94, 0x0e, 0x7e, 0x55, 0x40, 0x25,
+	0xe7, 0xc5, 0xcc, 0x90, 0x6e, 0xf1, 0x03, 0x9e, 0x21, 0xdd, 0x19, 0x8f, 0x52, 0x3f, 0x15, 0xf4,
+	0x3f, 0x86, 0xed, 0x67, 0xd1, 0xc7, 0x88, 0x99, 0xc3, 0x09, 0x9b, 0x18, 0x99, 0xc3, 0xcc, 0x13,
+	0x46, 0xed, 0xb3, 0xbb, 0x07, 0x4d, 0xb9, 0x7f, 0xd0, 0x94, 0xbf, 0x1f, 0x34, 0xe5, 0xf6, 0x51,
+	0x2b, 0xdd, 0x3f, 0x6a, 0xa5, 0x3f, 0x1e, 0xb5, 0xd2, 0x37, 0xcd, 0x2e, 0xe6, 0x7e, 0xdf, 0x35,
+	0x3c, 0x1a, 0xa6, 0x73, 0x02, 0xc7, 0x65, 0x7b, 0x98, 0x66, 0x63, 0xbf, 0xcd, 0x06, 0xf3, 0x41,
+	0x84, 0x98, 0x5b, 0x16, 0x5f, 0xa4, 0xfd, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x3b, 0x72,
+	0xfc, 0x9f, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -276,6 +683,14 @@ type QueryClient interface {
 	// ReportedCheckpointBtcHeight returns the BTC light client height at which
 	// the checkpoint with the given hash is reported back to Babylon
 	ReportedCheckpointBtcHeight(ctx context.Context, in *QueryReportedCheckpointBtcHeightRequest, opts ...grpc.CallOption) (*QueryReportedCheckpointBtcHeightResponse, error)
+	// Params queries the parameters of the module
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CheckpointLatency returns the latency metrics of the checkpoint of the
+	// given epoch
+	CheckpointLatency(ctx context.Context, in *QueryCheckpointLatencyRequest, opts ...grpc.CallOption) (*QueryCheckpointLatencyResponse, error)
+	// CheckpointLatencies returns the latency metrics of the checkpoints of the
+	// epochs in the given inclusive range
+	CheckpointLatencies(ctx context.Context, in *QueryCheckpointLatenciesRequest, opts ...grpc.CallOption) (*QueryCheckpointLatenciesResponse, error)
 }
 
 type queryClient struct {
@@ -304,6 +719,33 @@ func (c *queryClient) ReportedCheckpointBtcHeight(ctx context.Context, in *Query
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointLatency(ctx context.Context, in *QueryCheckpointLatencyRequest, opts ...grpc.CallOption) (*QueryCheckpointLatencyResponse, error) {
+	out := new(QueryCheckpointLatencyResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/CheckpointLatency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckpointLatencies(ctx context.Context, in *QueryCheckpointLatenciesRequest, opts ...grpc.CallOption) (*QueryCheckpointLatenciesResponse, error) {
+	out := new(QueryCheckpointLatenciesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.monitor.v1.Query/CheckpointLatencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// EndedEpochBtcHeight returns the BTC light client height at provided epoch
@@ -312,6 +754,14 @@ type QueryServer interface {
 	// ReportedCheckpointBtcHeight returns the BTC light client height at which
 	// the checkpoint with the given hash is reported back to Babylon
 	ReportedCheckpointBtcHeight(context.Context, *QueryReportedCheckpointBtcHeightRequest) (*QueryReportedCheckpointBtcHeightResponse, error)
+	// Params queries the parameters of the module
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CheckpointLatency returns the latency metrics of the checkpoint of the
+	// given epoch
+	CheckpointLatency(context.Context, *QueryCheckpointLatencyRequest) (*QueryCheckpointLatencyResponse, error)
+	// CheckpointLatencies returns the latency metrics of the checkpoints of the
+	// epochs in the given inclusive range
+	CheckpointLatencies(context.Context, *QueryCheckpointLatenciesRequest) (*QueryCheckpointLatenciesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -324,6 +774,15 @@ func (*UnimplementedQueryServer) EndedEpochBtcHeight(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) ReportedCheckpointBtcHeight(ctx context.Context, req *QueryReportedCheckpointBtcHeightRequest) (*QueryReportedCheckpointBtcHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportedCheckpointBtcHeight not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointLatency(ctx context.Context, req *QueryCheckpointLatencyRequest) (*QueryCheckpointLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointLatency not implemented")
+}
+func (*UnimplementedQueryServer) CheckpointLatencies(ctx context.Context, req *QueryCheckpointLatenciesRequest) (*QueryCheckpointLatenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckpointLatencies not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -365,32 +824,98 @@ func _Query_ReportedCheckpointBtcHeight_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.monitor.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "EndedEpochBtcHeight",
-			Handler:    _Query_EndedEpochBtcHeight_Handler,
-		},
-		{
-			MethodName: "ReportedCheckpointBtcHeight",
-			Handler:    _Query_ReportedCheckpointBtcHeight_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/monitor/v1/query.proto",
-}
-
-func (m *QueryEndedEpochBtcHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointLatencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointLatency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/CheckpointLatency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointLatency(ctx, req.(*QueryCheckpointLatencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckpointLatencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointLatenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckpointLatencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.monitor.v1.Query/CheckpointLatencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckpointLatencies(ctx, req.(*QueryCheckpointLatenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.monitor.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EndedEpochBtcHeight",
+			Handler:    _Query_EndedEpochBtcHeight_Handler,
+		},
+		{
+			MethodName: "ReportedCheckpointBtcHeight",
+			Handler:    _Query_ReportedCheckpointBtcHeight_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CheckpointLatency",
+			Handler:    _Query_CheckpointLatency_Handler,
+		},
+		{
+			MethodName: "CheckpointLatencies",
+			Handler:    _Query_CheckpointLatencies_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/monitor/v1/query.proto",
+}
+
+func (m *QueryEndedEpochBtcHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
 
 func (m *QueryEndedEpochBtcHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
@@ -496,73 +1021,865 @@ func (m *QueryReportedCheckpointBtcHeightResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryEndedEpochBtcHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointLatencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointLatencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointLatencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.FinalizationLatency != nil {
+		{
+			size, err := m.FinalizationLatency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ConfirmationLatency != nil {
+		{
+			size, err := m.ConfirmationLatency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SubmissionLatency != nil {
+		{
+			size, err := m.SubmissionLatency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EpochEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochEndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochEndBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochEndBtcHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.EpochNum != 0 {
-		n += 1 + sovQuery(uint64(m.EpochNum))
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEndedEpochBtcHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCheckpointLatencyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointLatencyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointLatencyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BtcLightClientHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	if m.EpochNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReportedCheckpointBtcHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCheckpointLatencyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointLatencyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointLatencyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CkptHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Latency != nil {
+		{
+			size, err := m.Latency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryReportedCheckpointBtcHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCheckpointLatenciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointLatenciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointLatenciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BtcLightClientHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	if m.EndEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndEpoch))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.StartEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryCheckpointLatenciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointLatenciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointLatenciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Latencies) > 0 {
+		for iNdEx := len(m.Latencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Latencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEndedEpochBtcHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryEndedEpochBtcHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	}
+	return n
+}
+
+func (m *QueryReportedCheckpointBtcHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CkptHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportedCheckpointBtcHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcLightClientHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcLightClientHeight))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CheckpointLatencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	if m.EpochEndBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EpochEndBtcHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EpochEndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SubmissionLatency != nil {
+		l = m.SubmissionLatency.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConfirmationLatency != nil {
+		l = m.ConfirmationLatency.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FinalizationLatency != nil {
+		l = m.FinalizationLatency.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointLatencyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovQuery(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *QueryCheckpointLatencyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Latency != nil {
+		l = m.Latency.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointLatenciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.StartEpoch))
+	}
+	if m.EndEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.EndEpoch))
+	}
+	return n
+}
+
+func (m *QueryCheckpointLatenciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Latencies) > 0 {
+		for _, e := range m.Latencies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			}
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CkptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CkptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+			}
+			m.BtcLightClientHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *CheckpointLatencyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -585,10 +1902,10 @@ func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointLatencyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointLatencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -610,6 +1927,166 @@ func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndBtcHeight", wireType)
+			}
+			m.EpochEndBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochEndBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EpochEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmissionLatency == nil {
+				m.SubmissionLatency = &CheckpointLatency{}
+			}
+			if err := m.SubmissionLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfirmationLatency == nil {
+				m.ConfirmationLatency = &CheckpointLatency{}
+			}
+			if err := m.ConfirmationLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationLatency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizationLatency == nil {
+				m.FinalizationLatency = &CheckpointLatency{}
+			}
+			if err := m.FinalizationLatency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -631,7 +2108,7 @@ func (m *QueryEndedEpochBtcHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointLatencyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -654,17 +2131,17 @@ func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointLatencyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEndedEpochBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointLatencyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			m.BtcLightClientHeight = 0
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -674,7 +2151,7 @@ func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -700,7 +2177,7 @@ func (m *QueryEndedEpochBtcHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointLatencyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -723,17 +2200,17 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointLatencyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointLatencyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CkptHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -743,23 +2220,27 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CkptHash = string(dAtA[iNdEx:postIndex])
+			if m.Latency == nil {
+				m.Latency = &CheckpointLatencyResponse{}
+			}
+			if err := m.Latency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -782,7 +2263,7 @@ func (m *QueryReportedCheckpointBtcHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointLatenciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -805,17 +2286,17 @@ func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointLatenciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReportedCheckpointBtcHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointLatenciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcLightClientHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
 			}
-			m.BtcLightClientHeight = 0
+			m.StartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -825,11 +2306,114 @@ func (m *QueryReportedCheckpointBtcHeightResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcLightClientHeight |= uint32(b&0x7F) << shift
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEpoch", wireType)
+			}
+			m.EndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCheckpointLatenciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointLatenciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointLatenciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Latencies = append(m.Latencies, &CheckpointLatencyResponse{})
+			if err := m.Latencies[len(m.Latencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])